@@ -285,6 +285,14 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				Columns: selector.Columns,
 			})
 		}
+		var rowImageRules []*config.RowImageRule
+		for _, rule := range c.Sink.RowImageRules {
+			rowImageRules = append(rowImageRules, &config.RowImageRule{
+				Matcher:        rule.Matcher,
+				UpdateOldValue: rule.UpdateOldValue,
+				Delete:         rule.Delete,
+			})
+		}
 		var csvConfig *config.CSVConfig
 		if c.Sink.CSVConfig != nil {
 			csvConfig = &config.CSVConfig{
@@ -317,6 +325,11 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				BasicPassword:           c.Sink.PulsarConfig.BasicPassword,
 				AuthTLSCertificatePath:  c.Sink.PulsarConfig.AuthTLSCertificatePath,
 				AuthTLSPrivateKeyPath:   c.Sink.PulsarConfig.AuthTLSPrivateKeyPath,
+				EnableTransaction:       c.Sink.PulsarConfig.EnableTransaction,
+				TransactionTimeout:      (*config.TimeSec)(c.Sink.PulsarConfig.TransactionTimeout),
+				BatchingByKey:           c.Sink.PulsarConfig.BatchingByKey,
+				EnableChunking:          c.Sink.PulsarConfig.EnableChunking,
+				ChunkMaxMessageSize:     c.Sink.PulsarConfig.ChunkMaxMessageSize,
 			}
 			if c.Sink.PulsarConfig.OAuth2 != nil {
 				pulsarConfig.OAuth2 = &config.OAuth2{
@@ -399,6 +412,7 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				Cert:                         c.Sink.KafkaConfig.Cert,
 				Key:                          c.Sink.KafkaConfig.Key,
 				InsecureSkipVerify:           c.Sink.KafkaConfig.InsecureSkipVerify,
+				EnableExactlyOnce:            c.Sink.KafkaConfig.EnableExactlyOnce,
 				CodecConfig:                  codeConfig,
 				LargeMessageHandle:           largeMessageHandle,
 				GlueSchemaRegistryConfig:     glueSchemaRegistryConfig,
@@ -452,6 +466,7 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			OnlyOutputUpdatedColumns:         c.Sink.OnlyOutputUpdatedColumns,
 			DeleteOnlyOutputHandleKeyColumns: c.Sink.DeleteOnlyOutputHandleKeyColumns,
 			ContentCompatible:                c.Sink.ContentCompatible,
+			RowImageRules:                    rowImageRules,
 			KafkaConfig:                      kafkaConfig,
 			MySQLConfig:                      mysqlConfig,
 			PulsarConfig:                     pulsarConfig,
@@ -468,6 +483,9 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 		if c.Sink.DebeziumDisableSchema != nil {
 			res.Sink.DebeziumDisableSchema = util.AddressOf(*c.Sink.DebeziumDisableSchema)
 		}
+		if c.Sink.DebeziumOutputKeyAndSchemaChange != nil {
+			res.Sink.DebeziumOutputKeyAndSchemaChange = util.AddressOf(*c.Sink.DebeziumOutputKeyAndSchemaChange)
+		}
 
 		if c.Sink.SendBootstrapIntervalInSec != nil {
 			res.Sink.SendBootstrapIntervalInSec = util.AddressOf(*c.Sink.SendBootstrapIntervalInSec)
@@ -567,6 +585,14 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Columns: selector.Columns,
 			})
 		}
+		var rowImageRules []*RowImageRule
+		for _, rule := range cloned.Sink.RowImageRules {
+			rowImageRules = append(rowImageRules, &RowImageRule{
+				Matcher:        rule.Matcher,
+				UpdateOldValue: rule.UpdateOldValue,
+				Delete:         rule.Delete,
+			})
+		}
 		var csvConfig *CSVConfig
 		if cloned.Sink.CSVConfig != nil {
 			csvConfig = &CSVConfig{
@@ -649,6 +675,7 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Cert:                         cloned.Sink.KafkaConfig.Cert,
 				Key:                          cloned.Sink.KafkaConfig.Key,
 				InsecureSkipVerify:           cloned.Sink.KafkaConfig.InsecureSkipVerify,
+				EnableExactlyOnce:            cloned.Sink.KafkaConfig.EnableExactlyOnce,
 				CodecConfig:                  codeConfig,
 				LargeMessageHandle:           largeMessageHandle,
 				GlueSchemaRegistryConfig:     glueSchemaRegistryConfig,
@@ -694,6 +721,11 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				BasicPassword:           cloned.Sink.PulsarConfig.BasicPassword,
 				AuthTLSCertificatePath:  cloned.Sink.PulsarConfig.AuthTLSCertificatePath,
 				AuthTLSPrivateKeyPath:   cloned.Sink.PulsarConfig.AuthTLSPrivateKeyPath,
+				EnableTransaction:       cloned.Sink.PulsarConfig.EnableTransaction,
+				TransactionTimeout:      (*int)(cloned.Sink.PulsarConfig.TransactionTimeout),
+				BatchingByKey:           cloned.Sink.PulsarConfig.BatchingByKey,
+				EnableChunking:          cloned.Sink.PulsarConfig.EnableChunking,
+				ChunkMaxMessageSize:     cloned.Sink.PulsarConfig.ChunkMaxMessageSize,
 			}
 			if cloned.Sink.PulsarConfig.OAuth2 != nil {
 				pulsarConfig.OAuth2 = &PulsarOAuth2{
@@ -733,6 +765,7 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			OnlyOutputUpdatedColumns:         cloned.Sink.OnlyOutputUpdatedColumns,
 			DeleteOnlyOutputHandleKeyColumns: cloned.Sink.DeleteOnlyOutputHandleKeyColumns,
 			ContentCompatible:                cloned.Sink.ContentCompatible,
+			RowImageRules:                    rowImageRules,
 			KafkaConfig:                      kafkaConfig,
 			MySQLConfig:                      mysqlConfig,
 			PulsarConfig:                     pulsarConfig,
@@ -762,6 +795,9 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 		if cloned.Sink.DebeziumDisableSchema != nil {
 			res.Sink.DebeziumDisableSchema = util.AddressOf(*cloned.Sink.DebeziumDisableSchema)
 		}
+		if cloned.Sink.DebeziumOutputKeyAndSchemaChange != nil {
+			res.Sink.DebeziumOutputKeyAndSchemaChange = util.AddressOf(*cloned.Sink.DebeziumOutputKeyAndSchemaChange)
+		}
 	}
 	if cloned.Consistent != nil {
 		res.Consistent = &ConsistentConfig{
@@ -915,6 +951,7 @@ type SinkConfig struct {
 	OnlyOutputUpdatedColumns         *bool               `json:"only_output_updated_columns,omitempty"`
 	DeleteOnlyOutputHandleKeyColumns *bool               `json:"delete_only_output_handle_key_columns"`
 	ContentCompatible                *bool               `json:"content_compatible"`
+	RowImageRules                    []*RowImageRule     `json:"row_image_rules,omitempty"`
 	SafeMode                         *bool               `json:"safe_mode,omitempty"`
 	KafkaConfig                      *KafkaConfig        `json:"kafka_config,omitempty"`
 	PulsarConfig                     *PulsarConfig       `json:"pulsar_config,omitempty"`
@@ -925,6 +962,7 @@ type SinkConfig struct {
 	SendBootstrapInMsgCount          *int32              `json:"send_bootstrap_in_msg_count,omitempty"`
 	SendBootstrapToAllPartition      *bool               `json:"send_bootstrap_to_all_partition,omitempty"`
 	DebeziumDisableSchema            *bool               `json:"debezium_disable_schema,omitempty"`
+	DebeziumOutputKeyAndSchemaChange *bool               `json:"debezium_output_key_and_schema_change,omitempty"`
 }
 
 // CSVConfig denotes the csv config
@@ -964,6 +1002,14 @@ type ColumnSelector struct {
 	Columns []string `json:"columns,omitempty"`
 }
 
+// RowImageRule represents the before image rule for a table.
+// This is a duplicate of config.RowImageRule
+type RowImageRule struct {
+	Matcher        []string `json:"matcher,omitempty"`
+	UpdateOldValue string   `json:"update_old_value,omitempty"`
+	Delete         string   `json:"delete,omitempty"`
+}
+
 // ConsistentConfig represents replication consistency config for a changefeed
 // This is a duplicate of config.ConsistentConfig
 type ConsistentConfig struct {
@@ -1165,6 +1211,11 @@ type PulsarConfig struct {
 	AuthTLSCertificatePath  *string       `json:"auth-tls-certificate-path,omitempty"`
 	AuthTLSPrivateKeyPath   *string       `json:"auth-tls-private-key-path,omitempty"`
 	OAuth2                  *PulsarOAuth2 `json:"oauth2,omitempty"`
+	EnableTransaction       *bool         `json:"enable-transaction,omitempty"`
+	TransactionTimeout      *int          `json:"transaction-timeout,omitempty"`
+	BatchingByKey           *bool         `json:"batching-by-key,omitempty"`
+	EnableChunking          *bool         `json:"enable-chunking,omitempty"`
+	ChunkMaxMessageSize     *uint         `json:"chunk-max-message-size,omitempty"`
 }
 
 // PulsarOAuth2 is the configuration for OAuth2
@@ -1211,6 +1262,7 @@ type KafkaConfig struct {
 	Cert                         *string                   `json:"cert,omitempty"`
 	Key                          *string                   `json:"key,omitempty"`
 	InsecureSkipVerify           *bool                     `json:"insecure_skip_verify,omitempty"`
+	EnableExactlyOnce            *bool                     `json:"enable_exactly_once,omitempty"`
 	CodecConfig                  *CodecConfig              `json:"codec_config,omitempty"`
 	LargeMessageHandle           *LargeMessageHandleConfig `json:"large_message_handle,omitempty"`
 	GlueSchemaRegistryConfig     *GlueSchemaRegistryConfig `json:"glue_schema_registry_config,omitempty"`
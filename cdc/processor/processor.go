@@ -609,6 +609,7 @@ func (p *processor) lazyInitImpl(etcdCtx cdcContext.Context) (err error) {
 		return errors.Trace(err)
 	}
 	p.latestInfo.Config.Sink.TiDBSourceID = sourceID
+	p.latestInfo.Config.Sink.ChangefeedEpoch = p.changefeedEpoch
 
 	p.redo.r = redo.NewDMLManager(p.changefeedID, p.latestInfo.Config.Consistent)
 	p.redo.name = "RedoManager"
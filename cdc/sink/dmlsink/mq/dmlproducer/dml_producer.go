@@ -34,6 +34,23 @@ type DMLProducer interface {
 	Close()
 }
 
+// TxnDMLProducer is the interface for the DML producer which sends
+// messages in transactions.
+type TxnDMLProducer interface {
+	DMLProducer
+
+	// AsyncSendTableMessage sends a message of the table asynchronously
+	// in the ongoing transaction which the table belongs to.
+	AsyncSendTableMessage(
+		ctx context.Context, tableID model.TableID,
+		topic string, partition int32, message *common.Message,
+	) error
+
+	// Flush commits the ongoing transaction, the callbacks of
+	// the messages are called after the commit succeeds.
+	Flush(ctx context.Context) error
+}
+
 // Factory is a function to create a producer.
 // errCh is used to report error to the caller(i.e. processor,owner).
 // Because the caller passes errCh to many goroutines,
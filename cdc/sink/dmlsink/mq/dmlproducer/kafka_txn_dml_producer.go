@@ -0,0 +1,279 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"go.uber.org/zap"
+)
+
+var _ TxnDMLProducer = (*kafkaTxnDMLProducer)(nil)
+
+// txnProducerIdleTimeout is the duration after which the idle transactional
+// producer of a table is closed, e.g. the table is removed from this capture.
+const txnProducerIdleTimeout = 10 * time.Minute
+
+// tableTxnProducer is the transactional producer of a table.
+type tableTxnProducer struct {
+	transactionalID string
+	producer        kafka.TxnAsyncProducer
+	cancel          context.CancelFunc
+	// inTxn indicates whether there is an ongoing transaction.
+	inTxn bool
+	// lastActive is the last time a message is sent by the producer.
+	lastActive time.Time
+}
+
+// kafkaTxnDMLProducer is used to send messages to kafka in transactions.
+// The messages of each table are sent by the transactional producer of the table,
+// whose transactional ID is derived from the changefeed, its epoch and the table.
+// The ID doesn't change when the table is moved to another capture or the capture
+// is restarted, so the producer created by the new owner of the table fences the
+// zombie producer left by the previous one.
+type kafkaTxnDMLProducer struct {
+	// id indicates which processor (changefeed) this sink belongs to.
+	id model.ChangeFeedID
+	// epoch is the epoch of the changefeed.
+	epoch   uint64
+	factory kafka.Factory
+	// metricsCollector is used to report metrics.
+	metricsCollector kafka.MetricsCollector
+	errCh            chan error
+
+	// closedMu is used to protect `closed` and `producers`.
+	closedMu sync.RWMutex
+	closed   bool
+	// producers are the transactional producers of the tables.
+	producers map[model.TableID]*tableTxnProducer
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewKafkaTxnDMLProducer creates a new kafka producer which sends messages in transactions.
+func NewKafkaTxnDMLProducer(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	changefeedEpoch uint64,
+	factory kafka.Factory,
+	metricsCollector kafka.MetricsCollector,
+	errCh chan error,
+) (TxnDMLProducer, error) {
+	log.Info("Starting kafka transactional DML producer ...",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Uint64("changefeedEpoch", changefeedEpoch))
+
+	ctx, cancel := context.WithCancel(ctx)
+	k := &kafkaTxnDMLProducer{
+		id:               changefeedID,
+		epoch:            changefeedEpoch,
+		factory:          factory,
+		metricsCollector: metricsCollector,
+		errCh:            errCh,
+		producers:        make(map[model.TableID]*tableTxnProducer),
+		ctx:              ctx,
+		cancel:           cancel,
+	}
+
+	// Start collecting metrics.
+	go k.metricsCollector.Run(ctx)
+	return k, nil
+}
+
+// AsyncSendMessage is not supported, since the messages
+// must be sent by the transactional producers of the tables.
+func (k *kafkaTxnDMLProducer) AsyncSendMessage(
+	_ context.Context, _ string, _ int32, _ *common.Message,
+) error {
+	return cerror.ErrKafkaTransaction.GenWithStack(
+		"the message must be sent with its table in transactions")
+}
+
+// AsyncSendTableMessage sends the message in the ongoing transaction of the table,
+// a new transaction is started if there is no ongoing one.
+func (k *kafkaTxnDMLProducer) AsyncSendTableMessage(
+	ctx context.Context, tableID model.TableID,
+	topic string, partition int32, message *common.Message,
+) error {
+	// We have to hold the lock to avoid writing to a closed producer.
+	k.closedMu.Lock()
+	defer k.closedMu.Unlock()
+
+	// If the producer is closed, we should skip the message and return an error.
+	if k.closed {
+		return cerror.ErrKafkaProducerClosed.GenWithStackByArgs()
+	}
+
+	p, err := k.getProducer(ctx, tableID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !p.inTxn {
+		if err := p.producer.BeginTxn(); err != nil {
+			return errors.Trace(err)
+		}
+		p.inTxn = true
+	}
+	p.lastActive = time.Now()
+	return p.producer.AsyncSend(ctx, topic, partition,
+		message.Key, message.Value, message.Callback)
+}
+
+// getProducer returns the transactional producer of the table, it's created
+// if not exists, which fences the producers with the same transactional ID.
+func (k *kafkaTxnDMLProducer) getProducer(
+	ctx context.Context, tableID model.TableID,
+) (*tableTxnProducer, error) {
+	if p, ok := k.producers[tableID]; ok {
+		return p, nil
+	}
+	transactionalID := newTransactionalID(k.id, k.epoch, tableID)
+	producer, err := k.factory.TxnAsyncProducer(ctx, transactionalID)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrKafkaNewProducer, err)
+	}
+	log.Info("Kafka transactional producer created",
+		zap.String("namespace", k.id.Namespace),
+		zap.String("changefeed", k.id.ID),
+		zap.Int64("tableID", tableID),
+		zap.String("transactionalID", transactionalID))
+
+	ctx, cancel := context.WithCancel(k.ctx)
+	p := &tableTxnProducer{
+		transactionalID: transactionalID,
+		producer:        producer,
+		cancel:          cancel,
+	}
+	k.producers[tableID] = p
+	go k.run(ctx, p)
+	return p, nil
+}
+
+// Flush commits the ongoing transactions of the tables. The callbacks of the messages
+// are called after the commit, so the checkpoint ts of the table never exceeds the
+// commit ts of the events which are invisible to the consumers.
+func (k *kafkaTxnDMLProducer) Flush(_ context.Context) error {
+	k.closedMu.Lock()
+	defer k.closedMu.Unlock()
+	if k.closed {
+		return cerror.ErrKafkaProducerClosed.GenWithStackByArgs()
+	}
+
+	for tableID, p := range k.producers {
+		if !p.inTxn {
+			if time.Since(p.lastActive) >= txnProducerIdleTimeout {
+				k.closeProducer(tableID, p)
+			}
+			continue
+		}
+		p.inTxn = false
+		if err := p.producer.CommitTxn(); err != nil {
+			log.Warn("Commit kafka transaction failed, abort it",
+				zap.String("namespace", k.id.Namespace),
+				zap.String("changefeed", k.id.ID),
+				zap.Int64("tableID", tableID),
+				zap.String("transactionalID", p.transactionalID),
+				zap.Error(err))
+			k.abort(p)
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (k *kafkaTxnDMLProducer) Close() {
+	// We have to hold the lock to synchronize closing with writing.
+	k.closedMu.Lock()
+	defer k.closedMu.Unlock()
+	// If the producer has already been closed, we should skip this close operation.
+	if k.closed {
+		log.Warn("Kafka transactional DML producer already closed",
+			zap.String("namespace", k.id.Namespace),
+			zap.String("changefeed", k.id.ID))
+		return
+	}
+	for tableID, p := range k.producers {
+		k.closeProducer(tableID, p)
+	}
+	k.cancel()
+	k.closed = true
+}
+
+// closeProducer closes the transactional producer of the table.
+func (k *kafkaTxnDMLProducer) closeProducer(tableID model.TableID, p *tableTxnProducer) {
+	// Abort the ongoing transaction explicitly, otherwise the consumers with
+	// `read_committed` isolation level are blocked until it times out.
+	if p.inTxn {
+		p.inTxn = false
+		k.abort(p)
+	}
+	p.cancel()
+	p.producer.Close()
+	delete(k.producers, tableID)
+}
+
+// abort aborts the ongoing transaction, the callbacks of the messages are dropped.
+func (k *kafkaTxnDMLProducer) abort(p *tableTxnProducer) {
+	if err := p.producer.AbortTxn(); err != nil {
+		log.Warn("Abort kafka transaction failed",
+			zap.String("namespace", k.id.Namespace),
+			zap.String("changefeed", k.id.ID),
+			zap.String("transactionalID", p.transactionalID),
+			zap.Error(err))
+	}
+}
+
+func (k *kafkaTxnDMLProducer) run(ctx context.Context, p *tableTxnProducer) {
+	err := p.producer.AsyncRunCallback(ctx)
+	if err == nil || errors.Cause(err) == context.Canceled {
+		return
+	}
+	select {
+	case <-ctx.Done():
+	case k.errCh <- err:
+		log.Error("Kafka transactional DML producer run error",
+			zap.String("namespace", k.id.Namespace),
+			zap.String("changefeed", k.id.ID),
+			zap.String("transactionalID", p.transactionalID),
+			zap.Error(err))
+	default:
+		log.Error("Error channel is full in kafka transactional DML producer",
+			zap.String("namespace", k.id.Namespace),
+			zap.String("changefeed", k.id.ID),
+			zap.String("transactionalID", p.transactionalID),
+			zap.Error(err))
+	}
+}
+
+// newTransactionalID returns the transactional ID of the table in the changefeed,
+// which is unique among all TiCDC clusters writing to the same kafka cluster.
+func newTransactionalID(
+	changefeedID model.ChangeFeedID, changefeedEpoch uint64, tableID model.TableID,
+) string {
+	serverCfg := config.GetGlobalServerConfig()
+	return fmt.Sprintf("ticdc-%s-%s-%s-%d-%d",
+		serverCfg.ClusterID, changefeedID.Namespace, changefeedID.ID, changefeedEpoch, tableID)
+}
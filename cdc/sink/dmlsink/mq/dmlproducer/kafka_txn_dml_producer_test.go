@@ -0,0 +1,166 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func newTxnProducer(
+	ctx context.Context, t *testing.T,
+	coordinator *kafka.MockTxnCoordinator, changefeed model.ChangeFeedID, epoch uint64,
+) TxnDMLProducer {
+	options := getOptions()
+	options.Version = "2.4.0"
+	options.EnableExactlyOnce = true
+	factory, err := coordinator.NewMockFactory(options, changefeed)
+	require.NoError(t, err)
+	adminClient, err := factory.AdminClient(ctx)
+	require.NoError(t, err)
+	metricsCollector := factory.MetricsCollector(util.RoleTester, adminClient)
+	producer, err := NewKafkaTxnDMLProducer(ctx, changefeed, epoch, factory, metricsCollector, make(chan error, 1))
+	require.NoError(t, err)
+	return producer
+}
+
+func TestTxnProducerCommitOnFlush(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	coordinator := kafka.NewMockTxnCoordinator()
+	changefeed := model.DefaultChangeFeedID("changefeed-test")
+	producer := newTxnProducer(ctx, t, coordinator, changefeed, 1)
+	defer producer.Close()
+
+	count := atomic.NewInt64(0)
+	for i := 0; i < 10; i++ {
+		for _, partition := range []int32{0, 1} {
+			err := producer.AsyncSendTableMessage(ctx, model.TableID(partition),
+				kafka.DefaultMockTopicName, partition, &common.Message{
+					Key:   []byte("test-key"),
+					Value: []byte("test-value"),
+					Callback: func() {
+						count.Add(1)
+					},
+				})
+			require.NoError(t, err)
+		}
+	}
+	// The messages are invisible and the callbacks are not
+	// called before the transaction is committed.
+	require.Empty(t, coordinator.CommittedMessages())
+	require.Equal(t, int64(0), count.Load())
+
+	require.NoError(t, producer.Flush(ctx))
+	require.Len(t, coordinator.CommittedMessages(), 20)
+	require.Equal(t, int64(20), count.Load())
+
+	// Flush without an ongoing transaction is a no-op.
+	require.NoError(t, producer.Flush(ctx))
+	require.Len(t, coordinator.CommittedMessages(), 20)
+	require.Zero(t, coordinator.AbortedTxns())
+}
+
+func TestTxnProducerFenceZombie(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	coordinator := kafka.NewMockTxnCoordinator()
+	changefeed := model.DefaultChangeFeedID("changefeed-test")
+	// The producers of the changefeed in two captures.
+	zombie := newTxnProducer(ctx, t, coordinator, changefeed, 1)
+	defer zombie.Close()
+	producer := newTxnProducer(ctx, t, coordinator, changefeed, 1)
+	defer producer.Close()
+
+	count := atomic.NewInt64(0)
+	message := &common.Message{
+		Key:      []byte("test-key"),
+		Value:    []byte("test-value"),
+		Callback: func() { count.Add(1) },
+	}
+	require.NoError(t, zombie.AsyncSendTableMessage(ctx, 1, kafka.DefaultMockTopicName, 0, message))
+
+	// The table is moved to the other capture, the producer
+	// created by the new owner fences the previous one.
+	require.NoError(t, producer.AsyncSendTableMessage(ctx, 1, kafka.DefaultMockTopicName, 0, message))
+	require.NoError(t, producer.Flush(ctx))
+
+	err := zombie.Flush(ctx)
+	require.ErrorIs(t, err, sarama.ErrProducerFenced)
+	require.Len(t, coordinator.CommittedMessages(), 1)
+	require.Equal(t, int64(1), count.Load())
+	require.Equal(t, 1, coordinator.AbortedTxns())
+
+	// The transactional ID is scoped by the table, so the
+	// other tables owned by the previous capture are not affected.
+	require.NoError(t, zombie.AsyncSendTableMessage(ctx, 2, kafka.DefaultMockTopicName, 0, message))
+	require.NoError(t, zombie.Flush(ctx))
+	require.Len(t, coordinator.CommittedMessages(), 2)
+
+	// The transactional ID is scoped by the changefeed and its epoch,
+	// so the other changefeeds and the changefeed recreated with a new
+	// epoch are not affected.
+	other := newTxnProducer(ctx, t, coordinator, model.DefaultChangeFeedID("other"), 1)
+	defer other.Close()
+	require.NoError(t, other.AsyncSendTableMessage(ctx, 1, kafka.DefaultMockTopicName, 0, message))
+	require.NoError(t, other.Flush(ctx))
+	recreated := newTxnProducer(ctx, t, coordinator, changefeed, 2)
+	defer recreated.Close()
+	require.NoError(t, recreated.AsyncSendTableMessage(ctx, 1, kafka.DefaultMockTopicName, 0, message))
+	require.NoError(t, recreated.Flush(ctx))
+	require.NoError(t, producer.AsyncSendTableMessage(ctx, 1, kafka.DefaultMockTopicName, 0, message))
+	require.NoError(t, producer.Flush(ctx))
+	require.Len(t, coordinator.CommittedMessages(), 5)
+}
+
+func TestTxnProducerClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	coordinator := kafka.NewMockTxnCoordinator()
+	producer := newTxnProducer(ctx, t, coordinator,
+		model.DefaultChangeFeedID("changefeed-test"), 1)
+	count := atomic.NewInt64(0)
+	message := &common.Message{
+		Key:      []byte("test-key"),
+		Value:    []byte("test-value"),
+		Callback: func() { count.Add(1) },
+	}
+	require.NoError(t, producer.AsyncSendTableMessage(ctx, 1, kafka.DefaultMockTopicName, 0, message))
+
+	// The ongoing transaction is aborted on close.
+	producer.Close()
+	require.Equal(t, 1, coordinator.AbortedTxns())
+	require.Empty(t, coordinator.CommittedMessages())
+	require.Equal(t, int64(0), count.Load())
+	// Close again should not panic.
+	producer.Close()
+	require.Equal(t, 1, coordinator.AbortedTxns())
+
+	err := producer.AsyncSendTableMessage(ctx, 1, kafka.DefaultMockTopicName, 0, message)
+	require.True(t, cerror.ErrKafkaProducerClosed.Equal(err))
+	err = producer.Flush(ctx)
+	require.True(t, cerror.ErrKafkaProducerClosed.Equal(err))
+}
@@ -121,6 +121,12 @@ func NewPulsarDMLProducer(
 	}
 	log.Info("Pulsar DML producer created", zap.Stringer("changefeed", p.id),
 		zap.Duration("duration", time.Since(start)))
+	if pulsarConfig.EnableTransaction != nil && *pulsarConfig.EnableTransaction {
+		return &pulsarTxnDMLProducer{
+			pulsarDMLProducer: p,
+			txnTimeout:        pulsarConfig.TransactionTimeout.Duration(),
+		}, nil
+	}
 	return p, nil
 }
 
@@ -129,8 +135,6 @@ func (p *pulsarDMLProducer) AsyncSendMessage(
 	ctx context.Context, topic string,
 	partition int32, message *common.Message,
 ) error {
-	wrapperSchemaAndTopic(message)
-
 	// We have to hold the lock to avoid writing to a closed producer.
 	// Close may be blocked for a long time.
 	p.closedMu.RLock()
@@ -140,17 +144,38 @@ func (p *pulsarDMLProducer) AsyncSendMessage(
 	if p.closed {
 		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
 	}
+	return p.asyncSend(ctx, topic, message, nil, func(err error) {
+		if err == nil && message.Callback != nil {
+			message.Callback()
+		}
+	})
+}
+
+// asyncSend sends the message in the transaction if txn is not nil,
+// the callback is called with the result after the message is sent.
+func (p *pulsarDMLProducer) asyncSend(
+	ctx context.Context, topic string, message *common.Message,
+	txn pulsar.Transaction, callback func(err error),
+) error {
+	wrapperSchemaAndTopic(message)
+
 	failpoint.Inject("PulsarSinkAsyncSendError", func() {
 		// simulate sending message to input channel successfully but flushing
 		// message to Pulsar meets error
 		log.Info("PulsarSinkAsyncSendError error injected", zap.String("namespace", p.id.Namespace),
 			zap.String("changefeed", p.id.ID))
-		p.failpointCh <- errors.New("pulsar sink injected error")
+		err := errors.New("pulsar sink injected error")
+		p.failpointCh <- err
+		callback(err)
 		failpoint.Return(nil)
 	})
 	data := &pulsar.ProducerMessage{
 		Payload: message.Value,
 		Key:     message.GetPartitionKey(),
+		// The key-shared subscriptions dispatch messages by the ordering key, so the
+		// messages with the same partition key are consumed by one consumer in order.
+		OrderingKey: message.GetPartitionKey(),
+		Transaction: txn,
 	}
 
 	producer, err := p.GetProducerByTopic(topic)
@@ -180,11 +205,12 @@ func (p *pulsarDMLProducer) AsyncSendMessage(
 					log.Warn("Error channel is full in pulsar DML producer",
 						zap.Stringer("changefeed", p.id), zap.Error(e))
 				}
-			} else if message.Callback != nil {
-				// success
-				message.Callback()
-				mq.IncPublishedDMLSuccess(topic, p.id.ID, message.GetSchema())
+				callback(e)
+				return
 			}
+			// success
+			callback(nil)
+			mq.IncPublishedDMLSuccess(topic, p.id.ID, message.GetSchema())
 		})
 
 	mq.IncPublishedDMLCount(topic, p.id.ID, message.GetSchema())
@@ -245,6 +271,16 @@ func newProducer(
 	if pConfig.SendTimeout != nil {
 		po.SendTimeout = pConfig.SendTimeout.Duration()
 	}
+	if pConfig.EnableChunking != nil && *pConfig.EnableChunking {
+		// chunking can not be enabled when batching is enabled.
+		po.DisableBatching = true
+		po.EnableChunking = true
+		if pConfig.ChunkMaxMessageSize != nil {
+			po.ChunkMaxMessageSize = *pConfig.ChunkMaxMessageSize
+		}
+	} else if pConfig.BatchingByKey != nil && *pConfig.BatchingByKey {
+		po.BatcherBuilderType = pulsar.KeyBasedBatchBuilder
+	}
 
 	producer, err := client.CreateProducer(po)
 	if err != nil {
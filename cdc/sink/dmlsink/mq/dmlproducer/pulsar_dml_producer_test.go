@@ -15,12 +15,17 @@ package dmlproducer
 
 import (
 	"context"
+	"errors"
 	"net/url"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	pulsarConfig "github.com/pingcap/tiflow/pkg/sink/pulsar"
 	"github.com/stretchr/testify/require"
@@ -101,3 +106,205 @@ func Test_pulsarDMLProducer_AsyncSendMessage(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+type fakePulsarTxn struct {
+	pulsar.Transaction
+	commitErr error
+	committed bool
+	aborted   bool
+}
+
+func (t *fakePulsarTxn) Commit(context.Context) error {
+	if t.commitErr != nil {
+		return t.commitErr
+	}
+	t.committed = true
+	return nil
+}
+
+func (t *fakePulsarTxn) Abort(context.Context) error {
+	t.aborted = true
+	return nil
+}
+
+type fakePulsarProducer struct {
+	pulsar.Producer
+	client *fakePulsarClient
+}
+
+func (p *fakePulsarProducer) SendAsync(
+	_ context.Context, m *pulsar.ProducerMessage,
+	callback func(pulsar.MessageID, *pulsar.ProducerMessage, error),
+) {
+	p.client.mu.Lock()
+	p.client.messages = append(p.client.messages, m)
+	sendErr := p.client.sendErr
+	p.client.mu.Unlock()
+	callback(nil, m, sendErr)
+}
+
+func (p *fakePulsarProducer) Close() {}
+
+// fakePulsarClient records the producer options, the messages
+// sent and the transactions created by the pulsar DML producer.
+type fakePulsarClient struct {
+	pulsar.Client
+
+	mu       sync.Mutex
+	options  []pulsar.ProducerOptions
+	messages []*pulsar.ProducerMessage
+	txns     []*fakePulsarTxn
+	// sendErr is returned to the callbacks of the messages if it's not nil.
+	sendErr error
+}
+
+func (c *fakePulsarClient) CreateProducer(o pulsar.ProducerOptions) (pulsar.Producer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.options = append(c.options, o)
+	return &fakePulsarProducer{client: c}, nil
+}
+
+func (c *fakePulsarClient) NewTransaction(time.Duration) (pulsar.Transaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	txn := &fakePulsarTxn{}
+	c.txns = append(c.txns, txn)
+	return txn, nil
+}
+
+func (c *fakePulsarClient) Close() {}
+
+func TestPulsarDMLProducerOrderingKey(t *testing.T) {
+	t.Parallel()
+
+	_, rc := newPulsarConfig(t)
+	rc.Sink.PulsarConfig.BatchingByKey = aws.Bool(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &fakePulsarClient{}
+	dml, err := NewPulsarDMLProducer(ctx, model.DefaultChangeFeedID("changefeed-test"),
+		client, rc.Sink, make(chan error, 1), make(chan error, 1))
+	require.NoError(t, err)
+	defer dml.Close()
+	_, ok := dml.(TxnDMLProducer)
+	require.False(t, ok)
+
+	err = dml.AsyncSendMessage(ctx, "test", 0, &common.Message{
+		Value:        []byte("this value for test input data"),
+		PartitionKey: str2Pointer("test_key"),
+	})
+	require.NoError(t, err)
+	require.Len(t, client.messages, 1)
+	require.Equal(t, "test_key", client.messages[0].Key)
+	require.Equal(t, "test_key", client.messages[0].OrderingKey)
+	require.Nil(t, client.messages[0].Transaction)
+
+	require.Len(t, client.options, 2)
+	for _, o := range client.options {
+		require.Equal(t, pulsar.KeyBasedBatchBuilder, o.BatcherBuilderType)
+		require.False(t, o.EnableChunking)
+	}
+}
+
+func TestPulsarDMLProducerChunking(t *testing.T) {
+	t.Parallel()
+
+	_, rc := newPulsarConfig(t)
+	rc.Sink.PulsarConfig.EnableChunking = aws.Bool(true)
+	rc.Sink.PulsarConfig.ChunkMaxMessageSize = aws.Uint(1024)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &fakePulsarClient{}
+	dml, err := NewPulsarDMLProducer(ctx, model.DefaultChangeFeedID("changefeed-test"),
+		client, rc.Sink, make(chan error, 1), make(chan error, 1))
+	require.NoError(t, err)
+	defer dml.Close()
+
+	require.Len(t, client.options, 1)
+	require.True(t, client.options[0].EnableChunking)
+	require.True(t, client.options[0].DisableBatching)
+	require.Equal(t, uint(1024), client.options[0].ChunkMaxMessageSize)
+}
+
+func TestPulsarTxnDMLProducer(t *testing.T) {
+	t.Parallel()
+
+	_, rc := newPulsarConfig(t)
+	rc.Sink.PulsarConfig.EnableTransaction = aws.Bool(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &fakePulsarClient{}
+	dml, err := NewPulsarDMLProducer(ctx, model.DefaultChangeFeedID("changefeed-test"),
+		client, rc.Sink, make(chan error, 1), make(chan error, 1))
+	require.NoError(t, err)
+	defer dml.Close()
+	producer, ok := dml.(TxnDMLProducer)
+	require.True(t, ok)
+
+	count := 0
+	for i := 0; i < 2; i++ {
+		err = producer.AsyncSendMessage(ctx, "test", 0, &common.Message{
+			Value:        []byte("this value for test input data"),
+			PartitionKey: str2Pointer("test_key"),
+			Callback: func() {
+				count++
+			},
+		})
+		require.NoError(t, err)
+	}
+	// All messages share one transaction, and the callbacks
+	// are not called before the transaction is committed.
+	require.Len(t, client.txns, 1)
+	require.Len(t, client.messages, 2)
+	for _, m := range client.messages {
+		require.Equal(t, client.txns[0], m.Transaction)
+	}
+	require.Equal(t, 0, count)
+
+	require.NoError(t, producer.Flush(ctx))
+	require.True(t, client.txns[0].committed)
+	require.Equal(t, 2, count)
+	// Flush without ongoing transaction is a no-op.
+	require.NoError(t, producer.Flush(ctx))
+	require.Len(t, client.txns, 1)
+
+	// The transaction is aborted if the commit fails.
+	err = producer.AsyncSendMessage(ctx, "test", 0, &common.Message{
+		Value: []byte("this value for test input data"),
+		Callback: func() {
+			count++
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, client.txns, 2)
+	client.txns[1].commitErr = errors.New("commit failed")
+	err = producer.Flush(ctx)
+	require.ErrorIs(t, err, cerror.ErrPulsarTransaction)
+	require.True(t, client.txns[1].aborted)
+	require.Equal(t, 2, count)
+
+	// The transaction is aborted if any message failed to be sent,
+	// and the callbacks of the messages sent successfully are not called.
+	for _, sendErr := range []error{nil, errors.New("send failed")} {
+		client.mu.Lock()
+		client.sendErr = sendErr
+		client.mu.Unlock()
+		err = producer.AsyncSendMessage(ctx, "test", 0, &common.Message{
+			Value: []byte("this value for test input data"),
+			Callback: func() {
+				count++
+			},
+		})
+		require.NoError(t, err)
+	}
+	require.Len(t, client.txns, 3)
+	err = producer.Flush(ctx)
+	require.ErrorIs(t, err, cerror.ErrPulsarTransaction)
+	require.False(t, client.txns[2].committed)
+	require.True(t, client.txns[2].aborted)
+	require.Equal(t, 2, count)
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"go.uber.org/zap"
+)
+
+var _ TxnDMLProducer = (*pulsarTxnDMLProducer)(nil)
+
+// pulsarTxn is the ongoing transaction with the messages sent in it.
+type pulsarTxn struct {
+	pulsar.Transaction
+	// callbacks are called after the transaction is committed.
+	callbacks []func()
+	// sending is used to wait for the results of the messages.
+	sending sync.WaitGroup
+
+	mu sync.Mutex
+	// sendErr is the first error of sending the messages.
+	sendErr error
+}
+
+func (t *pulsarTxn) done(err error) {
+	if err != nil {
+		t.mu.Lock()
+		if t.sendErr == nil {
+			t.sendErr = err
+		}
+		t.mu.Unlock()
+	}
+	t.sending.Done()
+}
+
+// wait waits for the results of all messages sent in the transaction,
+// it returns the error if any message failed to be sent.
+func (t *pulsarTxn) wait(ctx context.Context) error {
+	sent := make(chan struct{})
+	go func() {
+		t.sending.Wait()
+		close(sent)
+	}()
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	case <-sent:
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.sendErr
+}
+
+// pulsarTxnDMLProducer is used to send messages to pulsar in transactions.
+// Unlike kafka, the pulsar transaction is not bound to a producer, so the
+// messages of all tables are sent in one transaction, which is committed
+// at the flush boundary.
+type pulsarTxnDMLProducer struct {
+	*pulsarDMLProducer
+
+	txnTimeout time.Duration
+	// txnMu is used to protect `txn`.
+	txnMu sync.Mutex
+	txn   *pulsarTxn
+}
+
+// AsyncSendMessage sends the message in the ongoing transaction.
+func (p *pulsarTxnDMLProducer) AsyncSendMessage(
+	ctx context.Context, topic string,
+	_ int32, message *common.Message,
+) error {
+	// We have to hold the lock to avoid writing to a closed producer.
+	p.closedMu.RLock()
+	defer p.closedMu.RUnlock()
+
+	// If producers are closed, we should skip the message and return an error.
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	p.txnMu.Lock()
+	defer p.txnMu.Unlock()
+	if p.txn == nil {
+		txn, err := p.client.NewTransaction(p.txnTimeout)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrPulsarTransaction, err)
+		}
+		p.txn = &pulsarTxn{Transaction: txn}
+	}
+	txn := p.txn
+	if message.Callback != nil {
+		txn.callbacks = append(txn.callbacks, message.Callback)
+	}
+	txn.sending.Add(1)
+	if err := p.asyncSend(ctx, topic, message, txn.Transaction, txn.done); err != nil {
+		txn.done(err)
+		return err
+	}
+	return nil
+}
+
+// AsyncSendTableMessage sends the message in the ongoing transaction,
+// the messages of all tables are sent in the same transaction.
+func (p *pulsarTxnDMLProducer) AsyncSendTableMessage(
+	ctx context.Context, _ model.TableID,
+	topic string, partition int32, message *common.Message,
+) error {
+	return p.AsyncSendMessage(ctx, topic, partition, message)
+}
+
+// Flush commits the ongoing transaction, it waits for all the messages sent in
+// the transaction, and then calls the callbacks of them. The transaction is
+// aborted if any message failed to be sent, the callbacks are not called then.
+func (p *pulsarTxnDMLProducer) Flush(ctx context.Context) error {
+	p.closedMu.RLock()
+	defer p.closedMu.RUnlock()
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	p.txnMu.Lock()
+	txn := p.txn
+	p.txn = nil
+	p.txnMu.Unlock()
+	if txn == nil {
+		return nil
+	}
+
+	if err := txn.wait(ctx); err != nil {
+		log.Warn("Send message in pulsar transaction failed, abort it",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID),
+			zap.Error(err))
+		p.abort(ctx, txn)
+		return cerror.WrapError(cerror.ErrPulsarTransaction, err)
+	}
+	if err := txn.Commit(ctx); err != nil {
+		log.Warn("Commit pulsar transaction failed, abort it",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID),
+			zap.Error(err))
+		p.abort(ctx, txn)
+		return cerror.WrapError(cerror.ErrPulsarTransaction, err)
+	}
+	for _, callback := range txn.callbacks {
+		callback()
+	}
+	return nil
+}
+
+// abort aborts the transaction, the callbacks of the messages are dropped.
+func (p *pulsarTxnDMLProducer) abort(ctx context.Context, txn *pulsarTxn) {
+	if err := txn.Abort(ctx); err != nil {
+		log.Warn("Abort pulsar transaction failed",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID),
+			zap.Error(err))
+	}
+}
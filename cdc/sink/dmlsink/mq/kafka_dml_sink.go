@@ -54,6 +54,14 @@ func NewKafkaDMLSink(
 		return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
 	}
 
+	// The transactions are committed at the resolved ts boundaries of the tables,
+	// which are tracked by the physical table ID rather than the span.
+	if options.EnableExactlyOnce && replicaConfig.Scheduler != nil &&
+		replicaConfig.Scheduler.EnableTableAcrossNodes {
+		return nil, cerror.ErrKafkaInvalidConfig.GenWithStack(
+			"`enable-exactly-once` is not compatible with `scheduler.enable-table-across-nodes`")
+	}
+
 	factory, err := factoryCreator(options, changefeedID)
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrKafkaNewProducer, err)
@@ -113,14 +121,23 @@ func NewKafkaDMLSink(
 		return nil, cerror.WrapError(cerror.ErrKafkaNewProducer, err)
 	}
 
-	failpointCh := make(chan error, 1)
-	asyncProducer, err := factory.AsyncProducer(ctx, failpointCh)
-	if err != nil {
-		return nil, cerror.WrapError(cerror.ErrKafkaNewProducer, err)
-	}
-
 	metricsCollector := factory.MetricsCollector(tiflowutil.RoleProcessor, adminClient)
-	dmlProducer := producerCreator(ctx, changefeedID, asyncProducer, metricsCollector, errCh, failpointCh)
+	var dmlProducer dmlproducer.DMLProducer
+	if options.EnableExactlyOnce {
+		// The messages of each table are sent by its own transactional producer.
+		dmlProducer, err = dmlproducer.NewKafkaTxnDMLProducer(ctx, changefeedID,
+			replicaConfig.Sink.ChangefeedEpoch, factory, metricsCollector, errCh)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	} else {
+		failpointCh := make(chan error, 1)
+		asyncProducer, err := factory.AsyncProducer(ctx, failpointCh)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrKafkaNewProducer, err)
+		}
+		dmlProducer = producerCreator(ctx, changefeedID, asyncProducer, metricsCollector, errCh, failpointCh)
+	}
 	encoderGroup := codec.NewEncoderGroup(replicaConfig.Sink, encoderBuilder, changefeedID)
 	s := newDMLSink(ctx, changefeedID, dmlProducer, adminClient, topicManager,
 		eventRouter, trans, encoderGroup, protocol, scheme, errCh)
@@ -158,6 +158,8 @@ func (s *dmlSink) WriteEvents(txns ...*dmlsink.CallbackableEvent[*model.SingleTa
 		}
 	}
 
+	// resolvedTables are the tables whose events are written in this call.
+	var resolvedTables []model.TableID
 	for _, txn := range txns {
 		if txn.GetTableSinkState() != state.TableSinkSinking {
 			// The table where the event comes from is in stopping, so it's safe
@@ -207,6 +209,16 @@ func (s *dmlSink) WriteEvents(txns ...*dmlsink.CallbackableEvent[*model.SingleTa
 					SinkState: txn.SinkState,
 				},
 			}
+			if len(resolvedTables) == 0 || resolvedTables[len(resolvedTables)-1] != row.PhysicalTableID {
+				resolvedTables = append(resolvedTables, row.PhysicalTableID)
+			}
+		}
+	}
+	// The table sink writes all events before a resolved ts in one call, mark the
+	// boundaries so that the messages are committed in complete batches.
+	if s.alive.worker.txnTables != nil {
+		for i := range resolvedTables {
+			s.alive.worker.msgChan.In() <- mqEvent{resolvedTable: &resolvedTables[i]}
 		}
 	}
 	return nil
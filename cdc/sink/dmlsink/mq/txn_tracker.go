@@ -0,0 +1,140 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"sync"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+)
+
+// heldMessages are the encoded messages of a table which are not sent yet.
+type heldMessages struct {
+	tableID  model.TableID
+	key      model.TopicPartitionKey
+	messages []*common.Message
+	// end is the number of the events of the table added to the
+	// encoder group, including the events of these messages.
+	end int
+}
+
+// txnTable is the progress of a table whose messages are sent in transactions.
+type txnTable struct {
+	// added is the number of the events added to the encoder group.
+	added int
+	// resolved is the value of `added` at the latest resolved ts boundary.
+	resolved int
+	// boundaries are the values of `added` at the resolved ts boundaries
+	// whose messages are not released yet.
+	boundaries []int
+	// received is the number of the events whose messages are received from the encoder group.
+	received int
+	held     []heldMessages
+}
+
+// txnTableTracker holds the messages of each table until all events before the
+// resolved ts boundary of the table are encoded, so that the transactions only
+// contain the complete batches of events resolved by the table sinks. Otherwise,
+// the events committed before a failover would be replayed from the checkpoint.
+type txnTableTracker struct {
+	mu     sync.Mutex
+	tables map[model.TableID]*txnTable
+	// resolvedCh is notified once a resolved ts boundary is added.
+	resolvedCh chan struct{}
+}
+
+func newTxnTableTracker() *txnTableTracker {
+	return &txnTableTracker{
+		tables:     make(map[model.TableID]*txnTable),
+		resolvedCh: make(chan struct{}, 1),
+	}
+}
+
+func (t *txnTableTracker) getTable(tableID model.TableID) *txnTable {
+	table, ok := t.tables[tableID]
+	if !ok {
+		table = &txnTable{}
+		t.tables[tableID] = table
+	}
+	return table
+}
+
+// addEvents records the events of the table added to the encoder group.
+func (t *txnTableTracker) addEvents(tableID model.TableID, count int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.getTable(tableID).added += count
+}
+
+// resolve marks that all events of the table added so far are resolved.
+func (t *txnTableTracker) resolve(tableID model.TableID) {
+	t.mu.Lock()
+	table := t.getTable(tableID)
+	if table.added > table.resolved {
+		table.resolved = table.added
+		table.boundaries = append(table.boundaries, table.added)
+	}
+	t.mu.Unlock()
+
+	select {
+	case t.resolvedCh <- struct{}{}:
+	default:
+	}
+}
+
+// hold holds the messages encoded from the events of the table.
+func (t *txnTableTracker) hold(
+	tableID model.TableID, eventCount int,
+	key model.TopicPartitionKey, messages []*common.Message,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	table := t.getTable(tableID)
+	table.received += eventCount
+	table.held = append(table.held, heldMessages{
+		tableID:  tableID,
+		key:      key,
+		messages: messages,
+		end:      table.received,
+	})
+}
+
+// release returns the held messages before the latest resolved ts boundaries.
+func (t *txnTableTracker) release() []heldMessages {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var released []heldMessages
+	for tableID, table := range t.tables {
+		boundary := 0
+		for len(table.boundaries) > 0 && table.boundaries[0] <= table.received {
+			boundary = table.boundaries[0]
+			table.boundaries = table.boundaries[1:]
+		}
+		if boundary == 0 {
+			continue
+		}
+		i := 0
+		for i < len(table.held) && table.held[i].end <= boundary {
+			i++
+		}
+		released = append(released, table.held[:i]...)
+		table.held = append([]heldMessages(nil), table.held[i:]...)
+		// The table is idle, forget it in case it's removed from this capture.
+		if len(table.held) == 0 && len(table.boundaries) == 0 && table.added == table.received {
+			delete(t.tables, tableID)
+		}
+	}
+	return released
+}
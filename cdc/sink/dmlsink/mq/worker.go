@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/chann"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -39,6 +40,10 @@ const (
 	// batchInterval is the interval of the worker to collect a batch of messages.
 	// It shouldn't be too large, otherwise it will lead to a high latency.
 	batchInterval = 15 * time.Millisecond
+	// txnFlushInterval is the maximum interval to commit the ongoing transaction
+	// if the messages are sent in transactions. The transaction is also committed
+	// once there is no more message to send.
+	txnFlushInterval = 100 * time.Millisecond
 )
 
 // mqEvent is the event of the mq worker.
@@ -46,6 +51,10 @@ const (
 type mqEvent struct {
 	key      model.TopicPartitionKey
 	rowEvent *dmlsink.RowChangeCallbackableEvent
+	// resolvedTable is not nil if the event is a resolved ts boundary of the
+	// table rather than a row, it's only used when the messages are sent in
+	// transactions.
+	resolvedTable *model.TableID
 }
 
 // worker will send messages to the DML producer on a batch basis.
@@ -64,6 +73,11 @@ type worker struct {
 
 	// producer is used to send the messages to the Kafka broker.
 	producer dmlproducer.DMLProducer
+	// txnProducer is not nil if the messages are sent in transactions, i.e. the
+	// kafka exactly-once or the pulsar transaction is enabled.
+	txnProducer dmlproducer.TxnDMLProducer
+	// txnTables holds the messages of each table until its resolved ts boundary.
+	txnTables *txnTableTracker
 
 	// metricMQWorkerSendMessageDuration tracks the time duration cost on send messages.
 	metricMQWorkerSendMessageDuration prometheus.Observer
@@ -95,6 +109,10 @@ func newWorker(
 		metricMQWorkerBatchDuration:       mq.WorkerBatchDuration.WithLabelValues(id.Namespace, id.ID),
 		statistics:                        statistics,
 	}
+	if txnProducer, ok := producer.(dmlproducer.TxnDMLProducer); ok {
+		w.txnProducer = txnProducer
+		w.txnTables = newTxnTableTracker()
+	}
 
 	return w
 }
@@ -145,6 +163,10 @@ func (w *worker) nonBatchEncodeRun(ctx context.Context) error {
 					zap.String("changefeed", w.changeFeedID.ID))
 				return nil
 			}
+			if event.resolvedTable != nil {
+				w.txnTables.resolve(*event.resolvedTable)
+				continue
+			}
 			if event.rowEvent.GetTableSinkState() != state.TableSinkSinking {
 				event.rowEvent.Callback()
 				log.Debug("Skip event of stopped table",
@@ -153,6 +175,9 @@ func (w *worker) nonBatchEncodeRun(ctx context.Context) error {
 					zap.Any("event", event))
 				continue
 			}
+			if w.txnTables != nil {
+				w.txnTables.addEvents(event.rowEvent.Event.PhysicalTableID, 1)
+			}
 			if err := w.encoderGroup.AddEvents(
 				ctx,
 				event.key,
@@ -186,19 +211,30 @@ func (w *worker) batchEncodeRun(ctx context.Context) (retErr error) {
 		w.metricMQWorkerBatchDuration.Observe(time.Since(start).Seconds())
 
 		msgs := msgsBuf[:msgCount]
+		// The batch ends at a resolved ts boundary, which must be recorded
+		// after the events before it are added to the encoder group.
+		var resolvedTable *model.TableID
+		if last := msgs[len(msgs)-1]; last.resolvedTable != nil {
+			resolvedTable = last.resolvedTable
+			msgs = msgs[:len(msgs)-1]
+		}
 		// Group messages by its TopicPartitionKey before adding them to the encoder group.
 		groupedMsgs := w.group(msgs)
 		for key, msg := range groupedMsgs {
-			if err := w.encoderGroup.AddEvents(ctx, key, msg...); err != nil {
+			if err := w.addEvents(ctx, key, msg); err != nil {
 				return errors.Trace(err)
 			}
 		}
+		if resolvedTable != nil {
+			w.txnTables.resolve(*resolvedTable)
+		}
 	}
 }
 
 // batch collects a batch of messages from w.msgChan into buffer.
 // It returns the number of messages collected.
-// Note: It will block until at least one message is received.
+// Note: It will block until at least one message is received, and the batch
+// ends at a resolved ts boundary, which is the last message collected.
 func (w *worker) batch(
 	ctx context.Context, buffer []mqEvent, flushInterval time.Duration,
 ) (int, error) {
@@ -214,6 +250,10 @@ func (w *worker) batch(
 			log.Warn("MQ sink flush worker channel closed")
 			return msgCount, nil
 		}
+		if msg.resolvedTable != nil {
+			buffer[msgCount] = msg
+			return msgCount + 1, nil
+		}
 		if msg.rowEvent != nil {
 			w.statistics.ObserveRows(msg.rowEvent.Event)
 			buffer[msgCount] = msg
@@ -234,6 +274,10 @@ func (w *worker) batch(
 				return msgCount, nil
 			}
 
+			if msg.resolvedTable != nil {
+				buffer[msgCount] = msg
+				return msgCount + 1, nil
+			}
 			if msg.rowEvent != nil {
 				w.statistics.ObserveRows(msg.rowEvent.Event)
 				buffer[msgCount] = msg
@@ -269,6 +313,32 @@ func (w *worker) group(
 	return groupedMsgs
 }
 
+// addEvents adds the events to the encoder group. If the messages are sent in
+// transactions, the events are split by table, so that the messages of a table
+// can be held until its resolved ts boundary.
+func (w *worker) addEvents(
+	ctx context.Context,
+	key model.TopicPartitionKey,
+	events []*dmlsink.RowChangeCallbackableEvent,
+) error {
+	if w.txnProducer == nil {
+		return w.encoderGroup.AddEvents(ctx, key, events...)
+	}
+	start := 0
+	for i := 1; i <= len(events); i++ {
+		if i < len(events) &&
+			events[i].Event.PhysicalTableID == events[start].Event.PhysicalTableID {
+			continue
+		}
+		w.txnTables.addEvents(events[start].Event.PhysicalTableID, i-start)
+		if err := w.encoderGroup.AddEvents(ctx, key, events[start:i]...); err != nil {
+			return errors.Trace(err)
+		}
+		start = i
+	}
+	return nil
+}
+
 func (w *worker) sendMessages(ctx context.Context) error {
 	ticker := time.NewTicker(15 * time.Second)
 	metric := codec.EncoderGroupOutputChanSizeGauge.
@@ -279,7 +349,16 @@ func (w *worker) sendMessages(ctx context.Context) error {
 			DeleteLabelValues(w.changeFeedID.Namespace, w.changeFeedID.ID)
 	}()
 
-	var err error
+	var (
+		err        error
+		resolvedCh <-chan struct{}
+		// txnDirty is true if any message is sent in the ongoing transaction.
+		txnDirty     bool
+		lastTxnFlush = time.Now()
+	)
+	if w.txnTables != nil {
+		resolvedCh = w.txnTables.resolvedCh
+	}
 	outCh := w.encoderGroup.Output()
 	for {
 		select {
@@ -287,6 +366,7 @@ func (w *worker) sendMessages(ctx context.Context) error {
 			return errors.Trace(ctx.Err())
 		case <-ticker.C:
 			metric.Set(float64(len(outCh)))
+		case <-resolvedCh:
 		case future, ok := <-outCh:
 			if !ok {
 				log.Warn("MQ sink encoder's output channel closed",
@@ -297,25 +377,69 @@ func (w *worker) sendMessages(ctx context.Context) error {
 			if err = future.Ready(ctx); err != nil {
 				return errors.Trace(err)
 			}
+			if w.txnTables != nil {
+				w.txnTables.hold(future.PhysicalTableID(), future.EventCount(), future.Key, future.Messages)
+				break
+			}
 			for _, message := range future.Messages {
-				start := time.Now()
-				if err = w.statistics.RecordBatchExecution(func() (int, int64, error) {
-					message.SetPartitionKey(future.Key.PartitionKey)
-					if err := w.producer.AsyncSendMessage(
-						ctx,
-						future.Key.Topic,
-						future.Key.Partition,
-						message); err != nil {
-						return 0, 0, err
-					}
-					return message.GetRowsCount(), int64(message.Length()), nil
-				}); err != nil {
+				if err = w.sendMessage(ctx, future.PhysicalTableID(), future.Key, message); err != nil {
+					return err
+				}
+			}
+		}
+		if w.txnTables == nil {
+			continue
+		}
+
+		// Only the messages before the resolved ts boundaries are sent, so the
+		// transaction always contains the complete batches of the tables, and
+		// it can be committed at any time.
+		for _, held := range w.txnTables.release() {
+			for _, message := range held.messages {
+				if err = w.sendMessage(ctx, held.tableID, held.key, message); err != nil {
 					return err
 				}
-				w.metricMQWorkerSendMessageDuration.Observe(time.Since(start).Seconds())
+				txnDirty = true
 			}
 		}
+		if txnDirty && (len(outCh) == 0 || time.Since(lastTxnFlush) >= txnFlushInterval) {
+			if err = w.txnProducer.Flush(ctx); err != nil {
+				return errors.Trace(err)
+			}
+			txnDirty = false
+			lastTxnFlush = time.Now()
+		}
+	}
+}
+
+// sendMessage sends the message to the producer asynchronously.
+func (w *worker) sendMessage(
+	ctx context.Context, tableID model.TableID,
+	key model.TopicPartitionKey, message *common.Message,
+) error {
+	start := time.Now()
+	if err := w.statistics.RecordBatchExecution(func() (int, int64, error) {
+		message.SetPartitionKey(key.PartitionKey)
+		var err error
+		if w.txnProducer != nil {
+			err = w.txnProducer.AsyncSendTableMessage(
+				ctx, tableID, key.Topic, key.Partition, message)
+		} else {
+			err = w.producer.AsyncSendMessage(
+				ctx,
+				key.Topic,
+				key.Partition,
+				message)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		return message.GetRowsCount(), int64(message.Length()), nil
+	}); err != nil {
+		return err
 	}
+	w.metricMQWorkerSendMessageDuration.Observe(time.Since(start).Seconds())
+	return nil
 }
 
 func (w *worker) close() {
@@ -31,7 +31,10 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 )
 
 func newBatchEncodeWorker(ctx context.Context, t *testing.T) (*worker, dmlproducer.DMLProducer) {
@@ -473,6 +476,94 @@ func TestBatchEncode_SendMessages(t *testing.T) {
 	wg.Wait()
 }
 
+func TestBatchEncode_SendMessagesInTransaction(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	sql := `create table test.t(a varchar(255) primary key)`
+	job := helper.DDL2Job(sql)
+	tableInfo := model.WrapTableInfo(0, "test", 1, job.BinlogInfo.TableInfo)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	id := model.DefaultChangeFeedID("test")
+	options := kafka.NewOptions()
+	options.EnableExactlyOnce = true
+	coordinator := kafka.NewMockTxnCoordinator()
+	factory, err := coordinator.NewMockFactory(options, id)
+	require.NoError(t, err)
+	adminClient, err := factory.AdminClient(ctx)
+	require.NoError(t, err)
+	p, err := dmlproducer.NewKafkaTxnDMLProducer(ctx, id, 1, factory,
+		factory.MetricsCollector(util.RoleTester, adminClient), make(chan error, 1))
+	require.NoError(t, err)
+
+	encoderConfig := common.NewConfig(config.ProtocolOpen).WithMaxMessageBytes(200).WithChangefeedID(id)
+	encoderBuilder, err := builder.NewRowEventEncoderBuilder(ctx, encoderConfig)
+	require.NoError(t, err)
+	statistics := metrics.NewStatistics(ctx, id, sink.RowSink)
+	encoderGroup := codec.NewEncoderGroup(config.GetDefaultReplicaConfig().Sink, encoderBuilder, id)
+	worker := newWorker(id, config.ProtocolOpen, p, encoderGroup, statistics)
+	defer worker.close()
+
+	key := model.TopicPartitionKey{
+		Topic:     "test",
+		Partition: 1,
+	}
+	tableStatus := state.TableSinkSinking
+	count := 64
+	acked := []*atomic.Int64{atomic.NewInt64(0), atomic.NewInt64(0)}
+	for i := 0; i < count; i++ {
+		tableAcked := acked[i%2]
+		worker.msgChan.In() <- mqEvent{
+			key: key,
+			rowEvent: &dmlsink.RowChangeCallbackableEvent{
+				Event: &model.RowChangedEvent{
+					CommitTs:        uint64(i),
+					PhysicalTableID: int64(i % 2),
+					TableInfo:       tableInfo,
+					Columns:         model.Columns2ColumnDatas([]*model.Column{{Name: "a", Value: "aa"}}, tableInfo),
+				},
+				Callback: func() {
+					tableAcked.Inc()
+				},
+				SinkState: &tableStatus,
+			},
+		}
+	}
+	resolvedTables := []model.TableID{0, 1}
+	worker.msgChan.In() <- mqEvent{resolvedTable: &resolvedTables[0]}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = worker.run(ctx)
+	}()
+
+	// The events are acknowledged only after the transaction is committed,
+	// and the events of a table are held until its resolved ts boundary.
+	require.Eventually(t, func() bool {
+		return acked[0].Load() == int64(count/2)
+	}, 3*time.Second, 10*time.Millisecond)
+	committed := len(coordinator.CommittedMessages())
+	require.NotZero(t, committed)
+	time.Sleep(3 * txnFlushInterval)
+	require.Zero(t, acked[1].Load())
+	require.Len(t, coordinator.CommittedMessages(), committed)
+
+	worker.msgChan.In() <- mqEvent{resolvedTable: &resolvedTables[1]}
+	require.Eventually(t, func() bool {
+		return acked[1].Load() == int64(count/2)
+	}, 3*time.Second, 10*time.Millisecond)
+	require.Greater(t, len(coordinator.CommittedMessages()), committed)
+	require.Zero(t, coordinator.AbortedTxns())
+
+	cancel()
+	wg.Wait()
+}
+
 func TestBatchEncodeWorker_Abort(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,103 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/simulator/replay"
+	"go.uber.org/zap"
+)
+
+const usage = `Usage: dm-simulator <command> [flags]
+
+Commands:
+  replay    replay the workload in binlog files or relay logs against a target database
+`
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "replay" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg := &replay.Config{}
+	var logLevel, logFile string
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.StringVar(&cfg.Source, "source", "", "binlog file, directory of binlog files or relay log directory to replay")
+	fs.StringVar(&cfg.StartFile, "start-file", "", "binlog file name to start replaying, default is the first binlog file")
+	pos := fs.Uint("start-pos", 0, "binlog position in start-file to start replaying")
+	fs.StringVar(&cfg.Target.Host, "host", "127.0.0.1", "host of the target MySQL/TiDB")
+	fs.IntVar(&cfg.Target.Port, "port", 4000, "port of the target MySQL/TiDB")
+	fs.StringVar(&cfg.Target.User, "user", "root", "user of the target MySQL/TiDB")
+	fs.StringVar(&cfg.Target.Password, "password", "", "password of the target MySQL/TiDB")
+	fs.Float64Var(&cfg.Speed, "speed", 1, "speed multiplier of the original workload, 0 means as fast as possible")
+	fs.IntVar(&cfg.WorkerCount, "worker-count", 16, "number of concurrent connections to the target")
+	fs.DurationVar(&cfg.ReportInterval, "report-interval", 10*time.Second, "interval of logging the progress, 0 disables it")
+	fs.StringVar(&logLevel, "L", "info", "log level: debug, info, warn, error, fatal")
+	fs.StringVar(&logFile, "log-file", "", "log file path")
+	if err := fs.Parse(os.Args[2:]); err != nil {
+		if err == flag.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(2)
+	}
+	cfg.StartPos = uint32(*pos)
+	if err := cfg.Adjust(); err != nil {
+		common.PrintLinesf("invalid config: %s", terror.Message(err))
+		os.Exit(2)
+	}
+
+	if err := log.InitLogger(&log.Config{File: logFile, Level: strings.ToLower(logLevel)}); err != nil {
+		common.PrintLinesf("init logger error %s", terror.Message(err))
+		os.Exit(2)
+	}
+
+	db, err := conn.GetDownstreamDB(&cfg.Target)
+	if err != nil {
+		common.PrintLinesf("connect to target error %s", terror.Message(err))
+		os.Exit(2)
+	}
+	defer db.Close()
+
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		sig := <-sc
+		log.L().Info("got signal to exit", zap.Stringer("signal", sig))
+		cancel()
+	}()
+
+	report, err := replay.NewReplayer(cfg, db).Run(ctx)
+	if report != nil {
+		fmt.Println(report)
+	}
+	if err != nil {
+		common.PrintLinesf("replay error %s", terror.Message(err))
+		db.Close()
+		os.Exit(1)
+	}
+}
@@ -26,11 +26,9 @@ import (
 	"os"
 	"os/signal"
 	"runtime/debug"
-	"sort"
 	"strconv"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -48,12 +46,13 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/logutil"
-	"github.com/pingcap/tiflow/pkg/quotes"
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/avro"
 	"github.com/pingcap/tiflow/pkg/sink/codec/canal"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/consumer"
+	"github.com/pingcap/tiflow/pkg/sink/codec/debezium"
 	"github.com/pingcap/tiflow/pkg/sink/codec/open"
 	"github.com/pingcap/tiflow/pkg/sink/codec/simple"
 	"github.com/pingcap/tiflow/pkg/spanz"
@@ -387,6 +386,11 @@ func newSaramaConfig(o *consumerOption) (*sarama.Config, error) {
 	config.Metadata.Retry.Backoff = 500 * time.Millisecond
 	config.Consumer.Retry.Backoff = 500 * time.Millisecond
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	// Skip the messages of the aborted transactions, which are written by
+	// the transactional producer of TiCDC before it's fenced.
+	if version.IsAtLeast(sarama.V0_11_0_0) {
+		config.Consumer.IsolationLevel = sarama.ReadCommitted
+	}
 
 	if len(o.ca) != 0 {
 		config.Net.TLS.Enable = true
@@ -403,31 +407,18 @@ func newSaramaConfig(o *consumerOption) (*sarama.Config, error) {
 	return config, err
 }
 
-// partitionSinks maintained for each partition, it may sync data for multiple tables.
-type partitionSinks struct {
-	tablesCommitTsMap sync.Map
-	tableSinksMap     sync.Map
-	// resolvedTs record the maximum timestamp of the received event
-	resolvedTs uint64
-}
-
 // Consumer represents a Sarama consumer group consumer
 type Consumer struct {
 	ready chan bool
 
-	ddlList              []*model.DDLEvent
-	ddlListMu            sync.Mutex
-	ddlWithMaxCommitTs   *model.DDLEvent
-	ddlSink              ddlsink.Sink
-	fakeTableIDGenerator *fakeTableIDGenerator
+	// events reassembles the events received from all partitions.
+	events  *consumer.Consumer
+	ddlSink ddlsink.Sink
 
 	// sinkFactory is used to create table sink for each table.
 	sinkFactory *eventsinkfactory.SinkFactory
-	sinks       []*partitionSinks
-	sinksMu     sync.Mutex
-
-	// initialize to 0 by default
-	globalResolvedTs uint64
+	// tableSinks is only accessed by the Run goroutine.
+	tableSinks map[model.TableID]tablesink.TableSink
 
 	eventRouter *dispatcher.EventRouter
 
@@ -448,10 +439,6 @@ func NewConsumer(ctx context.Context, o *consumerOption) (*Consumer, error) {
 	config.GetGlobalServerConfig().TZ = o.timezone
 	o.codecConfig.TimeZone = tz
 
-	c.fakeTableIDGenerator = &fakeTableIDGenerator{
-		tableIDs: make(map[string]int64),
-	}
-
 	if o.codecConfig.LargeMessageHandle.HandleKeyOnly() {
 		db, err := openDB(ctx, o.upstreamTiDBDSN)
 		if err != nil {
@@ -466,14 +453,11 @@ func NewConsumer(ctx context.Context, o *consumerOption) (*Consumer, error) {
 	}
 	c.eventRouter = eventRouter
 
-	c.sinks = make([]*partitionSinks, o.partitionNum)
+	c.events = consumer.New(o.partitionNum)
+	c.tableSinks = make(map[model.TableID]tablesink.TableSink)
 	ctx, cancel := context.WithCancel(ctx)
 	errChan := make(chan error, 1)
 
-	for i := 0; i < int(o.partitionNum); i++ {
-		c.sinks[i] = &partitionSinks{}
-	}
-
 	changefeedID := model.DefaultChangeFeedID("kafka-consumer")
 	f, err := eventsinkfactory.New(ctx, changefeedID, o.downstreamURI, config.GetDefaultReplicaConfig(), errChan, nil)
 	if err != nil {
@@ -514,44 +498,9 @@ func (c *Consumer) Cleanup(sarama.ConsumerGroupSession) error {
 	return nil
 }
 
-type eventsGroup struct {
-	events []*model.RowChangedEvent
-}
-
-func newEventsGroup() *eventsGroup {
-	return &eventsGroup{
-		events: make([]*model.RowChangedEvent, 0),
-	}
-}
-
-func (g *eventsGroup) Append(e *model.RowChangedEvent) {
-	g.events = append(g.events, e)
-}
-
-func (g *eventsGroup) Resolve(resolveTs uint64) []*model.RowChangedEvent {
-	sort.Slice(g.events, func(i, j int) bool {
-		return g.events[i].CommitTs < g.events[j].CommitTs
-	})
-
-	i := sort.Search(len(g.events), func(i int) bool {
-		return g.events[i].CommitTs > resolveTs
-	})
-	result := g.events[:i]
-	g.events = g.events[i:]
-
-	return result
-}
-
 // ConsumeClaim must start a consumer loop of ConsumerGroupClaim's Messages().
 func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	partition := claim.Partition()
-	c.sinksMu.Lock()
-	sink := c.sinks[partition]
-	c.sinksMu.Unlock()
-	if sink == nil {
-		panic("sink should initialized")
-	}
-
 	ctx := context.Background()
 	var (
 		decoder codec.RowEventDecoder
@@ -574,6 +523,10 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 		decoder = avro.NewDecoder(c.option.codecConfig, schemaM, c.option.topic)
 	case config.ProtocolSimple:
 		decoder, err = simple.NewDecoder(ctx, c.option.codecConfig, c.upstreamTiDB)
+	case config.ProtocolDebezium:
+		// the resolved event is only sent when the TiDB extension is enabled,
+		// make sure `enable-tidb-extension=true` is set in the upstream uri.
+		decoder = debezium.NewDecoder(c.option.codecConfig)
 	default:
 		log.Panic("Protocol not supported", zap.Any("Protocol", c.option.protocol))
 	}
@@ -585,7 +538,6 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 		zap.String("topic", claim.Topic()), zap.Int32("partition", partition),
 		zap.Int64("initialOffset", claim.InitialOffset()), zap.Int64("highWaterMarkOffset", claim.HighWaterMarkOffset()))
 
-	eventGroups := make(map[int64]*eventsGroup)
 	for message := range claim.Messages() {
 		if err = decoder.AddKeyValue(message.Key, message.Value); err != nil {
 			log.Error("add key value to the decoder failed", zap.Error(err))
@@ -613,11 +565,7 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 			switch tp {
 			case model.MessageTypeDDL:
 				// for some protocol, DDL would be dispatched to all partitions,
-				// Consider that DDL a, b, c received from partition-0, the latest DDL is c,
-				// if we receive `a` from partition-1, which would be seemed as DDL regression,
-				// then cause the consumer panic, but it was a duplicate one.
-				// so we only handle DDL received from partition-0 should be enough.
-				// but all DDL event messages should be consumed.
+				// the duplicate ones are dropped by the consumer.
 				ddl, err := decoder.NextDDLEvent()
 				if err != nil {
 					log.Panic("decode message value failed",
@@ -628,26 +576,15 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 				if simple, ok := decoder.(*simple.Decoder); ok {
 					cachedEvents := simple.GetCachedEvents()
 					for _, row := range cachedEvents {
-						var partitionID int64
-						if row.TableInfo.IsPartitionTable() {
-							partitionID = row.PhysicalTableID
-						}
-						tableID := c.fakeTableIDGenerator.
-							generateFakeTableID(row.TableInfo.GetSchemaName(), row.TableInfo.GetTableName(), partitionID)
-						row.TableInfo.TableName.TableID = tableID
-
-						group, ok := eventGroups[tableID]
-						if !ok {
-							group = newEventsGroup()
-							eventGroups[tableID] = group
+						if _, err := c.events.AppendRow(partition, row); err != nil {
+							return cerror.Trace(err)
 						}
-						group.Append(row)
 					}
 				}
 
 				// the Query maybe empty if using simple protocol, it's comes from `bootstrap` event.
-				if partition == 0 && ddl.Query != "" {
-					c.appendDDL(ddl)
+				if ddl.Query != "" {
+					c.events.AppendDDL(ddl)
 				}
 				// todo: mark the offset after the DDL is fully synced to the downstream mysql.
 				session.MarkMessage(message, "")
@@ -676,34 +613,9 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 					)
 				}
 
-				globalResolvedTs := atomic.LoadUint64(&c.globalResolvedTs)
-				partitionResolvedTs := atomic.LoadUint64(&sink.resolvedTs)
-				if row.CommitTs <= globalResolvedTs || row.CommitTs <= partitionResolvedTs {
-					log.Warn("RowChangedEvent fallback row, ignore it",
-						zap.Uint64("commitTs", row.CommitTs),
-						zap.Uint64("globalResolvedTs", globalResolvedTs),
-						zap.Uint64("partitionResolvedTs", partitionResolvedTs),
-						zap.Int32("partition", partition),
-						zap.Any("row", row))
-					// todo: mark the offset after the DDL is fully synced to the downstream mysql.
-					session.MarkMessage(message, "")
-					continue
-				}
-				var partitionID int64
-				if row.TableInfo.IsPartitionTable() {
-					partitionID = row.PhysicalTableID
-				}
-				tableID := c.fakeTableIDGenerator.
-					generateFakeTableID(row.TableInfo.GetSchemaName(), row.TableInfo.GetTableName(), partitionID)
-				row.TableInfo.TableName.TableID = tableID
-
-				group, ok := eventGroups[tableID]
-				if !ok {
-					group = newEventsGroup()
-					eventGroups[tableID] = group
+				if _, err := c.events.AppendRow(partition, row); err != nil {
+					return cerror.Trace(err)
 				}
-
-				group.Append(row)
 				// todo: mark the offset after the DDL is fully synced to the downstream mysql.
 				session.MarkMessage(message, "")
 			case model.MessageTypeResolved:
@@ -714,39 +626,9 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 						zap.Error(err))
 				}
 
-				globalResolvedTs := atomic.LoadUint64(&c.globalResolvedTs)
-				partitionResolvedTs := atomic.LoadUint64(&sink.resolvedTs)
-				if ts < globalResolvedTs || ts < partitionResolvedTs {
-					log.Warn("partition resolved ts fallback, skip it",
-						zap.Uint64("ts", ts),
-						zap.Uint64("partitionResolvedTs", partitionResolvedTs),
-						zap.Uint64("globalResolvedTs", globalResolvedTs),
-						zap.Int32("partition", partition))
-					session.MarkMessage(message, "")
-					continue
-				}
-
-				for tableID, group := range eventGroups {
-					events := group.Resolve(ts)
-					if len(events) == 0 {
-						continue
-					}
-					if _, ok := sink.tableSinksMap.Load(tableID); !ok {
-						sink.tableSinksMap.Store(tableID, c.sinkFactory.CreateTableSinkForConsumer(
-							model.DefaultChangeFeedID("kafka-consumer"),
-							spanz.TableIDToComparableSpan(tableID),
-							events[0].CommitTs,
-						))
-					}
-					s, _ := sink.tableSinksMap.Load(tableID)
-					s.(tablesink.TableSink).AppendRowChangedEvents(events...)
-					commitTs := events[len(events)-1].CommitTs
-					lastCommitTs, ok := sink.tablesCommitTsMap.Load(tableID)
-					if !ok || lastCommitTs.(uint64) < commitTs {
-						sink.tablesCommitTsMap.Store(tableID, commitTs)
-					}
+				if _, err := c.events.UpdateResolvedTs(partition, ts); err != nil {
+					return cerror.Trace(err)
 				}
-				atomic.StoreUint64(&sink.resolvedTs, ts)
 				// todo: mark the offset after the DDL is fully synced to the downstream mysql.
 				session.MarkMessage(message, "")
 
@@ -763,77 +645,6 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 	return nil
 }
 
-// append DDL wait to be handled, only consider the constraint among DDLs.
-// for DDL a / b received in the order, a.CommitTs < b.CommitTs should be true.
-func (c *Consumer) appendDDL(ddl *model.DDLEvent) {
-	c.ddlListMu.Lock()
-	defer c.ddlListMu.Unlock()
-	// DDL CommitTs fallback, just crash it to indicate the bug.
-	if c.ddlWithMaxCommitTs != nil && ddl.CommitTs < c.ddlWithMaxCommitTs.CommitTs {
-		log.Warn("DDL CommitTs < maxCommitTsDDL.CommitTs",
-			zap.Uint64("commitTs", ddl.CommitTs),
-			zap.Uint64("maxCommitTs", c.ddlWithMaxCommitTs.CommitTs),
-			zap.String("DDL", ddl.Query))
-		return
-	}
-
-	// A rename tables DDL job contains multiple DDL events with same CommitTs.
-	// So to tell if a DDL is redundant or not, we must check the equivalence of
-	// the current DDL and the DDL with max CommitTs.
-	if ddl == c.ddlWithMaxCommitTs {
-		log.Info("ignore redundant DDL, the DDL is equal to ddlWithMaxCommitTs",
-			zap.Uint64("commitTs", ddl.CommitTs), zap.String("DDL", ddl.Query))
-		return
-	}
-
-	c.ddlList = append(c.ddlList, ddl)
-	log.Info("DDL event received", zap.Uint64("commitTs", ddl.CommitTs), zap.String("DDL", ddl.Query))
-	c.ddlWithMaxCommitTs = ddl
-}
-
-func (c *Consumer) getFrontDDL() *model.DDLEvent {
-	c.ddlListMu.Lock()
-	defer c.ddlListMu.Unlock()
-	if len(c.ddlList) > 0 {
-		return c.ddlList[0]
-	}
-	return nil
-}
-
-func (c *Consumer) popDDL() *model.DDLEvent {
-	c.ddlListMu.Lock()
-	defer c.ddlListMu.Unlock()
-	if len(c.ddlList) > 0 {
-		ddl := c.ddlList[0]
-		c.ddlList = c.ddlList[1:]
-		return ddl
-	}
-	return nil
-}
-
-func (c *Consumer) forEachSink(fn func(sink *partitionSinks) error) error {
-	c.sinksMu.Lock()
-	defer c.sinksMu.Unlock()
-	for _, sink := range c.sinks {
-		if err := fn(sink); err != nil {
-			return cerror.Trace(err)
-		}
-	}
-	return nil
-}
-
-func (c *Consumer) getMinPartitionResolvedTs() (result uint64, err error) {
-	result = uint64(math.MaxUint64)
-	err = c.forEachSink(func(sink *partitionSinks) error {
-		a := atomic.LoadUint64(&sink.resolvedTs)
-		if a < result {
-			result = a
-		}
-		return nil
-	})
-	return result, err
-}
-
 // Run the Consumer
 func (c *Consumer) Run(ctx context.Context) error {
 	ticker := time.NewTicker(100 * time.Millisecond)
@@ -845,55 +656,47 @@ func (c *Consumer) Run(ctx context.Context) error {
 		case <-ticker.C:
 		}
 
-		minPartitionResolvedTs, err := c.getMinPartitionResolvedTs()
-		if err != nil {
-			return cerror.Trace(err)
-		}
-
-		// handle DDL
-		todoDDL := c.getFrontDDL()
-		if todoDDL != nil && todoDDL.CommitTs <= minPartitionResolvedTs {
-			// flush DMLs
-			if err := c.forEachSink(func(sink *partitionSinks) error {
-				return syncFlushRowChangedEvents(ctx, sink, todoDDL.CommitTs)
-			}); err != nil {
-				return cerror.Trace(err)
+		for {
+			events := c.events.Next()
+			if events == nil {
+				break
 			}
-
-			// DDL can be executed, do it first.
-			if err := c.ddlSink.WriteDDLEvent(ctx, todoDDL); err != nil {
+			if err := c.writeEvents(ctx, events); err != nil {
 				return cerror.Trace(err)
 			}
-			c.popDDL()
-
-			if todoDDL.CommitTs < minPartitionResolvedTs {
-				log.Info("update minPartitionResolvedTs by DDL",
-					zap.Uint64("minPartitionResolvedTs", minPartitionResolvedTs),
-					zap.String("DDL", todoDDL.Query))
-			}
-			minPartitionResolvedTs = todoDDL.CommitTs
 		}
+	}
+}
 
-		// update global resolved ts
-		if c.globalResolvedTs > minPartitionResolvedTs {
-			log.Panic("global ResolvedTs fallback",
-				zap.Uint64("globalResolvedTs", c.globalResolvedTs),
-				zap.Uint64("minPartitionResolvedTs", minPartitionResolvedTs))
+// writeEvents writes the resolved events to the downstream, the DMLs are
+// flushed before the DDL is executed.
+func (c *Consumer) writeEvents(ctx context.Context, events *consumer.ResolvedEvents) error {
+	for _, txn := range events.Transactions {
+		tableSink, ok := c.tableSinks[txn.TableID]
+		if !ok {
+			tableSink = c.sinkFactory.CreateTableSinkForConsumer(
+				model.DefaultChangeFeedID("kafka-consumer"),
+				spanz.TableIDToComparableSpan(txn.TableID),
+				txn.CommitTs,
+			)
+			c.tableSinks[txn.TableID] = tableSink
 		}
+		tableSink.AppendRowChangedEvents(txn.Rows...)
+	}
 
-		if c.globalResolvedTs < minPartitionResolvedTs {
-			c.globalResolvedTs = minPartitionResolvedTs
-		}
+	if err := c.syncFlushRowChangedEvents(ctx, events.ResolvedTs); err != nil {
+		return cerror.Trace(err)
+	}
 
-		if err := c.forEachSink(func(sink *partitionSinks) error {
-			return syncFlushRowChangedEvents(ctx, sink, c.globalResolvedTs)
-		}); err != nil {
+	if events.DDL != nil {
+		if err := c.ddlSink.WriteDDLEvent(ctx, events.DDL); err != nil {
 			return cerror.Trace(err)
 		}
 	}
+	return nil
 }
 
-func syncFlushRowChangedEvents(ctx context.Context, sink *partitionSinks, resolvedTs uint64) error {
+func (c *Consumer) syncFlushRowChangedEvents(ctx context.Context, resolved model.ResolvedTs) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -901,50 +704,20 @@ func syncFlushRowChangedEvents(ctx context.Context, sink *partitionSinks, resolv
 		default:
 		}
 		flushedResolvedTs := true
-		sink.tablesCommitTsMap.Range(func(key, value interface{}) bool {
-			tableID := key.(int64)
-			resolvedTs := model.NewResolvedTs(resolvedTs)
-			tableSink, ok := sink.tableSinksMap.Load(tableID)
-			if !ok {
-				log.Panic("Table sink not found", zap.Int64("tableID", tableID))
-			}
-			if err := tableSink.(tablesink.TableSink).UpdateResolvedTs(resolvedTs); err != nil {
-				log.Error("Failed to update resolved ts", zap.Error(err))
-				return false
+		for _, tableSink := range c.tableSinks {
+			if err := tableSink.UpdateResolvedTs(resolved); err != nil {
+				return cerror.Trace(err)
 			}
-			checkpoint := tableSink.(tablesink.TableSink).GetCheckpointTs()
-			if !checkpoint.EqualOrGreater(resolvedTs) {
+			if !tableSink.GetCheckpointTs().EqualOrGreater(resolved) {
 				flushedResolvedTs = false
 			}
-			return true
-		})
+		}
 		if flushedResolvedTs {
 			return nil
 		}
 	}
 }
 
-type fakeTableIDGenerator struct {
-	tableIDs       map[string]int64
-	currentTableID int64
-	mu             sync.Mutex
-}
-
-func (g *fakeTableIDGenerator) generateFakeTableID(schema, table string, partition int64) int64 {
-	g.mu.Lock()
-	defer g.mu.Unlock()
-	key := quotes.QuoteSchema(schema, table)
-	if partition != 0 {
-		key = fmt.Sprintf("%s.`%d`", key, partition)
-	}
-	if tableID, ok := g.tableIDs[key]; ok {
-		return tableID
-	}
-	g.currentTableID++
-	g.tableIDs[key] = g.currentTableID
-	return g.currentTableID
-}
-
 func openDB(ctx context.Context, dsn string) (*sql.DB, error) {
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
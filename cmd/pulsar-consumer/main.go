@@ -16,15 +16,12 @@ package main
 import (
 	"context"
 	"fmt"
-	"math"
 	"net/url"
 	"os"
 	"os/signal"
-	"sort"
 	"strconv"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -40,11 +37,11 @@ import (
 	sutil "github.com/pingcap/tiflow/cdc/sink/util"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/logutil"
-	"github.com/pingcap/tiflow/pkg/quotes"
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/canal"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/consumer"
 	tpulsar "github.com/pingcap/tiflow/pkg/sink/pulsar"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -313,30 +310,16 @@ func NewPulsarConsumer(option *ConsumerOption) (pulsar.Consumer, pulsar.Client)
 	return consumer, client
 }
 
-// partitionSinks maintained for each partition, it may sync data for multiple tables.
-type partitionSinks struct {
-	tablesCommitTsMap sync.Map
-	tableSinksMap     sync.Map
-	// resolvedTs record the maximum timestamp of the received event
-	resolvedTs uint64
-}
-
 // Consumer represents a local pulsar consumer
 type Consumer struct {
-	eventGroups          map[int64]*eventsGroup
-	ddlList              []*model.DDLEvent
-	ddlListMu            sync.Mutex
-	lastReceivedDDL      *model.DDLEvent
-	ddlSink              ddlsink.Sink
-	fakeTableIDGenerator *fakeTableIDGenerator
+	// events reassembles the events received from all partitions.
+	events  *consumer.Consumer
+	ddlSink ddlsink.Sink
 
 	// sinkFactory is used to create table sink for each table.
 	sinkFactory *eventsinkfactory.SinkFactory
-	sinks       []*partitionSinks
-	sinksMu     sync.Mutex
-
-	// initialize to 0 by default
-	globalResolvedTs uint64
+	// tableSinks is only accessed by the Run goroutine.
+	tableSinks map[model.TableID]tablesink.TableSink
 
 	tz *time.Location
 
@@ -359,22 +342,18 @@ func NewConsumer(ctx context.Context, o *ConsumerOption) (*Consumer, error) {
 	config.GetGlobalServerConfig().TZ = o.timezone
 	c.tz = tz
 
-	c.fakeTableIDGenerator = &fakeTableIDGenerator{
-		tableIDs: make(map[string]int64),
-	}
-
 	c.codecConfig = common.NewConfig(o.protocol)
 	c.codecConfig.EnableTiDBExtension = o.enableTiDBExtension
 	if c.codecConfig.Protocol == config.ProtocolAvro {
 		c.codecConfig.AvroEnableWatermark = true
 	}
 
-	c.sinks = make([]*partitionSinks, o.partitionNum)
+	// The messages of all partitions are received by one consumer,
+	// and the resolved ts is tracked as if there is only one partition.
+	c.events = consumer.New(1)
+	c.tableSinks = make(map[model.TableID]tablesink.TableSink)
 	ctx, cancel := context.WithCancel(ctx)
 	errChan := make(chan error, 1)
-	for i := 0; i < o.partitionNum; i++ {
-		c.sinks[i] = &partitionSinks{}
-	}
 
 	changefeedID := model.DefaultChangeFeedID("pulsar-consumer")
 	f, err := eventsinkfactory.New(ctx, changefeedID, o.downstreamURI, config.GetDefaultReplicaConfig(), errChan, nil)
@@ -400,47 +379,11 @@ func NewConsumer(ctx context.Context, o *ConsumerOption) (*Consumer, error) {
 		return nil, errors.Trace(err)
 	}
 	c.ddlSink = ddlSink
-	c.eventGroups = make(map[int64]*eventsGroup)
 	return c, nil
 }
 
-type eventsGroup struct {
-	events []*model.RowChangedEvent
-}
-
-func newEventsGroup() *eventsGroup {
-	return &eventsGroup{
-		events: make([]*model.RowChangedEvent, 0),
-	}
-}
-
-func (g *eventsGroup) Append(e *model.RowChangedEvent) {
-	g.events = append(g.events, e)
-}
-
-func (g *eventsGroup) Resolve(resolveTs uint64) []*model.RowChangedEvent {
-	sort.Slice(g.events, func(i, j int) bool {
-		return g.events[i].CommitTs < g.events[j].CommitTs
-	})
-
-	i := sort.Search(len(g.events), func(i int) bool {
-		return g.events[i].CommitTs > resolveTs
-	})
-	result := g.events[:i]
-	g.events = g.events[i:]
-
-	return result
-}
-
 // HandleMsg handles the message received from the pulsar consumer
 func (c *Consumer) HandleMsg(msg pulsar.Message) error {
-	c.sinksMu.Lock()
-	sink := c.sinks[0]
-	c.sinksMu.Unlock()
-	if sink == nil {
-		panic("sink should initialized")
-	}
-
 	ctx := context.Background()
 	var (
 		decoder codec.RowEventDecoder
@@ -479,19 +422,14 @@ func (c *Consumer) HandleMsg(msg pulsar.Message) error {
 		switch tp {
 		case model.MessageTypeDDL:
 			// for some protocol, DDL would be dispatched to all partitions,
-			// Consider that DDL a, b, c received from partition-0, the latest DDL is c,
-			// if we receive `a` from partition-1, which would be seemed as DDL regression,
-			// then cause the consumer panic, but it was a duplicate one.
-			// so we only handle DDL received from partition-0 should be enough.
-			// but all DDL event messages should be consumed.
+			// the duplicate ones are dropped by the consumer.
 			ddl, err := decoder.NextDDLEvent()
 			if err != nil {
 				log.Panic("decode message value failed",
 					zap.ByteString("value", msg.Payload()),
 					zap.Error(err))
 			}
-			log.Info("DDL event received", zap.Any("DDL", ddl))
-			c.appendDDL(ddl)
+			c.events.AppendDDL(ddl)
 		case model.MessageTypeRow:
 			row, err := decoder.NextRowChangedEvent()
 			if err != nil {
@@ -499,33 +437,9 @@ func (c *Consumer) HandleMsg(msg pulsar.Message) error {
 					zap.ByteString("value", msg.Payload()),
 					zap.Error(err))
 			}
-			globalResolvedTs := atomic.LoadUint64(&c.globalResolvedTs)
-			partitionResolvedTs := atomic.LoadUint64(&sink.resolvedTs)
-			if row.CommitTs <= globalResolvedTs || row.CommitTs <= partitionResolvedTs {
-				log.Warn("RowChangedEvent fallback row, ignore it",
-					zap.Uint64("commitTs", row.CommitTs),
-					zap.Uint64("globalResolvedTs", globalResolvedTs),
-					zap.Uint64("partitionResolvedTs", partitionResolvedTs),
-					zap.Int32("partition", msg.ID().PartitionIdx()),
-					zap.Any("row", row))
-				// todo: mark the offset after the DDL is fully synced to the downstream mysql.
-				continue
-			}
-			var partitionID int64
-			if row.TableInfo.IsPartitionTable() {
-				partitionID = row.PhysicalTableID
-			}
-			// use schema, table and tableID to identify a table
-			tableID := c.fakeTableIDGenerator.
-				generateFakeTableID(row.TableInfo.GetSchemaName(), row.TableInfo.GetTableName(), partitionID)
-			row.TableInfo.TableName.TableID = tableID
-
-			group, ok := c.eventGroups[tableID]
-			if !ok {
-				group = newEventsGroup()
-				c.eventGroups[tableID] = group
+			if _, err := c.events.AppendRow(0, row); err != nil {
+				return errors.Trace(err)
 			}
-			group.Append(row)
 		case model.MessageTypeResolved:
 			ts, err := decoder.NextResolvedEvent()
 			if err != nil {
@@ -534,120 +448,15 @@ func (c *Consumer) HandleMsg(msg pulsar.Message) error {
 					zap.Error(err))
 			}
 
-			globalResolvedTs := atomic.LoadUint64(&c.globalResolvedTs)
-			partitionResolvedTs := atomic.LoadUint64(&sink.resolvedTs)
-			if ts < globalResolvedTs || ts < partitionResolvedTs {
-				log.Warn("partition resolved ts fallback, skip it",
-					zap.Uint64("ts", ts),
-					zap.Uint64("partitionResolvedTs", partitionResolvedTs),
-					zap.Uint64("globalResolvedTs", globalResolvedTs),
-					zap.Int32("partition", msg.ID().PartitionIdx()))
-				continue
-			}
-
-			for tableID, group := range c.eventGroups {
-				events := group.Resolve(ts)
-				if len(events) == 0 {
-					continue
-				}
-				if _, ok := sink.tableSinksMap.Load(tableID); !ok {
-					log.Info("create table sink for consumer", zap.Any("tableID", tableID))
-					tableSink := c.sinkFactory.CreateTableSinkForConsumer(
-						model.DefaultChangeFeedID("pulsar-consumer"),
-						spanz.TableIDToComparableSpan(tableID),
-						events[0].CommitTs)
-
-					log.Info("table sink created", zap.Any("tableID", tableID),
-						zap.Any("tableSink", tableSink.GetCheckpointTs()))
-
-					sink.tableSinksMap.Store(tableID, tableSink)
-				}
-				s, _ := sink.tableSinksMap.Load(tableID)
-				s.(tablesink.TableSink).AppendRowChangedEvents(events...)
-				commitTs := events[len(events)-1].CommitTs
-				lastCommitTs, ok := sink.tablesCommitTsMap.Load(tableID)
-				if !ok || lastCommitTs.(uint64) < commitTs {
-					sink.tablesCommitTsMap.Store(tableID, commitTs)
-				}
+			if _, err := c.events.UpdateResolvedTs(0, ts); err != nil {
+				return errors.Trace(err)
 			}
-			atomic.StoreUint64(&sink.resolvedTs, ts)
 		}
 
 	}
 	return nil
 }
 
-// append DDL wait to be handled, only consider the constraint among DDLs.
-// for DDL a / b received in the order, a.CommitTs < b.CommitTs should be true.
-func (c *Consumer) appendDDL(ddl *model.DDLEvent) {
-	c.ddlListMu.Lock()
-	defer c.ddlListMu.Unlock()
-	// DDL CommitTs fallback, just crash it to indicate the bug.
-	if c.lastReceivedDDL != nil && ddl.CommitTs < c.lastReceivedDDL.CommitTs {
-		log.Panic("DDL CommitTs < lastReceivedDDL.CommitTs",
-			zap.Uint64("commitTs", ddl.CommitTs),
-			zap.Uint64("lastReceivedDDLCommitTs", c.lastReceivedDDL.CommitTs),
-			zap.Any("DDL", ddl))
-	}
-
-	// A rename tables DDL job contains multiple DDL events with same CommitTs.
-	// So to tell if a DDL is redundant or not, we must check the equivalence of
-	// the current DDL and the DDL with max CommitTs.
-	if ddl == c.lastReceivedDDL {
-		log.Info("ignore redundant DDL, the DDL is equal to ddlWithMaxCommitTs",
-			zap.Any("DDL", ddl))
-		return
-	}
-
-	c.ddlList = append(c.ddlList, ddl)
-	log.Info("DDL event received", zap.Any("DDL", ddl))
-	c.lastReceivedDDL = ddl
-}
-
-func (c *Consumer) getFrontDDL() *model.DDLEvent {
-	c.ddlListMu.Lock()
-	defer c.ddlListMu.Unlock()
-	if len(c.ddlList) > 0 {
-		return c.ddlList[0]
-	}
-	return nil
-}
-
-func (c *Consumer) popDDL() *model.DDLEvent {
-	c.ddlListMu.Lock()
-	defer c.ddlListMu.Unlock()
-	if len(c.ddlList) > 0 {
-		ddl := c.ddlList[0]
-		c.ddlList = c.ddlList[1:]
-		return ddl
-	}
-	return nil
-}
-
-func (c *Consumer) forEachSink(fn func(sink *partitionSinks) error) error {
-	c.sinksMu.Lock()
-	defer c.sinksMu.Unlock()
-	for _, sink := range c.sinks {
-		if err := fn(sink); err != nil {
-			return errors.Trace(err)
-		}
-	}
-	return nil
-}
-
-// getMinResolvedTs returns the minimum resolvedTs of all the partitionSinks
-func (c *Consumer) getMinResolvedTs() (result uint64, err error) {
-	result = uint64(math.MaxUint64)
-	err = c.forEachSink(func(sink *partitionSinks) error {
-		a := atomic.LoadUint64(&sink.resolvedTs)
-		if a < result {
-			result = a
-		}
-		return nil
-	})
-	return result, err
-}
-
 // Run the Consumer
 func (c *Consumer) Run(ctx context.Context) error {
 	ticker := time.NewTicker(200 * time.Millisecond)
@@ -657,60 +466,55 @@ func (c *Consumer) Run(ctx context.Context) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-ticker.C:
-			// 1. Get the minimum resolvedTs of all the partitionSinks
-			minResolvedTs, err := c.getMinResolvedTs()
-			if err != nil {
-				return errors.Trace(err)
-			}
-
-			// 2. check if there is a DDL event that can be executed
-			//   if there is, execute it and update the minResolvedTs
-			nextDDL := c.getFrontDDL()
-			if nextDDL != nil {
-				log.Info("get nextDDL", zap.Any("DDL", nextDDL))
-			}
-			if nextDDL != nil && minResolvedTs >= nextDDL.CommitTs {
-				// flush DMLs that commitTs <= todoDDL.CommitTs
-				if err := c.forEachSink(func(sink *partitionSinks) error {
-					return flushRowChangedEvents(ctx, sink, nextDDL.CommitTs)
-				}); err != nil {
-					return errors.Trace(err)
+			for {
+				events := c.events.Next()
+				if events == nil {
+					break
 				}
-				log.Info("begin to execute DDL", zap.Any("DDL", nextDDL))
-				// all DMLs with commitTs <= todoDDL.CommitTs have been flushed to downstream,
-				// so we can execute the DDL now.
-				if err := c.ddlSink.WriteDDLEvent(ctx, nextDDL); err != nil {
+				if err := c.writeEvents(ctx, events); err != nil {
 					return errors.Trace(err)
 				}
-				ddl := c.popDDL()
-				log.Info("DDL executed", zap.Any("DDL", ddl))
-				minResolvedTs = ddl.CommitTs
 			}
+		}
+	}
+}
 
-			// 3. Update global resolved ts
-			if c.globalResolvedTs > minResolvedTs {
-				log.Panic("global ResolvedTs fallback",
-					zap.Uint64("globalResolvedTs", c.globalResolvedTs),
-					zap.Uint64("minPartitionResolvedTs", minResolvedTs))
-			}
+// writeEvents writes the resolved events to the downstream, all the DMLs
+// that commitTs <= DDL.CommitTs are flushed before the DDL is executed.
+func (c *Consumer) writeEvents(ctx context.Context, events *consumer.ResolvedEvents) error {
+	for _, txn := range events.Transactions {
+		tableSink, ok := c.tableSinks[txn.TableID]
+		if !ok {
+			log.Info("create table sink for consumer", zap.Any("tableID", txn.TableID))
+			tableSink = c.sinkFactory.CreateTableSinkForConsumer(
+				model.DefaultChangeFeedID("pulsar-consumer"),
+				spanz.TableIDToComparableSpan(txn.TableID),
+				txn.CommitTs)
+
+			log.Info("table sink created", zap.Any("tableID", txn.TableID),
+				zap.Any("tableSink", tableSink.GetCheckpointTs()))
+			c.tableSinks[txn.TableID] = tableSink
+		}
+		tableSink.AppendRowChangedEvents(txn.Rows...)
+	}
 
-			if c.globalResolvedTs < minResolvedTs {
-				c.globalResolvedTs = minResolvedTs
-			}
+	if err := c.flushRowChangedEvents(ctx, events.ResolvedTs); err != nil {
+		return errors.Trace(err)
+	}
 
-			// 4. flush all the DMLs that commitTs <= globalResolvedTs
-			if err := c.forEachSink(func(sink *partitionSinks) error {
-				return flushRowChangedEvents(ctx, sink, c.globalResolvedTs)
-			}); err != nil {
-				return errors.Trace(err)
-			}
+	if events.DDL != nil {
+		log.Info("begin to execute DDL", zap.Any("DDL", events.DDL))
+		if err := c.ddlSink.WriteDDLEvent(ctx, events.DDL); err != nil {
+			return errors.Trace(err)
 		}
+		log.Info("DDL executed", zap.Any("DDL", events.DDL))
 	}
+	return nil
 }
 
 // flushRowChangedEvents flushes all the DMLs that commitTs <= resolvedTs
 // Note: This function is synchronous, it will block until all the DMLs are flushed.
-func flushRowChangedEvents(ctx context.Context, sink *partitionSinks, resolvedTs uint64) error {
+func (c *Consumer) flushRowChangedEvents(ctx context.Context, resolved model.ResolvedTs) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -718,45 +522,16 @@ func flushRowChangedEvents(ctx context.Context, sink *partitionSinks, resolvedTs
 		default:
 		}
 		flushedResolvedTs := true
-		sink.tablesCommitTsMap.Range(func(key, value interface{}) bool {
-			tableID := key.(int64)
-			resolvedTs := model.NewResolvedTs(resolvedTs)
-			tableSink, ok := sink.tableSinksMap.Load(tableID)
-			if !ok {
-				log.Panic("Table sink not found", zap.Int64("tableID", tableID))
-			}
-			if err := tableSink.(tablesink.TableSink).UpdateResolvedTs(resolvedTs); err != nil {
-				log.Error("Failed to update resolved ts", zap.Error(err))
-				return false
+		for _, tableSink := range c.tableSinks {
+			if err := tableSink.UpdateResolvedTs(resolved); err != nil {
+				return errors.Trace(err)
 			}
-			if !tableSink.(tablesink.TableSink).GetCheckpointTs().EqualOrGreater(resolvedTs) {
+			if !tableSink.GetCheckpointTs().EqualOrGreater(resolved) {
 				flushedResolvedTs = false
 			}
-			return true
-		})
+		}
 		if flushedResolvedTs {
 			return nil
 		}
 	}
 }
-
-type fakeTableIDGenerator struct {
-	tableIDs       map[string]int64
-	currentTableID int64
-	mu             sync.Mutex
-}
-
-func (g *fakeTableIDGenerator) generateFakeTableID(schema, table string, partition int64) int64 {
-	g.mu.Lock()
-	defer g.mu.Unlock()
-	key := quotes.QuoteSchema(schema, table)
-	if partition != 0 {
-		key = fmt.Sprintf("%s.`%d`", key, partition)
-	}
-	if tableID, ok := g.tableIDs[key]; ok {
-		return tableID
-	}
-	g.currentTableID++
-	g.tableIDs[key] = g.currentTableID
-	return g.currentTableID
-}
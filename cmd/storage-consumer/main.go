@@ -24,7 +24,6 @@ import (
 	"os/signal"
 	"sort"
 	"strings"
-	"sync"
 	"syscall"
 	"time"
 
@@ -40,12 +39,12 @@ import (
 	"github.com/pingcap/tiflow/pkg/cmd/util"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/logutil"
-	"github.com/pingcap/tiflow/pkg/quotes"
 	psink "github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/canal"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	codecconsumer "github.com/pingcap/tiflow/pkg/sink/codec/consumer"
 	"github.com/pingcap/tiflow/pkg/sink/codec/csv"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	putil "github.com/pingcap/tiflow/pkg/util"
@@ -114,6 +113,14 @@ type fileIndexRange struct {
 	end   uint64
 }
 
+// tableConsumer consumes the events of a table or a partition of the table.
+type tableConsumer struct {
+	// events reassembles the events of the table, the files
+	// of the table are consumed as one partition in order.
+	events *codecconsumer.Consumer
+	sink   tablesink.TableSink
+}
+
 type consumer struct {
 	sinkFactory     *dmlfactory.SinkFactory
 	ddlSink         ddlsink.Sink
@@ -123,13 +130,11 @@ type consumer struct {
 	fileExtension   string
 	// tableDMLIdxMap maintains a map of <dmlPathKey, max file index>
 	tableDMLIdxMap map[cloudstorage.DmlPathKey]uint64
-	// tableTsMap maintains a map of <TableID, max commit ts>
-	tableTsMap map[model.TableID]model.ResolvedTs
 	// tableDefMap maintains a map of <`schema`.`table`, tableDef slice sorted by TableVersion>
 	tableDefMap map[string]map[uint64]*cloudstorage.TableDefinition
-	// tableSinkMap maintains a map of <TableID, TableSink>
-	tableSinkMap     map[model.TableID]tablesink.TableSink
-	tableIDGenerator *fakeTableIDGenerator
+	// tables maintains a map of <TableID, tableConsumer>
+	tables           map[model.TableID]*tableConsumer
+	tableIDGenerator *codecconsumer.TableIDGenerator
 	errCh            chan error
 }
 
@@ -208,20 +213,17 @@ func newConsumer(ctx context.Context) (*consumer, error) {
 	}
 
 	return &consumer{
-		sinkFactory:     sinkFactory,
-		ddlSink:         ddlSink,
-		replicationCfg:  replicaConfig,
-		codecCfg:        codecConfig,
-		externalStorage: storage,
-		fileExtension:   extension,
-		errCh:           errCh,
-		tableDMLIdxMap:  make(map[cloudstorage.DmlPathKey]uint64),
-		tableTsMap:      make(map[model.TableID]model.ResolvedTs),
-		tableDefMap:     make(map[string]map[uint64]*cloudstorage.TableDefinition),
-		tableSinkMap:    make(map[model.TableID]tablesink.TableSink),
-		tableIDGenerator: &fakeTableIDGenerator{
-			tableIDs: make(map[string]int64),
-		},
+		sinkFactory:      sinkFactory,
+		ddlSink:          ddlSink,
+		replicationCfg:   replicaConfig,
+		codecCfg:         codecConfig,
+		externalStorage:  storage,
+		fileExtension:    extension,
+		errCh:            errCh,
+		tableDMLIdxMap:   make(map[cloudstorage.DmlPathKey]uint64),
+		tableDefMap:      make(map[string]map[uint64]*cloudstorage.TableDefinition),
+		tables:           make(map[model.TableID]*tableConsumer),
+		tableIDGenerator: codecconsumer.NewTableIDGenerator(),
 	}, nil
 }
 
@@ -287,6 +289,17 @@ func (c *consumer) getNewFiles(
 	return tableDMLMap, err
 }
 
+func (c *consumer) getTableConsumer(tableID model.TableID) *tableConsumer {
+	table, ok := c.tables[tableID]
+	if !ok {
+		table = &tableConsumer{
+			events: codecconsumer.NewWithTableIDGenerator(1, c.tableIDGenerator),
+		}
+		c.tables[tableID] = table
+	}
+	return table
+}
+
 // emitDMLEvents decodes RowChangedEvents from file content and emit them.
 func (c *consumer) emitDMLEvents(
 	ctx context.Context, tableID int64,
@@ -324,8 +337,10 @@ func (c *consumer) emitDMLEvents(
 		}
 	}
 
+	table := c.getTableConsumer(tableID)
 	cnt := 0
 	filteredCnt := 0
+	var maxCommitTs uint64
 	for {
 		tp, hasNext, err := decoder.HasNext()
 		if err != nil {
@@ -343,33 +358,17 @@ func (c *consumer) emitDMLEvents(
 				log.Error("failed to get next row changed event", zap.Error(err))
 				return errors.Trace(err)
 			}
-
-			if _, ok := c.tableSinkMap[tableID]; !ok {
-				c.tableSinkMap[tableID] = c.sinkFactory.CreateTableSinkForConsumer(
-					model.DefaultChangeFeedID(defaultChangefeedName),
-					spanz.TableIDToComparableSpan(tableID),
-					row.CommitTs)
+			row.PhysicalTableID = tableID
+			ok, err := table.events.AppendRow(0, row)
+			if err != nil {
+				return errors.Trace(err)
 			}
-
-			_, ok := c.tableTsMap[tableID]
-			if !ok || row.CommitTs > c.tableTsMap[tableID].Ts {
-				c.tableTsMap[tableID] = model.ResolvedTs{
-					Mode:    model.BatchResolvedMode,
-					Ts:      row.CommitTs,
-					BatchID: 1,
-				}
-			} else if row.CommitTs == c.tableTsMap[tableID].Ts {
-				c.tableTsMap[tableID] = c.tableTsMap[tableID].AdvanceBatch()
-			} else {
-				log.Warn("row changed event commit ts fallback, ignore",
-					zap.Uint64("commitTs", row.CommitTs),
-					zap.Any("tableMaxCommitTs", c.tableTsMap[tableID]),
-					zap.Any("row", row),
-				)
+			if !ok {
 				continue
 			}
-			row.PhysicalTableID = tableID
-			c.tableSinkMap[tableID].AppendRowChangedEvents(row)
+			if row.CommitTs > maxCommitTs {
+				maxCommitTs = row.CommitTs
+			}
 			filteredCnt++
 		}
 	}
@@ -379,10 +378,52 @@ func (c *consumer) emitDMLEvents(
 		zap.Int("decodeRowsCnt", cnt),
 		zap.Int("filteredRowsCnt", filteredCnt))
 
-	return err
+	// The events of a transaction may be split into multiple files, so the
+	// events at the max commit ts in the file are resolved in the batch mode.
+	if maxCommitTs > 0 {
+		if _, err := table.events.UpdateBatchResolvedTs(0, maxCommitTs); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// writeTableEvents writes the resolved events of the table to the downstream,
+// the DMLs are flushed before the DDL is executed.
+func (c *consumer) writeTableEvents(
+	ctx context.Context, tableID model.TableID, table *tableConsumer,
+) error {
+	for {
+		events := table.events.Next()
+		if events == nil {
+			return nil
+		}
+		for _, txn := range events.Transactions {
+			if table.sink == nil {
+				table.sink = c.sinkFactory.CreateTableSinkForConsumer(
+					model.DefaultChangeFeedID(defaultChangefeedName),
+					spanz.TableIDToComparableSpan(tableID),
+					txn.CommitTs)
+			}
+			table.sink.AppendRowChangedEvents(txn.Rows...)
+		}
+		if table.sink != nil {
+			if err := c.waitTableFlushComplete(ctx, table.sink, events.ResolvedTs); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		if events.DDL != nil {
+			if err := c.ddlSink.WriteDDLEvent(ctx, events.DDL); err != nil {
+				return errors.Trace(err)
+			}
+			log.Info("execute ddl event successfully", zap.String("query", events.DDL.Query))
+		}
+	}
 }
 
-func (c *consumer) waitTableFlushComplete(ctx context.Context, tableID model.TableID) error {
+func (c *consumer) waitTableFlushComplete(
+	ctx context.Context, sink tablesink.TableSink, resolvedTs model.ResolvedTs,
+) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -392,14 +433,11 @@ func (c *consumer) waitTableFlushComplete(ctx context.Context, tableID model.Tab
 		default:
 		}
 
-		resolvedTs := c.tableTsMap[tableID]
-		err := c.tableSinkMap[tableID].UpdateResolvedTs(resolvedTs)
+		err := sink.UpdateResolvedTs(resolvedTs)
 		if err != nil {
 			return errors.Trace(err)
 		}
-		checkpoint := c.tableSinkMap[tableID].GetCheckpointTs()
-		if checkpoint.Equal(resolvedTs) {
-			c.tableTsMap[tableID] = resolvedTs.AdvanceBatch()
+		if sink.GetCheckpointTs().EqualOrGreater(resolvedTs) {
 			return nil
 		}
 		time.Sleep(defaultFlushWaitDuration)
@@ -418,24 +456,13 @@ func (c *consumer) syncExecDMLEvents(
 	if err != nil {
 		return errors.Trace(err)
 	}
-	tableID := c.tableIDGenerator.generateFakeTableID(
+	tableID := c.tableIDGenerator.GenerateFakeTableID(
 		key.Schema, key.Table, key.PartitionNum)
 	err = c.emitDMLEvents(ctx, tableID, tableDef, key, content)
 	if err != nil {
 		return errors.Trace(err)
 	}
-
-	resolvedTs := c.tableTsMap[tableID]
-	err = c.tableSinkMap[tableID].UpdateResolvedTs(resolvedTs)
-	if err != nil {
-		return errors.Trace(err)
-	}
-	err = c.waitTableFlushComplete(ctx, tableID)
-	if err != nil {
-		return errors.Trace(err)
-	}
-
-	return nil
+	return c.writeTableEvents(ctx, tableID, c.getTableConsumer(tableID))
 }
 
 func (c *consumer) parseDMLFilePath(_ context.Context, path string) error {
@@ -576,11 +603,18 @@ func (c *consumer) handleNewFiles(
 			if err != nil {
 				return err
 			}
-			if err := c.ddlSink.WriteDDLEvent(ctx, ddlEvent); err != nil {
+			// The DDL is a barrier of the table, all files of the
+			// previous table versions have been consumed.
+			tableID := c.tableIDGenerator.GenerateFakeTableID(key.Schema, key.Table, 0)
+			table := c.getTableConsumer(tableID)
+			table.events.AppendDDL(ddlEvent)
+			if _, err := table.events.UpdateResolvedTs(0, ddlEvent.CommitTs); err != nil {
+				return errors.Trace(err)
+			}
+			if err := c.writeTableEvents(ctx, tableID, table); err != nil {
 				return errors.Trace(err)
 			}
 			// TODO: need to cleanup tableDefMap in the future.
-			log.Info("execute ddl event successfully", zap.String("query", tableDef.Query))
 			continue
 		}
 
@@ -618,28 +652,6 @@ func (c *consumer) run(ctx context.Context) error {
 	}
 }
 
-// copied from kafka-consumer
-type fakeTableIDGenerator struct {
-	tableIDs       map[string]int64
-	currentTableID int64
-	mu             sync.Mutex
-}
-
-func (g *fakeTableIDGenerator) generateFakeTableID(schema, table string, partition int64) int64 {
-	g.mu.Lock()
-	defer g.mu.Unlock()
-	key := quotes.QuoteSchema(schema, table)
-	if partition != 0 {
-		key = fmt.Sprintf("%s.`%d`", key, partition)
-	}
-	if tableID, ok := g.tableIDs[key]; ok {
-		return tableID
-	}
-	g.currentTableID++
-	g.tableIDs[key] = g.currentTableID
-	return g.currentTableID
-}
-
 func main() {
 	var consumer *consumer
 	var err error
@@ -16,6 +16,7 @@ package checker
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -54,6 +55,8 @@ func ignoreExcept(itemMap map[string]struct{}) []string {
 		config.MetaPositionChecking,
 		config.ConnNumberChecking,
 		config.TargetDBPrivilegeChecking,
+		config.MigrationEstimateChecking,
+		config.SnapshotPrivilegeChecking,
 		config.LightningEmptyRegionChecking,
 		config.LightningRegionDistributionChecking,
 		config.LightningDownstreamVersionChecking,
@@ -173,6 +176,43 @@ func TestReplicationPrivilegeChecking(t *testing.T) {
 	}, cfgs)
 }
 
+func TestSnapshotPrivilegeChecking(t *testing.T) {
+	cfgs := []*config.SubTaskConfig{
+		{
+			Mode:                config.ModeAll,
+			IgnoreCheckingItems: ignoreExcept(map[string]struct{}{config.SnapshotPrivilegeChecking: {}}),
+			ValidatorCfg:        config.ValidatorConfig{Mode: config.ValidationSnapshot},
+		},
+	}
+
+	// test not enough privileges
+
+	mock := initMockDB(t)
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT SELECT,REPLICATION SLAVE,REPLICATION CLIENT ON *.* TO 'haha'@'%'"))
+	result, err := RunCheckOnConfigs(context.Background(), cfgs, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.Summary.Failed)
+	require.Contains(t, result.Results[0].Errors[0].ShortErr, "lack of RELOAD global (*.*) privilege")
+
+	// the snapshot check only runs on request, so it's a warning.
+	cfgs[0].ValidatorCfg.Mode = config.ValidationFull
+	mock = initMockDB(t)
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT SELECT,REPLICATION SLAVE,REPLICATION CLIENT ON *.* TO 'haha'@'%'"))
+	result, err = RunCheckOnConfigs(context.Background(), cfgs, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.Summary.Warning)
+
+	// happy path
+
+	checkHappyPath(t, func() {
+		mock := initMockDB(t)
+		mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+			AddRow("GRANT RELOAD ON *.* TO 'haha'@'%'"))
+	}, cfgs)
+}
+
 func TestTargetDBPrivilegeChecking(t *testing.T) {
 	cfgs := []*config.SubTaskConfig{
 		{
@@ -376,7 +416,7 @@ func TestBinlogRowImageChecking(t *testing.T) {
 		},
 	}
 
-	// binlog_row_image is not correct
+	// binlog_row_image is not FULL, which is supported with limits
 
 	mock := initMockDB(t)
 	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'version'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
@@ -384,8 +424,8 @@ func TestBinlogRowImageChecking(t *testing.T) {
 	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'binlog_row_image'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
 		AddRow("binlog_row_image", "MINIMAL"))
 	msg, err := CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt)
-	require.ErrorContains(t, err, "binlog_row_image is MINIMAL, and should be FULL")
-	require.Len(t, msg, 0)
+	require.NoError(t, err)
+	require.Contains(t, msg, "binlog_row_image is MINIMAL, some features are not supported")
 
 	mock = initMockDB(t)
 	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'version'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
@@ -394,7 +434,9 @@ func TestBinlogRowImageChecking(t *testing.T) {
 		AddRow("binlog_row_image", "MINIMAL"))
 	result, err := RunCheckOnConfigs(context.Background(), cfgs, false)
 	require.NoError(t, err)
-	require.Contains(t, result.Results[0].Errors[0].ShortErr, "binlog_row_image is MINIMAL, and should be FULL")
+	require.True(t, result.Summary.Passed)
+	require.Contains(t, result.Results[0].Errors[0].ShortErr, "binlog_row_image is MINIMAL, some features are not supported")
+	require.Contains(t, result.Results[0].Instruction, "take the default values of downstream")
 
 	// happy path
 
@@ -769,6 +811,46 @@ func TestMetaPositionChecking(t *testing.T) {
 	}, cfgs)
 }
 
+func TestMigrationEstimateChecking(t *testing.T) {
+	cfgs := []*config.SubTaskConfig{
+		{
+			Name:                "test",
+			MetaSchema:          "dm_meta",
+			Mode:                config.ModeFull,
+			IgnoreCheckingItems: ignoreExcept(map[string]struct{}{config.MigrationEstimateChecking: {}}),
+		},
+	}
+	cfgs[0].MydumperConfig.Threads = 4
+	cfgs[0].LoaderConfig.PoolSize = 16
+
+	// the estimate is not checked unless it's enabled
+	msg, err := CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt)
+	require.NoError(t, err)
+	require.Empty(t, msg)
+
+	mock := initMockDB(t)
+	mock.ExpectQuery("SELECT TABLE_SCHEMA, TABLE_NAME, TABLE_ROWS, DATA_LENGTH, INDEX_LENGTH FROM information_schema.TABLES").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "TABLE_ROWS", "DATA_LENGTH", "INDEX_LENGTH"}).
+			AddRow(schema, tb1, 100, 1024, 0).
+			AddRow(schema, tb2, 10, 512, 0))
+	mock.ExpectQuery("SELECT \\* FROM `db_1`.`t_1`").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec("CREATE DATABASE IF NOT EXISTS `dm_meta`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS `dm_meta`.`test_estimate_write`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO `dm_meta`.`test_estimate_write`").WillReturnResult(sqlmock.NewResult(0, 119))
+	mock.ExpectExec("DROP TABLE IF EXISTS `dm_meta`.`test_estimate_write`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SHOW CONFIG").WillReturnRows(sqlmock.NewRows([]string{"Type", "Instance", "Name", "Value"}).
+		AddRow("pd", "127.0.0.1:2379", "replication.max-replicas", "3"))
+
+	// the estimate is displayed even if the check is passed
+	msg, err = CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt, config.MigrationEstimateChecking)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(msg, CheckTaskSuccess))
+	require.Contains(t, msg, "2 tables, about 110 rows, data 1.5KiB")
+	require.Contains(t, msg, "downstream disk usage (3 replicas)")
+	require.Contains(t, msg, "estimated duration of full data migration")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func initMockDB(t *testing.T) sqlmock.Sqlmock {
 	t.Helper()
 
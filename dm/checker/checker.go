@@ -366,6 +366,11 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 			if _, ok := c.checkingItems[config.ReplicationPrivilegeChecking]; ok {
 				c.checkList = append(c.checkList, checker.NewSourceReplicationPrivilegeChecker(instance.sourceDB.DB, instance.sourceDBinfo))
 			}
+			validationMode := instance.cfg.ValidatorCfg.Mode
+			if _, ok := c.checkingItems[config.SnapshotPrivilegeChecking]; ok && validationMode != "" && validationMode != config.ValidationNone {
+				c.checkList = append(c.checkList, checker.NewSourceSnapshotCheckPrivilegeChecker(instance.sourceDB.DB, instance.sourceDBinfo,
+					validationMode == config.ValidationSnapshot))
+			}
 			if _, ok := c.checkingItems[config.OnlineDDLChecking]; c.onlineDDL != nil && ok {
 				c.checkList = append(c.checkList, checker.NewOnlineDDLChecker(instance.sourceDB.DB, info.sourceID2InterestedDB[i], c.onlineDDL, instance.baList))
 			}
@@ -386,6 +391,15 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 		))
 	}
 
+	if _, ok := c.checkingItems[config.MigrationEstimateChecking]; ok && config.HasDump(c.stCfgs[0].Mode) {
+		c.checkList = append(c.checkList, checker.NewMigrationEstimateChecker(
+			upstreamDBs,
+			info.sourceID2SourceTables,
+			c.instances[0].targetDB,
+			c.stCfgs,
+		))
+	}
+
 	instance := c.instances[0]
 	// Not check the sharding tables’ schema when the mode is increment.
 	// Because the table schema obtained from `show create table` is not the schema at the point of binlog.
@@ -617,6 +631,10 @@ func (c *Checker) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	results := result.Results[:0]
 	for _, r := range result.Results {
 		if r.State == checker.StateSuccess {
+			// the estimate of migration is displayed even if it's passed
+			if r.Name == checker.MigrationEstimateCheckerName {
+				results = append(results, r)
+			}
 			continue
 		}
 
@@ -673,7 +691,7 @@ func (c *Checker) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	}
 
 	var rawResult []byte
-	if result.Summary.Successful != result.Summary.Total {
+	if result.Summary.Successful != result.Summary.Total || len(result.Results) > 0 {
 		rawResult, err = json.MarshalIndent(result, "\t", "\t")
 		if err != nil {
 			rawResult = []byte(fmt.Sprintf("marshal error %v", err))
@@ -30,15 +30,15 @@ var (
 	CheckTaskSuccess = "pre-check is passed. "
 
 	// CheckSyncConfigFunc holds the CheckSyncConfig function.
-	CheckSyncConfigFunc func(ctx context.Context, cfgs []*config.SubTaskConfig, errCnt, warnCnt int64) (string, error)
+	CheckSyncConfigFunc func(ctx context.Context, cfgs []*config.SubTaskConfig, errCnt, warnCnt int64, enabledOptionalItems ...string) (string, error)
 )
 
 func init() {
 	CheckSyncConfigFunc = CheckSyncConfig
 }
 
-// CheckSyncConfig checks synchronization configuration.
-func CheckSyncConfig(ctx context.Context, cfgs []*config.SubTaskConfig, errCnt, warnCnt int64) (string, error) {
+// CheckSyncConfig checks synchronization configuration, optional checking items are checked only if they're enabled.
+func CheckSyncConfig(ctx context.Context, cfgs []*config.SubTaskConfig, errCnt, warnCnt int64, enabledOptionalItems ...string) (string, error) {
 	if len(cfgs) == 0 {
 		return "", nil
 	}
@@ -46,7 +46,7 @@ func CheckSyncConfig(ctx context.Context, cfgs []*config.SubTaskConfig, errCnt,
 	// all `IgnoreCheckingItems` and `Mode` of sub-task are same, so we take first one
 	// for ModeFull we don't need replication privilege; for ModeIncrement we don't need dump privilege
 	ignoreCheckingItems := cfgs[0].IgnoreCheckingItems
-	checkingItems := config.FilterCheckingItems(ignoreCheckingItems)
+	checkingItems := config.FilterCheckingItems(ignoreCheckingItems, enabledOptionalItems...)
 	if len(checkingItems) == 0 {
 		return "", nil
 	}
@@ -69,6 +69,13 @@ func CheckSyncConfig(ctx context.Context, cfgs []*config.SubTaskConfig, errCnt,
 		if len(r.Detail) == 0 {
 			return CheckTaskSuccess, nil
 		}
+		c.result.RLock()
+		warnings := c.result.detail.Summary.Warning
+		c.result.RUnlock()
+		if warnings == 0 {
+			// only the estimate of migration is displayed, which is checked only if it's enabled
+			return fmt.Sprintf("%s\n detail: %s", CheckTaskSuccess, string(r.Detail)), nil
+		}
 		return fmt.Sprintf("%s: no errors but some warnings\n detail: %s", CheckTaskMsgHeader, string(r.Detail)), nil
 	}
 
@@ -99,6 +99,10 @@ var (
 	// tb2:                       +a +b +c
 	// tb3:          +a +b +c
 	ShardDDLOptimismDroppedColumnsKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/shardddl-optimism/dropped-columns/")
+	// ShardDDLOptimismAutoResolveKeyAdapter is used to store the decisions made to resolve the conflicts of a lock
+	// automatically and the audit of them, so the new leader of DM-master can rebuild the lock with them.
+	// k/v: Encode(lock-id) -> the decisions of the lock.
+	ShardDDLOptimismAutoResolveKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/shardddl-optimism/auto-resolve/")
 
 	// OpenAPITaskTemplateKeyAdapter is used to store the openapi task-config-template (openapi.Task), now it's only used for WebUI.
 	// openapi.Task is a struct that can be converted to config.StubTaskConfig so if any field of openapi.Task updated
@@ -111,13 +115,22 @@ var (
 	// config because the command line arguments may be expected to take effect only once when failover.
 	// kv: Encode(task-name, source-id) -> TaskCliArgs.
 	TaskCliArgsKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/task-cli-args/")
+	// CutoverKeyAdapter is used to store the state of the cutover workflow of task, so the workflow can be resumed
+	// by the new leader of DM-master.
+	// k/v: Encode(task-name) -> the state of the cutover workflow.
+	CutoverKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/cutover/")
+	// TaskScheduleKeyAdapter is used to store the sources paused or throttled by the schedule of task, so the new
+	// leader of DM-master only resumes or restores the subtasks changed by the schedule.
+	// k/v: Encode(task-name) -> the sources changed by the schedule.
+	TaskScheduleKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/task-schedule/")
 )
 
 func keyAdapterKeysLen(s KeyAdapter) int {
 	switch s {
 	case WorkerRegisterKeyAdapter, UpstreamConfigKeyAdapter, UpstreamBoundWorkerKeyAdapter,
 		WorkerKeepAliveKeyAdapter, StageRelayKeyAdapter,
-		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter, OpenAPITaskTemplateKeyAdapter:
+		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter, OpenAPITaskTemplateKeyAdapter,
+		CutoverKeyAdapter, TaskScheduleKeyAdapter, ShardDDLOptimismAutoResolveKeyAdapter:
 		return 1
 	case UpstreamSubTaskKeyAdapter, StageSubTaskKeyAdapter, StageValidatorKeyAdapter,
 		ShardDDLPessimismInfoKeyAdapter, ShardDDLPessimismOperationKeyAdapter,
@@ -80,6 +80,21 @@ func (t *testCommon) TestKeyAdapter(c *C) {
 			adapter: OpenAPITaskTemplateKeyAdapter,
 			want:    "/dm-master/openapi-task-template/7461736b2d31",
 		},
+		{
+			keys:    []string{"task-1"},
+			adapter: CutoverKeyAdapter,
+			want:    "/dm-master/cutover/7461736b2d31",
+		},
+		{
+			keys:    []string{"task-1"},
+			adapter: TaskScheduleKeyAdapter,
+			want:    "/dm-master/task-schedule/7461736b2d31",
+		},
+		{
+			keys:    []string{"task-1"},
+			adapter: ShardDDLOptimismAutoResolveKeyAdapter,
+			want:    "/dm-master/shardddl-optimism/auto-resolve/7461736b2d31",
+		},
 	}
 
 	for _, ca := range testCases {
@@ -38,6 +38,8 @@ const (
 	MetaPositionChecking         = "meta_position"
 	ConnNumberChecking           = "conn_number"
 	TargetDBPrivilegeChecking    = "target_privilege"
+	MigrationEstimateChecking    = "migration_estimate"
+	SnapshotPrivilegeChecking    = "snapshot_privilege"
 	// lighting prechecks.
 	LightningEmptyRegionChecking        = "empty_region"
 	LightningRegionDistributionChecking = "region_distribution"
@@ -64,6 +66,8 @@ var AllCheckingItems = map[string]string{
 	MetaPositionChecking:         "meta position valid checking item",
 	ConnNumberChecking:           "connection number checking item",
 	TargetDBPrivilegeChecking:    "privileges of target DB checking item",
+	MigrationEstimateChecking:    "migration size, duration and binlog retention estimating item",
+	SnapshotPrivilegeChecking:    "privileges of source DB for the snapshot check of validator checking item",
 	// lightning prechecks
 	LightningEmptyRegionChecking:        "physical import mode empty region checking item",
 	LightningRegionDistributionChecking: "physical import mode region distribution checking item",
@@ -81,6 +85,12 @@ var LightningPrechecks = []string{
 	LightningMutexFeatureChecking,
 }
 
+// OptionalCheckingItems are only checked when they're enabled explicitly, because they cost much
+// more than other items and never fail the check.
+var OptionalCheckingItems = []string{
+	MigrationEstimateChecking,
+}
+
 // MaxSourceIDLength is the max length for dm-worker source id.
 const MaxSourceIDLength = 32
 
@@ -104,13 +114,20 @@ func SupportCheckingItems() string {
 	return buf.String()
 }
 
-// FilterCheckingItems filters ignored items from all checking items.
-func FilterCheckingItems(ignoredItems []string) map[string]string {
+// FilterCheckingItems filters ignored items from all checking items, optional items are kept only
+// if they're enabled.
+func FilterCheckingItems(ignoredItems []string, enabledOptionalItems ...string) map[string]string {
 	checkingItems := make(map[string]string)
 	for item, desc := range AllCheckingItems {
 		checkingItems[item] = desc
 	}
 	delete(checkingItems, AllChecking)
+	for _, item := range OptionalCheckingItems {
+		delete(checkingItems, item)
+	}
+	for _, item := range enabledOptionalItems {
+		checkingItems[item] = AllCheckingItems[item]
+	}
 
 	for _, item := range ignoredItems {
 		if item == AllChecking {
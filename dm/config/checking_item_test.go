@@ -32,7 +32,7 @@ func TestCheckingItems(t *testing.T) {
 	}
 	// remember to update the number when add new checking items.
 	require.Equal(t, 5, lightningCheck)
-	require.Equal(t, 16, normalCheck)
+	require.Equal(t, 18, normalCheck)
 	// all LightningPrechecks can be found by iterating AllCheckingItems
 	require.Len(t, LightningPrechecks, lightningCheck)
 	require.Error(t, ValidateCheckingItem("xxx"))
@@ -49,8 +49,11 @@ func TestCheckingItems(t *testing.T) {
 		checkingItems[item] = desc
 	}
 	delete(checkingItems, AllChecking)
-
+	// optional checking items are checked only if they're enabled
+	require.Equal(t, checkingItems, FilterCheckingItems(ignoredCheckingItems[:0], MigrationEstimateChecking))
+	delete(checkingItems, MigrationEstimateChecking)
 	require.Equal(t, checkingItems, FilterCheckingItems(ignoredCheckingItems[:0]))
+	require.Equal(t, checkingItems, FilterCheckingItems([]string{MigrationEstimateChecking}, MigrationEstimateChecking))
 
 	delete(checkingItems, ShardTableSchemaChecking)
 	require.Equal(t, checkingItems, FilterCheckingItems(ignoredCheckingItems[1:]))
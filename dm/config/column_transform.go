@@ -0,0 +1,121 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/pkg/quotes"
+)
+
+// ColumnTransformType is the type of a column transform.
+type ColumnTransformType string
+
+// column transform types.
+const (
+	// ColumnTransformHash replaces the value with the hex SHA-256 of the salt and the value.
+	ColumnTransformHash ColumnTransformType = "hash"
+	// ColumnTransformMask replaces the characters of the value with the mask char, except
+	// the first KeepPrefix and the last KeepSuffix characters.
+	ColumnTransformMask ColumnTransformType = "mask"
+	// ColumnTransformConstant replaces the value with Value.
+	ColumnTransformConstant ColumnTransformType = "constant"
+	// ColumnTransformExpression replaces the value with the result of Expr, which is evaluated
+	// on the original row like expression filters.
+	ColumnTransformExpression ColumnTransformType = "expression"
+)
+
+const defaultMaskChar = "*"
+
+// ColumnTransform rewrites the value of a column of an upstream table, both in dumped data and
+// in binlog events. NULL values are kept NULL by `hash` and `mask`.
+type ColumnTransform struct {
+	Schema string              `yaml:"schema" toml:"schema" json:"schema"`
+	Table  string              `yaml:"table" toml:"table" json:"table"`
+	Column string              `yaml:"column" toml:"column" json:"column"`
+	Type   ColumnTransformType `yaml:"type" toml:"type" json:"type"`
+
+	// Salt is prepended to the value by `hash`.
+	Salt string `yaml:"salt" toml:"salt" json:"salt"`
+	// KeepPrefix, KeepSuffix and MaskChar are used by `mask`, MaskChar defaults to "*".
+	KeepPrefix int    `yaml:"keep-prefix" toml:"keep-prefix" json:"keep-prefix"`
+	KeepSuffix int    `yaml:"keep-suffix" toml:"keep-suffix" json:"keep-suffix"`
+	MaskChar   string `yaml:"mask-char" toml:"mask-char" json:"mask-char"`
+	// Value is used by `constant`.
+	Value string `yaml:"value" toml:"value" json:"value"`
+	// Expr is used by `expression`, it can refer to any column of the table.
+	Expr string `yaml:"expr" toml:"expr" json:"expr"`
+}
+
+// Verify checks the transform and fills the default values.
+func (t *ColumnTransform) Verify(name string) error {
+	if t.Schema == "" || t.Table == "" || t.Column == "" {
+		return terror.ErrConfigInvalidColumnTransform.Generate(name, "`schema`, `table` and `column` should not be empty")
+	}
+	switch t.Type {
+	case ColumnTransformHash, ColumnTransformConstant:
+	case ColumnTransformMask:
+		if t.KeepPrefix < 0 || t.KeepSuffix < 0 {
+			return terror.ErrConfigInvalidColumnTransform.Generate(name, "`keep-prefix` and `keep-suffix` should not be negative")
+		}
+		if t.MaskChar == "" {
+			t.MaskChar = defaultMaskChar
+		}
+		if utf8.RuneCountInString(t.MaskChar) != 1 {
+			return terror.ErrConfigInvalidColumnTransform.Generate(name, "`mask-char` should be a single character")
+		}
+	case ColumnTransformExpression:
+		if t.Expr == "" {
+			return terror.ErrConfigInvalidColumnTransform.Generate(name, "`expr` is empty")
+		}
+	default:
+		return terror.ErrConfigInvalidColumnTransform.Generate(name, fmt.Sprintf("unknown type '%s'", t.Type))
+	}
+	if err := checkValidExpr(t.Expression()); err != nil {
+		return terror.ErrConfigInvalidColumnTransform.Generate(name, err.Error())
+	}
+	return nil
+}
+
+// Expression returns the expression which computes the new value of the column.
+func (t *ColumnTransform) Expression() string {
+	col := quotes.QuoteName(t.Column)
+	switch t.Type {
+	case ColumnTransformHash:
+		return fmt.Sprintf("SHA2(CONCAT(%s, %s), 256)", quoteLiteral(t.Salt), col)
+	case ColumnTransformMask:
+		maskChar := t.MaskChar
+		if maskChar == "" {
+			maskChar = defaultMaskChar
+		}
+		// values not longer than the kept characters are fully masked
+		keep := t.KeepPrefix + t.KeepSuffix
+		return fmt.Sprintf("IF(CHAR_LENGTH(%[1]s) <= %[2]d, REPEAT(%[3]s, CHAR_LENGTH(%[1]s)), "+
+			"CONCAT(LEFT(%[1]s, %[4]d), REPEAT(%[3]s, CHAR_LENGTH(%[1]s) - %[2]d), RIGHT(%[1]s, %[5]d)))",
+			col, keep, quoteLiteral(maskChar), t.KeepPrefix, t.KeepSuffix)
+	case ColumnTransformConstant:
+		return quoteLiteral(t.Value)
+	default:
+		return t.Expr
+	}
+}
+
+// quoteLiteral quotes s as an SQL string literal.
+func quoteLiteral(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
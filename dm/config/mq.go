@@ -0,0 +1,96 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	cdcconfig "github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink"
+)
+
+// MQConfig is the config of writing the row changes and DDLs of the sync unit to a message queue
+// instead of the target database. The target database is still used to store the checkpoints and
+// other meta data of the task.
+type MQConfig struct {
+	// SinkURI is a TiCDC Kafka sink URI, the message format is specified by the `protocol` parameter, e.g.
+	// kafka://127.0.0.1:9092/topic-name?protocol=canal-json&partition-num=3&max-message-bytes=1048576
+	SinkURI string `yaml:"sink-uri" toml:"sink-uri" json:"sink-uri"`
+	// SchemaRegistry is the URL of the schema registry, it's required by the `avro` protocol.
+	SchemaRegistry string `yaml:"schema-registry" toml:"schema-registry" json:"schema-registry"`
+	// DispatchRules dispatches the events of the matched target tables to topics and partitions.
+	DispatchRules []*MQDispatchRule `yaml:"dispatchers" toml:"dispatchers" json:"dispatchers"`
+}
+
+// MQDispatchRule is the same as the dispatcher of TiCDC sink config.
+type MQDispatchRule struct {
+	// Matcher is the table filter rules of the target tables.
+	Matcher []string `yaml:"matcher" toml:"matcher" json:"matcher"`
+	// Topic is the topic expression such as `{schema}_{table}`, empty means the topic in SinkURI.
+	Topic string `yaml:"topic" toml:"topic" json:"topic"`
+	// Partition is one of `default`, `index-value`, `table`, `ts` and `columns`.
+	Partition string `yaml:"partition" toml:"partition" json:"partition"`
+	// Index is the index used by the `index-value` partition dispatcher.
+	Index string `yaml:"index" toml:"index" json:"index"`
+	// Columns are used by the `columns` partition dispatcher.
+	Columns []string `yaml:"columns" toml:"columns" json:"columns"`
+}
+
+// Verify checks the sink URI and the dispatch rules.
+func (c *MQConfig) Verify() error {
+	_, _, err := c.ReplicaConfig()
+	return err
+}
+
+// ReplicaConfig parses the sink URI and converts the config to a TiCDC replica config, which is used to
+// create the Kafka sinks.
+func (c *MQConfig) ReplicaConfig() (*url.URL, *cdcconfig.ReplicaConfig, error) {
+	sinkURI, err := url.Parse(c.SinkURI)
+	if err != nil {
+		return nil, nil, terror.ErrConfigInvalidTargetMQ.Generate(fmt.Sprintf("parse sink-uri: %v", err))
+	}
+	switch strings.ToLower(sinkURI.Scheme) {
+	case sink.KafkaScheme, sink.KafkaSSLScheme:
+	default:
+		return nil, nil, terror.ErrConfigInvalidTargetMQ.Generate(fmt.Sprintf("unsupported sink-uri scheme '%s'", sinkURI.Scheme))
+	}
+	protocol := sinkURI.Query().Get("protocol")
+	if protocol == "" {
+		return nil, nil, terror.ErrConfigInvalidTargetMQ.Generate("`protocol` parameter of sink-uri is required")
+	}
+	if p, err2 := cdcconfig.ParseSinkProtocolFromString(protocol); err2 != nil || p == cdcconfig.ProtocolCsv {
+		return nil, nil, terror.ErrConfigInvalidTargetMQ.Generate(fmt.Sprintf("unsupported protocol '%s'", protocol))
+	}
+
+	replicaConfig := cdcconfig.GetDefaultReplicaConfig()
+	if c.SchemaRegistry != "" {
+		replicaConfig.Sink.SchemaRegistry = &c.SchemaRegistry
+	}
+	for _, rule := range c.DispatchRules {
+		replicaConfig.Sink.DispatchRules = append(replicaConfig.Sink.DispatchRules, &cdcconfig.DispatchRule{
+			Matcher:       rule.Matcher,
+			TopicRule:     rule.Topic,
+			PartitionRule: rule.Partition,
+			IndexName:     rule.Index,
+			Columns:       rule.Columns,
+		})
+	}
+	if err = replicaConfig.ValidateAndAdjust(sinkURI); err != nil {
+		return nil, nil, terror.ErrConfigInvalidTargetMQ.Generate(err.Error())
+	}
+	return sinkURI, replicaConfig, nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/robfig/cron"
+)
+
+// phases of a task schedule.
+const (
+	// SchedulePhaseWaitingStart means the start time of the task is not reached.
+	SchedulePhaseWaitingStart = "waiting-start"
+	// SchedulePhaseMaintenance means the incremental replication is paused by a maintenance window.
+	SchedulePhaseMaintenance = "maintenance"
+	// SchedulePhaseThrottled means the incremental replication is throttled by a maintenance window.
+	SchedulePhaseThrottled = "throttled"
+	// SchedulePhaseNormal means no schedule takes effect.
+	SchedulePhaseNormal = "normal"
+)
+
+// maxWindowOccurrences limits the iterations of finding the latest occurrence of a window.
+const maxWindowOccurrences = 10000
+
+// TaskSchedule is the schedule of a task, it's enforced by DM-master.
+type TaskSchedule struct {
+	// StartTime defers the start of the task, the subtasks are created as paused until then.
+	StartTime string `yaml:"start-time" toml:"start-time" json:"start-time"`
+	// Timezone is the timezone of StartTime and the cron expressions, such as `Asia/Shanghai` or `+08:00`,
+	// default is UTC.
+	Timezone           string               `yaml:"timezone" toml:"timezone" json:"timezone"`
+	MaintenanceWindows []*MaintenanceWindow `yaml:"maintenance-windows" toml:"maintenance-windows" json:"maintenance-windows"`
+}
+
+// MaintenanceWindow is a recurring window which starts at the times matching Cron and lasts for Duration.
+// During the window the subtasks in the sync unit are paused, or throttled if Throttle is set.
+type MaintenanceWindow struct {
+	Name     string          `yaml:"name" toml:"name" json:"name"`
+	Cron     string          `yaml:"cron" toml:"cron" json:"cron"`
+	Duration Duration        `yaml:"duration" toml:"duration" json:"duration"`
+	Throttle *ThrottleConfig `yaml:"throttle" toml:"throttle" json:"throttle"`
+}
+
+// ScheduleState is the state of a task schedule at a moment.
+type ScheduleState struct {
+	Phase string
+	// Window is the maintenance window in effect, nil if not in any window.
+	Window *MaintenanceWindow
+	// NextChange is the time when the phase may change next, it's zero if no change is expected.
+	NextChange time.Time
+}
+
+// Adjust validates the schedule and sets the default window names.
+func (s *TaskSchedule) Adjust() error {
+	loc, err := s.location()
+	if err != nil {
+		return err
+	}
+	if _, err = s.startTime(loc); err != nil {
+		return err
+	}
+	names := make(map[string]struct{}, len(s.MaintenanceWindows))
+	for i, w := range s.MaintenanceWindows {
+		if w == nil {
+			return terror.ErrConfigInvalidTaskSchedule.Generate(fmt.Sprintf("maintenance window #%d is empty", i+1))
+		}
+		if w.Name == "" {
+			w.Name = fmt.Sprintf("window-%d", i+1)
+		}
+		if _, ok := names[w.Name]; ok {
+			return terror.ErrConfigInvalidTaskSchedule.Generate(fmt.Sprintf("duplicate maintenance window name '%s'", w.Name))
+		}
+		names[w.Name] = struct{}{}
+		if _, err = cron.ParseStandard(w.Cron); err != nil {
+			return terror.ErrConfigInvalidTaskSchedule.Generate(fmt.Sprintf("invalid cron '%s' of window '%s': %v", w.Cron, w.Name, err))
+		}
+		if w.Duration.Duration <= 0 {
+			return terror.ErrConfigInvalidTaskSchedule.Generate(fmt.Sprintf("duration of window '%s' should be positive", w.Name))
+		}
+		if w.Throttle != nil {
+			if err = w.Throttle.Adjust(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// StateAt returns the state of the schedule at t. A pausing window takes precedence over a throttling window
+// when they overlap, and the earlier configured window takes precedence over the later one of the same kind.
+func (s *TaskSchedule) StateAt(t time.Time) (ScheduleState, error) {
+	loc, err := s.location()
+	if err != nil {
+		return ScheduleState{}, err
+	}
+	t = t.In(loc)
+	start, err := s.startTime(loc)
+	if err != nil {
+		return ScheduleState{}, err
+	}
+	if !start.IsZero() && t.Before(start) {
+		return ScheduleState{Phase: SchedulePhaseWaitingStart, NextChange: start}, nil
+	}
+
+	state := ScheduleState{Phase: SchedulePhaseNormal}
+	updateNext := func(next time.Time) {
+		if state.NextChange.IsZero() || next.Before(state.NextChange) {
+			state.NextChange = next
+		}
+	}
+	for _, w := range s.MaintenanceWindows {
+		sched, err2 := cron.ParseStandard(w.Cron)
+		if err2 != nil {
+			return ScheduleState{}, terror.ErrConfigInvalidTaskSchedule.Generate(fmt.Sprintf("invalid cron '%s' of window '%s': %v", w.Cron, w.Name, err2))
+		}
+		end, active := windowEnd(sched, w.Duration.Duration, t)
+		if !active {
+			updateNext(sched.Next(t))
+			continue
+		}
+		updateNext(end)
+		switch {
+		case state.Window == nil:
+			state.Window = w
+		case state.Window.Throttle != nil && w.Throttle == nil:
+			state.Window = w
+		}
+	}
+	if state.Window != nil {
+		state.Phase = SchedulePhaseMaintenance
+		if state.Window.Throttle != nil {
+			state.Phase = SchedulePhaseThrottled
+		}
+	}
+	return state, nil
+}
+
+func (s *TaskSchedule) location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := utils.ParseTimeZone(s.Timezone)
+	if err != nil {
+		return nil, terror.ErrConfigInvalidTaskSchedule.Generate(fmt.Sprintf("invalid timezone '%s'", s.Timezone))
+	}
+	return loc, nil
+}
+
+// startTime returns the parsed StartTime, it's zero if not set.
+func (s *TaskSchedule) startTime(loc *time.Location) (time.Time, error) {
+	if s.StartTime == "" {
+		return time.Time{}, nil
+	}
+	t, err := utils.ParseStartTimeInLoc(s.StartTime, loc)
+	if err != nil {
+		return time.Time{}, terror.ErrConfigInvalidTaskSchedule.Generate(fmt.Sprintf("invalid start-time '%s'", s.StartTime))
+	}
+	return t, nil
+}
+
+// windowEnd returns the end of the latest occurrence of the window which covers t.
+func windowEnd(sched cron.Schedule, duration time.Duration, t time.Time) (time.Time, bool) {
+	var (
+		end    time.Time
+		active bool
+	)
+	// Next returns the time strictly after the given one, so the window started at t-duration is not active.
+	start := sched.Next(t.Add(-duration))
+	for i := 0; i < maxWindowOccurrences && !start.IsZero() && !start.After(t); i++ {
+		end, active = start.Add(duration), true
+		start = sched.Next(start)
+	}
+	return end, active
+}
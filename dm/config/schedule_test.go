@@ -0,0 +1,112 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config/dbconfig"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestTaskSchedule(t *testing.T) {
+	t.Parallel()
+
+	var schedule TaskSchedule
+	require.NoError(t, yaml.UnmarshalStrict([]byte(`
+start-time: "2024-03-01 02:00:00"
+timezone: "Asia/Shanghai"
+maintenance-windows:
+- cron: "0 1 * * *"
+  duration: 2h
+  throttle:
+    rows-per-second: 100
+- name: batch
+  cron: "30 1 * * 1-5"
+  duration: 1h
+`), &schedule))
+	require.NoError(t, schedule.Adjust())
+	require.Equal(t, "window-1", schedule.MaintenanceWindows[0].Name)
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	at := func(s string) time.Time {
+		ts, err2 := time.ParseInLocation("2006-01-02 15:04", s, loc)
+		require.NoError(t, err2)
+		return ts
+	}
+
+	cases := []struct {
+		now    string
+		phase  string
+		window string
+		next   string
+	}{
+		{"2024-03-01 01:59", SchedulePhaseWaitingStart, "", "2024-03-01 02:00"},
+		// 2024-03-01 is Friday
+		{"2024-03-01 02:00", SchedulePhaseMaintenance, "batch", "2024-03-01 02:30"},
+		{"2024-03-01 03:00", SchedulePhaseNormal, "", "2024-03-02 01:00"},
+		{"2024-03-04 01:10", SchedulePhaseThrottled, "window-1", "2024-03-04 01:30"},
+		{"2024-03-04 01:30", SchedulePhaseMaintenance, "batch", "2024-03-04 02:30"},
+		{"2024-03-04 02:30", SchedulePhaseThrottled, "window-1", "2024-03-04 03:00"},
+		{"2024-03-02 01:30", SchedulePhaseThrottled, "window-1", "2024-03-02 03:00"},
+	}
+	for _, c := range cases {
+		state, err2 := schedule.StateAt(at(c.now).UTC())
+		require.NoError(t, err2)
+		require.Equal(t, c.phase, state.Phase, c.now)
+		if c.window == "" {
+			require.Nil(t, state.Window, c.now)
+		} else {
+			require.Equal(t, c.window, state.Window.Name, c.now)
+		}
+		require.True(t, at(c.next).Equal(state.NextChange), "%s: %s", c.now, state.NextChange)
+	}
+
+	invalid := []TaskSchedule{
+		{Timezone: "Local"},
+		{StartTime: "tomorrow"},
+		{MaintenanceWindows: []*MaintenanceWindow{{Cron: "0 1 * *", Duration: Duration{time.Hour}}}},
+		{MaintenanceWindows: []*MaintenanceWindow{{Cron: "0 1 * * *"}}},
+		{MaintenanceWindows: []*MaintenanceWindow{
+			{Name: "w", Cron: "0 1 * * *", Duration: Duration{time.Hour}},
+			{Name: "w", Cron: "0 2 * * *", Duration: Duration{time.Hour}},
+		}},
+	}
+	for _, s := range invalid {
+		require.True(t, terror.ErrConfigInvalidTaskSchedule.Equal(s.Adjust()), "%+v", s)
+	}
+
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = ModeAll
+	cfg.TargetDB = &dbconfig.DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1"})
+	cfg.Schedule = &schedule
+	require.NoError(t, cfg.adjust())
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]dbconfig.DBConfig{"source1": {}})
+	require.NoError(t, err)
+	require.Equal(t, cfg.Schedule, stCfgs[0].Schedule)
+	require.Equal(t, cfg.Schedule, SubTaskConfigsToTaskConfig(stCfgs...).Schedule)
+
+	// the schedule is kept after encoding the subtask config
+	content, err := stCfgs[0].Toml()
+	require.NoError(t, err)
+	stCfg := &SubTaskConfig{}
+	require.NoError(t, stCfg.Decode(content, false))
+	require.Equal(t, cfg.Schedule, stCfg.Schedule)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/BurntSushi/toml"
 	"github.com/go-mysql-org/go-mysql/mysql"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	extstorage "github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
@@ -65,6 +66,40 @@ type PurgeConfig struct {
 	RemainSpace int64 `yaml:"remain-space" toml:"remain-space" json:"remain-space"` // if remain space in @RelayBaseDir less than @RemainSpace (GB), then it can be purged
 }
 
+// RelayArchiveConfig is the configuration for relay log Archiver.
+type RelayArchiveConfig struct {
+	Storage           string `yaml:"storage" toml:"storage" json:"storage"`                                     // external storage URI to archive sealed relay log files to, like `s3://bucket/prefix`, empty means disabled
+	Interval          int64  `yaml:"interval" toml:"interval" json:"interval"`                                  // check whether need to archive at this @Interval (seconds)
+	PurgeBlockTimeout int64  `yaml:"purge-block-timeout" toml:"purge-block-timeout" json:"purge-block-timeout"` // if relay log files failed to be archived for @PurgeBlockTimeout (seconds), they can be purged without archived, 0 means never
+}
+
+// PlacementConfig is the configuration for placing the source onto DM-workers by their labels.
+type PlacementConfig struct {
+	Constraints map[string]string `yaml:"constraints" toml:"constraints" json:"constraints"` // the DM-worker must have all these labels to be bound to the source
+	Preferences map[string]string `yaml:"preferences" toml:"preferences" json:"preferences"` // DM-workers matching more of these labels are preferred
+}
+
+// MatchConstraints returns whether the DM-worker labels satisfy all the placement constraints.
+func (c PlacementConfig) MatchConstraints(labels map[string]string) bool {
+	for k, v := range c.Constraints {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// PreferenceScore returns how many placement preferences the DM-worker labels match.
+func (c PlacementConfig) PreferenceScore(labels map[string]string) int {
+	score := 0
+	for k, v := range c.Preferences {
+		if labels[k] == v {
+			score++
+		}
+	}
+	return score
+}
+
 // SourceConfig is the configuration for source.
 type SourceConfig struct {
 	Enable     bool `yaml:"enable" toml:"enable" json:"enable"`
@@ -91,6 +126,9 @@ type SourceConfig struct {
 	// config items for purger
 	Purge PurgeConfig `yaml:"purge" toml:"purge" json:"purge"`
 
+	// config items for relay log archiver
+	RelayArchive RelayArchiveConfig `yaml:"relay-archive" toml:"relay-archive" json:"relay-archive"`
+
 	// config items for task status checker
 	Checker CheckerConfig `yaml:"checker" toml:"checker" json:"checker"`
 
@@ -102,6 +140,9 @@ type SourceConfig struct {
 
 	CaseSensitive bool                  `yaml:"case-sensitive" toml:"case-sensitive" json:"case-sensitive"`
 	Filters       []*bf.BinlogEventRule `yaml:"filters" toml:"filters" json:"filters"`
+
+	// placement constraints and preferences of DM-workers to bind this source
+	Placement PlacementConfig `yaml:"placement" toml:"placement" json:"placement"`
 }
 
 // NewSourceConfig creates a new base config for upstream MySQL/MariaDB source.
@@ -120,6 +161,10 @@ func newSourceConfig() *SourceConfig {
 			Expires:     0,
 			RemainSpace: 15,
 		},
+		RelayArchive: RelayArchiveConfig{
+			Interval:          60,
+			PurgeBlockTimeout: 86400,
+		},
 		Checker: CheckerConfig{
 			CheckEnable:     true,
 			BackoffRollback: Duration{DefaultBackoffRollback},
@@ -249,6 +294,12 @@ func (c *SourceConfig) Verify() error {
 		}
 	}
 
+	if len(c.RelayArchive.Storage) > 0 {
+		if _, err = extstorage.ParseBackend(c.RelayArchive.Storage, nil); err != nil {
+			return terror.ErrConfigInvalidRelayArchiveStorage.Delegate(err, c.RelayArchive.Storage)
+		}
+	}
+
 	_, err = bf.NewBinlogEvent(c.CaseSensitive, c.Filters)
 	if err != nil {
 		return terror.ErrConfigBinlogEventFilter.Delegate(err)
@@ -258,6 +309,14 @@ func (c *SourceConfig) Verify() error {
 		return terror.ErrConfigCheckerMaxTooSmall.Generate(c.Checker.BackoffMax.Duration, c.Checker.BackoffMin.Duration)
 	}
 
+	for _, labels := range []map[string]string{c.Placement.Constraints, c.Placement.Preferences} {
+		for k := range labels {
+			if k == "" {
+				return terror.ErrConfigInvalidPlacementLabel.Generate()
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -421,6 +480,8 @@ type SourceConfigForDowngrade struct {
 	// any new config item, we mark it omitempty
 	CaseSensitive bool                  `yaml:"case-sensitive,omitempty"`
 	Filters       []*bf.BinlogEventRule `yaml:"filters,omitempty"`
+	RelayArchive  RelayArchiveConfig    `yaml:"relay-archive,omitempty"`
+	Placement     PlacementConfig       `yaml:"placement,omitempty"`
 }
 
 // NewSourceConfigForDowngrade creates a new base config for downgrade.
@@ -443,6 +504,8 @@ func NewSourceConfigForDowngrade(sourceCfg *SourceConfig) *SourceConfigForDowngr
 		Tracer:          sourceCfg.Tracer,
 		CaseSensitive:   sourceCfg.CaseSensitive,
 		Filters:         sourceCfg.Filters,
+		RelayArchive:    sourceCfg.RelayArchive,
+		Placement:       sourceCfg.Placement,
 	}
 }
 
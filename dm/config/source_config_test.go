@@ -32,6 +32,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/encrypt"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
@@ -72,6 +73,7 @@ func TestConfigFunctions(t *testing.T) {
 	require.Equal(t, uint32(100), cfg1.ServerID)
 	cfg.Filters = []*bf.BinlogEventRule{}
 	cfg.Tracer = map[string]interface{}{}
+	cfg.Placement = PlacementConfig{Constraints: map[string]string{}, Preferences: map[string]string{}}
 
 	var cfg2 SourceConfig
 	require.NoError(t, cfg2.FromToml(originCfgStr))
@@ -268,6 +270,32 @@ func TestConfigVerify(t *testing.T) {
 	runCasesFn()
 }
 
+func TestPlacementConfig(t *testing.T) {
+	cfg, err := SourceCfgFromYaml(SampleSourceConfig + `
+placement:
+  constraints:
+    zone: zone-a
+  preferences:
+    host: host-1
+    disk: ssd
+`)
+	require.NoError(t, err)
+	require.NoError(t, cfg.Verify())
+	placement := cfg.Placement
+
+	require.True(t, placement.MatchConstraints(map[string]string{"zone": "zone-a"}))
+	require.False(t, placement.MatchConstraints(map[string]string{"zone": "zone-b", "host": "host-1"}))
+	require.False(t, placement.MatchConstraints(nil))
+	require.True(t, PlacementConfig{}.MatchConstraints(nil))
+
+	require.Equal(t, 0, placement.PreferenceScore(nil))
+	require.Equal(t, 1, placement.PreferenceScore(map[string]string{"host": "host-1", "disk": "hdd"}))
+	require.Equal(t, 2, placement.PreferenceScore(map[string]string{"zone": "zone-a", "host": "host-1", "disk": "ssd"}))
+
+	cfg.Placement.Preferences[""] = "x"
+	require.True(t, terror.ErrConfigInvalidPlacementLabel.Equal(cfg.Verify()))
+}
+
 func TestSourceConfigForDowngrade(t *testing.T) {
 	cfg, err := SourceCfgFromYaml(SampleSourceConfig)
 	require.NoError(t, err)
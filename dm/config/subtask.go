@@ -90,6 +90,8 @@ type SubTaskConfig struct {
 	ShardMode                 string `toml:"shard-mode" json:"shard-mode"`
 	StrictOptimisticShardMode bool   `toml:"strict-optimistic-shard-mode" json:"strict-optimistic-shard-mode"`
 	OnlineDDL                 bool   `toml:"online-ddl" json:"online-ddl"`
+	// ShardAutoResolve is the strategies to resolve the conflicts of shard DDLs in optimistic mode.
+	ShardAutoResolve []string `toml:"shard-auto-resolve" json:"shard-auto-resolve"`
 
 	// pt/gh-ost name rule, support regex
 	ShadowTableRules []string `yaml:"shadow-table-rules" toml:"shadow-table-rules" json:"shadow-table-rules"`
@@ -132,12 +134,18 @@ type SubTaskConfig struct {
 	UseRelay bool              `toml:"use-relay" json:"use-relay"`
 	From     dbconfig.DBConfig `toml:"from" json:"from"`
 	To       dbconfig.DBConfig `toml:"to" json:"to"`
+	// TargetMQ is not nil when writing to a message queue, then To only stores the meta data
+	TargetMQ *MQConfig `toml:"target-mq" json:"target-mq"`
+	// Schedule is the task level schedule, it's enforced by DM-master
+	Schedule *TaskSchedule `toml:"schedule" json:"schedule"`
 
 	RouteRules  []*router.TableRule   `toml:"route-rules" json:"route-rules"`
 	FilterRules []*bf.BinlogEventRule `toml:"filter-rules" json:"filter-rules"`
 	// deprecated
 	ColumnMappingRules []*column.Rule      `toml:"mapping-rule" json:"mapping-rule"`
 	ExprFilter         []*ExpressionFilter `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	ConflictRules      []*ConflictRule     `yaml:"conflict-rules" toml:"conflict-rules" json:"conflict-rules"`
+	ColumnTransforms   []*ColumnTransform  `yaml:"column-transforms" toml:"column-transforms" json:"column-transforms"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList *filter.Rules `toml:"black-white-list" json:"black-white-list"`
@@ -294,6 +302,9 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	if c.StrictOptimisticShardMode && c.ShardMode != ShardOptimistic {
 		return terror.ErrConfigStrictOptimisticShardMode.Generate()
 	}
+	if err := checkShardAutoResolve(c.ShardMode, c.ShardAutoResolve); err != nil {
+		return err
+	}
 
 	if len(c.ColumnMappingRules) > 0 {
 		return terror.ErrConfigColumnMappingDeprecated.Generate()
@@ -379,6 +390,39 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	} else if c.SyncerConfig.SafeMode && duration == 0 {
 		return terror.ErrConfigConfictSafeModeDurationAndSafeMode.Generate()
 	}
+	if c.SyncerConfig.Delay != "" {
+		if duration, err := time.ParseDuration(c.SyncerConfig.Delay); err != nil || duration < 0 {
+			return terror.ErrConfigInvalidSyncerDelay.Generate(c.SyncerConfig.Delay)
+		}
+	}
+	if err := c.SyncerConfig.Throttle.Adjust(); err != nil {
+		return err
+	}
+	if c.SyncerConfig.SchemaDriftCheckInterval != "" {
+		if duration, err := time.ParseDuration(c.SyncerConfig.SchemaDriftCheckInterval); err != nil || duration < 0 {
+			return terror.ErrConfigInvalidSchemaDriftCheckInterval.Generate(c.SyncerConfig.SchemaDriftCheckInterval)
+		}
+	}
+	for _, rule := range c.ConflictRules {
+		if err := rule.Verify(rule.Route); err != nil {
+			return err
+		}
+	}
+	for _, transform := range c.ColumnTransforms {
+		if err := transform.Verify(transform.Column); err != nil {
+			return err
+		}
+	}
+	if c.TargetMQ != nil {
+		if err := c.TargetMQ.Verify(); err != nil {
+			return err
+		}
+	}
+	if c.Schedule != nil {
+		if err := c.Schedule.Adjust(); err != nil {
+			return err
+		}
+	}
 
 	c.From.AdjustWithTimeZone(c.Timezone)
 	c.To.AdjustWithTimeZone(c.Timezone)
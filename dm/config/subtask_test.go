@@ -18,6 +18,7 @@ import (
 	"crypto/rand"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/pingcap/tidb/pkg/util/filter"
@@ -63,6 +64,13 @@ func TestSubTask(t *testing.T) {
 	}
 	cfg.From.Adjust()
 	cfg.To.Adjust()
+	cfg.SyncerConfig.Throttle = ThrottleConfig{
+		RowsPerSecond:  1000,
+		BytesPerSecond: "10MiB",
+		TargetLatency:  Duration{100 * time.Millisecond},
+	}
+	require.Equal(t, int64(10*1024*1024), cfg.SyncerConfig.Throttle.BytesLimit())
+	require.Equal(t, int64(0), cfg.LoaderConfig.Throttle.BytesLimit())
 
 	clone1, err := cfg.Clone()
 	require.NoError(t, err)
@@ -94,6 +102,11 @@ func TestSubTask(t *testing.T) {
 	err = cfg.Adjust(true)
 	require.NoError(t, err)
 
+	cfg.ValidatorCfg = ValidatorConfig{Mode: ValidationSnapshot}
+	err = cfg.Adjust(true)
+	require.NoError(t, err)
+	require.Equal(t, DefaultValidatorSnapshotChunkSize, cfg.ValidatorCfg.SnapshotChunkSize)
+
 	cfg.ValidatorCfg = ValidatorConfig{Mode: "invalid-mode"}
 	err = cfg.Adjust(true)
 	require.True(t, terror.ErrConfigValidationMode.Equal(err))
@@ -163,6 +176,38 @@ func TestSubTaskAdjustFail(t *testing.T) {
 			},
 			"Message: online scheme rtc not supported",
 		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SyncerConfig.Delay = "1"
+				return cfg
+			},
+			"Message: invalid syncer delay '1'",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SyncerConfig.Delay = "-1h"
+				return cfg
+			},
+			"Message: invalid syncer delay '-1h'",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SyncerConfig.Throttle.RowsPerSecond = -1
+				return cfg
+			},
+			"Message: invalid throttle config: `rows-per-second` is negative",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.LoaderConfig.Throttle.BytesPerSecond = "10xb"
+				return cfg
+			},
+			"Message: invalid throttle config: invalid `bytes-per-second` '10xb'",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -56,6 +56,17 @@ const (
 	tidbTxnOptimistic = "optimistic"
 )
 
+// strategies to resolve the conflicts of shard DDLs automatically in optimistic mode.
+const (
+	// ShardAutoResolveWidenType widens the types of a column to their common supertype.
+	ShardAutoResolveWidenType = "widen-type"
+	// ShardAutoResolveUnionColumns takes the union of the added columns, and makes the columns
+	// with different default values or without default values nullable with NULL as default.
+	ShardAutoResolveUnionColumns = "union-columns"
+	// ShardAutoResolveIgnoreIndex ignores the differences of secondary indexes.
+	ShardAutoResolveIgnoreIndex = "ignore-index"
+)
+
 // collation_compatible.
 const (
 	LooseCollationCompatible  = "loose"
@@ -66,6 +77,9 @@ const (
 	ValidationNone = "none"
 	ValidationFast = "fast"
 	ValidationFull = "full"
+	// ValidationSnapshot validates incremental data as ValidationFull does, and
+	// checks all rows of the tables by chunk checksum once.
+	ValidationSnapshot = "snapshot"
 
 	DefaultValidatorWorkerCount       = 4
 	DefaultValidatorValidateInterval  = 10 * time.Second
@@ -74,6 +88,7 @@ const (
 	DefaultValidatorMetaFlushInterval = 5 * time.Minute
 	DefaultValidatorBatchQuerySize    = 100
 	DefaultValidatorMaxPendingRowSize = "500m"
+	DefaultValidatorSnapshotChunkSize = 1000
 
 	ValidatorMaxAccumulatedRow = 100000
 	// PendingRow is substantial in this version (in sysbench test)
@@ -301,6 +316,8 @@ type LoaderConfig struct {
 	RangeConcurrency    int                          `yaml:"range-concurrency" toml:"range-concurrency" json:"range-concurrency"`
 	CompressKVPairs     string                       `yaml:"compress-kv-pairs" toml:"compress-kv-pairs" json:"compress-kv-pairs"`
 	PDAddr              string                       `yaml:"pd-addr" toml:"pd-addr" json:"pd-addr"`
+	// throttle of logical import mode, rows are approximated by the lines of dump files.
+	Throttle ThrottleConfig `yaml:"load-throttle" toml:"load-throttle" json:"load-throttle"`
 }
 
 // DefaultLoaderConfig return default loader config for task.
@@ -385,9 +402,119 @@ func (m *LoaderConfig) adjust() error {
 		return terror.ErrConfigInvalidLoadAnalyze.Generate(m.Analyze)
 	}
 
+	return m.Throttle.Adjust()
+}
+
+// ThrottleConfig limits the rate of writing to the downstream, zero value means no limit.
+type ThrottleConfig struct {
+	RowsPerSecond  int    `yaml:"rows-per-second" toml:"rows-per-second" json:"rows-per-second"`
+	BytesPerSecond string `yaml:"bytes-per-second" toml:"bytes-per-second" json:"bytes-per-second"`
+	// TargetLatency enables adaptive throttling, the rows limit is lowered when the p99 latency of downstream
+	// writes exceeds it. they are DML executions for sync unit, and block deliveries for load unit.
+	TargetLatency Duration `yaml:"target-latency" toml:"target-latency" json:"target-latency"`
+}
+
+// Adjust validates the throttle config.
+func (t *ThrottleConfig) Adjust() error {
+	if t.RowsPerSecond < 0 {
+		return terror.ErrConfigInvalidThrottle.Generate("`rows-per-second` is negative")
+	}
+	if t.BytesPerSecond != "" {
+		if bytes, err := units.RAMInBytes(t.BytesPerSecond); err != nil || bytes < 0 {
+			return terror.ErrConfigInvalidThrottle.Generate(fmt.Sprintf("invalid `bytes-per-second` '%s'", t.BytesPerSecond))
+		}
+	}
+	if t.TargetLatency.Duration < 0 {
+		return terror.ErrConfigInvalidThrottle.Generate("`target-latency` is negative")
+	}
 	return nil
 }
 
+// BytesLimit returns the bytes limit per second, it should be called after Adjust.
+func (t *ThrottleConfig) BytesLimit() int64 {
+	if t.BytesPerSecond == "" {
+		return 0
+	}
+	bytes, _ := units.RAMInBytes(t.BytesPerSecond)
+	return bytes
+}
+
+// ConflictPolicy decides which change is kept when rows with the same key from different
+// sources are routed into one downstream table.
+type ConflictPolicy string
+
+// conflict policies.
+const (
+	// ConflictLastWriterWins keeps the change with the larger value of the timestamp column.
+	ConflictLastWriterWins ConflictPolicy = "last-writer-wins"
+	// ConflictSourcePriority keeps the change from the source with the higher priority.
+	ConflictSourcePriority ConflictPolicy = "source-priority"
+	// ConflictReject keeps the row of the source which writes it first, changes of the same row
+	// from other sources are rejected.
+	ConflictReject ConflictPolicy = "reject"
+)
+
+// ConflictRule resolves conflicts in the target tables of a route, the discarded changes are
+// recorded in the conflict log table in the meta schema.
+type ConflictRule struct {
+	// Route is the name of the route in task config, the rule applies to its target tables.
+	Route  string         `yaml:"route" toml:"route" json:"route"`
+	Policy ConflictPolicy `yaml:"policy" toml:"policy" json:"policy"`
+	// TimestampColumn is compared by `last-writer-wins`, the change with the larger value wins.
+	TimestampColumn string `yaml:"timestamp-column" toml:"timestamp-column" json:"timestamp-column"`
+	// SourceColumn stores which source the row comes from, it's used by `source-priority` and `reject`,
+	// and defaults to the target column of `extract-source` of the route.
+	SourceColumn string `yaml:"source-column" toml:"source-column" json:"source-column"`
+	// SourcePriority lists the values of SourceColumn from the highest priority to the lowest,
+	// sources not in the list have the lowest priority.
+	SourcePriority []string `yaml:"source-priority" toml:"source-priority" json:"source-priority"`
+
+	// TargetSchema and TargetTable are copied from the route when generating subtask configs,
+	// empty TargetTable means all tables in TargetSchema.
+	TargetSchema string `yaml:"-" toml:"target-schema" json:"target-schema"`
+	TargetTable  string `yaml:"-" toml:"target-table" json:"target-table"`
+}
+
+// adjust fills the default values from the route and validates the rule.
+func (r *ConflictRule) adjust(name string, route *router.TableRule) error {
+	r.TargetSchema = route.TargetSchema
+	r.TargetTable = route.TargetTable
+	if r.SourceColumn == "" && route.SourceExtractor != nil {
+		r.SourceColumn = route.SourceExtractor.TargetColumn
+	}
+	return r.Verify(name)
+}
+
+// Verify checks the policy and the columns needed by it.
+func (r *ConflictRule) Verify(name string) error {
+	if r.TargetSchema == "" {
+		return terror.ErrConfigInvalidConflictRule.Generate(name, "route has no target schema")
+	}
+	switch r.Policy {
+	case ConflictLastWriterWins:
+		if r.TimestampColumn == "" {
+			return terror.ErrConfigInvalidConflictRule.Generate(name, "`timestamp-column` is empty")
+		}
+	case ConflictSourcePriority:
+		if len(r.SourcePriority) == 0 {
+			return terror.ErrConfigInvalidConflictRule.Generate(name, "`source-priority` is empty")
+		}
+		fallthrough
+	case ConflictReject:
+		if r.SourceColumn == "" {
+			return terror.ErrConfigInvalidConflictRule.Generate(name, "`source-column` is empty")
+		}
+	default:
+		return terror.ErrConfigInvalidConflictRule.Generate(name, fmt.Sprintf("unknown policy '%s'", r.Policy))
+	}
+	return nil
+}
+
+// Match returns whether the rule applies to the target table.
+func (r *ConflictRule) Match(schema, table string) bool {
+	return r.TargetSchema == schema && (r.TargetTable == "" || r.TargetTable == table)
+}
+
 // SyncerConfig represents syncer process unit's specific config.
 type SyncerConfig struct {
 	MetaFile    string `yaml:"meta-file" toml:"meta-file" json:"meta-file"` // meta filename, used only when load SubConfig directly
@@ -410,6 +537,15 @@ type SyncerConfig struct {
 	DisableCausality bool   `yaml:"disable-detect" toml:"disable-detect" json:"disable-detect"`
 	SafeMode         bool   `yaml:"safe-mode" toml:"safe-mode" json:"safe-mode"`
 	SafeModeDuration string `yaml:"safe-mode-duration" toml:"safe-mode-duration" json:"safe-mode-duration"`
+	// delay holds binlog events until they are older than this duration, like `SOURCE_DELAY` in MySQL.
+	Delay string `yaml:"delay" toml:"delay" json:"delay"`
+	// throttle of DML workers.
+	Throttle ThrottleConfig `yaml:"sync-throttle" toml:"sync-throttle" json:"sync-throttle"`
+	// interval of comparing the tracked table structures with downstream tables, empty or 0 means disabled.
+	SchemaDriftCheckInterval string `yaml:"schema-drift-check-interval" toml:"schema-drift-check-interval" json:"schema-drift-check-interval"`
+	// max number of downstream tables which have their own Prometheus metrics, row changes of the other tables
+	// are counted to the `_others` table. 0 means per-table metrics are disabled.
+	TableMetricsLimit int `yaml:"table-metrics-limit" toml:"table-metrics-limit" json:"table-metrics-limit"`
 	// deprecated, use `ansi-quotes` in top level config instead
 	EnableANSIQuotes bool `yaml:"enable-ansi-quotes" toml:"enable-ansi-quotes" json:"enable-ansi-quotes"`
 }
@@ -448,6 +584,7 @@ type ValidatorConfig struct {
 	BatchQuerySize     int      `yaml:"batch-query-size" toml:"batch-query-size" json:"batch-query-size"`
 	MaxPendingRowSize  string   `yaml:"max-pending-row-size" toml:"max-pending-row-size" json:"max-pending-row-size"`
 	MaxPendingRowCount int      `yaml:"max-pending-row-count" toml:"max-pending-row-count" json:"max-pending-row-count"`
+	SnapshotChunkSize  int      `yaml:"snapshot-chunk-size" toml:"snapshot-chunk-size" json:"snapshot-chunk-size"`
 	StartTime          string   `yaml:"-" toml:"start-time" json:"-"`
 }
 
@@ -455,7 +592,7 @@ func (v *ValidatorConfig) Adjust() error {
 	if v.Mode == "" {
 		v.Mode = ValidationNone
 	}
-	if v.Mode != ValidationNone && v.Mode != ValidationFast && v.Mode != ValidationFull {
+	if v.Mode != ValidationNone && v.Mode != ValidationFast && v.Mode != ValidationFull && v.Mode != ValidationSnapshot {
 		return terror.ErrConfigValidationMode
 	}
 	if v.WorkerCount <= 0 {
@@ -487,6 +624,9 @@ func (v *ValidatorConfig) Adjust() error {
 	if v.MaxPendingRowCount == 0 {
 		v.MaxPendingRowCount = DefaultValidatorMaxPendingRow
 	}
+	if v.SnapshotChunkSize <= 0 {
+		v.SnapshotChunkSize = DefaultValidatorSnapshotChunkSize
+	}
 	return nil
 }
 
@@ -505,6 +645,8 @@ type TaskConfig struct {
 	IsSharding                bool   `yaml:"is-sharding" toml:"is-sharding" json:"is-sharding"`
 	ShardMode                 string `yaml:"shard-mode" toml:"shard-mode" json:"shard-mode"` // when `shard-mode` set, we always enable sharding support.
 	StrictOptimisticShardMode bool   `yaml:"strict-optimistic-shard-mode" toml:"strict-optimistic-shard-mode" json:"strict-optimistic-shard-mode"`
+	// ShardAutoResolve is the strategies tried in order to resolve the conflicts of shard DDLs in optimistic mode.
+	ShardAutoResolve []string `yaml:"shard-auto-resolve" toml:"shard-auto-resolve" json:"shard-auto-resolve"`
 	// treat it as hidden configuration
 	IgnoreCheckingItems []string `yaml:"ignore-checking-items" toml:"ignore-checking-items" json:"ignore-checking-items"`
 	// we store detail status in meta
@@ -527,6 +669,11 @@ type TaskConfig struct {
 	CollationCompatible string `yaml:"collation_compatible" toml:"collation_compatible" json:"collation_compatible"`
 
 	TargetDB *dbconfig.DBConfig `yaml:"target-database" toml:"target-database" json:"target-database"`
+	// write row changes and DDLs to a message queue instead of TargetDB, see MQConfig
+	TargetMQ *MQConfig `yaml:"target-mq" toml:"target-mq" json:"target-mq"`
+
+	// deferred start and maintenance windows enforced by DM-master, see TaskSchedule
+	Schedule *TaskSchedule `yaml:"schedule" toml:"schedule" json:"schedule"`
 
 	MySQLInstances []*MySQLInstance `yaml:"mysql-instances" toml:"mysql-instances" json:"mysql-instances"`
 
@@ -543,6 +690,10 @@ type TaskConfig struct {
 	// deprecated
 	ColumnMappings map[string]*column.Rule      `yaml:"column-mappings" toml:"column-mappings" json:"column-mappings"`
 	ExprFilter     map[string]*ExpressionFilter `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	// resolve conflicts of rows from different sources, see ConflictRule
+	ConflictRules map[string]*ConflictRule `yaml:"conflict-rules" toml:"conflict-rules" json:"conflict-rules"`
+	// rewrite column values of upstream tables in both dump and sync units, see ColumnTransform
+	ColumnTransforms map[string]*ColumnTransform `yaml:"column-transforms" toml:"column-transforms" json:"column-transforms"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList map[string]*filter.Rules `yaml:"black-white-list" toml:"black-white-list" json:"black-white-list"`
@@ -580,6 +731,8 @@ func NewTaskConfig() *TaskConfig {
 		Filters:                 make(map[string]*bf.BinlogEventRule),
 		ColumnMappings:          make(map[string]*column.Rule),
 		ExprFilter:              make(map[string]*ExpressionFilter),
+		ConflictRules:           make(map[string]*ConflictRule),
+		ColumnTransforms:        make(map[string]*ColumnTransform),
 		BWList:                  make(map[string]*filter.Rules),
 		BAList:                  make(map[string]*filter.Rules),
 		Mydumpers:               make(map[string]*MydumperConfig),
@@ -685,6 +838,9 @@ func (c *TaskConfig) adjust() error {
 	if c.StrictOptimisticShardMode && c.ShardMode != ShardOptimistic {
 		return terror.ErrConfigStrictOptimisticShardMode.Generate()
 	}
+	if err := checkShardAutoResolve(c.ShardMode, c.ShardAutoResolve); err != nil {
+		return err
+	}
 
 	if len(c.ColumnMappings) > 0 {
 		return terror.ErrConfigColumnMappingDeprecated.Generate()
@@ -717,6 +873,24 @@ func (c *TaskConfig) adjust() error {
 		return terror.ErrConfigMySQLInstsAtLeastOne.Generate()
 	}
 
+	if c.TargetMQ != nil {
+		if err := c.TargetMQ.Verify(); err != nil {
+			return err
+		}
+		if c.TaskMode != ModeIncrement {
+			return terror.ErrConfigInvalidTargetMQ.Generate(fmt.Sprintf("task-mode '%s' is not supported", c.TaskMode))
+		}
+		if len(c.ConflictRules) > 0 {
+			return terror.ErrConfigInvalidTargetMQ.Generate("conflict-rules are not supported")
+		}
+	}
+
+	if c.Schedule != nil {
+		if err := c.Schedule.Adjust(); err != nil {
+			return err
+		}
+	}
+
 	for name, exprFilter := range c.ExprFilter {
 		if exprFilter.Schema == "" {
 			return terror.ErrConfigExprFilterEmptyName.Generate(name, "schema")
@@ -755,6 +929,35 @@ func (c *TaskConfig) adjust() error {
 		}
 	}
 
+	for name, rule := range c.ConflictRules {
+		route, ok := c.Routes[rule.Route]
+		if !ok {
+			return terror.ErrConfigInvalidConflictRule.Generate(name, fmt.Sprintf("route '%s' not found", rule.Route))
+		}
+		if err := rule.adjust(name, route); err != nil {
+			return err
+		}
+	}
+
+	transformNames := make([]string, 0, len(c.ColumnTransforms))
+	for name := range c.ColumnTransforms {
+		transformNames = append(transformNames, name)
+	}
+	// sort names to report the duplicated column stably
+	sort.Strings(transformNames)
+	transformedColumns := make(map[string]string, len(c.ColumnTransforms))
+	for _, name := range transformNames {
+		transform := c.ColumnTransforms[name]
+		if err := transform.Verify(name); err != nil {
+			return err
+		}
+		column := strings.Join([]string{transform.Schema, transform.Table, transform.Column}, ".")
+		if other, ok := transformedColumns[column]; ok {
+			return terror.ErrConfigInvalidColumnTransform.Generate(name, fmt.Sprintf("column '%s' is also transformed by '%s'", column, other))
+		}
+		transformedColumns[column] = name
+	}
+
 	for _, validatorCfg := range c.Validators {
 		if err := validatorCfg.Adjust(); err != nil {
 			return err
@@ -894,6 +1097,19 @@ func (c *TaskConfig) adjust() error {
 		} else if inst.Syncer.SafeMode && duration == 0 {
 			return terror.ErrConfigConfictSafeModeDurationAndSafeMode.Generate()
 		}
+		if inst.Syncer.Delay != "" {
+			if duration, err := time.ParseDuration(inst.Syncer.Delay); err != nil || duration < 0 {
+				return terror.ErrConfigInvalidSyncerDelay.Generate(inst.Syncer.Delay)
+			}
+		}
+		if err := inst.Syncer.Throttle.Adjust(); err != nil {
+			return err
+		}
+		if inst.Syncer.SchemaDriftCheckInterval != "" {
+			if duration, err := time.ParseDuration(inst.Syncer.SchemaDriftCheckInterval); err != nil || duration < 0 {
+				return terror.ErrConfigInvalidSchemaDriftCheckInterval.Generate(inst.Syncer.SchemaDriftCheckInterval)
+			}
+		}
 		if inst.SyncerThread != 0 {
 			inst.Syncer.WorkerCount = inst.SyncerThread
 		}
@@ -909,6 +1125,9 @@ func (c *TaskConfig) adjust() error {
 				inst.ContinuousValidator = *rule
 			}
 		}
+		if c.TargetMQ != nil && inst.ContinuousValidator.Mode != ValidationNone {
+			return terror.ErrConfigInvalidTargetMQ.Generate(fmt.Sprintf("continuous validator of mysql-instance(%d) is not supported", i))
+		}
 
 		// for backward compatible, set global config `ansi-quotes: true` if any syncer is true
 		if inst.Syncer.EnableANSIQuotes {
@@ -1044,6 +1263,27 @@ func checkDuplicateString(ruleNames []string) []string {
 	return dupeArray
 }
 
+// checkShardAutoResolve checks the strategies to resolve the conflicts of shard DDLs.
+func checkShardAutoResolve(shardMode string, strategies []string) error {
+	if len(strategies) == 0 {
+		return nil
+	}
+	if shardMode != ShardOptimistic {
+		return terror.ErrConfigInvalidShardAutoResolve.Generate("`shard-mode` is not `optimistic`")
+	}
+	if dup := checkDuplicateString(strategies); len(dup) > 0 {
+		return terror.ErrConfigInvalidShardAutoResolve.Generate(fmt.Sprintf("duplicate strategies %v", dup))
+	}
+	for _, s := range strategies {
+		switch s {
+		case ShardAutoResolveWidenType, ShardAutoResolveUnionColumns, ShardAutoResolveIgnoreIndex:
+		default:
+			return terror.ErrConfigInvalidShardAutoResolve.Generate(fmt.Sprintf("unknown strategy '%s'", s))
+		}
+	}
+	return nil
+}
+
 // AdjustTargetDBSessionCfg adjust session cfg of TiDB.
 func AdjustTargetDBSessionCfg(dbConfig *dbconfig.DBConfig, version *semver.Version) {
 	lowerMap := make(map[string]string, len(dbConfig.Session))
@@ -1170,6 +1410,7 @@ type SyncerConfigForDowngrade struct {
 	EnableANSIQuotes        bool   `yaml:"enable-ansi-quotes"`
 
 	SafeModeDuration string `yaml:"safe-mode-duration,omitempty"`
+	Delay            string `yaml:"delay,omitempty"`
 	Compact          bool   `yaml:"compact,omitempty"`
 	MultipleRows     bool   `yaml:"multipleRows,omitempty"`
 }
@@ -1189,6 +1430,7 @@ func NewSyncerConfigsForDowngrade(syncerConfigs map[string]*SyncerConfig) map[st
 			DisableCausality:        syncerConfig.DisableCausality,
 			SafeMode:                syncerConfig.SafeMode,
 			SafeModeDuration:        syncerConfig.SafeModeDuration,
+			Delay:                   syncerConfig.Delay,
 			EnableANSIQuotes:        syncerConfig.EnableANSIQuotes,
 			Compact:                 syncerConfig.Compact,
 			MultipleRows:            syncerConfig.MultipleRows,
@@ -1242,6 +1484,11 @@ type TaskConfigForDowngrade struct {
 	ShadowTableRules          []string                     `yaml:"shadow-table-rules,omitempty"`
 	TrashTableRules           []string                     `yaml:"trash-table-rules,omitempty"`
 	StrictOptimisticShardMode bool                         `yaml:"strict-optimistic-shard-mode,omitempty"`
+	ShardAutoResolve          []string                     `yaml:"shard-auto-resolve,omitempty"`
+	ConflictRules             map[string]*ConflictRule     `yaml:"conflict-rules,omitempty"`
+	ColumnTransforms          map[string]*ColumnTransform  `yaml:"column-transforms,omitempty"`
+	TargetMQ                  *MQConfig                    `yaml:"target-mq,omitempty"`
+	Schedule                  *TaskSchedule                `yaml:"schedule,omitempty"`
 }
 
 // NewTaskConfigForDowngrade create new TaskConfigForDowngrade.
@@ -1253,6 +1500,7 @@ func NewTaskConfigForDowngrade(taskConfig *TaskConfig) *TaskConfigForDowngrade {
 		IsSharding:                taskConfig.IsSharding,
 		ShardMode:                 taskConfig.ShardMode,
 		StrictOptimisticShardMode: taskConfig.StrictOptimisticShardMode,
+		ShardAutoResolve:          taskConfig.ShardAutoResolve,
 		IgnoreCheckingItems:       taskConfig.IgnoreCheckingItems,
 		MetaSchema:                taskConfig.MetaSchema,
 		EnableHeartbeat:           taskConfig.EnableHeartbeat,
@@ -1278,6 +1526,10 @@ func NewTaskConfigForDowngrade(taskConfig *TaskConfig) *TaskConfigForDowngrade {
 		OnlineDDL:                 taskConfig.OnlineDDL,
 		ShadowTableRules:          taskConfig.ShadowTableRules,
 		TrashTableRules:           taskConfig.TrashTableRules,
+		ConflictRules:             taskConfig.ConflictRules,
+		ColumnTransforms:          taskConfig.ColumnTransforms,
+		TargetMQ:                  taskConfig.TargetMQ,
+		Schedule:                  taskConfig.Schedule,
 	}
 }
 
@@ -15,6 +15,7 @@ package config
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
@@ -32,6 +33,16 @@ import (
 
 // TaskConfigToSubTaskConfigs generates sub task configs by TaskConfig.
 func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBConfig) ([]*SubTaskConfig, error) {
+	transformNames := make([]string, 0, len(c.ColumnTransforms))
+	for name := range c.ColumnTransforms {
+		transformNames = append(transformNames, name)
+	}
+	sort.Strings(transformNames)
+	var transforms []*ColumnTransform
+	for _, name := range transformNames {
+		transforms = append(transforms, c.ColumnTransforms[name])
+	}
+
 	cfgs := make([]*SubTaskConfig, len(c.MySQLInstances))
 	for i, inst := range c.MySQLInstances {
 		dbCfg, exist := sources[inst.SourceID]
@@ -43,6 +54,7 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 		cfg.IsSharding = c.IsSharding
 		cfg.ShardMode = c.ShardMode
 		cfg.StrictOptimisticShardMode = c.StrictOptimisticShardMode
+		cfg.ShardAutoResolve = c.ShardAutoResolve
 		cfg.OnlineDDL = c.OnlineDDL
 		cfg.TrashTableRules = c.TrashTableRules
 		cfg.ShadowTableRules = c.ShadowTableRules
@@ -69,6 +81,8 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 			return nil, terror.ErrConfigNeedTargetDB
 		}
 		cfg.To = *toClone
+		cfg.TargetMQ = c.TargetMQ
+		cfg.Schedule = c.Schedule
 
 		cfg.SourceID = inst.SourceID
 
@@ -92,6 +106,15 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 			cfg.ExprFilter[j] = c.ExprFilter[name]
 		}
 
+		for _, name := range inst.RouteRules {
+			for _, rule := range c.ConflictRules {
+				if rule.Route == name {
+					cfg.ConflictRules = append(cfg.ConflictRules, rule)
+				}
+			}
+		}
+
+		cfg.ColumnTransforms = transforms
 		cfg.BAList = c.BAList[inst.BAListName]
 
 		cfg.MydumperConfig = *inst.Mydumper
@@ -350,6 +373,7 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.IsSharding = stCfg0.IsSharding
 	c.ShardMode = stCfg0.ShardMode
 	c.StrictOptimisticShardMode = stCfg0.StrictOptimisticShardMode
+	c.ShardAutoResolve = stCfg0.ShardAutoResolve
 	c.IgnoreCheckingItems = stCfg0.IgnoreCheckingItems
 	c.MetaSchema = stCfg0.MetaSchema
 	c.EnableHeartbeat = stCfg0.EnableHeartbeat
@@ -358,6 +382,8 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.Timezone = stCfg0.Timezone
 	c.CaseSensitive = stCfg0.CaseSensitive
 	c.TargetDB = &stCfg0.To // just ref
+	c.TargetMQ = stCfg0.TargetMQ
+	c.Schedule = stCfg0.Schedule
 	c.OnlineDDL = stCfg0.OnlineDDL
 	c.OnlineDDLScheme = stCfg0.OnlineDDLScheme
 	c.CleanDumpFile = stCfg0.CleanDumpFile
@@ -371,6 +397,8 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.Loaders = make(map[string]*LoaderConfig)
 	c.Syncers = make(map[string]*SyncerConfig)
 	c.ExprFilter = make(map[string]*ExpressionFilter)
+	c.ConflictRules = make(map[string]*ConflictRule)
+	c.ColumnTransforms = make(map[string]*ColumnTransform)
 	c.Experimental = stCfg0.Experimental
 	c.Validators = make(map[string]*ValidatorConfig)
 
@@ -382,9 +410,11 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	syncMap := make(map[string]string, len(stCfgs))
 	cmMap := make(map[string]string, len(stCfgs))
 	exprFilterMap := make(map[string]string, len(stCfgs))
+	conflictMap := make(map[string]string, len(stCfgs))
+	transformMap := make(map[string]string, len(stCfgs))
 	validatorMap := make(map[string]string, len(stCfgs))
-	var baListIdx, routeIdx, filterIdx, dumpIdx, loadIdx, syncIdx, validateIdx, cmIdx, efIdx int
-	var baListName, routeName, filterName, dumpName, loadName, syncName, validateName, cmName, efName string
+	var baListIdx, routeIdx, filterIdx, dumpIdx, loadIdx, syncIdx, validateIdx, cmIdx, efIdx, conflictIdx, transformIdx int
+	var baListName, routeName, filterName, dumpName, loadName, syncName, validateName, cmName, efName, conflictName, transformName string
 
 	// NOTE:
 	// - we choose to ref global configs for instances now.
@@ -399,6 +429,26 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 			c.Routes[routeName] = rule
 		}
 
+		// conflict rules refer to the generated names of their routes
+		for _, rule := range stCfg.ConflictRules {
+			for j, route := range stCfg.RouteRules {
+				if route.TargetSchema != rule.TargetSchema || route.TargetTable != rule.TargetTable {
+					continue
+				}
+				ruleClone := *rule
+				ruleClone.Route = routeNames[j]
+				conflictName, conflictIdx = getGenerateName(ruleClone, conflictIdx, "conflict", conflictMap)
+				c.ConflictRules[conflictName] = &ruleClone
+				break
+			}
+		}
+
+		// column transforms are the same for all subtasks
+		for _, transform := range stCfg.ColumnTransforms {
+			transformName, transformIdx = getGenerateName(transform, transformIdx, "transform", transformMap)
+			c.ColumnTransforms[transformName] = transform
+		}
+
 		filterNames := make([]string, 0, len(stCfg.FilterRules))
 		for _, rule := range stCfg.FilterRules {
 			filterName, filterIdx = getGenerateName(rule, filterIdx, "filter", filterMap)
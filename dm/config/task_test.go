@@ -1027,6 +1027,201 @@ func TestExclusiveAndWrongExprFilterFields(t *testing.T) {
 	require.True(t, terror.ErrConfigExprFilterWrongGrammar.Equal(err))
 }
 
+func TestConflictRules(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = ModeAll
+	cfg.TargetDB = &dbconfig.DBConfig{}
+	cfg.Routes["route-1"] = &router.TableRule{
+		SchemaPattern:   "db*",
+		TablePattern:    "tbl*",
+		TargetSchema:    "db",
+		TargetTable:     "tbl",
+		SourceExtractor: &router.SourceExtractor{TargetColumn: "c_source", SourceRegexp: "(.*)"},
+	}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1", RouteRules: []string{"route-1"}})
+	cfg.ConflictRules["conflict-1"] = &ConflictRule{Route: "route-1", Policy: ConflictSourcePriority, SourcePriority: []string{"source1"}}
+	require.NoError(t, cfg.adjust())
+	rule := cfg.ConflictRules["conflict-1"]
+	require.Equal(t, "c_source", rule.SourceColumn)
+	require.Equal(t, "db", rule.TargetSchema)
+	require.True(t, rule.Match("db", "tbl"))
+	require.False(t, rule.Match("db", "tbl2"))
+
+	// subtask configs refer to the generated route names
+	stCfg := &SubTaskConfig{
+		Name:          "test",
+		SourceID:      "source1",
+		RouteRules:    []*router.TableRule{cfg.Routes["route-1"]},
+		ConflictRules: []*ConflictRule{rule},
+	}
+	taskCfg := SubTaskConfigsToTaskConfig(stCfg)
+	require.Len(t, taskCfg.ConflictRules, 1)
+	require.Equal(t, "route-01", taskCfg.ConflictRules["conflict-01"].Route)
+
+	cases := []struct {
+		rule *ConflictRule
+		msg  string
+	}{
+		{&ConflictRule{Route: "route-2", Policy: ConflictReject}, "route 'route-2' not found"},
+		{&ConflictRule{Route: "route-1", Policy: "first-writer-wins"}, "unknown policy"},
+		{&ConflictRule{Route: "route-1", Policy: ConflictLastWriterWins}, "`timestamp-column` is empty"},
+		{&ConflictRule{Route: "route-1", Policy: ConflictSourcePriority}, "`source-priority` is empty"},
+	}
+	for _, c := range cases {
+		cfg.ConflictRules["conflict-1"] = c.rule
+		err := cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidConflictRule.Equal(err))
+		require.ErrorContains(t, err, c.msg)
+	}
+
+	cfg.Routes["route-1"].SourceExtractor = nil
+	cfg.ConflictRules["conflict-1"] = &ConflictRule{Route: "route-1", Policy: ConflictReject}
+	require.True(t, terror.ErrConfigInvalidConflictRule.Equal(cfg.adjust()))
+	cfg.ConflictRules["conflict-1"].SourceColumn = "c_source"
+	require.NoError(t, cfg.adjust())
+}
+
+func TestColumnTransforms(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = ModeAll
+	cfg.TargetDB = &dbconfig.DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances,
+		&MySQLInstance{SourceID: "source1"}, &MySQLInstance{SourceID: "source2"})
+	cfg.ColumnTransforms["mask-phone"] = &ColumnTransform{Schema: "db", Table: "tbl", Column: "phone", Type: ColumnTransformMask, KeepSuffix: 4}
+	cfg.ColumnTransforms["hash-name"] = &ColumnTransform{Schema: "db", Table: "tbl", Column: "name", Type: ColumnTransformHash, Salt: "x'y"}
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, "*", cfg.ColumnTransforms["mask-phone"].MaskChar)
+	require.Equal(t, "SHA2(CONCAT('x''y', `name`), 256)", cfg.ColumnTransforms["hash-name"].Expression())
+	require.Equal(t, "IF(CHAR_LENGTH(`phone`) <= 4, REPEAT('*', CHAR_LENGTH(`phone`)), "+
+		"CONCAT(LEFT(`phone`, 0), REPEAT('*', CHAR_LENGTH(`phone`) - 4), RIGHT(`phone`, 4)))",
+		cfg.ColumnTransforms["mask-phone"].Expression())
+
+	// all subtasks have the transforms sorted by name
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]dbconfig.DBConfig{"source1": {}, "source2": {}})
+	require.NoError(t, err)
+	require.Len(t, stCfgs, 2)
+	for _, stCfg := range stCfgs {
+		require.Equal(t, []*ColumnTransform{cfg.ColumnTransforms["hash-name"], cfg.ColumnTransforms["mask-phone"]}, stCfg.ColumnTransforms)
+	}
+	taskCfg := SubTaskConfigsToTaskConfig(stCfgs...)
+	require.Len(t, taskCfg.ColumnTransforms, 2)
+	require.Equal(t, cfg.ColumnTransforms["hash-name"], taskCfg.ColumnTransforms["transform-01"])
+
+	cases := []struct {
+		transform *ColumnTransform
+		msg       string
+	}{
+		{&ColumnTransform{Schema: "db", Table: "tbl", Type: ColumnTransformHash}, "should not be empty"},
+		{&ColumnTransform{Schema: "db", Table: "tbl", Column: "c", Type: "encrypt"}, "unknown type"},
+		{&ColumnTransform{Schema: "db", Table: "tbl", Column: "c", Type: ColumnTransformMask, KeepPrefix: -1}, "should not be negative"},
+		{&ColumnTransform{Schema: "db", Table: "tbl", Column: "c", Type: ColumnTransformMask, MaskChar: "**"}, "single character"},
+		{&ColumnTransform{Schema: "db", Table: "tbl", Column: "c", Type: ColumnTransformExpression}, "`expr` is empty"},
+		{&ColumnTransform{Schema: "db", Table: "tbl", Column: "c", Type: ColumnTransformExpression, Expr: "a +"}, "line 1 column"},
+		{&ColumnTransform{Schema: "db", Table: "tbl", Column: "name", Type: ColumnTransformConstant}, "is also transformed by 'hash-name'"},
+	}
+	for _, c := range cases {
+		cfg.ColumnTransforms["transform"] = c.transform
+		err := cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidColumnTransform.Equal(err))
+		require.ErrorContains(t, err, c.msg)
+	}
+}
+
+func TestTargetMQ(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = ModeIncrement
+	cfg.TargetDB = &dbconfig.DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1"})
+	cfg.TargetMQ = &MQConfig{
+		SinkURI: "kafka://127.0.0.1:9092/dm-topic?protocol=canal-json",
+		DispatchRules: []*MQDispatchRule{
+			{Matcher: []string{"db.*"}, Topic: "{schema}_{table}", Partition: "index-value"},
+		},
+	}
+	require.NoError(t, cfg.adjust())
+
+	sinkURI, replicaConfig, err := cfg.TargetMQ.ReplicaConfig()
+	require.NoError(t, err)
+	require.Equal(t, "dm-topic", sinkURI.Path[1:])
+	require.Equal(t, "canal-json", *replicaConfig.Sink.Protocol)
+	require.Len(t, replicaConfig.Sink.DispatchRules, 1)
+	require.Equal(t, "index-value", replicaConfig.Sink.DispatchRules[0].PartitionRule)
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]dbconfig.DBConfig{"source1": {}})
+	require.NoError(t, err)
+	require.Equal(t, cfg.TargetMQ, stCfgs[0].TargetMQ)
+	require.Equal(t, cfg.TargetMQ, SubTaskConfigsToTaskConfig(stCfgs...).TargetMQ)
+
+	cases := []struct {
+		sinkURI string
+		msg     string
+	}{
+		{"mysql://127.0.0.1:3306/", "unsupported sink-uri scheme 'mysql'"},
+		{"kafka://127.0.0.1:9092/dm-topic", "`protocol` parameter of sink-uri is required"},
+		{"kafka://127.0.0.1:9092/dm-topic?protocol=csv", "unsupported protocol 'csv'"},
+		{"kafka://127.0.0.1:9092/dm-topic?protocol=json", "unsupported protocol 'json'"},
+	}
+	for _, c := range cases {
+		cfg.TargetMQ.SinkURI = c.sinkURI
+		err = cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidTargetMQ.Equal(err))
+		require.ErrorContains(t, err, c.msg)
+	}
+
+	cfg.TargetMQ.SinkURI = "kafka://127.0.0.1:9092/dm-topic?protocol=simple"
+	cfg.TaskMode = ModeAll
+	require.ErrorContains(t, cfg.adjust(), "task-mode 'all' is not supported")
+	cfg.TaskMode = ModeIncrement
+	cfg.Validators["validator"] = &ValidatorConfig{Mode: ValidationFast}
+	cfg.MySQLInstances[0].ContinuousValidatorConfigName = "validator"
+	require.ErrorContains(t, cfg.adjust(), "continuous validator of mysql-instance(0) is not supported")
+}
+
+func TestShardAutoResolve(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = ModeAll
+	cfg.ShardMode = ShardOptimistic
+	cfg.IsSharding = true
+	cfg.TargetDB = &dbconfig.DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1"})
+	cfg.ShardAutoResolve = []string{ShardAutoResolveWidenType, ShardAutoResolveUnionColumns, ShardAutoResolveIgnoreIndex}
+	require.NoError(t, cfg.adjust())
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]dbconfig.DBConfig{"source1": {}})
+	require.NoError(t, err)
+	require.Equal(t, cfg.ShardAutoResolve, stCfgs[0].ShardAutoResolve)
+	require.Equal(t, cfg.ShardAutoResolve, SubTaskConfigsToTaskConfig(stCfgs...).ShardAutoResolve)
+
+	cases := []struct {
+		shardMode  string
+		strategies []string
+		msg        string
+	}{
+		{ShardPessimistic, []string{ShardAutoResolveWidenType}, "`shard-mode` is not `optimistic`"},
+		{ShardOptimistic, []string{ShardAutoResolveWidenType, ShardAutoResolveWidenType}, "duplicate strategies"},
+		{ShardOptimistic, []string{"drop-column"}, "unknown strategy 'drop-column'"},
+	}
+	for _, c := range cases {
+		cfg.ShardMode = c.shardMode
+		cfg.ShardAutoResolve = c.strategies
+		err = cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidShardAutoResolve.Equal(err))
+		require.ErrorContains(t, err, c.msg)
+	}
+}
+
 func TestTaskConfigForDowngrade(t *testing.T) {
 	t.Parallel()
 
@@ -85,6 +85,11 @@ func NewRootCmd() *cobra.Command {
 		master.NewSourceTableSchemaCmd(),
 		master.NewConfigCmd(),
 		master.NewValidationCmd(),
+		master.NewSyncDelayCmd(),
+		master.NewCutoverCmd(),
+		master.NewResyncTableCmd(),
+		master.NewUpdateTaskRulesCmd(),
+		master.NewRebalanceSourcesCmd(),
 		newEncryptCmd(),
 	)
 	// copied from (*cobra.Command).InitDefaultHelpCmd
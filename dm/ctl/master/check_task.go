@@ -29,13 +29,14 @@ import (
 // NewCheckTaskCmd creates a CheckTask command.
 func NewCheckTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "check-task <config-file> [--error count] [--warn count]",
+		Use:   "check-task <config-file> [--error count] [--warn count] [--estimate]",
 		Short: "Checks the configuration file of the task",
 		RunE:  checkTaskFunc,
 	}
 	cmd.Flags().Int64P("error", "e", common.DefaultErrorCnt, "max count of errors to display")
 	cmd.Flags().Int64P("warn", "w", common.DefaultWarnCnt, "max count of warns to display")
 	cmd.Flags().String("start-time", "", "specify the start time of binlog replication, e.g. '2021-10-21 00:01:00' or 2021-10-21T00:01:00")
+	cmd.Flags().Bool("estimate", false, "estimate the size and duration of the full data migration, it reads the largest table of sources and writes a table in the downstream")
 	return cmd
 }
 
@@ -63,6 +64,10 @@ func checkTaskFunc(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
+	estimate, err := cmd.Flags().GetBool("estimate")
+	if err != nil {
+		return err
+	}
 
 	lines := bytes.Split(content, []byte("\n"))
 	// we check if `is-sharding` is explicitly set, to distinguish between `false` from default value
@@ -108,6 +113,7 @@ func checkTaskFunc(cmd *cobra.Command, _ []string) error {
 			ErrCnt:    errCnt,
 			WarnCnt:   warnCnt,
 			StartTime: startTime,
+			Estimate:  estimate,
 		},
 		&resp,
 	)
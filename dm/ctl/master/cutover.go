@@ -0,0 +1,101 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/spf13/cobra"
+)
+
+// NewCutoverCmd creates a Cutover command.
+func NewCutoverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cutover <start | status | rollback> [--fence-writes] [--skip-validation] <task-name>",
+		Short: "`start`/`status`/`rollback` the guided cutover workflow of a task",
+		RunE:  cutoverFunc,
+	}
+	cmd.Flags().Bool("fence-writes", false, "set the upstream sources read-only before recording the cutover locations")
+	cmd.Flags().Bool("skip-validation", false, "skip comparing the data by the validators")
+	return cmd
+}
+
+func convertCutoverOp(op string) pb.CutoverOp {
+	switch op {
+	case "start":
+		return pb.CutoverOp_StartCutover
+	case "status":
+		return pb.CutoverOp_QueryCutover
+	case "rollback":
+		return pb.CutoverOp_RollbackCutover
+	default:
+		return pb.CutoverOp_InvalidCutoverOp
+	}
+}
+
+// cutoverFunc does operate cutover request.
+func cutoverFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 2 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	opType := cmd.Flags().Arg(0)
+	op := convertCutoverOp(opType)
+	if op == pb.CutoverOp_InvalidCutoverOp {
+		common.PrintLinesf("invalid operate '%s' on cutover", opType)
+		return errors.New("please check output to see error")
+	}
+	taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(1))
+
+	fenceWrites, err := cmd.Flags().GetBool("fence-writes")
+	if err != nil {
+		return err
+	}
+	skipValidation, err := cmd.Flags().GetBool("skip-validation")
+	if err != nil {
+		return err
+	}
+	if op != pb.CutoverOp_StartCutover && (fenceWrites || skipValidation) {
+		common.PrintLinesf("`--fence-writes` and `--skip-validation` can only be used with `start`")
+		return errors.New("please check output to see error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.OperateCutoverResponse{}
+	err = common.SendRequest(
+		ctx,
+		"OperateCutover",
+		&pb.OperateCutoverRequest{
+			Op:             op,
+			Task:           taskName,
+			FenceWrites:    fenceWrites,
+			SkipValidation: skipValidation,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
@@ -28,7 +28,7 @@ import (
 func NewOperateSchemaCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:    "operate-schema <operate-type> <-s source ...> <task-name | task-file> <-d database> <-t table> [schema-file] [--flush] [--sync]",
-		Short:  "`get`/`set`/`remove` the schema for an upstream table",
+		Short:  "`get`/`set`/`remove`/`reconcile` the schema for an upstream table",
 		Hidden: true,
 		RunE:   operateSchemaCmd,
 	}
@@ -47,6 +47,8 @@ func convertSchemaOpType(t string) pb.SchemaOp {
 		return pb.SchemaOp_SetSchema
 	case "remove":
 		return pb.SchemaOp_RemoveSchema
+	case "reconcile":
+		return pb.SchemaOp_ReconcileSchema
 	default:
 		return pb.SchemaOp_InvalidSchemaOp
 	}
@@ -81,7 +83,7 @@ func operateSchemaCmd(cmd *cobra.Command, _ []string) error {
 		}
 	default:
 		if schemaFile != "" {
-			common.PrintLinesf("schema file will be ignored for 'get'/'delete' operation")
+			common.PrintLinesf("schema file will be ignored for 'get'/'delete'/'reconcile' operation")
 		}
 	}
 
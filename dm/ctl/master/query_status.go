@@ -38,14 +38,35 @@ type taskInfo struct {
 	Sources    []string `json:"sources,omitempty"`
 }
 
+type tableResult struct {
+	Result bool         `json:"result"`
+	Msg    string       `json:"msg"`
+	Tables []*tableInfo `json:"tables"`
+}
+
+type tableInfo struct {
+	TaskName            string  `json:"taskName"`
+	Source              string  `json:"source"`
+	Table               string  `json:"table"`
+	InsertRows          int64   `json:"insertRows"`
+	UpdateRows          int64   `json:"updateRows"`
+	DeleteRows          int64   `json:"deleteRows"`
+	Bytes               int64   `json:"bytes"`
+	AvgLatency          float64 `json:"avgLatency"`
+	MaxLatency          float64 `json:"maxLatency"`
+	LastAppliedTs       int64   `json:"lastAppliedTs"`
+	SecondsBehindMaster int64   `json:"secondsBehindMaster"`
+}
+
 // NewQueryStatusCmd creates a QueryStatus command.
 func NewQueryStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "query-status [-s source ...] [task-name | task-file] [--more]",
+		Use:   "query-status [-s source ...] [task-name | task-file] [--more] [--table]",
 		Short: "Queries task status",
 		RunE:  queryStatusFunc,
 	}
 	cmd.Flags().BoolP("more", "", false, "whether to print the detailed task information")
+	cmd.Flags().BoolP("table", "", false, "whether to print the replication status of each downstream table in sync unit")
 	return cmd
 }
 
@@ -63,6 +84,12 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	table, err := cmd.Flags().GetBool("table")
+	if err != nil {
+		common.PrintLinesf("error in parse `--table`")
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), common.GlobalConfig().RPCTimeout)
 	defer cancel()
 
@@ -71,8 +98,9 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 		ctx,
 		"QueryStatus",
 		&pb.QueryStatusListRequest{
-			Name:    taskName,
-			Sources: sources,
+			Name:        taskName,
+			Sources:     sources,
+			TableStatus: table,
 		},
 		&resp,
 	)
@@ -88,6 +116,14 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if table {
+		result, hasFalseResult := wrapTableResult(resp)
+		if !hasFalseResult {
+			common.PrettyPrintInterface(result)
+			return nil
+		}
+	}
+
 	if resp.Result && taskName == "" && len(sources) == 0 && !more {
 		result, hasFalseResult := wrapTaskResult(resp)
 		if !hasFalseResult { // if any result is false, we still print the full status.
@@ -168,3 +204,38 @@ func wrapTaskResult(resp *pb.QueryStatusListResponse) (result *taskResult, hasFa
 		Tasks:  taskList,
 	}, hasFalseResult
 }
+
+// wrapTableResult picks the replication status of downstream tables from the sync units of all subtasks.
+func wrapTableResult(resp *pb.QueryStatusListResponse) (result *tableResult, hasFalseResult bool) {
+	hasFalseResult = !resp.Result
+	tables := make([]*tableInfo, 0)
+	for _, source := range resp.Sources {
+		hasFalseResult = hasFalseResult || !source.Result
+		for _, subTask := range source.SubTaskStatus {
+			syncStatus := subTask.GetSync()
+			if syncStatus == nil {
+				continue
+			}
+			for _, t := range syncStatus.Tables {
+				tables = append(tables, &tableInfo{
+					TaskName:            subTask.Name,
+					Source:              source.SourceStatus.Source,
+					Table:               t.Table,
+					InsertRows:          t.InsertRows,
+					UpdateRows:          t.UpdateRows,
+					DeleteRows:          t.DeleteRows,
+					Bytes:               t.Bytes,
+					AvgLatency:          t.AvgLatency,
+					MaxLatency:          t.MaxLatency,
+					LastAppliedTs:       t.LastAppliedTs,
+					SecondsBehindMaster: t.SecondsBehindMaster,
+				})
+			}
+		}
+	}
+	return &tableResult{
+		Result: resp.Result,
+		Msg:    resp.Msg,
+		Tables: tables,
+	}, hasFalseResult
+}
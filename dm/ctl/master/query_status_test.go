@@ -169,3 +169,42 @@ func (t *testCtlMaster) TestWrapTaskResult(c *check.C) {
 	_, hasFalseResult = wrapTaskResult(resp)
 	c.Assert(hasFalseResult, check.IsFalse)
 }
+
+func (t *testCtlMaster) TestWrapTableResult(c *check.C) {
+	resp := &pb.QueryStatusListResponse{
+		Result: true,
+		Sources: []*pb.QueryStatusResponse{
+			{
+				Result:       true,
+				SourceStatus: &pb.SourceStatus{Source: "mysql-replica-01"},
+				SubTaskStatus: []*pb.SubTaskStatus{{
+					Name: "test",
+					Unit: pb.UnitType_Sync,
+					Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{Tables: []*pb.TableSyncStatus{
+						{Table: "`db`.`t1`", InsertRows: 10, Bytes: 100, LastAppliedTs: 1700000000},
+						{Table: "`db`.`t2`", DeleteRows: 1},
+					}}},
+				}},
+			},
+			{
+				Result:       true,
+				SourceStatus: &pb.SourceStatus{Source: "mysql-replica-02"},
+				SubTaskStatus: []*pb.SubTaskStatus{{
+					Name:   "test",
+					Unit:   pb.UnitType_Load,
+					Status: &pb.SubTaskStatus_Load{Load: &pb.LoadStatus{}},
+				}},
+			},
+		},
+	}
+	result, hasFalseResult := wrapTableResult(resp)
+	c.Assert(hasFalseResult, check.IsFalse)
+	c.Assert(result.Tables, check.DeepEquals, []*tableInfo{
+		{TaskName: "test", Source: "mysql-replica-01", Table: "`db`.`t1`", InsertRows: 10, Bytes: 100, LastAppliedTs: 1700000000},
+		{TaskName: "test", Source: "mysql-replica-01", Table: "`db`.`t2`", DeleteRows: 1},
+	})
+
+	resp.Sources[1].Result = false
+	_, hasFalseResult = wrapTableResult(resp)
+	c.Assert(hasFalseResult, check.IsTrue)
+}
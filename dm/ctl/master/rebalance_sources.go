@@ -0,0 +1,67 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/spf13/cobra"
+)
+
+// NewRebalanceSourcesCmd creates a RebalanceSources command.
+func NewRebalanceSourcesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-sources [--dry-run]",
+		Short: "Moves sources off overloaded or placement-violating workers to free workers",
+		RunE:  rebalanceSourcesFunc,
+	}
+	cmd.Flags().Bool("dry-run", false, "only show the load of workers and the planned moves")
+	return cmd
+}
+
+func rebalanceSourcesFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) > 0 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.RebalanceSourcesResponse{}
+	err = common.SendRequest(
+		ctx,
+		"RebalanceSources",
+		&pb.RebalanceSourcesRequest{
+			DryRun: dryRun,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
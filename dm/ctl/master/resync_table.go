@@ -0,0 +1,87 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/spf13/cobra"
+)
+
+// NewResyncTableCmd creates a ResyncTable command.
+func NewResyncTableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resync-table [-s source ...] <task-name | task-file> <-d database> <-t table ...>",
+		Short: "Dump and load tables again inside a running task, other tables keep replicating",
+		RunE:  resyncTableFunc,
+	}
+	cmd.Flags().StringP("database", "d", "", "database name of the tables")
+	cmd.Flags().StringSliceP("table", "t", []string{}, "table names")
+	return cmd
+}
+
+// resyncTableFunc does resync table request.
+func resyncTableFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+	database, err := cmd.Flags().GetString("database")
+	if err != nil {
+		return err
+	} else if database == "" {
+		common.PrintLinesf("must specify 'database'")
+		return errors.New("please check output to see error")
+	}
+	tables, err := cmd.Flags().GetStringSlice("table")
+	if err != nil {
+		return err
+	} else if len(tables) == 0 {
+		common.PrintLinesf("must specify at least one 'table'")
+		return errors.New("please check output to see error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.ResyncTablesResponse{}
+	err = common.SendRequest(
+		ctx,
+		"ResyncTables",
+		&pb.ResyncTablesRequest{
+			TaskName: taskName,
+			Sources:  sources,
+			Database: database,
+			Tables:   tables,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
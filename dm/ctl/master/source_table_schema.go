@@ -31,6 +31,7 @@ func NewSourceTableSchemaCmd() *cobra.Command {
 		newSourceTableSchemaUpdateCmd(),
 		newSourceTableSchemaDeleteCmd(),
 		newSourceTableSchemaListCmd(),
+		newSourceTableSchemaReconcileCmd(),
 	)
 
 	return cmd
@@ -187,3 +188,36 @@ func newSourceTableSchemaDeleteCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+func newSourceTableSchemaReconcileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile <task-name> <database> <table>",
+		Short: "alter the downstream table to match the table schema structure",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 3 {
+				return cmd.Help()
+			}
+			taskName := common.GetTaskNameFromArgOrFile(args[0])
+			sources, err := common.GetSourceArgs(cmd)
+			if err != nil {
+				return err
+			}
+			database := args[1]
+			table := args[2]
+			request := &pb.OperateSchemaRequest{
+				Op:         pb.SchemaOp_ReconcileSchema,
+				Task:       taskName,
+				Sources:    sources,
+				Database:   database,
+				Table:      table,
+				Schema:     "",
+				Flush:      false,
+				Sync:       false,
+				FromSource: false,
+				FromTarget: false,
+			}
+			return sendOperateSchemaRequest(request)
+		},
+	}
+	return cmd
+}
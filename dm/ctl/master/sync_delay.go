@@ -0,0 +1,90 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/spf13/cobra"
+)
+
+// NewSyncDelayCmd creates a SyncDelay command.
+func NewSyncDelayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-delay <pause | resume | fast-forward> [-s source ...] <task-name>",
+		Short: "`pause`/`resume`/`fast-forward` the delayed window of a task which enables delayed replication",
+		RunE:  syncDelayFunc,
+	}
+	return cmd
+}
+
+func convertSyncDelayOp(op string) pb.SyncDelayOp {
+	switch op {
+	case "pause":
+		return pb.SyncDelayOp_PauseSyncDelay
+	case "resume":
+		return pb.SyncDelayOp_ResumeSyncDelay
+	case "fast-forward":
+		return pb.SyncDelayOp_FastForwardSyncDelay
+	default:
+		return pb.SyncDelayOp_InvalidSyncDelayOp
+	}
+}
+
+// syncDelayFunc does operate sync delay request.
+func syncDelayFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 2 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	opType := cmd.Flags().Arg(0)
+	op := convertSyncDelayOp(opType)
+	if op == pb.SyncDelayOp_InvalidSyncDelayOp {
+		common.PrintLinesf("invalid operate '%s' on delayed window", opType)
+		return errors.New("please check output to see error")
+	}
+	taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(1))
+
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.OperateSyncDelayResponse{}
+	err = common.SendRequest(
+		ctx,
+		"OperateSyncDelay",
+		&pb.OperateSyncDelayRequest{
+			Op:       op,
+			TaskName: taskName,
+			Sources:  sources,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// NewUpdateTaskRulesCmd creates a UpdateTaskRules command.
+func NewUpdateTaskRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-task-rules [--preview] [--backfill] <config-file>",
+		Short: "Updates block-allow list, routes and filters of a running task without pausing it",
+		RunE:  updateTaskRulesFunc,
+	}
+	cmd.Flags().Bool("preview", false, "only show the tables whose target tables are changed by the new rules")
+	cmd.Flags().Bool("backfill", false, "dump and load the tables which are replicated to new target tables")
+	return cmd
+}
+
+// updateTaskRulesFunc does update task rules request.
+func updateTaskRulesFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	content, err := common.GetFileContent(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	task := config.NewTaskConfig()
+	if err = task.RawDecode(string(content)); err != nil {
+		return err
+	}
+	if task.TargetDB != nil && task.TargetDB.Security != nil {
+		loadErr := task.TargetDB.Security.LoadTLSContent()
+		if loadErr != nil {
+			log.L().Warn("load tls content failed", zap.Error(terror.ErrCtlLoadTLSCfg.Generate(loadErr)))
+		}
+		content = []byte(task.String())
+	}
+
+	preview, err := cmd.Flags().GetBool("preview")
+	if err != nil {
+		common.PrintLinesf("error in parse `--preview`")
+		return err
+	}
+	backfill, err := cmd.Flags().GetBool("backfill")
+	if err != nil {
+		common.PrintLinesf("error in parse `--backfill`")
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.UpdateTaskRulesResponse{}
+	err = common.SendRequest(
+		ctx,
+		"UpdateTaskRules",
+		&pb.UpdateTaskRulesRequest{
+			Task:     string(content),
+			Preview:  preview,
+			Backfill: backfill,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
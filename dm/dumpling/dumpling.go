@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/transform"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/unit"
 	"github.com/pingcap/tiflow/engine/pkg/promutil"
@@ -192,6 +193,10 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	}
 	cancel()
 
+	if err == nil && len(m.cfg.ColumnTransforms) > 0 {
+		err = m.transformDumpFiles(ctx)
+	}
+
 	if err != nil {
 		if utils.IsContextCanceledError(err) {
 			m.logger.Info("filter out error caused by user cancel")
@@ -227,6 +232,34 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	}
 }
 
+// transformDumpFiles rewrites the dumped files of the tables which have column transforms.
+func (m *Dumpling) transformDumpFiles(ctx context.Context) error {
+	extStorage := m.cfg.ExtStorage
+	if extStorage == nil {
+		var err error
+		extStorage, err = storage.CreateStorage(ctx, m.cfg.Dir)
+		if err != nil {
+			return err
+		}
+		defer extStorage.Close()
+	}
+	sessionVars := make(map[string]string, len(m.dumpConfig.SessionParams))
+	for k, v := range m.dumpConfig.SessionParams {
+		sessionVars[k] = fmt.Sprint(v)
+	}
+	rewriter, err := transform.NewDumpRewriter(extStorage, m.cfg.ColumnTransforms, m.cfg.LoaderConfig.SQLMode,
+		sessionVars, m.dumpConfig.Threads, m.logger)
+	if err != nil {
+		return err
+	}
+	begin := time.Now()
+	if err = rewriter.Rewrite(ctx); err != nil {
+		return err
+	}
+	m.logger.Info("transform dumped files finished", zap.Duration("cost time", time.Since(begin)))
+	return nil
+}
+
 // Close implements Unit.Close.
 func (m *Dumpling) Close() {
 	if m.closed.Load() {
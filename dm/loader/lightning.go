@@ -40,6 +40,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/throttle"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/unit"
 	"github.com/pingcap/tiflow/engine/pkg/promutil"
@@ -82,6 +83,9 @@ type LightningLoader struct {
 
 	speedRecorder *export.SpeedRecorder
 	metricProxies *metricProxies
+	// throttle limits the rate of reading dump files in logical import mode, and lowers the rows
+	// limit by the latency of delivering rows to the downstream.
+	throttle *throttle.Limiter
 }
 
 // NewLightning creates a new Loader importing data with lightning.
@@ -99,6 +103,8 @@ func NewLightning(cfg *config.SubTaskConfig, cli *clientv3.Client, workerName st
 		core:                  lightning.New(lightningCfg),
 		logger:                logger.WithFields(zap.String("task", cfg.Name), zap.String("unit", "lightning-load")),
 		speedRecorder:         export.NewSpeedRecorder(),
+		throttle: throttle.NewLimiter(logger, cfg.LoaderConfig.Throttle.RowsPerSecond,
+			cfg.LoaderConfig.Throttle.BytesLimit(), cfg.LoaderConfig.Throttle.TargetLatency.Duration),
 	}
 	return loader
 }
@@ -234,38 +240,55 @@ func (l *LightningLoader) runLightning(ctx context.Context, cfg *lcfg.Config) (e
 		return err
 	}
 
-	var opts []lightning.Option
+	var (
+		opts     []lightning.Option
+		factory  promutil.Factory
+		registry prometheus.Registerer
+	)
 	if l.cfg.MetricsFactory != nil {
 		// this branch means dataflow engine has set a Factory, the Factory itself
 		// will register and deregister metrics, but lightning will expect the
 		// register and deregister at the beginning and end of its lifetime.
 		// So we use dataflow engine's Factory to register, and use dataflow engine's
 		// global metrics to manually deregister.
-		opts = append(opts,
-			lightning.WithPromFactory(
-				promutil.NewWrappingFactory(
-					l.cfg.MetricsFactory,
-					"",
-					prometheus.Labels{"task": l.cfg.Name, "source_id": l.cfg.SourceID},
-				)),
-			lightning.WithPromRegistry(promutil.GetGlobalMetricRegistry()))
+		factory = promutil.NewWrappingFactory(
+			l.cfg.MetricsFactory,
+			"",
+			prometheus.Labels{"task": l.cfg.Name, "source_id": l.cfg.SourceID},
+		)
+		registry = promutil.GetGlobalMetricRegistry()
 	} else {
-		registry := prometheus.DefaultGatherer.(prometheus.Registerer)
+		registry = prometheus.DefaultGatherer.(prometheus.Registerer)
 		failpoint.Inject("DontUnregister", func() {
 			registry = promutil.NewOnlyRegRegister(registry)
 		})
 
+		factory = promutil.NewWrappingFactory(
+			tidbpromutil.NewDefaultFactory(),
+			"",
+			prometheus.Labels{"task": l.cfg.Name, "source_id": l.cfg.SourceID},
+		)
+	}
+	// rows read from dump files since the last delivery to the downstream.
+	deliverRows := atomic.NewInt64(0)
+	if l.cfg.LoaderConfig.ImportMode == config.LoadModeLogical {
+		factory = newThrottledFactory(factory, l.throttle, deliverRows)
+	}
+	opts = append(opts,
+		lightning.WithPromFactory(factory),
+		lightning.WithPromRegistry(registry))
+	if l.cfg.LoaderConfig.ImportMode == config.LoadModeLogical {
+		dumpStorage := l.cfg.ExtStorage
+		if dumpStorage == nil {
+			dumpStorage, err = storage.CreateStorage(taskCtx, cfg.Mydumper.SourceDir)
+			if err != nil {
+				return terror.ErrLoadLightningRuntime.Delegate(err)
+			}
+			defer dumpStorage.Close()
+		}
 		opts = append(opts,
-			lightning.WithPromFactory(
-				promutil.NewWrappingFactory(
-					tidbpromutil.NewDefaultFactory(),
-					"",
-					prometheus.Labels{"task": l.cfg.Name, "source_id": l.cfg.SourceID},
-				),
-			),
-			lightning.WithPromRegistry(registry))
-	}
-	if l.cfg.ExtStorage != nil {
+			lightning.WithDumpFileStorage(newThrottledStorage(taskCtx, dumpStorage, l.throttle, deliverRows)))
+	} else if l.cfg.ExtStorage != nil {
 		opts = append(opts,
 			lightning.WithDumpFileStorage(l.cfg.ExtStorage))
 	}
@@ -618,9 +641,15 @@ func (l *LightningLoader) Update(ctx context.Context, cfg *config.SubTaskConfig)
 	l.cfg.BAList = cfg.BAList
 	l.cfg.RouteRules = cfg.RouteRules
 	l.cfg.ColumnMappingRules = cfg.ColumnMappingRules
+	l.UpdateThrottle(cfg.LoaderConfig.Throttle)
 	return nil
 }
 
+// UpdateThrottle updates the throttle limits of logical import mode at runtime.
+func (l *LightningLoader) UpdateThrottle(cfg config.ThrottleConfig) {
+	l.throttle.Update(cfg.RowsPerSecond, cfg.BytesLimit(), cfg.TargetLatency.Duration)
+}
+
 func (l *LightningLoader) status() *pb.LoadStatus {
 	finished, total := l.core.Status()
 	progress := percent(finished, total, l.finish.Load())
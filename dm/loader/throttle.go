@@ -0,0 +1,212 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/throttle"
+	"github.com/pingcap/tiflow/engine/pkg/promutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/atomic"
+)
+
+// blockDeliverSecondsName is the name of lightning's histogram which observes the duration of
+// delivering a block of rows to the downstream.
+const blockDeliverSecondsName = "block_deliver_seconds"
+
+// throttledStorage throttles reading dump files, so lightning writes to the downstream
+// no faster than the limits. Rows are counted by parsing the uncompressed SQL and CSV
+// data files, other files are only limited by bytes.
+type throttledStorage struct {
+	storage.ExternalStorage
+	ctx     context.Context
+	limiter *throttle.Limiter
+	// rows is the number of rows read since the last observed delivery.
+	rows *atomic.Int64
+}
+
+func newThrottledStorage(ctx context.Context, s storage.ExternalStorage, limiter *throttle.Limiter, rows *atomic.Int64) storage.ExternalStorage {
+	return &throttledStorage{
+		ExternalStorage: s,
+		ctx:             ctx,
+		limiter:         limiter,
+		rows:            rows,
+	}
+}
+
+// Open implements ExternalStorage.Open.
+func (s *throttledStorage) Open(ctx context.Context, path string, option *storage.ReaderOption) (storage.ExternalFileReader, error) {
+	r, err := s.ExternalStorage.Open(ctx, path, option)
+	if err != nil {
+		return nil, err
+	}
+	return &throttledReader{
+		ExternalFileReader: r,
+		ctx:                s.ctx,
+		limiter:            s.limiter,
+		counter:            newRowCounter(path),
+		rows:               s.rows,
+	}, nil
+}
+
+type throttledReader struct {
+	storage.ExternalFileReader
+	ctx     context.Context
+	limiter *throttle.Limiter
+	counter *rowCounter
+	rows    *atomic.Int64
+}
+
+// Read implements io.Reader.
+func (r *throttledReader) Read(p []byte) (int, error) {
+	n, err := r.ExternalFileReader.Read(p)
+	if n > 0 {
+		rows := r.counter.count(p[:n])
+		r.rows.Add(int64(rows))
+		if err2 := r.limiter.Wait(r.ctx, rows, n); err2 != nil {
+			return n, err2
+		}
+	}
+	return n, err
+}
+
+type rowFormat int
+
+const (
+	rowFormatNone rowFormat = iota
+	rowFormatSQL
+	rowFormatCSV
+)
+
+// rowCounter counts the rows in a dump file which is read in pieces. quoted strings may
+// contain newlines, and an extended INSERT statement contains many rows, so the rows are
+// counted by the end of value tuples for SQL files and the end of records for CSV files,
+// outside quoted strings.
+type rowCounter struct {
+	format rowFormat
+	// quote is the quote character of the current quoted string, 0 if not in a quoted string.
+	quote byte
+	// escaped is true if the previous character is a backslash in a quoted string.
+	escaped bool
+	// depth is the parentheses depth of SQL files.
+	depth int
+	// closed is true if a parenthesis is closed to depth 0 and the next non-space character
+	// is not met yet, the tuple is a row if it's followed by ',' or ';'.
+	closed bool
+}
+
+func newRowCounter(path string) *rowCounter {
+	format := rowFormatNone
+	switch {
+	case strings.HasSuffix(path, "-schema.sql"), strings.HasSuffix(path, "-schema-create.sql"),
+		strings.HasSuffix(path, "-schema-view.sql"), strings.HasSuffix(path, "-schema-trigger.sql"),
+		strings.HasSuffix(path, "-schema-post.sql"):
+		// schema files contain no rows.
+	case strings.HasSuffix(path, ".sql"):
+		format = rowFormatSQL
+	case strings.HasSuffix(path, ".csv"):
+		format = rowFormatCSV
+	}
+	return &rowCounter{format: format}
+}
+
+// count returns the number of rows ended in data.
+func (c *rowCounter) count(data []byte) int {
+	if c.format == rowFormatNone {
+		return 0
+	}
+	rows := 0
+	for _, b := range data {
+		if c.quote != 0 {
+			switch {
+			case c.escaped:
+				c.escaped = false
+			case b == '\\':
+				c.escaped = true
+			case b == c.quote:
+				// a doubled quote is closed and opened again, which makes no difference.
+				c.quote = 0
+			}
+			continue
+		}
+		if c.format == rowFormatCSV {
+			switch b {
+			case '"':
+				c.quote = b
+			case '\n':
+				rows++
+			}
+			continue
+		}
+
+		if c.closed {
+			switch b {
+			case ' ', '\t', '\r', '\n':
+				continue
+			case ',', ';':
+				rows++
+			}
+			c.closed = false
+		}
+		switch b {
+		case '\'', '"', '`':
+			c.quote = b
+		case '(':
+			c.depth++
+		case ')':
+			if c.depth > 0 {
+				c.depth--
+				c.closed = c.depth == 0
+			}
+		}
+	}
+	return rows
+}
+
+// throttledFactory wraps the prometheus factory of lightning, so the duration of delivering
+// each block to the downstream is observed by the limiter for adaptive throttling.
+type throttledFactory struct {
+	promutil.Factory
+	limiter *throttle.Limiter
+	rows    *atomic.Int64
+}
+
+func newThrottledFactory(f promutil.Factory, limiter *throttle.Limiter, rows *atomic.Int64) promutil.Factory {
+	return &throttledFactory{Factory: f, limiter: limiter, rows: rows}
+}
+
+// NewHistogram implements Factory.NewHistogram.
+func (f *throttledFactory) NewHistogram(opts prometheus.HistogramOpts) prometheus.Histogram {
+	h := f.Factory.NewHistogram(opts)
+	if opts.Name != blockDeliverSecondsName {
+		return h
+	}
+	return &deliverHistogram{Histogram: h, limiter: f.limiter, rows: f.rows}
+}
+
+type deliverHistogram struct {
+	prometheus.Histogram
+	limiter *throttle.Limiter
+	rows    *atomic.Int64
+}
+
+// Observe implements prometheus.Observer.
+func (h *deliverHistogram) Observe(seconds float64) {
+	h.Histogram.Observe(seconds)
+	h.limiter.Observe(time.Duration(seconds*float64(time.Second)), int(h.rows.Swap(0)))
+}
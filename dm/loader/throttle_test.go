@@ -0,0 +1,106 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/throttle"
+	"github.com/pingcap/tiflow/engine/pkg/promutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestThrottledStorage(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.CreateStorage(ctx, t.TempDir())
+	require.NoError(t, err)
+	data := []byte("INSERT INTO `t` VALUES\n(1),\n(2);\n")
+	require.NoError(t, s.WriteFile(ctx, "db.t.0000000000000.sql", data))
+
+	limiter := throttle.NewLimiter(log.L(), 0, 0, 0)
+	rows := atomic.NewInt64(0)
+	s = newThrottledStorage(ctx, s, limiter, rows)
+	r, err := s.Open(ctx, "db.t.0000000000000.sql", nil)
+	require.NoError(t, err)
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, content)
+	require.NoError(t, r.Close())
+	require.Equal(t, int64(2), rows.Load())
+
+	// reading is blocked by the limits until the context is canceled
+	limiter.Update(1, 0, 0)
+	ctx2, cancel := context.WithCancel(ctx)
+	cancel()
+	s.(*throttledStorage).ctx = ctx2
+	r, err = s.Open(ctx, "db.t.0000000000000.sql", nil)
+	require.NoError(t, err)
+	_, err = io.ReadAll(r)
+	require.ErrorIs(t, err, context.Canceled)
+	require.NoError(t, r.Close())
+}
+
+func TestRowCounter(t *testing.T) {
+	cases := []struct {
+		path   string
+		chunks []string
+		rows   int
+	}{
+		// extended INSERT with a column list
+		{"db.t.000000000.sql", []string{"INSERT INTO `t` (`a`,`b`) VALUES\n(1,'a'),\n(2,'b');\n"}, 2},
+		// quoted strings contain newlines, parentheses and quotes, and are split into pieces
+		{"db.t.000000000.sql", []string{"INSERT INTO `t` VALUES (1,'a\n(b),\n'", "),(2,'it''s \\\\'); ", "", "(3", ",\"x);\")\n", ";"}, 3},
+		// nested parentheses in values
+		{"db.t.000000000.sql", []string{"INSERT INTO `t` VALUES (1,POINT(1,2)),(2,POINT(3,4))", ";"}, 2},
+		{"db.t.000000000.csv", []string{"1,\"a\nb\"\n2,\"c\"\"\n", "d\"\n3,e\n"}, 3},
+		// schema and compressed files are not counted
+		{"db.t-schema.sql", []string{"CREATE TABLE `t` (`a` int);\n"}, 0},
+		{"db.t.000000000.sql.gz", []string{"(1),(2);"}, 0},
+	}
+	for _, cs := range cases {
+		c := newRowCounter(cs.path)
+		rows := 0
+		for _, chunk := range cs.chunks {
+			rows += c.count([]byte(chunk))
+		}
+		require.Equal(t, cs.rows, rows, cs.path, cs.chunks)
+	}
+}
+
+func TestThrottledFactory(t *testing.T) {
+	limiter := throttle.NewLimiter(log.L(), 0, 0, time.Millisecond)
+	rows := atomic.NewInt64(0)
+	f := newThrottledFactory(promutil.NewPromFactory(), limiter, rows)
+
+	other := f.NewHistogram(prometheus.HistogramOpts{Name: "row_read_seconds"})
+	_, ok := other.(*deliverHistogram)
+	require.False(t, ok)
+
+	h := f.NewHistogram(prometheus.HistogramOpts{Name: blockDeliverSecondsName})
+	rows.Store(100)
+	h.Observe(1)
+	require.Equal(t, int64(0), rows.Load())
+	// the rows limit is lowered after enough slow deliveries
+	for i := 0; i < 10000; i++ {
+		h.Observe(1)
+	}
+	require.Greater(t, limiter.RowsLimit(), float64(0))
+}
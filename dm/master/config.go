@@ -38,6 +38,7 @@ import (
 
 const (
 	defaultRPCTimeout              = "30s"
+	defaultRebalanceInterval       = "5m"
 	defaultNamePrefix              = "dm-master"
 	defaultDataDirPrefix           = "default"
 	defaultPeerUrls                = "http://127.0.0.1:8291"
@@ -112,6 +113,10 @@ type Config struct {
 	RPCRateLimit  float64       `toml:"rpc-rate-limit" json:"rpc-rate-limit"`
 	RPCRateBurst  int           `toml:"rpc-rate-burst" json:"rpc-rate-burst"`
 
+	// interval of moving sources off overloaded or placement-violating DM-workers, "0s" disables it
+	RebalanceIntervalStr string        `toml:"rebalance-interval" json:"rebalance-interval"`
+	RebalanceInterval    time.Duration `toml:"-" json:"-"`
+
 	MasterAddr    string `toml:"master-addr" json:"master-addr"`
 	AdvertiseAddr string `toml:"advertise-addr" json:"advertise-addr"`
 
@@ -277,6 +282,15 @@ func (c *Config) adjust() error {
 	}
 	c.RPCTimeout = timeout
 
+	if c.RebalanceIntervalStr == "" {
+		c.RebalanceIntervalStr = defaultRebalanceInterval
+	}
+	interval, err := time.ParseDuration(c.RebalanceIntervalStr)
+	if err != nil {
+		return terror.ErrMasterConfigRebalanceIntervalParse.Delegate(err)
+	}
+	c.RebalanceInterval = interval
+
 	// for backward compatibility
 	if c.RPCRateLimit <= 0 {
 		log.L().Warn("invalid rpc-rate-limit, default value used", zap.Float64("specified rpc-rate-limit", c.RPCRateLimit), zap.Float64("default rpc-rate-limit", DefaultRate))
@@ -0,0 +1,787 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/master/workerrpc"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+)
+
+// cutoverCheckInterval is the interval of driving the cutover workflows.
+var cutoverCheckInterval = 5 * time.Second
+
+// cutoverOperator is the operations used by cutoverKeeper, it's implemented by Server.
+type cutoverOperator interface {
+	loadCutovers() (map[string]*ha.Cutover, error)
+	putCutover(c *ha.Cutover) error
+	deleteCutover(task string) error
+	// taskSources returns the sources of the subtasks of the task.
+	taskSources(task string) []string
+	sourceCfg(source string) *config.SourceConfig
+	// upstreamReadOnly returns `read_only` and `super_read_only` of the source.
+	upstreamReadOnly(ctx context.Context, source string) (readOnly, superReadOnly bool, err error)
+	setUpstreamReadOnly(ctx context.Context, source string, readOnly, superReadOnly bool) error
+	upstreamLocation(ctx context.Context, source string) (binlog.Location, error)
+	subTaskStatus(ctx context.Context, task, source string) (*pb.SubTaskStatus, error)
+	validationStatus(ctx context.Context, task, source string) (*pb.ValidationStatus, error)
+	// updateValidation sets the cutover location of the validator, the validator and the syncer flush their
+	// checkpoints when reaching it.
+	updateValidation(ctx context.Context, task, source, binlogPos, binlogGTID string) error
+	// startSnapshotCheck starts a snapshot check of all tables on the validator.
+	startSnapshotCheck(ctx context.Context, task, source string) error
+}
+
+// cutoverKeeper drives the cutover workflows of tasks step by step, it runs on the leader.
+// The state of a workflow is persisted in etcd after every change, so the new leader can resume it. Every step
+// can be executed again after failover:
+//   - fence: set the sources `super_read_only` if needed, then record the locations of them as the cutover locations.
+//   - wait-sync: wait until the syncers flush their checkpoints to the cutover locations.
+//   - validate: start a snapshot check on the validators, and wait until it finishes without error rows.
+//   - record: record the flushed locations of the syncers as the final locations.
+//
+// A failed workflow is kept at the failed step until it's started again or rolled back. Rolling back restores
+// the `read_only` and `super_read_only` of the fenced sources, and removes the workflow. The workflow is also
+// removed with the task.
+type cutoverKeeper struct {
+	logger log.Logger
+	ops    cutoverOperator
+	now    func() time.Time
+
+	// mu serializes the steps and the operations of users.
+	mu       sync.Mutex
+	cutovers map[string]*ha.Cutover // nil if not loaded from etcd yet
+
+	notifyCh chan struct{}
+	wg       sync.WaitGroup
+	cancel   context.CancelFunc
+}
+
+func newCutoverKeeper(pLogger *log.Logger, ops cutoverOperator) *cutoverKeeper {
+	return &cutoverKeeper{
+		logger:   pLogger.WithFields(zap.String("component", "cutover")),
+		ops:      ops,
+		now:      time.Now,
+		notifyCh: make(chan struct{}, 1),
+	}
+}
+
+// Start loads the cutover workflows from etcd and drives them in the background.
+func (k *cutoverKeeper) Start(pCtx context.Context) {
+	k.Close()
+
+	ctx, cancel := context.WithCancel(pCtx)
+	k.cancel = cancel
+	k.wg.Add(1)
+	go func() {
+		defer k.wg.Done()
+		ticker := time.NewTicker(cutoverCheckInterval)
+		defer ticker.Stop()
+		for {
+			k.check(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-k.notifyCh:
+			}
+		}
+	}()
+	k.logger.Info("cutover keeper has started")
+}
+
+// Close stops driving the cutover workflows.
+func (k *cutoverKeeper) Close() {
+	if k.cancel == nil {
+		return
+	}
+	k.cancel()
+	k.wg.Wait()
+	k.cancel = nil
+
+	k.mu.Lock()
+	k.cutovers = nil
+	k.mu.Unlock()
+	k.logger.Info("cutover keeper has closed")
+}
+
+func (k *cutoverKeeper) notify() {
+	select {
+	case k.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// check drives all cutover workflows once.
+func (k *cutoverKeeper) check(ctx context.Context) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if err := k.loadIfNeeded(); err != nil {
+		k.logger.Warn("fail to load cutovers", zap.Error(err))
+		return
+	}
+	for _, task := range sortedKeys(k.cutovers) {
+		// the task may be removed when the keeper is not running, or by removing its sources.
+		if len(k.ops.taskSources(task)) == 0 {
+			if err := k.removeCutover(task); err != nil {
+				k.logger.Warn("fail to remove cutover of the removed task", zap.String("task", task), zap.Error(err))
+			}
+			continue
+		}
+		k.advance(ctx, k.cutovers[task])
+	}
+}
+
+func (k *cutoverKeeper) loadIfNeeded() error {
+	if k.cutovers != nil {
+		return nil
+	}
+	cutovers, err := k.ops.loadCutovers()
+	if err != nil {
+		return err
+	}
+	k.cutovers = cutovers
+	return nil
+}
+
+// advance executes the steps of the workflow until it's blocked, failed or finished.
+func (k *cutoverKeeper) advance(ctx context.Context, c *ha.Cutover) {
+	for !c.Failed && c.Step != pb.CutoverStep_CutoverFinished {
+		before := c.String()
+		var (
+			done bool
+			err  error
+		)
+		switch c.Step {
+		case pb.CutoverStep_CutoverFence:
+			done, err = k.fence(ctx, c)
+		case pb.CutoverStep_CutoverWaitSync:
+			done, err = k.waitSync(ctx, c)
+		case pb.CutoverStep_CutoverValidate:
+			done, err = k.validate(ctx, c)
+		case pb.CutoverStep_CutoverRecord:
+			done, err = k.record(ctx, c)
+		default:
+			err = terror.ErrMasterCutoverInProgress.Generate(c.Task, "at an unknown step "+c.Step.String())
+		}
+
+		switch {
+		case err != nil:
+			c.Failed = true
+			c.Msg = err.Error()
+			k.logger.Warn("cutover failed", zap.String("task", c.Task), zap.Stringer("step", c.Step), zap.Error(err))
+		case done:
+			prevStep := c.Step
+			c.Step = nextCutoverStep(c)
+			c.Msg = ""
+			k.logger.Info("cutover step finished", zap.String("task", c.Task), zap.Stringer("step", prevStep), zap.Stringer("next step", c.Step))
+		}
+		if c.String() != before {
+			c.UpdateTime = k.now()
+			if err2 := k.ops.putCutover(c); err2 != nil {
+				k.logger.Warn("fail to save cutover", zap.String("task", c.Task), zap.Error(err2))
+			}
+		}
+		if !done {
+			return
+		}
+	}
+}
+
+func nextCutoverStep(c *ha.Cutover) pb.CutoverStep {
+	switch c.Step {
+	case pb.CutoverStep_CutoverFence:
+		return pb.CutoverStep_CutoverWaitSync
+	case pb.CutoverStep_CutoverWaitSync:
+		if c.SkipValidation {
+			return pb.CutoverStep_CutoverRecord
+		}
+		return pb.CutoverStep_CutoverValidate
+	case pb.CutoverStep_CutoverValidate:
+		return pb.CutoverStep_CutoverRecord
+	default:
+		return pb.CutoverStep_CutoverFinished
+	}
+}
+
+// fence sets the sources read-only if needed, and records their locations as the cutover locations.
+func (k *cutoverKeeper) fence(ctx context.Context, c *ha.Cutover) (bool, error) {
+	sources := sortedKeys(c.Sources)
+	// check the validators before fencing the sources, to avoid blocking the writes for nothing.
+	if !c.SkipValidation {
+		for _, source := range sources {
+			if c.Sources[source].Fenced {
+				continue
+			}
+			status, err := k.ops.validationStatus(ctx, c.Task, source)
+			if err != nil {
+				return false, terror.ErrMasterCutoverValidation.Generate(source, err.Error())
+			}
+			if status.Stage != pb.Stage_Running {
+				return false, terror.ErrMasterCutoverValidation.Generate(source, "validator is "+status.Stage.String())
+			}
+		}
+	}
+
+	for _, source := range sources {
+		src := c.Sources[source]
+		if src.Fenced {
+			continue
+		}
+		if c.FenceWrites {
+			if src.ReadOnlyBefore == nil {
+				readOnly, superReadOnly, err := k.ops.upstreamReadOnly(ctx, source)
+				if err != nil {
+					return false, err
+				}
+				src.ReadOnlyBefore = &readOnly
+				src.SuperReadOnlyBefore = superReadOnly
+				// save it before changing the source, so it can be restored by rolling back.
+				if err = k.ops.putCutover(c); err != nil {
+					return false, err
+				}
+			}
+			if err := k.ops.setUpstreamReadOnly(ctx, source, true, true); err != nil {
+				return false, err
+			}
+		}
+		loc, err := k.ops.upstreamLocation(ctx, source)
+		if err != nil {
+			return false, err
+		}
+		src.CutoverBinlogPos = loc.Position.String()
+		src.CutoverBinlogGTID = loc.GTIDSetStr()
+		if !c.SkipValidation {
+			if err = k.ops.updateValidation(ctx, c.Task, source, src.CutoverBinlogPos, src.CutoverBinlogGTID); err != nil {
+				return false, err
+			}
+		}
+		src.Fenced = true
+		k.logger.Info("source is fenced for cutover", zap.String("task", c.Task), zap.String("source", source),
+			zap.Bool("read-only", c.FenceWrites), zap.Stringer("location", loc))
+	}
+	return true, nil
+}
+
+// waitSync checks whether all syncers have flushed their checkpoints to the cutover locations.
+func (k *cutoverKeeper) waitSync(ctx context.Context, c *ha.Cutover) (bool, error) {
+	for _, source := range sortedKeys(c.Sources) {
+		src := c.Sources[source]
+		if src.Synced {
+			continue
+		}
+		status, err := k.ops.subTaskStatus(ctx, c.Task, source)
+		if err != nil {
+			src.Msg = err.Error()
+			continue
+		}
+		syncStatus := status.GetSync()
+		if status.Unit != pb.UnitType_Sync || syncStatus == nil {
+			src.Msg = fmt.Sprintf("subtask is %s in %s unit", status.Stage, status.Unit)
+			continue
+		}
+		src.SyncerBinlog, src.SyncerBinlogGTID = syncStatus.SyncerBinlog, syncStatus.SyncerBinlogGtid
+		reached, err := k.reachCutoverLocation(source, src, syncStatus.SyncerBinlog, syncStatus.SyncerBinlogGtid)
+		if err != nil {
+			return false, err
+		}
+		if !reached {
+			src.Msg = fmt.Sprintf("subtask is %s, syncer hasn't reached the cutover location", status.Stage)
+			continue
+		}
+		src.Synced = true
+		src.Msg = ""
+	}
+	for _, src := range c.Sources {
+		if !src.Synced {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// validate runs a snapshot check on the validators after the syncers reach the cutover locations, and checks
+// whether it finishes without error rows. The snapshot check compares all rows of upstream and downstream, so the
+// rows loaded wrong or changed in downstream are found too.
+func (k *cutoverKeeper) validate(ctx context.Context, c *ha.Cutover) (bool, error) {
+	for _, source := range sortedKeys(c.Sources) {
+		src := c.Sources[source]
+		if src.Validated {
+			continue
+		}
+		status, err := k.ops.validationStatus(ctx, c.Task, source)
+		if err != nil {
+			src.Msg = err.Error()
+			continue
+		}
+		if status.Stage != pb.Stage_Running {
+			return false, terror.ErrMasterCutoverValidation.Generate(source, "validator is "+status.Stage.String())
+		}
+		// the snapshot check finished before is not at the cutover location, and the stage is lost if the validator
+		// is restarted, start it again in both cases.
+		if !src.SnapshotCheckStarted || status.SnapshotCheckStage == pb.Stage_InvalidStage {
+			if err = k.ops.startSnapshotCheck(ctx, c.Task, source); err != nil {
+				src.Msg = err.Error()
+				continue
+			}
+			src.SnapshotCheckStarted = true
+			src.Msg = "snapshot check is started"
+			k.logger.Info("snapshot check is started for cutover", zap.String("task", c.Task), zap.String("source", source))
+			continue
+		}
+		switch status.SnapshotCheckStage {
+		case pb.Stage_Running:
+			src.Msg = "snapshot check is running"
+			continue
+		case pb.Stage_Finished:
+			if status.SnapshotCheckMsg != "" {
+				return false, terror.ErrMasterCutoverValidation.Generate(source, status.SnapshotCheckMsg)
+			}
+		default:
+			return false, terror.ErrMasterCutoverValidation.Generate(source,
+				fmt.Sprintf("snapshot check is %s: %s", status.SnapshotCheckStage, status.SnapshotCheckMsg))
+		}
+		// the validator fails to load the error rows from the meta db, check it next time.
+		if status.ErrorRowCount == nil {
+			src.Msg = "fail to get error rows of validator: " + status.ErrorRowsStatus
+			continue
+		}
+		if status.ErrorRowCount.NewRows > 0 {
+			return false, terror.ErrMasterCutoverValidation.Generate(source,
+				fmt.Sprintf("validator has %d error rows", status.ErrorRowCount.NewRows))
+		}
+		src.Validated = true
+		src.Msg = ""
+	}
+	for _, src := range c.Sources {
+		if !src.Validated {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// record records the flushed locations of the syncers as the final locations.
+func (k *cutoverKeeper) record(ctx context.Context, c *ha.Cutover) (bool, error) {
+	for _, source := range sortedKeys(c.Sources) {
+		src := c.Sources[source]
+		status, err := k.ops.subTaskStatus(ctx, c.Task, source)
+		if err != nil {
+			src.Msg = err.Error()
+			return false, nil
+		}
+		syncStatus := status.GetSync()
+		if syncStatus == nil {
+			src.Msg = fmt.Sprintf("subtask is %s in %s unit", status.Stage, status.Unit)
+			return false, nil
+		}
+		src.FinalBinlogPos, src.FinalBinlogGTID = syncStatus.SyncerBinlog, syncStatus.SyncerBinlogGtid
+		src.Msg = ""
+	}
+	return true, nil
+}
+
+// reachCutoverLocation checks whether the location of the syncer or the validator reaches the cutover location.
+func (k *cutoverKeeper) reachCutoverLocation(source string, src *ha.CutoverSource, binlogPos, binlogGTID string) (bool, error) {
+	cfg := k.ops.sourceCfg(source)
+	if cfg == nil {
+		return false, terror.ErrSchedulerSourceCfgNotExist.Generate(source)
+	}
+	if cfg.EnableGTID {
+		if binlogGTID == "" {
+			return false, nil
+		}
+		gs, err := gtid.ParserGTID(cfg.Flavor, binlogGTID)
+		if err != nil {
+			return false, err
+		}
+		cutoverGS, err := gtid.ParserGTID(cfg.Flavor, src.CutoverBinlogGTID)
+		if err != nil {
+			return false, err
+		}
+		return gs.Contain(cutoverGS), nil
+	}
+	if binlogPos == "" {
+		return false, nil
+	}
+	pos, err := binlog.PositionFromPosStr(binlogPos)
+	if err != nil {
+		return false, err
+	}
+	cutoverPos, err := binlog.PositionFromPosStr(src.CutoverBinlogPos)
+	if err != nil {
+		return false, err
+	}
+	return binlog.ComparePosition(pos, cutoverPos) >= 0, nil
+}
+
+// StartCutover starts the cutover workflow of the task, or resumes the failed one.
+func (k *cutoverKeeper) StartCutover(task string, fenceWrites, skipValidation bool) (*pb.CutoverStatus, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if err := k.loadIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	if c, ok := k.cutovers[task]; ok {
+		switch {
+		case c.Failed:
+			c.Failed = false
+			c.Msg = ""
+			c.UpdateTime = k.now()
+			if err := k.ops.putCutover(c); err != nil {
+				return nil, err
+			}
+			k.logger.Info("resume cutover", zap.String("task", task), zap.Stringer("step", c.Step))
+			k.notify()
+			return cutoverToPB(c), nil
+		case c.Step == pb.CutoverStep_CutoverFinished:
+			return nil, terror.ErrMasterCutoverInProgress.Generate(task, "finished")
+		default:
+			return nil, terror.ErrMasterCutoverInProgress.Generate(task, "in progress")
+		}
+	}
+
+	sources := k.ops.taskSources(task)
+	if len(sources) == 0 {
+		return nil, terror.ErrSchedulerTaskNotExist.Generate(task)
+	}
+	c := ha.NewCutover(task, sources, fenceWrites, skipValidation, k.now())
+	if err := k.ops.putCutover(c); err != nil {
+		return nil, err
+	}
+	k.cutovers[task] = c
+	k.logger.Info("start cutover", zap.String("task", task), zap.Strings("sources", sources),
+		zap.Bool("fence writes", fenceWrites), zap.Bool("skip validation", skipValidation))
+	k.notify()
+	return cutoverToPB(c), nil
+}
+
+// QueryCutover returns the status of the cutover workflow of the task.
+func (k *cutoverKeeper) QueryCutover(task string) (*pb.CutoverStatus, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if err := k.loadIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	c, ok := k.cutovers[task]
+	if !ok {
+		return nil, terror.ErrMasterCutoverNotExist.Generate(task)
+	}
+	return cutoverToPB(c), nil
+}
+
+// RollbackCutover restores the `read_only` and `super_read_only` of the fenced sources and removes the cutover
+// workflow of the task.
+// If some sources fail to be restored, the workflow is kept as failed so it can be rolled back again.
+func (k *cutoverKeeper) RollbackCutover(ctx context.Context, task string) (*pb.CutoverStatus, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if err := k.loadIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	c, ok := k.cutovers[task]
+	if !ok {
+		return nil, terror.ErrMasterCutoverNotExist.Generate(task)
+	}
+	var firstErr error
+	for _, source := range sortedKeys(c.Sources) {
+		src := c.Sources[source]
+		if src.ReadOnlyBefore != nil && !src.SuperReadOnlyBefore {
+			if err := k.ops.setUpstreamReadOnly(ctx, source, *src.ReadOnlyBefore, false); err != nil {
+				src.Msg = err.Error()
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			k.logger.Info("source is unfenced by rolling back cutover", zap.String("task", task), zap.String("source", source))
+		}
+		src.ReadOnlyBefore = nil
+		src.Fenced = false
+		src.Msg = ""
+	}
+	c.UpdateTime = k.now()
+	if firstErr != nil {
+		c.Failed = true
+		c.Msg = "fail to roll back: " + firstErr.Error()
+		if err := k.ops.putCutover(c); err != nil {
+			k.logger.Warn("fail to save cutover", zap.String("task", task), zap.Error(err))
+		}
+		return cutoverToPB(c), firstErr
+	}
+
+	if err := k.ops.deleteCutover(task); err != nil {
+		return nil, err
+	}
+	delete(k.cutovers, task)
+	k.logger.Info("cutover is rolled back", zap.String("task", task), zap.Stringer("step", c.Step))
+	return cutoverToPB(c), nil
+}
+
+// RemoveCutover removes the cutover workflow of the removed task. The fenced sources are kept read-only, since
+// the task may be removed after the cutover finishes.
+func (k *cutoverKeeper) RemoveCutover(task string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if err := k.loadIfNeeded(); err != nil {
+		return err
+	}
+	if _, ok := k.cutovers[task]; !ok {
+		return nil
+	}
+	return k.removeCutover(task)
+}
+
+func (k *cutoverKeeper) removeCutover(task string) error {
+	c := k.cutovers[task]
+	if err := k.ops.deleteCutover(task); err != nil {
+		return err
+	}
+	delete(k.cutovers, task)
+	fenced := make([]string, 0, len(c.Sources))
+	for _, source := range sortedKeys(c.Sources) {
+		if c.Sources[source].Fenced {
+			fenced = append(fenced, source)
+		}
+	}
+	k.logger.Info("cutover is removed with the task", zap.String("task", task), zap.Stringer("step", c.Step),
+		zap.Strings("fenced sources", fenced))
+	return nil
+}
+
+func cutoverToPB(c *ha.Cutover) *pb.CutoverStatus {
+	status := &pb.CutoverStatus{
+		Task:           c.Task,
+		Step:           c.Step,
+		Failed:         c.Failed,
+		Msg:            c.Msg,
+		FenceWrites:    c.FenceWrites,
+		SkipValidation: c.SkipValidation,
+		StartTime:      c.StartTime.Format(time.RFC3339),
+		UpdateTime:     c.UpdateTime.Format(time.RFC3339),
+		Sources:        make([]*pb.CutoverSourceStatus, 0, len(c.Sources)),
+	}
+	for _, source := range sortedKeys(c.Sources) {
+		src := c.Sources[source]
+		status.Sources = append(status.Sources, &pb.CutoverSourceStatus{
+			Source:            source,
+			Fenced:            src.Fenced,
+			CutoverBinlogPos:  src.CutoverBinlogPos,
+			CutoverBinlogGtid: src.CutoverBinlogGTID,
+			SyncerBinlog:      src.SyncerBinlog,
+			SyncerBinlogGtid:  src.SyncerBinlogGTID,
+			Synced:            src.Synced,
+			Validated:         src.Validated,
+			FinalBinlogPos:    src.FinalBinlogPos,
+			FinalBinlogGtid:   src.FinalBinlogGTID,
+			Msg:               src.Msg,
+		})
+	}
+	return status
+}
+
+// loadCutovers implements cutoverOperator.
+func (s *Server) loadCutovers() (map[string]*ha.Cutover, error) {
+	return ha.GetAllCutovers(s.etcdClient)
+}
+
+// putCutover implements cutoverOperator.
+func (s *Server) putCutover(c *ha.Cutover) error {
+	return ha.PutCutover(s.etcdClient, c)
+}
+
+// deleteCutover implements cutoverOperator.
+func (s *Server) deleteCutover(task string) error {
+	return ha.DeleteCutover(s.etcdClient, task)
+}
+
+// taskSources implements cutoverOperator.
+func (s *Server) taskSources(task string) []string {
+	return s.getSubTaskSourcesByTaskAndSource(task, nil)
+}
+
+// sourceCfg implements cutoverOperator.
+func (s *Server) sourceCfg(source string) *config.SourceConfig {
+	return s.scheduler.GetSourceCfgByID(source)
+}
+
+func (s *Server) withUpstreamDB(ctx context.Context, source string, fn func(tctx *tcontext.Context, db *conn.BaseDB, cfg *config.SourceConfig) error) error {
+	cfg := s.scheduler.GetSourceCfgByID(source)
+	if cfg == nil {
+		return terror.ErrSchedulerSourceCfgNotExist.Generate(source)
+	}
+	db, err := conn.GetUpstreamDB(cfg.GenerateDBConfig())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return fn(tcontext.NewContext(ctx, log.L()), db, cfg)
+}
+
+// upstreamReadOnly implements cutoverOperator.
+func (s *Server) upstreamReadOnly(ctx context.Context, source string) (readOnly, superReadOnly bool, err error) {
+	err = s.withUpstreamDB(ctx, source, func(tctx *tcontext.Context, db *conn.BaseDB, _ *config.SourceConfig) error {
+		value, err2 := conn.GetGlobalVariable(tctx, db, "read_only")
+		if err2 != nil {
+			return err2
+		}
+		readOnly = value == "ON" || value == "1"
+		value, err2 = conn.GetGlobalVariable(tctx, db, "super_read_only")
+		superReadOnly = value == "ON" || value == "1"
+		return err2
+	})
+	return readOnly, superReadOnly, err
+}
+
+// setUpstreamReadOnly implements cutoverOperator.
+func (s *Server) setUpstreamReadOnly(ctx context.Context, source string, readOnly, superReadOnly bool) error {
+	// `read_only` doesn't block the users with SUPER privilege, so the sources are fenced by `super_read_only`.
+	// enabling `super_read_only` enables `read_only` too, and disabling `read_only` disables `super_read_only` too.
+	var query string
+	switch {
+	case superReadOnly:
+		query = "SET GLOBAL super_read_only = ON"
+	case readOnly:
+		query = "SET GLOBAL super_read_only = OFF"
+	default:
+		query = "SET GLOBAL read_only = OFF"
+	}
+	return s.withUpstreamDB(ctx, source, func(tctx *tcontext.Context, db *conn.BaseDB, _ *config.SourceConfig) error {
+		// need SUPER or SYSTEM_VARIABLES_ADMIN privilege
+		if _, err := db.ExecContext(tctx, query); err != nil {
+			return terror.DBErrorAdapt(err, db.Scope, terror.ErrDBDriverError)
+		}
+		return nil
+	})
+}
+
+// upstreamLocation implements cutoverOperator.
+func (s *Server) upstreamLocation(ctx context.Context, source string) (binlog.Location, error) {
+	var loc binlog.Location
+	err := s.withUpstreamDB(ctx, source, func(tctx *tcontext.Context, db *conn.BaseDB, cfg *config.SourceConfig) error {
+		pos, gs, err := conn.GetPosAndGs(tctx, db, cfg.Flavor)
+		if err != nil {
+			return err
+		}
+		if !cfg.EnableGTID {
+			gs = nil
+		}
+		loc = binlog.NewLocation(pos, gs)
+		return nil
+	})
+	return loc, err
+}
+
+// subTaskStatus implements cutoverOperator.
+func (s *Server) subTaskStatus(ctx context.Context, task, source string) (*pb.SubTaskStatus, error) {
+	for _, resp := range s.getStatusFromWorkers(ctx, []string{source}, task, false, false) {
+		if !resp.Result {
+			return nil, terror.ErrMasterOperRespNotSuccess.Generate(resp.Msg)
+		}
+		for _, st := range resp.SubTaskStatus {
+			if st.Name == task {
+				return st, nil
+			}
+		}
+	}
+	return nil, terror.ErrWorkerSubTaskNotFound.Generate(task)
+}
+
+// validationStatus implements cutoverOperator.
+func (s *Server) validationStatus(ctx context.Context, task, source string) (*pb.ValidationStatus, error) {
+	var (
+		workerResps  = make([]*pb.GetValidationStatusResponse, 0, 1)
+		workerRespMu sync.Mutex
+		wg           sync.WaitGroup
+	)
+	req := &workerrpc.Request{
+		Type:                workerrpc.CmdGetValidationStatus,
+		GetValidationStatus: &pb.GetValidationStatusRequest{TaskName: task},
+	}
+	sendValidationRequest(ctx, s, req, source, &wg, &workerRespMu, &workerResps, "get validation status")
+	wg.Wait()
+	for _, resp := range workerResps {
+		if !resp.Result {
+			return nil, terror.ErrMasterOperRespNotSuccess.Generate(resp.Msg)
+		}
+		for _, status := range resp.Validators {
+			if status.Task == task {
+				return status, nil
+			}
+		}
+	}
+	return nil, terror.ErrValidatorNotFound.Generate(task, source)
+}
+
+// updateValidation implements cutoverOperator.
+func (s *Server) updateValidation(ctx context.Context, task, source, binlogPos, binlogGTID string) error {
+	resp, err := s.UpdateValidation(ctx, &pb.UpdateValidationRequest{
+		TaskName:   task,
+		Sources:    []string{source},
+		BinlogPos:  binlogPos,
+		BinlogGTID: binlogGTID,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrMasterOperRespNotSuccess.Generate(resp.Msg)
+	}
+	for _, r := range resp.Sources {
+		if !r.Result {
+			return terror.ErrMasterOperRespNotSuccess.Generate(r.Msg)
+		}
+	}
+	return nil
+}
+
+// startSnapshotCheck implements cutoverOperator.
+func (s *Server) startSnapshotCheck(ctx context.Context, task, source string) error {
+	worker := s.scheduler.GetWorkerBySource(source)
+	if worker == nil {
+		return terror.ErrMasterOperRespNotSuccess.Generate(fmt.Sprintf("source %s relevant worker-client not found", source))
+	}
+	req := &workerrpc.Request{
+		Type: workerrpc.CmdUpdateValidation,
+		UpdateValidation: &pb.UpdateValidationWorkerRequest{
+			TaskName:      task,
+			SnapshotCheck: true,
+		},
+	}
+	resp, err := worker.SendRequest(ctx, req, s.cfg.RPCTimeout)
+	if err != nil {
+		return err
+	}
+	if !resp.UpdateValidation.Result {
+		return terror.ErrMasterOperRespNotSuccess.Generate(resp.UpdateValidation.Msg)
+	}
+	return nil
+}
@@ -0,0 +1,418 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCutoverOperator struct {
+	persisted     map[string]string
+	sources       map[string][]string
+	cfgs          map[string]*config.SourceConfig
+	readOnly      map[string]bool
+	superReadOnly map[string]bool
+	readOnlyErr   error
+	locations     map[string]binlog.Location
+	subTasks      map[string]*pb.SubTaskStatus
+	validations   map[string]*pb.ValidationStatus
+	updated       map[string]string
+	checked       map[string]int
+}
+
+func (m *mockCutoverOperator) loadCutovers() (map[string]*ha.Cutover, error) {
+	cutovers := make(map[string]*ha.Cutover, len(m.persisted))
+	for task, s := range m.persisted {
+		c := &ha.Cutover{}
+		if err := json.Unmarshal([]byte(s), c); err != nil {
+			return nil, err
+		}
+		cutovers[task] = c
+	}
+	return cutovers, nil
+}
+
+func (m *mockCutoverOperator) putCutover(c *ha.Cutover) error {
+	m.persisted[c.Task] = c.String()
+	return nil
+}
+
+func (m *mockCutoverOperator) deleteCutover(task string) error {
+	delete(m.persisted, task)
+	return nil
+}
+
+func (m *mockCutoverOperator) taskSources(task string) []string {
+	return m.sources[task]
+}
+
+func (m *mockCutoverOperator) sourceCfg(source string) *config.SourceConfig {
+	return m.cfgs[source]
+}
+
+func (m *mockCutoverOperator) upstreamReadOnly(ctx context.Context, source string) (bool, bool, error) {
+	return m.readOnly[source], m.superReadOnly[source], nil
+}
+
+func (m *mockCutoverOperator) setUpstreamReadOnly(ctx context.Context, source string, readOnly, superReadOnly bool) error {
+	if m.readOnlyErr != nil {
+		return m.readOnlyErr
+	}
+	m.readOnly[source] = readOnly || superReadOnly
+	m.superReadOnly[source] = superReadOnly
+	return nil
+}
+
+func (m *mockCutoverOperator) upstreamLocation(ctx context.Context, source string) (binlog.Location, error) {
+	return m.locations[source], nil
+}
+
+func (m *mockCutoverOperator) subTaskStatus(ctx context.Context, task, source string) (*pb.SubTaskStatus, error) {
+	if status, ok := m.subTasks[source]; ok {
+		return status, nil
+	}
+	return nil, errors.New("subtask not found")
+}
+
+func (m *mockCutoverOperator) validationStatus(ctx context.Context, task, source string) (*pb.ValidationStatus, error) {
+	if status, ok := m.validations[source]; ok {
+		return status, nil
+	}
+	return nil, terror.ErrValidatorNotFound.Generate(task, source)
+}
+
+func (m *mockCutoverOperator) updateValidation(ctx context.Context, task, source, binlogPos, binlogGTID string) error {
+	m.updated[source] = binlogPos + binlogGTID
+	return nil
+}
+
+func (m *mockCutoverOperator) startSnapshotCheck(ctx context.Context, task, source string) error {
+	status, ok := m.validations[source]
+	if !ok {
+		return terror.ErrValidatorNotFound.Generate(task, source)
+	}
+	status.SnapshotCheckStage = pb.Stage_Running
+	m.checked[source]++
+	return nil
+}
+
+func syncingSubTask(binlogPos, binlogGTID string) *pb.SubTaskStatus {
+	return &pb.SubTaskStatus{
+		Stage: pb.Stage_Running,
+		Unit:  pb.UnitType_Sync,
+		Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{
+			SyncerBinlog:     binlogPos,
+			SyncerBinlogGtid: binlogGTID,
+		}},
+	}
+}
+
+func runningValidator(snapshotCheckStage pb.Stage, errorRowCount *pb.ValidationErrorRowCount) *pb.ValidationStatus {
+	return &pb.ValidationStatus{
+		Stage:              pb.Stage_Running,
+		SnapshotCheckStage: snapshotCheckStage,
+		ErrorRowCount:      errorRowCount,
+	}
+}
+
+func TestCutoverKeeper(t *testing.T) {
+	fencedGTID := "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14"
+	gs, err := gtid.ParserGTID(gmysql.MySQLFlavor, fencedGTID)
+	require.NoError(t, err)
+	ops := &mockCutoverOperator{
+		persisted: map[string]string{},
+		sources:   map[string][]string{"task": {"source1", "source2"}},
+		cfgs: map[string]*config.SourceConfig{
+			"source1": {Flavor: gmysql.MySQLFlavor},
+			"source2": {Flavor: gmysql.MySQLFlavor, EnableGTID: true},
+		},
+		readOnly:      map[string]bool{"source2": true},
+		superReadOnly: map[string]bool{},
+		locations: map[string]binlog.Location{
+			"source1": binlog.NewLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil),
+			"source2": binlog.NewLocation(gmysql.Position{Name: "mysql-bin.000003", Pos: 4}, gs),
+		},
+		subTasks: map[string]*pb.SubTaskStatus{},
+		validations: map[string]*pb.ValidationStatus{
+			"source1": {Stage: pb.Stage_Stopped},
+			"source2": {Stage: pb.Stage_Running},
+		},
+		updated: map[string]string{},
+		checked: map[string]int{},
+	}
+	logger := log.L()
+	k := newCutoverKeeper(&logger, ops)
+	now := time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+	k.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err = k.StartCutover("not-exist", true, false)
+	require.True(t, terror.ErrSchedulerTaskNotExist.Equal(err))
+	_, err = k.QueryCutover("task")
+	require.True(t, terror.ErrMasterCutoverNotExist.Equal(err))
+
+	// the sources are not fenced when the validator is not running
+	status, err := k.StartCutover("task", true, false)
+	require.NoError(t, err)
+	require.Equal(t, pb.CutoverStep_CutoverFence, status.Step)
+	k.check(ctx)
+	status, err = k.QueryCutover("task")
+	require.NoError(t, err)
+	require.Equal(t, pb.CutoverStep_CutoverFence, status.Step)
+	require.True(t, status.Failed)
+	require.Contains(t, status.Msg, "validator is Stopped")
+	require.False(t, ops.readOnly["source1"])
+	_, err = k.StartCutover("task", true, false)
+	require.NoError(t, err)
+	_, err = k.StartCutover("task", true, false)
+	require.True(t, terror.ErrMasterCutoverInProgress.Equal(err))
+
+	// fenced, then wait for the syncers
+	ops.validations["source1"].Stage = pb.Stage_Running
+	ops.subTasks["source1"] = syncingSubTask("(mysql-bin.000001, 1000)", "")
+	ops.subTasks["source2"] = &pb.SubTaskStatus{Stage: pb.Stage_Running, Unit: pb.UnitType_Load}
+	k.check(ctx)
+	status, err = k.QueryCutover("task")
+	require.NoError(t, err)
+	require.False(t, status.Failed)
+	require.Equal(t, pb.CutoverStep_CutoverWaitSync, status.Step)
+	require.True(t, ops.superReadOnly["source1"])
+	require.True(t, ops.superReadOnly["source2"])
+	require.Equal(t, "(mysql-bin.000001, 1234)", ops.updated["source1"])
+	require.Equal(t, "(mysql-bin.000003, 4)"+fencedGTID, ops.updated["source2"])
+	require.Equal(t, "(mysql-bin.000001, 1234)", status.Sources[0].CutoverBinlogPos)
+	require.Equal(t, fencedGTID, status.Sources[1].CutoverBinlogGtid)
+	require.Contains(t, status.Sources[0].Msg, "syncer hasn't reached the cutover location")
+	require.Contains(t, status.Sources[1].Msg, "Load unit")
+
+	// the syncers reach the cutover locations, then a new snapshot check is started even if one finished before
+	ops.subTasks["source1"] = syncingSubTask("(mysql-bin.000001, 1234)", "")
+	ops.subTasks["source2"] = syncingSubTask("(mysql-bin.000003, 100)", fencedGTID)
+	ops.validations["source1"] = runningValidator(pb.Stage_Finished, &pb.ValidationErrorRowCount{})
+	ops.validations["source2"] = runningValidator(pb.Stage_InvalidStage, &pb.ValidationErrorRowCount{})
+	k.check(ctx)
+	status, err = k.QueryCutover("task")
+	require.NoError(t, err)
+	require.Equal(t, pb.CutoverStep_CutoverValidate, status.Step)
+	require.True(t, status.Sources[0].Synced)
+	require.True(t, status.Sources[1].Synced)
+	require.Equal(t, "snapshot check is started", status.Sources[0].Msg)
+	require.Equal(t, map[string]int{"source1": 1, "source2": 1}, ops.checked)
+	k.check(ctx)
+	status, err = k.QueryCutover("task")
+	require.NoError(t, err)
+	require.Equal(t, "snapshot check is running", status.Sources[0].Msg)
+	require.Equal(t, map[string]int{"source1": 1, "source2": 1}, ops.checked)
+
+	// the snapshot check is started again after the validator restarts
+	ops.validations["source2"].SnapshotCheckStage = pb.Stage_InvalidStage
+	k.check(ctx)
+	require.Equal(t, map[string]int{"source1": 1, "source2": 2}, ops.checked)
+
+	// wait for the error rows which are failed to be loaded
+	ops.validations["source1"] = runningValidator(pb.Stage_Finished, nil)
+	ops.validations["source2"] = runningValidator(pb.Stage_Finished, &pb.ValidationErrorRowCount{})
+	k.check(ctx)
+	status, err = k.QueryCutover("task")
+	require.NoError(t, err)
+	require.Equal(t, pb.CutoverStep_CutoverValidate, status.Step)
+	require.False(t, status.Failed)
+	require.Contains(t, status.Sources[0].Msg, "fail to get error rows of validator")
+
+	// failed by the error rows, resumed after they are resolved
+	ops.validations["source1"] = runningValidator(pb.Stage_Finished, &pb.ValidationErrorRowCount{NewRows: 1})
+	ops.validations["source2"] = runningValidator(pb.Stage_Finished, &pb.ValidationErrorRowCount{})
+	k.check(ctx)
+	status, err = k.QueryCutover("task")
+	require.NoError(t, err)
+	require.Equal(t, pb.CutoverStep_CutoverValidate, status.Step)
+	require.True(t, status.Failed)
+	require.Contains(t, status.Msg, "validator has 1 error rows")
+	ops.validations["source1"] = runningValidator(pb.Stage_Finished, &pb.ValidationErrorRowCount{ResolvedRows: 1})
+	_, err = k.StartCutover("task", true, false)
+	require.NoError(t, err)
+	k.check(ctx)
+	status, err = k.QueryCutover("task")
+	require.NoError(t, err)
+	require.Equal(t, pb.CutoverStep_CutoverFinished, status.Step)
+	require.False(t, status.Failed)
+	require.Equal(t, "(mysql-bin.000001, 1234)", status.Sources[0].FinalBinlogPos)
+	require.Equal(t, fencedGTID, status.Sources[1].FinalBinlogGtid)
+	_, err = k.StartCutover("task", true, false)
+	require.True(t, terror.ErrMasterCutoverInProgress.Equal(err))
+
+	// the new leader loads the workflow from etcd, and rolls it back
+	k2 := newCutoverKeeper(&logger, ops)
+	status2, err := k2.QueryCutover("task")
+	require.NoError(t, err)
+	require.Equal(t, status, status2)
+	_, err = k2.RollbackCutover(ctx, "task")
+	require.NoError(t, err)
+	require.False(t, ops.readOnly["source1"])
+	require.False(t, ops.superReadOnly["source1"])
+	require.True(t, ops.readOnly["source2"])
+	require.False(t, ops.superReadOnly["source2"])
+	require.Empty(t, ops.persisted)
+	_, err = k2.QueryCutover("task")
+	require.True(t, terror.ErrMasterCutoverNotExist.Equal(err))
+}
+
+func TestCutoverKeeperRollbackFailed(t *testing.T) {
+	ops := &mockCutoverOperator{
+		persisted:     map[string]string{},
+		sources:       map[string][]string{"task": {"source1"}},
+		cfgs:          map[string]*config.SourceConfig{"source1": {Flavor: gmysql.MySQLFlavor}},
+		readOnly:      map[string]bool{},
+		superReadOnly: map[string]bool{},
+		locations: map[string]binlog.Location{
+			"source1": binlog.NewLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil),
+		},
+		subTasks:    map[string]*pb.SubTaskStatus{"source1": syncingSubTask("(mysql-bin.000001, 4)", "")},
+		validations: map[string]*pb.ValidationStatus{},
+		updated:     map[string]string{},
+		checked:     map[string]int{},
+	}
+	logger := log.L()
+	k := newCutoverKeeper(&logger, ops)
+	ctx := context.Background()
+
+	// the validators are not required when skipping validation
+	_, err := k.StartCutover("task", true, true)
+	require.NoError(t, err)
+	k.check(ctx)
+	status, err := k.QueryCutover("task")
+	require.NoError(t, err)
+	require.Equal(t, pb.CutoverStep_CutoverWaitSync, status.Step)
+	require.True(t, ops.readOnly["source1"])
+	require.Empty(t, ops.updated)
+
+	// the workflow is kept if the source fails to be unfenced
+	ops.readOnlyErr = errors.New("connection refused")
+	status, err = k.RollbackCutover(ctx, "task")
+	require.Error(t, err)
+	require.True(t, status.Failed)
+	require.Equal(t, "connection refused", status.Sources[0].Msg)
+	require.Contains(t, ops.persisted, "task")
+	require.True(t, ops.readOnly["source1"])
+
+	ops.readOnlyErr = nil
+	_, err = k.RollbackCutover(ctx, "task")
+	require.NoError(t, err)
+	require.False(t, ops.readOnly["source1"])
+	require.Empty(t, ops.persisted)
+}
+
+func TestCutoverKeeperValidateFailed(t *testing.T) {
+	ops := &mockCutoverOperator{
+		persisted:     map[string]string{},
+		sources:       map[string][]string{"task": {"source1"}},
+		cfgs:          map[string]*config.SourceConfig{"source1": {Flavor: gmysql.MySQLFlavor}},
+		readOnly:      map[string]bool{},
+		superReadOnly: map[string]bool{},
+		locations: map[string]binlog.Location{
+			"source1": binlog.NewLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil),
+		},
+		subTasks:    map[string]*pb.SubTaskStatus{"source1": syncingSubTask("(mysql-bin.000001, 1234)", "")},
+		validations: map[string]*pb.ValidationStatus{"source1": runningValidator(pb.Stage_InvalidStage, &pb.ValidationErrorRowCount{})},
+		updated:     map[string]string{},
+		checked:     map[string]int{},
+	}
+	logger := log.L()
+	k := newCutoverKeeper(&logger, ops)
+	ctx := context.Background()
+
+	_, err := k.StartCutover("task", false, false)
+	require.NoError(t, err)
+	k.check(ctx)
+	require.Equal(t, 1, ops.checked["source1"])
+	require.False(t, ops.readOnly["source1"])
+
+	// the snapshot check fails
+	ops.validations["source1"].SnapshotCheckStage = pb.Stage_Paused
+	ops.validations["source1"].SnapshotCheckMsg = "connection refused"
+	k.check(ctx)
+	status, err := k.QueryCutover("task")
+	require.NoError(t, err)
+	require.Equal(t, pb.CutoverStep_CutoverValidate, status.Step)
+	require.True(t, status.Failed)
+	require.Contains(t, status.Msg, "snapshot check is Paused: connection refused")
+
+	// the snapshot check is skipped
+	ops.validations["source1"].SnapshotCheckStage = pb.Stage_Finished
+	ops.validations["source1"].SnapshotCheckMsg = "snapshot check is skipped"
+	_, err = k.StartCutover("task", false, false)
+	require.NoError(t, err)
+	k.check(ctx)
+	status, err = k.QueryCutover("task")
+	require.NoError(t, err)
+	require.True(t, status.Failed)
+	require.Contains(t, status.Msg, "snapshot check is skipped")
+}
+
+func TestCutoverKeeperRemoveTask(t *testing.T) {
+	ops := &mockCutoverOperator{
+		persisted:     map[string]string{},
+		sources:       map[string][]string{"task1": {"source1"}, "task2": {"source1"}},
+		cfgs:          map[string]*config.SourceConfig{"source1": {Flavor: gmysql.MySQLFlavor}},
+		readOnly:      map[string]bool{},
+		superReadOnly: map[string]bool{},
+		locations: map[string]binlog.Location{
+			"source1": binlog.NewLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil),
+		},
+		subTasks:    map[string]*pb.SubTaskStatus{},
+		validations: map[string]*pb.ValidationStatus{},
+		updated:     map[string]string{},
+		checked:     map[string]int{},
+	}
+	logger := log.L()
+	k := newCutoverKeeper(&logger, ops)
+	ctx := context.Background()
+
+	_, err := k.StartCutover("task1", true, true)
+	require.NoError(t, err)
+	_, err = k.StartCutover("task2", true, true)
+	require.NoError(t, err)
+	k.check(ctx)
+	require.Len(t, ops.persisted, 2)
+
+	// removed together with the task, the source is kept read-only
+	require.NoError(t, k.RemoveCutover("task1"))
+	require.NoError(t, k.RemoveCutover("not-exist"))
+	_, err = k.QueryCutover("task1")
+	require.True(t, terror.ErrMasterCutoverNotExist.Equal(err))
+	require.NotContains(t, ops.persisted, "task1")
+	require.True(t, ops.superReadOnly["source1"])
+
+	// the task is removed when the keeper is not running
+	delete(ops.sources, "task2")
+	k2 := newCutoverKeeper(&logger, ops)
+	k2.check(ctx)
+	_, err = k2.QueryCutover("task2")
+	require.True(t, terror.ErrMasterCutoverNotExist.Equal(err))
+	require.Empty(t, ops.persisted)
+}
@@ -187,6 +187,9 @@ func (s *Server) startLeaderComponent(ctx context.Context) bool {
 		return false
 	}
 
+	s.taskScheduleKeeper.Start(ctx)
+	s.cutoverKeeper.Start(ctx)
+
 	err = s.initClusterID(ctx)
 	if err != nil {
 		log.L().Error("init cluster id failed", zap.Error(err))
@@ -205,6 +208,8 @@ func (s *Server) startLeaderComponent(ctx context.Context) bool {
 }
 
 func (s *Server) retireLeader() {
+	s.cutoverKeeper.Close()
+	s.taskScheduleKeeper.Close()
 	s.pessimist.Close()
 	s.optimist.Close()
 	s.scheduler.Close()
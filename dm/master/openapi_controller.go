@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/dm/checker"
@@ -35,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/ha"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 )
@@ -73,7 +75,7 @@ func (s *Server) updateClusterInfo(ctx context.Context, topo *openapi.ClusterTop
 }
 
 func (s *Server) getSourceStatusListFromWorker(ctx context.Context, sourceName string, specifiedSource bool) ([]openapi.SourceStatus, error) {
-	workerStatusList := s.getStatusFromWorkers(ctx, []string{sourceName}, "", specifiedSource)
+	workerStatusList := s.getStatusFromWorkers(ctx, []string{sourceName}, "", specifiedSource, false)
 	sourceStatusList := make([]openapi.SourceStatus, len(workerStatusList))
 	for i, workerStatus := range workerStatusList {
 		if workerStatus == nil {
@@ -362,12 +364,16 @@ func (s *Server) transferSource(ctx context.Context, sourceName, workerName stri
 	return s.scheduler.TransferSource(ctx, sourceName, workerName)
 }
 
-func (s *Server) checkTask(ctx context.Context, subtaskCfgList []*config.SubTaskConfig, errCnt, warnCnt int64) (string, error) {
+func (s *Server) checkTask(ctx context.Context, subtaskCfgList []*config.SubTaskConfig, errCnt, warnCnt int64, estimate bool) (string, error) {
 	// TODO(ehco) no api for this task now
-	return checker.CheckSyncConfigFunc(ctx, subtaskCfgList, errCnt, warnCnt)
+	var optionalItems []string
+	if estimate {
+		optionalItems = append(optionalItems, config.MigrationEstimateChecking)
+	}
+	return checker.CheckSyncConfigFunc(ctx, subtaskCfgList, errCnt, warnCnt, optionalItems...)
 }
 
-func (s *Server) checkOpenAPITaskBeforeOperate(ctx context.Context, task *openapi.Task) ([]*config.SubTaskConfig, string, error) {
+func (s *Server) checkOpenAPITaskBeforeOperate(ctx context.Context, task *openapi.Task, estimate bool) ([]*config.SubTaskConfig, string, error) {
 	// prepare target db config
 	toDBCfg := config.GetTargetDBCfgFromOpenAPITask(task)
 	if err := AdjustTargetDBSessionCfg(ctx, toDBCfg); err != nil {
@@ -392,7 +398,7 @@ func (s *Server) checkOpenAPITaskBeforeOperate(ctx context.Context, task *openap
 		return nil, "", err
 	}
 	// check subtask config
-	msg, err := s.checkTask(ctx, stCfgsForCheck, common.DefaultErrorCnt, common.DefaultWarnCnt)
+	msg, err := s.checkTask(ctx, stCfgsForCheck, common.DefaultErrorCnt, common.DefaultWarnCnt, estimate)
 	if err != nil {
 		return nil, "", terror.WithClass(err, terror.ClassDMMaster)
 	}
@@ -404,7 +410,7 @@ func (s *Server) createTask(ctx context.Context, req openapi.CreateTaskRequest)
 	if err := task.Adjust(); err != nil {
 		return nil, err
 	}
-	subTaskConfigList, msg, err := s.checkOpenAPITaskBeforeOperate(ctx, task)
+	subTaskConfigList, msg, err := s.checkOpenAPITaskBeforeOperate(ctx, task, req.Estimate != nil && *req.Estimate)
 	if err != nil {
 		return nil, err
 	}
@@ -420,7 +426,7 @@ func (s *Server) updateTask(ctx context.Context, req openapi.UpdateTaskRequest)
 	if err := task.Adjust(); err != nil {
 		return nil, err
 	}
-	subTaskConfigList, msg, err := s.checkOpenAPITaskBeforeOperate(ctx, task)
+	subTaskConfigList, msg, err := s.checkOpenAPITaskBeforeOperate(ctx, task, req.Estimate != nil && *req.Estimate)
 	if err != nil {
 		return nil, err
 	}
@@ -488,7 +494,10 @@ func (s *Server) deleteTask(ctx context.Context, taskName string, force bool) er
 	release()
 	sourceNameList := s.getTaskSourceNameList(taskName)
 	// delete subtask on worker
-	return s.scheduler.RemoveSubTasks(taskName, sourceNameList...)
+	if err = s.scheduler.RemoveSubTasks(taskName, sourceNameList...); err != nil {
+		return err
+	}
+	return s.cutoverKeeper.RemoveCutover(taskName)
 }
 
 func (s *Server) getTask(ctx context.Context, taskName string, req openapi.DMAPIGetTaskParams) (*openapi.Task, error) {
@@ -516,7 +525,8 @@ func (s *Server) getTaskStatus(ctx context.Context, taskName string, req openapi
 		sourceNameList := openapi.SourceNameList(s.getTaskSourceNameList(taskName))
 		req.SourceNameList = &sourceNameList
 	}
-	workerStatusList := s.getStatusFromWorkers(ctx, *req.SourceNameList, taskName, true)
+	withTableStatus := req.WithTableStatus != nil && *req.WithTableStatus
+	workerStatusList := s.getStatusFromWorkers(ctx, *req.SourceNameList, taskName, true, withTableStatus)
 	subTaskStatusList := make([]openapi.SubTaskStatus, 0, len(workerStatusList))
 
 	handleProcessError := func(err *pb.ProcessError) string {
@@ -596,6 +606,23 @@ func (s *Server) getTaskStatus(ctx context.Context, taskName string, req openapi
 					}
 				}
 			}
+			if tables := syncerS.GetTables(); len(tables) > 0 {
+				tableStatusList := make([]openapi.TableSyncStatus, len(tables))
+				for i, table := range tables {
+					tableStatusList[i] = openapi.TableSyncStatus{
+						Table:               table.Table,
+						InsertRows:          table.InsertRows,
+						UpdateRows:          table.UpdateRows,
+						DeleteRows:          table.DeleteRows,
+						Bytes:               table.Bytes,
+						AvgLatency:          table.AvgLatency,
+						MaxLatency:          table.MaxLatency,
+						LastAppliedTs:       table.LastAppliedTs,
+						SecondsBehindMaster: table.SecondsBehindMaster,
+					}
+				}
+				openapiSubTaskStatus.SyncStatus.Tables = &tableStatusList
+			}
 		}
 		// add dump status
 		if dumpS := subTaskStatus.GetDump(); dumpS != nil {
@@ -735,7 +762,9 @@ func (s *Server) startTask(ctx context.Context, taskName string, req openapi.Sta
 		release()
 	}
 
-	return s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Running, taskName, *req.SourceNameList...)
+	// the subtasks are kept paused until the start time of the schedule
+	stage := initialStage(needStartSubTaskList[0].Schedule, time.Now())
+	return s.scheduler.UpdateExpectSubTaskStage(stage, taskName, *req.SourceNameList...)
 }
 
 // nolint:unparam
@@ -756,6 +785,406 @@ func (s *Server) stopTask(ctx context.Context, taskName string, req openapi.Stop
 	return s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Stopped, taskName, *req.SourceNameList...)
 }
 
+func (s *Server) operateTaskSyncDelay(ctx context.Context, taskName string, req openapi.OperateTaskSyncDelayRequest) error {
+	var op pb.SyncDelayOp
+	switch req.Op {
+	case openapi.OperateTaskSyncDelayRequestOpPause:
+		op = pb.SyncDelayOp_PauseSyncDelay
+	case openapi.OperateTaskSyncDelayRequestOpResume:
+		op = pb.SyncDelayOp_ResumeSyncDelay
+	case openapi.OperateTaskSyncDelayRequestOpFastForward:
+		op = pb.SyncDelayOp_FastForwardSyncDelay
+	default:
+		return terror.ErrOpenAPICommonError.Generatef("invalid operate '%s' on delayed window", req.Op)
+	}
+	var sourceNameList []string
+	if req.SourceNameList != nil {
+		sourceNameList = *req.SourceNameList
+	}
+	sources := s.getSubTaskSourcesByTaskAndSource(taskName, sourceNameList)
+	if len(sources) == 0 {
+		return terror.ErrSchedulerTaskNotExist.Generate(taskName)
+	}
+	for _, workerResp := range s.operateSyncDelay(ctx, op, taskName, sources) {
+		if !workerResp.Result {
+			return terror.ErrOpenAPICommonError.Generatef("source %s: %s", workerResp.Source, workerResp.Msg)
+		}
+	}
+	return nil
+}
+
+func (s *Server) resyncTaskTables(ctx context.Context, taskName string, req openapi.ResyncTaskTablesRequest) error {
+	if req.Database == "" || len(req.TableList) == 0 {
+		return terror.ErrOpenAPICommonError.Generatef("database and tables to resync must be specified")
+	}
+	var sourceNameList []string
+	if req.SourceNameList != nil {
+		sourceNameList = *req.SourceNameList
+	}
+	sources := s.getSubTaskSourcesByTaskAndSource(taskName, sourceNameList)
+	if len(sources) == 0 {
+		return terror.ErrSchedulerTaskNotExist.Generate(taskName)
+	}
+	for _, workerResp := range s.resyncTables(ctx, taskName, req.Database, req.TableList, sources) {
+		if !workerResp.Result {
+			return terror.ErrOpenAPICommonError.Generatef("source %s: %s", workerResp.Source, workerResp.Msg)
+		}
+	}
+	return nil
+}
+
+func (s *Server) updateTaskThrottle(ctx context.Context, taskName string, req openapi.UpdateTaskThrottleRequest) error {
+	workerReq := &pb.UpdateThrottleWorkerRequest{TaskName: taskName}
+	if req.RowsPerSecond != nil {
+		workerReq.RowsPerSecond = int64(*req.RowsPerSecond)
+	}
+	if req.BytesPerSecond != nil {
+		workerReq.BytesPerSecond = *req.BytesPerSecond
+	}
+	if req.TargetLatency != nil {
+		workerReq.TargetLatency = *req.TargetLatency
+	}
+	var sourceNameList []string
+	if req.SourceNameList != nil {
+		sourceNameList = *req.SourceNameList
+	}
+	sources := s.getSubTaskSourcesByTaskAndSource(taskName, sourceNameList)
+	if len(sources) == 0 {
+		return terror.ErrSchedulerTaskNotExist.Generate(taskName)
+	}
+	for _, workerResp := range s.updateThrottle(ctx, workerReq, sources) {
+		if !workerResp.Result {
+			return terror.ErrOpenAPICommonError.Generatef("source %s: %s", workerResp.Source, workerResp.Msg)
+		}
+	}
+	return nil
+}
+
+func (s *Server) operateTaskBinlog(ctx context.Context, taskName string, req openapi.OperateTaskBinlogRequest) error {
+	var op pb.ErrorOp
+	switch req.Op {
+	case openapi.OperateTaskBinlogRequestOpSkip:
+		op = pb.ErrorOp_Skip
+	case openapi.OperateTaskBinlogRequestOpReplace:
+		op = pb.ErrorOp_Replace
+	case openapi.OperateTaskBinlogRequestOpRevert:
+		op = pb.ErrorOp_Revert
+	case openapi.OperateTaskBinlogRequestOpInject:
+		op = pb.ErrorOp_Inject
+	default:
+		return terror.ErrOpenAPICommonError.Generatef("invalid operate '%s' on binlog", req.Op)
+	}
+	handleReq := &pb.HandleErrorRequest{Op: op, Task: taskName}
+	if req.BinlogPos != nil {
+		handleReq.BinlogPos = *req.BinlogPos
+	}
+	if req.SqlList != nil {
+		handleReq.Sqls = *req.SqlList
+	}
+	if (op == pb.ErrorOp_Replace || op == pb.ErrorOp_Inject) && len(handleReq.Sqls) == 0 {
+		return terror.ErrOpenAPICommonError.Generatef("sql_list must be specified to %s binlog", req.Op)
+	}
+	var sourceNameList []string
+	if req.SourceNameList != nil {
+		sourceNameList = *req.SourceNameList
+	}
+	handleReq.Sources = s.getSubTaskSourcesByTaskAndSource(taskName, sourceNameList)
+	if len(handleReq.Sources) == 0 {
+		return terror.ErrSchedulerTaskNotExist.Generate(taskName)
+	}
+	resp, err := s.HandleError(ctx, handleReq)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	for _, workerResp := range resp.Sources {
+		if !workerResp.Result {
+			return terror.ErrOpenAPICommonError.Generatef("source %s: %s", workerResp.Source, workerResp.Msg)
+		}
+	}
+	return nil
+}
+
+func (s *Server) operateTaskCutover(ctx context.Context, req *pb.OperateCutoverRequest) (*openapi.TaskCutoverStatus, error) {
+	resp, err := s.OperateCutover(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+
+	optionalString := func(str string) *string {
+		if str == "" {
+			return nil
+		}
+		return &str
+	}
+	status := &openapi.TaskCutoverStatus{
+		TaskName:         resp.Cutover.Task,
+		Step:             resp.Cutover.Step.String(),
+		Failed:           resp.Cutover.Failed,
+		Msg:              optionalString(resp.Cutover.Msg),
+		FenceWrites:      resp.Cutover.FenceWrites,
+		SkipValidation:   resp.Cutover.SkipValidation,
+		StartTime:        resp.Cutover.StartTime,
+		UpdateTime:       resp.Cutover.UpdateTime,
+		SourceStatusList: make([]openapi.SourceCutoverStatus, 0, len(resp.Cutover.Sources)),
+	}
+	for _, source := range resp.Cutover.Sources {
+		status.SourceStatusList = append(status.SourceStatusList, openapi.SourceCutoverStatus{
+			SourceName:        source.Source,
+			Fenced:            source.Fenced,
+			CutoverBinlogPos:  optionalString(source.CutoverBinlogPos),
+			CutoverBinlogGtid: optionalString(source.CutoverBinlogGtid),
+			SyncerBinlog:      optionalString(source.SyncerBinlog),
+			SyncerBinlogGtid:  optionalString(source.SyncerBinlogGtid),
+			Synced:            source.Synced,
+			Validated:         source.Validated,
+			FinalBinlogPos:    optionalString(source.FinalBinlogPos),
+			FinalBinlogGtid:   optionalString(source.FinalBinlogGtid),
+			Msg:               optionalString(source.Msg),
+		})
+	}
+	return status, nil
+}
+
+func (s *Server) listTaskShardDDLLock(ctx context.Context, taskName string, sourceNameList []string) ([]openapi.ShardDDLLock, error) {
+	resp, err := s.ShowDDLLocks(ctx, &pb.ShowDDLLocksRequest{Task: taskName, Sources: sourceNameList})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	lockList := make([]openapi.ShardDDLLock, 0, len(resp.Locks))
+	for _, lock := range resp.Locks {
+		lockList = append(lockList, openapi.ShardDDLLock{
+			Id:       lock.ID,
+			TaskName: lock.Task,
+			Mode:     lock.Mode,
+			Owner:    lock.Owner,
+			DdlList:  lock.DDLs,
+			Synced:   lock.Synced,
+			Unsynced: lock.Unsynced,
+		})
+	}
+	return lockList, nil
+}
+
+func (s *Server) unlockTaskShardDDLLock(ctx context.Context, taskName string, req openapi.UnlockTaskShardDDLLockRequest) error {
+	if task := utils.ExtractTaskFromLockID(req.LockId); task != taskName {
+		return terror.ErrOpenAPICommonError.Generatef("lock %s doesn't belong to task %s", req.LockId, taskName)
+	}
+	unlockReq := &pb.UnlockDDLLockRequest{ID: req.LockId}
+	if req.ReplaceOwner != nil {
+		unlockReq.ReplaceOwner = *req.ReplaceOwner
+	}
+	if req.ForceRemove != nil {
+		unlockReq.ForceRemove = *req.ForceRemove
+	}
+	if req.Op != nil {
+		switch *req.Op {
+		case openapi.UnlockTaskShardDDLLockRequestOpSkip:
+			unlockReq.Op = pb.UnlockDDLLockOp_SkipLock
+		case openapi.UnlockTaskShardDDLLockRequestOpExec:
+			unlockReq.Op = pb.UnlockDDLLockOp_ExecLock
+		default:
+			return terror.ErrOpenAPICommonError.Generatef("invalid operate '%s' on shard DDL lock", *req.Op)
+		}
+	}
+	if req.SourceName != nil {
+		unlockReq.Sources = []string{*req.SourceName}
+	}
+	if req.Database != nil {
+		unlockReq.Database = *req.Database
+	}
+	if req.Table != nil {
+		unlockReq.Table = *req.Table
+	}
+	resp, err := s.UnlockDDLLock(ctx, unlockReq)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	return nil
+}
+
+func (s *Server) startTaskValidation(ctx context.Context, taskName string, req openapi.StartTaskValidationRequest) error {
+	startReq := &pb.StartValidationRequest{TaskName: taskName}
+	if req.Mode != nil {
+		startReq.Mode = &pb.StartValidationRequest_ModeValue{ModeValue: string(*req.Mode)}
+	}
+	if req.StartTime != nil {
+		startReq.StartTime = &pb.StartValidationRequest_StartTimeValue{StartTimeValue: *req.StartTime}
+	}
+	if req.SourceNameList != nil {
+		startReq.Sources = *req.SourceNameList
+	}
+	resp, err := s.StartValidation(ctx, startReq)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	return nil
+}
+
+func (s *Server) stopTaskValidation(ctx context.Context, taskName string, req openapi.StopTaskValidationRequest) error {
+	stopReq := &pb.StopValidationRequest{TaskName: taskName}
+	if req.SourceNameList != nil {
+		stopReq.Sources = *req.SourceNameList
+	}
+	resp, err := s.StopValidation(ctx, stopReq)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	return nil
+}
+
+func (s *Server) getTaskValidationStatus(ctx context.Context, taskName string, params openapi.DMAPIGetTaskValidationStatusParams) (*openapi.GetTaskValidationStatusResponse, error) {
+	statusReq := &pb.GetValidationStatusRequest{TaskName: taskName}
+	if params.TableStage != nil {
+		switch *params.TableStage {
+		case openapi.TaskStageRunning:
+			statusReq.FilterStatus = pb.Stage_Running
+		case openapi.TaskStageStopped:
+			statusReq.FilterStatus = pb.Stage_Stopped
+		default:
+			return nil, terror.ErrOpenAPICommonError.Generatef("table_stage should be either `%s` or `%s`", openapi.TaskStageRunning, openapi.TaskStageStopped)
+		}
+	}
+	resp, err := s.GetValidationStatus(ctx, statusReq)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	status := &openapi.GetTaskValidationStatusResponse{
+		ValidatorStatusList: make([]openapi.ValidatorStatus, 0, len(resp.Validators)),
+		TableStatusList:     make([]openapi.ValidationTableStatus, 0, len(resp.TableStatuses)),
+	}
+	for _, validator := range resp.Validators {
+		validatorStatus := openapi.ValidatorStatus{
+			TaskName:            validator.Task,
+			SourceName:          validator.Source,
+			Mode:                validator.Mode,
+			Stage:               openapi.TaskStage(validator.Stage.String()),
+			ValidatorBinlog:     validator.ValidatorBinlog,
+			ValidatorBinlogGtid: validator.ValidatorBinlogGtid,
+			ProcessedRowsStatus: validator.ProcessedRowsStatus,
+			PendingRowsStatus:   validator.PendingRowsStatus,
+			ErrorRowsStatus:     validator.ErrorRowsStatus,
+			CutoverBinlogPos:    validator.CutoverBinlogPos,
+			CutoverBinlogGtid:   validator.CutoverBinlogGtid,
+		}
+		if validator.Result != nil && len(validator.Result.Errors) > 0 {
+			errorMsg := validator.Result.Errors[0].Message
+			validatorStatus.ErrorMsg = &errorMsg
+		}
+		status.ValidatorStatusList = append(status.ValidatorStatusList, validatorStatus)
+	}
+	for _, table := range resp.TableStatuses {
+		status.TableStatusList = append(status.TableStatusList, openapi.ValidationTableStatus{
+			SourceName:  table.Source,
+			SourceTable: table.SrcTable,
+			TargetTable: table.DstTable,
+			Stage:       openapi.TaskStage(table.Stage.String()),
+			Message:     table.Message,
+		})
+	}
+	return status, nil
+}
+
+func (s *Server) listTaskValidationError(ctx context.Context, taskName string, params openapi.DMAPIGetTaskValidationErrorListParams) ([]openapi.ValidationError, error) {
+	errReq := &pb.GetValidationErrorRequest{TaskName: taskName, ErrState: pb.ValidateErrorState_NewErr}
+	if params.ErrorState != nil {
+		switch *params.ErrorState {
+		case "all":
+			// invalid state represents all errors
+			errReq.ErrState = pb.ValidateErrorState_InvalidErr
+		case "unprocessed":
+			errReq.ErrState = pb.ValidateErrorState_NewErr
+		case "ignored":
+			errReq.ErrState = pb.ValidateErrorState_IgnoredErr
+		default:
+			return nil, terror.ErrOpenAPICommonError.Generatef("error_state should be either `all`, `unprocessed` or `ignored`")
+		}
+	}
+	resp, err := s.GetValidationError(ctx, errReq)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	errorList := make([]openapi.ValidationError, 0, len(resp.Error))
+	for _, validationErr := range resp.Error {
+		var status openapi.ValidationErrorStatus
+		switch validationErr.Status {
+		case pb.ValidateErrorState_IgnoredErr:
+			status = openapi.ValidationErrorStatusIgnored
+		case pb.ValidateErrorState_ResolvedErr:
+			status = openapi.ValidationErrorStatusResolved
+		default:
+			status = openapi.ValidationErrorStatusUnprocessed
+		}
+		errorList = append(errorList, openapi.ValidationError{
+			Id:          validationErr.Id,
+			SourceName:  validationErr.Source,
+			SourceTable: validationErr.SrcTable,
+			SourceData:  validationErr.SrcData,
+			TargetTable: validationErr.DstTable,
+			TargetData:  validationErr.DstData,
+			ErrorType:   validationErr.ErrorType,
+			Status:      status,
+			Time:        validationErr.Time,
+			Message:     validationErr.Message,
+		})
+	}
+	return errorList, nil
+}
+
+func (s *Server) operateTaskValidationError(ctx context.Context, taskName string, req openapi.OperateTaskValidationErrorRequest) error {
+	operateReq := &pb.OperateValidationErrorRequest{TaskName: taskName}
+	switch req.Op {
+	case openapi.OperateTaskValidationErrorRequestOpIgnore:
+		operateReq.Op = pb.ValidationErrOp_IgnoreErrOp
+	case openapi.OperateTaskValidationErrorRequestOpResolve:
+		operateReq.Op = pb.ValidationErrOp_ResolveErrOp
+	case openapi.OperateTaskValidationErrorRequestOpClear:
+		operateReq.Op = pb.ValidationErrOp_ClearErrOp
+	default:
+		return terror.ErrOpenAPICommonError.Generatef("invalid operate '%s' on validation error", req.Op)
+	}
+	if req.AllErrors != nil {
+		operateReq.IsAllError = *req.AllErrors
+	}
+	if req.ErrorId != nil {
+		operateReq.ErrId = *req.ErrorId
+	}
+	if operateReq.IsAllError == (req.ErrorId != nil) {
+		return terror.ErrOpenAPICommonError.Generatef("either all_errors or error_id should be set")
+	}
+	resp, err := s.OperateValidationError(ctx, operateReq)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	return nil
+}
+
 // handleCliArgs handles cli args.
 // it will try to delete args if cli args is nil.
 func handleCliArgs(cli *clientv3.Client, taskName string, sources []string, cliArgs *config.TaskCliArgs) error {
@@ -288,10 +288,19 @@ func (s *OpenAPIControllerSuite) TestTaskController() {
 
 	// create
 	{
-		createTaskReq := openapi.CreateTaskRequest{Task: *s.testTask}
+		var checkedItems []string
+		checker.CheckSyncConfigFunc = func(_ context.Context, _ []*config.SubTaskConfig, _, _ int64, enabledOptionalItems ...string) (string, error) {
+			checkedItems = enabledOptionalItems
+			return "", nil
+		}
+
+		estimate := true
+		createTaskReq := openapi.CreateTaskRequest{Task: *s.testTask, Estimate: &estimate}
 		res, err := server.createTask(ctx, createTaskReq)
+		checker.CheckSyncConfigFunc = mockCheckSyncConfig
 		s.Nil(err)
 		s.EqualValues(*s.testTask, res.Task)
+		s.Equal([]string{config.MigrationEstimateChecking}, checkedItems)
 	}
 
 	// update
@@ -546,6 +555,81 @@ func (s *OpenAPIControllerSuite) TestTaskControllerWithInvalidTask() {
 	}
 }
 
+func (s *OpenAPIControllerSuite) TestTaskErrorHandlingController() {
+	ctx, cancel := context.WithCancel(context.Background())
+	server := setupTestServer(ctx, s.T())
+	defer func() {
+		cancel()
+		server.Close()
+	}()
+
+	// create source and task
+	{
+		worker1Name := "worker1"
+		worker1Addr := "172.16.10.72:8262"
+		s.NoError(server.scheduler.AddWorker(worker1Name, worker1Addr))
+		server.scheduler.GetWorkerByName(worker1Name).ToFree()
+		_, err := server.createSource(ctx, openapi.CreateSourceRequest{Source: *s.testSource, WorkerName: &worker1Name})
+		s.NoError(err)
+		_, err = server.createTask(ctx, openapi.CreateTaskRequest{Task: *s.testTask})
+		s.NoError(err)
+	}
+
+	// binlog
+	{
+		err := server.operateTaskBinlog(ctx, s.testTask.Name, openapi.OperateTaskBinlogRequest{Op: "pause"})
+		s.ErrorContains(err, "invalid operate 'pause' on binlog")
+		err = server.operateTaskBinlog(ctx, s.testTask.Name, openapi.OperateTaskBinlogRequest{Op: openapi.OperateTaskBinlogRequestOpReplace})
+		s.ErrorContains(err, "sql_list must be specified")
+		err = server.operateTaskBinlog(ctx, "not-exist", openapi.OperateTaskBinlogRequest{Op: openapi.OperateTaskBinlogRequestOpSkip})
+		s.True(terror.ErrSchedulerTaskNotExist.Equal(err))
+		// no true worker, will get an error msg
+		err = server.operateTaskBinlog(ctx, s.testTask.Name, openapi.OperateTaskBinlogRequest{Op: openapi.OperateTaskBinlogRequestOpSkip})
+		s.ErrorContains(err, "source "+s.testSource.SourceName)
+	}
+
+	// shard DDL lock
+	{
+		lockList, err := server.listTaskShardDDLLock(ctx, s.testTask.Name, nil)
+		s.NoError(err)
+		s.Len(lockList, 0)
+
+		err = server.unlockTaskShardDDLLock(ctx, s.testTask.Name, openapi.UnlockTaskShardDDLLockRequest{LockId: "other-task-`db`.`tb`"})
+		s.ErrorContains(err, "doesn't belong to task "+s.testTask.Name)
+		err = server.unlockTaskShardDDLLock(ctx, s.testTask.Name, openapi.UnlockTaskShardDDLLockRequest{LockId: s.testTask.Name + "-`db`.`tb`"})
+		s.ErrorContains(err, "not found")
+	}
+
+	// validation
+	{
+		mode := openapi.StartTaskValidationRequestModeFast
+		s.NoError(server.startTaskValidation(ctx, s.testTask.Name, openapi.StartTaskValidationRequest{Mode: &mode}))
+		s.True(server.scheduler.ValidatorEnabled(s.testTask.Name, s.testSource.SourceName))
+		subTaskCfg := server.scheduler.GetSubTaskCfgsByTask(s.testTask.Name)[s.testSource.SourceName]
+		s.Equal(config.ValidationFast, subTaskCfg.ValidatorCfg.Mode)
+		// can't enable again
+		err := server.startTaskValidation(ctx, s.testTask.Name, openapi.StartTaskValidationRequest{Mode: &mode})
+		s.ErrorContains(err, "all target validator has enabled")
+
+		s.NoError(server.stopTaskValidation(ctx, s.testTask.Name, openapi.StopTaskValidationRequest{}))
+		s.Equal(pb.Stage_Stopped, server.scheduler.GetValidatorStage(s.testTask.Name, s.testSource.SourceName).Expect)
+		err = server.stopTaskValidation(ctx, "not-exist", openapi.StopTaskValidationRequest{})
+		s.ErrorContains(err, "cannot get subtask by task name `not-exist`")
+
+		stage := openapi.TaskStageFinished
+		_, err = server.getTaskValidationStatus(ctx, s.testTask.Name, openapi.DMAPIGetTaskValidationStatusParams{TableStage: &stage})
+		s.ErrorContains(err, "table_stage should be either `Running` or `Stopped`")
+		errState := openapi.DMAPIGetTaskValidationErrorListParamsErrorState("resolved")
+		_, err = server.listTaskValidationError(ctx, s.testTask.Name, openapi.DMAPIGetTaskValidationErrorListParams{ErrorState: &errState})
+		s.ErrorContains(err, "error_state should be either")
+
+		err = server.operateTaskValidationError(ctx, s.testTask.Name, openapi.OperateTaskValidationErrorRequest{Op: openapi.OperateTaskValidationErrorRequestOpResolve})
+		s.ErrorContains(err, "either all_errors or error_id should be set")
+		err = server.operateTaskValidationError(ctx, s.testTask.Name, openapi.OperateTaskValidationErrorRequest{Op: "remove"})
+		s.ErrorContains(err, "invalid operate 'remove' on validation error")
+	}
+}
+
 func TestOpenAPIControllerSuite(t *testing.T) {
 	suite.Run(t, new(OpenAPIControllerSuite))
 }
@@ -517,6 +517,9 @@ func (s *Server) DMAPIGetTaskStatus(c *gin.Context, taskName string, params open
 		return
 	}
 	resp := openapi.GetTaskStatusResponse{Total: len(*task.StatusList), Data: *task.StatusList}
+	if status := s.taskScheduleStatus(taskName); status != nil {
+		resp.Schedule = taskScheduleStatusToOpenAPI(status)
+	}
 	c.IndentedJSON(http.StatusOK, resp)
 }
 
@@ -560,6 +563,202 @@ func (s *Server) DMAPIStopTask(c *gin.Context, taskName string) {
 	c.Status(http.StatusOK)
 }
 
+// DMAPIOperateTaskSyncDelay url is: (POST /api/v1/tasks/{task-name}/sync-delay).
+func (s *Server) DMAPIOperateTaskSyncDelay(c *gin.Context, taskName string) {
+	var req openapi.OperateTaskSyncDelayRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.operateTaskSyncDelay(ctx, taskName, req); err != nil {
+		_ = c.Error(err)
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIResyncTaskTables url is: (POST /api/v1/tasks/{task-name}/resync-tables).
+func (s *Server) DMAPIResyncTaskTables(c *gin.Context, taskName string) {
+	var req openapi.ResyncTaskTablesRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.resyncTaskTables(ctx, taskName, req); err != nil {
+		_ = c.Error(err)
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIUpdateTaskThrottle url is: (PUT /api/v1/tasks/{task-name}/throttle).
+func (s *Server) DMAPIUpdateTaskThrottle(c *gin.Context, taskName string) {
+	var req openapi.UpdateTaskThrottleRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.updateTaskThrottle(ctx, taskName, req); err != nil {
+		_ = c.Error(err)
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIOperateTaskBinlog url is: (POST /api/v1/tasks/{task-name}/binlog).
+func (s *Server) DMAPIOperateTaskBinlog(c *gin.Context, taskName string) {
+	var req openapi.OperateTaskBinlogRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.operateTaskBinlog(ctx, taskName, req); err != nil {
+		_ = c.Error(err)
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIStartTaskCutover url is: (POST /api/v1/tasks/{task-name}/cutover).
+func (s *Server) DMAPIStartTaskCutover(c *gin.Context, taskName string) {
+	var req openapi.StartTaskCutoverRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	cutoverReq := &pb.OperateCutoverRequest{Op: pb.CutoverOp_StartCutover, Task: taskName}
+	if req.FenceWrites != nil {
+		cutoverReq.FenceWrites = *req.FenceWrites
+	}
+	if req.SkipValidation != nil {
+		cutoverReq.SkipValidation = *req.SkipValidation
+	}
+	ctx := c.Request.Context()
+	status, err := s.operateTaskCutover(ctx, cutoverReq)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, status)
+}
+
+// DMAPIGetTaskCutover url is: (GET /api/v1/tasks/{task-name}/cutover).
+func (s *Server) DMAPIGetTaskCutover(c *gin.Context, taskName string) {
+	ctx := c.Request.Context()
+	status, err := s.operateTaskCutover(ctx, &pb.OperateCutoverRequest{Op: pb.CutoverOp_QueryCutover, Task: taskName})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, status)
+}
+
+// DMAPIRollbackTaskCutover url is: (DELETE /api/v1/tasks/{task-name}/cutover).
+func (s *Server) DMAPIRollbackTaskCutover(c *gin.Context, taskName string) {
+	ctx := c.Request.Context()
+	status, err := s.operateTaskCutover(ctx, &pb.OperateCutoverRequest{Op: pb.CutoverOp_RollbackCutover, Task: taskName})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, status)
+}
+
+// DMAPIGetTaskShardDDLLockList url is: (GET /api/v1/tasks/{task-name}/shard-ddl-locks).
+func (s *Server) DMAPIGetTaskShardDDLLockList(c *gin.Context, taskName string, params openapi.DMAPIGetTaskShardDDLLockListParams) {
+	var sourceNameList []string
+	if params.SourceNameList != nil {
+		sourceNameList = *params.SourceNameList
+	}
+	ctx := c.Request.Context()
+	lockList, err := s.listTaskShardDDLLock(ctx, taskName, sourceNameList)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := openapi.GetShardDDLLockListResponse{Total: len(lockList), Data: lockList}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPIUnlockTaskShardDDLLock url is: (POST /api/v1/tasks/{task-name}/shard-ddl-locks/unlock).
+func (s *Server) DMAPIUnlockTaskShardDDLLock(c *gin.Context, taskName string) {
+	var req openapi.UnlockTaskShardDDLLockRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.unlockTaskShardDDLLock(ctx, taskName, req); err != nil {
+		_ = c.Error(err)
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIStartTaskValidation url is: (POST /api/v1/tasks/{task-name}/validation/start).
+func (s *Server) DMAPIStartTaskValidation(c *gin.Context, taskName string) {
+	var req openapi.StartTaskValidationRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.startTaskValidation(ctx, taskName, req); err != nil {
+		_ = c.Error(err)
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIStopTaskValidation url is: (POST /api/v1/tasks/{task-name}/validation/stop).
+func (s *Server) DMAPIStopTaskValidation(c *gin.Context, taskName string) {
+	var req openapi.StopTaskValidationRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.stopTaskValidation(ctx, taskName, req); err != nil {
+		_ = c.Error(err)
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIGetTaskValidationStatus url is: (GET /api/v1/tasks/{task-name}/validation/status).
+func (s *Server) DMAPIGetTaskValidationStatus(c *gin.Context, taskName string, params openapi.DMAPIGetTaskValidationStatusParams) {
+	ctx := c.Request.Context()
+	resp, err := s.getTaskValidationStatus(ctx, taskName, params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPIGetTaskValidationErrorList url is: (GET /api/v1/tasks/{task-name}/validation/errors).
+func (s *Server) DMAPIGetTaskValidationErrorList(c *gin.Context, taskName string, params openapi.DMAPIGetTaskValidationErrorListParams) {
+	ctx := c.Request.Context()
+	errorList, err := s.listTaskValidationError(ctx, taskName, params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := openapi.GetTaskValidationErrorListResponse{Total: len(errorList), Data: errorList}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPIOperateTaskValidationError url is: (POST /api/v1/tasks/{task-name}/validation/errors).
+func (s *Server) DMAPIOperateTaskValidationError(c *gin.Context, taskName string) {
+	var req openapi.OperateTaskValidationErrorRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.operateTaskValidationError(ctx, taskName, req); err != nil {
+		_ = c.Error(err)
+	}
+	c.Status(http.StatusOK)
+}
+
 // DMAPIGetSchemaListByTaskAndSource get task source schema list url is: (GET /api/v1/tasks/{task-name}/sources/{source-name}/schemas).
 func (s *Server) DMAPIGetSchemaListByTaskAndSource(c *gin.Context, taskName string, sourceName string) {
 	worker := s.scheduler.GetWorkerBySource(sourceName)
@@ -0,0 +1,380 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+)
+
+const (
+	reasonViolatePlacement = "violate placement constraints"
+	// a subtask counts as one more load unit for every rowsPerLoad rows per second it synced recently.
+	rowsPerLoad = 1000
+)
+
+// WorkerLoad is the load of a DM-worker.
+// the load is the sum of the loads of the running subtasks of the bound source and the relay the worker is pulling,
+// which are measured by the metrics reported by DM-workers, see subtaskLoad and relayLoad.
+type WorkerLoad struct {
+	Worker   string
+	Stage    WorkerStage
+	Source   string
+	Load     int64
+	Capacity int64
+	Labels   map[string]string
+}
+
+// SourceMove represents moving a source from an overloaded or placement-violating DM-worker to a free one.
+type SourceMove struct {
+	Source     string
+	FromWorker string
+	ToWorker   string
+	Reason     string
+	Err        error // the error when moving the source, always nil in dry-run mode.
+}
+
+// SetRebalanceInterval sets the interval of moving sources off overloaded or placement-violating DM-workers,
+// 0 disables it. It should be called before the scheduler starts.
+func (s *Scheduler) SetRebalanceInterval(interval time.Duration) {
+	s.rebalanceInterval = interval
+}
+
+// GetWorkerLoads returns the load of all DM-workers, sorted by the worker name.
+func (s *Scheduler) GetWorkerLoads() []WorkerLoad {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	loads := make([]WorkerLoad, 0, len(s.workers))
+	for _, name := range s.sortedWorkerNames() {
+		w := s.workers[name]
+		loads = append(loads, WorkerLoad{
+			Worker:   name,
+			Stage:    w.Stage(),
+			Source:   w.Bound().Source,
+			Load:     s.workerLoad(w),
+			Capacity: w.Capacity(),
+			Labels:   w.Labels(),
+		})
+	}
+	return loads
+}
+
+// RebalanceSources moves sources off overloaded or placement-violating DM-workers to the most suitable free
+// DM-workers. In dry-run mode, it only returns the planned moves.
+func (s *Scheduler) RebalanceSources(ctx context.Context, dryRun bool) ([]SourceMove, error) {
+	if !s.started.Load() {
+		return nil, terror.ErrSchedulerNotStarted.Generate()
+	}
+
+	s.updateReportedLoads(ctx)
+	s.mu.RLock()
+	moves := s.planRebalance()
+	s.mu.RUnlock()
+	if dryRun {
+		return moves, nil
+	}
+
+	for i := range moves {
+		m := &moves[i]
+		// TransferSource checks the stages again, because they may have changed after planning.
+		m.Err = s.TransferSource(ctx, m.Source, m.ToWorker)
+		if m.Err != nil {
+			s.logger.Warn("failed to move source when rebalancing", zap.String("source", m.Source),
+				zap.String("from worker", m.FromWorker), zap.String("to worker", m.ToWorker),
+				zap.String("reason", m.Reason), zap.Error(m.Err))
+			continue
+		}
+		s.logger.Info("moved source when rebalancing", zap.String("source", m.Source),
+			zap.String("from worker", m.FromWorker), zap.String("to worker", m.ToWorker),
+			zap.String("reason", m.Reason))
+	}
+	return moves, nil
+}
+
+// rebalanceLoop rebalances sources periodically until the context is done.
+func (s *Scheduler) rebalanceLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.RebalanceSources(ctx, false); err != nil {
+				s.logger.Warn("failed to rebalance sources", zap.Error(err))
+			}
+		}
+	}
+}
+
+// planRebalance plans moving sources off bound workers which are overloaded or violate the placement constraints of
+// their sources, each free worker is used as the target at most once.
+// NOTE: this func need to hold the mutex.
+func (s *Scheduler) planRebalance() []SourceMove {
+	var (
+		moves   []SourceMove
+		targets = make(map[string]struct{})
+	)
+	for _, name := range s.sortedWorkerNames() {
+		w := s.workers[name]
+		if w.Stage() != WorkerBound {
+			continue
+		}
+		source := w.Bound().Source
+		sourceLoad := s.sourceLoad(source)
+
+		var reason string
+		if !s.matchPlacement(source, w) {
+			reason = reasonViolatePlacement
+		} else if load, capacity := s.workerLoad(w), w.Capacity(); !canHold(capacity, load) {
+			reason = fmt.Sprintf("load %d exceeds capacity %d", load, capacity)
+		} else {
+			continue
+		}
+
+		target := s.pickWorkerForSource(source, sourceLoad, targets)
+		// moving an overloaded source only helps when the target can hold it.
+		if target == nil || (reason != reasonViolatePlacement && !canHold(target.Capacity(), sourceLoad)) {
+			s.logger.Info("no suitable free worker to move the source to", zap.String("source", source),
+				zap.String("worker", name), zap.String("reason", reason))
+			continue
+		}
+		targets[target.BaseInfo().Name] = struct{}{}
+		moves = append(moves, SourceMove{
+			Source:     source,
+			FromWorker: name,
+			ToWorker:   target.BaseInfo().Name,
+			Reason:     reason,
+		})
+	}
+	return moves
+}
+
+// matchPlacement returns whether the worker satisfies the placement constraints of the source.
+// NOTE: this func need to hold the mutex.
+func (s *Scheduler) matchPlacement(source string, w *Worker) bool {
+	cfg, ok := s.sourceCfgs[source]
+	if !ok {
+		return true
+	}
+	return cfg.Placement.MatchConstraints(w.Labels())
+}
+
+// updateReportedLoads queries the status of the bound workers and records the loads of their subtasks and relay.
+// the loads reported before are kept if the query fails.
+func (s *Scheduler) updateReportedLoads(ctx context.Context) {
+	s.mu.RLock()
+	bounds := make(map[string]*Worker, len(s.bounds))
+	for source, w := range s.bounds {
+		bounds[source] = w
+	}
+	s.mu.RUnlock()
+
+	for source, w := range bounds {
+		resp, err := w.queryStatus(ctx)
+		if err != nil || resp.QueryStatus == nil {
+			s.logger.Warn("failed to query the load of worker", zap.String("worker", w.BaseInfo().Name),
+				zap.String("source", source), zap.Error(err))
+			continue
+		}
+		subtaskLoads := make(map[string]int64, len(resp.QueryStatus.SubTaskStatus))
+		for _, st := range resp.QueryStatus.SubTaskStatus {
+			subtaskLoads[st.Name] = subtaskLoad(st)
+		}
+		s.mu.Lock()
+		s.subtaskLoads[source] = subtaskLoads
+		if status := resp.QueryStatus.SourceStatus; status != nil && status.RelayStatus != nil {
+			s.relayLoads[source] = relayLoad(status.RelayStatus)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// subtaskLoad returns the load of a subtask, it counts as one plus one for every rowsPerLoad rows per second
+// it synced recently.
+func subtaskLoad(st *pb.SubTaskStatus) int64 {
+	load := int64(1)
+	if sync := st.GetSync(); sync != nil {
+		load += sync.RecentRps / rowsPerLoad
+	}
+	return load
+}
+
+// relayLoad returns the load of a relay, it counts as one plus one when it has not caught up with the upstream.
+func relayLoad(st *pb.RelayStatus) int64 {
+	load := int64(1)
+	if st.Stage == pb.Stage_Running && !st.RelayCatchUpMaster {
+		load++
+	}
+	return load
+}
+
+// sourceLoad returns the load of the running subtasks of the source, plus the load of relay if the source enables
+// relay. subtasks and relay whose load has not been reported count as one.
+// NOTE: this func need to hold the mutex.
+func (s *Scheduler) sourceLoad(source string) int64 {
+	var (
+		running = pb.Stage_Running
+		load    int64
+	)
+	for _, task := range s.GetTaskNameListBySourceName(source, &running) {
+		if l, ok := s.subtaskLoads[source][task]; ok {
+			load += l
+		} else {
+			load++
+		}
+	}
+	if cfg, ok := s.sourceCfgs[source]; ok && cfg.EnableRelay {
+		load += s.relayLoad(source)
+	}
+	return load
+}
+
+// relayLoad returns the reported load of the relay of the source, 1 if not reported.
+// NOTE: this func need to hold the mutex.
+func (s *Scheduler) relayLoad(source string) int64 {
+	if l, ok := s.relayLoads[source]; ok {
+		return l
+	}
+	return 1
+}
+
+// workerLoad returns the load of the bound source of the worker, plus the load of relay if the worker pulls relay
+// log for a source which is not counted in the source load.
+// NOTE: this func need to hold the mutex.
+func (s *Scheduler) workerLoad(w *Worker) int64 {
+	var load int64
+	source := w.Bound().Source
+	if source != "" {
+		load = s.sourceLoad(source)
+	}
+	if relaySource := w.RelaySourceID(); relaySource != "" {
+		if cfg, ok := s.sourceCfgs[relaySource]; relaySource != source || !ok || !cfg.EnableRelay {
+			load += s.relayLoad(relaySource)
+		}
+	}
+	return load
+}
+
+// pickWorkerForSource picks the most suitable free worker which satisfies the placement constraints of the source.
+// workers in `excluded` are skipped, returns nil if no worker can be picked.
+// NOTE: this func need to hold the mutex.
+func (s *Scheduler) pickWorkerForSource(source string, load int64, excluded map[string]struct{}) *Worker {
+	var placement config.PlacementConfig
+	if cfg, ok := s.sourceCfgs[source]; ok {
+		placement = cfg.Placement
+	}
+
+	var candidates []*Worker
+	for name, w := range s.workers {
+		if _, ok := excluded[name]; ok {
+			continue
+		}
+		if w.Stage() == WorkerFree && placement.MatchConstraints(w.Labels()) {
+			candidates = append(candidates, w)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	// prefer workers which can hold the load, then match more placement preferences, then have more capacity.
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if fitA, fitB := canHold(a.Capacity(), load), canHold(b.Capacity(), load); fitA != fitB {
+			return fitA
+		}
+		if scoreA, scoreB := placement.PreferenceScore(a.Labels()), placement.PreferenceScore(b.Labels()); scoreA != scoreB {
+			return scoreA > scoreB
+		}
+		if capA, capB := effectiveCapacity(a.Capacity()), effectiveCapacity(b.Capacity()); capA != capB {
+			return capA > capB
+		}
+		return a.BaseInfo().Name < b.BaseInfo().Name
+	})
+	return candidates[0]
+}
+
+// pickSourceForWorker picks the most suitable unbound source whose placement constraints the worker satisfies,
+// returns empty string if no source can be picked.
+// NOTE: this func need to hold the mutex.
+func (s *Scheduler) pickSourceForWorker(w *Worker) string {
+	var (
+		labels   = w.Labels()
+		capacity = w.Capacity()
+		sources  []string
+	)
+	for source := range s.unbounds {
+		if s.matchPlacement(source, w) {
+			sources = append(sources, source)
+		}
+	}
+	if len(sources) == 0 {
+		return ""
+	}
+	// prefer sources which the worker can hold, then whose placement preferences the worker matches more.
+	sort.Slice(sources, func(i, j int) bool {
+		a, b := sources[i], sources[j]
+		if fitA, fitB := canHold(capacity, s.sourceLoad(a)), canHold(capacity, s.sourceLoad(b)); fitA != fitB {
+			return fitA
+		}
+		if scoreA, scoreB := s.preferenceScore(a, labels), s.preferenceScore(b, labels); scoreA != scoreB {
+			return scoreA > scoreB
+		}
+		return a < b
+	})
+	return sources[0]
+}
+
+// preferenceScore returns how many placement preferences of the source the labels match.
+// NOTE: this func need to hold the mutex.
+func (s *Scheduler) preferenceScore(source string, labels map[string]string) int {
+	cfg, ok := s.sourceCfgs[source]
+	if !ok {
+		return 0
+	}
+	return cfg.Placement.PreferenceScore(labels)
+}
+
+// sortedWorkerNames returns the names of all workers in order.
+// NOTE: this func need to hold the mutex.
+func (s *Scheduler) sortedWorkerNames() []string {
+	names := make([]string, 0, len(s.workers))
+	for name := range s.workers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// canHold returns whether a worker with the capacity can hold the load, non-positive capacity means unlimited.
+func canHold(capacity, load int64) bool {
+	return capacity <= 0 || load <= capacity
+}
+
+func effectiveCapacity(capacity int64) int64 {
+	if capacity <= 0 {
+		return math.MaxInt64
+	}
+	return capacity
+}
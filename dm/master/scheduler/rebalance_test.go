@@ -0,0 +1,281 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/config/security"
+	"github.com/pingcap/tiflow/dm/master/workerrpc"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+// statusClient is a worker client which returns the status as the result of querying status.
+type statusClient struct {
+	status *pb.QueryStatusResponse
+}
+
+func (c *statusClient) SendRequest(_ context.Context, req *workerrpc.Request, _ time.Duration) (*workerrpc.Response, error) {
+	if req.Type != workerrpc.CmdQueryStatus || c.status == nil {
+		return nil, errors.New("no status")
+	}
+	return &workerrpc.Response{Type: workerrpc.CmdQueryStatus, QueryStatus: c.status}, nil
+}
+
+func (c *statusClient) Close() error {
+	return nil
+}
+
+func (t *testSchedulerSuite) TestPlacementBound() {
+	var (
+		logger    = log.L()
+		s         = NewScheduler(&logger, security.Security{})
+		sourceID1 = "mysql-replica-1"
+		sourceID2 = "mysql-replica-2"
+	)
+
+	worker1 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-1"}, labels: map[string]string{"zone": "b"}}
+	worker2 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-2"}, labels: map[string]string{"zone": "a", "host": "h1"}}
+	worker3 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-3"}, labels: map[string]string{"zone": "a", "host": "h2"}}
+
+	s.started.Store(true)
+	s.etcdCli = t.etcdTestCli
+	for _, w := range []*Worker{worker1, worker2, worker3} {
+		s.workers[w.BaseInfo().Name] = w
+		w.ToFree()
+	}
+	s.sourceCfgs[sourceID1] = &config.SourceConfig{Placement: config.PlacementConfig{
+		Constraints: map[string]string{"zone": "a"},
+		Preferences: map[string]string{"host": "h2"},
+	}}
+	s.sourceCfgs[sourceID2] = &config.SourceConfig{}
+
+	// source1 is bound to the worker matching both constraints and preferences
+	s.unbounds[sourceID1] = struct{}{}
+	bound, err := s.tryBoundForSource(sourceID1)
+	require.NoError(t.T(), err)
+	require.True(t.T(), bound)
+	require.Equal(t.T(), worker3, s.bounds[sourceID1])
+
+	// after worker3 becomes offline, source1 fails over to worker2 rather than worker1 in another zone
+	s.updateStatusToUnbound(sourceID1)
+	worker3.ToOffline()
+	bound, err = s.tryBoundForSource(sourceID1)
+	require.NoError(t.T(), err)
+	require.True(t.T(), bound)
+	require.Equal(t.T(), worker2, s.bounds[sourceID1])
+
+	// worker1 doesn't satisfy the constraints of source1, so it can only be bound to source2
+	s.updateStatusToUnbound(sourceID1)
+	worker2.ToOffline()
+	bound, err = s.tryBoundForWorker(worker1)
+	require.NoError(t.T(), err)
+	require.False(t.T(), bound)
+	s.unbounds[sourceID2] = struct{}{}
+	bound, err = s.tryBoundForWorker(worker1)
+	require.NoError(t.T(), err)
+	require.True(t.T(), bound)
+	require.Equal(t.T(), worker1, s.bounds[sourceID2])
+	_, ok := s.unbounds[sourceID1]
+	require.True(t.T(), ok)
+}
+
+func (t *testSchedulerSuite) TestRebalanceSources() {
+	var (
+		logger    = log.L()
+		s         = NewScheduler(&logger, security.Security{})
+		sourceID1 = "mysql-replica-1"
+		sourceID2 = "mysql-replica-2"
+		ctx       = context.Background()
+	)
+
+	worker1 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-1"}, labels: map[string]string{"zone": "b"}}
+	worker2 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-2"}, labels: map[string]string{"zone": "a"}, capacity: 1}
+	worker3 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-3"}, labels: map[string]string{"zone": "a"}, capacity: 1}
+	worker4 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-4"}, labels: map[string]string{"zone": "a", "host": "h1"}}
+	for _, w := range []*Worker{worker1, worker2, worker3, worker4} {
+		// no load is reported, so each subtask counts as one
+		w.cli = &statusClient{}
+	}
+
+	_, err := s.RebalanceSources(ctx, true)
+	require.Error(t.T(), err)
+
+	s.started.Store(true)
+	s.etcdCli = t.etcdTestCli
+	for _, w := range []*Worker{worker1, worker2, worker3, worker4} {
+		s.workers[w.BaseInfo().Name] = w
+		w.ToFree()
+	}
+	s.sourceCfgs[sourceID1] = &config.SourceConfig{Placement: config.PlacementConfig{
+		Constraints: map[string]string{"zone": "a"},
+		Preferences: map[string]string{"host": "h1"},
+	}}
+	s.sourceCfgs[sourceID2] = &config.SourceConfig{}
+	require.NoError(t.T(), s.boundSourceToWorker(sourceID1, worker1))
+	require.NoError(t.T(), s.boundSourceToWorker(sourceID2, worker2))
+	s.expectSubTaskStages.Store("task1", map[string]ha.Stage{sourceID2: {Expect: pb.Stage_Running}})
+	s.expectSubTaskStages.Store("task2", map[string]ha.Stage{sourceID2: {Expect: pb.Stage_Running}})
+	s.expectSubTaskStages.Store("task3", map[string]ha.Stage{sourceID2: {Expect: pb.Stage_Paused}})
+
+	loads := s.GetWorkerLoads()
+	require.Len(t.T(), loads, 4)
+	require.Equal(t.T(), WorkerLoad{
+		Worker:   worker2.BaseInfo().Name,
+		Stage:    WorkerBound,
+		Source:   sourceID2,
+		Load:     2,
+		Capacity: 1,
+		Labels:   map[string]string{"zone": "a"},
+	}, loads[1])
+
+	// source1 violates the placement constraints, and source2 is overloaded but worker3 can't hold it either
+	moves, err := s.RebalanceSources(ctx, true)
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), []SourceMove{
+		{Source: sourceID1, FromWorker: worker1.BaseInfo().Name, ToWorker: worker4.BaseInfo().Name, Reason: reasonViolatePlacement},
+	}, moves)
+
+	// worker3 can hold source2 after its capacity increased
+	worker3.updateLabels(map[string]string{"zone": "a"}, 3)
+	moves, err = s.RebalanceSources(ctx, true)
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), []SourceMove{
+		{Source: sourceID1, FromWorker: worker1.BaseInfo().Name, ToWorker: worker4.BaseInfo().Name, Reason: reasonViolatePlacement},
+		{Source: sourceID2, FromWorker: worker2.BaseInfo().Name, ToWorker: worker3.BaseInfo().Name, Reason: "load 2 exceeds capacity 1"},
+	}, moves)
+	// dry-run doesn't move any source
+	require.Equal(t.T(), worker1, s.bounds[sourceID1])
+	require.Equal(t.T(), worker2, s.bounds[sourceID2])
+
+	// source2 is not overloaded when its tasks are paused, and source1 is moved
+	s.expectSubTaskStages.Delete("task1")
+	s.expectSubTaskStages.Delete("task2")
+	moves, err = s.RebalanceSources(ctx, false)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), moves, 1)
+	require.NoError(t.T(), moves[0].Err)
+	require.Equal(t.T(), worker4, s.bounds[sourceID1])
+	require.Equal(t.T(), WorkerFree, worker1.Stage())
+	bounds, _, err := ha.GetSourceBound(t.etcdTestCli, worker4.BaseInfo().Name)
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), sourceID1, bounds[worker4.BaseInfo().Name].Source)
+
+	moves, err = s.RebalanceSources(ctx, true)
+	require.NoError(t.T(), err)
+	require.Empty(t.T(), moves)
+}
+
+func (t *testSchedulerSuite) TestRebalanceWeightedSubtasks() {
+	var (
+		logger   = log.L()
+		s        = NewScheduler(&logger, security.Security{})
+		sourceID = "mysql-replica-1"
+		ctx      = context.Background()
+		cli      = &statusClient{}
+	)
+
+	worker1 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-1"}, capacity: 5, cli: cli}
+	worker2 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-2"}, capacity: 4}
+	worker3 := &Worker{baseInfo: ha.WorkerInfo{Name: "dm-worker-3"}, capacity: 6}
+
+	s.started.Store(true)
+	s.etcdCli = t.etcdTestCli
+	for _, w := range []*Worker{worker1, worker2, worker3} {
+		s.workers[w.BaseInfo().Name] = w
+		w.ToFree()
+	}
+	s.sourceCfgs[sourceID] = &config.SourceConfig{EnableRelay: true}
+	require.NoError(t.T(), s.boundSourceToWorker(sourceID, worker1))
+	s.expectSubTaskStages.Store("task1", map[string]ha.Stage{sourceID: {Expect: pb.Stage_Running}})
+	s.expectSubTaskStages.Store("task2", map[string]ha.Stage{sourceID: {Expect: pb.Stage_Running}})
+
+	// before the load is reported, worker1 can hold two subtasks and relay
+	require.Equal(t.T(), int64(3), s.GetWorkerLoads()[0].Load)
+
+	// task1 syncs 2500 rows per second and relay is catching up, so worker1 is overloaded
+	cli.status = &pb.QueryStatusResponse{
+		Result: true,
+		SourceStatus: &pb.SourceStatus{
+			Source:      sourceID,
+			RelayStatus: &pb.RelayStatus{Stage: pb.Stage_Running},
+		},
+		SubTaskStatus: []*pb.SubTaskStatus{
+			{Name: "task1", Stage: pb.Stage_Running, Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{RecentRps: 2500}}},
+			{Name: "task2", Stage: pb.Stage_Running, Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{}}},
+		},
+	}
+	moves, err := s.RebalanceSources(ctx, true)
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), []SourceMove{
+		{Source: sourceID, FromWorker: worker1.BaseInfo().Name, ToWorker: worker3.BaseInfo().Name, Reason: "load 6 exceeds capacity 5"},
+	}, moves)
+	require.Equal(t.T(), int64(6), s.GetWorkerLoads()[0].Load)
+
+	// the reported load is kept if the query fails
+	cli.status = nil
+	moves, err = s.RebalanceSources(ctx, true)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), moves, 1)
+
+	// task1 becomes idle and relay catches up
+	cli.status = &pb.QueryStatusResponse{
+		Result: true,
+		SourceStatus: &pb.SourceStatus{
+			Source:      sourceID,
+			RelayStatus: &pb.RelayStatus{Stage: pb.Stage_Running, RelayCatchUpMaster: true},
+		},
+		SubTaskStatus: []*pb.SubTaskStatus{
+			{Name: "task1", Stage: pb.Stage_Running, Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{RecentRps: 10}}},
+			{Name: "task2", Stage: pb.Stage_Running, Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{}}},
+		},
+	}
+	moves, err = s.RebalanceSources(ctx, true)
+	require.NoError(t.T(), err)
+	require.Empty(t.T(), moves)
+	require.Equal(t.T(), int64(3), s.GetWorkerLoads()[0].Load)
+}
+
+func (t *testSchedulerSuite) TestAddWorkerWithLabels() {
+	var (
+		logger     = log.L()
+		s          = NewScheduler(&logger, security.Security{})
+		workerName = "dm-worker-1"
+		workerAddr = "127.0.0.1:8262"
+	)
+	s.started.Store(true)
+	s.etcdCli = t.etcdTestCli
+	defer s.CloseAllWorkers()
+
+	require.NoError(t.T(), s.AddWorkerWithLabels(workerName, workerAddr, map[string]string{"zone": "a"}, 2))
+	w := s.GetWorkerByName(workerName)
+	require.Equal(t.T(), map[string]string{"zone": "a"}, w.Labels())
+	require.Equal(t.T(), int64(2), w.Capacity())
+
+	// the worker restarts with new labels
+	require.NoError(t.T(), s.AddWorkerWithLabels(workerName, workerAddr, map[string]string{"zone": "b"}, 0))
+	require.Equal(t.T(), map[string]string{"zone": "b"}, w.Labels())
+	require.Equal(t.T(), int64(0), w.Capacity())
+	infos, _, err := ha.GetAllWorkerInfo(t.etcdTestCli)
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), ha.WorkerInfo{Name: workerName, Addr: workerAddr, Labels: map[string]string{"zone": "b"}}, infos[workerName])
+
+	require.Error(t.T(), s.AddWorkerWithLabels(workerName, "127.0.0.1:18262", nil, 0))
+}
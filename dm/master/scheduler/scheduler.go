@@ -35,6 +35,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 )
 
 const (
@@ -198,6 +199,15 @@ type Scheduler struct {
 	// task -> source -> worker
 	loadTasks map[string]map[string]string
 
+	// interval of moving sources off overloaded or placement-violating workers, 0 means disabled.
+	rebalanceInterval time.Duration
+
+	// loads of subtasks and relay according to the metrics reported by the bound workers, refreshed when rebalancing.
+	// source ID -> task name -> load
+	subtaskLoads map[string]map[string]int64
+	// source ID -> load
+	relayLoads map[string]int64
+
 	securityCfg security.Security
 }
 
@@ -214,6 +224,8 @@ func NewScheduler(pLogger *log.Logger, securityCfg security.Security) *Scheduler
 		expectRelayStages: make(map[string]ha.Stage),
 		relayWorkers:      make(map[string]map[string]struct{}),
 		loadTasks:         make(map[string]map[string]string),
+		subtaskLoads:      make(map[string]map[string]int64),
+		relayLoads:        make(map[string]int64),
 		securityCfg:       securityCfg,
 	}
 }
@@ -266,14 +278,13 @@ func (s *Scheduler) Start(pCtx context.Context, etcdCli *clientv3.Client) (err e
 
 	// check if we can bind free or relay source and workers
 	for _, w := range s.workers {
+		if len(s.unbounds) == 0 {
+			break
+		}
 		if w.stage == WorkerFree || w.stage == WorkerRelay {
-			bound, err := s.tryBoundForWorker(w)
-			if err != nil {
+			if _, err := s.tryBoundForWorker(w); err != nil {
 				return err
 			}
-			if !bound {
-				break
-			}
 		}
 	}
 
@@ -297,6 +308,14 @@ func (s *Scheduler) Start(pCtx context.Context, etcdCli *clientv3.Client) (err e
 		s.observeLoadTask(ctx, rev1)
 	}(loadTaskRev)
 
+	if s.rebalanceInterval > 0 {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.rebalanceLoop(ctx, s.rebalanceInterval)
+		}()
+	}
+
 	s.started.Store(true) // started now
 	s.cancel = cancel
 	s.logger.Info("the scheduler has started")
@@ -492,6 +511,8 @@ func (s *Scheduler) RemoveSourceCfg(source string) error {
 	// 5. delete the config and expectant stage in the scheduler
 	delete(s.sourceCfgs, source)
 	delete(s.expectRelayStages, source)
+	delete(s.subtaskLoads, source)
+	delete(s.relayLoads, source)
 
 	// 6. unbound for the source.
 	s.updateStatusToUnbound(source)
@@ -1106,6 +1127,36 @@ func (s *Scheduler) UpdateSubTasks(ctx context.Context, cfgs ...config.SubTaskCo
 	return nil
 }
 
+// UpdateSubTaskCfgs puts the subtask configs which are already applied by the DM-workers into etcd,
+// unlike UpdateSubTasks, the stage of the subtasks is not checked.
+func (s *Scheduler) UpdateSubTaskCfgs(cfgs ...config.SubTaskConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.started.Load() {
+		return terror.ErrSchedulerNotStarted.Generate()
+	}
+	if len(cfgs) == 0 {
+		return nil
+	}
+	for _, cfg := range cfgs {
+		v, ok := s.subTaskCfgs.Load(cfg.Name)
+		if !ok {
+			return terror.ErrSchedulerTaskNotExist.Generate(cfg.Name)
+		}
+		if _, ok = v.(map[string]config.SubTaskConfig)[cfg.SourceID]; !ok {
+			return terror.ErrSchedulerSubTaskNotExist.Generate(cfg.Name, cfg.SourceID)
+		}
+	}
+	if _, err := ha.PutSubTaskCfgStage(s.etcdCli, cfgs, []ha.Stage{}, []ha.Stage{}); err != nil {
+		return err
+	}
+	for _, cfg := range cfgs {
+		v, _ := s.subTaskCfgs.Load(cfg.Name)
+		v.(map[string]config.SubTaskConfig)[cfg.SourceID] = cfg
+	}
+	return nil
+}
+
 // getSubTaskCfgByTaskSource gets subtask config by task name and source ID. Only used in tests.
 func (s *Scheduler) getSubTaskCfgByTaskSource(task, source string) *config.SubTaskConfig {
 	v, ok := s.subTaskCfgs.Load(task)
@@ -1257,6 +1308,12 @@ func (s *Scheduler) GetTaskNameListBySourceName(sourceName string, expectStage *
 // in order to know whether it's online (ready to handle works),
 // we need to wait for its healthy status through keep-alive.
 func (s *Scheduler) AddWorker(name, addr string) error {
+	return s.AddWorkerWithLabels(name, addr, nil, 0)
+}
+
+// AddWorkerWithLabels adds the information of the DM-worker with its labels and capacity,
+// which are used to place sources onto DM-workers.
+func (s *Scheduler) AddWorkerWithLabels(name, addr string, labels map[string]string, capacity int64) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -1264,12 +1321,25 @@ func (s *Scheduler) AddWorker(name, addr string) error {
 		return terror.ErrSchedulerNotStarted.Generate()
 	}
 
+	info := ha.NewWorkerInfo(name, addr)
+	info.Labels = labels
+	info.Capacity = capacity
+
 	// 1. check whether exists.
 	if w, ok := s.workers[name]; ok {
 		// NOTE: we do not support add the worker with different address now, support if needed later.
 		// but we support add the worker with all the same information multiple times, and only the first one take effect,
 		// because this is needed when restarting the worker.
 		if addr == w.BaseInfo().Addr {
+			// the labels and capacity may be changed when restarting the worker.
+			if !maps.Equal(labels, w.Labels()) || capacity != w.Capacity() {
+				if _, err := ha.PutWorkerInfo(s.etcdCli, info); err != nil {
+					return err
+				}
+				w.updateLabels(labels, capacity)
+				s.logger.Info("update labels of the worker", zap.Stringer("worker info", info))
+				return nil
+			}
 			s.logger.Warn("add the same worker again", zap.Stringer("worker info", w.BaseInfo()))
 			return nil
 		}
@@ -1277,7 +1347,6 @@ func (s *Scheduler) AddWorker(name, addr string) error {
 	}
 
 	// 2. put the base info into etcd.
-	info := ha.NewWorkerInfo(name, addr)
 	_, err := ha.PutWorkerInfo(s.etcdCli, info)
 	if err != nil {
 		return err
@@ -2233,7 +2302,7 @@ func (s *Scheduler) handleWorkerOffline(ev ha.WorkerEvent, toLock bool) error {
 // - try to bind sources on which the worker has unfinished load task
 // - try to bind the last bound source
 // - if enabled relay, bind to the relay source or keep unbound
-// - try to bind any unbound sources
+// - try to bind unbound sources whose placement constraints the worker satisfies, by placement preferences
 // if the source is bound to a relay enabled worker, we must check that the source is also the relay source of worker.
 // pulling binlog using relay or not is determined by whether the worker has enabled relay.
 func (s *Scheduler) tryBoundForWorker(w *Worker) (bound bool, err error) {
@@ -2252,7 +2321,7 @@ func (s *Scheduler) tryBoundForWorker(w *Worker) (bound bool, err error) {
 	// NOTE: if worker isn't in lastBound, we'll get "zero" SourceBound and it's OK, because "zero" string is not in
 	// unbounds
 	source := s.lastBound[w.baseInfo.Name].Source
-	if _, ok := s.unbounds[source]; !ok {
+	if _, ok := s.unbounds[source]; !ok || !s.matchPlacement(source, w) {
 		source = ""
 	}
 
@@ -2286,13 +2355,13 @@ func (s *Scheduler) tryBoundForWorker(w *Worker) (bound bool, err error) {
 		}
 	}
 
-	// randomly pick one from unbounds
+	// pick the most preferred one from unbounds
 	if source == "" {
-		for source = range s.unbounds {
+		source = s.pickSourceForWorker(w)
+		if source != "" {
 			s.logger.Info("found unbound source when worker bound",
 				zap.String("worker", w.BaseInfo().Name),
 				zap.String("source", source))
-			break // got a source.
 		}
 	}
 
@@ -2309,12 +2378,14 @@ func (s *Scheduler) tryBoundForWorker(w *Worker) (bound bool, err error) {
 	return true, nil
 }
 
-// tryBoundForSource tries to bound a source to a random Free worker. The order of picking worker is
+// tryBoundForSource tries to bound a source to a Free worker. The order of picking worker is
 // - try to bind a worker which has unfinished load task
 // - try to bind a relay worker which has be bound to this source before
 // - try to bind any relay worker
 // - try to bind any worker which has be bound to this source before
 // - try to bind any free worker
+// except relay workers, the worker must satisfy the placement constraints of the source, and free workers are picked
+// by placement preferences and capacity.
 // pulling binlog using relay or not is determined by whether the worker has enabled relay.
 // caller should update the s.unbounds.
 // caller should make sure this source has source config.
@@ -2378,7 +2449,7 @@ func (s *Scheduler) tryBoundForSource(source string) (bool, error) {
 					// a not found worker
 					continue
 				}
-				if w.Stage() == WorkerFree {
+				if w.Stage() == WorkerFree && s.matchPlacement(source, w) {
 					worker = w
 					s.logger.Info("found history worker when source bound",
 						zap.String("worker", workerName),
@@ -2389,16 +2460,13 @@ func (s *Scheduler) tryBoundForSource(source string) (bool, error) {
 		}
 	}
 
-	// and then a random Free worker.
+	// and then the most preferred Free worker.
 	if worker == nil {
-		for _, w := range s.workers {
-			if w.Stage() == WorkerFree {
-				worker = w
-				s.logger.Info("found free worker when source bound",
-					zap.String("worker", w.BaseInfo().Name),
-					zap.String("source", source))
-				break
-			}
+		worker = s.pickWorkerForSource(source, s.sourceLoad(source), nil)
+		if worker != nil {
+			s.logger.Info("found free worker when source bound",
+				zap.String("worker", worker.BaseInfo().Name),
+				zap.String("source", source))
 		}
 	}
 
@@ -2513,6 +2581,8 @@ func (s *Scheduler) reset() {
 	s.expectRelayStages = make(map[string]ha.Stage)
 	s.expectSubTaskStages = sync.Map{}
 	s.loadTasks = make(map[string]map[string]string)
+	s.subtaskLoads = make(map[string]map[string]int64)
+	s.relayLoads = make(map[string]int64)
 }
 
 // strMapToSlice converts a `map[string]struct{}` to `[]string` in increasing order.
@@ -90,6 +90,10 @@ type Worker struct {
 
 	// the source ID from which the worker is pulling relay log. should keep consistent with Scheduler.relayWorkers
 	relaySource string
+
+	// the labels and capacity reported when the worker registered, they may change when the worker restarts.
+	labels   map[string]string
+	capacity int64
 }
 
 // NewWorker creates a new Worker instance with Offline stage.
@@ -103,6 +107,8 @@ func NewWorker(baseInfo ha.WorkerInfo, securityCfg security.Security) (*Worker,
 		cli:      cli,
 		baseInfo: baseInfo,
 		stage:    WorkerOffline,
+		labels:   baseInfo.Labels,
+		capacity: baseInfo.Capacity,
 	}
 	w.reportMetrics()
 	return w, nil
@@ -243,6 +249,28 @@ func (w *Worker) RelaySourceID() string {
 	return w.relaySource
 }
 
+// Labels returns the labels of the worker.
+func (w *Worker) Labels() map[string]string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.labels
+}
+
+// Capacity returns the max load of the worker, 0 means unlimited.
+func (w *Worker) Capacity() int64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.capacity
+}
+
+// updateLabels updates the labels and capacity of the worker.
+func (w *Worker) updateLabels(labels map[string]string, capacity int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.labels = labels
+	w.capacity = capacity
+}
+
 // SendRequest sends request to the DM-worker instance.
 func (w *Worker) SendRequest(ctx context.Context, req *workerrpc.Request, d time.Duration) (*workerrpc.Response, error) {
 	return w.cli.SendRequest(ctx, req, d)
@@ -124,6 +124,11 @@ type Server struct {
 	// shard DDL optimist
 	optimist *shardddl.Optimist
 
+	// enforce the schedules of tasks
+	taskScheduleKeeper *taskScheduleKeeper
+	// drive the cutover workflows of tasks
+	cutoverKeeper *cutoverKeeper
+
 	// agent pool
 	ap *AgentPool
 
@@ -145,8 +150,11 @@ func NewServer(cfg *Config) *Server {
 		scheduler: scheduler.NewScheduler(&logger, cfg.Security),
 		ap:        NewAgentPool(&RateLimitConfig{rate: cfg.RPCRateLimit, burst: cfg.RPCRateBurst}),
 	}
+	server.scheduler.SetRebalanceInterval(cfg.RebalanceInterval)
 	server.pessimist = shardddl.NewPessimist(&logger, server.getTaskSourceNameList)
 	server.optimist = shardddl.NewOptimist(&logger, server.scheduler.GetDownstreamMetaByTask)
+	server.taskScheduleKeeper = newTaskScheduleKeeper(&logger, &server)
+	server.cutoverKeeper = newCutoverKeeper(&logger, &server)
 	server.closed.Store(true)
 	setUseTLS(&cfg.Security)
 
@@ -338,7 +346,7 @@ func (s *Server) RegisterWorker(ctx context.Context, req *pb.RegisterWorkerReque
 		return resp2, err2
 	}
 
-	err := s.scheduler.AddWorker(req.Name, req.Address)
+	err := s.scheduler.AddWorkerWithLabels(req.Name, req.Address, req.Labels, req.Capacity)
 	if err != nil {
 		// nolint:nilerr
 		return &pb.RegisterWorkerResponse{
@@ -346,7 +354,8 @@ func (s *Server) RegisterWorker(ctx context.Context, req *pb.RegisterWorkerReque
 			Msg:    err.Error(),
 		}, nil
 	}
-	log.L().Info("register worker successfully", zap.String("name", req.Name), zap.String("address", req.Address))
+	log.L().Info("register worker successfully", zap.String("name", req.Name), zap.String("address", req.Address),
+		zap.Any("labels", req.Labels), zap.Int64("capacity", req.Capacity))
 	return &pb.RegisterWorkerResponse{
 		Result:    true,
 		SecretKey: s.cfg.SecretKey,
@@ -590,7 +599,8 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 				return respWithErr(terror.Annotate(err, "while putting task command line arguments"))
 			}
 		}
-		err = s.scheduler.AddSubTasks(latched, pb.Stage_Running, subtaskCfgPointersToInstances(stCfgs...)...)
+		// the subtasks are paused until the start time of the schedule
+		err = s.scheduler.AddSubTasks(latched, initialStage(cfg.Schedule, time.Now()), subtaskCfgPointersToInstances(stCfgs...)...)
 		if err != nil {
 			return respWithErr(err)
 		}
@@ -652,7 +662,8 @@ func (s *Server) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*
 	if req.Op == pb.TaskOp_Delete {
 		err = s.scheduler.RemoveSubTasks(req.Name, sources...)
 	} else {
-		err = s.scheduler.UpdateExpectSubTaskStage(expect, req.Name, sources...)
+		// the subtasks paused by the user are not resumed by the schedule of the task.
+		err = s.taskScheduleKeeper.UpdateSubTaskStageByUser(expect, req.Name, sources)
 	}
 	if err != nil {
 		resp.Msg = err.Error()
@@ -673,6 +684,11 @@ func (s *Server) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*
 		if err2 != nil {
 			log.L().Error("failed to delete metadata for task", zap.String("task name", req.Name), log.ShortError(err2))
 		}
+		if len(s.getTaskSourceNameList(req.Name)) == 0 {
+			if err2 = s.cutoverKeeper.RemoveCutover(req.Name); err2 != nil {
+				log.L().Error("failed to delete cutover for task", zap.String("task name", req.Name), log.ShortError(err2))
+			}
+		}
 	}
 	return resp, nil
 }
@@ -842,7 +858,7 @@ func (s *Server) QueryStatus(ctx context.Context, req *pb.QueryStatusListRequest
 			Msg:    err.Error(),
 		}, nil
 	}
-	resps := s.getStatusFromWorkers(ctx, sources, req.Name, specifiedSource)
+	resps := s.getStatusFromWorkers(ctx, sources, req.Name, specifiedSource, req.TableStatus)
 	workerRespMap := make(map[string][]*pb.QueryStatusResponse, len(sources)) // sourceName -> worker QueryStatusResponse
 	inSlice := func(s []string, e string) bool {
 		for _, v := range s {
@@ -864,7 +880,30 @@ func (s *Server) QueryStatus(ctx context.Context, req *pb.QueryStatusListRequest
 	for _, sourceName := range sources {
 		workerResps = append(workerResps, workerRespMap[sourceName]...)
 	}
-	return &pb.QueryStatusListResponse{Result: true, Sources: workerResps}, nil
+	return &pb.QueryStatusListResponse{Result: true, Sources: workerResps, Schedule: s.taskScheduleStatus(req.Name)}, nil
+}
+
+// taskScheduleStatus returns the schedule status of the task, nil if the task has no schedule.
+func (s *Server) taskScheduleStatus(task string) *pb.TaskScheduleStatus {
+	if task == "" {
+		return nil
+	}
+	if status := s.taskScheduleKeeper.Status(task); status != nil {
+		return status
+	}
+	// the keeper hasn't checked the task yet
+	for _, cfg := range s.scheduler.GetSubTaskCfgsByTask(task) {
+		if cfg.Schedule == nil {
+			return nil
+		}
+		state, err := cfg.Schedule.StateAt(time.Now())
+		if err != nil {
+			return nil
+		}
+		st := &taskScheduleState{ScheduleState: state}
+		return st.toPB()
+	}
+	return nil
 }
 
 // adjust unsynced field in sync status by looking at DDL locks.
@@ -1124,11 +1163,11 @@ func (s *Server) getTaskSourceNameList(taskName string) []string {
 
 // getStatusFromWorkers does RPC request to get status from dm-workers.
 func (s *Server) getStatusFromWorkers(
-	ctx context.Context, sources []string, taskName string, specifiedSource bool,
+	ctx context.Context, sources []string, taskName string, specifiedSource, tableStatus bool,
 ) []*pb.QueryStatusResponse {
 	workerReq := &workerrpc.Request{
 		Type:        workerrpc.CmdQueryStatus,
-		QueryStatus: &pb.QueryStatusRequest{Name: taskName},
+		QueryStatus: &pb.QueryStatusRequest{Name: taskName, TableStatus: tableStatus},
 	}
 	var (
 		workerResps  = make([]*pb.QueryStatusResponse, 0, len(sources))
@@ -1331,7 +1370,11 @@ func (s *Server) CheckTask(ctx context.Context, req *pb.CheckTaskRequest) (*pb.C
 		return resp, nil
 	}
 
-	msg, err := checker.CheckSyncConfigFunc(ctx, stCfgsForCheck, req.ErrCnt, req.WarnCnt)
+	var optionalItems []string
+	if req.Estimate {
+		optionalItems = append(optionalItems, config.MigrationEstimateChecking)
+	}
+	msg, err := checker.CheckSyncConfigFunc(ctx, stCfgsForCheck, req.ErrCnt, req.WarnCnt, optionalItems...)
 	if err != nil {
 		resp.Msg = terror.WithClass(err, terror.ClassDMMaster).Error()
 		return resp, nil
@@ -1818,6 +1861,8 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 		dbutil.TableName(metaSchema, cputil.SyncerShardMeta(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.SyncerOnlineDDL(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerConflict(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.ValidatorCheckpoint(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
@@ -2669,6 +2714,50 @@ func (s *Server) TransferSource(ctx context.Context, req *pb.TransferSourceReque
 	return resp2, nil
 }
 
+// RebalanceSources implements MasterServer.RebalanceSources.
+func (s *Server) RebalanceSources(ctx context.Context, req *pb.RebalanceSourcesRequest) (*pb.RebalanceSourcesResponse, error) {
+	var (
+		resp2 = &pb.RebalanceSourcesResponse{}
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	moves, err := s.scheduler.RebalanceSources(ctx, req.DryRun)
+	if err != nil {
+		resp2.Msg = err.Error()
+		// nolint:nilerr
+		return resp2, nil
+	}
+	for _, load := range s.scheduler.GetWorkerLoads() {
+		resp2.Workers = append(resp2.Workers, &pb.WorkerLoad{
+			Worker:   load.Worker,
+			Stage:    string(load.Stage),
+			Source:   load.Source,
+			Load:     load.Load,
+			Capacity: load.Capacity,
+			Labels:   load.Labels,
+		})
+	}
+	resp2.Result = true
+	for _, move := range moves {
+		m := &pb.SourceMove{
+			Source:     move.Source,
+			FromWorker: move.FromWorker,
+			ToWorker:   move.ToWorker,
+			Reason:     move.Reason,
+		}
+		if move.Err != nil {
+			m.Msg = move.Err.Error()
+			resp2.Result = false
+		}
+		resp2.Moves = append(resp2.Moves, m)
+	}
+	return resp2, nil
+}
+
 // OperateRelay implements MasterServer.OperateRelay.
 func (s *Server) OperateRelay(ctx context.Context, req *pb.OperateRelayRequest) (*pb.OperateRelayResponse, error) {
 	var (
@@ -2774,9 +2863,9 @@ func (s *Server) checkStartValidationParams(
 	explicitModeOrStartTime := req.Mode != nil || req.StartTime != nil
 	if req.Mode != nil {
 		mode := req.GetModeValue()
-		if mode != config.ValidationFull && mode != config.ValidationFast {
-			msg := fmt.Sprintf("validation mode should be either `%s` or `%s`",
-				config.ValidationFull, config.ValidationFast)
+		if mode != config.ValidationFull && mode != config.ValidationFast && mode != config.ValidationSnapshot {
+			msg := fmt.Sprintf("validation mode should be one of `%s`, `%s` or `%s`",
+				config.ValidationFull, config.ValidationFast, config.ValidationSnapshot)
 			return msg, false
 		}
 	}
@@ -3308,6 +3397,468 @@ func (s *Server) UpdateValidation(ctx context.Context, req *pb.UpdateValidationR
 	}, nil
 }
 
+// OperateSyncDelay implements MasterServer.OperateSyncDelay.
+func (s *Server) OperateSyncDelay(ctx context.Context, req *pb.OperateSyncDelayRequest) (*pb.OperateSyncDelayResponse, error) {
+	var (
+		resp2 *pb.OperateSyncDelayResponse
+		err   error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err)
+	if shouldRet {
+		return resp2, err
+	}
+	resp := &pb.OperateSyncDelayResponse{
+		Result: false,
+	}
+	if req.Op == pb.SyncDelayOp_InvalidSyncDelayOp {
+		resp.Msg = fmt.Sprintf("invalid operate `%s` on delayed window", req.Op)
+		return resp, nil
+	}
+	sources := s.getSubTaskSourcesByTaskAndSource(req.TaskName, req.Sources)
+	if len(sources) == 0 {
+		if len(req.Sources) > 0 {
+			resp.Msg = fmt.Sprintf("cannot get subtask by task name `%s` and sources `%v`",
+				req.TaskName, req.Sources)
+		} else {
+			resp.Msg = fmt.Sprintf("cannot get subtask by task name `%s`", req.TaskName)
+		}
+		return resp, nil
+	}
+
+	resp.Result = true
+	resp.Sources = s.operateSyncDelay(ctx, req.Op, req.TaskName, sources)
+	return resp, nil
+}
+
+// OperateCutover implements MasterServer.OperateCutover.
+func (s *Server) OperateCutover(ctx context.Context, req *pb.OperateCutoverRequest) (*pb.OperateCutoverResponse, error) {
+	var (
+		resp2 *pb.OperateCutoverResponse
+		err   error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err)
+	if shouldRet {
+		return resp2, err
+	}
+	resp := &pb.OperateCutoverResponse{
+		Result: false,
+	}
+	switch req.Op {
+	case pb.CutoverOp_StartCutover:
+		resp.Cutover, err = s.cutoverKeeper.StartCutover(req.Task, req.FenceWrites, req.SkipValidation)
+	case pb.CutoverOp_QueryCutover:
+		resp.Cutover, err = s.cutoverKeeper.QueryCutover(req.Task)
+	case pb.CutoverOp_RollbackCutover:
+		resp.Cutover, err = s.cutoverKeeper.RollbackCutover(ctx, req.Task)
+	default:
+		resp.Msg = fmt.Sprintf("invalid operate `%s` on cutover", req.Op)
+		return resp, nil
+	}
+	if err != nil {
+		resp.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Result = true
+	return resp, nil
+}
+
+// getSubTaskSourcesByTaskAndSource returns the sources of the subtasks of taskName, only
+// the specified sources are returned if sources is not empty.
+func (s *Server) getSubTaskSourcesByTaskAndSource(taskName string, sources []string) []string {
+	ret := make([]string, 0, len(sources))
+	for _, subTaskCfg := range s.scheduler.GetSubTaskCfgsByTaskAndSource(taskName, sources) {
+		for sourceID := range subTaskCfg {
+			ret = append(ret, sourceID)
+		}
+	}
+	return ret
+}
+
+// operateSyncDelay sends the operation on delayed window to the workers of sources.
+func (s *Server) operateSyncDelay(ctx context.Context, op pb.SyncDelayOp, taskName string, sources []string) []*pb.CommonWorkerResponse {
+	workerReq := workerrpc.Request{
+		Type: workerrpc.CmdOperateSyncDelay,
+		OperateSyncDelay: &pb.OperateSyncDelayWorkerRequest{
+			Op:       op,
+			TaskName: taskName,
+		},
+	}
+
+	workerRespCh := make(chan *pb.CommonWorkerResponse, len(sources))
+	var wg sync.WaitGroup
+	for _, sourceID := range sources {
+		wg.Add(1)
+		go func(source string) {
+			defer wg.Done()
+			worker := s.scheduler.GetWorkerBySource(source)
+			if worker == nil {
+				workerRespCh <- errorCommonWorkerResponse(fmt.Sprintf("source %s relevant worker-client not found", source), source, "")
+				return
+			}
+			var workerResp *pb.CommonWorkerResponse
+			resp, err := worker.SendRequest(ctx, &workerReq, s.cfg.RPCTimeout)
+			if err != nil {
+				workerResp = errorCommonWorkerResponse(err.Error(), source, worker.BaseInfo().Name)
+			} else {
+				workerResp = resp.OperateSyncDelay
+			}
+			workerResp.Source = source
+			workerRespCh <- workerResp
+		}(sourceID)
+	}
+	wg.Wait()
+
+	workerResps := make([]*pb.CommonWorkerResponse, 0, len(sources))
+	for len(workerRespCh) > 0 {
+		workerResp := <-workerRespCh
+		workerResps = append(workerResps, workerResp)
+	}
+
+	sort.Slice(workerResps, func(i, j int) bool {
+		return workerResps[i].Source < workerResps[j].Source
+	})
+	return workerResps
+}
+
+// ResyncTables implements MasterServer.ResyncTables.
+func (s *Server) ResyncTables(ctx context.Context, req *pb.ResyncTablesRequest) (*pb.ResyncTablesResponse, error) {
+	var (
+		resp2 *pb.ResyncTablesResponse
+		err   error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err)
+	if shouldRet {
+		return resp2, err
+	}
+	resp := &pb.ResyncTablesResponse{
+		Result: false,
+	}
+	if req.Database == "" || len(req.Tables) == 0 {
+		resp.Msg = "database and tables to resync must be specified"
+		return resp, nil
+	}
+	sources := s.getSubTaskSourcesByTaskAndSource(req.TaskName, req.Sources)
+	if len(sources) == 0 {
+		if len(req.Sources) > 0 {
+			resp.Msg = fmt.Sprintf("cannot get subtask by task name `%s` and sources `%v`",
+				req.TaskName, req.Sources)
+		} else {
+			resp.Msg = fmt.Sprintf("cannot get subtask by task name `%s`", req.TaskName)
+		}
+		return resp, nil
+	}
+
+	resp.Result = true
+	resp.Sources = s.resyncTables(ctx, req.TaskName, req.Database, req.Tables, sources)
+	return resp, nil
+}
+
+// resyncTables sends the request of resyncing tables to the workers of sources.
+func (s *Server) resyncTables(ctx context.Context, taskName, database string, tables, sources []string) []*pb.CommonWorkerResponse {
+	workerReq := workerrpc.Request{
+		Type: workerrpc.CmdResyncTables,
+		ResyncTables: &pb.ResyncTablesWorkerRequest{
+			TaskName: taskName,
+			Database: database,
+			Tables:   tables,
+		},
+	}
+
+	workerRespCh := make(chan *pb.CommonWorkerResponse, len(sources))
+	var wg sync.WaitGroup
+	for _, sourceID := range sources {
+		wg.Add(1)
+		go func(source string) {
+			defer wg.Done()
+			worker := s.scheduler.GetWorkerBySource(source)
+			if worker == nil {
+				workerRespCh <- errorCommonWorkerResponse(fmt.Sprintf("source %s relevant worker-client not found", source), source, "")
+				return
+			}
+			var workerResp *pb.CommonWorkerResponse
+			resp, err := worker.SendRequest(ctx, &workerReq, s.cfg.RPCTimeout)
+			if err != nil {
+				workerResp = errorCommonWorkerResponse(err.Error(), source, worker.BaseInfo().Name)
+			} else {
+				workerResp = resp.ResyncTables
+			}
+			workerResp.Source = source
+			workerRespCh <- workerResp
+		}(sourceID)
+	}
+	wg.Wait()
+
+	workerResps := make([]*pb.CommonWorkerResponse, 0, len(sources))
+	for len(workerRespCh) > 0 {
+		workerResp := <-workerRespCh
+		workerResps = append(workerResps, workerResp)
+	}
+
+	sort.Slice(workerResps, func(i, j int) bool {
+		return workerResps[i].Source < workerResps[j].Source
+	})
+	return workerResps
+}
+
+func (s *Server) updateThrottle(ctx context.Context, req *pb.UpdateThrottleWorkerRequest, sources []string) []*pb.CommonWorkerResponse {
+	workerReq := workerrpc.Request{
+		Type:           workerrpc.CmdUpdateThrottle,
+		UpdateThrottle: req,
+	}
+
+	workerRespCh := make(chan *pb.CommonWorkerResponse, len(sources))
+	var wg sync.WaitGroup
+	for _, sourceID := range sources {
+		wg.Add(1)
+		go func(source string) {
+			defer wg.Done()
+			worker := s.scheduler.GetWorkerBySource(source)
+			if worker == nil {
+				workerRespCh <- errorCommonWorkerResponse(fmt.Sprintf("source %s relevant worker-client not found", source), source, "")
+				return
+			}
+			var workerResp *pb.CommonWorkerResponse
+			resp, err := worker.SendRequest(ctx, &workerReq, s.cfg.RPCTimeout)
+			if err != nil {
+				workerResp = errorCommonWorkerResponse(err.Error(), source, worker.BaseInfo().Name)
+			} else {
+				workerResp = resp.UpdateThrottle
+			}
+			workerResp.Source = source
+			workerRespCh <- workerResp
+		}(sourceID)
+	}
+	wg.Wait()
+
+	workerResps := make([]*pb.CommonWorkerResponse, 0, len(sources))
+	for len(workerRespCh) > 0 {
+		workerResp := <-workerRespCh
+		workerResps = append(workerResps, workerResp)
+	}
+
+	sort.Slice(workerResps, func(i, j int) bool {
+		return workerResps[i].Source < workerResps[j].Source
+	})
+	return workerResps
+}
+
+// UpdateTaskRules implements MasterServer.UpdateTaskRules.
+// the new block-allow list, routes and filters are checked by all subtasks first, then they're prepared
+// by all subtasks at the end of a transaction and committed together without pausing the task, and saved
+// into etcd at last. the update is aborted if any subtask fails to prepare, and the subtasks which have
+// applied the new rules are rolled back if any subtask fails to commit.
+func (s *Server) UpdateTaskRules(ctx context.Context, req *pb.UpdateTaskRulesRequest) (*pb.UpdateTaskRulesResponse, error) {
+	var (
+		resp2 *pb.UpdateTaskRulesResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.UpdateTaskRulesResponse{}
+	cfg, stCfgs, err := s.generateSubTask(ctx, req.Task, nil)
+	if err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	var currCfgs map[string]config.SubTaskConfig
+	if m := s.scheduler.GetSubTaskCfgsByTaskAndSource(cfg.Name, nil); len(m) > 0 {
+		currCfgs = m[cfg.Name]
+	}
+	if len(currCfgs) == 0 {
+		resp.Msg = fmt.Sprintf("cannot get subtask by task name `%s`", cfg.Name)
+		return resp, nil
+	}
+	if len(currCfgs) != len(stCfgs) {
+		resp.Msg = "sources of the task can't be changed when updating rules"
+		return resp, nil
+	}
+	// only the rules are taken from the new config, so other fields such as meta are kept.
+	newCfgs := make([]*config.SubTaskConfig, 0, len(stCfgs))
+	for _, stCfg := range stCfgs {
+		currCfg, ok := currCfgs[stCfg.SourceID]
+		if !ok {
+			resp.Msg = fmt.Sprintf("source %s is not in the task, sources of the task can't be changed when updating rules", stCfg.SourceID)
+			return resp, nil
+		}
+		newCfg, err3 := currCfg.Clone()
+		if err3 != nil {
+			resp.Msg = err3.Error()
+			// nolint:nilerr
+			return resp, nil
+		}
+		newCfg.BAList = stCfg.BAList
+		newCfg.BWList = stCfg.BWList
+		newCfg.RouteRules = stCfg.RouteRules
+		newCfg.FilterRules = stCfg.FilterRules
+		newCfg.ExprFilter = stCfg.ExprFilter
+		newCfgs = append(newCfgs, newCfg)
+	}
+	log.L().Info("update task rules", zap.String("task name", cfg.Name), zap.Bool("preview", req.Preview), zap.Bool("backfill", req.Backfill))
+
+	// preview on all subtasks first, so the rules are applied only if all subtasks can update them.
+	resp.Sources = s.updateRules(ctx, newCfgs, true, req.Backfill, pb.UpdateRulesOp_PrepareRules)
+	failed := failedUpdateRulesSources(resp.Sources)
+	if len(failed) > 0 {
+		resp.Msg = fmt.Sprintf("rules can't be updated for sources %v", failed)
+		return resp, nil
+	}
+	if req.Preview {
+		resp.Result = true
+		return resp, nil
+	}
+
+	// prepare on all subtasks, each of them flushes its jobs and is held at the end of a transaction, so
+	// they switch to the new rules together after all of them are prepared, or none of them switches.
+	resp.Sources = s.updateRules(ctx, newCfgs, false, req.Backfill, pb.UpdateRulesOp_PrepareRules)
+	failed = failedUpdateRulesSources(resp.Sources)
+	if len(failed) > 0 {
+		s.updateRules(ctx, newCfgs, false, req.Backfill, pb.UpdateRulesOp_AbortRules)
+		resp.Msg = fmt.Sprintf("fail to prepare rules for sources %v, the update is aborted", failed)
+		return resp, nil
+	}
+
+	resp.Sources = s.updateRules(ctx, newCfgs, false, req.Backfill, pb.UpdateRulesOp_CommitRules)
+	failed = failedUpdateRulesSources(resp.Sources)
+	if len(failed) == 0 {
+		saved := make([]config.SubTaskConfig, 0, len(newCfgs))
+		for _, newCfg := range newCfgs {
+			saved = append(saved, *newCfg)
+		}
+		if err = s.scheduler.UpdateSubTaskCfgs(saved...); err != nil {
+			resp.Msg = fmt.Sprintf("rules are applied but fail to save the subtask configs: %s", err)
+			return resp, nil
+		}
+		resp.Result = true
+		return resp, nil
+	}
+
+	// the failed subtasks keep the old rules, so roll back the subtasks which have applied the new rules.
+	failedSources := make(map[string]struct{}, len(failed))
+	for _, source := range failed {
+		failedSources[source] = struct{}{}
+	}
+	oldCfgs := make([]*config.SubTaskConfig, 0, len(newCfgs))
+	for _, newCfg := range newCfgs {
+		if _, ok := failedSources[newCfg.SourceID]; ok {
+			continue
+		}
+		oldCfg := currCfgs[newCfg.SourceID]
+		oldCfgs = append(oldCfgs, &oldCfg)
+	}
+	rollbackFailed := s.rollbackRules(ctx, oldCfgs)
+	if len(rollbackFailed) == 0 {
+		resp.Msg = fmt.Sprintf("fail to apply rules for sources %v, the other sources are rolled back to the old rules", failed)
+		return resp, nil
+	}
+	// the sources which fail to roll back keep running with the new rules, save their configs to match.
+	rollbackFailedSources := make(map[string]struct{}, len(rollbackFailed))
+	for _, source := range rollbackFailed {
+		rollbackFailedSources[source] = struct{}{}
+	}
+	applied := make([]config.SubTaskConfig, 0, len(rollbackFailed))
+	for _, newCfg := range newCfgs {
+		if _, ok := rollbackFailedSources[newCfg.SourceID]; ok {
+			applied = append(applied, *newCfg)
+		}
+	}
+	if err = s.scheduler.UpdateSubTaskCfgs(applied...); err != nil {
+		resp.Msg = fmt.Sprintf("fail to apply rules for sources %v and fail to roll back sources %v, and fail to save the subtask configs: %s", failed, rollbackFailed, err)
+		return resp, nil
+	}
+	resp.Msg = fmt.Sprintf("fail to apply rules for sources %v and fail to roll back sources %v, please check the errors and update rules again", failed, rollbackFailed)
+	return resp, nil
+}
+
+// rollbackRules applies the old rules to the subtasks again without backfilling, and returns the
+// sources which fail to roll back.
+func (s *Server) rollbackRules(ctx context.Context, oldCfgs []*config.SubTaskConfig) []string {
+	if len(oldCfgs) == 0 {
+		return nil
+	}
+	log.L().Warn("roll back rules", zap.String("task name", oldCfgs[0].Name), zap.Int("sources", len(oldCfgs)))
+	failed := failedUpdateRulesSources(s.updateRules(ctx, oldCfgs, false, false, pb.UpdateRulesOp_PrepareRules))
+	if len(failed) > 0 {
+		s.updateRules(ctx, oldCfgs, false, false, pb.UpdateRulesOp_AbortRules)
+		sources := make([]string, 0, len(oldCfgs))
+		for _, cfg := range oldCfgs {
+			sources = append(sources, cfg.SourceID)
+		}
+		sort.Strings(sources)
+		return sources
+	}
+	return failedUpdateRulesSources(s.updateRules(ctx, oldCfgs, false, false, pb.UpdateRulesOp_CommitRules))
+}
+
+// updateRules sends the subtask configs with new rules and the operation to the workers of their sources.
+func (s *Server) updateRules(ctx context.Context, cfgs []*config.SubTaskConfig, preview, backfill bool, op pb.UpdateRulesOp) []*pb.UpdateRulesWorkerResponse {
+	workerRespCh := make(chan *pb.UpdateRulesWorkerResponse, len(cfgs))
+	var wg sync.WaitGroup
+	for _, cfg := range cfgs {
+		wg.Add(1)
+		go func(cfg *config.SubTaskConfig) {
+			defer wg.Done()
+			source := cfg.SourceID
+			workerResp := &pb.UpdateRulesWorkerResponse{Source: source}
+			defer func() {
+				workerRespCh <- workerResp
+			}()
+			worker := s.scheduler.GetWorkerBySource(source)
+			if worker == nil {
+				workerResp.Msg = fmt.Sprintf("source %s relevant worker-client not found", source)
+				return
+			}
+			workerResp.Worker = worker.BaseInfo().Name
+			tomlStr, err := cfg.Toml()
+			if err != nil {
+				workerResp.Msg = err.Error()
+				return
+			}
+			workerReq := workerrpc.Request{
+				Type: workerrpc.CmdUpdateRules,
+				UpdateRules: &pb.UpdateRulesWorkerRequest{
+					SubtaskCfgTomlString: tomlStr,
+					Preview:              preview,
+					Backfill:             backfill,
+					Op:                   op,
+				},
+			}
+			resp, err := worker.SendRequest(ctx, &workerReq, s.cfg.RPCTimeout)
+			if err != nil {
+				workerResp.Msg = err.Error()
+				return
+			}
+			workerResp = resp.UpdateRules
+			workerResp.Source = source
+		}(cfg)
+	}
+	wg.Wait()
+
+	workerResps := make([]*pb.UpdateRulesWorkerResponse, 0, len(cfgs))
+	for len(workerRespCh) > 0 {
+		workerResp := <-workerRespCh
+		workerResps = append(workerResps, workerResp)
+	}
+
+	sort.Slice(workerResps, func(i, j int) bool {
+		return workerResps[i].Source < workerResps[j].Source
+	})
+	return workerResps
+}
+
+func failedUpdateRulesSources(workerResps []*pb.UpdateRulesWorkerResponse) []string {
+	failed := make([]string, 0)
+	for _, workerResp := range workerResps {
+		if !workerResp.Result {
+			failed = append(failed, workerResp.Source)
+		}
+	}
+	return failed
+}
+
 func (s *Server) Encrypt(ctx context.Context, req *pb.EncryptRequest) (*pb.EncryptResponse, error) {
 	var (
 		resp2 *pb.EncryptResponse
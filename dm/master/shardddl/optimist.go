@@ -203,13 +203,14 @@ func (o *Optimist) ShowLocks(task string, sources []string) ([]*pb.DDLLock, erro
 		}
 		for i, owner := range owners {
 			ret = append(ret, &pb.DDLLock{
-				ID:       lock.ID,
-				Task:     lock.Task,
-				Mode:     config.ShardOptimistic,
-				Owner:    owner,
-				DDLs:     ddlGroups[i],
-				Synced:   lockSynced,
-				Unsynced: lockUnsynced,
+				ID:           lock.ID,
+				Task:         lock.Task,
+				Mode:         config.ShardOptimistic,
+				Owner:        owner,
+				DDLs:         ddlGroups[i],
+				Synced:       lockSynced,
+				Unsynced:     lockUnsynced,
+				AutoResolved: lock.AutoResolved(),
 			})
 		}
 	}
@@ -429,6 +430,13 @@ func (o *Optimist) rebuildLocks() (revSource, revInfo, revOperation int64, err e
 	}
 	o.lk.SetDropColumns(colm)
 
+	arm, _, err := optimism.GetAllAutoResolves(o.cli)
+	if err != nil {
+		// only log the error like dropped columns, the conflicts may be resolved again or handled by the user.
+		o.logger.Error("fail to recover the decisions of auto resolving", log.ShortError(err))
+	}
+	o.lk.SetAutoResolves(arm)
+
 	// recover the shard DDL lock based on history shard DDL info & lock operation.
 	err = o.recoverLocks(ifm, opm)
 	if err != nil {
@@ -437,6 +445,7 @@ func (o *Optimist) rebuildLocks() (revSource, revInfo, revOperation int64, err e
 		o.logger.Error("fail to recover locks", log.ShortError(err))
 	}
 	o.lk.SetDropColumns(nil)
+	o.lk.SetAutoResolves(nil)
 
 	return revSource, revInfo, revOperation, nil
 }
@@ -0,0 +1,566 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+)
+
+// taskScheduleCheckInterval is the interval of enforcing the schedules of tasks.
+var taskScheduleCheckInterval = 10 * time.Second
+
+// scheduleOperator is the operations used by taskScheduleKeeper, it's implemented by Server.
+type scheduleOperator interface {
+	subTaskCfgs() map[string]map[string]config.SubTaskConfig
+	expectSubTaskStage(task, source string) pb.Stage
+	updateSubTaskStage(stage pb.Stage, task string, sources []string) error
+	// syncingSources returns the sources whose subtask of the task is in the sync unit.
+	syncingSources(ctx context.Context, task string, sources []string) []string
+	updateSubTaskThrottle(ctx context.Context, task string, cfg config.ThrottleConfig, sources []string) error
+	// the sources changed by the schedules are persisted, so the new leader only resumes or restores them.
+	loadTaskScheduleSources() (map[string]*ha.TaskScheduleSources, error)
+	saveTaskScheduleSources(sources *ha.TaskScheduleSources) error
+	deleteTaskScheduleSources(task string) error
+}
+
+// taskScheduleState is the state of the schedule of a task kept by taskScheduleKeeper.
+type taskScheduleState struct {
+	config.ScheduleState
+	// sources paused by the schedule, source -> who paused it, ha.PausedBySchedule or ha.PausedByUser.
+	pausedSources map[string]string
+	// sources throttled by the schedule, source -> window name.
+	throttledSources map[string]string
+	// the task is just started and has no persisted sources, so the subtasks paused at the start of the task
+	// should be taken over.
+	newTask bool
+	// the JSON of the persisted sources, empty if not persisted yet.
+	saved string
+}
+
+// taskScheduleKeeper enforces the deferred start and maintenance windows of tasks, it runs on the leader.
+// Before the start time, the subtasks are paused. In a pausing window, the subtasks in the sync unit are paused,
+// and in a throttling window the throttle of the window is applied to them. When the phase changes, only the
+// sources paused or throttled by the schedule are resumed or restored to the throttle of the task config, so
+// the stages changed by the user are respected. The sources paused by the schedule and then paused by the user
+// are recorded as paused by the user and are not resumed. The sources paused or throttled by the schedule are
+// persisted into etcd before changing them, so they're still known after the leader changes.
+type taskScheduleKeeper struct {
+	logger log.Logger
+	ops    scheduleOperator
+	now    func() time.Time
+
+	// stateMu makes the stages changed by the user and by the checking goroutine not interleaved.
+	stateMu sync.Mutex
+	states  map[string]*taskScheduleState
+	// whether the persisted sources are loaded into states.
+	loaded bool
+
+	mu       sync.RWMutex
+	statuses map[string]*pb.TaskScheduleStatus
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+func newTaskScheduleKeeper(pLogger *log.Logger, ops scheduleOperator) *taskScheduleKeeper {
+	return &taskScheduleKeeper{
+		logger:   pLogger.WithFields(zap.String("component", "task schedule")),
+		ops:      ops,
+		now:      time.Now,
+		states:   make(map[string]*taskScheduleState),
+		statuses: make(map[string]*pb.TaskScheduleStatus),
+	}
+}
+
+// Start starts checking the schedules of tasks in the background.
+func (k *taskScheduleKeeper) Start(pCtx context.Context) {
+	k.Close()
+
+	ctx, cancel := context.WithCancel(pCtx)
+	k.cancel = cancel
+	k.stateMu.Lock()
+	k.states = make(map[string]*taskScheduleState)
+	k.loaded = false
+	k.stateMu.Unlock()
+	k.wg.Add(1)
+	go func() {
+		defer k.wg.Done()
+		ticker := time.NewTicker(taskScheduleCheckInterval)
+		defer ticker.Stop()
+		for {
+			k.check(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	k.logger.Info("task schedule keeper has started")
+}
+
+// Close stops checking the schedules of tasks.
+func (k *taskScheduleKeeper) Close() {
+	if k.cancel == nil {
+		return
+	}
+	k.cancel()
+	k.wg.Wait()
+	k.cancel = nil
+
+	k.mu.Lock()
+	k.statuses = make(map[string]*pb.TaskScheduleStatus)
+	k.mu.Unlock()
+	k.logger.Info("task schedule keeper has closed")
+}
+
+// Status returns the schedule status of the task, nil if the task has no schedule or is not checked yet.
+func (k *taskScheduleKeeper) Status(task string) *pb.TaskScheduleStatus {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.statuses[task]
+}
+
+// UpdateSubTaskStageByUser updates the expectant stage of the subtasks of the task for the user. Before pausing
+// the subtasks, the sources already paused by the schedule are recorded as paused by the user, so they're not
+// resumed when the phase of the schedule changes.
+func (k *taskScheduleKeeper) UpdateSubTaskStageByUser(stage pb.Stage, task string, sources []string) error {
+	k.stateMu.Lock()
+	defer k.stateMu.Unlock()
+
+	if stage == pb.Stage_Paused && hasSchedule(k.ops.subTaskCfgs()[task]) {
+		if err := k.markPausedByUser(task, sources); err != nil {
+			return err
+		}
+	}
+	return k.ops.updateSubTaskStage(stage, task, sources)
+}
+
+// markPausedByUser records the sources paused by the schedule as paused by the user.
+func (k *taskScheduleKeeper) markPausedByUser(task string, sources []string) error {
+	if err := k.load(); err != nil {
+		return err
+	}
+	st, ok := k.states[task]
+	if !ok {
+		return nil
+	}
+	var marked []string
+	for _, source := range sources {
+		if st.pausedSources[source] == ha.PausedBySchedule {
+			st.pausedSources[source] = ha.PausedByUser
+			marked = append(marked, source)
+		}
+	}
+	if err := k.persist(task, st); err != nil {
+		for _, source := range marked {
+			st.pausedSources[source] = ha.PausedBySchedule
+		}
+		return err
+	}
+	if len(marked) > 0 {
+		k.logger.Info("subtasks paused by schedule are paused by user", zap.String("task", task), zap.Strings("sources", marked))
+	}
+	return nil
+}
+
+// load loads the persisted sources into states if they're not loaded yet.
+func (k *taskScheduleKeeper) load() error {
+	if k.loaded {
+		return nil
+	}
+	persisted, err := k.ops.loadTaskScheduleSources()
+	if err != nil {
+		return err
+	}
+	for task, ps := range persisted {
+		st := &taskScheduleState{
+			pausedSources:    make(map[string]string, len(ps.PausedSources)),
+			throttledSources: make(map[string]string, len(ps.ThrottledSources)),
+			saved:            ps.String(),
+		}
+		for source, pausedBy := range ps.PausedSources {
+			st.pausedSources[source] = pausedBy
+		}
+		for source, window := range ps.ThrottledSources {
+			st.throttledSources[source] = window
+		}
+		k.states[task] = st
+	}
+	k.loaded = true
+	return nil
+}
+
+// check enforces the schedules of all tasks once.
+func (k *taskScheduleKeeper) check(ctx context.Context) {
+	k.stateMu.Lock()
+	defer k.stateMu.Unlock()
+
+	if err := k.load(); err != nil {
+		k.logger.Warn("fail to load the sources changed by task schedules", zap.Error(err))
+		return
+	}
+
+	now := k.now()
+	cfgs := k.ops.subTaskCfgs()
+	scheduled := make(map[string]*config.TaskSchedule, len(cfgs))
+	sortedSources := make(map[string][]string, len(cfgs))
+	for task, sourceCfgs := range cfgs {
+		sources := sortedKeys(sourceCfgs)
+		if schedule := sourceCfgs[sources[0]].Schedule; schedule != nil {
+			scheduled[task] = schedule
+			sortedSources[task] = sources
+		}
+	}
+	for task, st := range k.states {
+		if _, ok := scheduled[task]; ok {
+			continue
+		}
+		if st.saved != "" {
+			if err := k.ops.deleteTaskScheduleSources(task); err != nil {
+				k.logger.Warn("fail to delete the sources changed by task schedule", zap.String("task", task), zap.Error(err))
+				continue
+			}
+		}
+		delete(k.states, task)
+	}
+
+	statuses := make(map[string]*pb.TaskScheduleStatus, len(scheduled))
+	for task, schedule := range scheduled {
+		state, err := schedule.StateAt(now)
+		if err != nil {
+			k.logger.Warn("invalid task schedule", zap.String("task", task), zap.Error(err))
+			if st, ok := k.states[task]; ok {
+				statuses[task] = st.toPB()
+			}
+			continue
+		}
+
+		st, ok := k.states[task]
+		if !ok {
+			st = &taskScheduleState{
+				pausedSources:    make(map[string]string),
+				throttledSources: make(map[string]string),
+				newTask:          true,
+			}
+			k.states[task] = st
+		}
+		prevPhase, prevWindow := st.Phase, st.windowName()
+		st.ScheduleState = state
+		if st.Phase != prevPhase || st.windowName() != prevWindow {
+			k.logger.Info("task schedule phase changes", zap.String("task", task),
+				zap.String("phase", st.Phase), zap.String("window", st.windowName()))
+		}
+		k.enforce(ctx, task, sortedSources[task], cfgs[task], st)
+		st.newTask = false
+		if err := k.persist(task, st); err != nil {
+			k.logger.Warn("fail to persist the sources changed by task schedule", zap.String("task", task), zap.Error(err))
+		}
+		statuses[task] = st.toPB()
+	}
+
+	k.mu.Lock()
+	k.statuses = statuses
+	k.mu.Unlock()
+}
+
+func (k *taskScheduleKeeper) enforce(
+	ctx context.Context,
+	task string,
+	sources []string,
+	sourceCfgs map[string]config.SubTaskConfig,
+	st *taskScheduleState,
+) {
+	var running []string
+	for _, source := range sources {
+		switch k.ops.expectSubTaskStage(task, source) {
+		case pb.Stage_Running:
+			running = append(running, source)
+		case pb.Stage_Paused:
+			// the subtasks of a task started before the start time are paused by initialStage.
+			if st.newTask && st.Phase == config.SchedulePhaseWaitingStart {
+				st.pausedSources[source] = ha.PausedBySchedule
+			}
+		}
+	}
+
+	switch st.Phase {
+	case config.SchedulePhaseWaitingStart, config.SchedulePhaseMaintenance:
+		if st.Phase == config.SchedulePhaseMaintenance && len(running) > 0 {
+			// only the incremental replication is paused by the maintenance window.
+			running = k.ops.syncingSources(ctx, task, running)
+		}
+		if len(running) == 0 {
+			return
+		}
+		// persist the sources before pausing them, otherwise they may be left paused after the leader changes.
+		for _, source := range running {
+			st.pausedSources[source] = ha.PausedBySchedule
+		}
+		err := k.persist(task, st)
+		if err == nil {
+			err = k.ops.updateSubTaskStage(pb.Stage_Paused, task, running)
+		}
+		if err != nil {
+			k.logger.Warn("fail to pause subtasks by schedule", zap.String("task", task), zap.Strings("sources", running), zap.Error(err))
+			for _, source := range running {
+				delete(st.pausedSources, source)
+			}
+			return
+		}
+		k.logger.Info("pause subtasks by schedule", zap.String("task", task), zap.Strings("sources", running))
+		return
+	}
+
+	k.resume(task, st)
+
+	if st.Phase == config.SchedulePhaseThrottled {
+		var toThrottle []string
+		for _, source := range running {
+			if st.throttledSources[source] != st.Window.Name {
+				toThrottle = append(toThrottle, source)
+			}
+		}
+		if len(toThrottle) > 0 {
+			toThrottle = k.ops.syncingSources(ctx, task, toThrottle)
+		}
+		if len(toThrottle) == 0 {
+			return
+		}
+		prevWindows := make(map[string]string, len(toThrottle))
+		for _, source := range toThrottle {
+			prevWindows[source] = st.throttledSources[source]
+			st.throttledSources[source] = st.Window.Name
+		}
+		err := k.persist(task, st)
+		if err == nil {
+			err = k.ops.updateSubTaskThrottle(ctx, task, *st.Window.Throttle, toThrottle)
+		}
+		if err != nil {
+			k.logger.Warn("fail to throttle subtasks by schedule", zap.String("task", task), zap.Strings("sources", toThrottle), zap.Error(err))
+			// a source may be partly throttled, so keep it to be restored if it's throttled by a previous window.
+			for source, window := range prevWindows {
+				if window == "" {
+					delete(st.throttledSources, source)
+				} else {
+					st.throttledSources[source] = window
+				}
+			}
+			return
+		}
+		k.logger.Info("throttle subtasks by schedule", zap.String("task", task), zap.String("window", st.Window.Name), zap.Strings("sources", toThrottle))
+		return
+	}
+
+	for _, source := range sortedKeys(st.throttledSources) {
+		cfg := sourceCfgs[source]
+		if err := k.ops.updateSubTaskThrottle(ctx, task, cfg.SyncerConfig.Throttle, []string{source}); err != nil {
+			k.logger.Warn("fail to restore throttle of subtask", zap.String("task", task), zap.String("source", source), zap.Error(err))
+			continue
+		}
+		delete(st.throttledSources, source)
+	}
+}
+
+// resume resumes the sources paused by the schedule if they're still paused, the sources paused by the user
+// are kept paused.
+func (k *taskScheduleKeeper) resume(task string, st *taskScheduleState) {
+	var toResume []string
+	for _, source := range sortedKeys(st.pausedSources) {
+		switch {
+		case k.ops.expectSubTaskStage(task, source) != pb.Stage_Paused:
+			delete(st.pausedSources, source)
+		case st.pausedSources[source] == ha.PausedBySchedule:
+			toResume = append(toResume, source)
+		}
+	}
+	if len(toResume) == 0 {
+		return
+	}
+	if err := k.ops.updateSubTaskStage(pb.Stage_Running, task, toResume); err != nil {
+		k.logger.Warn("fail to resume subtasks by schedule", zap.String("task", task), zap.Strings("sources", toResume), zap.Error(err))
+		return
+	}
+	k.logger.Info("resume subtasks by schedule", zap.String("task", task), zap.Strings("sources", toResume))
+	for _, source := range toResume {
+		delete(st.pausedSources, source)
+	}
+}
+
+// persist saves the sources changed by the schedule of the task if they're changed since the last save.
+func (k *taskScheduleKeeper) persist(task string, st *taskScheduleState) error {
+	sources := &ha.TaskScheduleSources{Task: task}
+	if len(st.pausedSources) > 0 {
+		sources.PausedSources = make(map[string]string, len(st.pausedSources))
+		for source, pausedBy := range st.pausedSources {
+			sources.PausedSources[source] = pausedBy
+		}
+	}
+	if len(st.throttledSources) > 0 {
+		sources.ThrottledSources = make(map[string]string, len(st.throttledSources))
+		for source, window := range st.throttledSources {
+			sources.ThrottledSources[source] = window
+		}
+	}
+	saved := sources.String()
+	if saved == st.saved {
+		return nil
+	}
+	if err := k.ops.saveTaskScheduleSources(sources); err != nil {
+		return err
+	}
+	st.saved = saved
+	return nil
+}
+
+func (st *taskScheduleState) windowName() string {
+	if st.Window == nil {
+		return ""
+	}
+	return st.Window.Name
+}
+
+func (st *taskScheduleState) toPB() *pb.TaskScheduleStatus {
+	status := &pb.TaskScheduleStatus{
+		Phase:  st.Phase,
+		Window: st.windowName(),
+	}
+	for _, source := range sortedKeys(st.pausedSources) {
+		if st.pausedSources[source] == ha.PausedBySchedule {
+			status.PausedSources = append(status.PausedSources, source)
+		}
+	}
+	if !st.NextChange.IsZero() {
+		status.NextChange = st.NextChange.Format(time.RFC3339)
+	}
+	return status
+}
+
+func taskScheduleStatusToOpenAPI(status *pb.TaskScheduleStatus) *openapi.TaskScheduleStatus {
+	ret := &openapi.TaskScheduleStatus{Phase: status.Phase}
+	if status.Window != "" {
+		ret.Window = &status.Window
+	}
+	if status.NextChange != "" {
+		ret.NextChange = &status.NextChange
+	}
+	if len(status.PausedSources) > 0 {
+		ret.PausedSources = &status.PausedSources
+	}
+	return ret
+}
+
+// initialStage returns the expectant stage of the subtasks when the task is started.
+func initialStage(schedule *config.TaskSchedule, now time.Time) pb.Stage {
+	if schedule == nil {
+		return pb.Stage_Running
+	}
+	state, err := schedule.StateAt(now)
+	if err == nil && state.Phase == config.SchedulePhaseWaitingStart {
+		return pb.Stage_Paused
+	}
+	return pb.Stage_Running
+}
+
+func hasSchedule(sourceCfgs map[string]config.SubTaskConfig) bool {
+	for _, cfg := range sourceCfgs {
+		return cfg.Schedule != nil
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// subTaskCfgs implements scheduleOperator.
+func (s *Server) subTaskCfgs() map[string]map[string]config.SubTaskConfig {
+	return s.scheduler.GetSubTaskCfgs()
+}
+
+// expectSubTaskStage implements scheduleOperator.
+func (s *Server) expectSubTaskStage(task, source string) pb.Stage {
+	return s.scheduler.GetExpectSubTaskStage(task, source).Expect
+}
+
+// updateSubTaskStage implements scheduleOperator.
+func (s *Server) updateSubTaskStage(stage pb.Stage, task string, sources []string) error {
+	return s.scheduler.UpdateExpectSubTaskStage(stage, task, sources...)
+}
+
+// syncingSources implements scheduleOperator.
+func (s *Server) syncingSources(ctx context.Context, task string, sources []string) []string {
+	var ret []string
+	for _, resp := range s.getStatusFromWorkers(ctx, sources, task, false, false) {
+		if !resp.Result || resp.SourceStatus == nil {
+			continue
+		}
+		for _, st := range resp.SubTaskStatus {
+			if st.Name == task && st.Unit == pb.UnitType_Sync {
+				ret = append(ret, resp.SourceStatus.Source)
+			}
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// updateSubTaskThrottle implements scheduleOperator.
+func (s *Server) updateSubTaskThrottle(ctx context.Context, task string, cfg config.ThrottleConfig, sources []string) error {
+	req := &pb.UpdateThrottleWorkerRequest{
+		TaskName:       task,
+		RowsPerSecond:  int64(cfg.RowsPerSecond),
+		BytesPerSecond: cfg.BytesPerSecond,
+	}
+	if cfg.TargetLatency.Duration > 0 {
+		req.TargetLatency = cfg.TargetLatency.Duration.String()
+	}
+	for _, resp := range s.updateThrottle(ctx, req, sources) {
+		if !resp.Result {
+			return terror.ErrMasterOperRespNotSuccess.Generate(resp.Msg)
+		}
+	}
+	return nil
+}
+
+// loadTaskScheduleSources implements scheduleOperator.
+func (s *Server) loadTaskScheduleSources() (map[string]*ha.TaskScheduleSources, error) {
+	return ha.GetAllTaskScheduleSources(s.etcdClient)
+}
+
+// saveTaskScheduleSources implements scheduleOperator.
+func (s *Server) saveTaskScheduleSources(sources *ha.TaskScheduleSources) error {
+	return ha.PutTaskScheduleSources(s.etcdClient, sources)
+}
+
+// deleteTaskScheduleSources implements scheduleOperator.
+func (s *Server) deleteTaskScheduleSources(task string) error {
+	return ha.DeleteTaskScheduleSources(s.etcdClient, task)
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+type mockScheduleOperator struct {
+	cfgs      map[string]map[string]config.SubTaskConfig
+	stages    map[string]pb.Stage
+	syncing   map[string]bool
+	throttles map[string]config.ThrottleConfig
+	persisted map[string]*ha.TaskScheduleSources
+}
+
+func (m *mockScheduleOperator) subTaskCfgs() map[string]map[string]config.SubTaskConfig {
+	return m.cfgs
+}
+
+func (m *mockScheduleOperator) expectSubTaskStage(task, source string) pb.Stage {
+	return m.stages[source]
+}
+
+func (m *mockScheduleOperator) updateSubTaskStage(stage pb.Stage, task string, sources []string) error {
+	for _, source := range sources {
+		m.stages[source] = stage
+	}
+	return nil
+}
+
+func (m *mockScheduleOperator) syncingSources(ctx context.Context, task string, sources []string) []string {
+	var ret []string
+	for _, source := range sources {
+		if m.syncing[source] {
+			ret = append(ret, source)
+		}
+	}
+	return ret
+}
+
+func (m *mockScheduleOperator) updateSubTaskThrottle(ctx context.Context, task string, cfg config.ThrottleConfig, sources []string) error {
+	for _, source := range sources {
+		m.throttles[source] = cfg
+	}
+	return nil
+}
+
+func (m *mockScheduleOperator) loadTaskScheduleSources() (map[string]*ha.TaskScheduleSources, error) {
+	ret := make(map[string]*ha.TaskScheduleSources, len(m.persisted))
+	for task, sources := range m.persisted {
+		ret[task] = sources
+	}
+	return ret, nil
+}
+
+func (m *mockScheduleOperator) saveTaskScheduleSources(sources *ha.TaskScheduleSources) error {
+	m.persisted[sources.Task] = sources
+	return nil
+}
+
+func (m *mockScheduleOperator) deleteTaskScheduleSources(task string) error {
+	delete(m.persisted, task)
+	return nil
+}
+
+func TestTaskScheduleKeeper(t *testing.T) {
+	schedule := &config.TaskSchedule{
+		StartTime: "2024-03-01 02:00:00",
+		MaintenanceWindows: []*config.MaintenanceWindow{
+			{Name: "batch", Cron: "0 3 * * *", Duration: config.Duration{Duration: time.Hour}},
+			{Name: "report", Cron: "0 5 * * *", Duration: config.Duration{Duration: time.Hour}, Throttle: &config.ThrottleConfig{RowsPerSecond: 10}},
+		},
+	}
+	require.NoError(t, schedule.Adjust())
+	cfgs := map[string]config.SubTaskConfig{}
+	for _, source := range []string{"source1", "source2"} {
+		cfg := config.SubTaskConfig{Name: "task", SourceID: source, Schedule: schedule}
+		cfg.SyncerConfig.Throttle.RowsPerSecond = 1000
+		cfgs[source] = cfg
+	}
+	ops := &mockScheduleOperator{
+		cfgs:      map[string]map[string]config.SubTaskConfig{"task": cfgs},
+		stages:    map[string]pb.Stage{"source1": pb.Stage_Paused, "source2": pb.Stage_Paused},
+		syncing:   map[string]bool{},
+		throttles: map[string]config.ThrottleConfig{},
+		persisted: map[string]*ha.TaskScheduleSources{},
+	}
+	require.Equal(t, pb.Stage_Paused, initialStage(schedule, time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC)))
+	require.Equal(t, pb.Stage_Running, initialStage(schedule, time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)))
+	require.Equal(t, pb.Stage_Running, initialStage(nil, time.Time{}))
+
+	logger := log.L()
+	k := newTaskScheduleKeeper(&logger, ops)
+	var now time.Time
+	k.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// the subtasks created before the start time are kept paused
+	now = time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC)
+	k.check(ctx)
+	status := k.Status("task")
+	require.Equal(t, config.SchedulePhaseWaitingStart, status.Phase)
+	require.Equal(t, "2024-03-01T02:00:00Z", status.NextChange)
+	require.Equal(t, []string{"source1", "source2"}, status.PausedSources)
+	require.Equal(t, map[string]string{"source1": ha.PausedBySchedule, "source2": ha.PausedBySchedule}, ops.persisted["task"].PausedSources)
+
+	// resumed at the start time
+	now = time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+	k.check(ctx)
+	require.Equal(t, pb.Stage_Running, ops.stages["source1"])
+	require.Equal(t, pb.Stage_Running, ops.stages["source2"])
+	require.Equal(t, config.SchedulePhaseNormal, k.Status("task").Phase)
+	require.Empty(t, k.Status("task").PausedSources)
+
+	// only the subtask in the sync unit is paused by the maintenance window
+	ops.syncing["source1"] = true
+	now = time.Date(2024, 3, 1, 3, 0, 0, 0, time.UTC)
+	k.check(ctx)
+	require.Equal(t, pb.Stage_Paused, ops.stages["source1"])
+	require.Equal(t, pb.Stage_Running, ops.stages["source2"])
+	status = k.Status("task")
+	require.Equal(t, config.SchedulePhaseMaintenance, status.Phase)
+	require.Equal(t, "batch", status.Window)
+	require.Equal(t, []string{"source1"}, status.PausedSources)
+	require.Equal(t, map[string]string{"source1": ha.PausedBySchedule}, ops.persisted["task"].PausedSources)
+
+	// the subtask stopped by the user is not resumed
+	ops.stages["source1"] = pb.Stage_Stopped
+	now = time.Date(2024, 3, 1, 4, 0, 0, 0, time.UTC)
+	k.check(ctx)
+	require.Equal(t, pb.Stage_Stopped, ops.stages["source1"])
+	require.Empty(t, k.Status("task").PausedSources)
+
+	// throttled by the window and restored after it
+	ops.stages["source1"] = pb.Stage_Running
+	ops.syncing["source2"] = true
+	now = time.Date(2024, 3, 1, 5, 0, 0, 0, time.UTC)
+	k.check(ctx)
+	require.Equal(t, config.SchedulePhaseThrottled, k.Status("task").Phase)
+	require.Equal(t, 10, ops.throttles["source1"].RowsPerSecond)
+	require.Equal(t, 10, ops.throttles["source2"].RowsPerSecond)
+	require.Equal(t, map[string]string{"source1": "report", "source2": "report"}, ops.persisted["task"].ThrottledSources)
+	now = time.Date(2024, 3, 1, 6, 0, 0, 0, time.UTC)
+	k.check(ctx)
+	require.Equal(t, 1000, ops.throttles["source1"].RowsPerSecond)
+	require.Equal(t, 1000, ops.throttles["source2"].RowsPerSecond)
+	require.Empty(t, ops.persisted["task"].ThrottledSources)
+
+	// source1 is paused by the maintenance window and source2 is paused by the user before the leader changes
+	now = time.Date(2024, 3, 2, 3, 0, 0, 0, time.UTC)
+	ops.syncing["source2"] = false
+	k.check(ctx)
+	ops.stages["source2"] = pb.Stage_Paused
+	require.Equal(t, map[string]string{"source1": ha.PausedBySchedule}, ops.persisted["task"].PausedSources)
+
+	// the new leader only resumes the subtask paused by the schedule
+	k2 := newTaskScheduleKeeper(&logger, ops)
+	k2.now = func() time.Time { return now }
+	now = time.Date(2024, 3, 2, 3, 30, 0, 0, time.UTC)
+	k2.check(ctx)
+	require.Equal(t, []string{"source1"}, k2.Status("task").PausedSources)
+	now = time.Date(2024, 3, 2, 4, 0, 0, 0, time.UTC)
+	k2.check(ctx)
+	require.Equal(t, pb.Stage_Running, ops.stages["source1"])
+	require.Equal(t, pb.Stage_Paused, ops.stages["source2"])
+	require.Empty(t, ops.persisted["task"].PausedSources)
+
+	// source1 is paused by the maintenance window and then paused by the user
+	ops.stages["source2"] = pb.Stage_Running
+	ops.syncing["source2"] = true
+	now = time.Date(2024, 3, 3, 3, 0, 0, 0, time.UTC)
+	k2.check(ctx)
+	require.Equal(t, []string{"source1", "source2"}, k2.Status("task").PausedSources)
+	require.NoError(t, k2.UpdateSubTaskStageByUser(pb.Stage_Paused, "task", []string{"source1"}))
+	require.Equal(t, map[string]string{"source1": ha.PausedByUser, "source2": ha.PausedBySchedule}, ops.persisted["task"].PausedSources)
+
+	// only the subtask paused by the schedule is resumed after the window, also by a new leader
+	k3 := newTaskScheduleKeeper(&logger, ops)
+	k3.now = func() time.Time { return now }
+	now = time.Date(2024, 3, 3, 4, 0, 0, 0, time.UTC)
+	k3.check(ctx)
+	require.Equal(t, pb.Stage_Paused, ops.stages["source1"])
+	require.Equal(t, pb.Stage_Running, ops.stages["source2"])
+	require.Empty(t, k3.Status("task").PausedSources)
+	require.Equal(t, map[string]string{"source1": ha.PausedByUser}, ops.persisted["task"].PausedSources)
+
+	// the record is removed after the user resumes the subtask
+	require.NoError(t, k3.UpdateSubTaskStageByUser(pb.Stage_Running, "task", []string{"source1"}))
+	k3.check(ctx)
+	require.Equal(t, pb.Stage_Running, ops.stages["source1"])
+	require.Empty(t, ops.persisted["task"].PausedSources)
+
+	// the task is removed
+	delete(ops.cfgs, "task")
+	k3.check(ctx)
+	require.Nil(t, k3.Status("task"))
+	require.Empty(t, ops.persisted)
+}
@@ -53,6 +53,11 @@ const (
 	CmdGetValidationError
 	CmdOperateValidationError
 	CmdUpdateValidation
+
+	CmdOperateSyncDelay
+	CmdResyncTables
+	CmdUpdateThrottle
+	CmdUpdateRules
 )
 
 // Request wraps all dm-worker rpc requests.
@@ -75,6 +80,11 @@ type Request struct {
 	GetValidationError     *pb.GetValidationErrorRequest
 	OperateValidationError *pb.OperateValidationErrorRequest
 	UpdateValidation       *pb.UpdateValidationWorkerRequest
+
+	OperateSyncDelay *pb.OperateSyncDelayWorkerRequest
+	ResyncTables     *pb.ResyncTablesWorkerRequest
+	UpdateThrottle   *pb.UpdateThrottleWorkerRequest
+	UpdateRules      *pb.UpdateRulesWorkerRequest
 }
 
 // Response wraps all dm-worker rpc responses.
@@ -97,6 +107,11 @@ type Response struct {
 	GetValidationError     *pb.GetValidationErrorResponse
 	OperateValidationError *pb.OperateValidationErrorResponse
 	UpdateValidation       *pb.CommonWorkerResponse
+
+	OperateSyncDelay *pb.CommonWorkerResponse
+	ResyncTables     *pb.CommonWorkerResponse
+	UpdateThrottle   *pb.CommonWorkerResponse
+	UpdateRules      *pb.UpdateRulesWorkerResponse
 }
 
 // Client is a client that sends RPC.
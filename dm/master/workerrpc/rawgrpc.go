@@ -126,6 +126,14 @@ func callRPC(ctx context.Context, client pb.WorkerClient, req *Request) (*Respon
 		resp.OperateValidationError, err = client.OperateValidatorError(ctx, req.OperateValidationError)
 	case CmdUpdateValidation:
 		resp.UpdateValidation, err = client.UpdateValidator(ctx, req.UpdateValidation)
+	case CmdOperateSyncDelay:
+		resp.OperateSyncDelay, err = client.OperateSyncDelay(ctx, req.OperateSyncDelay)
+	case CmdResyncTables:
+		resp.ResyncTables, err = client.ResyncTables(ctx, req.ResyncTables)
+	case CmdUpdateThrottle:
+		resp.UpdateThrottle, err = client.UpdateThrottle(ctx, req.UpdateThrottle)
+	case CmdUpdateRules:
+		resp.UpdateRules, err = client.UpdateRules(ctx, req.UpdateRules)
 	default:
 		return nil, terror.ErrMasterGRPCInvalidReqType.Generate(req.Type)
 	}
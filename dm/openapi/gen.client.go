@@ -216,6 +216,35 @@ type ClientInterface interface {
 
 	DMAPIUpdateTask(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPIOperateTaskBinlog request with any body
+	DMAPIOperateTaskBinlogWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIOperateTaskBinlog(ctx context.Context, taskName string, body DMAPIOperateTaskBinlogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIRollbackTaskCutover request
+	DMAPIRollbackTaskCutover(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetTaskCutover request
+	DMAPIGetTaskCutover(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIStartTaskCutover request with any body
+	DMAPIStartTaskCutoverWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIStartTaskCutover(ctx context.Context, taskName string, body DMAPIStartTaskCutoverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIResyncTaskTables request with any body
+	DMAPIResyncTaskTablesWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIResyncTaskTables(ctx context.Context, taskName string, body DMAPIResyncTaskTablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetTaskShardDDLLockList request
+	DMAPIGetTaskShardDDLLockList(ctx context.Context, taskName string, params *DMAPIGetTaskShardDDLLockListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIUnlockTaskShardDDLLock request with any body
+	DMAPIUnlockTaskShardDDLLockWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIUnlockTaskShardDDLLock(ctx context.Context, taskName string, body DMAPIUnlockTaskShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIGetTaskMigrateTargets request
 	DMAPIGetTaskMigrateTargets(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -248,6 +277,37 @@ type ClientInterface interface {
 	DMAPIStopTaskWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	DMAPIStopTask(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIOperateTaskSyncDelay request with any body
+	DMAPIOperateTaskSyncDelayWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIOperateTaskSyncDelay(ctx context.Context, taskName string, body DMAPIOperateTaskSyncDelayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIUpdateTaskThrottle request with any body
+	DMAPIUpdateTaskThrottleWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIUpdateTaskThrottle(ctx context.Context, taskName string, body DMAPIUpdateTaskThrottleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetTaskValidationErrorList request
+	DMAPIGetTaskValidationErrorList(ctx context.Context, taskName string, params *DMAPIGetTaskValidationErrorListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIOperateTaskValidationError request with any body
+	DMAPIOperateTaskValidationErrorWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIOperateTaskValidationError(ctx context.Context, taskName string, body DMAPIOperateTaskValidationErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIStartTaskValidation request with any body
+	DMAPIStartTaskValidationWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIStartTaskValidation(ctx context.Context, taskName string, body DMAPIStartTaskValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetTaskValidationStatus request
+	DMAPIGetTaskValidationStatus(ctx context.Context, taskName string, params *DMAPIGetTaskValidationStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIStopTaskValidation request with any body
+	DMAPIStopTaskValidationWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIStopTaskValidation(ctx context.Context, taskName string, body DMAPIStopTaskValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) DMAPIGetClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -802,6 +862,138 @@ func (c *Client) DMAPIUpdateTask(ctx context.Context, taskName string, body DMAP
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIOperateTaskBinlogWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateTaskBinlogRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIOperateTaskBinlog(ctx context.Context, taskName string, body DMAPIOperateTaskBinlogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateTaskBinlogRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIRollbackTaskCutover(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIRollbackTaskCutoverRequest(c.Server, taskName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetTaskCutover(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetTaskCutoverRequest(c.Server, taskName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStartTaskCutoverWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStartTaskCutoverRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStartTaskCutover(ctx context.Context, taskName string, body DMAPIStartTaskCutoverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStartTaskCutoverRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIResyncTaskTablesWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIResyncTaskTablesRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIResyncTaskTables(ctx context.Context, taskName string, body DMAPIResyncTaskTablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIResyncTaskTablesRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetTaskShardDDLLockList(ctx context.Context, taskName string, params *DMAPIGetTaskShardDDLLockListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetTaskShardDDLLockListRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIUnlockTaskShardDDLLockWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIUnlockTaskShardDDLLockRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIUnlockTaskShardDDLLock(ctx context.Context, taskName string, body DMAPIUnlockTaskShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIUnlockTaskShardDDLLockRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DMAPIGetTaskMigrateTargets(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDMAPIGetTaskMigrateTargetsRequest(c.Server, taskName, sourceName, params)
 	if err != nil {
@@ -946,6 +1138,150 @@ func (c *Client) DMAPIStopTask(ctx context.Context, taskName string, body DMAPIS
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIOperateTaskSyncDelayWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateTaskSyncDelayRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIOperateTaskSyncDelay(ctx context.Context, taskName string, body DMAPIOperateTaskSyncDelayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateTaskSyncDelayRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIUpdateTaskThrottleWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIUpdateTaskThrottleRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIUpdateTaskThrottle(ctx context.Context, taskName string, body DMAPIUpdateTaskThrottleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIUpdateTaskThrottleRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetTaskValidationErrorList(ctx context.Context, taskName string, params *DMAPIGetTaskValidationErrorListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetTaskValidationErrorListRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIOperateTaskValidationErrorWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateTaskValidationErrorRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIOperateTaskValidationError(ctx context.Context, taskName string, body DMAPIOperateTaskValidationErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateTaskValidationErrorRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStartTaskValidationWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStartTaskValidationRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStartTaskValidation(ctx context.Context, taskName string, body DMAPIStartTaskValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStartTaskValidationRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetTaskValidationStatus(ctx context.Context, taskName string, params *DMAPIGetTaskValidationStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetTaskValidationStatusRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStopTaskValidationWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStopTaskValidationRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStopTaskValidation(ctx context.Context, taskName string, body DMAPIStopTaskValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStopTaskValidationRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewDMAPIGetClusterInfoRequest generates requests for DMAPIGetClusterInfo
 func NewDMAPIGetClusterInfoRequest(server string) (*http.Request, error) {
 	var err error
@@ -2317,81 +2653,19 @@ func NewDMAPIUpdateTaskRequestWithBody(server string, taskName string, contentTy
 	return req, nil
 }
 
-// NewDMAPIGetTaskMigrateTargetsRequest generates requests for DMAPIGetTaskMigrateTargets
-func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/migrate_targets", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	queryValues := queryURL.Query()
-
-	if params.SchemaPattern != nil {
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "schema_pattern", runtime.ParamLocationQuery, *params.SchemaPattern); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-	}
-
-	if params.TablePattern != nil {
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_pattern", runtime.ParamLocationQuery, *params.TablePattern); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// NewDMAPIOperateTaskBinlogRequest calls the generic DMAPIOperateTaskBinlog builder with application/json body
+func NewDMAPIOperateTaskBinlogRequest(server string, taskName string, body DMAPIOperateTaskBinlogJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIOperateTaskBinlogRequestWithBody(server, taskName, "application/json", bodyReader)
 }
 
-// NewDMAPIGetSchemaListByTaskAndSourceRequest generates requests for DMAPIGetSchemaListByTaskAndSource
-func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string, sourceName string) (*http.Request, error) {
+// NewDMAPIOperateTaskBinlogRequestWithBody generates requests for DMAPIOperateTaskBinlog with any type of body
+func NewDMAPIOperateTaskBinlogRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2401,19 +2675,12 @@ func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/binlog", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2423,64 +2690,18 @@ func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewDMAPIGetTableListByTaskAndSourceRequest generates requests for DMAPIGetTableListByTaskAndSource
-func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string, sourceName string, schemaName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	req.Header.Add("Content-Type", contentType)
 
 	return req, nil
 }
 
-// NewDMAPIDeleteTableStructureRequest generates requests for DMAPIDeleteTableStructure
-func NewDMAPIDeleteTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string) (*http.Request, error) {
+// NewDMAPIRollbackTaskCutoverRequest generates requests for DMAPIRollbackTaskCutover
+func NewDMAPIRollbackTaskCutoverRequest(server string, taskName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2490,33 +2711,12 @@ func NewDMAPIDeleteTableStructureRequest(server string, taskName string, sourceN
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/cutover", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2534,8 +2734,8 @@ func NewDMAPIDeleteTableStructureRequest(server string, taskName string, sourceN
 	return req, nil
 }
 
-// NewDMAPIGetTableStructureRequest generates requests for DMAPIGetTableStructure
-func NewDMAPIGetTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string) (*http.Request, error) {
+// NewDMAPIGetTaskCutoverRequest generates requests for DMAPIGetTaskCutover
+func NewDMAPIGetTaskCutoverRequest(server string, taskName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2545,33 +2745,12 @@ func NewDMAPIGetTableStructureRequest(server string, taskName string, sourceName
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/cutover", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2589,19 +2768,19 @@ func NewDMAPIGetTableStructureRequest(server string, taskName string, sourceName
 	return req, nil
 }
 
-// NewDMAPIOperateTableStructureRequest calls the generic DMAPIOperateTableStructure builder with application/json body
-func NewDMAPIOperateTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody) (*http.Request, error) {
+// NewDMAPIStartTaskCutoverRequest calls the generic DMAPIStartTaskCutover builder with application/json body
+func NewDMAPIStartTaskCutoverRequest(server string, taskName string, body DMAPIStartTaskCutoverJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIOperateTableStructureRequestWithBody(server, taskName, sourceName, schemaName, tableName, "application/json", bodyReader)
+	return NewDMAPIStartTaskCutoverRequestWithBody(server, taskName, "application/json", bodyReader)
 }
 
-// NewDMAPIOperateTableStructureRequestWithBody generates requests for DMAPIOperateTableStructure with any type of body
-func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIStartTaskCutoverRequestWithBody generates requests for DMAPIStartTaskCutover with any type of body
+func NewDMAPIStartTaskCutoverRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2611,33 +2790,12 @@ func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/cutover", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2647,7 +2805,7 @@ func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2657,19 +2815,19 @@ func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string
 	return req, nil
 }
 
-// NewDMAPIStartTaskRequest calls the generic DMAPIStartTask builder with application/json body
-func NewDMAPIStartTaskRequest(server string, taskName string, body DMAPIStartTaskJSONRequestBody) (*http.Request, error) {
+// NewDMAPIResyncTaskTablesRequest calls the generic DMAPIResyncTaskTables builder with application/json body
+func NewDMAPIResyncTaskTablesRequest(server string, taskName string, body DMAPIResyncTaskTablesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIStartTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+	return NewDMAPIResyncTaskTablesRequestWithBody(server, taskName, "application/json", bodyReader)
 }
 
-// NewDMAPIStartTaskRequestWithBody generates requests for DMAPIStartTask with any type of body
-func NewDMAPIStartTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIResyncTaskTablesRequestWithBody generates requests for DMAPIResyncTaskTables with any type of body
+func NewDMAPIResyncTaskTablesRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2684,7 +2842,7 @@ func NewDMAPIStartTaskRequestWithBody(server string, taskName string, contentTyp
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/start", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/resync-tables", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2704,8 +2862,8 @@ func NewDMAPIStartTaskRequestWithBody(server string, taskName string, contentTyp
 	return req, nil
 }
 
-// NewDMAPIGetTaskStatusRequest generates requests for DMAPIGetTaskStatus
-func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIGetTaskStatusParams) (*http.Request, error) {
+// NewDMAPIGetTaskShardDDLLockListRequest generates requests for DMAPIGetTaskShardDDLLockList
+func NewDMAPIGetTaskShardDDLLockListRequest(server string, taskName string, params *DMAPIGetTaskShardDDLLockListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2720,7 +2878,7 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/status", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/shard-ddl-locks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2756,19 +2914,19 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 	return req, nil
 }
 
-// NewDMAPIStopTaskRequest calls the generic DMAPIStopTask builder with application/json body
-func NewDMAPIStopTaskRequest(server string, taskName string, body DMAPIStopTaskJSONRequestBody) (*http.Request, error) {
+// NewDMAPIUnlockTaskShardDDLLockRequest calls the generic DMAPIUnlockTaskShardDDLLock builder with application/json body
+func NewDMAPIUnlockTaskShardDDLLockRequest(server string, taskName string, body DMAPIUnlockTaskShardDDLLockJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIStopTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+	return NewDMAPIUnlockTaskShardDDLLockRequestWithBody(server, taskName, "application/json", bodyReader)
 }
 
-// NewDMAPIStopTaskRequestWithBody generates requests for DMAPIStopTask with any type of body
-func NewDMAPIStopTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIUnlockTaskShardDDLLockRequestWithBody generates requests for DMAPIUnlockTaskShardDDLLock with any type of body
+func NewDMAPIUnlockTaskShardDDLLockRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2783,7 +2941,7 @@ func NewDMAPIStopTaskRequestWithBody(server string, taskName string, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/stop", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/shard-ddl-locks/unlock", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2803,217 +2961,1629 @@ func NewDMAPIStopTaskRequestWithBody(server string, taskName string, contentType
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewDMAPIGetTaskMigrateTargetsRequest generates requests for DMAPIGetTaskMigrateTargets
+func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam1 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// DMAPIGetClusterInfo request
-	DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/migrate_targets", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DMAPIUpdateClusterInfo request with any body
-	DMAPIUpdateClusterInfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	DMAPIUpdateClusterInfoWithResponse(ctx context.Context, body DMAPIUpdateClusterInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error)
+	queryValues := queryURL.Query()
 
-	// DMAPIGetClusterMasterList request
-	DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error)
+	if params.SchemaPattern != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "schema_pattern", runtime.ParamLocationQuery, *params.SchemaPattern); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
 
-	// DMAPIOfflineMasterNode request
-	DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error)
+	if params.TablePattern != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_pattern", runtime.ParamLocationQuery, *params.TablePattern); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
 
-	// DMAPIGetClusterWorkerList request
-	DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error)
+	queryURL.RawQuery = queryValues.Encode()
 
-	// DMAPIOfflineWorkerNode request
-	DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDocJSON request
-	GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error)
+	return req, nil
+}
 
-	// GetDocHTML request
-	GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error)
+// NewDMAPIGetSchemaListByTaskAndSourceRequest generates requests for DMAPIGetSchemaListByTaskAndSource
+func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string, sourceName string) (*http.Request, error) {
+	var err error
 
-	// DMAPIGetSourceList request
-	DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error)
+	var pathParam0 string
 
-	// DMAPICreateSource request with any body
-	DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
 
-	DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+	var pathParam1 string
 
-	// DMAPIDeleteSource request
-	DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetSource request
-	DMAPIGetSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIGetSourceParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIUpdateSource request with any body
-	DMAPIUpdateSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	DMAPIUpdateSourceWithResponse(ctx context.Context, sourceName string, body DMAPIUpdateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIDisableSource request
-	DMAPIDisableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIDisableSourceResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIEnableSource request
-	DMAPIEnableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIEnableSourceResponse, error)
+	return req, nil
+}
 
-	// DMAPIDisableRelay request with any body
-	DMAPIDisableRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIDisableRelayResponse, error)
+// NewDMAPIGetTableListByTaskAndSourceRequest generates requests for DMAPIGetTableListByTaskAndSource
+func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string, sourceName string, schemaName string) (*http.Request, error) {
+	var err error
 
-	DMAPIDisableRelayWithResponse(ctx context.Context, sourceName string, body DMAPIDisableRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIDisableRelayResponse, error)
+	var pathParam0 string
 
-	// DMAPIEnableRelay request with any body
-	DMAPIEnableRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIEnableRelayResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
 
-	DMAPIEnableRelayWithResponse(ctx context.Context, sourceName string, body DMAPIEnableRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIEnableRelayResponse, error)
+	var pathParam1 string
 
-	// DMAPIPurgeRelay request with any body
-	DMAPIPurgeRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIPurgeRelayResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
 
-	DMAPIPurgeRelayWithResponse(ctx context.Context, sourceName string, body DMAPIPurgeRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIPurgeRelayResponse, error)
+	var pathParam2 string
 
-	// DMAPIGetSourceSchemaList request
-	DMAPIGetSourceSchemaListWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceSchemaListResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetSourceTableList request
-	DMAPIGetSourceTableListWithResponse(ctx context.Context, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceTableListResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetSourceStatus request
-	DMAPIGetSourceStatusWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceStatusResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DMAPITransferSource request with any body
-	DMAPITransferSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	DMAPITransferSourceWithResponse(ctx context.Context, sourceName string, body DMAPITransferSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetTaskList request
-	DMAPIGetTaskListWithResponse(ctx context.Context, params *DMAPIGetTaskListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskListResponse, error)
+	return req, nil
+}
 
-	// DMAPICreateTask request with any body
-	DMAPICreateTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskResponse, error)
+// NewDMAPIDeleteTableStructureRequest generates requests for DMAPIDeleteTableStructure
+func NewDMAPIDeleteTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string) (*http.Request, error) {
+	var err error
 
-	DMAPICreateTaskWithResponse(ctx context.Context, body DMAPICreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskResponse, error)
+	var pathParam0 string
 
-	// DMAPIConvertTask request with any body
-	DMAPIConvertTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
 
-	DMAPIConvertTaskWithResponse(ctx context.Context, body DMAPIConvertTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error)
+	var pathParam1 string
 
-	// DMAPIGetTaskTemplateList request
-	DMAPIGetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateListResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPICreateTaskTemplate request with any body
-	DMAPICreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error)
+	var pathParam2 string
 
-	DMAPICreateTaskTemplateWithResponse(ctx context.Context, body DMAPICreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIImportTaskTemplate request with any body
-	DMAPIImportTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error)
+	var pathParam3 string
 
-	DMAPIImportTaskTemplateWithResponse(ctx context.Context, body DMAPIImportTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error)
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIDeleteTaskTemplate request
-	DMAPIDeleteTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskTemplateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetTaskTemplate request
-	DMAPIGetTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DMAPUpdateTaskTemplate request
-	DMAPUpdateTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPUpdateTaskTemplateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIDeleteTask request
-	DMAPIDeleteTaskWithResponse(ctx context.Context, taskName string, params *DMAPIDeleteTaskParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetTask request
-	DMAPIGetTaskWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskResponse, error)
+	return req, nil
+}
 
-	// DMAPIUpdateTask request with any body
-	DMAPIUpdateTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error)
+// NewDMAPIGetTableStructureRequest generates requests for DMAPIGetTableStructure
+func NewDMAPIGetTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string) (*http.Request, error) {
+	var err error
 
-	DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error)
+	var pathParam0 string
 
-	// DMAPIGetTaskMigrateTargets request
-	DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetSchemaListByTaskAndSource request
-	DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error)
+	var pathParam1 string
 
-	// DMAPIGetTableListByTaskAndSource request
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIOperateTableStructureRequest calls the generic DMAPIOperateTableStructure builder with application/json body
+func NewDMAPIOperateTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIOperateTableStructureRequestWithBody(server, taskName, sourceName, schemaName, tableName, "application/json", bodyReader)
+}
+
+// NewDMAPIOperateTableStructureRequestWithBody generates requests for DMAPIOperateTableStructure with any type of body
+func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIStartTaskRequest calls the generic DMAPIStartTask builder with application/json body
+func NewDMAPIStartTaskRequest(server string, taskName string, body DMAPIStartTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIStartTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIStartTaskRequestWithBody generates requests for DMAPIStartTask with any type of body
+func NewDMAPIStartTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetTaskStatusRequest generates requests for DMAPIGetTaskStatus
+func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIGetTaskStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SourceNameList != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
+
+	if params.WithTableStatus != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "with_table_status", runtime.ParamLocationQuery, *params.WithTableStatus); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIStopTaskRequest calls the generic DMAPIStopTask builder with application/json body
+func NewDMAPIStopTaskRequest(server string, taskName string, body DMAPIStopTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIStopTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIStopTaskRequestWithBody generates requests for DMAPIStopTask with any type of body
+func NewDMAPIStopTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/stop", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIOperateTaskSyncDelayRequest calls the generic DMAPIOperateTaskSyncDelay builder with application/json body
+func NewDMAPIOperateTaskSyncDelayRequest(server string, taskName string, body DMAPIOperateTaskSyncDelayJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIOperateTaskSyncDelayRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIOperateTaskSyncDelayRequestWithBody generates requests for DMAPIOperateTaskSyncDelay with any type of body
+func NewDMAPIOperateTaskSyncDelayRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sync-delay", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIUpdateTaskThrottleRequest calls the generic DMAPIUpdateTaskThrottle builder with application/json body
+func NewDMAPIUpdateTaskThrottleRequest(server string, taskName string, body DMAPIUpdateTaskThrottleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIUpdateTaskThrottleRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIUpdateTaskThrottleRequestWithBody generates requests for DMAPIUpdateTaskThrottle with any type of body
+func NewDMAPIUpdateTaskThrottleRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/throttle", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetTaskValidationErrorListRequest generates requests for DMAPIGetTaskValidationErrorList
+func NewDMAPIGetTaskValidationErrorListRequest(server string, taskName string, params *DMAPIGetTaskValidationErrorListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/errors", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.ErrorState != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error_state", runtime.ParamLocationQuery, *params.ErrorState); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIOperateTaskValidationErrorRequest calls the generic DMAPIOperateTaskValidationError builder with application/json body
+func NewDMAPIOperateTaskValidationErrorRequest(server string, taskName string, body DMAPIOperateTaskValidationErrorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIOperateTaskValidationErrorRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIOperateTaskValidationErrorRequestWithBody generates requests for DMAPIOperateTaskValidationError with any type of body
+func NewDMAPIOperateTaskValidationErrorRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/errors", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIStartTaskValidationRequest calls the generic DMAPIStartTaskValidation builder with application/json body
+func NewDMAPIStartTaskValidationRequest(server string, taskName string, body DMAPIStartTaskValidationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIStartTaskValidationRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIStartTaskValidationRequestWithBody generates requests for DMAPIStartTaskValidation with any type of body
+func NewDMAPIStartTaskValidationRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetTaskValidationStatusRequest generates requests for DMAPIGetTaskValidationStatus
+func NewDMAPIGetTaskValidationStatusRequest(server string, taskName string, params *DMAPIGetTaskValidationStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.TableStage != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_stage", runtime.ParamLocationQuery, *params.TableStage); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIStopTaskValidationRequest calls the generic DMAPIStopTaskValidation builder with application/json body
+func NewDMAPIStopTaskValidationRequest(server string, taskName string, body DMAPIStopTaskValidationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIStopTaskValidationRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIStopTaskValidationRequestWithBody generates requests for DMAPIStopTaskValidation with any type of body
+func NewDMAPIStopTaskValidationRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/stop", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// DMAPIGetClusterInfo request
+	DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error)
+
+	// DMAPIUpdateClusterInfo request with any body
+	DMAPIUpdateClusterInfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error)
+
+	DMAPIUpdateClusterInfoWithResponse(ctx context.Context, body DMAPIUpdateClusterInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error)
+
+	// DMAPIGetClusterMasterList request
+	DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error)
+
+	// DMAPIOfflineMasterNode request
+	DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error)
+
+	// DMAPIGetClusterWorkerList request
+	DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error)
+
+	// DMAPIOfflineWorkerNode request
+	DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error)
+
+	// GetDocJSON request
+	GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error)
+
+	// GetDocHTML request
+	GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error)
+
+	// DMAPIGetSourceList request
+	DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error)
+
+	// DMAPICreateSource request with any body
+	DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+
+	DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+
+	// DMAPIDeleteSource request
+	DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error)
+
+	// DMAPIGetSource request
+	DMAPIGetSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIGetSourceParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceResponse, error)
+
+	// DMAPIUpdateSource request with any body
+	DMAPIUpdateSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error)
+
+	DMAPIUpdateSourceWithResponse(ctx context.Context, sourceName string, body DMAPIUpdateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error)
+
+	// DMAPIDisableSource request
+	DMAPIDisableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIDisableSourceResponse, error)
+
+	// DMAPIEnableSource request
+	DMAPIEnableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIEnableSourceResponse, error)
+
+	// DMAPIDisableRelay request with any body
+	DMAPIDisableRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIDisableRelayResponse, error)
+
+	DMAPIDisableRelayWithResponse(ctx context.Context, sourceName string, body DMAPIDisableRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIDisableRelayResponse, error)
+
+	// DMAPIEnableRelay request with any body
+	DMAPIEnableRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIEnableRelayResponse, error)
+
+	DMAPIEnableRelayWithResponse(ctx context.Context, sourceName string, body DMAPIEnableRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIEnableRelayResponse, error)
+
+	// DMAPIPurgeRelay request with any body
+	DMAPIPurgeRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIPurgeRelayResponse, error)
+
+	DMAPIPurgeRelayWithResponse(ctx context.Context, sourceName string, body DMAPIPurgeRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIPurgeRelayResponse, error)
+
+	// DMAPIGetSourceSchemaList request
+	DMAPIGetSourceSchemaListWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceSchemaListResponse, error)
+
+	// DMAPIGetSourceTableList request
+	DMAPIGetSourceTableListWithResponse(ctx context.Context, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceTableListResponse, error)
+
+	// DMAPIGetSourceStatus request
+	DMAPIGetSourceStatusWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceStatusResponse, error)
+
+	// DMAPITransferSource request with any body
+	DMAPITransferSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error)
+
+	DMAPITransferSourceWithResponse(ctx context.Context, sourceName string, body DMAPITransferSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error)
+
+	// DMAPIGetTaskList request
+	DMAPIGetTaskListWithResponse(ctx context.Context, params *DMAPIGetTaskListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskListResponse, error)
+
+	// DMAPICreateTask request with any body
+	DMAPICreateTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskResponse, error)
+
+	DMAPICreateTaskWithResponse(ctx context.Context, body DMAPICreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskResponse, error)
+
+	// DMAPIConvertTask request with any body
+	DMAPIConvertTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error)
+
+	DMAPIConvertTaskWithResponse(ctx context.Context, body DMAPIConvertTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error)
+
+	// DMAPIGetTaskTemplateList request
+	DMAPIGetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateListResponse, error)
+
+	// DMAPICreateTaskTemplate request with any body
+	DMAPICreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error)
+
+	DMAPICreateTaskTemplateWithResponse(ctx context.Context, body DMAPICreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error)
+
+	// DMAPIImportTaskTemplate request with any body
+	DMAPIImportTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error)
+
+	DMAPIImportTaskTemplateWithResponse(ctx context.Context, body DMAPIImportTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error)
+
+	// DMAPIDeleteTaskTemplate request
+	DMAPIDeleteTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskTemplateResponse, error)
+
+	// DMAPIGetTaskTemplate request
+	DMAPIGetTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateResponse, error)
+
+	// DMAPUpdateTaskTemplate request
+	DMAPUpdateTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPUpdateTaskTemplateResponse, error)
+
+	// DMAPIDeleteTask request
+	DMAPIDeleteTaskWithResponse(ctx context.Context, taskName string, params *DMAPIDeleteTaskParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskResponse, error)
+
+	// DMAPIGetTask request
+	DMAPIGetTaskWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskResponse, error)
+
+	// DMAPIUpdateTask request with any body
+	DMAPIUpdateTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error)
+
+	DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error)
+
+	// DMAPIOperateTaskBinlog request with any body
+	DMAPIOperateTaskBinlogWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskBinlogResponse, error)
+
+	DMAPIOperateTaskBinlogWithResponse(ctx context.Context, taskName string, body DMAPIOperateTaskBinlogJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskBinlogResponse, error)
+
+	// DMAPIRollbackTaskCutover request
+	DMAPIRollbackTaskCutoverWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIRollbackTaskCutoverResponse, error)
+
+	// DMAPIGetTaskCutover request
+	DMAPIGetTaskCutoverWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskCutoverResponse, error)
+
+	// DMAPIStartTaskCutover request with any body
+	DMAPIStartTaskCutoverWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskCutoverResponse, error)
+
+	DMAPIStartTaskCutoverWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskCutoverJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskCutoverResponse, error)
+
+	// DMAPIResyncTaskTables request with any body
+	DMAPIResyncTaskTablesWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResyncTaskTablesResponse, error)
+
+	DMAPIResyncTaskTablesWithResponse(ctx context.Context, taskName string, body DMAPIResyncTaskTablesJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResyncTaskTablesResponse, error)
+
+	// DMAPIGetTaskShardDDLLockList request
+	DMAPIGetTaskShardDDLLockListWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskShardDDLLockListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskShardDDLLockListResponse, error)
+
+	// DMAPIUnlockTaskShardDDLLock request with any body
+	DMAPIUnlockTaskShardDDLLockWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUnlockTaskShardDDLLockResponse, error)
+
+	DMAPIUnlockTaskShardDDLLockWithResponse(ctx context.Context, taskName string, body DMAPIUnlockTaskShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUnlockTaskShardDDLLockResponse, error)
+
+	// DMAPIGetTaskMigrateTargets request
+	DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error)
+
+	// DMAPIGetSchemaListByTaskAndSource request
+	DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error)
+
+	// DMAPIGetTableListByTaskAndSource request
 	DMAPIGetTableListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableListByTaskAndSourceResponse, error)
 
-	// DMAPIDeleteTableStructure request
-	DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error)
+	// DMAPIDeleteTableStructure request
+	DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error)
+
+	// DMAPIGetTableStructure request
+	DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error)
+
+	// DMAPIOperateTableStructure request with any body
+	DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error)
+
+	DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error)
+
+	// DMAPIStartTask request with any body
+	DMAPIStartTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error)
+
+	DMAPIStartTaskWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error)
+
+	// DMAPIGetTaskStatus request
+	DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error)
+
+	// DMAPIStopTask request with any body
+	DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
+
+	DMAPIStopTaskWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
+
+	// DMAPIOperateTaskSyncDelay request with any body
+	DMAPIOperateTaskSyncDelayWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskSyncDelayResponse, error)
+
+	DMAPIOperateTaskSyncDelayWithResponse(ctx context.Context, taskName string, body DMAPIOperateTaskSyncDelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskSyncDelayResponse, error)
+
+	// DMAPIUpdateTaskThrottle request with any body
+	DMAPIUpdateTaskThrottleWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskThrottleResponse, error)
+
+	DMAPIUpdateTaskThrottleWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskThrottleJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskThrottleResponse, error)
+
+	// DMAPIGetTaskValidationErrorList request
+	DMAPIGetTaskValidationErrorListWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskValidationErrorListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskValidationErrorListResponse, error)
+
+	// DMAPIOperateTaskValidationError request with any body
+	DMAPIOperateTaskValidationErrorWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskValidationErrorResponse, error)
+
+	DMAPIOperateTaskValidationErrorWithResponse(ctx context.Context, taskName string, body DMAPIOperateTaskValidationErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskValidationErrorResponse, error)
+
+	// DMAPIStartTaskValidation request with any body
+	DMAPIStartTaskValidationWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskValidationResponse, error)
+
+	DMAPIStartTaskValidationWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskValidationResponse, error)
+
+	// DMAPIGetTaskValidationStatus request
+	DMAPIGetTaskValidationStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskValidationStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskValidationStatusResponse, error)
+
+	// DMAPIStopTaskValidation request with any body
+	DMAPIStopTaskValidationWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskValidationResponse, error)
+
+	DMAPIStopTaskValidationWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskValidationResponse, error)
+}
+
+type DMAPIGetClusterInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClusterInfoResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetClusterInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetClusterInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIUpdateClusterInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClusterInfoResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIUpdateClusterInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIUpdateClusterInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetClusterMasterListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClusterMasterListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetClusterMasterListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetClusterMasterListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIOfflineMasterNodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIOfflineMasterNodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIOfflineMasterNodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetClusterWorkerListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClusterWorkerListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetClusterWorkerListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetClusterWorkerListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIOfflineWorkerNodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIOfflineWorkerNodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIOfflineWorkerNodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocJSONResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocJSONResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocJSONResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocHTMLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocHTMLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocHTMLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetSourceListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSourceListResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetSourceListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetSourceListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPICreateSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Source
+	JSON400      *ErrorWithMessage
+}
 
-	// DMAPIGetTableStructure request
-	DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPICreateSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPICreateSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIDeleteSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIDeleteSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIDeleteSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Source
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIUpdateSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Source
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIUpdateSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIUpdateSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIDisableSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIDisableSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIDisableSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIEnableSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIEnableSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIEnableSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIDisableRelayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIDisableRelayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIDisableRelayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIEnableRelayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIEnableRelayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIEnableRelayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIPurgeRelayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIPurgeRelayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIPurgeRelayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetSourceSchemaListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchemaNameList
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetSourceSchemaListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetSourceSchemaListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetSourceTableListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TableNameList
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetSourceTableListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetSourceTableListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetSourceStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSourceStatusResponse
+	JSON400      *ErrorWithMessage
+}
 
-	// DMAPIOperateTableStructure request with any body
-	DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPIGetSourceStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetSourceStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DMAPIStartTask request with any body
-	DMAPIStartTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error)
+type DMAPITransferSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
 
-	DMAPIStartTaskWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPITransferSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DMAPIGetTaskStatus request
-	DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPITransferSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DMAPIStopTask request with any body
-	DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
+type DMAPIGetTaskListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetTaskListResponse
+	JSON400      *ErrorWithMessage
+}
 
-	DMAPIStopTaskWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPIGetTaskListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type DMAPIGetClusterInfoResponse struct {
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetTaskListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPICreateTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetClusterInfoResponse
+	JSON201      *OperateTaskResponse
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetClusterInfoResponse) Status() string {
+func (r DMAPICreateTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3021,21 +4591,22 @@ func (r DMAPIGetClusterInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetClusterInfoResponse) StatusCode() int {
+func (r DMAPICreateTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIUpdateClusterInfoResponse struct {
+type DMAPIConvertTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetClusterInfoResponse
+	JSON201      *ConverterTaskResponse
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIUpdateClusterInfoResponse) Status() string {
+func (r DMAPIConvertTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3043,22 +4614,22 @@ func (r DMAPIUpdateClusterInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIUpdateClusterInfoResponse) StatusCode() int {
+func (r DMAPIConvertTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetClusterMasterListResponse struct {
+type DMAPIGetTaskTemplateListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetClusterMasterListResponse
+	JSON200      *GetTaskListResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetClusterMasterListResponse) Status() string {
+func (r DMAPIGetTaskTemplateListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3066,21 +4637,22 @@ func (r DMAPIGetClusterMasterListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetClusterMasterListResponse) StatusCode() int {
+func (r DMAPIGetTaskTemplateListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIOfflineMasterNodeResponse struct {
+type DMAPICreateTaskTemplateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *Task
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIOfflineMasterNodeResponse) Status() string {
+func (r DMAPICreateTaskTemplateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3088,22 +4660,22 @@ func (r DMAPIOfflineMasterNodeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIOfflineMasterNodeResponse) StatusCode() int {
+func (r DMAPICreateTaskTemplateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetClusterWorkerListResponse struct {
+type DMAPIImportTaskTemplateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetClusterWorkerListResponse
+	JSON202      *TaskTemplateResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetClusterWorkerListResponse) Status() string {
+func (r DMAPIImportTaskTemplateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3111,21 +4683,21 @@ func (r DMAPIGetClusterWorkerListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetClusterWorkerListResponse) StatusCode() int {
+func (r DMAPIImportTaskTemplateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIOfflineWorkerNodeResponse struct {
+type DMAPIDeleteTaskTemplateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIOfflineWorkerNodeResponse) Status() string {
+func (r DMAPIDeleteTaskTemplateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3133,20 +4705,22 @@ func (r DMAPIOfflineWorkerNodeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIOfflineWorkerNodeResponse) StatusCode() int {
+func (r DMAPIDeleteTaskTemplateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetDocJSONResponse struct {
+type DMAPIGetTaskTemplateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDocJSONResponse) Status() string {
+func (r DMAPIGetTaskTemplateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3154,20 +4728,22 @@ func (r GetDocJSONResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDocJSONResponse) StatusCode() int {
+func (r DMAPIGetTaskTemplateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetDocHTMLResponse struct {
+type DMAPUpdateTaskTemplateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDocHTMLResponse) Status() string {
+func (r DMAPUpdateTaskTemplateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3175,21 +4751,21 @@ func (r GetDocHTMLResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDocHTMLResponse) StatusCode() int {
+func (r DMAPUpdateTaskTemplateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetSourceListResponse struct {
+type DMAPIDeleteTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetSourceListResponse
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetSourceListResponse) Status() string {
+func (r DMAPIDeleteTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3197,22 +4773,21 @@ func (r DMAPIGetSourceListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSourceListResponse) StatusCode() int {
+func (r DMAPIDeleteTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPICreateSourceResponse struct {
+type DMAPIGetTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Source
-	JSON400      *ErrorWithMessage
+	JSON200      *Task
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPICreateSourceResponse) Status() string {
+func (r DMAPIGetTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3220,21 +4795,22 @@ func (r DMAPICreateSourceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPICreateSourceResponse) StatusCode() int {
+func (r DMAPIGetTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIDeleteSourceResponse struct {
+type DMAPIUpdateTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *OperateTaskResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIDeleteSourceResponse) Status() string {
+func (r DMAPIUpdateTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3242,21 +4818,21 @@ func (r DMAPIDeleteSourceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDeleteSourceResponse) StatusCode() int {
+func (r DMAPIUpdateTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetSourceResponse struct {
+type DMAPIOperateTaskBinlogResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Source
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetSourceResponse) Status() string {
+func (r DMAPIOperateTaskBinlogResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3264,22 +4840,22 @@ func (r DMAPIGetSourceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSourceResponse) StatusCode() int {
+func (r DMAPIOperateTaskBinlogResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIUpdateSourceResponse struct {
+type DMAPIRollbackTaskCutoverResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Source
+	JSON200      *TaskCutoverStatus
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIUpdateSourceResponse) Status() string {
+func (r DMAPIRollbackTaskCutoverResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3287,21 +4863,22 @@ func (r DMAPIUpdateSourceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIUpdateSourceResponse) StatusCode() int {
+func (r DMAPIRollbackTaskCutoverResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIDisableSourceResponse struct {
+type DMAPIGetTaskCutoverResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *TaskCutoverStatus
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIDisableSourceResponse) Status() string {
+func (r DMAPIGetTaskCutoverResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3309,21 +4886,22 @@ func (r DMAPIDisableSourceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDisableSourceResponse) StatusCode() int {
+func (r DMAPIGetTaskCutoverResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIEnableSourceResponse struct {
+type DMAPIStartTaskCutoverResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *TaskCutoverStatus
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIEnableSourceResponse) Status() string {
+func (r DMAPIStartTaskCutoverResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3331,21 +4909,21 @@ func (r DMAPIEnableSourceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIEnableSourceResponse) StatusCode() int {
+func (r DMAPIStartTaskCutoverResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIDisableRelayResponse struct {
+type DMAPIResyncTaskTablesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIDisableRelayResponse) Status() string {
+func (r DMAPIResyncTaskTablesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3353,21 +4931,22 @@ func (r DMAPIDisableRelayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDisableRelayResponse) StatusCode() int {
+func (r DMAPIResyncTaskTablesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIEnableRelayResponse struct {
+type DMAPIGetTaskShardDDLLockListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *GetShardDDLLockListResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIEnableRelayResponse) Status() string {
+func (r DMAPIGetTaskShardDDLLockListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3375,21 +4954,21 @@ func (r DMAPIEnableRelayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIEnableRelayResponse) StatusCode() int {
+func (r DMAPIGetTaskShardDDLLockListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIPurgeRelayResponse struct {
+type DMAPIUnlockTaskShardDDLLockResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIPurgeRelayResponse) Status() string {
+func (r DMAPIUnlockTaskShardDDLLockResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3397,22 +4976,22 @@ func (r DMAPIPurgeRelayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIPurgeRelayResponse) StatusCode() int {
+func (r DMAPIUnlockTaskShardDDLLockResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetSourceSchemaListResponse struct {
+type DMAPIGetTaskMigrateTargetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SchemaNameList
+	JSON200      *GetTaskMigrateTargetsResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetSourceSchemaListResponse) Status() string {
+func (r DMAPIGetTaskMigrateTargetsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3420,22 +4999,22 @@ func (r DMAPIGetSourceSchemaListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSourceSchemaListResponse) StatusCode() int {
+func (r DMAPIGetTaskMigrateTargetsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetSourceTableListResponse struct {
+type DMAPIGetSchemaListByTaskAndSourceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TableNameList
+	JSON200      *SchemaNameList
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetSourceTableListResponse) Status() string {
+func (r DMAPIGetSchemaListByTaskAndSourceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3443,22 +5022,22 @@ func (r DMAPIGetSourceTableListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSourceTableListResponse) StatusCode() int {
+func (r DMAPIGetSchemaListByTaskAndSourceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetSourceStatusResponse struct {
+type DMAPIGetTableListByTaskAndSourceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetSourceStatusResponse
+	JSON200      *TableNameList
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetSourceStatusResponse) Status() string {
+func (r DMAPIGetTableListByTaskAndSourceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3466,21 +5045,21 @@ func (r DMAPIGetSourceStatusResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSourceStatusResponse) StatusCode() int {
+func (r DMAPIGetTableListByTaskAndSourceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPITransferSourceResponse struct {
+type DMAPIDeleteTableStructureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPITransferSourceResponse) Status() string {
+func (r DMAPIDeleteTableStructureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3488,22 +5067,22 @@ func (r DMAPITransferSourceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPITransferSourceResponse) StatusCode() int {
+func (r DMAPIDeleteTableStructureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetTaskListResponse struct {
+type DMAPIGetTableStructureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetTaskListResponse
+	JSON200      *GetTaskTableStructureResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetTaskListResponse) Status() string {
+func (r DMAPIGetTableStructureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3511,22 +5090,21 @@ func (r DMAPIGetTaskListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskListResponse) StatusCode() int {
+func (r DMAPIGetTableStructureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPICreateTaskResponse struct {
+type DMAPIOperateTableStructureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *OperateTaskResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPICreateTaskResponse) Status() string {
+func (r DMAPIOperateTableStructureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3534,22 +5112,21 @@ func (r DMAPICreateTaskResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPICreateTaskResponse) StatusCode() int {
+func (r DMAPIOperateTableStructureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIConvertTaskResponse struct {
+type DMAPIStartTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *ConverterTaskResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIConvertTaskResponse) Status() string {
+func (r DMAPIStartTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3557,22 +5134,22 @@ func (r DMAPIConvertTaskResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIConvertTaskResponse) StatusCode() int {
+func (r DMAPIStartTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetTaskTemplateListResponse struct {
+type DMAPIGetTaskStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetTaskListResponse
+	JSON200      *GetTaskStatusResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetTaskTemplateListResponse) Status() string {
+func (r DMAPIGetTaskStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3580,22 +5157,21 @@ func (r DMAPIGetTaskTemplateListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskTemplateListResponse) StatusCode() int {
+func (r DMAPIGetTaskStatusResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPICreateTaskTemplateResponse struct {
+type DMAPIStopTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Task
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPICreateTaskTemplateResponse) Status() string {
+func (r DMAPIStopTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3603,22 +5179,21 @@ func (r DMAPICreateTaskTemplateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPICreateTaskTemplateResponse) StatusCode() int {
+func (r DMAPIStopTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIImportTaskTemplateResponse struct {
+type DMAPIOperateTaskSyncDelayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON202      *TaskTemplateResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIImportTaskTemplateResponse) Status() string {
+func (r DMAPIOperateTaskSyncDelayResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3626,21 +5201,21 @@ func (r DMAPIImportTaskTemplateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIImportTaskTemplateResponse) StatusCode() int {
+func (r DMAPIOperateTaskSyncDelayResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIDeleteTaskTemplateResponse struct {
+type DMAPIUpdateTaskThrottleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIDeleteTaskTemplateResponse) Status() string {
+func (r DMAPIUpdateTaskThrottleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3648,22 +5223,22 @@ func (r DMAPIDeleteTaskTemplateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDeleteTaskTemplateResponse) StatusCode() int {
+func (r DMAPIUpdateTaskThrottleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetTaskTemplateResponse struct {
+type DMAPIGetTaskValidationErrorListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Task
+	JSON200      *GetTaskValidationErrorListResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetTaskTemplateResponse) Status() string {
+func (r DMAPIGetTaskValidationErrorListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3671,22 +5246,21 @@ func (r DMAPIGetTaskTemplateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskTemplateResponse) StatusCode() int {
+func (r DMAPIGetTaskValidationErrorListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPUpdateTaskTemplateResponse struct {
+type DMAPIOperateTaskValidationErrorResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Task
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPUpdateTaskTemplateResponse) Status() string {
+func (r DMAPIOperateTaskValidationErrorResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3694,21 +5268,21 @@ func (r DMAPUpdateTaskTemplateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPUpdateTaskTemplateResponse) StatusCode() int {
+func (r DMAPIOperateTaskValidationErrorResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIDeleteTaskResponse struct {
+type DMAPIStartTaskValidationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIDeleteTaskResponse) Status() string {
+func (r DMAPIStartTaskValidationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3716,21 +5290,22 @@ func (r DMAPIDeleteTaskResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDeleteTaskResponse) StatusCode() int {
+func (r DMAPIStartTaskValidationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetTaskResponse struct {
+type DMAPIGetTaskValidationStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Task
+	JSON200      *GetTaskValidationStatusResponse
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetTaskResponse) Status() string {
+func (r DMAPIGetTaskValidationStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3738,22 +5313,21 @@ func (r DMAPIGetTaskResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskResponse) StatusCode() int {
+func (r DMAPIGetTaskValidationStatusResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIUpdateTaskResponse struct {
+type DMAPIStopTaskValidationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OperateTaskResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIUpdateTaskResponse) Status() string {
+func (r DMAPIStopTaskValidationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3761,815 +5335,1166 @@ func (r DMAPIUpdateTaskResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIUpdateTaskResponse) StatusCode() int {
+func (r DMAPIStopTaskValidationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
-	return 0
+	return 0
+}
+
+// DMAPIGetClusterInfoWithResponse request returning *DMAPIGetClusterInfoResponse
+func (c *ClientWithResponses) DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error) {
+	rsp, err := c.DMAPIGetClusterInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetClusterInfoResponse(rsp)
+}
+
+// DMAPIUpdateClusterInfoWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateClusterInfoResponse
+func (c *ClientWithResponses) DMAPIUpdateClusterInfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error) {
+	rsp, err := c.DMAPIUpdateClusterInfoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIUpdateClusterInfoResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIUpdateClusterInfoWithResponse(ctx context.Context, body DMAPIUpdateClusterInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error) {
+	rsp, err := c.DMAPIUpdateClusterInfo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIUpdateClusterInfoResponse(rsp)
+}
+
+// DMAPIGetClusterMasterListWithResponse request returning *DMAPIGetClusterMasterListResponse
+func (c *ClientWithResponses) DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error) {
+	rsp, err := c.DMAPIGetClusterMasterList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetClusterMasterListResponse(rsp)
+}
+
+// DMAPIOfflineMasterNodeWithResponse request returning *DMAPIOfflineMasterNodeResponse
+func (c *ClientWithResponses) DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error) {
+	rsp, err := c.DMAPIOfflineMasterNode(ctx, masterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIOfflineMasterNodeResponse(rsp)
+}
+
+// DMAPIGetClusterWorkerListWithResponse request returning *DMAPIGetClusterWorkerListResponse
+func (c *ClientWithResponses) DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error) {
+	rsp, err := c.DMAPIGetClusterWorkerList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetClusterWorkerListResponse(rsp)
+}
+
+// DMAPIOfflineWorkerNodeWithResponse request returning *DMAPIOfflineWorkerNodeResponse
+func (c *ClientWithResponses) DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error) {
+	rsp, err := c.DMAPIOfflineWorkerNode(ctx, workerName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIOfflineWorkerNodeResponse(rsp)
+}
+
+// GetDocJSONWithResponse request returning *GetDocJSONResponse
+func (c *ClientWithResponses) GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error) {
+	rsp, err := c.GetDocJSON(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocJSONResponse(rsp)
+}
+
+// GetDocHTMLWithResponse request returning *GetDocHTMLResponse
+func (c *ClientWithResponses) GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error) {
+	rsp, err := c.GetDocHTML(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocHTMLResponse(rsp)
+}
+
+// DMAPIGetSourceListWithResponse request returning *DMAPIGetSourceListResponse
+func (c *ClientWithResponses) DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error) {
+	rsp, err := c.DMAPIGetSourceList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetSourceListResponse(rsp)
+}
+
+// DMAPICreateSourceWithBodyWithResponse request with arbitrary body returning *DMAPICreateSourceResponse
+func (c *ClientWithResponses) DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error) {
+	rsp, err := c.DMAPICreateSourceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPICreateSourceResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error) {
+	rsp, err := c.DMAPICreateSource(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPICreateSourceResponse(rsp)
+}
+
+// DMAPIDeleteSourceWithResponse request returning *DMAPIDeleteSourceResponse
+func (c *ClientWithResponses) DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error) {
+	rsp, err := c.DMAPIDeleteSource(ctx, sourceName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIDeleteSourceResponse(rsp)
+}
+
+// DMAPIGetSourceWithResponse request returning *DMAPIGetSourceResponse
+func (c *ClientWithResponses) DMAPIGetSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIGetSourceParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceResponse, error) {
+	rsp, err := c.DMAPIGetSource(ctx, sourceName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetSourceResponse(rsp)
+}
+
+// DMAPIUpdateSourceWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateSourceResponse
+func (c *ClientWithResponses) DMAPIUpdateSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error) {
+	rsp, err := c.DMAPIUpdateSourceWithBody(ctx, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIUpdateSourceResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIUpdateSourceWithResponse(ctx context.Context, sourceName string, body DMAPIUpdateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error) {
+	rsp, err := c.DMAPIUpdateSource(ctx, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIUpdateSourceResponse(rsp)
+}
+
+// DMAPIDisableSourceWithResponse request returning *DMAPIDisableSourceResponse
+func (c *ClientWithResponses) DMAPIDisableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIDisableSourceResponse, error) {
+	rsp, err := c.DMAPIDisableSource(ctx, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIDisableSourceResponse(rsp)
+}
+
+// DMAPIEnableSourceWithResponse request returning *DMAPIEnableSourceResponse
+func (c *ClientWithResponses) DMAPIEnableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIEnableSourceResponse, error) {
+	rsp, err := c.DMAPIEnableSource(ctx, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIEnableSourceResponse(rsp)
+}
+
+// DMAPIDisableRelayWithBodyWithResponse request with arbitrary body returning *DMAPIDisableRelayResponse
+func (c *ClientWithResponses) DMAPIDisableRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIDisableRelayResponse, error) {
+	rsp, err := c.DMAPIDisableRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIDisableRelayResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIDisableRelayWithResponse(ctx context.Context, sourceName string, body DMAPIDisableRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIDisableRelayResponse, error) {
+	rsp, err := c.DMAPIDisableRelay(ctx, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIDisableRelayResponse(rsp)
+}
+
+// DMAPIEnableRelayWithBodyWithResponse request with arbitrary body returning *DMAPIEnableRelayResponse
+func (c *ClientWithResponses) DMAPIEnableRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIEnableRelayResponse, error) {
+	rsp, err := c.DMAPIEnableRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIEnableRelayResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIEnableRelayWithResponse(ctx context.Context, sourceName string, body DMAPIEnableRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIEnableRelayResponse, error) {
+	rsp, err := c.DMAPIEnableRelay(ctx, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIEnableRelayResponse(rsp)
+}
+
+// DMAPIPurgeRelayWithBodyWithResponse request with arbitrary body returning *DMAPIPurgeRelayResponse
+func (c *ClientWithResponses) DMAPIPurgeRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIPurgeRelayResponse, error) {
+	rsp, err := c.DMAPIPurgeRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIPurgeRelayResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIPurgeRelayWithResponse(ctx context.Context, sourceName string, body DMAPIPurgeRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIPurgeRelayResponse, error) {
+	rsp, err := c.DMAPIPurgeRelay(ctx, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIPurgeRelayResponse(rsp)
+}
+
+// DMAPIGetSourceSchemaListWithResponse request returning *DMAPIGetSourceSchemaListResponse
+func (c *ClientWithResponses) DMAPIGetSourceSchemaListWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceSchemaListResponse, error) {
+	rsp, err := c.DMAPIGetSourceSchemaList(ctx, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetSourceSchemaListResponse(rsp)
+}
+
+// DMAPIGetSourceTableListWithResponse request returning *DMAPIGetSourceTableListResponse
+func (c *ClientWithResponses) DMAPIGetSourceTableListWithResponse(ctx context.Context, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceTableListResponse, error) {
+	rsp, err := c.DMAPIGetSourceTableList(ctx, sourceName, schemaName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetSourceTableListResponse(rsp)
+}
+
+// DMAPIGetSourceStatusWithResponse request returning *DMAPIGetSourceStatusResponse
+func (c *ClientWithResponses) DMAPIGetSourceStatusWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceStatusResponse, error) {
+	rsp, err := c.DMAPIGetSourceStatus(ctx, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetSourceStatusResponse(rsp)
+}
+
+// DMAPITransferSourceWithBodyWithResponse request with arbitrary body returning *DMAPITransferSourceResponse
+func (c *ClientWithResponses) DMAPITransferSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error) {
+	rsp, err := c.DMAPITransferSourceWithBody(ctx, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPITransferSourceResponse(rsp)
 }
 
-type DMAPIGetTaskMigrateTargetsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetTaskMigrateTargetsResponse
-	JSON400      *ErrorWithMessage
+func (c *ClientWithResponses) DMAPITransferSourceWithResponse(ctx context.Context, sourceName string, body DMAPITransferSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error) {
+	rsp, err := c.DMAPITransferSource(ctx, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPITransferSourceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTaskMigrateTargetsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIGetTaskListWithResponse request returning *DMAPIGetTaskListResponse
+func (c *ClientWithResponses) DMAPIGetTaskListWithResponse(ctx context.Context, params *DMAPIGetTaskListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskListResponse, error) {
+	rsp, err := c.DMAPIGetTaskList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIGetTaskListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskMigrateTargetsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPICreateTaskWithBodyWithResponse request with arbitrary body returning *DMAPICreateTaskResponse
+func (c *ClientWithResponses) DMAPICreateTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskResponse, error) {
+	rsp, err := c.DMAPICreateTaskWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPICreateTaskResponse(rsp)
 }
 
-type DMAPIGetSchemaListByTaskAndSourceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SchemaNameList
-	JSON400      *ErrorWithMessage
+func (c *ClientWithResponses) DMAPICreateTaskWithResponse(ctx context.Context, body DMAPICreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskResponse, error) {
+	rsp, err := c.DMAPICreateTask(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPICreateTaskResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetSchemaListByTaskAndSourceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIConvertTaskWithBodyWithResponse request with arbitrary body returning *DMAPIConvertTaskResponse
+func (c *ClientWithResponses) DMAPIConvertTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error) {
+	rsp, err := c.DMAPIConvertTaskWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIConvertTaskResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSchemaListByTaskAndSourceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPIConvertTaskWithResponse(ctx context.Context, body DMAPIConvertTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error) {
+	rsp, err := c.DMAPIConvertTask(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIConvertTaskResponse(rsp)
 }
 
-type DMAPIGetTableListByTaskAndSourceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TableNameList
-	JSON400      *ErrorWithMessage
+// DMAPIGetTaskTemplateListWithResponse request returning *DMAPIGetTaskTemplateListResponse
+func (c *ClientWithResponses) DMAPIGetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateListResponse, error) {
+	rsp, err := c.DMAPIGetTaskTemplateList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTaskTemplateListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTableListByTaskAndSourceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPICreateTaskTemplateWithBodyWithResponse request with arbitrary body returning *DMAPICreateTaskTemplateResponse
+func (c *ClientWithResponses) DMAPICreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error) {
+	rsp, err := c.DMAPICreateTaskTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPICreateTaskTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTableListByTaskAndSourceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPICreateTaskTemplateWithResponse(ctx context.Context, body DMAPICreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error) {
+	rsp, err := c.DMAPICreateTaskTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPICreateTaskTemplateResponse(rsp)
 }
 
-type DMAPIDeleteTableStructureResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// DMAPIImportTaskTemplateWithBodyWithResponse request with arbitrary body returning *DMAPIImportTaskTemplateResponse
+func (c *ClientWithResponses) DMAPIImportTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error) {
+	rsp, err := c.DMAPIImportTaskTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIImportTaskTemplateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIDeleteTableStructureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DMAPIImportTaskTemplateWithResponse(ctx context.Context, body DMAPIImportTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error) {
+	rsp, err := c.DMAPIImportTaskTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIImportTaskTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDeleteTableStructureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIDeleteTaskTemplateWithResponse request returning *DMAPIDeleteTaskTemplateResponse
+func (c *ClientWithResponses) DMAPIDeleteTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskTemplateResponse, error) {
+	rsp, err := c.DMAPIDeleteTaskTemplate(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIDeleteTaskTemplateResponse(rsp)
 }
 
-type DMAPIGetTableStructureResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetTaskTableStructureResponse
-	JSON400      *ErrorWithMessage
+// DMAPIGetTaskTemplateWithResponse request returning *DMAPIGetTaskTemplateResponse
+func (c *ClientWithResponses) DMAPIGetTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateResponse, error) {
+	rsp, err := c.DMAPIGetTaskTemplate(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTaskTemplateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTableStructureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPUpdateTaskTemplateWithResponse request returning *DMAPUpdateTaskTemplateResponse
+func (c *ClientWithResponses) DMAPUpdateTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPUpdateTaskTemplateResponse, error) {
+	rsp, err := c.DMAPUpdateTaskTemplate(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPUpdateTaskTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTableStructureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIDeleteTaskWithResponse request returning *DMAPIDeleteTaskResponse
+func (c *ClientWithResponses) DMAPIDeleteTaskWithResponse(ctx context.Context, taskName string, params *DMAPIDeleteTaskParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskResponse, error) {
+	rsp, err := c.DMAPIDeleteTask(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIDeleteTaskResponse(rsp)
 }
 
-type DMAPIOperateTableStructureResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// DMAPIGetTaskWithResponse request returning *DMAPIGetTaskResponse
+func (c *ClientWithResponses) DMAPIGetTaskWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskResponse, error) {
+	rsp, err := c.DMAPIGetTask(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTaskResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIOperateTableStructureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIUpdateTaskWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateTaskResponse
+func (c *ClientWithResponses) DMAPIUpdateTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
+	rsp, err := c.DMAPIUpdateTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIUpdateTaskResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIOperateTableStructureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
+	rsp, err := c.DMAPIUpdateTask(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIUpdateTaskResponse(rsp)
 }
 
-type DMAPIStartTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// DMAPIOperateTaskBinlogWithBodyWithResponse request with arbitrary body returning *DMAPIOperateTaskBinlogResponse
+func (c *ClientWithResponses) DMAPIOperateTaskBinlogWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskBinlogResponse, error) {
+	rsp, err := c.DMAPIOperateTaskBinlogWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIOperateTaskBinlogResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIStartTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DMAPIOperateTaskBinlogWithResponse(ctx context.Context, taskName string, body DMAPIOperateTaskBinlogJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskBinlogResponse, error) {
+	rsp, err := c.DMAPIOperateTaskBinlog(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIOperateTaskBinlogResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIStartTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIRollbackTaskCutoverWithResponse request returning *DMAPIRollbackTaskCutoverResponse
+func (c *ClientWithResponses) DMAPIRollbackTaskCutoverWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIRollbackTaskCutoverResponse, error) {
+	rsp, err := c.DMAPIRollbackTaskCutover(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIRollbackTaskCutoverResponse(rsp)
 }
 
-type DMAPIGetTaskStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetTaskStatusResponse
-	JSON400      *ErrorWithMessage
+// DMAPIGetTaskCutoverWithResponse request returning *DMAPIGetTaskCutoverResponse
+func (c *ClientWithResponses) DMAPIGetTaskCutoverWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskCutoverResponse, error) {
+	rsp, err := c.DMAPIGetTaskCutover(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTaskCutoverResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTaskStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIStartTaskCutoverWithBodyWithResponse request with arbitrary body returning *DMAPIStartTaskCutoverResponse
+func (c *ClientWithResponses) DMAPIStartTaskCutoverWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskCutoverResponse, error) {
+	rsp, err := c.DMAPIStartTaskCutoverWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIStartTaskCutoverResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPIStartTaskCutoverWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskCutoverJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskCutoverResponse, error) {
+	rsp, err := c.DMAPIStartTaskCutover(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIStartTaskCutoverResponse(rsp)
 }
 
-type DMAPIStopTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// DMAPIResyncTaskTablesWithBodyWithResponse request with arbitrary body returning *DMAPIResyncTaskTablesResponse
+func (c *ClientWithResponses) DMAPIResyncTaskTablesWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResyncTaskTablesResponse, error) {
+	rsp, err := c.DMAPIResyncTaskTablesWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIResyncTaskTablesResponse(rsp)
 }
-
-// Status returns HTTPResponse.Status
-func (r DMAPIStopTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+
+func (c *ClientWithResponses) DMAPIResyncTaskTablesWithResponse(ctx context.Context, taskName string, body DMAPIResyncTaskTablesJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResyncTaskTablesResponse, error) {
+	rsp, err := c.DMAPIResyncTaskTables(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIResyncTaskTablesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIStopTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIGetTaskShardDDLLockListWithResponse request returning *DMAPIGetTaskShardDDLLockListResponse
+func (c *ClientWithResponses) DMAPIGetTaskShardDDLLockListWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskShardDDLLockListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskShardDDLLockListResponse, error) {
+	rsp, err := c.DMAPIGetTaskShardDDLLockList(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIGetTaskShardDDLLockListResponse(rsp)
 }
 
-// DMAPIGetClusterInfoWithResponse request returning *DMAPIGetClusterInfoResponse
-func (c *ClientWithResponses) DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error) {
-	rsp, err := c.DMAPIGetClusterInfo(ctx, reqEditors...)
+// DMAPIUnlockTaskShardDDLLockWithBodyWithResponse request with arbitrary body returning *DMAPIUnlockTaskShardDDLLockResponse
+func (c *ClientWithResponses) DMAPIUnlockTaskShardDDLLockWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUnlockTaskShardDDLLockResponse, error) {
+	rsp, err := c.DMAPIUnlockTaskShardDDLLockWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetClusterInfoResponse(rsp)
+	return ParseDMAPIUnlockTaskShardDDLLockResponse(rsp)
 }
 
-// DMAPIUpdateClusterInfoWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateClusterInfoResponse
-func (c *ClientWithResponses) DMAPIUpdateClusterInfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error) {
-	rsp, err := c.DMAPIUpdateClusterInfoWithBody(ctx, contentType, body, reqEditors...)
+func (c *ClientWithResponses) DMAPIUnlockTaskShardDDLLockWithResponse(ctx context.Context, taskName string, body DMAPIUnlockTaskShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUnlockTaskShardDDLLockResponse, error) {
+	rsp, err := c.DMAPIUnlockTaskShardDDLLock(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIUpdateClusterInfoResponse(rsp)
+	return ParseDMAPIUnlockTaskShardDDLLockResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIUpdateClusterInfoWithResponse(ctx context.Context, body DMAPIUpdateClusterInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error) {
-	rsp, err := c.DMAPIUpdateClusterInfo(ctx, body, reqEditors...)
+// DMAPIGetTaskMigrateTargetsWithResponse request returning *DMAPIGetTaskMigrateTargetsResponse
+func (c *ClientWithResponses) DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error) {
+	rsp, err := c.DMAPIGetTaskMigrateTargets(ctx, taskName, sourceName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIUpdateClusterInfoResponse(rsp)
+	return ParseDMAPIGetTaskMigrateTargetsResponse(rsp)
 }
 
-// DMAPIGetClusterMasterListWithResponse request returning *DMAPIGetClusterMasterListResponse
-func (c *ClientWithResponses) DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error) {
-	rsp, err := c.DMAPIGetClusterMasterList(ctx, reqEditors...)
+// DMAPIGetSchemaListByTaskAndSourceWithResponse request returning *DMAPIGetSchemaListByTaskAndSourceResponse
+func (c *ClientWithResponses) DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
+	rsp, err := c.DMAPIGetSchemaListByTaskAndSource(ctx, taskName, sourceName, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetClusterMasterListResponse(rsp)
+	return ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp)
 }
 
-// DMAPIOfflineMasterNodeWithResponse request returning *DMAPIOfflineMasterNodeResponse
-func (c *ClientWithResponses) DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error) {
-	rsp, err := c.DMAPIOfflineMasterNode(ctx, masterName, reqEditors...)
+// DMAPIGetTableListByTaskAndSourceWithResponse request returning *DMAPIGetTableListByTaskAndSourceResponse
+func (c *ClientWithResponses) DMAPIGetTableListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
+	rsp, err := c.DMAPIGetTableListByTaskAndSource(ctx, taskName, sourceName, schemaName, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIOfflineMasterNodeResponse(rsp)
+	return ParseDMAPIGetTableListByTaskAndSourceResponse(rsp)
 }
 
-// DMAPIGetClusterWorkerListWithResponse request returning *DMAPIGetClusterWorkerListResponse
-func (c *ClientWithResponses) DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error) {
-	rsp, err := c.DMAPIGetClusterWorkerList(ctx, reqEditors...)
+// DMAPIDeleteTableStructureWithResponse request returning *DMAPIDeleteTableStructureResponse
+func (c *ClientWithResponses) DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error) {
+	rsp, err := c.DMAPIDeleteTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetClusterWorkerListResponse(rsp)
+	return ParseDMAPIDeleteTableStructureResponse(rsp)
 }
 
-// DMAPIOfflineWorkerNodeWithResponse request returning *DMAPIOfflineWorkerNodeResponse
-func (c *ClientWithResponses) DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error) {
-	rsp, err := c.DMAPIOfflineWorkerNode(ctx, workerName, reqEditors...)
+// DMAPIGetTableStructureWithResponse request returning *DMAPIGetTableStructureResponse
+func (c *ClientWithResponses) DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error) {
+	rsp, err := c.DMAPIGetTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIOfflineWorkerNodeResponse(rsp)
+	return ParseDMAPIGetTableStructureResponse(rsp)
 }
 
-// GetDocJSONWithResponse request returning *GetDocJSONResponse
-func (c *ClientWithResponses) GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error) {
-	rsp, err := c.GetDocJSON(ctx, reqEditors...)
+// DMAPIOperateTableStructureWithBodyWithResponse request with arbitrary body returning *DMAPIOperateTableStructureResponse
+func (c *ClientWithResponses) DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
+	rsp, err := c.DMAPIOperateTableStructureWithBody(ctx, taskName, sourceName, schemaName, tableName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDocJSONResponse(rsp)
+	return ParseDMAPIOperateTableStructureResponse(rsp)
 }
 
-// GetDocHTMLWithResponse request returning *GetDocHTMLResponse
-func (c *ClientWithResponses) GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error) {
-	rsp, err := c.GetDocHTML(ctx, reqEditors...)
+func (c *ClientWithResponses) DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
+	rsp, err := c.DMAPIOperateTableStructure(ctx, taskName, sourceName, schemaName, tableName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDocHTMLResponse(rsp)
+	return ParseDMAPIOperateTableStructureResponse(rsp)
 }
 
-// DMAPIGetSourceListWithResponse request returning *DMAPIGetSourceListResponse
-func (c *ClientWithResponses) DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error) {
-	rsp, err := c.DMAPIGetSourceList(ctx, params, reqEditors...)
+// DMAPIStartTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStartTaskResponse
+func (c *ClientWithResponses) DMAPIStartTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
+	rsp, err := c.DMAPIStartTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetSourceListResponse(rsp)
+	return ParseDMAPIStartTaskResponse(rsp)
 }
 
-// DMAPICreateSourceWithBodyWithResponse request with arbitrary body returning *DMAPICreateSourceResponse
-func (c *ClientWithResponses) DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error) {
-	rsp, err := c.DMAPICreateSourceWithBody(ctx, contentType, body, reqEditors...)
+func (c *ClientWithResponses) DMAPIStartTaskWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
+	rsp, err := c.DMAPIStartTask(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPICreateSourceResponse(rsp)
+	return ParseDMAPIStartTaskResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error) {
-	rsp, err := c.DMAPICreateSource(ctx, body, reqEditors...)
+// DMAPIGetTaskStatusWithResponse request returning *DMAPIGetTaskStatusResponse
+func (c *ClientWithResponses) DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error) {
+	rsp, err := c.DMAPIGetTaskStatus(ctx, taskName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPICreateSourceResponse(rsp)
+	return ParseDMAPIGetTaskStatusResponse(rsp)
 }
 
-// DMAPIDeleteSourceWithResponse request returning *DMAPIDeleteSourceResponse
-func (c *ClientWithResponses) DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error) {
-	rsp, err := c.DMAPIDeleteSource(ctx, sourceName, params, reqEditors...)
+// DMAPIStopTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStopTaskResponse
+func (c *ClientWithResponses) DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error) {
+	rsp, err := c.DMAPIStopTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIDeleteSourceResponse(rsp)
+	return ParseDMAPIStopTaskResponse(rsp)
 }
 
-// DMAPIGetSourceWithResponse request returning *DMAPIGetSourceResponse
-func (c *ClientWithResponses) DMAPIGetSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIGetSourceParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceResponse, error) {
-	rsp, err := c.DMAPIGetSource(ctx, sourceName, params, reqEditors...)
+func (c *ClientWithResponses) DMAPIStopTaskWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error) {
+	rsp, err := c.DMAPIStopTask(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetSourceResponse(rsp)
+	return ParseDMAPIStopTaskResponse(rsp)
 }
 
-// DMAPIUpdateSourceWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateSourceResponse
-func (c *ClientWithResponses) DMAPIUpdateSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error) {
-	rsp, err := c.DMAPIUpdateSourceWithBody(ctx, sourceName, contentType, body, reqEditors...)
+// DMAPIOperateTaskSyncDelayWithBodyWithResponse request with arbitrary body returning *DMAPIOperateTaskSyncDelayResponse
+func (c *ClientWithResponses) DMAPIOperateTaskSyncDelayWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskSyncDelayResponse, error) {
+	rsp, err := c.DMAPIOperateTaskSyncDelayWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIUpdateSourceResponse(rsp)
+	return ParseDMAPIOperateTaskSyncDelayResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIUpdateSourceWithResponse(ctx context.Context, sourceName string, body DMAPIUpdateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error) {
-	rsp, err := c.DMAPIUpdateSource(ctx, sourceName, body, reqEditors...)
+func (c *ClientWithResponses) DMAPIOperateTaskSyncDelayWithResponse(ctx context.Context, taskName string, body DMAPIOperateTaskSyncDelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskSyncDelayResponse, error) {
+	rsp, err := c.DMAPIOperateTaskSyncDelay(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIUpdateSourceResponse(rsp)
+	return ParseDMAPIOperateTaskSyncDelayResponse(rsp)
 }
 
-// DMAPIDisableSourceWithResponse request returning *DMAPIDisableSourceResponse
-func (c *ClientWithResponses) DMAPIDisableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIDisableSourceResponse, error) {
-	rsp, err := c.DMAPIDisableSource(ctx, sourceName, reqEditors...)
+// DMAPIUpdateTaskThrottleWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateTaskThrottleResponse
+func (c *ClientWithResponses) DMAPIUpdateTaskThrottleWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskThrottleResponse, error) {
+	rsp, err := c.DMAPIUpdateTaskThrottleWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIDisableSourceResponse(rsp)
+	return ParseDMAPIUpdateTaskThrottleResponse(rsp)
 }
 
-// DMAPIEnableSourceWithResponse request returning *DMAPIEnableSourceResponse
-func (c *ClientWithResponses) DMAPIEnableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIEnableSourceResponse, error) {
-	rsp, err := c.DMAPIEnableSource(ctx, sourceName, reqEditors...)
+func (c *ClientWithResponses) DMAPIUpdateTaskThrottleWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskThrottleJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskThrottleResponse, error) {
+	rsp, err := c.DMAPIUpdateTaskThrottle(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIEnableSourceResponse(rsp)
+	return ParseDMAPIUpdateTaskThrottleResponse(rsp)
 }
 
-// DMAPIDisableRelayWithBodyWithResponse request with arbitrary body returning *DMAPIDisableRelayResponse
-func (c *ClientWithResponses) DMAPIDisableRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIDisableRelayResponse, error) {
-	rsp, err := c.DMAPIDisableRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+// DMAPIGetTaskValidationErrorListWithResponse request returning *DMAPIGetTaskValidationErrorListResponse
+func (c *ClientWithResponses) DMAPIGetTaskValidationErrorListWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskValidationErrorListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskValidationErrorListResponse, error) {
+	rsp, err := c.DMAPIGetTaskValidationErrorList(ctx, taskName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIDisableRelayResponse(rsp)
+	return ParseDMAPIGetTaskValidationErrorListResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIDisableRelayWithResponse(ctx context.Context, sourceName string, body DMAPIDisableRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIDisableRelayResponse, error) {
-	rsp, err := c.DMAPIDisableRelay(ctx, sourceName, body, reqEditors...)
+// DMAPIOperateTaskValidationErrorWithBodyWithResponse request with arbitrary body returning *DMAPIOperateTaskValidationErrorResponse
+func (c *ClientWithResponses) DMAPIOperateTaskValidationErrorWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskValidationErrorResponse, error) {
+	rsp, err := c.DMAPIOperateTaskValidationErrorWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIDisableRelayResponse(rsp)
+	return ParseDMAPIOperateTaskValidationErrorResponse(rsp)
 }
 
-// DMAPIEnableRelayWithBodyWithResponse request with arbitrary body returning *DMAPIEnableRelayResponse
-func (c *ClientWithResponses) DMAPIEnableRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIEnableRelayResponse, error) {
-	rsp, err := c.DMAPIEnableRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+func (c *ClientWithResponses) DMAPIOperateTaskValidationErrorWithResponse(ctx context.Context, taskName string, body DMAPIOperateTaskValidationErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTaskValidationErrorResponse, error) {
+	rsp, err := c.DMAPIOperateTaskValidationError(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIEnableRelayResponse(rsp)
+	return ParseDMAPIOperateTaskValidationErrorResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIEnableRelayWithResponse(ctx context.Context, sourceName string, body DMAPIEnableRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIEnableRelayResponse, error) {
-	rsp, err := c.DMAPIEnableRelay(ctx, sourceName, body, reqEditors...)
+// DMAPIStartTaskValidationWithBodyWithResponse request with arbitrary body returning *DMAPIStartTaskValidationResponse
+func (c *ClientWithResponses) DMAPIStartTaskValidationWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskValidationResponse, error) {
+	rsp, err := c.DMAPIStartTaskValidationWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIEnableRelayResponse(rsp)
+	return ParseDMAPIStartTaskValidationResponse(rsp)
 }
 
-// DMAPIPurgeRelayWithBodyWithResponse request with arbitrary body returning *DMAPIPurgeRelayResponse
-func (c *ClientWithResponses) DMAPIPurgeRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIPurgeRelayResponse, error) {
-	rsp, err := c.DMAPIPurgeRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+func (c *ClientWithResponses) DMAPIStartTaskValidationWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskValidationResponse, error) {
+	rsp, err := c.DMAPIStartTaskValidation(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIPurgeRelayResponse(rsp)
+	return ParseDMAPIStartTaskValidationResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIPurgeRelayWithResponse(ctx context.Context, sourceName string, body DMAPIPurgeRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIPurgeRelayResponse, error) {
-	rsp, err := c.DMAPIPurgeRelay(ctx, sourceName, body, reqEditors...)
+// DMAPIGetTaskValidationStatusWithResponse request returning *DMAPIGetTaskValidationStatusResponse
+func (c *ClientWithResponses) DMAPIGetTaskValidationStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskValidationStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskValidationStatusResponse, error) {
+	rsp, err := c.DMAPIGetTaskValidationStatus(ctx, taskName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIPurgeRelayResponse(rsp)
+	return ParseDMAPIGetTaskValidationStatusResponse(rsp)
 }
 
-// DMAPIGetSourceSchemaListWithResponse request returning *DMAPIGetSourceSchemaListResponse
-func (c *ClientWithResponses) DMAPIGetSourceSchemaListWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceSchemaListResponse, error) {
-	rsp, err := c.DMAPIGetSourceSchemaList(ctx, sourceName, reqEditors...)
+// DMAPIStopTaskValidationWithBodyWithResponse request with arbitrary body returning *DMAPIStopTaskValidationResponse
+func (c *ClientWithResponses) DMAPIStopTaskValidationWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskValidationResponse, error) {
+	rsp, err := c.DMAPIStopTaskValidationWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetSourceSchemaListResponse(rsp)
+	return ParseDMAPIStopTaskValidationResponse(rsp)
 }
 
-// DMAPIGetSourceTableListWithResponse request returning *DMAPIGetSourceTableListResponse
-func (c *ClientWithResponses) DMAPIGetSourceTableListWithResponse(ctx context.Context, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceTableListResponse, error) {
-	rsp, err := c.DMAPIGetSourceTableList(ctx, sourceName, schemaName, reqEditors...)
+func (c *ClientWithResponses) DMAPIStopTaskValidationWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskValidationResponse, error) {
+	rsp, err := c.DMAPIStopTaskValidation(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetSourceTableListResponse(rsp)
+	return ParseDMAPIStopTaskValidationResponse(rsp)
 }
 
-// DMAPIGetSourceStatusWithResponse request returning *DMAPIGetSourceStatusResponse
-func (c *ClientWithResponses) DMAPIGetSourceStatusWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceStatusResponse, error) {
-	rsp, err := c.DMAPIGetSourceStatus(ctx, sourceName, reqEditors...)
+// ParseDMAPIGetClusterInfoResponse parses an HTTP response from a DMAPIGetClusterInfoWithResponse call
+func ParseDMAPIGetClusterInfoResponse(rsp *http.Response) (*DMAPIGetClusterInfoResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetSourceStatusResponse(rsp)
+
+	response := &DMAPIGetClusterInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClusterInfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-// DMAPITransferSourceWithBodyWithResponse request with arbitrary body returning *DMAPITransferSourceResponse
-func (c *ClientWithResponses) DMAPITransferSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error) {
-	rsp, err := c.DMAPITransferSourceWithBody(ctx, sourceName, contentType, body, reqEditors...)
+// ParseDMAPIUpdateClusterInfoResponse parses an HTTP response from a DMAPIUpdateClusterInfoWithResponse call
+func ParseDMAPIUpdateClusterInfoResponse(rsp *http.Response) (*DMAPIUpdateClusterInfoResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPITransferSourceResponse(rsp)
+
+	response := &DMAPIUpdateClusterInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClusterInfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPITransferSourceWithResponse(ctx context.Context, sourceName string, body DMAPITransferSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error) {
-	rsp, err := c.DMAPITransferSource(ctx, sourceName, body, reqEditors...)
+// ParseDMAPIGetClusterMasterListResponse parses an HTTP response from a DMAPIGetClusterMasterListWithResponse call
+func ParseDMAPIGetClusterMasterListResponse(rsp *http.Response) (*DMAPIGetClusterMasterListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPITransferSourceResponse(rsp)
+
+	response := &DMAPIGetClusterMasterListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClusterMasterListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DMAPIGetTaskListWithResponse request returning *DMAPIGetTaskListResponse
-func (c *ClientWithResponses) DMAPIGetTaskListWithResponse(ctx context.Context, params *DMAPIGetTaskListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskListResponse, error) {
-	rsp, err := c.DMAPIGetTaskList(ctx, params, reqEditors...)
+// ParseDMAPIOfflineMasterNodeResponse parses an HTTP response from a DMAPIOfflineMasterNodeWithResponse call
+func ParseDMAPIOfflineMasterNodeResponse(rsp *http.Response) (*DMAPIOfflineMasterNodeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskListResponse(rsp)
+
+	response := &DMAPIOfflineMasterNodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
+	return response, nil
 }
 
-// DMAPICreateTaskWithBodyWithResponse request with arbitrary body returning *DMAPICreateTaskResponse
-func (c *ClientWithResponses) DMAPICreateTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskResponse, error) {
-	rsp, err := c.DMAPICreateTaskWithBody(ctx, contentType, body, reqEditors...)
+// ParseDMAPIGetClusterWorkerListResponse parses an HTTP response from a DMAPIGetClusterWorkerListWithResponse call
+func ParseDMAPIGetClusterWorkerListResponse(rsp *http.Response) (*DMAPIGetClusterWorkerListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPICreateTaskResponse(rsp)
-}
 
-func (c *ClientWithResponses) DMAPICreateTaskWithResponse(ctx context.Context, body DMAPICreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskResponse, error) {
-	rsp, err := c.DMAPICreateTask(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIGetClusterWorkerListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPICreateTaskResponse(rsp)
-}
 
-// DMAPIConvertTaskWithBodyWithResponse request with arbitrary body returning *DMAPIConvertTaskResponse
-func (c *ClientWithResponses) DMAPIConvertTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error) {
-	rsp, err := c.DMAPIConvertTaskWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClusterWorkerListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIConvertTaskResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPIConvertTaskWithResponse(ctx context.Context, body DMAPIConvertTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error) {
-	rsp, err := c.DMAPIConvertTask(ctx, body, reqEditors...)
+// ParseDMAPIOfflineWorkerNodeResponse parses an HTTP response from a DMAPIOfflineWorkerNodeWithResponse call
+func ParseDMAPIOfflineWorkerNodeResponse(rsp *http.Response) (*DMAPIOfflineWorkerNodeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIConvertTaskResponse(rsp)
-}
 
-// DMAPIGetTaskTemplateListWithResponse request returning *DMAPIGetTaskTemplateListResponse
-func (c *ClientWithResponses) DMAPIGetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateListResponse, error) {
-	rsp, err := c.DMAPIGetTaskTemplateList(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIOfflineWorkerNodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIGetTaskTemplateListResponse(rsp)
-}
 
-// DMAPICreateTaskTemplateWithBodyWithResponse request with arbitrary body returning *DMAPICreateTaskTemplateResponse
-func (c *ClientWithResponses) DMAPICreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error) {
-	rsp, err := c.DMAPICreateTaskTemplateWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 	}
-	return ParseDMAPICreateTaskTemplateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPICreateTaskTemplateWithResponse(ctx context.Context, body DMAPICreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error) {
-	rsp, err := c.DMAPICreateTaskTemplate(ctx, body, reqEditors...)
+// ParseGetDocJSONResponse parses an HTTP response from a GetDocJSONWithResponse call
+func ParseGetDocJSONResponse(rsp *http.Response) (*GetDocJSONResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPICreateTaskTemplateResponse(rsp)
-}
 
-// DMAPIImportTaskTemplateWithBodyWithResponse request with arbitrary body returning *DMAPIImportTaskTemplateResponse
-func (c *ClientWithResponses) DMAPIImportTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error) {
-	rsp, err := c.DMAPIImportTaskTemplateWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDocJSONResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIImportTaskTemplateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPIImportTaskTemplateWithResponse(ctx context.Context, body DMAPIImportTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error) {
-	rsp, err := c.DMAPIImportTaskTemplate(ctx, body, reqEditors...)
+// ParseGetDocHTMLResponse parses an HTTP response from a GetDocHTMLWithResponse call
+func ParseGetDocHTMLResponse(rsp *http.Response) (*GetDocHTMLResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIImportTaskTemplateResponse(rsp)
-}
 
-// DMAPIDeleteTaskTemplateWithResponse request returning *DMAPIDeleteTaskTemplateResponse
-func (c *ClientWithResponses) DMAPIDeleteTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskTemplateResponse, error) {
-	rsp, err := c.DMAPIDeleteTaskTemplate(ctx, taskName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDocHTMLResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIDeleteTaskTemplateResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIGetTaskTemplateWithResponse request returning *DMAPIGetTaskTemplateResponse
-func (c *ClientWithResponses) DMAPIGetTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateResponse, error) {
-	rsp, err := c.DMAPIGetTaskTemplate(ctx, taskName, reqEditors...)
+// ParseDMAPIGetSourceListResponse parses an HTTP response from a DMAPIGetSourceListWithResponse call
+func ParseDMAPIGetSourceListResponse(rsp *http.Response) (*DMAPIGetSourceListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskTemplateResponse(rsp)
-}
 
-// DMAPUpdateTaskTemplateWithResponse request returning *DMAPUpdateTaskTemplateResponse
-func (c *ClientWithResponses) DMAPUpdateTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPUpdateTaskTemplateResponse, error) {
-	rsp, err := c.DMAPUpdateTaskTemplate(ctx, taskName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIGetSourceListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPUpdateTaskTemplateResponse(rsp)
-}
 
-// DMAPIDeleteTaskWithResponse request returning *DMAPIDeleteTaskResponse
-func (c *ClientWithResponses) DMAPIDeleteTaskWithResponse(ctx context.Context, taskName string, params *DMAPIDeleteTaskParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskResponse, error) {
-	rsp, err := c.DMAPIDeleteTask(ctx, taskName, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetSourceListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseDMAPIDeleteTaskResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIGetTaskWithResponse request returning *DMAPIGetTaskResponse
-func (c *ClientWithResponses) DMAPIGetTaskWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskResponse, error) {
-	rsp, err := c.DMAPIGetTask(ctx, taskName, params, reqEditors...)
+// ParseDMAPICreateSourceResponse parses an HTTP response from a DMAPICreateSourceWithResponse call
+func ParseDMAPICreateSourceResponse(rsp *http.Response) (*DMAPICreateSourceResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskResponse(rsp)
-}
 
-// DMAPIUpdateTaskWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateTaskResponse
-func (c *ClientWithResponses) DMAPIUpdateTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
-	rsp, err := c.DMAPIUpdateTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPICreateSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIUpdateTaskResponse(rsp)
-}
 
-func (c *ClientWithResponses) DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
-	rsp, err := c.DMAPIUpdateTask(ctx, taskName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Source
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIUpdateTaskResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIGetTaskMigrateTargetsWithResponse request returning *DMAPIGetTaskMigrateTargetsResponse
-func (c *ClientWithResponses) DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error) {
-	rsp, err := c.DMAPIGetTaskMigrateTargets(ctx, taskName, sourceName, params, reqEditors...)
+// ParseDMAPIDeleteSourceResponse parses an HTTP response from a DMAPIDeleteSourceWithResponse call
+func ParseDMAPIDeleteSourceResponse(rsp *http.Response) (*DMAPIDeleteSourceResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskMigrateTargetsResponse(rsp)
-}
 
-// DMAPIGetSchemaListByTaskAndSourceWithResponse request returning *DMAPIGetSchemaListByTaskAndSourceResponse
-func (c *ClientWithResponses) DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
-	rsp, err := c.DMAPIGetSchemaListByTaskAndSource(ctx, taskName, sourceName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIDeleteSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp)
-}
 
-// DMAPIGetTableListByTaskAndSourceWithResponse request returning *DMAPIGetTableListByTaskAndSourceResponse
-func (c *ClientWithResponses) DMAPIGetTableListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
-	rsp, err := c.DMAPIGetTableListByTaskAndSource(ctx, taskName, sourceName, schemaName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 	}
-	return ParseDMAPIGetTableListByTaskAndSourceResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIDeleteTableStructureWithResponse request returning *DMAPIDeleteTableStructureResponse
-func (c *ClientWithResponses) DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error) {
-	rsp, err := c.DMAPIDeleteTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
+// ParseDMAPIGetSourceResponse parses an HTTP response from a DMAPIGetSourceWithResponse call
+func ParseDMAPIGetSourceResponse(rsp *http.Response) (*DMAPIGetSourceResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIDeleteTableStructureResponse(rsp)
-}
 
-// DMAPIGetTableStructureWithResponse request returning *DMAPIGetTableStructureResponse
-func (c *ClientWithResponses) DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error) {
-	rsp, err := c.DMAPIGetTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIGetSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIGetTableStructureResponse(rsp)
-}
 
-// DMAPIOperateTableStructureWithBodyWithResponse request with arbitrary body returning *DMAPIOperateTableStructureResponse
-func (c *ClientWithResponses) DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
-	rsp, err := c.DMAPIOperateTableStructureWithBody(ctx, taskName, sourceName, schemaName, tableName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Source
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseDMAPIOperateTableStructureResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
-	rsp, err := c.DMAPIOperateTableStructure(ctx, taskName, sourceName, schemaName, tableName, body, reqEditors...)
+// ParseDMAPIUpdateSourceResponse parses an HTTP response from a DMAPIUpdateSourceWithResponse call
+func ParseDMAPIUpdateSourceResponse(rsp *http.Response) (*DMAPIUpdateSourceResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIOperateTableStructureResponse(rsp)
-}
 
-// DMAPIStartTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStartTaskResponse
-func (c *ClientWithResponses) DMAPIStartTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
-	rsp, err := c.DMAPIStartTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIUpdateSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIStartTaskResponse(rsp)
-}
 
-func (c *ClientWithResponses) DMAPIStartTaskWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
-	rsp, err := c.DMAPIStartTask(ctx, taskName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Source
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIStartTaskResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIGetTaskStatusWithResponse request returning *DMAPIGetTaskStatusResponse
-func (c *ClientWithResponses) DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error) {
-	rsp, err := c.DMAPIGetTaskStatus(ctx, taskName, params, reqEditors...)
+// ParseDMAPIDisableSourceResponse parses an HTTP response from a DMAPIDisableSourceWithResponse call
+func ParseDMAPIDisableSourceResponse(rsp *http.Response) (*DMAPIDisableSourceResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskStatusResponse(rsp)
-}
 
-// DMAPIStopTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStopTaskResponse
-func (c *ClientWithResponses) DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error) {
-	rsp, err := c.DMAPIStopTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIDisableSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIStopTaskResponse(rsp)
-}
 
-func (c *ClientWithResponses) DMAPIStopTaskWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error) {
-	rsp, err := c.DMAPIStopTask(ctx, taskName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 	}
-	return ParseDMAPIStopTaskResponse(rsp)
+
+	return response, nil
 }
 
-// ParseDMAPIGetClusterInfoResponse parses an HTTP response from a DMAPIGetClusterInfoWithResponse call
-func ParseDMAPIGetClusterInfoResponse(rsp *http.Response) (*DMAPIGetClusterInfoResponse, error) {
+// ParseDMAPIEnableSourceResponse parses an HTTP response from a DMAPIEnableSourceWithResponse call
+func ParseDMAPIEnableSourceResponse(rsp *http.Response) (*DMAPIEnableSourceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetClusterInfoResponse{
+	response := &DMAPIEnableSourceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetClusterInfoResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIUpdateClusterInfoResponse parses an HTTP response from a DMAPIUpdateClusterInfoWithResponse call
-func ParseDMAPIUpdateClusterInfoResponse(rsp *http.Response) (*DMAPIUpdateClusterInfoResponse, error) {
+// ParseDMAPIDisableRelayResponse parses an HTTP response from a DMAPIDisableRelayWithResponse call
+func ParseDMAPIDisableRelayResponse(rsp *http.Response) (*DMAPIDisableRelayResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIUpdateClusterInfoResponse{
+	response := &DMAPIDisableRelayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetClusterInfoResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetClusterMasterListResponse parses an HTTP response from a DMAPIGetClusterMasterListWithResponse call
-func ParseDMAPIGetClusterMasterListResponse(rsp *http.Response) (*DMAPIGetClusterMasterListResponse, error) {
+// ParseDMAPIEnableRelayResponse parses an HTTP response from a DMAPIEnableRelayWithResponse call
+func ParseDMAPIEnableRelayResponse(rsp *http.Response) (*DMAPIEnableRelayResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetClusterMasterListResponse{
+	response := &DMAPIEnableRelayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetClusterMasterListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIOfflineMasterNodeResponse parses an HTTP response from a DMAPIOfflineMasterNodeWithResponse call
-func ParseDMAPIOfflineMasterNodeResponse(rsp *http.Response) (*DMAPIOfflineMasterNodeResponse, error) {
+// ParseDMAPIPurgeRelayResponse parses an HTTP response from a DMAPIPurgeRelayWithResponse call
+func ParseDMAPIPurgeRelayResponse(rsp *http.Response) (*DMAPIPurgeRelayResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIOfflineMasterNodeResponse{
+	response := &DMAPIPurgeRelayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4586,22 +6511,22 @@ func ParseDMAPIOfflineMasterNodeResponse(rsp *http.Response) (*DMAPIOfflineMaste
 	return response, nil
 }
 
-// ParseDMAPIGetClusterWorkerListResponse parses an HTTP response from a DMAPIGetClusterWorkerListWithResponse call
-func ParseDMAPIGetClusterWorkerListResponse(rsp *http.Response) (*DMAPIGetClusterWorkerListResponse, error) {
+// ParseDMAPIGetSourceSchemaListResponse parses an HTTP response from a DMAPIGetSourceSchemaListWithResponse call
+func ParseDMAPIGetSourceSchemaListResponse(rsp *http.Response) (*DMAPIGetSourceSchemaListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetClusterWorkerListResponse{
+	response := &DMAPIGetSourceSchemaListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetClusterWorkerListResponse
+		var dest SchemaNameList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4619,104 +6544,146 @@ func ParseDMAPIGetClusterWorkerListResponse(rsp *http.Response) (*DMAPIGetCluste
 	return response, nil
 }
 
-// ParseDMAPIOfflineWorkerNodeResponse parses an HTTP response from a DMAPIOfflineWorkerNodeWithResponse call
-func ParseDMAPIOfflineWorkerNodeResponse(rsp *http.Response) (*DMAPIOfflineWorkerNodeResponse, error) {
+// ParseDMAPIGetSourceTableListResponse parses an HTTP response from a DMAPIGetSourceTableListWithResponse call
+func ParseDMAPIGetSourceTableListResponse(rsp *http.Response) (*DMAPIGetSourceTableListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIOfflineWorkerNodeResponse{
+	response := &DMAPIGetSourceTableListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TableNameList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetDocJSONResponse parses an HTTP response from a GetDocJSONWithResponse call
-func ParseGetDocJSONResponse(rsp *http.Response) (*GetDocJSONResponse, error) {
+// ParseDMAPIGetSourceStatusResponse parses an HTTP response from a DMAPIGetSourceStatusWithResponse call
+func ParseDMAPIGetSourceStatusResponse(rsp *http.Response) (*DMAPIGetSourceStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDocJSONResponse{
+	response := &DMAPIGetSourceStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetSourceStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetDocHTMLResponse parses an HTTP response from a GetDocHTMLWithResponse call
-func ParseGetDocHTMLResponse(rsp *http.Response) (*GetDocHTMLResponse, error) {
+// ParseDMAPITransferSourceResponse parses an HTTP response from a DMAPITransferSourceWithResponse call
+func ParseDMAPITransferSourceResponse(rsp *http.Response) (*DMAPITransferSourceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDocHTMLResponse{
+	response := &DMAPITransferSourceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
 	return response, nil
 }
 
-// ParseDMAPIGetSourceListResponse parses an HTTP response from a DMAPIGetSourceListWithResponse call
-func ParseDMAPIGetSourceListResponse(rsp *http.Response) (*DMAPIGetSourceListResponse, error) {
+// ParseDMAPIGetTaskListResponse parses an HTTP response from a DMAPIGetTaskListWithResponse call
+func ParseDMAPIGetTaskListResponse(rsp *http.Response) (*DMAPIGetTaskListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSourceListResponse{
+	response := &DMAPIGetTaskListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetSourceListResponse
+		var dest GetTaskListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPICreateSourceResponse parses an HTTP response from a DMAPICreateSourceWithResponse call
-func ParseDMAPICreateSourceResponse(rsp *http.Response) (*DMAPICreateSourceResponse, error) {
+// ParseDMAPICreateTaskResponse parses an HTTP response from a DMAPICreateTaskWithResponse call
+func ParseDMAPICreateTaskResponse(rsp *http.Response) (*DMAPICreateTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPICreateSourceResponse{
+	response := &DMAPICreateTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Source
+		var dest OperateTaskResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4734,76 +6701,92 @@ func ParseDMAPICreateSourceResponse(rsp *http.Response) (*DMAPICreateSourceRespo
 	return response, nil
 }
 
-// ParseDMAPIDeleteSourceResponse parses an HTTP response from a DMAPIDeleteSourceWithResponse call
-func ParseDMAPIDeleteSourceResponse(rsp *http.Response) (*DMAPIDeleteSourceResponse, error) {
+// ParseDMAPIConvertTaskResponse parses an HTTP response from a DMAPIConvertTaskWithResponse call
+func ParseDMAPIConvertTaskResponse(rsp *http.Response) (*DMAPIConvertTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDeleteSourceResponse{
+	response := &DMAPIConvertTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ConverterTaskResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetSourceResponse parses an HTTP response from a DMAPIGetSourceWithResponse call
-func ParseDMAPIGetSourceResponse(rsp *http.Response) (*DMAPIGetSourceResponse, error) {
+// ParseDMAPIGetTaskTemplateListResponse parses an HTTP response from a DMAPIGetTaskTemplateListWithResponse call
+func ParseDMAPIGetTaskTemplateListResponse(rsp *http.Response) (*DMAPIGetTaskTemplateListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSourceResponse{
+	response := &DMAPIGetTaskTemplateListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Source
+		var dest GetTaskListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIUpdateSourceResponse parses an HTTP response from a DMAPIUpdateSourceWithResponse call
-func ParseDMAPIUpdateSourceResponse(rsp *http.Response) (*DMAPIUpdateSourceResponse, error) {
+// ParseDMAPICreateTaskTemplateResponse parses an HTTP response from a DMAPICreateTaskTemplateWithResponse call
+func ParseDMAPICreateTaskTemplateResponse(rsp *http.Response) (*DMAPICreateTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIUpdateSourceResponse{
+	response := &DMAPICreateTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Source
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
@@ -4817,40 +6800,48 @@ func ParseDMAPIUpdateSourceResponse(rsp *http.Response) (*DMAPIUpdateSourceRespo
 	return response, nil
 }
 
-// ParseDMAPIDisableSourceResponse parses an HTTP response from a DMAPIDisableSourceWithResponse call
-func ParseDMAPIDisableSourceResponse(rsp *http.Response) (*DMAPIDisableSourceResponse, error) {
+// ParseDMAPIImportTaskTemplateResponse parses an HTTP response from a DMAPIImportTaskTemplateWithResponse call
+func ParseDMAPIImportTaskTemplateResponse(rsp *http.Response) (*DMAPIImportTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDisableSourceResponse{
+	response := &DMAPIImportTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TaskTemplateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIEnableSourceResponse parses an HTTP response from a DMAPIEnableSourceWithResponse call
-func ParseDMAPIEnableSourceResponse(rsp *http.Response) (*DMAPIEnableSourceResponse, error) {
+// ParseDMAPIDeleteTaskTemplateResponse parses an HTTP response from a DMAPIDeleteTaskTemplateWithResponse call
+func ParseDMAPIDeleteTaskTemplateResponse(rsp *http.Response) (*DMAPIDeleteTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIEnableSourceResponse{
+	response := &DMAPIDeleteTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4867,65 +6858,81 @@ func ParseDMAPIEnableSourceResponse(rsp *http.Response) (*DMAPIEnableSourceRespo
 	return response, nil
 }
 
-// ParseDMAPIDisableRelayResponse parses an HTTP response from a DMAPIDisableRelayWithResponse call
-func ParseDMAPIDisableRelayResponse(rsp *http.Response) (*DMAPIDisableRelayResponse, error) {
+// ParseDMAPIGetTaskTemplateResponse parses an HTTP response from a DMAPIGetTaskTemplateWithResponse call
+func ParseDMAPIGetTaskTemplateResponse(rsp *http.Response) (*DMAPIGetTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDisableRelayResponse{
+	response := &DMAPIGetTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIEnableRelayResponse parses an HTTP response from a DMAPIEnableRelayWithResponse call
-func ParseDMAPIEnableRelayResponse(rsp *http.Response) (*DMAPIEnableRelayResponse, error) {
+// ParseDMAPUpdateTaskTemplateResponse parses an HTTP response from a DMAPUpdateTaskTemplateWithResponse call
+func ParseDMAPUpdateTaskTemplateResponse(rsp *http.Response) (*DMAPUpdateTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIEnableRelayResponse{
+	response := &DMAPUpdateTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIPurgeRelayResponse parses an HTTP response from a DMAPIPurgeRelayWithResponse call
-func ParseDMAPIPurgeRelayResponse(rsp *http.Response) (*DMAPIPurgeRelayResponse, error) {
+// ParseDMAPIDeleteTaskResponse parses an HTTP response from a DMAPIDeleteTaskWithResponse call
+func ParseDMAPIDeleteTaskResponse(rsp *http.Response) (*DMAPIDeleteTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIPurgeRelayResponse{
+	response := &DMAPIDeleteTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4942,55 +6949,47 @@ func ParseDMAPIPurgeRelayResponse(rsp *http.Response) (*DMAPIPurgeRelayResponse,
 	return response, nil
 }
 
-// ParseDMAPIGetSourceSchemaListResponse parses an HTTP response from a DMAPIGetSourceSchemaListWithResponse call
-func ParseDMAPIGetSourceSchemaListResponse(rsp *http.Response) (*DMAPIGetSourceSchemaListResponse, error) {
+// ParseDMAPIGetTaskResponse parses an HTTP response from a DMAPIGetTaskWithResponse call
+func ParseDMAPIGetTaskResponse(rsp *http.Response) (*DMAPIGetTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSourceSchemaListResponse{
+	response := &DMAPIGetTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaNameList
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorWithMessage
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetSourceTableListResponse parses an HTTP response from a DMAPIGetSourceTableListWithResponse call
-func ParseDMAPIGetSourceTableListResponse(rsp *http.Response) (*DMAPIGetSourceTableListResponse, error) {
+// ParseDMAPIUpdateTaskResponse parses an HTTP response from a DMAPIUpdateTaskWithResponse call
+func ParseDMAPIUpdateTaskResponse(rsp *http.Response) (*DMAPIUpdateTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSourceTableListResponse{
+	response := &DMAPIUpdateTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TableNameList
+		var dest OperateTaskResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5008,27 +7007,20 @@ func ParseDMAPIGetSourceTableListResponse(rsp *http.Response) (*DMAPIGetSourceTa
 	return response, nil
 }
 
-// ParseDMAPIGetSourceStatusResponse parses an HTTP response from a DMAPIGetSourceStatusWithResponse call
-func ParseDMAPIGetSourceStatusResponse(rsp *http.Response) (*DMAPIGetSourceStatusResponse, error) {
+// ParseDMAPIOperateTaskBinlogResponse parses an HTTP response from a DMAPIOperateTaskBinlogWithResponse call
+func ParseDMAPIOperateTaskBinlogResponse(rsp *http.Response) (*DMAPIOperateTaskBinlogResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSourceStatusResponse{
+	response := &DMAPIOperateTaskBinlogResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetSourceStatusResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5041,15 +7033,15 @@ func ParseDMAPIGetSourceStatusResponse(rsp *http.Response) (*DMAPIGetSourceStatu
 	return response, nil
 }
 
-// ParseDMAPITransferSourceResponse parses an HTTP response from a DMAPITransferSourceWithResponse call
-func ParseDMAPITransferSourceResponse(rsp *http.Response) (*DMAPITransferSourceResponse, error) {
+// ParseDMAPIResyncTaskTablesResponse parses an HTTP response from a DMAPIResyncTaskTablesWithResponse call
+func ParseDMAPIResyncTaskTablesResponse(rsp *http.Response) (*DMAPIResyncTaskTablesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPITransferSourceResponse{
+	response := &DMAPIResyncTaskTablesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5061,27 +7053,28 @@ func ParseDMAPITransferSourceResponse(rsp *http.Response) (*DMAPITransferSourceR
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetTaskListResponse parses an HTTP response from a DMAPIGetTaskListWithResponse call
-func ParseDMAPIGetTaskListResponse(rsp *http.Response) (*DMAPIGetTaskListResponse, error) {
+// ParseDMAPIRollbackTaskCutoverResponse parses an HTTP response from a DMAPIRollbackTaskCutoverWithResponse call
+func ParseDMAPIRollbackTaskCutoverResponse(rsp *http.Response) (*DMAPIRollbackTaskCutoverResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTaskListResponse{
+	response := &DMAPIRollbackTaskCutoverResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetTaskListResponse
+		var dest TaskCutoverStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5099,26 +7092,26 @@ func ParseDMAPIGetTaskListResponse(rsp *http.Response) (*DMAPIGetTaskListRespons
 	return response, nil
 }
 
-// ParseDMAPICreateTaskResponse parses an HTTP response from a DMAPICreateTaskWithResponse call
-func ParseDMAPICreateTaskResponse(rsp *http.Response) (*DMAPICreateTaskResponse, error) {
+// ParseDMAPIGetTaskCutoverResponse parses an HTTP response from a DMAPIGetTaskCutoverWithResponse call
+func ParseDMAPIGetTaskCutoverResponse(rsp *http.Response) (*DMAPIGetTaskCutoverResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPICreateTaskResponse{
+	response := &DMAPIGetTaskCutoverResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest OperateTaskResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskCutoverStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
@@ -5132,26 +7125,26 @@ func ParseDMAPICreateTaskResponse(rsp *http.Response) (*DMAPICreateTaskResponse,
 	return response, nil
 }
 
-// ParseDMAPIConvertTaskResponse parses an HTTP response from a DMAPIConvertTaskWithResponse call
-func ParseDMAPIConvertTaskResponse(rsp *http.Response) (*DMAPIConvertTaskResponse, error) {
+// ParseDMAPIStartTaskCutoverResponse parses an HTTP response from a DMAPIStartTaskCutoverWithResponse call
+func ParseDMAPIStartTaskCutoverResponse(rsp *http.Response) (*DMAPIStartTaskCutoverResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIConvertTaskResponse{
+	response := &DMAPIStartTaskCutoverResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ConverterTaskResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskCutoverStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
@@ -5165,22 +7158,22 @@ func ParseDMAPIConvertTaskResponse(rsp *http.Response) (*DMAPIConvertTaskRespons
 	return response, nil
 }
 
-// ParseDMAPIGetTaskTemplateListResponse parses an HTTP response from a DMAPIGetTaskTemplateListWithResponse call
-func ParseDMAPIGetTaskTemplateListResponse(rsp *http.Response) (*DMAPIGetTaskTemplateListResponse, error) {
+// ParseDMAPIGetTaskShardDDLLockListResponse parses an HTTP response from a DMAPIGetTaskShardDDLLockListWithResponse call
+func ParseDMAPIGetTaskShardDDLLockListResponse(rsp *http.Response) (*DMAPIGetTaskShardDDLLockListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTaskTemplateListResponse{
+	response := &DMAPIGetTaskShardDDLLockListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetTaskListResponse
+		var dest GetShardDDLLockListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5198,27 +7191,20 @@ func ParseDMAPIGetTaskTemplateListResponse(rsp *http.Response) (*DMAPIGetTaskTem
 	return response, nil
 }
 
-// ParseDMAPICreateTaskTemplateResponse parses an HTTP response from a DMAPICreateTaskTemplateWithResponse call
-func ParseDMAPICreateTaskTemplateResponse(rsp *http.Response) (*DMAPICreateTaskTemplateResponse, error) {
+// ParseDMAPIUnlockTaskShardDDLLockResponse parses an HTTP response from a DMAPIUnlockTaskShardDDLLockWithResponse call
+func ParseDMAPIUnlockTaskShardDDLLockResponse(rsp *http.Response) (*DMAPIUnlockTaskShardDDLLockResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPICreateTaskTemplateResponse{
+	response := &DMAPIUnlockTaskShardDDLLockResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5231,26 +7217,26 @@ func ParseDMAPICreateTaskTemplateResponse(rsp *http.Response) (*DMAPICreateTaskT
 	return response, nil
 }
 
-// ParseDMAPIImportTaskTemplateResponse parses an HTTP response from a DMAPIImportTaskTemplateWithResponse call
-func ParseDMAPIImportTaskTemplateResponse(rsp *http.Response) (*DMAPIImportTaskTemplateResponse, error) {
+// ParseDMAPIGetTaskMigrateTargetsResponse parses an HTTP response from a DMAPIGetTaskMigrateTargetsWithResponse call
+func ParseDMAPIGetTaskMigrateTargetsResponse(rsp *http.Response) (*DMAPIGetTaskMigrateTargetsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIImportTaskTemplateResponse{
+	response := &DMAPIGetTaskMigrateTargetsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest TaskTemplateResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetTaskMigrateTargetsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON202 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
@@ -5264,47 +7250,55 @@ func ParseDMAPIImportTaskTemplateResponse(rsp *http.Response) (*DMAPIImportTaskT
 	return response, nil
 }
 
-// ParseDMAPIDeleteTaskTemplateResponse parses an HTTP response from a DMAPIDeleteTaskTemplateWithResponse call
-func ParseDMAPIDeleteTaskTemplateResponse(rsp *http.Response) (*DMAPIDeleteTaskTemplateResponse, error) {
+// ParseDMAPIGetSchemaListByTaskAndSourceResponse parses an HTTP response from a DMAPIGetSchemaListByTaskAndSourceWithResponse call
+func ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDeleteTaskTemplateResponse{
+	response := &DMAPIGetSchemaListByTaskAndSourceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaNameList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetTaskTemplateResponse parses an HTTP response from a DMAPIGetTaskTemplateWithResponse call
-func ParseDMAPIGetTaskTemplateResponse(rsp *http.Response) (*DMAPIGetTaskTemplateResponse, error) {
+// ParseDMAPIGetTableListByTaskAndSourceResponse parses an HTTP response from a DMAPIGetTableListByTaskAndSourceWithResponse call
+func ParseDMAPIGetTableListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTaskTemplateResponse{
+	response := &DMAPIGetTableListByTaskAndSourceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Task
+		var dest TableNameList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5322,138 +7316,130 @@ func ParseDMAPIGetTaskTemplateResponse(rsp *http.Response) (*DMAPIGetTaskTemplat
 	return response, nil
 }
 
-// ParseDMAPUpdateTaskTemplateResponse parses an HTTP response from a DMAPUpdateTaskTemplateWithResponse call
-func ParseDMAPUpdateTaskTemplateResponse(rsp *http.Response) (*DMAPUpdateTaskTemplateResponse, error) {
+// ParseDMAPIDeleteTableStructureResponse parses an HTTP response from a DMAPIDeleteTableStructureWithResponse call
+func ParseDMAPIDeleteTableStructureResponse(rsp *http.Response) (*DMAPIDeleteTableStructureResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPUpdateTaskTemplateResponse{
+	response := &DMAPIDeleteTableStructureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIDeleteTaskResponse parses an HTTP response from a DMAPIDeleteTaskWithResponse call
-func ParseDMAPIDeleteTaskResponse(rsp *http.Response) (*DMAPIDeleteTaskResponse, error) {
+// ParseDMAPIGetTableStructureResponse parses an HTTP response from a DMAPIGetTableStructureWithResponse call
+func ParseDMAPIGetTableStructureResponse(rsp *http.Response) (*DMAPIGetTableStructureResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDeleteTaskResponse{
+	response := &DMAPIGetTableStructureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetTaskTableStructureResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetTaskResponse parses an HTTP response from a DMAPIGetTaskWithResponse call
-func ParseDMAPIGetTaskResponse(rsp *http.Response) (*DMAPIGetTaskResponse, error) {
+// ParseDMAPIOperateTableStructureResponse parses an HTTP response from a DMAPIOperateTableStructureWithResponse call
+func ParseDMAPIOperateTableStructureResponse(rsp *http.Response) (*DMAPIOperateTableStructureResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTaskResponse{
+	response := &DMAPIOperateTableStructureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Task
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIUpdateTaskResponse parses an HTTP response from a DMAPIUpdateTaskWithResponse call
-func ParseDMAPIUpdateTaskResponse(rsp *http.Response) (*DMAPIUpdateTaskResponse, error) {
+// ParseDMAPIStartTaskResponse parses an HTTP response from a DMAPIStartTaskWithResponse call
+func ParseDMAPIStartTaskResponse(rsp *http.Response) (*DMAPIStartTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIUpdateTaskResponse{
+	response := &DMAPIStartTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OperateTaskResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetTaskMigrateTargetsResponse parses an HTTP response from a DMAPIGetTaskMigrateTargetsWithResponse call
-func ParseDMAPIGetTaskMigrateTargetsResponse(rsp *http.Response) (*DMAPIGetTaskMigrateTargetsResponse, error) {
+// ParseDMAPIGetTaskStatusResponse parses an HTTP response from a DMAPIGetTaskStatusWithResponse call
+func ParseDMAPIGetTaskStatusResponse(rsp *http.Response) (*DMAPIGetTaskStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTaskMigrateTargetsResponse{
+	response := &DMAPIGetTaskStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetTaskMigrateTargetsResponse
+		var dest GetTaskStatusResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5471,27 +7457,20 @@ func ParseDMAPIGetTaskMigrateTargetsResponse(rsp *http.Response) (*DMAPIGetTaskM
 	return response, nil
 }
 
-// ParseDMAPIGetSchemaListByTaskAndSourceResponse parses an HTTP response from a DMAPIGetSchemaListByTaskAndSourceWithResponse call
-func ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
+// ParseDMAPIStopTaskResponse parses an HTTP response from a DMAPIStopTaskWithResponse call
+func ParseDMAPIStopTaskResponse(rsp *http.Response) (*DMAPIStopTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSchemaListByTaskAndSourceResponse{
+	response := &DMAPIStopTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaNameList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5504,27 +7483,20 @@ func ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIG
 	return response, nil
 }
 
-// ParseDMAPIGetTableListByTaskAndSourceResponse parses an HTTP response from a DMAPIGetTableListByTaskAndSourceWithResponse call
-func ParseDMAPIGetTableListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
+// ParseDMAPIOperateTaskSyncDelayResponse parses an HTTP response from a DMAPIOperateTaskSyncDelayWithResponse call
+func ParseDMAPIOperateTaskSyncDelayResponse(rsp *http.Response) (*DMAPIOperateTaskSyncDelayResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTableListByTaskAndSourceResponse{
+	response := &DMAPIOperateTaskSyncDelayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TableNameList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5537,15 +7509,15 @@ func ParseDMAPIGetTableListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGe
 	return response, nil
 }
 
-// ParseDMAPIDeleteTableStructureResponse parses an HTTP response from a DMAPIDeleteTableStructureWithResponse call
-func ParseDMAPIDeleteTableStructureResponse(rsp *http.Response) (*DMAPIDeleteTableStructureResponse, error) {
+// ParseDMAPIUpdateTaskThrottleResponse parses an HTTP response from a DMAPIUpdateTaskThrottleWithResponse call
+func ParseDMAPIUpdateTaskThrottleResponse(rsp *http.Response) (*DMAPIUpdateTaskThrottleResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDeleteTableStructureResponse{
+	response := &DMAPIUpdateTaskThrottleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5557,27 +7529,28 @@ func ParseDMAPIDeleteTableStructureResponse(rsp *http.Response) (*DMAPIDeleteTab
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetTableStructureResponse parses an HTTP response from a DMAPIGetTableStructureWithResponse call
-func ParseDMAPIGetTableStructureResponse(rsp *http.Response) (*DMAPIGetTableStructureResponse, error) {
+// ParseDMAPIGetTaskValidationErrorListResponse parses an HTTP response from a DMAPIGetTaskValidationErrorListWithResponse call
+func ParseDMAPIGetTaskValidationErrorListResponse(rsp *http.Response) (*DMAPIGetTaskValidationErrorListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTableStructureResponse{
+	response := &DMAPIGetTaskValidationErrorListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetTaskTableStructureResponse
+		var dest GetTaskValidationErrorListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5595,15 +7568,15 @@ func ParseDMAPIGetTableStructureResponse(rsp *http.Response) (*DMAPIGetTableStru
 	return response, nil
 }
 
-// ParseDMAPIOperateTableStructureResponse parses an HTTP response from a DMAPIOperateTableStructureWithResponse call
-func ParseDMAPIOperateTableStructureResponse(rsp *http.Response) (*DMAPIOperateTableStructureResponse, error) {
+// ParseDMAPIOperateTaskValidationErrorResponse parses an HTTP response from a DMAPIOperateTaskValidationErrorWithResponse call
+func ParseDMAPIOperateTaskValidationErrorResponse(rsp *http.Response) (*DMAPIOperateTaskValidationErrorResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIOperateTableStructureResponse{
+	response := &DMAPIOperateTaskValidationErrorResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5615,20 +7588,21 @@ func ParseDMAPIOperateTableStructureResponse(rsp *http.Response) (*DMAPIOperateT
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIStartTaskResponse parses an HTTP response from a DMAPIStartTaskWithResponse call
-func ParseDMAPIStartTaskResponse(rsp *http.Response) (*DMAPIStartTaskResponse, error) {
+// ParseDMAPIStartTaskValidationResponse parses an HTTP response from a DMAPIStartTaskValidationWithResponse call
+func ParseDMAPIStartTaskValidationResponse(rsp *http.Response) (*DMAPIStartTaskValidationResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIStartTaskResponse{
+	response := &DMAPIStartTaskValidationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5640,27 +7614,28 @@ func ParseDMAPIStartTaskResponse(rsp *http.Response) (*DMAPIStartTaskResponse, e
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetTaskStatusResponse parses an HTTP response from a DMAPIGetTaskStatusWithResponse call
-func ParseDMAPIGetTaskStatusResponse(rsp *http.Response) (*DMAPIGetTaskStatusResponse, error) {
+// ParseDMAPIGetTaskValidationStatusResponse parses an HTTP response from a DMAPIGetTaskValidationStatusWithResponse call
+func ParseDMAPIGetTaskValidationStatusResponse(rsp *http.Response) (*DMAPIGetTaskValidationStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTaskStatusResponse{
+	response := &DMAPIGetTaskValidationStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetTaskStatusResponse
+		var dest GetTaskValidationStatusResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5678,15 +7653,15 @@ func ParseDMAPIGetTaskStatusResponse(rsp *http.Response) (*DMAPIGetTaskStatusRes
 	return response, nil
 }
 
-// ParseDMAPIStopTaskResponse parses an HTTP response from a DMAPIStopTaskWithResponse call
-func ParseDMAPIStopTaskResponse(rsp *http.Response) (*DMAPIStopTaskResponse, error) {
+// ParseDMAPIStopTaskValidationResponse parses an HTTP response from a DMAPIStopTaskValidationWithResponse call
+func ParseDMAPIStopTaskValidationResponse(rsp *http.Response) (*DMAPIStopTaskValidationResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIStopTaskResponse{
+	response := &DMAPIStopTaskValidationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5698,6 +7673,7 @@ func ParseDMAPIStopTaskResponse(rsp *http.Response) (*DMAPIStopTaskResponse, err
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
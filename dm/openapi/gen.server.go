@@ -122,6 +122,27 @@ type ServerInterface interface {
 	// update a task
 	// (PUT /api/v1/tasks/{task-name})
 	DMAPIUpdateTask(c *gin.Context, taskName string)
+	// skip, replace, revert or inject the binlog event which fails to be replicated
+	// (POST /api/v1/tasks/{task-name}/binlog)
+	DMAPIOperateTaskBinlog(c *gin.Context, taskName string)
+	// roll back the cutover workflow of a task, the fenced sources are writable again
+	// (DELETE /api/v1/tasks/{task-name}/cutover)
+	DMAPIRollbackTaskCutover(c *gin.Context, taskName string)
+	// get the status of the cutover workflow of a task
+	// (GET /api/v1/tasks/{task-name}/cutover)
+	DMAPIGetTaskCutover(c *gin.Context, taskName string)
+	// start the guided cutover workflow of a task, or resume the failed one
+	// (POST /api/v1/tasks/{task-name}/cutover)
+	DMAPIStartTaskCutover(c *gin.Context, taskName string)
+	// dump and load tables again inside a running task, other tables keep replicating
+	// (POST /api/v1/tasks/{task-name}/resync-tables)
+	DMAPIResyncTaskTables(c *gin.Context, taskName string)
+	// get the unresolved shard DDL locks of a task
+	// (GET /api/v1/tasks/{task-name}/shard-ddl-locks)
+	DMAPIGetTaskShardDDLLockList(c *gin.Context, taskName string, params DMAPIGetTaskShardDDLLockListParams)
+	// unlock a shard DDL lock of a task manually
+	// (POST /api/v1/tasks/{task-name}/shard-ddl-locks/unlock)
+	DMAPIUnlockTaskShardDDLLock(c *gin.Context, taskName string)
 	// get task source table and target table route relation
 	// (GET /api/v1/tasks/{task-name}/sources/{source-name}/migrate_targets)
 	DMAPIGetTaskMigrateTargets(c *gin.Context, taskName string, sourceName string, params DMAPIGetTaskMigrateTargetsParams)
@@ -149,6 +170,27 @@ type ServerInterface interface {
 	// stop a task
 	// (POST /api/v1/tasks/{task-name}/stop)
 	DMAPIStopTask(c *gin.Context, taskName string)
+	// pause, resume or fast-forward the delayed window of a task which enables delayed replication
+	// (POST /api/v1/tasks/{task-name}/sync-delay)
+	DMAPIOperateTaskSyncDelay(c *gin.Context, taskName string)
+	// update the throttle limits of writing to the downstream of a running task
+	// (PUT /api/v1/tasks/{task-name}/throttle)
+	DMAPIUpdateTaskThrottle(c *gin.Context, taskName string)
+	// get the validation errors of a task
+	// (GET /api/v1/tasks/{task-name}/validation/errors)
+	DMAPIGetTaskValidationErrorList(c *gin.Context, taskName string, params DMAPIGetTaskValidationErrorListParams)
+	// ignore, resolve or clear the validation errors of a task
+	// (POST /api/v1/tasks/{task-name}/validation/errors)
+	DMAPIOperateTaskValidationError(c *gin.Context, taskName string)
+	// enable or resume the validation of a task
+	// (POST /api/v1/tasks/{task-name}/validation/start)
+	DMAPIStartTaskValidation(c *gin.Context, taskName string)
+	// get the validation status of a task
+	// (GET /api/v1/tasks/{task-name}/validation/status)
+	DMAPIGetTaskValidationStatus(c *gin.Context, taskName string, params DMAPIGetTaskValidationStatusParams)
+	// stop the validation of a task
+	// (POST /api/v1/tasks/{task-name}/validation/stop)
+	DMAPIStopTaskValidation(c *gin.Context, taskName string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -806,6 +848,159 @@ func (siw *ServerInterfaceWrapper) DMAPIUpdateTask(c *gin.Context) {
 	siw.Handler.DMAPIUpdateTask(c, taskName)
 }
 
+// DMAPIOperateTaskBinlog operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIOperateTaskBinlog(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIOperateTaskBinlog(c, taskName)
+}
+
+// DMAPIRollbackTaskCutover operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIRollbackTaskCutover(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIRollbackTaskCutover(c, taskName)
+}
+
+// DMAPIGetTaskCutover operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetTaskCutover(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetTaskCutover(c, taskName)
+}
+
+// DMAPIStartTaskCutover operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIStartTaskCutover(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIStartTaskCutover(c, taskName)
+}
+
+// DMAPIResyncTaskTables operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIResyncTaskTables(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIResyncTaskTables(c, taskName)
+}
+
+// DMAPIGetTaskShardDDLLockList operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetTaskShardDDLLockList(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIGetTaskShardDDLLockListParams
+
+	// ------------- Optional query parameter "source_name_list" -------------
+	if paramValue := c.Query("source_name_list"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "source_name_list", c.Request.URL.Query(), &params.SourceNameList)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source_name_list: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetTaskShardDDLLockList(c, taskName, params)
+}
+
+// DMAPIUnlockTaskShardDDLLock operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIUnlockTaskShardDDLLock(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIUnlockTaskShardDDLLock(c, taskName)
+}
+
 // DMAPIGetTaskMigrateTargets operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTaskMigrateTargets(c *gin.Context) {
 	var err error
@@ -1112,6 +1307,16 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTaskStatus(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "with_table_status" -------------
+	if paramValue := c.Query("with_table_status"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "with_table_status", c.Request.URL.Query(), &params.WithTableStatus)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter with_table_status: %s", err)})
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -1139,6 +1344,172 @@ func (siw *ServerInterfaceWrapper) DMAPIStopTask(c *gin.Context) {
 	siw.Handler.DMAPIStopTask(c, taskName)
 }
 
+// DMAPIOperateTaskSyncDelay operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIOperateTaskSyncDelay(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIOperateTaskSyncDelay(c, taskName)
+}
+
+// DMAPIUpdateTaskThrottle operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIUpdateTaskThrottle(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIUpdateTaskThrottle(c, taskName)
+}
+
+// DMAPIGetTaskValidationErrorList operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetTaskValidationErrorList(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIGetTaskValidationErrorListParams
+
+	// ------------- Optional query parameter "error_state" -------------
+	if paramValue := c.Query("error_state"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "error_state", c.Request.URL.Query(), &params.ErrorState)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter error_state: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetTaskValidationErrorList(c, taskName, params)
+}
+
+// DMAPIOperateTaskValidationError operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIOperateTaskValidationError(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIOperateTaskValidationError(c, taskName)
+}
+
+// DMAPIStartTaskValidation operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIStartTaskValidation(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIStartTaskValidation(c, taskName)
+}
+
+// DMAPIGetTaskValidationStatus operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetTaskValidationStatus(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIGetTaskValidationStatusParams
+
+	// ------------- Optional query parameter "table_stage" -------------
+	if paramValue := c.Query("table_stage"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "table_stage", c.Request.URL.Query(), &params.TableStage)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter table_stage: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetTaskValidationStatus(c, taskName, params)
+}
+
+// DMAPIStopTaskValidation operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIStopTaskValidation(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIStopTaskValidation(c, taskName)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL     string
@@ -1225,6 +1596,20 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name", wrapper.DMAPIUpdateTask)
 
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/binlog", wrapper.DMAPIOperateTaskBinlog)
+
+	router.DELETE(options.BaseURL+"/api/v1/tasks/:task-name/cutover", wrapper.DMAPIRollbackTaskCutover)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/cutover", wrapper.DMAPIGetTaskCutover)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/cutover", wrapper.DMAPIStartTaskCutover)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/resync-tables", wrapper.DMAPIResyncTaskTables)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/shard-ddl-locks", wrapper.DMAPIGetTaskShardDDLLockList)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/shard-ddl-locks/unlock", wrapper.DMAPIUnlockTaskShardDDLLock)
+
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/migrate_targets", wrapper.DMAPIGetTaskMigrateTargets)
 
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/schemas", wrapper.DMAPIGetSchemaListByTaskAndSource)
@@ -1243,119 +1628,185 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/stop", wrapper.DMAPIStopTask)
 
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/sync-delay", wrapper.DMAPIOperateTaskSyncDelay)
+
+	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name/throttle", wrapper.DMAPIUpdateTaskThrottle)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/validation/errors", wrapper.DMAPIGetTaskValidationErrorList)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/validation/errors", wrapper.DMAPIOperateTaskValidationError)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/validation/start", wrapper.DMAPIStartTaskValidation)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/validation/status", wrapper.DMAPIGetTaskValidationStatus)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/validation/stop", wrapper.DMAPIStopTaskValidation)
+
 	return router
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9bXPbOJLwX8Gj5z7sTEmWZDtO4qv9kMSerO+cl4o9tbe1lWMgEpSwJgEGAO3Rpvzf",
-	"r/BCEiQBkrItx5pkP+w4Igh0N/odjea3UUjTjBJEBB8dfxvxcIVSqP58lSAm3kECl4hd0owmdLmWv2eM",
-	"ZogJjNSoFeVC/hf9AdMsQaPj0Xz/+d5sb7Y3H41HYp3Jn7hgmCxHt+NRRll9+MvZy4NyHCYCLREb3d6O",
-	"Rwx9zTFD0ej4n3oR8/LncjRd/AuFQs76Jsm5QOwdlP/fhhFGkfo1QjxkOBOYktGx+hVxDmgMxAqBMGcM",
-	"EQFSNQkgNEKjsQut4xf7R07cYIKvUXsdShJMEOACityshrlZxl5BsByVsy4oTRAkctoEwQg54Mfcnknh",
-	"YIYOmJTAFNW3TU/jQKyxF+rNAtkSurEmcsfm+FkISkYLUs1pgbDG/QdD8eh49P+nFZNODYdOnex5Ox4t",
-	"GYwhgYPneavH21NoUpQzBAnWPI4FSnnffJoJ7ekMRSBjUP07YzRFYoVyPhjIj+Ur9sQ3lF3dGc6/q5f9",
-	"cN76t1K/+t3kbEFzEgWc5ixEQcHI9TX1QyAfAjUcCKqlRdOsvWy65l+TyaxrQQGXjqX09OphKdy+RdRY",
-	"1wptcdRTDBdHSfo6pC5COeWTkmvEJM9CfvUJfc2R5qL63grIr/pYSk6gGAnyqyCkJMbLIMaJg2j6IZAP",
-	"ASZgDdMExJSlUICVEBk/nk4jGvK9DJNlCLO9kKbTf6+mAkeLKRdwkaCpXGSi58kZlPNO5HSTOE+SPSfZ",
-	"+jDnGSUc/SlRtzlGoeOA1MkbDEGBLhQHeVlDM1gfhfQkltry8fykn+nNin6IH4iVXZRzLXqCudyYTyiB",
-	"a2vZhh4M5R9SEXFBMwABk8MBM+PHDSgtKpWKvV+fv4cpOpejnQx/kqfZhfJDHCqz9E+iPM1ATnAbJrls",
-	"ggSKAsWI6jfNu6PjUUTzRYKqvSN5upC+3HiEuMApFCgQVMAkYPRm6JsxJpivUBQs1gJt/NIGC2nIHFhh",
-	"Io4OR70eau39cZtQLVSaYLqp5GK2U7IZr0EmeplNPQ0WmCR0GSwFjpz8wQQmS/D28uykMOZ5xgVDMAX6",
-	"1ZqxQy/hPA739yconL2YzOfo5WSxD8PJbP9wH4bz+Ww2OzieT56/OHw5Go9IniQSr4bLWpnIGogeq1+A",
-	"KPWZsv0DwNSGf4HJ3kz+b384LBE23k4M80Tyyt5UP9BL1GGTYESYoVBQtgY3K8SQAk3vS0KXAHOpGCQ/",
-	"DYBgG9rhlDHK/o7F6h3i3OnrSJZR9gYgObbFRurXIJRuT+td9QyE2iVqStPYvJrype/N1ADVZxuqicY2",
-	"PC5JeouE8WjPSEz9DkCoBwUusTDPAJbbVmqN3Kc2pKYZ5vI3w6YmnhZQ3bjpgERuux/DCAo4OHKoR9uO",
-	"AEcpMGVpByhNKSly9W4kNP8+PBImlNkyEtr3eUDoK2dq+2Brh+FBATc+yJbBlz7cA9K8dPG3DPI7vGTK",
-	"hWVLJPgDAl+b+DEweVjOyRfVnI8B/aU0wBeC5aHIGfJjoQEMQhV4BPxrUg9q3nw6fXV5Ci5fvT4/BV/E",
-	"/Av4yxccfQGYiL/M57+A9x8uwfvfz8/Bq98vPwRn7998On13+v5y/PHT2btXn/4B/vv0H/qNX8D018v/",
-	"90+j91EUYBKhPz6DN+e/X1yefjo9Ab9OfwGn79+evT/96xkh9OQ1ODn97dXv55fgzd9efbo4vfxrLuIX",
-	"6eIQvPlwfv7q8rT4t3SrXGkJg1o7UosWzkSJcnYdw9Xv8wGRafl6MZdFVedWNZJ3D56ePpjNZvdOT59T",
-	"GPWHXQmFkTvs6oiC/H5GigQ07rIlFBWq1vPS42/Tg9ElQ5w7H+o4ZThMDaq1AiJ7PmvpOioOwF0kb2Rh",
-	"78sXvnT5IB56sX8076WG4fo+VvqgPHDUnbAKVyi8ChjiKixpclzG0ESNAGaEHQ1VDzEHGeQcRXvALer3",
-	"SaKM6zD2YNrUxL1Br45TEFA6xBv0xknOV7UITgdb9Vn/zrBAXMVqGi+dSkZAYZBRTATg8hcowMk7EEKi",
-	"JRkLAGMZGTBUxqXytSL91jqS4V+TIKREIOLAjX9NwJrm4AYSYWFY2zuHpQFfwnllagprIM3NGHwJ9/2P",
-	"DtyP7mFf/tNpYNYkbCP7exbBguY0EzjFXOAQ8BVkkSSj1ADSeoMbLFY64262hpJkDXKOIhlhEwBNoApo",
-	"GOaMA0y8c56cnIO0FpyWW9NMPlr75GJcx1nNNk5N72+WPubMFeRXGYlQ4p9nIKMJDteglnFux/5/ZJgZ",
-	"v66Qp1lTmNQgnUEQWOdnyuXs+LkwIZ48iGXm5J/sWjt+5boHR7PW0pcrBIrBUoIyxDCNcAiTZA2Myovb",
-	"KRmNVjQGZnJwDZMcHQO1hGQojkJKIn436BlKISYBz2CIahjMnzXhf4cJTvMUxAwhEGF+BdRbCoa3r++y",
-	"/K2PJx40j/2Iebu+PF1tzQyFOF4b4Hm+sLJzMWWgBfYeOIsBoQLoN7HkCXXmLlWVAJQgcIOTBCyQUkB7",
-	"4EJBas52jsE+RM+PDg8OJ/Hzl/FkPkcvJosI7RfpUOlovtCozPsTgA1Jb9PYJe9qW98oIW7TQ1k0fTRV",
-	"CGVbxFXmOdAPK4/QsmE/88g7lUe+9XFJf7Riq+06l5jqiSr0qE/RoGFxEKrFRBuWiqh/aVB1Pgbzl89f",
-	"/uIS9tq6HuZz8dw9mK2budwgaMIVVRASoIcHIIQiXAV5FqRlRVQdiJuV9FCYVOJqLMgz7UyVu2OFXz4x",
-	"d+rVzfizwntvyvOFmtLlJrpLLwoiaq6sTfcpJ0S+3Kc568zqZCIbXdcO+4hegO1SxRfKXS2PY9pypt1Z",
-	"pXvU8c64SpL1Z2EaibELFOYMi3V7GeVEm1oZzpO6h6fNW4xREpWWbYWjCBHtXC+RKIMae6LaJCBmNFVD",
-	"lO8VSz+nrZYa4StiIoBJQm9QFISkDfYbmqaUgPdGM19cnAP5Do5xCHXyoCRWL3E4T4IQ+gMva2KtqoqR",
-	"Nrc5eVZOLDHxTv2bNZ3E4+PpO+MtTP/n2exlURXSQK1/1Su09i/6plpP7krG8LVE7Qqty5IUa/Ge9ZqR",
-	"UZ2WDhq0AXRKhwnK3jKaZ460cZS0S916NzrGjIsgoaG2Mq5XZDSKos2mFTqb7hqak80nbCVL1OzjCucW",
-	"IiXY1oJOopZVOq5SOY+vV/NLYpjwVnqktCQqCtcaQIZN6vWaijevt62JcSsrczloPSrdbO1EymgulwpK",
-	"aWWudY7LvHtBiBN4TR3WTP9e1vWVtGq4fS5JLEJ8Z2GiqYl0Fz46MwCQ8xvKIu+M5YD6lAeHz46GeKJF",
-	"hsE9t3xozXtwMDtyRbNZkVDoLGVVgypXpYxHul6yQxcpqJZF6zwzKsbJdwbWiw6uCtVex2ZFt73Hn5Bf",
-	"DS/quIT8qirpGI9y7vL1DG7yYQs/RqkYWG0XODLUZsm6CBf/6tBCHY6PVbjrd3z0qMkw78cmuW+90oN0",
-	"1bP0F6Voh4irvJ90iW4YdfmeBc/zEphenq9Y5R78y1CW4BB6+LhRjtnOmplqZuNtJ2u7ohq5dOKGdZwF",
-	"Z9mAOHlHhuWdlZ0MpfQaBSnS58qDLYl+T+WVlSu7gFx5QhG9ISYeKn52p+5hjIKURigQOEVBVORI29ER",
-	"ThEoHkuzIt8s8s6W3p5xp8apyDVIPzSETessJhSQDtggvzLlgmqADdD+bHY0mc0ns30wf3Y8OzyePRtW",
-	"Yn0haNa5ZffHSQJLczGY6jcQ67hF40uzOumf8YGY1eoR2k5qnmYDBd2qyt2gEG6wzkkojAZCYh1UW4ee",
-	"DjYpzug7OLRPSfmD/D6Td6EGGn99IGYXaxJWmKlTdjdm8hFQsNlcoc6pxi4XnyFOk2sUBcpDp+FV4DlK",
-	"71SzxYURJ2ncJ8V+3VmQ0uDpVKUVOTpyfBJrd0WCyX/oeR3ILiQlMFlKqriWsE/dblY4XJUJMcxB8fJG",
-	"cXwr6zgwP+gw0SEiIhDZ0EILcwAULNAKk8hKuQ15twwQHUZFPuvEqDbCj5Guq0DXxR3PAXCZcvbBNLDk",
-	"YCmD9q491wMa2w4ZAjmZFLPYW98p1rVMQW80bRPCRrK26+NhScH69jg3oykHLjpZ4bstVD62cgmzKo+4",
-	"by7RV6LVlrRLU/nRVp4+NRHjRNKP5TqhAKMIy7dg8rE2uk/vv8bknC5/U5N9knO5zDIiK0hCFOhbt0FR",
-	"nLeCZIl6az0sl1DHMIDnmYx01JGgKh3Ql3mjKAFZki8xGXLZFi8JZShQh8ySGUryNy70qmEgY8gcR6th",
-	"zt26Rozr5E+/YkQCGjLUT5midKIc5SYRHE6vQp8LyorqC++BTTWpt4bK707Y3Miv3OEdJUGUq3BGOGZb",
-	"0Ru5eStIIp1bjRMcChQpTFRkmqf6wDRLdCq6uMigiW/Jl6VmpZJR7r37uOMGrtVBCqVSF0GBpFmzFssQ",
-	"56beZDQeVcUn7sW0WR+WFlHekHrByo3cJS3RV1sr4QtFUMEeNIkyMNIqxErN1yrEaUVCPonSxaGpLmgu",
-	"FUuTs+RKZgxQY8bDi6WVUjUV0w1l08j9brBXuvT6BAr4WoaSRcLHzVoF5AVNDDfFeZJIREjIUIqIrmWG",
-	"iaqPrQQIJslQR7ICoUd7NoSvib9zV5oM7bZfDt3uOi0RSCkgOTEHUBQnyAm6RknL9hilq6y9I5SSPxd+",
-	"vkcf18bUSAuiNBmiew0Mpia8XdGXQSEQU7U02kb6gfENr+D63xOmYtn+EwbnDvyWJ4nhd6lMfBeXrdyF",
-	"5MRSviQXtRNokMBk/W+XcFJ18sVoomuveJ7KKbPVmuMQJgCnRdK51NiGcbUGld6D/DOO63xvPWvRoVjo",
-	"iUBD04whzidX15MMYsa7wTKjwdU1UKPd8DlWIRxzgUi47py/sGOYGMdcnejq+jfKpAWN1e29cjYAOc+Z",
-	"VBZ14cgFdcEhp/MUYgnK4FIVDbT9gL1psX5gLHh7Zsyvgq85LVJ+NVcG8yugninwHftZrvRi9tY1u14+",
-	"ECuGYFQvbjxsmjklD/oFuTshJSa6cYZMGgafW1HtjB6nrEApdC1+TOhSIiblz+BYZ8TqeQtDA4cLw/mR",
-	"E0UDUT+KtrkIChD6uLB4QyoZFSzlOmu3QgSkCIlyAAKM3nC1sWZul5z6/TzrFKQc1eluBiVtt4GDX9kQ",
-	"SpCKQkne3FfzqH2cFwW9zVgyZycSJk19UG5soTZ8Bb0W4upNYL3ZqaLsJAhV1XYe9aAfluqhV5D3pvIV",
-	"dyWRz/adkZBtZvss98tj+iRTBQsowvoVg3m7Mtmei69JuGKU4H+XS6k5APoDhZqLpCfwNYdEYLWUu6w4",
-	"SwZKdBORXrH20bB+u9Ad51XOgrrb2KKZ8RWraLW31sm8IYpiBSuE9F1RUz7rBkuYN4Yu4T7iMus1AG6C",
-	"01jM5yz7cz1lNN2Z6eFXgxM9VXTZPuJo5B2rFWYHcTjbPzqY7L8In0/mc/R8Ao+eHUyOwtnixWH07GV8",
-	"MDueT57PDueH+wfj2bPD54fRQWgNf3HwbH+yPzuIFvuHR1F0EB3PJ/PnM2dzpnolr9VsST2oSqp9b2a0",
-	"TqBDt47ayulrx3mob/Nr8b4HlAlDCZROW/eVDWnNy3AtNHvcF8M244RbHYtuPE9T59ZzH14iNzEaHNBb",
-	"nNyXJ7bh8G5DcVpVWOkLQbNMRQRV7elv5oqjM9PjzDL4y6V1OkNQ+1DaTm7wgdnXhkOnHqoJCv51qAz5",
-	"eFi1Be+sMhvIl3a20pPJHoMbnEQhZFGRoq2nIReTX+95PtmqNvGdW4qqUK6dfhoAq3DC2lkpYZkLn50Q",
-	"Hjtccc9DbkZEEdeXY0y+vMCYN7ZlfkcKDlzAZ5Eb5BneTsyRtesgaZUw76bpk6oN3E4t4F1K9LZUv+as",
-	"WCtp4t11lGZSPryVK/QasRuGxWYJ8PIt7W0Ls0r5R//902rdftB9N8RjiBPVnIxftU8KOmrgnNfAS3Xa",
-	"33ewUGDVpE7d1TQqeRgizj3gblZR3Z5r3KaGCyh9KflBWyEOV0N68UfuatjoGdZVtNIRbviLAdsbXa3o",
-	"vX1qrplyUFgvQU2BIu9qodhXcnOH4sW+csVGg92Hb0HhbRG71R4UtyorKqQyTk5o6Ehbn7wDHzJEXn08",
-	"Aycf3kiVy5LR8aivu+lEGs+JdmkxJabZqY4vYqpYHAuFeGuB4jj8eHQkCagSdxkiMMOj49GB+klqfLFS",
-	"0E5hhqfX86nppDMtpjf+Utnk7ixSa736eFZvFKfKRbRmVfPtz2a6OWZ55QZmZf5v+i+uSxIrP6qzG7W7",
-	"JZ2iesMsakWmNpHnaQrZenQscQBlSzoSU8DzcAUgB7U+dQIuudVDbvRZFe/7sNfKp0kAJYavabR+MNzb",
-	"He9aSJtlwUKue/uE9yFXNKttxZ6T8LfjFj/qUh8+lCWr/n6Pw5iOfoJdZBmPDh8QjFaPSsfS2px3CIbV",
-	"erwwXJtszPSb/kNFhLda/yVI+4GOnfoQxwkmSJPtvT5nzyCDKdK7/M/Wwb8FXhGTq8Y9UKxGhSEYWTCM",
-	"bDWuSyZc+U1/h//PLcY5dPjhT2xHqaZro5H8oI0sHIaBElY1n3wcCXM0u9wxCbMa4G8kYWZjpt+MF7aR",
-	"hBnvcYCE2eD5JcyC4ceWsPrnDDo3Mkr3CuCckvUWiRMa/tfFh/ceUaqDJecqb1y32S2iIVDLVVBFNGxA",
-	"ZHzUDnD+dvnufBA4cmAPOCuhS4N84Oggr1/1VC1j+5hZyldx81b1cCgvsyme/pojtraYGotVUI5wMLG7",
-	"5O527PiszRowJHKmm2rpyr6J6adTXApzgVBrI7MJDJ+3q30dXXodkmK3OkiKXtoNPmgOqfihiPFVjMZ9",
-	"+29/dmFbzrbjyw6bO9zzB4OnzIk8eTunW5ICSKKimhUCgm7sXXdteFsHTL9ZJwv9Vu5EPSyZolMnLBO6",
-	"UI3NcoK/5vX+HH6DVz/oGGTwvPej2wojpvqmLc0KSGDCTROxokOMSuiYcgqX6lBz3FNn7IDh1XwAYB9P",
-	"jYfYkF3klcexadu0Jx36rOzdfujkRUN5KkCsPhfVti9dDNGXxtkZnvi8HbvnSuPf1hOhEtzb78MaT0wP",
-	"mSwWvK9tm0b6A0kqCe53e8xnlHaLRftihidnWzSRH2BTqxZBHXuqv1b0c0u3uaWlG3rfHVUh2WbC+qno",
-	"FPpjmhPXl99ujT3ZVc1QtWqMc6Kb/RbXXx+GwTZQHD84ezm+9bar3GWU1NaZq2xC1sFbVZfrH5e12p2+",
-	"h7vBT5vTFAfUGhRvzkvWd9AHhNi6neuQZO0WWMffDG27AW69he2OHFAV/d508aovOTuUPabf9B9VBm8A",
-	"s6ia76fHK+OOAl/P8hXuA5d31v9ulUvrvVF2i0l1/fPdebTs7zREg5UNEJ+ONey8OPMoZ0GND9jtCPvY",
-	"X9mvmnk9hIclGCQ8Np/+97tXl2bYj55rbJez/llcrIIRSlVFAdRfptG1Aj3cpY94+jRT8f3OXgaSPA/5",
-	"1WOefpt7U4t10UZSN9xzrVk8G2qwygaHXas65KO5bLOx5nij9LRlM7esalufaXUwoSJyYhp+Ph1FW0JV",
-	"sbuuph9yvH+pO6Zt73Dfvi7wPY/2Xd8s3KFz/vKLffUdbqqzaUjJNWJF5W7X9uuB29z/ApQeFsCx5mHM",
-	"ASZZLnSXe6NL9Rc/Cqx0v2fIr0wnJ/21CMrANQ4RuEaMw60yUQOl3WGjS1UgpahMTMts82EPGgPY/FpK",
-	"i6h7AzivuDs2zKQWt8MeoZ51x1V7eTnvXjr+srrZtw1ZN3e6vp969wHwRPV5bWc3Ea6paTbTrdzP1KBH",
-	"2vfmHdXN2WB/S/Dsjn423azuzhbfVLPSTWr4GtyxUXRs90t1hMUlLAODYl+j1Z2um/PfrG4q8MHGcne2",
-	"afbDKfa2ve7acm+BXHXH+uem70xp2tB9b+nvu2ntp8oRXcXWCgZ0jQjAsfpeCOD5ogj7WNmr6Ge5tS/S",
-	"H2AmdoYvHiFX+j20UyOIPPR1xusoqvbvfl9J9VNmgK1WUd8vwTj70ROMZXX1wASjZbI853NFD76iv+aQ",
-	"dFCtbyffGUX26MURzjMW3R/f9GUf+Yoefh0+o26h3z2hGvPr45+Jt7ll507G1VmdXV0BSWS60ZofGM2F",
-	"uYuGaxeL7y6Vg2vJyiqy12tJ61ckutsJ+g8ilD+r27r4213idm8u3rDkrSx2+8nSP4vwdlaWnJV4DyxK",
-	"8r1FgjZMSSwSdCFYHoqc/ZSppyZTY39HWx/JCw4YTHP3V/t2P31fkzxusfimyZmfEvJTQubfJ1iqM9/u",
-	"B0udYujPkpXpmZ+iuPHiP4ogPnyK0koKNuXwz1WLrSVuQ7PZ7bUK2FvnciHH/ICZ7xLvXb+Pqzb5jsnn",
-	"YTeLrE/K7qCyL1ua73pt/Y5eYjLXKjT3bMadNOtVXjT7IXWXRnv3VRfN/JpLfXyEXRc7Wm8+v6b5XkRT",
-	"iIlqPT+SpDYTuHXBqK/bfUTDwS3uTU/76dcch1cTpYEnuix1UnUFq+mYkcszU2hvF6obLFaTKLXgUcu2",
-	"oSm6wJbjih9uP9/+XwAAAP//rLmSJF28AAA=",
+
+	"H4sIAAAAAAAC/+x9aXPcuJLgX8FyN+Idy1KVDl/amA+2pe7xrmx32OrtnXjhLaFIVBVGJEADoOTqDv33",
+	"CVwkSAIkS5clyxPz3pOLOBKJzEQiL/wVJTQvKEFE8Ojwr4gna5RD9efrDDHxHhK4QuyUFjSjq438vWC0",
+	"QExgpFqtKRfyf9E3mBcZig6j3b0XO7Od2c5uFEdiU8ifuGCYrKKrOCooazZ/NXu1X7XDRKAVYtHVVRwx",
+	"9LXEDKXR4b/0JKbzl6o1XfwnSoQc9W1WcoHYeyj/uwsjTFP1a4p4wnAhMCXRofoVcQ7oEog1AknJGCIC",
+	"5GoQQGiKoti3rMOXe8+9a4MZvkDdeSjJMEGACyhKMxvmZhp3BsFKVI26oDRDkMhhMwRT5IEfc3cktQbT",
+	"dMSgBOaouW16GM/CWnuhetrFVtDFGsk9mxMmISgJbZ5rSpsLp93/YGgZHUb/fVoT6dRQ6NRLnldxtGJw",
+	"CQkcPc6vur07hEZFNcI8w5rGsUA5HxpPE6E7nMEIZAyqfxeM5kisUclHA/lb1cUd+JKy82vD+YfqHIbz",
+	"KryVuut347MFLUk657RkCZpbQm7OqT8C+RGo5kBQzS0aZ91p8w3/mk1mfRMKuPJMpYdXHyvmDk2i2vpm",
+	"6LKjHmI8O0rUNyH1IcrLn5RcICZpFvLzT+hribjo7q2A/HyIpOQAipAgP58nlCzxar7EmQdp+iOQHwEm",
+	"YAPzDCwpy6EAayEKfjidpjThOwUmqwQWOwnNp3+upwKniykXcJGhqZxkoscpGZTjTuRwk2WZZTtetA2t",
+	"nBeUcPRDLt2lGLUcD6Re2mAICvRZUVCQNDSBDWFID+KIrRDNT4aJ3swYhriXlBEXOIfC7M0SlpmIDpcw",
+	"4yhu7dXlWgpdJoWH7aRkWI5XGu+A4z9RDFKzDQCSFCwwyegKMCQQUT9iAgqGkjVKziPfWTyevnw76UPC",
+	"EeaSUD6hDG4cNLTkcqKgExRwQQsAAZPNATPt4xbWnF2rDprh8+UDzNGJbO1lwKMyLz4rvagLXq0vpWVe",
+	"gJLgLkxy2gwJlM4VY6jfNC9Fh1FKy0WGaoyTMl8gJqe1ezkXVMBszujl2J5LTDBfo3S+2Ai0dactJtKQ",
+	"eVaFiXh+EA1qzI3+cRdRnaW0wfRjyUdsx2Q7WoNMDBKb+jrXnDRfCZx66YMJTFbg19N3R1a5KAsuGIK5",
+	"YcLG4Ytewd1lsrc3Qcns5WR3F72aLPZgMpntHezBZHd3NpvtH+5OXrw8eBXFESmzDC46KnR9ZDdADGgh",
+	"FkQpX2WTMWBqRWSByc5M/t/eeFhSzBoiLdqZ6g96iiZsEowUM5QIyjbgco2YFm16X6QAw1wKBklPIyC4",
+	"C+lwzBhlf2Cxfo849+pekmS04EWybYeM1K/zhKaevuobSLSK1uam2HTN+SrUMzdADZ1V9UCxC4+Pk35F",
+	"wmjY78iShhWSRDea+9jCfANYblslNcqQ2IijsVeQ9jWuvU4HqP616QuS3PbwClMo4OibTGNc34VLCTA5",
+	"yhihGcV69v5FaPq9/UXoce96EZ/XkKVHRycnNDm/xTW4w975EpQKeJvAV/rp3YOtdZ5bBVwPedfgSzX0",
+	"FnFe3ZruGOT3Sl9Hp5CtkOC3CHxj4PtYye1STrmox/RBLxumZYbGoOKzaeuMdstrP5UayGfBykSUDIVx",
+	"oKGaJ+omOOdfs+Yt8+2n49enx+D09ZuTY3Amds/A389wegYwEX/f3f0H+PDxFHz4/eQEvP799OP83Ye3",
+	"n47fH384jX/79O7960//Af7P8X/oHv8A03+e/rd/mYMPpXNMUvTtC3h78vvn0+NPx0fgn9N/gOMPv777",
+	"cPxv7wihR2/A0fEvr38/OQVv//31p8/Hp/9WiuXLfHEA3n48OXl9emz/LfVKn53ILK17dU4XXsuV0vY9",
+	"zdXvuyNMBVV3O5aD1Z6t+r8ww6nSzJQSd4siozXyffBcPeUQ92kU6ZvrdtbYeg5D5SGWvNANKbvJNJSF",
+	"JmihyD9b7FmoF38t0/qtO4/2Z7PZjZ1HJxSmw0aIjMLUb4TosQmEte4cCWgujw6l1kt1vlf33y4+GF0x",
+	"xLn3o761j4ephbWOecAdz5m6uRQP4D6Ut3wkN6WLkDNrFA1JL8MgNowIHCKlj+o+qqyPbxQKgjZIg6GC",
+	"eoiuoBzLP621QLcF6AIRIY2JZ9KccFhQfmYMxzEwd36gfILINL1c42QNlhBnHAgKFggwVGQ4gULd6YMm",
+	"h93D3b39Ax/yaNGF9oyf4+IMyP/mHXBjcCbnhAk6A+YPDrAAl1iswRn/minhcRaDM0wkEs8A+oaSUiDu",
+	"fAULtKQMASxiZWA9Y0ia7c9AAkmCMg4gKBi6wFRyamUR0KvHHBAqACyKDKMUbJDcP0TKXO6qhDmKIwOY",
+	"+ksOHMWRhib64mLJtO6eybV/ZZTpQ+vrtekjjuxK/fIH5bI7KDlKwWLjIFThwuDN3c9/Ra9PTo8/WQ0n",
+	"Xeye7ZyJxe4ZeH10JDWN399/AMkeePfhVK6wOji6IqTvaKDFEAv0GDCkLXzOEC8zz6ILhiaqBTAt3F2o",
+	"P2IOCsg5SneAX/W5iVU9bsI4sNLPG5IctQygzRV7OaeAJUdnYE2zlAOYZQ3W4aAkAmdyv3mZI8kjS8jF",
+	"ZEnZJWSp5KcMQY58PS8ZFgIRyziEXiqOo6UAlxBrkyTVIQOpBNvhCQWTYgU5axRH7qRNhrBNb50jtqa0",
+	"9s1g0AqtxQQCSo8JWqGXWcnXDZOqtn42R/2DYYG06NML0r5mBBQFFRQTAbj8BQpw9F4KLa1MYAHgUiAm",
+	"qdwaimU365/r+ImkmEgoEYj4JMXXDGxoCS4hEc4Ko7j/5gPOkt366mNvJ/L6E4OzZC/8ad//6Qb3nf/l",
+	"JaUNSbqL/b1IocU5LQTOMRc4AVzanyQapRIitXp9ziiXvNkaSrKNFqWXayT9ddpyDGiSlIzL0zU05tHR",
+	"Ccgb1uJqa9reSWefBgi3dZsJig+YZXMFKN/KaWnJXEqIi2oqvWS5WC4QTKWacaZ+muP0zEt59mt3J2rX",
+	"S3t8l/J2x5mifUKyPtAp8c7DHeGFV4QyI71opuKTkgxB1pRb9cf+229A7nhice4iKu7mF5vfSuZzmtQe",
+	"HokbUhagoBlONqARUdD1pXwrMENN+pu1aU810psjsPZ3VdO5/ghLAwG/kkMT8k92AbPGvPvPZ52pT9cI",
+	"2MaS9gvEME1xArNsA4zGsOy6uPSy0lqFvoBZiQ6BmkLKA44SSlJ+PegZyiEmc17ApBlusPusDf97THBe",
+	"5mDJkPTM8XOgeikYfn1znemvQjRxq3EB9+gHHfJ7NuYsUIKXGwM8LxeOt1PqPh2wd8C7pbor6J5Y0oSE",
+	"MYMCcQEoQeASS00LqfNjB3xWkJor2CHYg+jF84P9g8nyxauldC+/nCxStGfdy9JU8dLcr4Ydqi1O7+LY",
+	"x+9qW98qJu7iQykk6lvFlF0WV578uf54+JfnIPjpl39UfvmrEJUM27tcsd2kEhMdWxuvmkO0cGgD3TSb",
+	"6IOlRurf26aHGOy+evHqHz5mb8wbID4fzd2A2PqJyw+CRpyNcpUA3T4ACRTJel4W87yKeA/qgKotKAut",
+	"C1e74xjwQmzulavb0We97p0pLxdqSM+qAqG1FomaKhvDfSoJkZ2HJGeTWL1E5C7Xt8MhpFuw/aJYXlyq",
+	"qykPKvbylrKAPBzCbBtYqq7itwZ9Pze3S2krf8AypcHT4Eg6Y2rJTTuU0JAt9m5gZ6ow1IDHh/TPaiHV",
+	"Arogq+86KNz4MSqYBrHZ9sN8RknJsNh0p1EXT4MezrOmWq11iiVGWVqpE2ucpojoC+kKicoQ4A7UGAQs",
+	"Gc1VE6XwLrXxsn0WtExuiIk5zDJ6idJ5Qrpgv6V5Tgn4YI7Dz59PgOyDl8pgzKPxGxhHnGfzBIaNFc7A",
+	"+nywLV2y9tK0HFiuJDj0L85wch2/Hb83Ktr0/z2bvTJ/t5c2POs52oQnfVvPJ3elYPhCLu0cbao4b2fy",
+	"gfna1oQmLj046ALo5Q43OKcrh9KQCfro6KTK3chocr4VIfSbDJRtRRlWzMCug5qfT3YnjvHaqxJ4I/v0",
+	"sHk7+aJAnBurjm8sekl8J6n6ubV+r5UKpSEhyZWl3khK7ZZYwwsEGEoQvkCpGlqieSvUqvh9v34rPwHr",
+	"tW+PU5JrQUv+Jm4EcIuq1blaL8Fspd2FuKbHCrkO5EHyxmT1K6Nl0U/f43G8xIyLeUYTrbn6utTI3Gbr",
+	"VLiQr2lJth+w479Qozdw2FrIaKRWmR0+SgncH7ewUCrDrD7gMAe6e0NtNN09Fkl9Va1V8JEW0ZIjfTEV",
+	"FBRl5Tjh+kj1XRmCICwzeEE9EkP/XuWCVbhqXSV9vGnNhl5Vy+TR+ZPlfKMVkPNLyoKcDqoGzSH3D549",
+	"H3O7tVZL/9iUNQ66/f3Zc5+FrLBGyt70R9Wovv5UNo6+Tq45RDKqo7D1qsG2XVOF7s0xHJ1JeJ2YncH4",
+	"TitFR2n58k7i6vglRyy4Nvmxsz5GqRiZoTX3xE2YKZssbP/VI4XeloJeIFZbLlr6rf48GDPTaucNwLBy",
+	"stJTNDaUgo7F3zhYIiM9O8ObL17j2RITmNmJgwA2Wo0DTwpwJk9nylKUAijUz4iktoXMzlhm9NKrQ3F/",
+	"BFKL9kfSd0C1qCV+Be4acsAQlFGjJi9YbQxwDqkuCnXfvsCpRoswlo0XaRBWAgu+psI4EJquLqoXYSOl",
+	"Khe79iiaLKohX2GDTyq6qo7nGs4wZ/TceJ006PCNV7eajLv2usIoNF9lr/Fl4wyn1GhG48rLJu/Cl4z6",
+	"LD32NOAVMIOnQS1EbyDZTRBVgANaya1dH5VuYA2E2cbNT0c+bWHLrFhLSy4gXtoRkKnwViNYgyYqRZTz",
+	"SxXlMKxrcSSapnR7q2AIphPlfjdRKVpeWWNHm/39hkkZgzWvPcAjwDnHBZDkAFnDrLLYNDk5wKphrAXR",
+	"xVBOL9A8RwIOg+doprqfCl2ojX6YgJReEoNK1xTWRQ1corm8Rc0FztHcpiR7bofSMWs/S5Eme3avy7sz",
+	"fkfRbhKFCsjAzVU1UP7jBkB7s9nzyWx3MtsDu88OZweHs2fj0vyrPaujLYLOT4kGdQGuoTT3klbwgTLk",
+	"bWQ0z9+ENOJJuldyq9lMnRALhIhzu2m5U7w2DGcEuzMmxkEm+ZuwrCiO7AHVjHAwbe558/S+WcgNtxkv",
+	"kI1I0xFP8sNt7i8telny5suWwNJSjOYqG2Vn6JkWTdZ6xrdbmZdwb3eN3ukb+UGdKWVe/sjT10n03yK3",
+	"drQiIOPpRkLiRPs7keNb2M+20BzCfq7BZCrV0KizI1cmg1DrlalUBf/K5CegYHOJ0nhvPBYpEy+VzpVB",
+	"iSbn84BS3av76I8B1PjD7cMKjUWlWadXv6nR0ePmlqv2p3WYG4Qe17PYhcQEJiuJFR4wQtu4QW1Gte5M",
+	"zIHtvJXFt+N4H+kiF129OUFEzEUxNlvFxEDNF2iNSep4ncf03YRvxLd2nasLZbSd8TrhQcrketPlrdPV",
+	"q1TvWMeGMiRKRmx86Jm8083dbKczuX2Clcjdun52lrHJDd70JqzNdez2SKzqLuN30OHilbSQ91GsbtAi",
+	"WsgQKMnEjjJ29U2z/KDp2kWEu8gGzcbjvPpN4vKSUpuLfXhyLuOuSAgxhU8UKRK4qV86lLLZJac2xY3k",
+	"CthhCRX+GBSQ8GI1z6BAJPE4weEFYvIEN0iSFxyT2QMgWKiQFBlkRC9BsoZkhXjzqhPFY4rkVMltrbmL",
+	"gtFvulqTrM9kzTZ19pM7sS+ysss/KcqQQN0KPuEemHDExDY9MsjF3GQqzYVnYY1EMKlwcgHzwi5Pdq8S",
+	"neoFjltfDr+FNzOH3+52I4PnS5huM7i6lYWL2mXlTtU9HUxqgB5Sxczb67h0UMs2Xhd1qVIVxpOBLwM7",
+	"alJTc9AmbVqmiBvs2dzfLqVtJ8z4eRdfJmnFq0ItcSalMzOFBGCaqvRGmP3WaD2kE7/B5ISuflGDfZJj",
+	"+a4siKyhNFfpoqtzWwpAE8RQJo9b7k3f+XlZFJSJKltKDwvSNANFVq4wGVNrVWclzJUJWR41lXBvzq6b",
+	"VaXigG7mPQsuEOMNE3mP0ogENGhorD9K84n8FsVhyq/MUHL5XFBmc2uC8Zz1oMEk3fBVqx394RuFknla",
+	"GjHeHW1NL+XmrSFJdRTQMsOJlPdyJY4Npc74tKkqGvnRF8+USi+a+800yrkCN3LShCprpjx0jo5OnMma",
+	"cSd1apF/Mn3lGefhVDdF7Ryr3ZzX8TAO1gERDCdiXsM+byNlbBVFw1ZqvE6aVccIGeIorZDrKoyoEixt",
+	"ypIzmTZAtYnHF3ZROpSp7tISNq0wji32SpeJOYICvoEcVb5bP2lZyP3mP0wSphKDVQULmGVNAyD02f/8",
+	"l+wahAHp2WK+9vq9u9Im6NCB0pHtvrg+gZQAkgNz6eQ06lCGLlDWOXuM0FW6Unc0o0JtigZR9LRpoBak",
+	"eTZG9hoYTAWabr5mAYVATKXa6DMyDEyoeQ3X/z9itBiG6iqwAwPudVlFYMhZar3MJgK8KGpfNBeoiAEW",
+	"KvtVWssFZFIuwxXERHs/BNt4/Rpt91O3RdCD3XUWdTsb+rx+YEYTcV7h6ZrMXXv33sFktj+Z7Z7uzg5n",
+	"8v//5+zl4Wzmtxyioi9AHhVtX3+sUpaknmog/EUi8iyu/v0HxELeEp2fjJ3ZbfVJeelMsQE7kvF4nzWz",
+	"i5vDhnLyvdWIQse9UXTHIO9ZCHme9P7aiIiKKLbE3aK1LgE19rIJnJeQQtLulzLLzNkiD+5QjWjHRSel",
+	"fnWWyRV0veuQwGzzp+8gpCoemtFMRzHwMpdDFusNl9mRAOc2VqvSjgy2tLYiNXX553LZPGOcb519sxM9",
+	"EGhoXjDE+eT8YlJAzHg/WKY1OL8AqrUfPs8shGMeuEI741udEdsaJcohrVNRqZKKS1WYtBoNQM5LJg/m",
+	"5kFUCuqDQw4XyIkUVBllUsy6OvfO1M4/N9pyd2TMz+dfSypgd2z5DahvCnzPflYzvZz96htdTz8Xa4Zg",
+	"2ow0OGirlIofdAe5OwklRhT6TTEKhpAKX++Mbqc0rorpOvSY0ZVcmOQ/s8YmIdbfOyvEeXCFu8+9S8T5",
+	"yCW6qtncgjBEhbaHFDLK7FmayjmIgBwhUTVAKppJbawZ28en4TuVm3tvW/Ve7eYVbu9iDWFhQyhBylpC",
+	"yva+mk8dsIt0PvjuReGNFWRSrZ5XG5tsGhQxi8MLVz2B07NXRLnOGKoSXwPiQX+sxMMgI+9MZRd/Ul9I",
+	"z3xHErbd2edcdQJHnySqubJFNnmqWyTAHUtatteMEvxnNZUaw9g35U9S6/5aQiKwmsqf4V9kIzm6vZBB",
+	"tg7hsFl11G9TqZUF2aiLM6uuVJahsfmElcG07iD8Hcz9cIspTI+xU/jj38x8LYDb4LQmC6lqYa9NZbnq",
+	"9dnw85Eum04BVa+tiQu5pzbu13Sw/5azeb2Y9qMKRoJVvw5NEPRNNGyl7elVkEs1ZLGGHIFcZjurPkD2",
+	"V4byT7+83d/f9+a7OYr7bH/o1qMqTNkXbHg4Y0m3s0F9zvrGO9fVYroz6DW2EB7ba6wJ85moa0EMZK0P",
+	"gYjU1GR4GKNCZCgFlAEiEZE1g0nrxt44UkxSeunfBqcr0O0k0tFyiZImsglerUW2mWjZOMQ/GgUhTqgN",
+	"jcFqhtY9XwMw218ms73n+5O9l8kLme3+YgKfP9ufPE9mi5cH6bNXy/2ZzHafHewe7O3Hs2cHLw7S/cRp",
+	"/nL/2d5kb7afLvYOnqfpfnq4O9l94aWWVs2HGgr9oS6+Eeppou2rjgf+I/ROIod7YnmHd8RX8MNEdTOU",
+	"KY9nf3EfqWxWlrvE7PGQObN9jb3SZsmtx2mrBE0zeBDJ7RWNtu06lDwUkODCEdwGG9RllcjP2vYVxU6V",
+	"Amsy8Rr9vQbncGENbdkW1PXXu3ZuPtIR17pvqI9qAEu/nhNNfh6XKcB7cwdH0qXruAqETMQyjz5NpPvA",
+	"eOtaxREm/7xhGF8nUyIU3hfwJev9GgGr8MLaG+XvaDMhNUYE1MSaem5zM1KKdMlVs8BqxaNqVozA4MgJ",
+	"QgpjCz3jHxbzOHB6UFr7Tvtx+qAyPu8mw/M6iZd3lJXozUOscBLcdZQXkj/CxV0vEFNG4+2KM9peWjcX",
+	"Zpbqj+HksXreYdBDpXi17XuurOId70dP/la/bX+8Cb4e1Cu72odKmSSI8wC42+XJd8eKu9jwAfU7kUGD",
+	"6uh3ampco8RPdaK3i/zImGFdUkeGIgJIXFd5p1ZGQJYuqTwndFrRdTKRIHAiF9SsKvLMVlHUhTGq6uI2",
+	"KMyA73XlObHjd1YShBbBsJAwOmNwJuF3qo/bXWhmACsnmFvvHHvKicsxxlUON8bQeaD0iJlUQa4aarwz",
+	"vJIpwqCqTNLdqPH56CO0oGvRZN+kgUO+pd9ea9oxZiJLhV7WVi69W33vdLyGoSd/4k+X1kg4NQaUYK6e",
+	"XE6Gcyx4g0GsU5wSZGuoVwYwzG21bZTGiphyLKTmq8YBOYJEKpf6n91r1EYgPi8Qm+twyS5IqkWV7GZy",
+	"eh2tsEDMRNK2ci3f4zdeCUEv+yeUDbaeTxYZnA3YNq5dMk4ZVYOhxBm9NNEiCnKN9tqi+OoVMF3Vw6/1",
+	"QrRPHqBvCUKplbsO/mb5yJS69vtBAS3Hpv20KG5TVIKpU+Q6ekuzMifanSsvKLm0uvnLRGxdSlt0H4gJ",
+	"vImZIgFx1oYyJPnt20sdqrLxzk5JnNtNhWt5E/rOgnAllcCLGZ09Mid0SQpGE8Q5Siv/ZFqXC2+9b9Bs",
+	"HXJWDGKwEQw/6PLoD0P3juBNxq14SuFACr6l//F5X2Gulo2h6UdxKacFfxMpsctL1Y4ZgGsK/tLLpe5z",
+	"WB1eDTLB5XpTV8tUwWjcSUz+zrRcU9iALrt9uujW1DQSln4blNfPZv9pEzVHbDfdtrqPNz3GlveSu+5s",
+	"OoD+qJ0R9YDMwNW7TKMHv/VEZz2g0gr6BKDSjnWqo6cWzVD9RE/tgcHKAgUiMs1vG9BMlyBwlfDdalDb",
+	"KTjs/eZvX7dKY/3wXqjOdocoO4WJek+v9gRjeGqbGfqiLhvSw5CYFROdhYdADRGInxZ9rONl/tgra3xC",
+	"q/WEeV/Ce0+IQLi6T9f6Vc8YvBOZVxo4sCZ9QU3Foa5XaIt0/WtUIxqqP6QXc4dvAGoAdu/5EcArFcko",
+	"ECMwO6KJR2AdvQcfC0Re//YOHH18G8VRybLoMFoLUfDD6TSlCd8pMFklsNhJaD79cz0VOF1MpE41qa7v",
+	"U64PWOV0XVI5jcAiQ74JbLrYYfRcIlCbyRCBBY4Oo331UxwVUKwVtFNY4OnF7tS8azu1wxsnUvUgz7tU",
+	"zfX6t3fNd+u1Pq3MzWq8vdlM/o9TPBkWVcze9D+5jsyvnUt9sjXwQr7CekuSa+uu2kRe5jlkm+hQrgFU",
+	"L+STJQW8TNYActB4Nl/AFXeetI++qDqVodVri0UbAYoN39B0c2tr7z7A31m0mRYs5LxXD3gfdOh8Yyt2",
+	"vIi/ijv0qHNT+ViS1G+NnmgBfA8IqecbhZY4OrhFMJRN4w8s1u+Ntu2Z2mQ7hBlDIxgQmlYH1zYbM/1L",
+	"/6Hc5Fda/mVIoMBOfVwuM0yQRtsHrQwUkMEc6V3+VzcbvQbPBioQ9RyhWEf2IIgcGCJXjOuUQl9Mou7h",
+	"O9i+dAjnwKM6PrAdpRqv7m6O3UirMIzkMH2S3x+H1fM9Vg6zytW2HGY2ZvqX0cK24jCjPY7gMBe8MIc5",
+	"MDxtDnPQNbSRab5jgfNy1q9IHNHkf3/++CHASk2w5FjV2xldcktpAtR0NVQpTVoQGR21B5x/P31/Mgoc",
+	"2XAAnLXIsz5wnFDfXtGjPQ9G5vQSs+SvqoKzfAKpuv0pmv5aIpV4aola1nuqWniI2J+SfhW3p3XCsE01",
+	"ZqmuT8xzdLbKqw+Exits28Dw5W6lb43yPrHrPlqTYe6lg3aTmh6sd1Td0Xho/98yVHlp70rZdqawl+3t",
+	"Fe7dW4On8iY/+HMuUZhT7mRNyQACgi7dXfdteFcGTP9ywi2HT7kj9bEiil6ZsMroQr0LWhL8tWy+tBQ+",
+	"8JrRn6MOvGAURFdgqHAdbVc2kMCMG0OzfWBNGXRMCpRPdKgxbigzHsHBq+kAwCGaisecIY+RVu7nTLvL",
+	"86RHnpkvktYOwhZ6KoxDs3u+9BHEkBnn0dDEl7s593wBUFdXV21wr74PaTwwOWSsWPCmZ9s0xdx6bHvU",
+	"niPd6nGR6NCd4cGdLRrJt7CpiIzY02Pyc0vveksrNfSmO6quZNsx6yf70PbTPE5cLDjHydVjlgz1S8fL",
+	"kui38m15yNshsC0ExxMnr2Pyw1CXEVJ3TlzVe3s9tKWe23vipFXjYHs1+GFTmqKAxvv+29OSAWKkmVY/",
+	"zD3GWHsHpBN+3exuL7jNx8gfiYPK4F+PFTTOjiWP6V/6j9qCN4JYVMDvw6OVuCfrOTB9vfaR06eL+6bS",
+	"5ssEj4tIdWz39Wm0iicdI8Gq2MKHcxr2VhO5F1+Qxspjc8K7OWL16xe3oWEJBglfIjagXp2aZk/d1tgN",
+	"Z/1RVCxLCKBO4YVgyZCNFRigLu3iGZJMMs58zDmpaF7Gmt+j99sUk1ls9Mw2uNs3p/029sCqguv7ZvXw",
+	"R3vadqpjvJV52jkz71jU2m3uE7MKyZlJwXw4graCqiZ3nfc7xr0v132nzn030fp7uvY/KgwYcB7PUWr9",
+	"/MA8/dLa4bY4myaUXCBmI3f7tl83vMv9t6AMkABeahrGHGBSlFJ1wNzK0iwDC1XdRw2lH3CWSS663o+s",
+	"0I8AZeACJwjIAHx4p0TUWtLjIaNTFSClsExM/nr9xBJsVk/yIHVnBOXZgjrjjlRbMuce4lkfuWi3eL2Z",
+	"jD+tyx3dBa+b6hPfT7yHAHig8ryxs9sw19QUiO4X7u9Uo3va93bhru3JYO+O4Hk88lnv6g3I4i/5w1Yx",
+	"fC3q2Op27CbVeq7FFSwjL8Whl0keddxcuNxcW4CPPiwfzzbNnpxg757XfVseDJBzCjP93PTHEpo2dt87",
+	"8vt6UvuhUkRfsLWCwRY25DRHgJeLqlhYVcD5Z7h16KY/4ph4NHRxD7bS7yGdWpfIg1Axkp6g6vDuD4VU",
+	"P2QCuNMo6psZGGdP3cBYRVePNDA6R9a0LprTcxd18PJGt39aVNpZ/4/mhpN1d2NbG1T+IU2kgDKAiSzW",
+	"ooyNjTf19Xt8VU3jBQL2yXmUbkmBporQoPL0iWbZAupy0uYFzaeqV7deUn34EopR6QSAybmJKVDg18/g",
+	"KgO2fpRJflePi6bAvlkEGVJVTXVsunwH91qq1U+aeVw0YyNQmpEnYdrZ0q7+WUD2aOji9o+09vK/Uwrb",
+	"o6RMVRZbUeOqxClKewUaZYAhXua65LYeD5gXMrc4JRniG5JMlBAc8gt/Um2rt5L4EyPt9vJ/NGUtLfNC",
+	"VQ3IKEx1cCc378NjwnEqbwLGHGNpUBe41y3PESoqbc3U+N2CENW7E5M0zSbyeYBxnmL39Y9RgVgPxcrh",
+	"REdVlSkfc4hUeyMeY0RqSWwR8tYbKLxPF9iGpqelerdmQMr6H7d5avab3hd+fhSJq+kBwBbB1fQG9DvY",
+	"2WZbwvNGR9tnIe2LxGNEbOOlY/4YBez9pKZ4xbcaZV5AIRAjUSjl5J/jR1RH7cCAqs0/7z8joUstj+4U",
+	"kNTayG2R2pDmFvMDo6UwlYAwJbfClaMz+aocvjcbievXJL1e/sITYcqfuYV99O1PMLwxFW+ZcFilGv4k",
+	"6Z8pkI+Wl7x5kLfMSrKfLF+5XUCIermHlYko2U+eemg8FYcfWQ6h3FLAaJwvMvRDBk82OI87JL6t/+Yn",
+	"h/zkkN3vc1lqEt/jvyz1smE4RqkKgvjJiltP/lQY8U5Db9p8+GPZGDXHbXls9mutAg5mGVVu4Kfq/n7s",
+	"1dC0L/p6Xo9xdV1MIYFrVHV5Om67DgTOewaVq5USJ5YFwWQN2s+MAkyA9F1LBIq+aGrV+qHFVDdo5XGq",
+	"SRah27ERLQalLC2epJClxY8hY2lxTRErY3ZSVR9ydIT15w1Jjq5TUvLHCbKuUPDDVZSEJUexDQijDCwh",
+	"F5MlZZfSr6wqa8lloxRcYpK6gWQm4loXP+VVM+dw2ZI0xZpRIUz13BGJKae2/VNNULEI+OGCG0wC5Fr+",
+	"Ry8RZDjHQukpMvBaBZLR1hP9mjTdOLMtCbB+PHuqXhwep4nW792rBT+qaDJTdUviUa9YFeASUKAYpGgJ",
+	"y0zIVMqzklQPNp+Fnr5SbzSrvk39j5S53ACoHh93BpLjrAiVa/nyXaxono17jNFnNdXaPbxuALpz3rVw",
+	"83QP/hYifjRRqzkwBiaAUSoASYYg256yxkrWrWxANfafqjWoxsCPRnqman4zG8EhuduhtbH2pBrPj8yy",
+	"5BzhZuWoCsHXh3mwfGdls7lmEc97PaMfb8Fih6Zra9vNSXu0jecJC1Fa/NgytHphcqTclJ0Ru7C7X7Is",
+	"OozWQhT8cDrd0HInpTnEZCeh+TS6+lIN4DdnR3GEvgnECMyOzFPEzWYpTaK4NUtKE75TYLJKYKHm+XM9",
+	"FThdSGG9yND0a4mT84nSEia6rt2kflawYSaPfM5Ffn7nUEl79yTNHXjUtF1o7DPSVTv7w9WXq/8aAGue",
+	"8pm9EQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
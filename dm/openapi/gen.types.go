@@ -8,6 +8,44 @@ import (
 	"fmt"
 )
 
+// Defines values for OperateTaskBinlogRequestOp.
+const (
+	OperateTaskBinlogRequestOpInject OperateTaskBinlogRequestOp = "inject"
+
+	OperateTaskBinlogRequestOpReplace OperateTaskBinlogRequestOp = "replace"
+
+	OperateTaskBinlogRequestOpRevert OperateTaskBinlogRequestOp = "revert"
+
+	OperateTaskBinlogRequestOpSkip OperateTaskBinlogRequestOp = "skip"
+)
+
+// Defines values for OperateTaskSyncDelayRequestOp.
+const (
+	OperateTaskSyncDelayRequestOpFastForward OperateTaskSyncDelayRequestOp = "fast-forward"
+
+	OperateTaskSyncDelayRequestOpPause OperateTaskSyncDelayRequestOp = "pause"
+
+	OperateTaskSyncDelayRequestOpResume OperateTaskSyncDelayRequestOp = "resume"
+)
+
+// Defines values for OperateTaskValidationErrorRequestOp.
+const (
+	OperateTaskValidationErrorRequestOpClear OperateTaskValidationErrorRequestOp = "clear"
+
+	OperateTaskValidationErrorRequestOpIgnore OperateTaskValidationErrorRequestOp = "ignore"
+
+	OperateTaskValidationErrorRequestOpResolve OperateTaskValidationErrorRequestOp = "resolve"
+)
+
+// Defines values for StartTaskValidationRequestMode.
+const (
+	StartTaskValidationRequestModeFast StartTaskValidationRequestMode = "fast"
+
+	StartTaskValidationRequestModeFull StartTaskValidationRequestMode = "full"
+
+	StartTaskValidationRequestModeSnapshot StartTaskValidationRequestMode = "snapshot"
+)
+
 // Defines values for TaskOnDuplicate.
 const (
 	TaskOnDuplicateError TaskOnDuplicate = "error"
@@ -83,6 +121,22 @@ const (
 	TaskStageStopped TaskStage = "Stopped"
 )
 
+// Defines values for UnlockTaskShardDDLLockRequestOp.
+const (
+	UnlockTaskShardDDLLockRequestOpExec UnlockTaskShardDDLLockRequestOp = "exec"
+
+	UnlockTaskShardDDLLockRequestOpSkip UnlockTaskShardDDLLockRequestOp = "skip"
+)
+
+// Defines values for ValidationErrorStatus.
+const (
+	ValidationErrorStatusIgnored ValidationErrorStatus = "ignored"
+
+	ValidationErrorStatusResolved ValidationErrorStatus = "resolved"
+
+	ValidationErrorStatusUnprocessed ValidationErrorStatus = "unprocessed"
+)
+
 // AlertManagerTopology defines model for AlertManagerTopology.
 type AlertManagerTopology struct {
 	Host string `json:"host"`
@@ -151,6 +205,9 @@ type CreateSourceRequest struct {
 
 // CreateTaskRequest defines model for CreateTaskRequest.
 type CreateTaskRequest struct {
+	// whether to estimate the migration size, duration and binlog retention in precheck
+	Estimate *bool `json:"estimate,omitempty"`
+
 	// task
 	Task Task `json:"task"`
 }
@@ -213,6 +270,12 @@ type GetClusterWorkerListResponse struct {
 	Total int             `json:"total"`
 }
 
+// GetShardDDLLockListResponse defines model for GetShardDDLLockListResponse.
+type GetShardDDLLockListResponse struct {
+	Data  []ShardDDLLock `json:"data"`
+	Total int            `json:"total"`
+}
+
 // GetSourceListResponse defines model for GetSourceListResponse.
 type GetSourceListResponse struct {
 	Data  []Source `json:"data"`
@@ -239,8 +302,11 @@ type GetTaskMigrateTargetsResponse struct {
 
 // GetTaskStatusResponse defines model for GetTaskStatusResponse.
 type GetTaskStatusResponse struct {
-	Data  []SubTaskStatus `json:"data"`
-	Total int             `json:"total"`
+	Data []SubTaskStatus `json:"data"`
+
+	// the state of the schedule of the task, only returned when the task has a schedule
+	Schedule *TaskScheduleStatus `json:"schedule,omitempty"`
+	Total    int                 `json:"total"`
 }
 
 // GetTaskTableStructureResponse defines model for GetTaskTableStructureResponse.
@@ -250,6 +316,18 @@ type GetTaskTableStructureResponse struct {
 	TableName       string  `json:"table_name"`
 }
 
+// GetTaskValidationErrorListResponse defines model for GetTaskValidationErrorListResponse.
+type GetTaskValidationErrorListResponse struct {
+	Data  []ValidationError `json:"data"`
+	Total int               `json:"total"`
+}
+
+// GetTaskValidationStatusResponse defines model for GetTaskValidationStatusResponse.
+type GetTaskValidationStatusResponse struct {
+	TableStatusList     []ValidationTableStatus `json:"table_status_list"`
+	ValidatorStatusList []ValidatorStatus       `json:"validator_status_list"`
+}
+
 // GrafanaTopology defines model for GrafanaTopology.
 type GrafanaTopology struct {
 	Host string `json:"host"`
@@ -272,6 +350,24 @@ type MasterTopology struct {
 	Port int    `json:"port"`
 }
 
+// OperateTaskBinlogRequest defines model for OperateTaskBinlogRequest.
+type OperateTaskBinlogRequest struct {
+	// position of the binlog event in `file:pos` format, default is the event which fails to be replicated
+	BinlogPos *string `json:"binlog_pos,omitempty"`
+
+	// `skip` skips the binlog event, `replace` replaces it with `sql_list`, `inject` executes `sql_list` before it, and `revert` cancels a previous operation which is not applied yet
+	Op OperateTaskBinlogRequestOp `json:"op"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// statements used by `replace` and `inject`
+	SqlList *[]string `json:"sql_list,omitempty"`
+}
+
+// `skip` skips the binlog event, `replace` replaces it with `sql_list`, `inject` executes `sql_list` before it, and `revert` cancels a previous operation which is not applied yet
+type OperateTaskBinlogRequestOp string
+
 // OperateTaskResponse defines model for OperateTaskResponse.
 type OperateTaskResponse struct {
 	// pre-check result
@@ -281,6 +377,18 @@ type OperateTaskResponse struct {
 	Task Task `json:"task"`
 }
 
+// OperateTaskSyncDelayRequest defines model for OperateTaskSyncDelayRequest.
+type OperateTaskSyncDelayRequest struct {
+	// `pause` holds all binlog events until `resume`, `fast-forward` releases all binlog events written before now without waiting for the delay
+	Op OperateTaskSyncDelayRequestOp `json:"op"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+}
+
+// `pause` holds all binlog events until `resume`, `fast-forward` releases all binlog events written before now without waiting for the delay
+type OperateTaskSyncDelayRequestOp string
+
 // action to operate table request
 type OperateTaskTableStructureRequest struct {
 	// Writes the schema to the checkpoint so that DM can load it after restarting the task
@@ -293,6 +401,21 @@ type OperateTaskTableStructureRequest struct {
 	Sync *bool `json:"sync,omitempty"`
 }
 
+// OperateTaskValidationErrorRequest defines model for OperateTaskValidationErrorRequest.
+type OperateTaskValidationErrorRequest struct {
+	// whether to operate all validation errors instead of `error_id`
+	AllErrors *bool `json:"all_errors,omitempty"`
+
+	// ID of the validation error
+	ErrorId *uint64 `json:"error_id,omitempty"`
+
+	// operation on the validation errors
+	Op OperateTaskValidationErrorRequestOp `json:"op"`
+}
+
+// operation on the validation errors
+type OperateTaskValidationErrorRequestOp string
+
 // PrometheusTopology defines model for PrometheusTopology.
 type PrometheusTopology struct {
 	Host string `json:"host"`
@@ -355,6 +478,18 @@ type RelayStatus struct {
 	Stage string `json:"stage"`
 }
 
+// ResyncTaskTablesRequest defines model for ResyncTaskTablesRequest.
+type ResyncTaskTablesRequest struct {
+	// source database of the tables
+	Database string `json:"database"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// source tables to resync
+	TableList []string `json:"table_list"`
+}
+
 // schema name list
 type SchemaNameList []string
 
@@ -373,6 +508,30 @@ type Security struct {
 	SslKeyContent string `json:"ssl_key_content"`
 }
 
+// ShardDDLLock defines model for ShardDDLLock.
+type ShardDDLLock struct {
+	// DDLs of the lock
+	DdlList []string `json:"ddl_list"`
+
+	// ID of the shard DDL lock
+	Id string `json:"id"`
+
+	// shard mode
+	Mode string `json:"mode"`
+
+	// owner of the lock
+	Owner string `json:"owner"`
+
+	// sources and tables which have received the DDLs
+	Synced []string `json:"synced"`
+
+	// task name
+	TaskName string `json:"task_name"`
+
+	// sources and tables which haven't received the DDLs
+	Unsynced []string `json:"unsynced"`
+}
+
 // ShardingGroup defines model for ShardingGroup.
 type ShardingGroup struct {
 	DdlList       []string `json:"ddl_list"`
@@ -422,6 +581,29 @@ type Source struct {
 	User string `json:"user"`
 }
 
+// SourceCutoverStatus defines model for SourceCutoverStatus.
+type SourceCutoverStatus struct {
+	CutoverBinlogGtid *string `json:"cutover_binlog_gtid,omitempty"`
+
+	// location of the source when it's fenced
+	CutoverBinlogPos *string `json:"cutover_binlog_pos,omitempty"`
+	Fenced           bool    `json:"fenced"`
+	FinalBinlogGtid  *string `json:"final_binlog_gtid,omitempty"`
+
+	// location of the syncer recorded at the end of the workflow
+	FinalBinlogPos *string `json:"final_binlog_pos,omitempty"`
+	Msg            *string `json:"msg,omitempty"`
+	SourceName     string  `json:"source_name"`
+
+	// whether the syncer has reached the cutover location
+	Synced           bool    `json:"synced"`
+	SyncerBinlog     *string `json:"syncer_binlog,omitempty"`
+	SyncerBinlogGtid *string `json:"syncer_binlog_gtid,omitempty"`
+
+	// whether the snapshot check of the validator has finished without error rows
+	Validated bool `json:"validated"`
+}
+
 // source name list
 type SourceNameList []string
 
@@ -440,6 +622,15 @@ type SourceStatus struct {
 	WorkerName string `json:"worker_name"`
 }
 
+// StartTaskCutoverRequest defines model for StartTaskCutoverRequest.
+type StartTaskCutoverRequest struct {
+	// set the upstream sources read-only before recording the cutover locations
+	FenceWrites *bool `json:"fence_writes,omitempty"`
+
+	// skip comparing the data by the validators
+	SkipValidation *bool `json:"skip_validation,omitempty"`
+}
+
 // StartTaskRequest defines model for StartTaskRequest.
 type StartTaskRequest struct {
 	// whether to remove meta database in downstream database
@@ -455,6 +646,21 @@ type StartTaskRequest struct {
 	StartTime *string `json:"start_time,omitempty"`
 }
 
+// mode and start_time enable the validation, they can't be set when the validation has been enabled
+type StartTaskValidationRequest struct {
+	// validation mode
+	Mode *StartTaskValidationRequestMode `json:"mode,omitempty"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// start validating the binlog written after the time
+	StartTime *string `json:"start_time,omitempty"`
+}
+
+// validation mode
+type StartTaskValidationRequestMode string
+
 // StopTaskRequest defines model for StopTaskRequest.
 type StopTaskRequest struct {
 	// source name list
@@ -464,6 +670,12 @@ type StopTaskRequest struct {
 	TimeoutDuration *string `json:"timeout_duration,omitempty"`
 }
 
+// StopTaskValidationRequest defines model for StopTaskValidationRequest.
+type StopTaskValidationRequest struct {
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+}
+
 // SubTaskStatus defines model for SubTaskStatus.
 type SubTaskStatus struct {
 	// status of dump unit
@@ -506,8 +718,11 @@ type SyncStatus struct {
 	Synced              bool     `json:"synced"`
 	SyncerBinlog        string   `json:"syncer_binlog"`
 	SyncerBinlogGtid    string   `json:"syncer_binlog_gtid"`
-	TotalEvents         int64    `json:"total_events"`
-	TotalTps            int64    `json:"total_tps"`
+
+	// replication status of each downstream table, only returned when `with_table_status` is true
+	Tables      *[]TableSyncStatus `json:"tables,omitempty"`
+	TotalEvents int64              `json:"total_events"`
+	TotalTps    int64              `json:"total_tps"`
 
 	// sharding groups which current are un-resolved
 	UnresolvedGroups []ShardingGroup `json:"unresolved_groups"`
@@ -516,6 +731,30 @@ type SyncStatus struct {
 // schema name list
 type TableNameList []string
 
+// replication status of a downstream table in sync unit
+type TableSyncStatus struct {
+	// average seconds to execute a batch of row changes in downstream
+	AvgLatency float64 `json:"avg_latency"`
+
+	// approximate size of the replicated row changes
+	Bytes      int64 `json:"bytes"`
+	DeleteRows int64 `json:"delete_rows"`
+	InsertRows int64 `json:"insert_rows"`
+
+	// binlog event timestamp of the last applied row change
+	LastAppliedTs int64 `json:"last_applied_ts"`
+
+	// max seconds to execute a batch of row changes in downstream
+	MaxLatency float64 `json:"max_latency"`
+
+	// replication lag of the last applied row change
+	SecondsBehindMaster int64 `json:"seconds_behind_master"`
+
+	// downstream table, in the format of `database`.`table`
+	Table      string `json:"table"`
+	UpdateRows int64  `json:"update_rows"`
+}
+
 // task
 type Task struct {
 	BinlogFilterRule *Task_BinlogFilterRule `json:"binlog_filter_rule,omitempty"`
@@ -578,6 +817,22 @@ type TaskBinLogFilterRule struct {
 	IgnoreSql *[]string `json:"ignore_sql,omitempty"`
 }
 
+// TaskCutoverStatus defines model for TaskCutoverStatus.
+type TaskCutoverStatus struct {
+	// whether the workflow is stopped at the step, it can be started again to retry
+	Failed           bool                  `json:"failed"`
+	FenceWrites      bool                  `json:"fence_writes"`
+	Msg              *string               `json:"msg,omitempty"`
+	SkipValidation   bool                  `json:"skip_validation"`
+	SourceStatusList []SourceCutoverStatus `json:"source_status_list"`
+	StartTime        string                `json:"start_time"`
+
+	// current step of the workflow, one of `CutoverFence`, `CutoverWaitSync`, `CutoverValidate`, `CutoverRecord` and `CutoverFinished`
+	Step       string `json:"step"`
+	TaskName   string `json:"task_name"`
+	UpdateTime string `json:"update_time"`
+}
+
 // configuration of full migrate tasks
 type TaskFullMigrateConf struct {
 	// to control checksum of physical import
@@ -658,6 +913,21 @@ type TaskMigrateTarget struct {
 // task name list
 type TaskNameList []string
 
+// the state of the schedule of the task, only returned when the task has a schedule
+type TaskScheduleStatus struct {
+	// the time when the phase may change next, in RFC3339 format
+	NextChange *string `json:"next_change,omitempty"`
+
+	// sources paused by the schedule
+	PausedSources *[]string `json:"paused_sources,omitempty"`
+
+	// phase of the schedule, can be waiting-start, maintenance, throttled or normal
+	Phase string `json:"phase"`
+
+	// the maintenance window in effect
+	Window *string `json:"window,omitempty"`
+}
+
 // TaskSourceConf defines model for TaskSourceConf.
 type TaskSourceConf struct {
 	BinlogGtid *string `json:"binlog_gtid,omitempty"`
@@ -743,6 +1013,33 @@ type TaskTemplateResponse struct {
 	SuccessTaskList []string `json:"success_task_list"`
 }
 
+// UnlockTaskShardDDLLockRequest defines model for UnlockTaskShardDDLLockRequest.
+type UnlockTaskShardDDLLockRequest struct {
+	// upstream database of the DDL to resolve an optimistic lock
+	Database *string `json:"database,omitempty"`
+
+	// whether to remove a pessimistic lock even if the owner fails to execute the DDL
+	ForceRemove *bool `json:"force_remove,omitempty"`
+
+	// ID of the shard DDL lock
+	LockId string `json:"lock_id"`
+
+	// how to resolve an optimistic lock, `exec` executes the DDL of the source and `skip` skips it
+	Op *UnlockTaskShardDDLLockRequestOp `json:"op,omitempty"`
+
+	// source to replace the original owner of a pessimistic lock
+	ReplaceOwner *string `json:"replace_owner,omitempty"`
+
+	// source of the DDL to resolve an optimistic lock
+	SourceName *string `json:"source_name,omitempty"`
+
+	// upstream table of the DDL to resolve an optimistic lock
+	Table *string `json:"table,omitempty"`
+}
+
+// how to resolve an optimistic lock, `exec` executes the DDL of the source and `skip` skips it
+type UnlockTaskShardDDLLockRequestOp string
+
 // UpdateSourceRequest defines model for UpdateSourceRequest.
 type UpdateSourceRequest struct {
 	// source
@@ -751,10 +1048,117 @@ type UpdateSourceRequest struct {
 
 // UpdateTaskRequest defines model for UpdateTaskRequest.
 type UpdateTaskRequest struct {
+	// whether to estimate the migration size, duration and binlog retention in precheck
+	Estimate *bool `json:"estimate,omitempty"`
+
 	// task
 	Task Task `json:"task"`
 }
 
+// the limits replace the current ones until the task is restarted, an omitted limit means no limit
+type UpdateTaskThrottleRequest struct {
+	// bytes written to the downstream per second
+	BytesPerSecond *string `json:"bytes_per_second,omitempty"`
+
+	// rows written to the downstream per second
+	RowsPerSecond *int `json:"rows_per_second,omitempty"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// lower the rows limit when the p99 latency of downstream writes exceeds it
+	TargetLatency *string `json:"target_latency,omitempty"`
+}
+
+// ValidationError defines model for ValidationError.
+type ValidationError struct {
+	// type of the error
+	ErrorType string `json:"error_type"`
+
+	// ID of the validation error
+	Id string `json:"id"`
+
+	// detail of the error
+	Message string `json:"message"`
+
+	// row in the upstream
+	SourceData string `json:"source_data"`
+
+	// source name
+	SourceName string `json:"source_name"`
+
+	// upstream table
+	SourceTable string `json:"source_table"`
+
+	// state of the error
+	Status ValidationErrorStatus `json:"status"`
+
+	// row in the downstream
+	TargetData string `json:"target_data"`
+
+	// downstream table
+	TargetTable string `json:"target_table"`
+
+	// when the error is found
+	Time string `json:"time"`
+}
+
+// state of the error
+type ValidationErrorStatus string
+
+// ValidationTableStatus defines model for ValidationTableStatus.
+type ValidationTableStatus struct {
+	// why the table stops validating
+	Message string `json:"message"`
+
+	// source name
+	SourceName string `json:"source_name"`
+
+	// upstream table
+	SourceTable string    `json:"source_table"`
+	Stage       TaskStage `json:"stage"`
+
+	// downstream table
+	TargetTable string `json:"target_table"`
+}
+
+// ValidatorStatus defines model for ValidatorStatus.
+type ValidatorStatus struct {
+	// binlog GTID to stop validating at
+	CutoverBinlogGtid string `json:"cutover_binlog_gtid"`
+
+	// binlog position to stop validating at
+	CutoverBinlogPos string `json:"cutover_binlog_pos"`
+
+	// error message when something wrong
+	ErrorMsg *string `json:"error_msg,omitempty"`
+
+	// statistics of error rows
+	ErrorRowsStatus string `json:"error_rows_status"`
+
+	// validation mode
+	Mode string `json:"mode"`
+
+	// statistics of pending rows
+	PendingRowsStatus string `json:"pending_rows_status"`
+
+	// statistics of processed rows
+	ProcessedRowsStatus string `json:"processed_rows_status"`
+
+	// source name
+	SourceName string    `json:"source_name"`
+	Stage      TaskStage `json:"stage"`
+
+	// task name
+	TaskName string `json:"task_name"`
+
+	// binlog position the validator has processed
+	ValidatorBinlog string `json:"validator_binlog"`
+
+	// binlog GTID the validator has processed
+	ValidatorBinlogGtid string `json:"validator_binlog_gtid"`
+}
+
 // worker name list
 type WorkerNameList []string
 
@@ -852,6 +1256,24 @@ type DMAPIGetTaskParams struct {
 // DMAPIUpdateTaskJSONBody defines parameters for DMAPIUpdateTask.
 type DMAPIUpdateTaskJSONBody UpdateTaskRequest
 
+// DMAPIOperateTaskBinlogJSONBody defines parameters for DMAPIOperateTaskBinlog.
+type DMAPIOperateTaskBinlogJSONBody OperateTaskBinlogRequest
+
+// DMAPIStartTaskCutoverJSONBody defines parameters for DMAPIStartTaskCutover.
+type DMAPIStartTaskCutoverJSONBody StartTaskCutoverRequest
+
+// DMAPIResyncTaskTablesJSONBody defines parameters for DMAPIResyncTaskTables.
+type DMAPIResyncTaskTablesJSONBody ResyncTaskTablesRequest
+
+// DMAPIGetTaskShardDDLLockListParams defines parameters for DMAPIGetTaskShardDDLLockList.
+type DMAPIGetTaskShardDDLLockListParams struct {
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+}
+
+// DMAPIUnlockTaskShardDDLLockJSONBody defines parameters for DMAPIUnlockTaskShardDDLLock.
+type DMAPIUnlockTaskShardDDLLockJSONBody UnlockTaskShardDDLLockRequest
+
 // DMAPIGetTaskMigrateTargetsParams defines parameters for DMAPIGetTaskMigrateTargets.
 type DMAPIGetTaskMigrateTargetsParams struct {
 	SchemaPattern *string `json:"schema_pattern,omitempty"`
@@ -868,11 +1290,44 @@ type DMAPIStartTaskJSONBody StartTaskRequest
 type DMAPIGetTaskStatusParams struct {
 	// source name list
 	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// return the replication status of each downstream table in sync unit
+	WithTableStatus *bool `json:"with_table_status,omitempty"`
 }
 
 // DMAPIStopTaskJSONBody defines parameters for DMAPIStopTask.
 type DMAPIStopTaskJSONBody StopTaskRequest
 
+// DMAPIOperateTaskSyncDelayJSONBody defines parameters for DMAPIOperateTaskSyncDelay.
+type DMAPIOperateTaskSyncDelayJSONBody OperateTaskSyncDelayRequest
+
+// DMAPIUpdateTaskThrottleJSONBody defines parameters for DMAPIUpdateTaskThrottle.
+type DMAPIUpdateTaskThrottleJSONBody UpdateTaskThrottleRequest
+
+// DMAPIGetTaskValidationErrorListParams defines parameters for DMAPIGetTaskValidationErrorList.
+type DMAPIGetTaskValidationErrorListParams struct {
+	// filter the errors by state, default is `unprocessed`
+	ErrorState *DMAPIGetTaskValidationErrorListParamsErrorState `json:"error_state,omitempty"`
+}
+
+// DMAPIGetTaskValidationErrorListParamsErrorState defines parameters for DMAPIGetTaskValidationErrorList.
+type DMAPIGetTaskValidationErrorListParamsErrorState string
+
+// DMAPIOperateTaskValidationErrorJSONBody defines parameters for DMAPIOperateTaskValidationError.
+type DMAPIOperateTaskValidationErrorJSONBody OperateTaskValidationErrorRequest
+
+// DMAPIStartTaskValidationJSONBody defines parameters for DMAPIStartTaskValidation.
+type DMAPIStartTaskValidationJSONBody StartTaskValidationRequest
+
+// DMAPIGetTaskValidationStatusParams defines parameters for DMAPIGetTaskValidationStatus.
+type DMAPIGetTaskValidationStatusParams struct {
+	// filter the validated tables by stage
+	TableStage *TaskStage `json:"table_stage,omitempty"`
+}
+
+// DMAPIStopTaskValidationJSONBody defines parameters for DMAPIStopTaskValidation.
+type DMAPIStopTaskValidationJSONBody StopTaskValidationRequest
+
 // DMAPIUpdateClusterInfoJSONRequestBody defines body for DMAPIUpdateClusterInfo for application/json ContentType.
 type DMAPIUpdateClusterInfoJSONRequestBody DMAPIUpdateClusterInfoJSONBody
 
@@ -909,6 +1364,18 @@ type DMAPIImportTaskTemplateJSONRequestBody DMAPIImportTaskTemplateJSONBody
 // DMAPIUpdateTaskJSONRequestBody defines body for DMAPIUpdateTask for application/json ContentType.
 type DMAPIUpdateTaskJSONRequestBody DMAPIUpdateTaskJSONBody
 
+// DMAPIOperateTaskBinlogJSONRequestBody defines body for DMAPIOperateTaskBinlog for application/json ContentType.
+type DMAPIOperateTaskBinlogJSONRequestBody DMAPIOperateTaskBinlogJSONBody
+
+// DMAPIStartTaskCutoverJSONRequestBody defines body for DMAPIStartTaskCutover for application/json ContentType.
+type DMAPIStartTaskCutoverJSONRequestBody DMAPIStartTaskCutoverJSONBody
+
+// DMAPIResyncTaskTablesJSONRequestBody defines body for DMAPIResyncTaskTables for application/json ContentType.
+type DMAPIResyncTaskTablesJSONRequestBody DMAPIResyncTaskTablesJSONBody
+
+// DMAPIUnlockTaskShardDDLLockJSONRequestBody defines body for DMAPIUnlockTaskShardDDLLock for application/json ContentType.
+type DMAPIUnlockTaskShardDDLLockJSONRequestBody DMAPIUnlockTaskShardDDLLockJSONBody
+
 // DMAPIOperateTableStructureJSONRequestBody defines body for DMAPIOperateTableStructure for application/json ContentType.
 type DMAPIOperateTableStructureJSONRequestBody DMAPIOperateTableStructureJSONBody
 
@@ -918,6 +1385,21 @@ type DMAPIStartTaskJSONRequestBody DMAPIStartTaskJSONBody
 // DMAPIStopTaskJSONRequestBody defines body for DMAPIStopTask for application/json ContentType.
 type DMAPIStopTaskJSONRequestBody DMAPIStopTaskJSONBody
 
+// DMAPIOperateTaskSyncDelayJSONRequestBody defines body for DMAPIOperateTaskSyncDelay for application/json ContentType.
+type DMAPIOperateTaskSyncDelayJSONRequestBody DMAPIOperateTaskSyncDelayJSONBody
+
+// DMAPIUpdateTaskThrottleJSONRequestBody defines body for DMAPIUpdateTaskThrottle for application/json ContentType.
+type DMAPIUpdateTaskThrottleJSONRequestBody DMAPIUpdateTaskThrottleJSONBody
+
+// DMAPIOperateTaskValidationErrorJSONRequestBody defines body for DMAPIOperateTaskValidationError for application/json ContentType.
+type DMAPIOperateTaskValidationErrorJSONRequestBody DMAPIOperateTaskValidationErrorJSONBody
+
+// DMAPIStartTaskValidationJSONRequestBody defines body for DMAPIStartTaskValidation for application/json ContentType.
+type DMAPIStartTaskValidationJSONRequestBody DMAPIStartTaskValidationJSONBody
+
+// DMAPIStopTaskValidationJSONRequestBody defines body for DMAPIStopTaskValidation for application/json ContentType.
+type DMAPIStopTaskValidationJSONRequestBody DMAPIStopTaskValidationJSONBody
+
 // Getter for additional properties for Task_BinlogFilterRule. Returns the specified
 // element and whether it was found
 func (a Task_BinlogFilterRule) Get(fieldName string) (value TaskBinLogFilterRule, found bool) {
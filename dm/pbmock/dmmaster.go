@@ -277,6 +277,26 @@ func (mr *MockMasterClientMockRecorder) OfflineMember(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfflineMember", reflect.TypeOf((*MockMasterClient)(nil).OfflineMember), varargs...)
 }
 
+// OperateCutover mocks base method.
+func (m *MockMasterClient) OperateCutover(arg0 context.Context, arg1 *pb.OperateCutoverRequest, arg2 ...grpc.CallOption) (*pb.OperateCutoverResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperateCutover", varargs...)
+	ret0, _ := ret[0].(*pb.OperateCutoverResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateCutover indicates an expected call of OperateCutover.
+func (mr *MockMasterClientMockRecorder) OperateCutover(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateCutover", reflect.TypeOf((*MockMasterClient)(nil).OperateCutover), varargs...)
+}
+
 // OperateLeader mocks base method.
 func (m *MockMasterClient) OperateLeader(arg0 context.Context, arg1 *pb.OperateLeaderRequest, arg2 ...grpc.CallOption) (*pb.OperateLeaderResponse, error) {
 	m.ctrl.T.Helper()
@@ -357,6 +377,26 @@ func (mr *MockMasterClientMockRecorder) OperateSource(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSource", reflect.TypeOf((*MockMasterClient)(nil).OperateSource), varargs...)
 }
 
+// OperateSyncDelay mocks base method.
+func (m *MockMasterClient) OperateSyncDelay(arg0 context.Context, arg1 *pb.OperateSyncDelayRequest, arg2 ...grpc.CallOption) (*pb.OperateSyncDelayResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperateSyncDelay", varargs...)
+	ret0, _ := ret[0].(*pb.OperateSyncDelayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateSyncDelay indicates an expected call of OperateSyncDelay.
+func (mr *MockMasterClientMockRecorder) OperateSyncDelay(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSyncDelay", reflect.TypeOf((*MockMasterClient)(nil).OperateSyncDelay), varargs...)
+}
+
 // OperateTask mocks base method.
 func (m *MockMasterClient) OperateTask(arg0 context.Context, arg1 *pb.OperateTaskRequest, arg2 ...grpc.CallOption) (*pb.OperateTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -457,6 +497,26 @@ func (mr *MockMasterClientMockRecorder) QueryStatus(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockMasterClient)(nil).QueryStatus), varargs...)
 }
 
+// RebalanceSources mocks base method.
+func (m *MockMasterClient) RebalanceSources(arg0 context.Context, arg1 *pb.RebalanceSourcesRequest, arg2 ...grpc.CallOption) (*pb.RebalanceSourcesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebalanceSources", varargs...)
+	ret0, _ := ret[0].(*pb.RebalanceSourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceSources indicates an expected call of RebalanceSources.
+func (mr *MockMasterClientMockRecorder) RebalanceSources(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceSources", reflect.TypeOf((*MockMasterClient)(nil).RebalanceSources), varargs...)
+}
+
 // RegisterWorker mocks base method.
 func (m *MockMasterClient) RegisterWorker(arg0 context.Context, arg1 *pb.RegisterWorkerRequest, arg2 ...grpc.CallOption) (*pb.RegisterWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -477,6 +537,26 @@ func (mr *MockMasterClientMockRecorder) RegisterWorker(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWorker", reflect.TypeOf((*MockMasterClient)(nil).RegisterWorker), varargs...)
 }
 
+// ResyncTables mocks base method.
+func (m *MockMasterClient) ResyncTables(arg0 context.Context, arg1 *pb.ResyncTablesRequest, arg2 ...grpc.CallOption) (*pb.ResyncTablesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResyncTables", varargs...)
+	ret0, _ := ret[0].(*pb.ResyncTablesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResyncTables indicates an expected call of ResyncTables.
+func (mr *MockMasterClientMockRecorder) ResyncTables(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncTables", reflect.TypeOf((*MockMasterClient)(nil).ResyncTables), varargs...)
+}
+
 // ShowDDLLocks mocks base method.
 func (m *MockMasterClient) ShowDDLLocks(arg0 context.Context, arg1 *pb.ShowDDLLocksRequest, arg2 ...grpc.CallOption) (*pb.ShowDDLLocksResponse, error) {
 	m.ctrl.T.Helper()
@@ -617,6 +697,26 @@ func (mr *MockMasterClientMockRecorder) UpdateTask(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockMasterClient)(nil).UpdateTask), varargs...)
 }
 
+// UpdateTaskRules mocks base method.
+func (m *MockMasterClient) UpdateTaskRules(arg0 context.Context, arg1 *pb.UpdateTaskRulesRequest, arg2 ...grpc.CallOption) (*pb.UpdateTaskRulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskRules", varargs...)
+	ret0, _ := ret[0].(*pb.UpdateTaskRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskRules indicates an expected call of UpdateTaskRules.
+func (mr *MockMasterClientMockRecorder) UpdateTaskRules(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskRules", reflect.TypeOf((*MockMasterClient)(nil).UpdateTaskRules), varargs...)
+}
+
 // UpdateValidation mocks base method.
 func (m *MockMasterClient) UpdateValidation(arg0 context.Context, arg1 *pb.UpdateValidationRequest, arg2 ...grpc.CallOption) (*pb.UpdateValidationResponse, error) {
 	m.ctrl.T.Helper()
@@ -840,6 +940,21 @@ func (mr *MockMasterServerMockRecorder) OfflineMember(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfflineMember", reflect.TypeOf((*MockMasterServer)(nil).OfflineMember), arg0, arg1)
 }
 
+// OperateCutover mocks base method.
+func (m *MockMasterServer) OperateCutover(arg0 context.Context, arg1 *pb.OperateCutoverRequest) (*pb.OperateCutoverResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperateCutover", arg0, arg1)
+	ret0, _ := ret[0].(*pb.OperateCutoverResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateCutover indicates an expected call of OperateCutover.
+func (mr *MockMasterServerMockRecorder) OperateCutover(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateCutover", reflect.TypeOf((*MockMasterServer)(nil).OperateCutover), arg0, arg1)
+}
+
 // OperateLeader mocks base method.
 func (m *MockMasterServer) OperateLeader(arg0 context.Context, arg1 *pb.OperateLeaderRequest) (*pb.OperateLeaderResponse, error) {
 	m.ctrl.T.Helper()
@@ -900,6 +1015,21 @@ func (mr *MockMasterServerMockRecorder) OperateSource(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSource", reflect.TypeOf((*MockMasterServer)(nil).OperateSource), arg0, arg1)
 }
 
+// OperateSyncDelay mocks base method.
+func (m *MockMasterServer) OperateSyncDelay(arg0 context.Context, arg1 *pb.OperateSyncDelayRequest) (*pb.OperateSyncDelayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperateSyncDelay", arg0, arg1)
+	ret0, _ := ret[0].(*pb.OperateSyncDelayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateSyncDelay indicates an expected call of OperateSyncDelay.
+func (mr *MockMasterServerMockRecorder) OperateSyncDelay(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSyncDelay", reflect.TypeOf((*MockMasterServer)(nil).OperateSyncDelay), arg0, arg1)
+}
+
 // OperateTask mocks base method.
 func (m *MockMasterServer) OperateTask(arg0 context.Context, arg1 *pb.OperateTaskRequest) (*pb.OperateTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -975,6 +1105,21 @@ func (mr *MockMasterServerMockRecorder) QueryStatus(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockMasterServer)(nil).QueryStatus), arg0, arg1)
 }
 
+// RebalanceSources mocks base method.
+func (m *MockMasterServer) RebalanceSources(arg0 context.Context, arg1 *pb.RebalanceSourcesRequest) (*pb.RebalanceSourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceSources", arg0, arg1)
+	ret0, _ := ret[0].(*pb.RebalanceSourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceSources indicates an expected call of RebalanceSources.
+func (mr *MockMasterServerMockRecorder) RebalanceSources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceSources", reflect.TypeOf((*MockMasterServer)(nil).RebalanceSources), arg0, arg1)
+}
+
 // RegisterWorker mocks base method.
 func (m *MockMasterServer) RegisterWorker(arg0 context.Context, arg1 *pb.RegisterWorkerRequest) (*pb.RegisterWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -990,6 +1135,21 @@ func (mr *MockMasterServerMockRecorder) RegisterWorker(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWorker", reflect.TypeOf((*MockMasterServer)(nil).RegisterWorker), arg0, arg1)
 }
 
+// ResyncTables mocks base method.
+func (m *MockMasterServer) ResyncTables(arg0 context.Context, arg1 *pb.ResyncTablesRequest) (*pb.ResyncTablesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResyncTables", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ResyncTablesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResyncTables indicates an expected call of ResyncTables.
+func (mr *MockMasterServerMockRecorder) ResyncTables(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncTables", reflect.TypeOf((*MockMasterServer)(nil).ResyncTables), arg0, arg1)
+}
+
 // ShowDDLLocks mocks base method.
 func (m *MockMasterServer) ShowDDLLocks(arg0 context.Context, arg1 *pb.ShowDDLLocksRequest) (*pb.ShowDDLLocksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1095,6 +1255,21 @@ func (mr *MockMasterServerMockRecorder) UpdateTask(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockMasterServer)(nil).UpdateTask), arg0, arg1)
 }
 
+// UpdateTaskRules mocks base method.
+func (m *MockMasterServer) UpdateTaskRules(arg0 context.Context, arg1 *pb.UpdateTaskRulesRequest) (*pb.UpdateTaskRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskRules", arg0, arg1)
+	ret0, _ := ret[0].(*pb.UpdateTaskRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskRules indicates an expected call of UpdateTaskRules.
+func (mr *MockMasterServerMockRecorder) UpdateTaskRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskRules", reflect.TypeOf((*MockMasterServer)(nil).UpdateTaskRules), arg0, arg1)
+}
+
 // UpdateValidation mocks base method.
 func (m *MockMasterServer) UpdateValidation(arg0 context.Context, arg1 *pb.UpdateValidationRequest) (*pb.UpdateValidationResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +156,26 @@ func (mr *MockWorkerClientMockRecorder) OperateSchema(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSchema", reflect.TypeOf((*MockWorkerClient)(nil).OperateSchema), varargs...)
 }
 
+// OperateSyncDelay mocks base method.
+func (m *MockWorkerClient) OperateSyncDelay(arg0 context.Context, arg1 *pb.OperateSyncDelayWorkerRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperateSyncDelay", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateSyncDelay indicates an expected call of OperateSyncDelay.
+func (mr *MockWorkerClientMockRecorder) OperateSyncDelay(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSyncDelay", reflect.TypeOf((*MockWorkerClient)(nil).OperateSyncDelay), varargs...)
+}
+
 // OperateV1Meta mocks base method.
 func (m *MockWorkerClient) OperateV1Meta(arg0 context.Context, arg1 *pb.OperateV1MetaRequest, arg2 ...grpc.CallOption) (*pb.OperateV1MetaResponse, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +256,66 @@ func (mr *MockWorkerClientMockRecorder) QueryStatus(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockWorkerClient)(nil).QueryStatus), varargs...)
 }
 
+// ResyncTables mocks base method.
+func (m *MockWorkerClient) ResyncTables(arg0 context.Context, arg1 *pb.ResyncTablesWorkerRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResyncTables", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResyncTables indicates an expected call of ResyncTables.
+func (mr *MockWorkerClientMockRecorder) ResyncTables(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncTables", reflect.TypeOf((*MockWorkerClient)(nil).ResyncTables), varargs...)
+}
+
+// UpdateRules mocks base method.
+func (m *MockWorkerClient) UpdateRules(arg0 context.Context, arg1 *pb.UpdateRulesWorkerRequest, arg2 ...grpc.CallOption) (*pb.UpdateRulesWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRules", varargs...)
+	ret0, _ := ret[0].(*pb.UpdateRulesWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRules indicates an expected call of UpdateRules.
+func (mr *MockWorkerClientMockRecorder) UpdateRules(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRules", reflect.TypeOf((*MockWorkerClient)(nil).UpdateRules), varargs...)
+}
+
+// UpdateThrottle mocks base method.
+func (m *MockWorkerClient) UpdateThrottle(arg0 context.Context, arg1 *pb.UpdateThrottleWorkerRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateThrottle", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateThrottle indicates an expected call of UpdateThrottle.
+func (mr *MockWorkerClientMockRecorder) UpdateThrottle(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateThrottle", reflect.TypeOf((*MockWorkerClient)(nil).UpdateThrottle), varargs...)
+}
+
 // UpdateValidator mocks base method.
 func (m *MockWorkerClient) UpdateValidator(arg0 context.Context, arg1 *pb.UpdateValidationWorkerRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -369,6 +449,21 @@ func (mr *MockWorkerServerMockRecorder) OperateSchema(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSchema", reflect.TypeOf((*MockWorkerServer)(nil).OperateSchema), arg0, arg1)
 }
 
+// OperateSyncDelay mocks base method.
+func (m *MockWorkerServer) OperateSyncDelay(arg0 context.Context, arg1 *pb.OperateSyncDelayWorkerRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperateSyncDelay", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateSyncDelay indicates an expected call of OperateSyncDelay.
+func (mr *MockWorkerServerMockRecorder) OperateSyncDelay(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSyncDelay", reflect.TypeOf((*MockWorkerServer)(nil).OperateSyncDelay), arg0, arg1)
+}
+
 // OperateV1Meta mocks base method.
 func (m *MockWorkerServer) OperateV1Meta(arg0 context.Context, arg1 *pb.OperateV1MetaRequest) (*pb.OperateV1MetaResponse, error) {
 	m.ctrl.T.Helper()
@@ -429,6 +524,51 @@ func (mr *MockWorkerServerMockRecorder) QueryStatus(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockWorkerServer)(nil).QueryStatus), arg0, arg1)
 }
 
+// ResyncTables mocks base method.
+func (m *MockWorkerServer) ResyncTables(arg0 context.Context, arg1 *pb.ResyncTablesWorkerRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResyncTables", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResyncTables indicates an expected call of ResyncTables.
+func (mr *MockWorkerServerMockRecorder) ResyncTables(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncTables", reflect.TypeOf((*MockWorkerServer)(nil).ResyncTables), arg0, arg1)
+}
+
+// UpdateRules mocks base method.
+func (m *MockWorkerServer) UpdateRules(arg0 context.Context, arg1 *pb.UpdateRulesWorkerRequest) (*pb.UpdateRulesWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRules", arg0, arg1)
+	ret0, _ := ret[0].(*pb.UpdateRulesWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRules indicates an expected call of UpdateRules.
+func (mr *MockWorkerServerMockRecorder) UpdateRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRules", reflect.TypeOf((*MockWorkerServer)(nil).UpdateRules), arg0, arg1)
+}
+
+// UpdateThrottle mocks base method.
+func (m *MockWorkerServer) UpdateThrottle(arg0 context.Context, arg1 *pb.UpdateThrottleWorkerRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateThrottle", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateThrottle indicates an expected call of UpdateThrottle.
+func (mr *MockWorkerServerMockRecorder) UpdateThrottle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateThrottle", reflect.TypeOf((*MockWorkerServer)(nil).UpdateThrottle), arg0, arg1)
+}
+
 // UpdateValidator mocks base method.
 func (m *MockWorkerServer) UpdateValidator(arg0 context.Context, arg1 *pb.UpdateValidationWorkerRequest) (*pb.CommonWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +213,47 @@ func (r *binlogPosFinder) initTargetBinlogFile(ts int64) error {
 	return nil
 }
 
+// initTargetBinlogFileByIndex finds the target binlog file and the position to start searching by the relay index,
+// it returns nil if the relay index can't be used.
+func (r *binlogPosFinder) initTargetBinlogFileByIndex(targetTS uint32) (*RelayIndexEntry, error) {
+	if r.remote {
+		return nil, nil
+	}
+	entry, err := FindRelayIndexByTimestamp(r.relayDir, targetTS)
+	if err != nil {
+		r.tctx.L().Warn("fail to find binlog position by relay index, will search the binlog files", zap.Error(err))
+		return nil, nil
+	}
+	// the relay log is written without GTID.
+	if entry == nil || (r.enableGTID && entry.GTIDSet == "") {
+		return nil, nil
+	}
+	binaryLogs, err := r.getBinlogFiles()
+	if err != nil {
+		return nil, err
+	}
+	for i, binaryLog := range binaryLogs {
+		if binaryLog.name == entry.Name {
+			// the next binlog file may begin before targetTS without any transaction boundary in the index.
+			if i < len(binaryLogs)-1 {
+				minTS, err2 := r.findMinTimestampOfBinlog(binaryLogs[i+1])
+				if err2 != nil {
+					return nil, err2
+				}
+				if minTS < targetTS {
+					return nil, nil
+				}
+			}
+			r.targetBinlog = binaryLog
+			r.lastBinlogFile = i == len(binaryLogs)-1
+			r.tctx.L().Info("target binlog file found by relay index", zap.Reflect("file", r.targetBinlog),
+				zap.Uint32("position", entry.Pos), zap.Bool("last binlog", r.lastBinlogFile))
+			return entry, nil
+		}
+	}
+	return nil, nil
+}
+
 func (r *binlogPosFinder) processGTIDRelatedEvent(ev *replication.BinlogEvent, prevSet mysql.GTIDSet) (mysql.GTIDSet, error) {
 	ev, err := r.parser.Parse(ev.RawData)
 	if err != nil {
@@ -298,16 +339,29 @@ func (r *binlogPosFinder) checkTransactionBeginEvent(ev *replication.BinlogEvent
 func (r *binlogPosFinder) FindByTimestamp(ts int64) (*Location, PosType, error) {
 	r.tctx.L().Info("target timestamp", zap.Int64("ts", ts))
 
-	if err := r.initTargetBinlogFile(ts); err != nil {
-		return nil, InvalidBinlogPos, err
-	}
-
 	targetTS := uint32(ts)
-	position := mysql.Position{Name: r.targetBinlog.name, Pos: FileHeaderLen}
 	gtidSet, err := gtid.ZeroGTIDSet(r.flavor)
 	if err != nil {
 		return nil, InvalidBinlogPos, err
 	}
+	var position mysql.Position
+	entry, err := r.initTargetBinlogFileByIndex(targetTS)
+	if err != nil {
+		return nil, InvalidBinlogPos, err
+	}
+	if entry != nil {
+		position = entry.Location()
+		if r.enableGTID {
+			if gtidSet, err = gtid.ParserGTID(r.flavor, entry.GTIDSet); err != nil {
+				return nil, InvalidBinlogPos, err
+			}
+		}
+	} else {
+		if err = r.initTargetBinlogFile(ts); err != nil {
+			return nil, InvalidBinlogPos, err
+		}
+		position = mysql.Position{Name: r.targetBinlog.name, Pos: FileHeaderLen}
+	}
 
 	binlogReader, err := r.startSync(position)
 	if err != nil {
@@ -332,7 +386,10 @@ func (r *binlogPosFinder) FindByTimestamp(ts int64) (*Location, PosType, error)
 		if transactionBeginEvent && ev.Header.Timestamp >= targetTS {
 			break
 		}
-		position.Pos = ev.Header.LogPos
+		// the FORMAT_DESCRIPTION event is always read before seeking to the position found by the relay index.
+		if ev.Header.EventType != replication.FORMAT_DESCRIPTION_EVENT || ev.Header.LogPos > position.Pos {
+			position.Pos = ev.Header.LogPos
+		}
 
 		if r.enableGTID {
 			eventType := ev.Header.EventType
@@ -27,6 +27,7 @@ import (
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/stretchr/testify/require"
 )
@@ -448,3 +449,80 @@ func TestMariadbGTID(t *testing.T) {
 		require.Equal(t, AboveUpperBoundBinlogPos, posType)
 	}
 }
+
+func TestMySQL57GTIDWithRelayIndex(t *testing.T) {
+	t.Parallel()
+	flavor := "mysql"
+	relayDir := t.TempDir()
+	beforeTime := time.Now()
+	latestGTIDStr := "ffffffff-ffff-ffff-ffff-ffffffffffff:1"
+
+	generator, _ := event.NewGeneratorV2(flavor, "5.7.0", latestGTIDStr, true)
+	gset, err := gtid.ParserGTID(flavor, latestGTIDStr)
+	require.Nil(t, err)
+
+	var (
+		allEvents []*replication.BinlogEvent
+		entries   []RelayIndexEntry
+		maxTS     uint32
+	)
+	for i := 1; i <= 3; i++ {
+		name := fmt.Sprintf("mysql-bin.%06d", i)
+		events, data := genBinlogFile(generator, beforeTime.Add(time.Duration(i-1)*5*time.Second), fmt.Sprintf("mysql-bin.%06d", i+1))
+		_ = os.WriteFile(path.Join(relayDir, name), data, 0o644)
+		allEvents = append(allEvents, events...)
+
+		// the relay index records the transaction boundaries like the relay unit.
+		for _, ev := range events {
+			if ev.Header.Timestamp > maxTS {
+				maxTS = ev.Header.Timestamp
+			}
+			boundary := false
+			switch e := ev.Event.(type) {
+			case *replication.GTIDEvent:
+				gtidStr, err2 := event.GetGTIDStr(ev)
+				require.Nil(t, err2)
+				require.Nil(t, gset.Update(gtidStr))
+			case *replication.XIDEvent:
+				boundary = true
+			case *replication.QueryEvent:
+				boundary = string(e.Query) != "BEGIN"
+			}
+			if boundary {
+				entries = append(entries, RelayIndexEntry{Name: name, Pos: ev.Header.LogPos, GTIDSet: gset.String(), Timestamp: maxTS})
+			}
+		}
+	}
+
+	tcctx := tcontext.NewContext(context.Background(), log.L())
+	findAll := func() ([]*Location, []PosType) {
+		var (
+			locations []*Location
+			posTypes  []PosType
+		)
+		for _, ev := range allEvents {
+			finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+			location, posType, err2 := finder.FindByTimestamp(int64(ev.Header.Timestamp))
+			require.Nil(t, err2)
+			locations = append(locations, location)
+			posTypes = append(posTypes, posType)
+		}
+		return locations, posTypes
+	}
+
+	// the results with the relay index are the same as searching the binlog files.
+	expectedLocations, expectedPosTypes := findAll()
+	require.Nil(t, WriteRelayIndex(relayDir, entries))
+	// the last transaction of the last file is found by the relay index.
+	finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+	entry, err := finder.initTargetBinlogFileByIndex(allEvents[len(allEvents)-1].Header.Timestamp)
+	require.Nil(t, err)
+	require.Equal(t, &entries[len(entries)-2], entry)
+	locations, posTypes := findAll()
+	require.Equal(t, expectedPosTypes, posTypes)
+	require.Equal(t, len(expectedLocations), len(locations))
+	for i := range locations {
+		require.Equal(t, expectedLocations[i].Position, locations[i].Position)
+		require.Equal(t, expectedLocations[i].GTIDSetStr(), locations[i].GTIDSetStr())
+	}
+}
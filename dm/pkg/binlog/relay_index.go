@@ -0,0 +1,151 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// RelayIndexFilename is the name of the sidecar index file in a relay log sub directory.
+// The index is a list of transaction boundaries written by the relay unit, one JSON entry per line,
+// which is used to seek the relay log files directly instead of scanning them from the beginning.
+const RelayIndexFilename = "relay.index"
+
+// RelayIndexEntry is a transaction boundary in the relay log files of a sub directory.
+type RelayIndexEntry struct {
+	// Name is the relay log filename, without the UUID suffix.
+	Name string `json:"name"`
+	// Pos is the position after the transaction.
+	Pos uint32 `json:"pos"`
+	// GTIDSet is the GTID set of all transactions before Pos, empty if GTID is not enabled.
+	GTIDSet string `json:"gtid-set,omitempty"`
+	// Timestamp is the max timestamp of all events before Pos, so it's not decreasing between entries.
+	Timestamp uint32 `json:"timestamp"`
+}
+
+// Location returns the position of the entry.
+func (e *RelayIndexEntry) Location() mysql.Position {
+	return mysql.Position{Name: e.Name, Pos: e.Pos}
+}
+
+// ReadRelayIndex reads the entries of the relay index in the directory, returns nil if the index not exists.
+// The incomplete entry at the end of the index is ignored, it's written when the relay unit exits abnormally.
+func ReadRelayIndex(dir string) ([]RelayIndexEntry, error) {
+	data, err := os.ReadFile(path.Join(dir, RelayIndexFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, terror.ErrRelayIndexRead.Delegate(err, dir)
+	}
+	var entries []RelayIndexEntry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var entry RelayIndexEntry
+		if err = json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			log.L().Warn("ignore broken relay index entry", zap.String("directory", dir), zap.ByteString("entry", scanner.Bytes()))
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, terror.ErrRelayIndexRead.Delegate(err, dir)
+	}
+	return entries, nil
+}
+
+// WriteRelayIndex writes the entries as the relay index in the directory atomically.
+func WriteRelayIndex(dir string, entries []RelayIndexEntry) error {
+	var buf bytes.Buffer
+	for i := range entries {
+		data, err := json.Marshal(&entries[i])
+		if err != nil {
+			return terror.ErrRelayIndexWrite.Delegate(err, dir)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	if err := utils.WriteFileAtomic(path.Join(dir, RelayIndexFilename), buf.Bytes(), 0o644); err != nil {
+		return terror.ErrRelayIndexWrite.Delegate(err, dir)
+	}
+	return nil
+}
+
+// validRelayIndexEntry checks whether the relay log file of the entry still exists and is not truncated,
+// the file may be purged or be truncated when recovering the relay log after the entry is written.
+func validRelayIndexEntry(dir string, entry *RelayIndexEntry) bool {
+	size, err := utils.GetFileSize(path.Join(dir, entry.Name))
+	return err == nil && size >= int64(entry.Pos)
+}
+
+// FindRelayIndexByGTID finds the last entry in the relay index whose GTID set is contained by gset,
+// all transactions before the position of the entry are contained by gset.
+// It returns nil if no entry is found.
+func FindRelayIndexByGTID(dir, flavor string, gset mysql.GTIDSet) (*RelayIndexEntry, error) {
+	entries, err := ReadRelayIndex(dir)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := &entries[i]
+		if entry.GTIDSet == "" {
+			continue
+		}
+		entryGSet, err2 := gtid.ParserGTID(flavor, entry.GTIDSet)
+		if err2 != nil {
+			return nil, err2
+		}
+		if !gset.Contain(entryGSet) {
+			continue
+		}
+		if !validRelayIndexEntry(dir, entry) {
+			return nil, nil
+		}
+		return entry, nil
+	}
+	return nil, nil
+}
+
+// FindRelayIndexByTimestamp finds the last entry in the relay index whose timestamp is less than ts,
+// the timestamps of all events before the position of the entry are less than ts.
+// It returns nil if no entry is found.
+func FindRelayIndexByTimestamp(dir string, ts uint32) (*RelayIndexEntry, error) {
+	entries, err := ReadRelayIndex(dir)
+	if err != nil {
+		return nil, err
+	}
+	i := sort.Search(len(entries), func(i int) bool {
+		return entries[i].Timestamp >= ts
+	})
+	if i == 0 {
+		return nil, nil
+	}
+	entry := &entries[i-1]
+	if !validRelayIndexEntry(dir, entry) {
+		return nil, nil
+	}
+	return entry, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayIndex(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	gs := func(s string) mysql.GTIDSet {
+		gset, err := gtid.ParserGTID(mysql.MySQLFlavor, s)
+		require.NoError(t, err)
+		return gset
+	}
+
+	// no index.
+	entries, err := ReadRelayIndex(dir)
+	require.NoError(t, err)
+	require.Nil(t, entries)
+	entry, err := FindRelayIndexByGTID(dir, mysql.MySQLFlavor, gs("3ccc475b-2343-11e7-be21-6c0b84d59f30:1-100"))
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	expected := []RelayIndexEntry{
+		{Name: "mysql-bin.000001", Pos: 100, GTIDSet: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-10", Timestamp: 10},
+		{Name: "mysql-bin.000001", Pos: 500, GTIDSet: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-20", Timestamp: 20},
+		{Name: "mysql-bin.000002", Pos: 200, GTIDSet: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-30", Timestamp: 20},
+		{Name: "mysql-bin.000002", Pos: 800, GTIDSet: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-40", Timestamp: 30},
+	}
+	require.NoError(t, WriteRelayIndex(dir, expected))
+	// the broken entry at the end is ignored.
+	f, err := os.OpenFile(path.Join(dir, RelayIndexFilename), os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"name":"mysql-bin.000002","po`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	entries, err = ReadRelayIndex(dir)
+	require.NoError(t, err)
+	require.Equal(t, expected, entries)
+	require.Equal(t, mysql.Position{Name: "mysql-bin.000002", Pos: 200}, entries[2].Location())
+
+	require.NoError(t, os.WriteFile(path.Join(dir, "mysql-bin.000001"), make([]byte, 600), 0o644))
+	require.NoError(t, os.WriteFile(path.Join(dir, "mysql-bin.000002"), make([]byte, 900), 0o644))
+
+	gtidCases := []struct {
+		gset     string
+		expected *RelayIndexEntry
+	}{
+		{"3ccc475b-2343-11e7-be21-6c0b84d59f30:1-5", nil},
+		{"3ccc475b-2343-11e7-be21-6c0b84d59f30:1-10", &expected[0]},
+		{"3ccc475b-2343-11e7-be21-6c0b84d59f30:1-35", &expected[2]},
+		{"3ccc475b-2343-11e7-be21-6c0b84d59f30:1-50,53ea0ed1-9bf8-11e6-8bea-64006a897c73:1-10", &expected[3]},
+		{"53ea0ed1-9bf8-11e6-8bea-64006a897c73:1-10", nil},
+	}
+	for _, cs := range gtidCases {
+		entry, err = FindRelayIndexByGTID(dir, mysql.MySQLFlavor, gs(cs.gset))
+		require.NoError(t, err)
+		require.Equal(t, cs.expected, entry, cs.gset)
+	}
+
+	tsCases := []struct {
+		ts       uint32
+		expected *RelayIndexEntry
+	}{
+		{5, nil},
+		{10, nil},
+		{15, &expected[0]},
+		{20, &expected[0]},
+		{25, &expected[2]},
+		{100, &expected[3]},
+	}
+	for _, cs := range tsCases {
+		entry, err = FindRelayIndexByTimestamp(dir, cs.ts)
+		require.NoError(t, err)
+		require.Equal(t, cs.expected, entry, cs.ts)
+	}
+
+	// the relay log file is truncated or purged.
+	require.NoError(t, os.Truncate(path.Join(dir, "mysql-bin.000002"), 500))
+	entry, err = FindRelayIndexByGTID(dir, mysql.MySQLFlavor, gs("3ccc475b-2343-11e7-be21-6c0b84d59f30:1-50"))
+	require.NoError(t, err)
+	require.Nil(t, entry)
+	entry, err = FindRelayIndexByTimestamp(dir, 25)
+	require.NoError(t, err)
+	require.Equal(t, &expected[2], entry)
+	require.NoError(t, os.Remove(path.Join(dir, "mysql-bin.000001")))
+	entry, err = FindRelayIndexByTimestamp(dir, 15)
+	require.NoError(t, err)
+	require.Nil(t, entry)
+}
@@ -181,7 +181,15 @@ func (pc *MySQLBinlogRowImageChecker) Check(ctx context.Context) *Result {
 		markCheckError(result, err)
 		return result
 	}
-	if strings.ToUpper(value) != "FULL" {
+	switch strings.ToUpper(value) {
+	case "FULL":
+	case "MINIMAL", "NOBLOB":
+		// the syncer identifies the rows by the logged PK/UK columns and only updates the logged columns.
+		result.State = StateWarning
+		result.Errors = append(result.Errors, NewWarn("binlog_row_image is %s, some features are not supported", value))
+		result.Instruction = "DM can replicate the binlog which is not FULL, with these limits: the columns not logged in INSERT take the default values of downstream, which may differ from upstream for non-deterministic defaults such as CURRENT_TIMESTAMP; the compaction (`syncer.compact`) and multiple-rows statements (`syncer.multiple-rows`) are not applied to the rows not fully logged; safe mode uses UPDATE rather than REPLACE for them, which can't recreate the rows missing in downstream; the partial JSON updates inserting into or removing from arrays report errors in safe mode when the values before update are not logged; the continuous validator only compares the logged columns; and binlog value expression filters report errors on the rows not fully logged. Please execute 'set global binlog_row_image = FULL;' if these features are needed."
+		return result
+	default:
 		result.Errors = append(result.Errors, NewError("binlog_row_image is %s, and should be FULL", value))
 		result.Instruction = "MySQL as source: please execute 'set global binlog_row_image = FULL;'; AWS Aurora (MySQL)/RDS MySQL as source: please refer to the document to create a new DB parameter group and set the binlog_row_image = FULL: https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/USER_WorkingWithDBInstanceParamGroups.html Then modify the instance to use the new DB parameter group and restart the instance to take effect."
 		return result
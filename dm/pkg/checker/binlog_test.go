@@ -158,13 +158,19 @@ func TestMySQLBinlogRowImageChecker(t *testing.T) {
 			needCheck: true,
 			rowImage:  "full",
 		},
-		// mysql >= 5.6.2  need check - failed
+		// mysql >= 5.6.2  need check - warning
 		{
 			version:   "5.6.2-log",
-			state:     StateFailure,
+			state:     StateWarning,
 			needCheck: true,
 			rowImage:  "NOBLOB",
 		},
+		{
+			version:   "8.0.30",
+			state:     StateWarning,
+			needCheck: true,
+			rowImage:  "minimal",
+		},
 
 		// mariadb < 10.1.6 don't need check
 		{
@@ -181,10 +187,10 @@ func TestMySQLBinlogRowImageChecker(t *testing.T) {
 			needCheck: true,
 			rowImage:  "full",
 		},
-		// mariadb >= 10.1.6  need check - failed
+		// mariadb >= 10.1.6  need check - warning
 		{
 			version:   "10.1.6-MariaDB-1~wheezy",
-			state:     StateFailure,
+			state:     StateWarning,
 			needCheck: true,
 			rowImage:  "NOBLOB",
 		},
@@ -0,0 +1,538 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// MigrationEstimateCheckerName is the name of MigrationEstimateChecker, its result is always displayed.
+	MigrationEstimateCheckerName = "migration_estimate"
+
+	// the dump speed is measured by reading the largest table for at most sampleDuration.
+	sampleRows = 100000
+	// the rows written to measure the write speed of the downstream are no larger than maxSampleRowSize.
+	maxSampleRowSize = units.MiB
+	// the size of rows written by an INSERT statement, it's the same as the chunk size of logical import.
+	writeBatchBytes = units.MiB
+	// compaction can't catch up with the writes of logical import, so more space is used temporarily.
+	logicalImportSpaceRatio = 1.5
+
+	// number of the largest tables displayed for each source.
+	displayTableCount = 5
+)
+
+var (
+	sampleDuration = 3 * time.Second
+	// the write speed is measured by writing at most writeSampleBytes for at most sampleDuration.
+	writeSampleBytes int64 = 256 * units.MiB
+)
+
+type tableEstimate struct {
+	table      filter.Table
+	rows       int64
+	dataBytes  int64
+	indexBytes int64
+}
+
+type sourceEstimate struct {
+	sourceID   string
+	tables     []tableEstimate
+	rows       int64
+	dataBytes  int64
+	indexBytes int64
+
+	// bytes per second of one dump thread, 0 means the tables are empty.
+	dumpSpeed    float64
+	dumpDuration time.Duration
+	loadDuration time.Duration
+	// 0 means the binlog is never purged automatically, -1 means it's not fetched.
+	binlogRetention time.Duration
+}
+
+// MigrationEstimateChecker estimates the size and duration of the full data migration, and checks
+// whether the binlog of sources still exist when the load unit finishes. It never fails the check.
+// the dump speed is measured by reading the largest table of each source, and the write speed is
+// measured by writing a table in the meta schema of the downstream, which is dropped at last.
+type MigrationEstimateChecker struct {
+	sourceDBs map[string]*conn.BaseDB
+	tables    map[string][]filter.Table // sourceID -> source tables
+	targetDB  *conn.BaseDB
+	stCfgs    []*config.SubTaskConfig
+}
+
+// NewMigrationEstimateChecker returns a RealChecker.
+func NewMigrationEstimateChecker(
+	sourceDBs map[string]*conn.BaseDB,
+	tables map[string][]filter.Table,
+	targetDB *conn.BaseDB,
+	stCfgs []*config.SubTaskConfig,
+) RealChecker {
+	return &MigrationEstimateChecker{
+		sourceDBs: sourceDBs,
+		tables:    tables,
+		targetDB:  targetDB,
+		stCfgs:    stCfgs,
+	}
+}
+
+// Name implements the RealChecker interface.
+func (c *MigrationEstimateChecker) Name() string {
+	return MigrationEstimateCheckerName
+}
+
+// Check implements the RealChecker interface.
+func (c *MigrationEstimateChecker) Check(ctx context.Context) *Result {
+	result := &Result{
+		Name:  c.Name(),
+		Desc:  "estimate the size and duration of the full data migration and check the binlog retention of sources",
+		State: StateSuccess,
+	}
+	if len(c.stCfgs) == 0 {
+		return result
+	}
+	// Mode and LoaderConfig are the same across all the subtasks
+	cfg := c.stCfgs[0]
+	hasLoad := config.HasLoad(cfg.Mode)
+
+	// a source which fails to be estimated doesn't affect the others.
+	estimates := make([]*sourceEstimate, 0, len(c.stCfgs))
+	for _, stCfg := range c.stCfgs {
+		db, ok := c.sourceDBs[stCfg.SourceID]
+		if !ok {
+			continue
+		}
+		est, err := c.estimateSource(ctx, db, stCfg)
+		if err != nil {
+			markEstimateError(result, fmt.Sprintf("fail to estimate source %s", stCfg.SourceID), err)
+			continue
+		}
+		estimates = append(estimates, est)
+	}
+	if len(estimates) == 0 {
+		return result
+	}
+
+	var buf bytes.Buffer
+	var totalBytes, totalDataBytes, totalRows int64
+	for _, est := range estimates {
+		totalBytes += est.dataBytes + est.indexBytes
+		totalDataBytes += est.dataBytes
+		totalRows += est.rows
+	}
+	var importSpeed string
+	if hasLoad {
+		importSpeed = c.estimateLoad(ctx, result, estimates, totalDataBytes, totalRows)
+	}
+	for _, est := range estimates {
+		writeSourceEstimate(&buf, est, cfg.MydumperConfig.Threads)
+	}
+
+	fmt.Fprintf(&buf, "dump files: about %s on DM-worker\n", units.BytesSize(float64(totalDataBytes)))
+	if hasLoad {
+		replicas, err := fetchMaxReplicas(ctx, c.targetDB)
+		if err != nil {
+			log.L().Info("fail to fetch max replicas of downstream, assume it has only one replica", zap.Error(err))
+			replicas = 1
+		}
+		logical := float64(totalBytes) * float64(replicas) * logicalImportSpaceRatio
+		physical := float64(totalBytes) * float64(replicas)
+		fmt.Fprintf(&buf, "downstream disk usage (%d replicas): about %s for logical import, about %s for physical import", replicas,
+			units.BytesSize(logical), units.BytesSize(physical))
+		fmt.Fprintf(&buf, ", which also needs %s of local sorting space on DM-worker\n", units.BytesSize(float64(totalBytes)))
+		buf.WriteString(importSpeed)
+	}
+
+	var maxDuration time.Duration
+	for _, est := range estimates {
+		duration := est.dumpDuration + est.loadDuration
+		if duration > maxDuration {
+			maxDuration = duration
+		}
+		if est.binlogRetention > 0 && est.binlogRetention < duration {
+			result.State = StateWarning
+			result.Errors = append(result.Errors, NewWarn(
+				"binlog of source %s may be purged before the full data migration finishes, binlog retention is %s but the migration is estimated to take %s",
+				est.sourceID, est.binlogRetention, formatDuration(duration)))
+			result.Instruction = "please increase `binlog_expire_logs_seconds` or `expire_logs_days` of the source, or enable relay log for the source"
+		}
+	}
+	fmt.Fprintf(&buf, "estimated duration of full data migration: %s", formatDuration(maxDuration))
+	result.Extra = buf.String()
+	return result
+}
+
+// estimateSource estimates the tables of a source.
+func (c *MigrationEstimateChecker) estimateSource(ctx context.Context, db *conn.BaseDB, cfg *config.SubTaskConfig) (*sourceEstimate, error) {
+	est := &sourceEstimate{sourceID: cfg.SourceID, binlogRetention: -1}
+	tables, err := fetchTableEstimates(ctx, db, c.tables[cfg.SourceID])
+	if err != nil {
+		return nil, err
+	}
+	est.tables = tables
+	for _, t := range tables {
+		est.rows += t.rows
+		est.dataBytes += t.dataBytes
+		est.indexBytes += t.indexBytes
+	}
+
+	if len(tables) > 0 {
+		// tables are sorted by size, sample the largest one
+		est.dumpSpeed, err = measureDumpSpeed(ctx, db, tables[0].table)
+		if err != nil {
+			return nil, err
+		}
+	}
+	threads := cfg.MydumperConfig.Threads
+	if threads <= 0 {
+		threads = 1
+	}
+	if est.dumpSpeed > 0 {
+		est.dumpDuration = time.Duration(float64(est.dataBytes) / (est.dumpSpeed * float64(threads)) * float64(time.Second))
+	}
+
+	// the incremental replication starts from the position of dumping, relay log pulls binlog
+	// since the task starts so it's not affected by purging
+	if config.HasSync(cfg.Mode) && !cfg.UseRelay {
+		est.binlogRetention, err = fetchBinlogRetention(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return est, nil
+}
+
+// estimateLoad measures the write speed of the downstream with rows of the average size, estimates
+// the load duration of each source by it, and returns the description of the import speed.
+func (c *MigrationEstimateChecker) estimateLoad(ctx context.Context, result *Result, estimates []*sourceEstimate, dataBytes, rows int64) string {
+	if dataBytes == 0 {
+		return ""
+	}
+	// Mode and LoaderConfig are the same across all the subtasks
+	cfg := c.stCfgs[0]
+	rowSize := int64(1)
+	if rows > 0 {
+		rowSize = dataBytes / rows
+	}
+	if rowSize > maxSampleRowSize {
+		rowSize = maxSampleRowSize
+	}
+	if rowSize < 1 {
+		rowSize = 1
+	}
+	speed, err := measureWriteSpeed(ctx, c.targetDB, cfg.MetaSchema, cfg.Name+"_estimate_write", rowSize, dataBytes)
+	if err != nil {
+		markEstimateError(result, "fail to measure the write speed of downstream", err)
+		return ""
+	}
+	if speed == 0 {
+		return ""
+	}
+	poolSize := cfg.LoaderConfig.PoolSize
+	if poolSize <= 0 {
+		poolSize = 1
+	}
+	loadSpeed := speed * float64(poolSize)
+	if limit := cfg.LoaderConfig.Throttle.BytesLimit(); limit > 0 && float64(limit) < loadSpeed {
+		loadSpeed = float64(limit)
+	}
+	for _, est := range estimates {
+		est.loadDuration = time.Duration(float64(est.dataBytes) / loadSpeed * float64(time.Second))
+	}
+	if cfg.LoaderConfig.ImportMode == config.LoadModePhysical {
+		// physical import doesn't write by SQL, the measured speed is only a lower bound.
+		return fmt.Sprintf("import speed: at least %s/s per source (measured %s/s per SQL session, %d threads, physical import is usually faster)\n",
+			units.BytesSize(loadSpeed), units.BytesSize(speed), poolSize)
+	}
+	return fmt.Sprintf("import speed: %s/s per source (measured %s/s per SQL session, %d threads)\n",
+		units.BytesSize(loadSpeed), units.BytesSize(speed), poolSize)
+}
+
+// fetchTableEstimates returns the estimated rows and bytes of tables from information_schema,
+// the result is sorted by size in descending order.
+func fetchTableEstimates(ctx context.Context, db *conn.BaseDB, tables []filter.Table) ([]tableEstimate, error) {
+	if len(tables) == 0 {
+		return nil, nil
+	}
+	wanted := make(map[filter.Table]struct{}, len(tables))
+	schemas := make([]string, 0)
+	for _, t := range tables {
+		if !containsSchema(schemas, t.Schema) {
+			schemas = append(schemas, t.Schema)
+		}
+		wanted[t] = struct{}{}
+	}
+	args := make([]interface{}, 0, len(schemas))
+	for _, s := range schemas {
+		args = append(args, s)
+	}
+	query := "SELECT TABLE_SCHEMA, TABLE_NAME, TABLE_ROWS, DATA_LENGTH, INDEX_LENGTH FROM information_schema.TABLES WHERE TABLE_SCHEMA IN (" +
+		strings.TrimSuffix(strings.Repeat("?,", len(schemas)), ",") + ")"
+	rows, err := db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := make([]tableEstimate, 0, len(tables))
+	for rows.Next() {
+		var (
+			t                         filter.Table
+			rowCnt, dataLen, indexLen sql.NullInt64
+		)
+		if err = rows.Scan(&t.Schema, &t.Name, &rowCnt, &dataLen, &indexLen); err != nil {
+			return nil, err
+		}
+		if _, ok := wanted[t]; !ok {
+			continue
+		}
+		ret = append(ret, tableEstimate{
+			table:      t,
+			rows:       rowCnt.Int64,
+			dataBytes:  dataLen.Int64,
+			indexBytes: indexLen.Int64,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].dataBytes+ret[i].indexBytes > ret[j].dataBytes+ret[j].indexBytes
+	})
+	return ret, nil
+}
+
+func containsSchema(schemas []string, schema string) bool {
+	for _, s := range schemas {
+		if s == schema {
+			return true
+		}
+	}
+	return false
+}
+
+// measureDumpSpeed reads some rows of the table like a dump thread and returns the bytes read per second.
+// 0 is returned when the table is empty.
+func measureDumpSpeed(ctx context.Context, db *conn.BaseDB, table filter.Table) (float64, error) {
+	ctx2, cancel := context.WithTimeout(ctx, sampleDuration)
+	defer cancel()
+
+	start := time.Now()
+	rows, err := db.DB.QueryContext(ctx2, fmt.Sprintf("SELECT * FROM %s LIMIT %d", dbutil.TableName(table.Schema, table.Name), sampleRows))
+	if err != nil {
+		if ctx.Err() == nil && errors.Cause(err) == context.DeadlineExceeded {
+			return 0, errors.Errorf("no rows of %s are read in %s", table.String(), sampleDuration)
+		}
+		return 0, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	var read int64
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return 0, err
+		}
+		for _, v := range values {
+			read += int64(len(v))
+		}
+	}
+	// reaching sampleDuration is expected for large tables
+	if err = rows.Err(); err != nil && (ctx.Err() != nil || errors.Cause(err) != context.DeadlineExceeded) {
+		return 0, err
+	}
+	elapsed := time.Since(start)
+	if read == 0 || elapsed <= 0 {
+		return 0, nil
+	}
+	return float64(read) / elapsed.Seconds(), nil
+}
+
+// measureWriteSpeed writes rows of rowSize bytes into a new table of the downstream in one session like
+// a thread of logical import, and returns the bytes written per second. at most maxBytes are written,
+// and the table is dropped at last.
+func measureWriteSpeed(ctx context.Context, db *conn.BaseDB, schema, table string, rowSize, maxBytes int64) (float64, error) {
+	c, err := db.DB.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tableName := dbutil.TableName(schema, table)
+	for _, stmt := range []string{
+		"CREATE DATABASE IF NOT EXISTS " + dbutil.ColumnName(schema),
+		"CREATE TABLE IF NOT EXISTS " + tableName + " (id BIGINT PRIMARY KEY, v LONGBLOB)",
+	} {
+		if _, err = c.ExecContext(ctx, stmt); err != nil {
+			return 0, err
+		}
+	}
+	defer func() {
+		if _, err2 := c.ExecContext(ctx, "DROP TABLE IF EXISTS "+tableName); err2 != nil {
+			log.L().Warn("fail to drop the table for estimating", zap.String("table", tableName), zap.Error(err2))
+		}
+	}()
+
+	if maxBytes > writeSampleBytes {
+		maxBytes = writeSampleBytes
+	}
+	batch := writeBatchBytes / rowSize
+	if rows := (maxBytes + rowSize - 1) / rowSize; rows < batch {
+		batch = rows
+	}
+	if batch < 1 {
+		batch = 1
+	}
+	value := strings.Repeat("x", int(rowSize))
+
+	ctx2, cancel := context.WithTimeout(ctx, sampleDuration)
+	defer cancel()
+	start := time.Now()
+	var (
+		id, written int64
+		query       strings.Builder
+	)
+	for written < maxBytes {
+		query.Reset()
+		query.WriteString("INSERT INTO " + tableName + " VALUES ")
+		for i := int64(0); i < batch; i++ {
+			if i > 0 {
+				query.WriteByte(',')
+			}
+			fmt.Fprintf(&query, "(%d,'%s')", id, value)
+			id++
+		}
+		if _, err = c.ExecContext(ctx2, query.String()); err != nil {
+			// reaching sampleDuration is expected for slow downstream
+			if ctx.Err() == nil && ctx2.Err() != nil {
+				break
+			}
+			return 0, err
+		}
+		written += batch * rowSize
+	}
+	elapsed := time.Since(start)
+	if written == 0 {
+		return 0, errors.Errorf("no rows are written in %s", sampleDuration)
+	}
+	return float64(written) / elapsed.Seconds(), nil
+}
+
+// fetchBinlogRetention returns how long the binlog is kept by the source, 0 means it's never purged automatically.
+func fetchBinlogRetention(ctx context.Context, db *conn.BaseDB) (time.Duration, error) {
+	rows, err := db.DB.QueryContext(ctx, "SHOW GLOBAL VARIABLES WHERE Variable_name IN ('binlog_expire_logs_seconds', 'expire_logs_days')")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	var seconds, days float64
+	for rows.Next() {
+		var name, value string
+		if err = rows.Scan(&name, &value); err != nil {
+			return 0, err
+		}
+		// expire_logs_days is a decimal in MariaDB
+		v, err2 := strconv.ParseFloat(value, 64)
+		if err2 != nil {
+			return 0, errors.Annotatef(err2, "invalid value of %s", name)
+		}
+		switch strings.ToLower(name) {
+		case "binlog_expire_logs_seconds":
+			seconds = v
+		case "expire_logs_days":
+			days = v
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+	if seconds > 0 {
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+	return time.Duration(days * float64(24*time.Hour)), nil
+}
+
+// fetchMaxReplicas returns the replica number of the downstream TiDB cluster.
+func fetchMaxReplicas(ctx context.Context, db *conn.BaseDB) (int, error) {
+	var tp, instance, name, value string
+	err := db.DB.QueryRowContext(ctx, "SHOW CONFIG WHERE type = 'pd' AND name = 'replication.max-replicas'").
+		Scan(&tp, &instance, &name, &value)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(value)
+}
+
+func writeSourceEstimate(buf *bytes.Buffer, est *sourceEstimate, threads int) {
+	fmt.Fprintf(buf, "source %s: %d tables, about %d rows, data %s, index %s\n", est.sourceID, len(est.tables), est.rows,
+		units.BytesSize(float64(est.dataBytes)), units.BytesSize(float64(est.indexBytes)))
+	for i, t := range est.tables {
+		if i == displayTableCount {
+			fmt.Fprintf(buf, "  ... and %d more tables\n", len(est.tables)-displayTableCount)
+			break
+		}
+		fmt.Fprintf(buf, "  %s: about %d rows, data %s, index %s\n", t.table.String(), t.rows,
+			units.BytesSize(float64(t.dataBytes)), units.BytesSize(float64(t.indexBytes)))
+	}
+	if est.dumpSpeed > 0 {
+		fmt.Fprintf(buf, "  dump: %s (measured %s/s per thread, %d threads)\n", formatDuration(est.dumpDuration),
+			units.BytesSize(est.dumpSpeed), threads)
+	}
+	if est.loadDuration > 0 {
+		fmt.Fprintf(buf, "  import: %s\n", formatDuration(est.loadDuration))
+	}
+	switch {
+	case est.binlogRetention == 0:
+		fmt.Fprintf(buf, "  binlog retention: never purged automatically\n")
+	case est.binlogRetention > 0:
+		fmt.Fprintf(buf, "  binlog retention: %s\n", est.binlogRetention)
+	}
+}
+
+func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return "less than 1s"
+	}
+	return d.Round(time.Second).String()
+}
+
+// markEstimateError marks the result as warning, estimating should not block the task.
+func markEstimateError(result *Result, msg string, err error) {
+	result.State = StateWarning
+	result.Errors = append(result.Errors, NewWarn("%s: %s", msg, err.Error()))
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/docker/go-units"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/stretchr/testify/require"
+)
+
+func mockTableEstimates(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT TABLE_SCHEMA, TABLE_NAME, TABLE_ROWS, DATA_LENGTH, INDEX_LENGTH FROM information_schema.TABLES WHERE TABLE_SCHEMA IN \\(\\?\\)").
+		WithArgs("db").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "TABLE_ROWS", "DATA_LENGTH", "INDEX_LENGTH"}).
+			AddRow("db", "t1", 1000, units.MiB, 0).
+			AddRow("db", "t2", 10000000, 10*units.GiB, units.GiB).
+			AddRow("db", "t3", 1, 1, 1).
+			AddRow("db", "v1", nil, nil, nil))
+}
+
+func mockSampleRows(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{"id", "c"})
+	value := strings.Repeat("x", 1024)
+	for i := 0; i < 100; i++ {
+		rows.AddRow(i, value)
+	}
+	mock.ExpectQuery("SELECT \\* FROM `db`.`t2` LIMIT 100000").WillReturnRows(rows)
+}
+
+func mockWriteRows(mock sqlmock.Sqlmock, inserts int, delay time.Duration) {
+	mock.ExpectExec("CREATE DATABASE IF NOT EXISTS `dm_meta`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS `dm_meta`.`test_estimate_write`").WillReturnResult(sqlmock.NewResult(0, 0))
+	for i := 0; i < inserts; i++ {
+		mock.ExpectExec("INSERT INTO `dm_meta`.`test_estimate_write` VALUES").WillDelayFor(delay).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec("DROP TABLE IF EXISTS `dm_meta`.`test_estimate_write`").WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func TestMigrationEstimateChecker(t *testing.T) {
+	sourceDB, sourceMock, err := sqlmock.New()
+	require.NoError(t, err)
+	targetDB, targetMock, err := sqlmock.New()
+	require.NoError(t, err)
+	backup := writeSampleBytes
+	writeSampleBytes = 2 * units.MiB
+	defer func() {
+		writeSampleBytes = backup
+	}()
+
+	cfg := &config.SubTaskConfig{
+		Name:           "test",
+		MetaSchema:     "dm_meta",
+		SourceID:       "mysql-01",
+		Mode:           config.ModeAll,
+		MydumperConfig: config.MydumperConfig{Threads: 4},
+		LoaderConfig:   config.LoaderConfig{PoolSize: 16, ImportMode: config.LoadModeLogical},
+	}
+	tables := map[string][]filter.Table{
+		"mysql-01": {{Schema: "db", Name: "t1"}, {Schema: "db", Name: "t2"}, {Schema: "db", Name: "v1"}},
+	}
+	checker := NewMigrationEstimateChecker(
+		map[string]*conn.BaseDB{"mysql-01": conn.NewBaseDBForTest(sourceDB)},
+		tables,
+		conn.NewBaseDBForTest(targetDB),
+		[]*config.SubTaskConfig{cfg},
+	)
+
+	// binlog is purged in 86.4 seconds, but the migration takes longer
+	mockTableEstimates(sourceMock)
+	mockSampleRows(sourceMock)
+	sourceMock.ExpectQuery("SHOW GLOBAL VARIABLES WHERE Variable_name IN").
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+			AddRow("binlog_expire_logs_seconds", "0").
+			AddRow("expire_logs_days", "0.001"))
+	// the downstream writes about 3.3MiB/s per session
+	mockWriteRows(targetMock, 3, 200*time.Millisecond)
+	targetMock.ExpectQuery("SHOW CONFIG WHERE type = 'pd' AND name = 'replication.max-replicas'").
+		WillReturnRows(sqlmock.NewRows([]string{"Type", "Instance", "Name", "Value"}).
+			AddRow("pd", "127.0.0.1:2379", "replication.max-replicas", "3"))
+	result := checker.Check(context.Background())
+	require.NoError(t, sourceMock.ExpectationsWereMet())
+	require.NoError(t, targetMock.ExpectationsWereMet())
+	require.Equal(t, StateWarning, result.State)
+	require.Len(t, result.Errors, 1)
+	require.Contains(t, result.Errors[0].ShortErr, "binlog of source mysql-01 may be purged")
+	require.Contains(t, result.Errors[0].ShortErr, "binlog retention is 1m26.4s")
+	require.Contains(t, result.Extra, "source mysql-01: 3 tables, about 10001000 rows, data 10GiB, index 1GiB")
+	// the largest table is displayed first
+	require.Less(t, strings.Index(result.Extra, "`db`.`t2`"), strings.Index(result.Extra, "`db`.`t1`"))
+	require.NotContains(t, result.Extra, "`db`.`t3`")
+	require.Contains(t, result.Extra, "measured")
+	require.Contains(t, result.Extra, "binlog retention: 1m26.4s")
+	require.Contains(t, result.Extra, "downstream disk usage (3 replicas): about 49.5GiB for logical import, about 33GiB for physical import")
+	require.Contains(t, result.Extra, "import speed: ")
+	require.Contains(t, result.Extra, "per SQL session, 16 threads)")
+
+	// relay log is enabled, and downstream is not TiDB
+	cfg.UseRelay = true
+	cfg.LoaderConfig.Throttle.BytesPerSecond = "1MiB"
+	mockTableEstimates(sourceMock)
+	mockSampleRows(sourceMock)
+	mockWriteRows(targetMock, 3, 0)
+	targetMock.ExpectQuery("SHOW CONFIG").WillReturnError(errors.New("not TiDB"))
+	result = checker.Check(context.Background())
+	require.NoError(t, sourceMock.ExpectationsWereMet())
+	require.NoError(t, targetMock.ExpectationsWereMet())
+	require.Equal(t, StateSuccess, result.State)
+	require.Len(t, result.Errors, 0)
+	require.NotContains(t, result.Extra, "binlog retention")
+	require.Contains(t, result.Extra, "downstream disk usage (1 replicas)")
+	require.Contains(t, result.Extra, "import speed: 1MiB/s per source")
+
+	// fail to measure the write speed is a warning, the import duration is not estimated
+	mockTableEstimates(sourceMock)
+	mockSampleRows(sourceMock)
+	targetMock.ExpectExec("CREATE DATABASE").WillReturnError(errors.New("no privilege"))
+	targetMock.ExpectQuery("SHOW CONFIG").WillReturnError(errors.New("not TiDB"))
+	result = checker.Check(context.Background())
+	require.NoError(t, sourceMock.ExpectationsWereMet())
+	require.NoError(t, targetMock.ExpectationsWereMet())
+	require.Equal(t, StateWarning, result.State)
+	require.Len(t, result.Errors, 1)
+	require.Contains(t, result.Errors[0].ShortErr, "fail to measure the write speed of downstream: no privilege")
+	require.NotContains(t, result.Extra, "import speed")
+	require.Contains(t, result.Extra, "measured")
+
+	// fail to estimate a source is a warning
+	sourceMock.ExpectQuery("SELECT TABLE_SCHEMA").WillReturnError(errors.New("no privilege"))
+	result = checker.Check(context.Background())
+	require.NoError(t, sourceMock.ExpectationsWereMet())
+	require.Equal(t, StateWarning, result.State)
+	require.Contains(t, result.Errors[0].ShortErr, "fail to estimate source mysql-01: no privilege")
+	require.Empty(t, result.Extra)
+}
+
+func TestMigrationEstimateCheckerPartialFailure(t *testing.T) {
+	sourceDB1, sourceMock1, err := sqlmock.New()
+	require.NoError(t, err)
+	sourceDB2, sourceMock2, err := sqlmock.New()
+	require.NoError(t, err)
+	targetDB, targetMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	cfgs := []*config.SubTaskConfig{
+		{Name: "test", MetaSchema: "dm_meta", SourceID: "mysql-01", Mode: config.ModeFull},
+		{Name: "test", MetaSchema: "dm_meta", SourceID: "mysql-02", Mode: config.ModeFull},
+	}
+	tables := map[string][]filter.Table{
+		"mysql-01": {{Schema: "db", Name: "t1"}},
+		"mysql-02": {{Schema: "db", Name: "t1"}},
+	}
+	checker := NewMigrationEstimateChecker(
+		map[string]*conn.BaseDB{"mysql-01": conn.NewBaseDBForTest(sourceDB1), "mysql-02": conn.NewBaseDBForTest(sourceDB2)},
+		tables,
+		conn.NewBaseDBForTest(targetDB),
+		cfgs,
+	)
+
+	// the estimate of mysql-02 is kept when mysql-01 fails
+	sourceMock1.ExpectQuery("SELECT TABLE_SCHEMA").WillReturnError(errors.New("no privilege"))
+	sourceMock2.ExpectQuery("SELECT TABLE_SCHEMA").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "TABLE_ROWS", "DATA_LENGTH", "INDEX_LENGTH"}).
+			AddRow("db", "t1", 10, 1024, 0))
+	sourceMock2.ExpectQuery("SELECT \\* FROM `db`.`t1`").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mockWriteRows(targetMock, 1, 0)
+	targetMock.ExpectQuery("SHOW CONFIG").WillReturnError(errors.New("not TiDB"))
+	result := checker.Check(context.Background())
+	require.NoError(t, sourceMock1.ExpectationsWereMet())
+	require.NoError(t, sourceMock2.ExpectationsWereMet())
+	require.NoError(t, targetMock.ExpectationsWereMet())
+	require.Equal(t, StateWarning, result.State)
+	require.Len(t, result.Errors, 1)
+	require.Contains(t, result.Errors[0].ShortErr, "fail to estimate source mysql-01: no privilege")
+	require.NotContains(t, result.Extra, "source mysql-01")
+	require.Contains(t, result.Extra, "source mysql-02: 1 tables, about 10 rows")
+	require.Contains(t, result.Extra, "import speed: ")
+}
+
+func TestFetchBinlogRetention(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	baseDB := conn.NewBaseDBForTest(db)
+
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES WHERE Variable_name IN").
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+			AddRow("binlog_expire_logs_seconds", "2592000").
+			AddRow("expire_logs_days", "0"))
+	retention, err := fetchBinlogRetention(context.Background(), baseDB)
+	require.NoError(t, err)
+	require.Equal(t, "720h0m0s", retention.String())
+
+	// MySQL 5.7 without auto purging
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES WHERE Variable_name IN").
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+			AddRow("expire_logs_days", "0"))
+	retention, err = fetchBinlogRetention(context.Background(), baseDB)
+	require.NoError(t, err)
+	require.Zero(t, retention)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
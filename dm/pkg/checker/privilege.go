@@ -168,6 +168,57 @@ func (pc *SourceReplicatePrivilegeChecker) Name() string {
 	return "source db replication privilege checker"
 }
 
+// SourceSnapshotCheckPrivilegeChecker checks the privileges of source DB required by
+// the snapshot check of the validator.
+type SourceSnapshotCheckPrivilegeChecker struct {
+	db     *sql.DB
+	dbinfo *dbutil.DBConfig
+	// snapshotMode is true if the validator runs the snapshot check once it starts,
+	// otherwise the snapshot check only runs when it's requested, e.g. by cutover.
+	snapshotMode bool
+}
+
+// NewSourceSnapshotCheckPrivilegeChecker returns a RealChecker.
+func NewSourceSnapshotCheckPrivilegeChecker(db *sql.DB, dbinfo *dbutil.DBConfig, snapshotMode bool) RealChecker {
+	return &SourceSnapshotCheckPrivilegeChecker{db: db, dbinfo: dbinfo, snapshotMode: snapshotMode}
+}
+
+// Check implements the RealChecker interface.
+// We only check RELOAD privilege, which is required to take the global read lock
+// while starting the consistent snapshot of each table.
+func (pc *SourceSnapshotCheckPrivilegeChecker) Check(ctx context.Context) *Result {
+	result := &Result{
+		Name:  pc.Name(),
+		Desc:  "check snapshot check privileges of source DB",
+		State: StateSuccess,
+		Extra: fmt.Sprintf("address of db instance - %s:%d", pc.dbinfo.Host, pc.dbinfo.Port),
+	}
+
+	grants, err := dbutil.ShowGrants(ctx, pc.db, "", "")
+	if err != nil {
+		markCheckError(result, err)
+		return result
+	}
+	snapshotRequiredPrivs := map[mysql.PrivilegeType]priv{
+		mysql.ReloadPriv: {needGlobal: true},
+	}
+	err2 := verifyPrivilegesWithResult(result, grants, snapshotRequiredPrivs)
+	if err2 != nil {
+		result.Errors = append(result.Errors, err2)
+		result.Instruction = "Grant the required privileges to the account, otherwise the snapshot check of the validator fails."
+		result.State = StateWarning
+		if pc.snapshotMode {
+			result.State = StateFailure
+		}
+	}
+	return result
+}
+
+// Name implements the RealChecker interface.
+func (pc *SourceSnapshotCheckPrivilegeChecker) Name() string {
+	return "source db snapshot check privilege checker"
+}
+
 type TargetPrivilegeChecker struct {
 	db     *sql.DB
 	dbinfo *dbutil.DBConfig
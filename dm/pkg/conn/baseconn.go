@@ -135,6 +135,16 @@ func (conn *BaseConn) QuerySQL(tctx *tcontext.Context, query string, args ...int
 // 1. failed: (the index of sqls executed error, error)
 // 2. succeed: (rows affected, nil).
 func (conn *BaseConn) ExecuteSQLWithIgnoreError(tctx *tcontext.Context, hVec *prometheus.HistogramVec, task string, ignoreErr func(error) bool, queries []string, args ...[]interface{}) (int, error) {
+	return conn.executeSQLs(tctx, hVec, task, ignoreErr, nil, queries, args...)
+}
+
+// ExecuteSQLWithRowsAffected executes sql on real DB like ExecuteSQL, and also records the rows
+// affected by each query into rowsAffected, which should have the same length as queries.
+func (conn *BaseConn) ExecuteSQLWithRowsAffected(tctx *tcontext.Context, hVec *prometheus.HistogramVec, task string, rowsAffected []int64, queries []string, args ...[]interface{}) (int, error) {
+	return conn.executeSQLs(tctx, hVec, task, nil, rowsAffected, queries, args...)
+}
+
+func (conn *BaseConn) executeSQLs(tctx *tcontext.Context, hVec *prometheus.HistogramVec, task string, ignoreErr func(error) bool, rowsAffected []int64, queries []string, args ...[]interface{}) (int, error) {
 	var affect int64
 	// inject an error to trigger retry, this should be placed before the real execution of the SQL statement.
 	failpoint.Inject("retryableError", func(val failpoint.Value) {
@@ -191,6 +201,9 @@ func (conn *BaseConn) ExecuteSQLWithIgnoreError(tctx *tcontext.Context, hVec *pr
 		if err2 == nil {
 			rows, _ := result.RowsAffected()
 			affect += rows
+			if len(rowsAffected) > i {
+				rowsAffected[i] = rows
+			}
 			if hVec != nil {
 				hVec.WithLabelValues("stmt", task).Observe(time.Since(startTime).Seconds())
 			}
@@ -56,6 +56,11 @@ func SyncerOnlineDDL(task string) string {
 	return task + "_onlineddl"
 }
 
+// SyncerConflict returns syncer's conflict log table name for multi-source conflict resolution.
+func SyncerConflict(task string) string {
+	return task + "_syncer_conflict"
+}
+
 func ValidatorCheckpoint(task string) string {
 	return task + "_validator_checkpoint"
 }
@@ -0,0 +1,141 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Cutover represents the state of the cutover workflow of a task, it's driven by the leader of DM-master.
+type Cutover struct {
+	Task           string         `json:"task"`
+	FenceWrites    bool           `json:"fence-writes"`
+	SkipValidation bool           `json:"skip-validation"`
+	Step           pb.CutoverStep `json:"step"`
+	// Failed means the workflow is stopped at Step because of Msg.
+	Failed     bool      `json:"failed"`
+	Msg        string    `json:"msg,omitempty"`
+	StartTime  time.Time `json:"start-time"`
+	UpdateTime time.Time `json:"update-time"`
+
+	Sources map[string]*CutoverSource `json:"sources"`
+}
+
+// CutoverSource represents the cutover state of a source of the task.
+type CutoverSource struct {
+	// ReadOnlyBefore and SuperReadOnlyBefore are the values of `read_only` and `super_read_only` of the source
+	// before fencing, ReadOnlyBefore is nil if not fetched yet.
+	ReadOnlyBefore      *bool `json:"read-only-before,omitempty"`
+	SuperReadOnlyBefore bool  `json:"super-read-only-before,omitempty"`
+	Fenced              bool  `json:"fenced"`
+
+	CutoverBinlogPos  string `json:"cutover-binlog-pos,omitempty"`
+	CutoverBinlogGTID string `json:"cutover-binlog-gtid,omitempty"`
+	SyncerBinlog      string `json:"syncer-binlog,omitempty"`
+	SyncerBinlogGTID  string `json:"syncer-binlog-gtid,omitempty"`
+	Synced            bool   `json:"synced"`
+	// SnapshotCheckStarted means the snapshot check of the validate step is started on the validator.
+	SnapshotCheckStarted bool   `json:"snapshot-check-started,omitempty"`
+	Validated            bool   `json:"validated"`
+	FinalBinlogPos       string `json:"final-binlog-pos,omitempty"`
+	FinalBinlogGTID      string `json:"final-binlog-gtid,omitempty"`
+	Msg                  string `json:"msg,omitempty"`
+}
+
+// NewCutover creates a new Cutover instance at the first step.
+func NewCutover(task string, sources []string, fenceWrites, skipValidation bool, now time.Time) *Cutover {
+	c := &Cutover{
+		Task:           task,
+		FenceWrites:    fenceWrites,
+		SkipValidation: skipValidation,
+		Step:           pb.CutoverStep_CutoverFence,
+		StartTime:      now,
+		UpdateTime:     now,
+		Sources:        make(map[string]*CutoverSource, len(sources)),
+	}
+	for _, source := range sources {
+		c.Sources[source] = &CutoverSource{}
+	}
+	return c
+}
+
+// String implements Stringer interface.
+func (c *Cutover) String() string {
+	s, _ := c.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (c *Cutover) toJSON() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// cutoverFromJSON constructs Cutover from its JSON represent.
+func cutoverFromJSON(s string) (*Cutover, error) {
+	c := &Cutover{}
+	err := json.Unmarshal([]byte(s), c)
+	return c, err
+}
+
+// PutCutover puts the state of the cutover workflow into etcd.
+func PutCutover(cli *clientv3.Client, c *Cutover) error {
+	value, err := c.toJSON()
+	if err != nil {
+		return err
+	}
+	op := clientv3.OpPut(common.CutoverKeyAdapter.Encode(c.Task), value)
+	_, _, err = etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(op))
+	return err
+}
+
+// GetAllCutovers gets the states of all cutover workflows from etcd.
+// k/v: task-name -> the state of the cutover workflow.
+func GetAllCutovers(cli *clientv3.Client) (map[string]*Cutover, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.CutoverKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, terror.ErrHAFailTxnOperation.Delegate(err, "fail to get cutovers")
+	}
+
+	cutovers := make(map[string]*Cutover, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		c, err2 := cutoverFromJSON(string(kv.Value))
+		if err2 != nil {
+			return nil, terror.ErrHAFailTxnOperation.Delegate(err2, "fail to decode cutover")
+		}
+		cutovers[c.Task] = c
+	}
+	return cutovers, nil
+}
+
+// DeleteCutover deletes the state of the cutover workflow of the task.
+func DeleteCutover(cli *clientv3.Client, task string) error {
+	op := clientv3.OpDelete(common.CutoverKeyAdapter.Encode(task))
+	_, _, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(op))
+	return err
+}
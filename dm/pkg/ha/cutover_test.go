@@ -0,0 +1,62 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tiflow/dm/pb"
+)
+
+func (t *testForEtcd) TestCutoverEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	now := time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+	c1 := NewCutover("task1", []string{"source1", "source2"}, true, false, now)
+	c2 := NewCutover("task2", []string{"source1"}, false, true, now)
+	c.Assert(c1.Step, Equals, pb.CutoverStep_CutoverFence)
+	c.Assert(c1.Sources, HasLen, 2)
+
+	// no cutover.
+	cutovers, err := GetAllCutovers(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(cutovers, HasLen, 0)
+
+	// put and update.
+	c.Assert(PutCutover(etcdTestCli, c1), IsNil)
+	c.Assert(PutCutover(etcdTestCli, c2), IsNil)
+	readOnly := false
+	c1.Sources["source1"].ReadOnlyBefore = &readOnly
+	c1.Sources["source1"].Fenced = true
+	c1.Sources["source1"].CutoverBinlogPos = "(mysql-bin.000001, 1234)"
+	c1.Step = pb.CutoverStep_CutoverWaitSync
+	c.Assert(PutCutover(etcdTestCli, c1), IsNil)
+
+	cutovers, err = GetAllCutovers(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(cutovers, HasLen, 2)
+	c.Assert(cutovers["task1"].Step, Equals, pb.CutoverStep_CutoverWaitSync)
+	c.Assert(cutovers["task1"].StartTime.Equal(now), IsTrue)
+	c.Assert(*cutovers["task1"].Sources["source1"], DeepEquals, *c1.Sources["source1"])
+	c.Assert(cutovers["task1"].Sources["source2"].ReadOnlyBefore, IsNil)
+	c.Assert(cutovers["task2"].SkipValidation, IsTrue)
+
+	// delete.
+	c.Assert(DeleteCutover(etcdTestCli, "task1"), IsNil)
+	cutovers, err = GetAllCutovers(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(cutovers, HasLen, 1)
+	c.Assert(cutovers["task2"].Task, Equals, "task2")
+}
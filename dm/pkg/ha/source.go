@@ -136,8 +136,10 @@ func ClearTestInfoOperation(cli *clientv3.Client) error {
 	clearSubTaskStage := clientv3.OpDelete(common.StageSubTaskKeyAdapter.Path(), clientv3.WithPrefix())
 	clearValidatorStage := clientv3.OpDelete(common.StageValidatorKeyAdapter.Path(), clientv3.WithPrefix())
 	clearLoadTasks := clientv3.OpDelete(common.LoadTaskKeyAdapter.Path(), clientv3.WithPrefix())
+	clearCutovers := clientv3.OpDelete(common.CutoverKeyAdapter.Path(), clientv3.WithPrefix())
+	clearTaskSchedules := clientv3.OpDelete(common.TaskScheduleKeyAdapter.Path(), clientv3.WithPrefix())
 	_, _, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(clearSource, clearSubTask, clearWorkerInfo,
 		clearBound, clearLastBound, clearWorkerKeepAlive, clearRelayStage, clearRelayConfig, clearSubTaskStage,
-		clearValidatorStage, clearLoadTasks))
+		clearValidatorStage, clearLoadTasks, clearCutovers, clearTaskSchedules))
 	return err
 }
@@ -0,0 +1,89 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pingcap/tiflow/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// who paused the source recorded in TaskScheduleSources.
+const (
+	// PausedBySchedule means the source is paused by the schedule, it's resumed when the phase changes.
+	PausedBySchedule = "schedule"
+	// PausedByUser means the source paused by the schedule is also paused by the user, it's not resumed
+	// by the schedule.
+	PausedByUser = "user"
+)
+
+// TaskScheduleSources represents the sources of a task paused or throttled by the schedule of the task,
+// it's kept by the leader of DM-master.
+type TaskScheduleSources struct {
+	Task string `json:"task"`
+	// source -> who paused it, PausedBySchedule or PausedByUser.
+	PausedSources map[string]string `json:"paused-sources,omitempty"`
+	// source -> the name of the throttling window.
+	ThrottledSources map[string]string `json:"throttled-sources,omitempty"`
+}
+
+// String implements Stringer interface.
+func (s *TaskScheduleSources) String() string {
+	data, _ := json.Marshal(s)
+	return string(data)
+}
+
+// PutTaskScheduleSources puts the sources changed by the schedule of the task into etcd.
+func PutTaskScheduleSources(cli *clientv3.Client, s *TaskScheduleSources) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	op := clientv3.OpPut(common.TaskScheduleKeyAdapter.Encode(s.Task), string(data))
+	_, _, err = etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(op))
+	return err
+}
+
+// GetAllTaskScheduleSources gets the sources changed by the schedules of all tasks from etcd.
+// k/v: task-name -> the sources changed by the schedule.
+func GetAllTaskScheduleSources(cli *clientv3.Client) (map[string]*TaskScheduleSources, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.TaskScheduleKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, terror.ErrHAFailTxnOperation.Delegate(err, "fail to get task schedule sources")
+	}
+
+	ret := make(map[string]*TaskScheduleSources, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		s := &TaskScheduleSources{}
+		if err2 := json.Unmarshal(kv.Value, s); err2 != nil {
+			return nil, terror.ErrHAFailTxnOperation.Delegate(err2, "fail to decode task schedule sources")
+		}
+		ret[s.Task] = s
+	}
+	return ret, nil
+}
+
+// DeleteTaskScheduleSources deletes the sources changed by the schedule of the task.
+func DeleteTaskScheduleSources(cli *clientv3.Client, task string) error {
+	op := clientv3.OpDelete(common.TaskScheduleKeyAdapter.Encode(task))
+	_, _, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(op))
+	return err
+}
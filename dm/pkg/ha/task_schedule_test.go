@@ -0,0 +1,49 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestTaskScheduleSourcesEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	s1 := &TaskScheduleSources{Task: "task1", PausedSources: map[string]string{"source1": PausedBySchedule, "source2": PausedBySchedule}}
+	s2 := &TaskScheduleSources{Task: "task2", ThrottledSources: map[string]string{"source1": "report"}}
+
+	// no sources.
+	all, err := GetAllTaskScheduleSources(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(all, HasLen, 0)
+
+	// put and update.
+	c.Assert(PutTaskScheduleSources(etcdTestCli, s1), IsNil)
+	c.Assert(PutTaskScheduleSources(etcdTestCli, s2), IsNil)
+	s1.PausedSources = map[string]string{"source1": PausedByUser}
+	c.Assert(PutTaskScheduleSources(etcdTestCli, s1), IsNil)
+
+	all, err = GetAllTaskScheduleSources(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(all, HasLen, 2)
+	c.Assert(all["task1"], DeepEquals, s1)
+	c.Assert(all["task2"], DeepEquals, s2)
+
+	// delete.
+	c.Assert(DeleteTaskScheduleSources(etcdTestCli, "task1"), IsNil)
+	all, err = GetAllTaskScheduleSources(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(all, HasLen, 1)
+	c.Assert(all["task2"], DeepEquals, s2)
+}
@@ -28,6 +28,9 @@ import (
 type WorkerInfo struct {
 	Name string `json:"name"` // the name of the node.
 	Addr string `json:"addr"` // the client address of the node to advertise.
+
+	Labels   map[string]string `json:"labels,omitempty"`   // the labels like zone and host of the node.
+	Capacity int64             `json:"capacity,omitempty"` // the max load of the node, 0 means unlimited.
 }
 
 // NewWorkerInfo creates a new WorkerInfo instance.
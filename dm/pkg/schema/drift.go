@@ -0,0 +1,147 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/pkg/quotes"
+)
+
+// DriftType is the kind of difference between a tracked table and its downstream table.
+type DriftType string
+
+// DriftTypes.
+const (
+	// DriftExtraColumn means the column only exists in downstream.
+	DriftExtraColumn DriftType = "extra-column"
+	// DriftMissingColumn means the column only exists in schema tracker.
+	DriftMissingColumn DriftType = "missing-column"
+	// DriftTypeChange means the column exists in both sides with different types or nullability.
+	DriftTypeChange DriftType = "type-change"
+	// DriftIndexMismatch means a primary key or unique key only exists in one side.
+	DriftIndexMismatch DriftType = "index-mismatch"
+)
+
+// AllDriftTypes lists all DriftTypes.
+var AllDriftTypes = []DriftType{DriftExtraColumn, DriftMissingColumn, DriftTypeChange, DriftIndexMismatch}
+
+// Drift is a difference between a tracked table and its downstream table.
+type Drift struct {
+	Type DriftType
+	// Name is the name of the column or index.
+	Name string
+	// Tracked and Downstream are the definitions of the column or index, empty if not exists.
+	Tracked    string
+	Downstream string
+}
+
+// CompareTableInfo compares the tracked table info with the downstream table info. Columns are matched by
+// name, and only the primary key and unique keys are compared because they decide how DML is replicated,
+// other indexes added to downstream are allowed.
+func CompareTableInfo(tracked, downstream *model.TableInfo) []*Drift {
+	var drifts []*Drift
+
+	downstreamCols := make(map[string]*model.ColumnInfo, len(downstream.Columns))
+	for _, col := range downstream.Columns {
+		if !col.Hidden {
+			downstreamCols[col.Name.L] = col
+		}
+	}
+	trackedCols := make(map[string]struct{}, len(tracked.Columns))
+	for _, col := range tracked.Columns {
+		if col.Hidden {
+			continue
+		}
+		trackedCols[col.Name.L] = struct{}{}
+		downCol, ok := downstreamCols[col.Name.L]
+		switch {
+		case !ok:
+			drifts = append(drifts, &Drift{Type: DriftMissingColumn, Name: col.Name.O, Tracked: columnDefinition(col)})
+		case columnDefinition(col) != columnDefinition(downCol):
+			drifts = append(drifts, &Drift{
+				Type:       DriftTypeChange,
+				Name:       col.Name.O,
+				Tracked:    columnDefinition(col),
+				Downstream: columnDefinition(downCol),
+			})
+		}
+	}
+	for _, col := range downstream.Columns {
+		if _, ok := trackedCols[col.Name.L]; !ok && !col.Hidden {
+			drifts = append(drifts, &Drift{Type: DriftExtraColumn, Name: col.Name.O, Downstream: columnDefinition(col)})
+		}
+	}
+
+	downstreamKeys := uniqueKeys(downstream)
+	trackedKeys := uniqueKeys(tracked)
+	for _, def := range sortedKeys(trackedKeys) {
+		if _, ok := downstreamKeys[def]; !ok {
+			drifts = append(drifts, &Drift{Type: DriftIndexMismatch, Name: trackedKeys[def], Tracked: def})
+		}
+	}
+	for _, def := range sortedKeys(downstreamKeys) {
+		if _, ok := trackedKeys[def]; !ok {
+			drifts = append(drifts, &Drift{Type: DriftIndexMismatch, Name: downstreamKeys[def], Downstream: def})
+		}
+	}
+	return drifts
+}
+
+// columnDefinition returns the type and nullability of a column.
+func columnDefinition(col *model.ColumnInfo) string {
+	def := col.FieldType.InfoSchemaStr()
+	if mysql.HasNotNullFlag(col.GetFlag()) {
+		def += " NOT NULL"
+	}
+	return def
+}
+
+// uniqueKeys returns the definitions of the primary key and unique keys, mapped to the key names.
+// The definitions don't contain the key names so renamed keys are treated as the same.
+func uniqueKeys(ti *model.TableInfo) map[string]string {
+	keys := make(map[string]string)
+	if ti.PKIsHandle {
+		if pk := ti.GetPkColInfo(); pk != nil {
+			keys["PRIMARY KEY("+quotes.QuoteName(pk.Name.L)+")"] = mysql.PrimaryKeyName
+		}
+	}
+	for _, idx := range ti.Indices {
+		if !idx.Primary && !idx.Unique {
+			continue
+		}
+		cols := make([]string, 0, len(idx.Columns))
+		for _, col := range idx.Columns {
+			cols = append(cols, quotes.QuoteName(col.Name.L))
+		}
+		kind := "UNIQUE KEY"
+		if idx.Primary {
+			kind = "PRIMARY KEY"
+		}
+		keys[kind+"("+strings.Join(cols, ",")+")"] = idx.Name.O
+	}
+	return keys
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	dlog "github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareTableInfo(t *testing.T) {
+	ctx := context.Background()
+	p := parser.New()
+
+	dbConn, mock := mockBaseConn(t)
+	tracker, err := NewTestTracker(ctx, "test-tracker", dbConn, dlog.L())
+	require.NoError(t, err)
+	defer tracker.Close()
+
+	require.NoError(t, tracker.CreateSchemaIfNotExists("testdb"))
+	require.NoError(t, tracker.Exec(ctx, "testdb", parseSQL(t, p,
+		"create table testdb.t (id int(11) primary key, a varchar(20), b int not null, c int, d int, unique key uk_d(d))")))
+	tracked, err := tracker.GetTableInfo(&filter.Table{Schema: "testdb", Name: "t"})
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(fmt.Sprintf("SET SESSION SQL_MODE = '%s'", mysql.DefaultSQLMode)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	tableID := "`testdb`.`t`"
+	mock.ExpectQuery("SHOW CREATE TABLE " + tableID).WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).
+			AddRow("t", "CREATE TABLE `t` (`id` int NOT NULL, `A` varchar(20), `b` bigint NOT NULL, `d` int, `e` int, "+
+				"PRIMARY KEY (`id`), UNIQUE KEY `uk_de` (`d`,`e`), KEY `idx_a` (`a`))"))
+	downstream, err := tracker.FetchDownStreamTableInfo(tcontext.Background(), dbConn, tableID, tracked)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	drifts := CompareTableInfo(tracked, downstream)
+	require.Equal(t, []*Drift{
+		{Type: DriftTypeChange, Name: "b", Tracked: "int(11) NOT NULL", Downstream: "bigint(20) NOT NULL"},
+		{Type: DriftMissingColumn, Name: "c", Tracked: "int(11)"},
+		{Type: DriftExtraColumn, Name: "e", Downstream: "int(11)"},
+		{Type: DriftIndexMismatch, Name: "uk_d", Tracked: "UNIQUE KEY(`d`)"},
+		{Type: DriftIndexMismatch, Name: "uk_de", Downstream: "UNIQUE KEY(`d`,`e`)"},
+	}, drifts)
+
+	require.Empty(t, CompareTableInfo(tracked, tracked))
+
+	// the cached downstream table info is replaced when the downstream table changed
+	mock.ExpectQuery("SHOW CREATE TABLE " + tableID).WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).
+			AddRow("t", "CREATE TABLE `t` (`id` int NOT NULL, `a` varchar(20), PRIMARY KEY (`id`))"))
+	cached, err := tracker.GetDownStreamTableInfo(tcontext.Background(), tableID, tracked)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, cached.TableInfo.Columns, 2)
+
+	driftConn, driftMock := mockBaseConn(t)
+	driftMock.ExpectBegin()
+	driftMock.ExpectExec(fmt.Sprintf("SET SESSION SQL_MODE = '%s'", mysql.DefaultSQLMode)).WillReturnResult(sqlmock.NewResult(0, 0))
+	driftMock.ExpectCommit()
+	driftMock.ExpectQuery("SHOW CREATE TABLE " + tableID).WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).
+			AddRow("t", "CREATE TABLE `t` (`id` int NOT NULL, `a` varchar(20), `b` int NOT NULL, PRIMARY KEY (`id`))"))
+	downstream, err = tracker.FetchDownStreamTableInfo(tcontext.Background(), driftConn, tableID, tracked)
+	require.NoError(t, err)
+	require.NoError(t, driftMock.ExpectationsWereMet())
+	cached, err = tracker.GetDownStreamTableInfo(tcontext.Background(), tableID, tracked)
+	require.NoError(t, err)
+	require.Same(t, downstream, cached.TableInfo)
+}
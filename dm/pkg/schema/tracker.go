@@ -412,6 +412,41 @@ func (tr *Tracker) RemoveDownstreamSchema(tctx *tcontext.Context, targetTables [
 	}
 }
 
+// FetchDownStreamTableInfo gets the latest downstream table info through the connection bypassing the cache, it's
+// used to find the changes of downstream tables which are not made by DM. The connection should not be shared with
+// the downstream tracker, because the query runs without holding the lock of the downstream tracker. If the table
+// info is cached and the downstream table has changed, the cache is replaced.
+func (tr *Tracker) FetchDownStreamTableInfo(tctx *tcontext.Context, downstreamConn *dbconn.DBConn, tableID string, originTI *model.TableInfo) (*model.TableInfo, error) {
+	dt := tr.downstreamTracker
+	if dt == nil {
+		return nil, dmterror.ErrSchemaTrackerIsClosed.New("fail to fetch downstream table info")
+	}
+	// the parser of the downstream tracker uses the default sql_mode.
+	setSQLMode := fmt.Sprintf("SET SESSION SQL_MODE = '%s'", mysql.DefaultSQLMode)
+	if _, err := downstreamConn.ExecuteSQL(tctx, nil, []string{setSQLMode}); err != nil {
+		return nil, dmterror.ErrSchemaTrackerCannotSetDownstreamSQLMode.Delegate(err, mysql.DefaultSQLMode)
+	}
+	createStr, err := dbconn.GetTableCreateSQL(tctx, downstreamConn, tableID)
+	if err != nil {
+		return nil, dmterror.ErrSchemaTrackerCannotFetchDownstreamCreateTableStmt.Delegate(err, tableID)
+	}
+
+	dt.Lock()
+	defer dt.Unlock()
+	ti, err := dt.buildTableInfo(tctx, tableID, createStr)
+	if err != nil {
+		return nil, err
+	}
+	if dti, ok := dt.tableInfos[tableID]; ok && len(CompareTableInfo(dti.TableInfo, ti)) > 0 {
+		tctx.Logger.Info("Downstream table changed, replace the cached table info", zap.String("tableID", tableID))
+		dt.tableInfos[tableID] = &DownstreamTableInfo{
+			TableInfo:   ti,
+			WhereHandle: sqlmodel.GetWhereHandle(originTI, ti),
+		}
+	}
+	return ti, nil
+}
+
 func (dt *downstreamTracker) getOrInit(tctx *tcontext.Context, tableID string, originTI *model.TableInfo) (*DownstreamTableInfo, error) {
 	dt.RLock()
 	dti, ok := dt.tableInfos[tableID]
@@ -474,6 +509,16 @@ func (dt *downstreamTracker) getTableInfoByCreateStmt(tctx *tcontext.Context, ta
 	if err != nil {
 		return nil, dmterror.ErrSchemaTrackerCannotFetchDownstreamCreateTableStmt.Delegate(err, tableID)
 	}
+	return dt.buildTableInfo(tctx, tableID, createStr)
+}
+
+// buildTableInfo builds the table info from the create table statement of the downstream table.
+func (dt *downstreamTracker) buildTableInfo(tctx *tcontext.Context, tableID, createStr string) (*model.TableInfo, error) {
+	if dt.stmtParser == nil {
+		if err := dt.initParser(); err != nil {
+			return nil, err
+		}
+	}
 
 	tctx.Logger.Info("Show create table info", zap.String("tableID", tableID), zap.String("create string", createStr))
 	// parse create table stmt.
@@ -507,6 +552,11 @@ func (dt *downstreamTracker) initDownStreamSQLModeAndParser(tctx *tcontext.Conte
 	if err != nil {
 		return dmterror.ErrSchemaTrackerCannotSetDownstreamSQLMode.Delegate(err, mysql.DefaultSQLMode)
 	}
+	return dt.initParser()
+}
+
+// initParser initializes the statement parser with the default sql_mode.
+func (dt *downstreamTracker) initParser() error {
 	stmtParser, err := conn.GetParserFromSQLModeStr(mysql.DefaultSQLMode)
 	if err != nil {
 		return dmterror.ErrSchemaTrackerCannotInitDownstreamParser.Delegate(err, mysql.DefaultSQLMode)
@@ -61,6 +61,9 @@ type Info struct {
 
 	// use to resolve conflict
 	IgnoreConflict bool `json:"ignore-conflict"`
+
+	// strategies to resolve conflict automatically, it's `shard-auto-resolve` of the task.
+	AutoResolve []string `json:"auto-resolve,omitempty"`
 }
 
 // LogInfo replace TableInfo with schema.Table.String() for log.
@@ -308,7 +311,8 @@ func ClearTestInfoOperationColumn(cli *clientv3.Client) error {
 	clearInfo := clientv3.OpDelete(common.ShardDDLOptimismInfoKeyAdapter.Path(), clientv3.WithPrefix())
 	clearOp := clientv3.OpDelete(common.ShardDDLOptimismOperationKeyAdapter.Path(), clientv3.WithPrefix())
 	clearColumns := clientv3.OpDelete(common.ShardDDLOptimismDroppedColumnsKeyAdapter.Path(), clientv3.WithPrefix())
-	_, err := cli.Txn(context.Background()).Then(clearSource, clearInfo, clearOp, clearColumns).Commit()
+	clearAutoResolves := clientv3.OpDelete(common.ShardDDLOptimismAutoResolveKeyAdapter.Path(), clientv3.WithPrefix())
+	_, err := cli.Txn(context.Background()).Then(clearSource, clearInfo, clearOp, clearColumns, clearAutoResolves).Commit()
 	return err
 }
 
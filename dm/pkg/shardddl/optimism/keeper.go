@@ -34,7 +34,7 @@ type DownstreamMeta struct {
 
 // LockKeeper used to keep and handle DDL lock conveniently.
 // The lock information do not need to be persistent, and can be re-constructed from the shard DDL info.
-// But the drop columns should be persistent.
+// But the drop columns and the decisions of auto resolving should be persistent.
 type LockKeeper struct {
 	mu    sync.RWMutex
 	locks map[string]*Lock // lockID -> Lock
@@ -43,6 +43,8 @@ type LockKeeper struct {
 	getDownstreamMetaFunc func(string) (*dbconfig.DBConfig, string)
 	// lockID -> column name -> source -> upSchema -> upTable -> int
 	dropColumns map[string]map[string]map[string]map[string]map[string]DropColumnStage
+	// lockID -> the decisions of auto resolving
+	autoResolves map[string]AutoResolve
 }
 
 // NewLockKeeper creates a new LockKeeper instance.
@@ -59,6 +61,11 @@ func (lk *LockKeeper) SetDropColumns(dropColumns map[string]map[string]map[strin
 	lk.dropColumns = dropColumns
 }
 
+// SetAutoResolves set the decisions of auto resolving for lock keeper.
+func (lk *LockKeeper) SetAutoResolves(autoResolves map[string]AutoResolve) {
+	lk.autoResolves = autoResolves
+}
+
 // getDownstreamMeta gets and cached downstream meta.
 func (lk *LockKeeper) getDownstreamMeta(task string) (*DownstreamMeta, error) {
 	if downstreamMeta, ok := lk.downstreamMetaMap[task]; ok {
@@ -109,6 +116,10 @@ func (lk *LockKeeper) TrySync(cli *clientv3.Client, info Info, tts []TargetTable
 				l.columns = cols
 			}
 		}
+		// set the decisions of auto resolving, only when recover locks
+		if ar, ok := lk.autoResolves[lockID]; ok {
+			l.restoreAutoResolve(ar, info.TableInfoBefore)
+		}
 	}
 
 	newDDLs, cols, err := l.TrySync(info, tts)
@@ -104,6 +104,12 @@ type Lock struct {
 	columns map[string]map[string]map[string]map[string]DropColumnStage
 
 	downstreamMeta *DownstreamMeta
+
+	// resolver resolves the conflicts automatically, it's created when receiving an info with `AutoResolve`.
+	resolver *autoResolver
+	// the table infos known by the lock, encoded table -> table info.
+	// only kept for tables fetched from downstream or when resolver is created.
+	knownTables map[string]*model.TableInfo
 }
 
 // NewLock creates a new Lock instance.
@@ -123,6 +129,7 @@ func NewLock(cli *clientv3.Client, id, task, downSchema, downTable string, initT
 		versions:       make(map[string]map[string]map[string]int64),
 		columns:        make(map[string]map[string]map[string]map[string]DropColumnStage),
 		downstreamMeta: downstreamMeta,
+		knownTables:    make(map[string]*model.TableInfo),
 	}
 	l.addTables(tts)
 	metrics.ReportDDLPending(task, metrics.DDLPendingNone, metrics.DDLPendingSynced)
@@ -219,6 +226,9 @@ func (l *Lock) TrySync(info Info, tts []TargetTable) (newDDLs []string, cols []s
 	if info.TableInfoBefore == nil {
 		return emptyDDLs, emptyCols, terror.ErrMasterOptimisticTableInfoBeforeNotExist.Generate(ddls)
 	}
+	if len(info.AutoResolve) > 0 && l.resolver == nil {
+		l.resolver = newAutoResolver()
+	}
 
 	defer func() {
 		if err == nil && len(cols) > 0 {
@@ -230,11 +240,11 @@ func (l *Lock) TrySync(info Info, tts []TargetTable) (newDDLs []string, cols []s
 			switch {
 			case ignoreConflict:
 				// forcely set schema for --ignore-conflict
-				revertInfo = schemacmp.Encode(newTIs[len(newTIs)-1])
+				revertInfo = l.encodeTable(newTIs[len(newTIs)-1])
 			case terror.ErrShardDDLOptimismNeedSkipAndRedirect.Equal(err):
 				return
 			default:
-				revertInfo = schemacmp.Encode(info.TableInfoBefore)
+				revertInfo = l.encodeTable(info.TableInfoBefore)
 			}
 			l.tables[callerSource][callerSchema][callerTable] = revertInfo
 			l.finalTables[callerSource][callerSchema][callerTable] = revertInfo
@@ -255,11 +265,27 @@ func (l *Lock) TrySync(info Info, tts []TargetTable) (newDDLs []string, cols []s
 
 	newDDLs = []string{}
 	cols = []string{}
-	prevTable := schemacmp.Encode(info.TableInfoBefore)
+	prevTable := l.encodeTable(info.TableInfoBefore)
 	// join and compare every new table info
 	for idx, ti := range newTIs {
-		postTable := schemacmp.Encode(ti)
+		postTable := l.encodeTable(ti)
 		schemaChanged, conflictStage := l.trySyncForOneDDL(callerSource, callerSchema, callerTable, prevTable, postTable)
+		// try to resolve the conflict automatically before declaring it.
+		if conflictStage == ConflictDetected || conflictStage == ConflictSkipWaitRedirect {
+			prevTI := info.TableInfoBefore
+			if idx > 0 {
+				prevTI = newTIs[idx-1]
+			}
+			resolvedDDLs, resolvedTable, ok, err2 := l.tryAutoResolve(info.AutoResolve, callerSource, callerSchema, callerTable, ddls[idx], prevTI, ti, prevTable)
+			if err2 != nil {
+				return emptyDDLs, emptyCols, err2
+			}
+			if ok {
+				newDDLs = append(newDDLs, resolvedDDLs...)
+				prevTable = resolvedTable
+				continue
+			}
+		}
 
 		switch conflictStage {
 		case ConflictDetected:
@@ -399,7 +425,7 @@ func (l *Lock) UpdateTableAfterUnlock(info Info) {
 	if _, ok = l.tables[info.Source][info.UpSchema]; !ok {
 		l.tables[info.Source][info.UpSchema] = make(map[string]schemacmp.Table)
 	}
-	l.tables[info.Source][info.UpSchema][info.UpTable] = schemacmp.Encode(info.TableInfosAfter[len(info.TableInfosAfter)-1])
+	l.tables[info.Source][info.UpSchema][info.UpTable] = l.encodeTable(info.TableInfosAfter[len(info.TableInfosAfter)-1])
 }
 
 // IsSynced returns whether the lock has synced.
@@ -564,7 +590,10 @@ func (l *Lock) AddTable(source, schema, table string, needLock bool) {
 			l.tables[source][schema][table] = l.initTable
 			l.finalTables[source][schema][table] = l.initTable
 		} else {
-			t := schemacmp.Encode(ti)
+			t := l.encodeTable(ti)
+			if l.resolver == nil {
+				l.knownTables[t.String()] = ti
+			}
 			log.L().Debug("get source table info", zap.String("task", l.Task), zap.String("source", source), zap.String("schema", schema), zap.String("table", table), zap.Stringer("info", t))
 			l.tables[source][schema][table] = t
 			l.finalTables[source][schema][table] = t
@@ -33,7 +33,7 @@ func DeleteInfosOperationsColumns(cli *clientv3.Client, infos []Info, ops []Oper
 	for _, op := range ops {
 		opsDel = append(opsDel, deleteOperationOp(op))
 	}
-	opsDel = append(opsDel, deleteDroppedColumnsByLockOp(lockID))
+	opsDel = append(opsDel, deleteDroppedColumnsByLockOp(lockID), deleteAutoResolveOp(lockID))
 	resp, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.FullOpFunc(cmps, opsDel, []clientv3.Op{}))
 	if err != nil {
 		return 0, false, err
@@ -50,6 +50,7 @@ func DeleteInfosOperationsTablesByTask(cli *clientv3.Client, task string, lockID
 	opsDel = append(opsDel, clientv3.OpDelete(common.ShardDDLOptimismSourceTablesKeyAdapter.Encode(task), clientv3.WithPrefix()))
 	for lockID := range lockIDSet {
 		opsDel = append(opsDel, clientv3.OpDelete(common.ShardDDLOptimismDroppedColumnsKeyAdapter.Encode(lockID), clientv3.WithPrefix()))
+		opsDel = append(opsDel, deleteAutoResolveOp(lockID))
 	}
 	_, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(opsDel...))
 	return rev, err
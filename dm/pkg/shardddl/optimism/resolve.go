@@ -0,0 +1,828 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimism
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tidb/pkg/util/schemacmp"
+	"github.com/pingcap/tiflow/dm/common"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+// maxKnownTables is the threshold to prune the table infos kept by the lock.
+const maxKnownTables = 256
+
+// typeSpec is the part of a column type which can be widened.
+type typeSpec struct {
+	tp       byte
+	flen     int
+	decimal  int
+	unsigned bool
+}
+
+func newTypeSpec(ft *types.FieldType) typeSpec {
+	return typeSpec{tp: ft.GetType(), flen: ft.GetFlen(), decimal: ft.GetDecimal(), unsigned: mysql.HasUnsignedFlag(ft.GetFlag())}
+}
+
+func (s typeSpec) apply(ft *types.FieldType) {
+	ft.SetType(s.tp)
+	ft.SetFlen(s.flen)
+	ft.SetDecimal(s.decimal)
+	if s.unsigned {
+		ft.AddFlag(mysql.UnsignedFlag)
+	} else {
+		ft.DelFlag(mysql.UnsignedFlag)
+	}
+}
+
+// AutoResolveType is the column type widened by the strategies of `shard-auto-resolve`.
+type AutoResolveType struct {
+	Tp       byte `json:"tp"`
+	Flen     int  `json:"flen"`
+	Decimal  int  `json:"decimal"`
+	Unsigned bool `json:"unsigned,omitempty"`
+}
+
+// AutoResolveDecision is the audit of a decision made to resolve the conflict automatically.
+type AutoResolveDecision struct {
+	Time     time.Time `json:"time"`
+	Source   string    `json:"source"`
+	UpSchema string    `json:"up-schema"`
+	UpTable  string    `json:"up-table"`
+	DDL      string    `json:"ddl"`
+	Decision string    `json:"decision"`
+	// the DDLs executed in the downstream to resolve the conflict.
+	DownstreamDDLs []string `json:"downstream-ddls"`
+}
+
+// String implements Stringer interface.
+func (d AutoResolveDecision) String() string {
+	return fmt.Sprintf("%s-%s: %s, DDL: %s", d.Source, dbutil.TableName(d.UpSchema, d.UpTable), d.Decision, d.DDL)
+}
+
+// AutoResolve represents the decisions made to resolve the conflicts of a lock automatically, it's persisted in
+// etcd and deleted with the shard DDL info of the lock.
+type AutoResolve struct {
+	ID              string                     `json:"id"` // the lock ID
+	WidenedColumns  map[string]AutoResolveType `json:"widened-columns,omitempty"`
+	NullableColumns []string                   `json:"nullable-columns,omitempty"`
+	IgnoredIndexes  []string                   `json:"ignored-indexes,omitempty"`
+	Decisions       []AutoResolveDecision      `json:"decisions"`
+}
+
+// String implements Stringer interface.
+func (ar AutoResolve) String() string {
+	s, _ := ar.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (ar AutoResolve) toJSON() (string, error) {
+	data, err := json.Marshal(ar)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// autoResolveFromJSON constructs AutoResolve from its JSON represent.
+func autoResolveFromJSON(s string) (ar AutoResolve, err error) {
+	err = json.Unmarshal([]byte(s), &ar)
+	return
+}
+
+// PutAutoResolve puts the decisions of the lock into etcd.
+func PutAutoResolve(cli *clientv3.Client, ar AutoResolve) (int64, error) {
+	value, err := ar.toJSON()
+	if err != nil {
+		return 0, err
+	}
+	op := clientv3.OpPut(common.ShardDDLOptimismAutoResolveKeyAdapter.Encode(ar.ID), value)
+	_, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(op))
+	return rev, err
+}
+
+// GetAllAutoResolves gets the decisions of all locks from etcd.
+// k/v: lock ID -> the decisions of the lock.
+func GetAllAutoResolves(cli *clientv3.Client) (map[string]AutoResolve, int64, error) {
+	op := clientv3.OpGet(common.ShardDDLOptimismAutoResolveKeyAdapter.Path(), clientv3.WithPrefix())
+	respTxn, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(op))
+	if err != nil {
+		return nil, 0, err
+	}
+	resp := respTxn.Responses[0].GetResponseRange()
+
+	arm := make(map[string]AutoResolve, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		ar, err2 := autoResolveFromJSON(string(kv.Value))
+		if err2 != nil {
+			return nil, 0, err2
+		}
+		arm[ar.ID] = ar
+	}
+	return arm, rev, nil
+}
+
+// deleteAutoResolveOp returns a DELETE etcd operation for the decisions of the lock.
+func deleteAutoResolveOp(lockID string) clientv3.Op {
+	return clientv3.OpDelete(common.ShardDDLOptimismAutoResolveKeyAdapter.Encode(lockID))
+}
+
+// autoResolver keeps the decisions made by the strategies of `shard-auto-resolve`.
+// The decisions are applied on all table infos of the lock before encoding them,
+// so the tables received after a decision are consistent with the resolved downstream table.
+type autoResolver struct {
+	// column name -> the common supertype of the column.
+	widenedColumns map[string]typeSpec
+	// columns which are made nullable with NULL as default.
+	nullableColumns map[string]struct{}
+	// secondary indexes whose differences are ignored.
+	ignoredIndexes map[string]struct{}
+
+	// audit of the automatic decisions.
+	decisions []AutoResolveDecision
+}
+
+func newAutoResolver() *autoResolver {
+	return &autoResolver{
+		widenedColumns:  make(map[string]typeSpec),
+		nullableColumns: make(map[string]struct{}),
+		ignoredIndexes:  make(map[string]struct{}),
+	}
+}
+
+// newAutoResolverFrom creates an autoResolver with the decisions persisted in etcd.
+func newAutoResolverFrom(ar AutoResolve) *autoResolver {
+	r := newAutoResolver()
+	for col, tp := range ar.WidenedColumns {
+		r.widenedColumns[col] = typeSpec{tp: tp.Tp, flen: tp.Flen, decimal: tp.Decimal, unsigned: tp.Unsigned}
+	}
+	for _, col := range ar.NullableColumns {
+		r.nullableColumns[col] = struct{}{}
+	}
+	for _, idx := range ar.IgnoredIndexes {
+		r.ignoredIndexes[idx] = struct{}{}
+	}
+	r.decisions = append(r.decisions, ar.Decisions...)
+	return r
+}
+
+// toAutoResolve returns the decisions to be persisted in etcd.
+func (r *autoResolver) toAutoResolve(lockID string) AutoResolve {
+	ar := AutoResolve{
+		ID:              lockID,
+		NullableColumns: utils.SetToSlice(r.nullableColumns),
+		IgnoredIndexes:  utils.SetToSlice(r.ignoredIndexes),
+		Decisions:       append([]AutoResolveDecision{}, r.decisions...),
+	}
+	if len(r.widenedColumns) > 0 {
+		ar.WidenedColumns = make(map[string]AutoResolveType, len(r.widenedColumns))
+		for col, spec := range r.widenedColumns {
+			ar.WidenedColumns[col] = AutoResolveType{Tp: spec.tp, Flen: spec.flen, Decimal: spec.decimal, Unsigned: spec.unsigned}
+		}
+	}
+	sort.Strings(ar.NullableColumns)
+	sort.Strings(ar.IgnoredIndexes)
+	return ar
+}
+
+func (r *autoResolver) clone() *autoResolver {
+	c := newAutoResolver()
+	for k, v := range r.widenedColumns {
+		c.widenedColumns[k] = v
+	}
+	for k := range r.nullableColumns {
+		c.nullableColumns[k] = struct{}{}
+	}
+	for k := range r.ignoredIndexes {
+		c.ignoredIndexes[k] = struct{}{}
+	}
+	c.decisions = append(c.decisions, r.decisions...)
+	return c
+}
+
+// normalize applies the decisions on the table info, the original table info is not modified.
+func (r *autoResolver) normalize(ti *model.TableInfo) *model.TableInfo {
+	if len(r.widenedColumns) == 0 && len(r.nullableColumns) == 0 && len(r.ignoredIndexes) == 0 {
+		return ti
+	}
+	ti = ti.Clone()
+	for _, col := range ti.Columns {
+		if spec, ok := r.widenedColumns[col.Name.L]; ok {
+			// only widen the compatible types, other types are left to be detected as conflict.
+			if st, ok2 := supertype(newTypeSpec(&col.FieldType), spec, &col.FieldType, &col.FieldType); ok2 && st == spec {
+				spec.apply(&col.FieldType)
+			}
+		}
+		if _, ok := r.nullableColumns[col.Name.L]; ok {
+			col.DelFlag(mysql.NotNullFlag | mysql.NoDefaultValueFlag)
+			col.DefaultValue = nil
+			col.OriginDefaultValue = nil
+		}
+	}
+	if len(r.ignoredIndexes) > 0 {
+		indices := ti.Indices[:0]
+		for _, idx := range ti.Indices {
+			if _, ok := r.ignoredIndexes[idx.Name.L]; !ok || idx.Primary {
+				indices = append(indices, idx)
+			}
+		}
+		ti.Indices = indices
+		resetKeyFlags(ti)
+	}
+	return ti
+}
+
+// resetKeyFlags sets the key flags of the columns by the indexes, like what TiDB does when building the table info.
+func resetKeyFlags(ti *model.TableInfo) {
+	for _, col := range ti.Columns {
+		col.DelFlag(mysql.UniqueKeyFlag | mysql.MultipleKeyFlag)
+	}
+	for _, idx := range ti.Indices {
+		if idx.Primary || len(idx.Columns) == 0 {
+			continue
+		}
+		col := ti.Columns[idx.Columns[0].Offset]
+		if idx.Unique && len(idx.Columns) == 1 {
+			col.AddFlag(mysql.UniqueKeyFlag)
+		} else {
+			col.AddFlag(mysql.MultipleKeyFlag)
+		}
+	}
+}
+
+// restoreAutoResolve restores the decisions persisted in etcd when the lock is rebuilt. initTI is the table
+// info used to create the lock, the tables added before are re-encoded with the decisions applied.
+func (l *Lock) restoreAutoResolve(ar AutoResolve, initTI *model.TableInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.resolver = newAutoResolverFrom(ar)
+	oldInitTable := l.initTable.String()
+	l.initTable = l.encodeTable(initTI)
+	for _, all := range []map[string]map[string]map[string]schemacmp.Table{l.tables, l.finalTables} {
+		for _, schemaTables := range all {
+			for _, tables := range schemaTables {
+				for table, t := range tables {
+					if t.String() == oldInitTable {
+						tables[table] = l.initTable
+					} else if ti, ok := l.knownTables[t.String()]; ok {
+						tables[table] = l.encodeTable(ti)
+					}
+				}
+			}
+		}
+	}
+}
+
+// encodeTable encodes the table info, the decisions of auto resolving are applied if any.
+// The table info is kept for re-encoding it after new decisions are made.
+func (l *Lock) encodeTable(ti *model.TableInfo) schemacmp.Table {
+	if l.resolver == nil {
+		return schemacmp.Encode(ti)
+	}
+	ti = l.resolver.normalize(ti)
+	t := schemacmp.Encode(ti)
+	l.knownTables[t.String()] = ti
+	return t
+}
+
+// decide makes new decisions by the strategies to resolve the conflicts between the tables.
+// postTI is the table info of the caller after the DDL, and prevTI is the one before the DDL.
+func (r *autoResolver) decide(strategies []string, prevTI, postTI *model.TableInfo, others []*model.TableInfo) []string {
+	var newDecisions []string
+	enabled := make(map[string]bool, len(strategies))
+	for _, s := range strategies {
+		enabled[s] = true
+	}
+	all := append([]*model.TableInfo{postTI}, others...)
+
+	prevCols := make(map[string]struct{}, len(prevTI.Columns))
+	for _, col := range prevTI.Columns {
+		prevCols[col.Name.L] = struct{}{}
+	}
+	for _, col := range postTI.Columns {
+		name := col.Name.L
+		var cols []*model.ColumnInfo
+		for _, ti := range all {
+			if c := model.FindColumnInfo(ti.Columns, name); c != nil {
+				cols = append(cols, c)
+			}
+		}
+
+		if enabled[config.ShardAutoResolveWidenType] {
+			if spec, ok := r.widen(cols); ok {
+				r.widenedColumns[name] = spec
+				newDecisions = append(newDecisions, fmt.Sprintf("widen column %s to %s", name, specString(spec, &col.FieldType)))
+			}
+		}
+
+		if enabled[config.ShardAutoResolveUnionColumns] {
+			if _, ok := r.nullableColumns[name]; ok {
+				continue
+			}
+			_, existed := prevCols[name]
+			added := !existed && len(cols) < len(all) && mysql.HasNotNullFlag(col.GetFlag()) && !hasDefault(col)
+			if added || differentDefaults(cols) {
+				r.nullableColumns[name] = struct{}{}
+				newDecisions = append(newDecisions, fmt.Sprintf("make column %s nullable with NULL as default", name))
+			}
+		}
+	}
+
+	if enabled[config.ShardAutoResolveIgnoreIndex] {
+		for _, idx := range postTI.Indices {
+			if _, ok := r.ignoredIndexes[idx.Name.L]; ok || idx.Primary {
+				continue
+			}
+			for _, ti := range others {
+				if other := ti.FindIndexByName(idx.Name.L); other != nil && !sameIndex(idx, other) {
+					r.ignoredIndexes[idx.Name.L] = struct{}{}
+					newDecisions = append(newDecisions, fmt.Sprintf("ignore differences of index %s", idx.Name.L))
+					break
+				}
+			}
+		}
+	}
+	return newDecisions
+}
+
+// widen returns the common supertype of the columns if their types are not compatible.
+func (r *autoResolver) widen(cols []*model.ColumnInfo) (typeSpec, bool) {
+	if len(cols) < 2 {
+		return typeSpec{}, false
+	}
+	incompatible := false
+	for _, c := range cols[1:] {
+		if newTypeSpec(&c.FieldType) == newTypeSpec(&cols[0].FieldType) {
+			continue
+		}
+		if _, err := schemacmp.Type(&cols[0].FieldType).Join(schemacmp.Type(&c.FieldType)); err != nil {
+			incompatible = true
+		}
+	}
+	if !incompatible {
+		return typeSpec{}, false
+	}
+	spec := newTypeSpec(&cols[0].FieldType)
+	for _, c := range cols[1:] {
+		var ok bool
+		if spec, ok = supertype(spec, newTypeSpec(&c.FieldType), &cols[0].FieldType, &c.FieldType); !ok {
+			return typeSpec{}, false
+		}
+	}
+	if old, ok := r.widenedColumns[cols[0].Name.L]; ok {
+		if spec == old {
+			return typeSpec{}, false
+		}
+		var ok2 bool
+		if spec, ok2 = supertype(spec, old, &cols[0].FieldType, &cols[0].FieldType); !ok2 {
+			return typeSpec{}, false
+		}
+	}
+	return spec, true
+}
+
+// intDigits returns the max digits of the integer types.
+var intDigits = map[byte]int{
+	mysql.TypeTiny:     3,
+	mysql.TypeShort:    5,
+	mysql.TypeInt24:    8,
+	mysql.TypeLong:     10,
+	mysql.TypeLonglong: 20,
+}
+
+// intTypes are the integer types ordered by their sizes.
+var intTypes = []byte{mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong}
+
+func intSize(tp byte) int {
+	for i, t := range intTypes {
+		if t == tp {
+			return i
+		}
+	}
+	return -1
+}
+
+// supertype returns the common supertype of two column types, the charset and collation of
+// the string types should be the same. aft and bft are used to check other attributes.
+func supertype(a, b typeSpec, aft, bft *types.FieldType) (typeSpec, bool) {
+	if aft.GetCharset() != bft.GetCharset() || aft.GetCollate() != bft.GetCollate() ||
+		mysql.HasZerofillFlag(aft.GetFlag()) != mysql.HasZerofillFlag(bft.GetFlag()) {
+		return typeSpec{}, false
+	}
+	if a == b {
+		return a, true
+	}
+	switch {
+	case mysql.IsIntegerType(a.tp) && mysql.IsIntegerType(b.tp):
+		if a.unsigned == b.unsigned {
+			tp := a.tp
+			if intSize(b.tp) > intSize(a.tp) {
+				tp = b.tp
+			}
+			return typeSpec{tp: tp, flen: max(a.flen, b.flen), decimal: 0, unsigned: a.unsigned}, true
+		}
+		signed, unsigned := a, b
+		if a.unsigned {
+			signed, unsigned = b, a
+		}
+		size := max(intSize(signed.tp), intSize(unsigned.tp)+1)
+		if size >= len(intTypes) {
+			return typeSpec{tp: mysql.TypeNewDecimal, flen: intDigits[mysql.TypeLonglong], decimal: 0}, true
+		}
+		flen, _ := mysql.GetDefaultFieldLengthAndDecimal(intTypes[size])
+		return typeSpec{tp: intTypes[size], flen: flen}, true
+	case a.tp == mysql.TypeNewDecimal || b.tp == mysql.TypeNewDecimal:
+		da, oka := decimalDigits(a)
+		db, okb := decimalDigits(b)
+		if !oka || !okb {
+			return typeSpec{}, false
+		}
+		scale := max(a.decimal, b.decimal, 0)
+		flen := max(da, db) + scale
+		if flen > mysql.MaxDecimalWidth {
+			return typeSpec{}, false
+		}
+		return typeSpec{tp: mysql.TypeNewDecimal, flen: flen, decimal: scale, unsigned: a.unsigned && b.unsigned}, true
+	case isFloatType(a.tp) && isFloatType(b.tp):
+		if a.unsigned != b.unsigned {
+			return typeSpec{}, false
+		}
+		flen, dec := mysql.GetDefaultFieldLengthAndDecimal(mysql.TypeDouble)
+		return typeSpec{tp: mysql.TypeDouble, flen: flen, decimal: dec, unsigned: a.unsigned}, true
+	case isStringType(a.tp) && isStringType(b.tp):
+		if types.IsTypeBlob(a.tp) || types.IsTypeBlob(b.tp) {
+			tp := blobTypeFor(a)
+			if bt := blobTypeFor(b); blobSize(bt) > blobSize(tp) {
+				tp = bt
+			}
+			flen, _ := mysql.GetDefaultFieldLengthAndDecimal(tp)
+			return typeSpec{tp: tp, flen: flen}, true
+		}
+		return typeSpec{tp: mysql.TypeVarchar, flen: max(a.flen, b.flen)}, true
+	}
+	return typeSpec{}, false
+}
+
+// decimalDigits returns the digits before the decimal point of an integer or decimal type.
+func decimalDigits(s typeSpec) (int, bool) {
+	if s.tp == mysql.TypeNewDecimal {
+		return s.flen - s.decimal, true
+	}
+	d, ok := intDigits[s.tp]
+	return d, ok
+}
+
+func isFloatType(tp byte) bool {
+	return tp == mysql.TypeFloat || tp == mysql.TypeDouble
+}
+
+func isStringType(tp byte) bool {
+	return tp == mysql.TypeString || tp == mysql.TypeVarchar || tp == mysql.TypeVarString || types.IsTypeBlob(tp)
+}
+
+// blobTypes are the blob types ordered by their sizes.
+var blobTypes = []byte{mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob}
+
+func blobSize(tp byte) int {
+	for i, t := range blobTypes {
+		if t == tp {
+			return i
+		}
+	}
+	return -1
+}
+
+// blobTypeFor returns the blob type which can hold the string type.
+func blobTypeFor(s typeSpec) byte {
+	if types.IsTypeBlob(s.tp) {
+		return s.tp
+	}
+	// 4 bytes per character at most.
+	if s.flen*4 > 65535 {
+		return mysql.TypeMediumBlob
+	}
+	return mysql.TypeBlob
+}
+
+func hasDefault(col *model.ColumnInfo) bool {
+	return col.DefaultValue != nil || mysql.HasAutoIncrementFlag(col.GetFlag()) || !mysql.HasNoDefaultValueFlag(col.GetFlag())
+}
+
+func differentDefaults(cols []*model.ColumnInfo) bool {
+	for _, c := range cols[1:] {
+		if hasDefault(c) != hasDefault(cols[0]) || fmt.Sprint(c.DefaultValue) != fmt.Sprint(cols[0].DefaultValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameIndex(a, b *model.IndexInfo) bool {
+	if a.Unique != b.Unique || a.Primary != b.Primary || len(a.Columns) != len(b.Columns) {
+		return false
+	}
+	for i := range a.Columns {
+		if a.Columns[i].Name.L != b.Columns[i].Name.L || a.Columns[i].Length != b.Columns[i].Length {
+			return false
+		}
+	}
+	return true
+}
+
+func specString(spec typeSpec, ft *types.FieldType) string {
+	ft = ft.Clone()
+	spec.apply(ft)
+	return ft.String()
+}
+
+// columnDefinition returns the definition of the column in the joined table.
+func columnDefinition(col *model.ColumnInfo, ft *types.FieldType, nullable bool) string {
+	var sb strings.Builder
+	sb.WriteString(dbutil.ColumnName(col.Name.O))
+	sb.WriteString(" ")
+	sb.WriteString(ft.String())
+	switch {
+	case nullable:
+		sb.WriteString(" NULL DEFAULT NULL")
+	case mysql.HasNotNullFlag(ft.GetFlag()):
+		sb.WriteString(" NOT NULL")
+		if col.DefaultValue != nil {
+			sb.WriteString(" DEFAULT ")
+			sb.WriteString(defaultValueString(col.DefaultValue))
+		}
+	default:
+		sb.WriteString(" NULL")
+		if col.DefaultValue != nil {
+			sb.WriteString(" DEFAULT ")
+			sb.WriteString(defaultValueString(col.DefaultValue))
+		}
+	}
+	return sb.String()
+}
+
+func defaultValueString(v interface{}) string {
+	s := fmt.Sprint(v)
+	if strings.HasPrefix(strings.ToUpper(s), "CURRENT_TIMESTAMP") {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// tryAutoResolve tries to resolve the conflict of the DDL of the caller table by the strategies.
+// It's called after trySyncForOneDDL detects a conflict, and returns the DDLs to be executed in the
+// downstream and the encoded table of the caller if the conflict is resolved. The decisions are
+// persisted into etcd before returning, and an error is returned if it fails.
+// NOTE: the conflict is only resolved when no other table is in conflict and the DDL doesn't drop columns.
+func (l *Lock) tryAutoResolve(
+	strategies []string, source, schema, table, ddl string, prevTI, postTI *model.TableInfo, prevTable schemacmp.Table,
+) ([]string, schemacmp.Table, bool, error) {
+	if l.resolver == nil {
+		return nil, schemacmp.Table{}, false, nil
+	}
+	logger := log.L().WithFields(zap.String("lock", l.ID), zap.String("source", source),
+		zap.String("schema", schema), zap.String("table", table), zap.String("DDL", ddl))
+	for source2, schemaTables := range l.conflictTables {
+		for schema2, tables := range schemaTables {
+			for table2 := range tables {
+				if source2 != source || schema2 != schema || table2 != table {
+					logger.Info("skip auto resolving shard DDL conflict because other tables are in conflict")
+					return nil, schemacmp.Table{}, false, nil
+				}
+			}
+		}
+	}
+	for _, col := range prevTI.Columns {
+		if model.FindColumnInfo(postTI.Columns, col.Name.L) == nil {
+			logger.Info("skip auto resolving shard DDL conflict because the DDL drops or renames columns")
+			return nil, schemacmp.Table{}, false, nil
+		}
+	}
+
+	// snapshot the states to roll back.
+	var (
+		oldResolver      = l.resolver.clone()
+		oldTables        = copyTables(l.tables)
+		oldFinalTables   = copyTables(l.finalTables)
+		oldConflictTable = l.conflictTables[source][schema][table]
+		_, inConflict    = l.conflictTables[source][schema][table]
+	)
+	rollback := func() {
+		l.resolver = oldResolver
+		l.tables = oldTables
+		l.finalTables = oldFinalTables
+		if inConflict {
+			l.addConflictTable(source, schema, table, oldConflictTable)
+		}
+	}
+
+	l.removeConflictTable(source, schema, table)
+	l.tables[source][schema][table] = prevTable
+	l.finalTables[source][schema][table] = prevTable
+	oldJoined, err := l.joinNormalTables()
+	if err != nil {
+		rollback()
+		return nil, schemacmp.Table{}, false, nil
+	}
+
+	type tableKey struct{ source, schema, table string }
+	var (
+		keys   []tableKey
+		others []*model.TableInfo
+	)
+	for source2, schemaTables := range l.tables {
+		for schema2, tables := range schemaTables {
+			for table2, t := range tables {
+				if source2 == source && schema2 == schema && table2 == table {
+					continue
+				}
+				ti, ok := l.knownTables[t.String()]
+				if !ok {
+					logger.Info("skip auto resolving shard DDL conflict because table info is unknown",
+						zap.String("other source", source2), zap.String("other schema", schema2), zap.String("other table", table2))
+					rollback()
+					return nil, schemacmp.Table{}, false, nil
+				}
+				keys = append(keys, tableKey{source2, schema2, table2})
+				others = append(others, ti)
+			}
+		}
+	}
+
+	newDecisions := l.resolver.decide(strategies, l.resolver.normalize(prevTI), l.resolver.normalize(postTI), others)
+	if len(newDecisions) == 0 {
+		rollback()
+		return nil, schemacmp.Table{}, false, nil
+	}
+
+	for i, key := range keys {
+		t := l.encodeTable(others[i])
+		l.tables[key.source][key.schema][key.table] = t
+		l.finalTables[key.source][key.schema][key.table] = t
+	}
+	postTable := l.encodeTable(postTI)
+	l.tables[source][schema][table] = postTable
+	l.finalTables[source][schema][table] = postTable
+	newJoined, err := l.joinNormalTables()
+	if err != nil {
+		logger.Info("fail to auto resolve shard DDL conflict", zap.Strings("decisions", newDecisions), log.ShortError(err))
+		rollback()
+		return nil, schemacmp.Table{}, false, nil
+	}
+
+	ddls, err := l.genResolvedDDLs(oldJoined, newJoined, l.resolver.normalize(postTI), others)
+	if err != nil {
+		logger.Info("fail to auto resolve shard DDL conflict", zap.Strings("decisions", newDecisions), log.ShortError(err))
+		rollback()
+		return nil, schemacmp.Table{}, false, nil
+	}
+
+	now := time.Now()
+	for _, d := range newDecisions {
+		l.resolver.decisions = append(l.resolver.decisions, AutoResolveDecision{
+			Time:           now,
+			Source:         source,
+			UpSchema:       schema,
+			UpTable:        table,
+			DDL:            ddl,
+			Decision:       d,
+			DownstreamDDLs: ddls,
+		})
+	}
+	// persist the decisions before executing the DDLs, so the new leader rebuilds the lock with them.
+	if _, err = PutAutoResolve(l.cli, l.resolver.toAutoResolve(l.ID)); err != nil {
+		rollback()
+		return nil, schemacmp.Table{}, false, err
+	}
+	for _, d := range newDecisions {
+		logger.Info("auto resolved shard DDL conflict", zap.String("task", l.Task), zap.String("decision", d), zap.Strings("downstream DDLs", ddls))
+	}
+	l.pruneKnownTables()
+	return ddls, postTable, true, nil
+}
+
+// genResolvedDDLs generates the DDLs to change the columns of the downstream table from oldJoined to newJoined.
+func (l *Lock) genResolvedDDLs(oldJoined, newJoined schemacmp.Table, postTI *model.TableInfo, others []*model.TableInfo) ([]string, error) {
+	oldCols := schemacmp.DecodeColumnFieldTypes(oldJoined)
+	newCols := schemacmp.DecodeColumnFieldTypes(newJoined)
+	names := make([]string, 0, len(newCols))
+	for name := range newCols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var ddls []string
+	for _, name := range names {
+		newFt := newCols[name]
+		oldFt, existed := oldCols[name]
+		_, nullable := l.resolver.nullableColumns[name]
+		if existed {
+			// the key flags are changed by ignoring indexes, which doesn't need to change the column.
+			cmp, err := schemacmp.Type(withoutKeyFlags(oldFt)).Compare(schemacmp.Type(withoutKeyFlags(newFt)))
+			if err == nil && cmp == 0 {
+				continue
+			}
+		}
+		var col *model.ColumnInfo
+		for _, ti := range append([]*model.TableInfo{postTI}, others...) {
+			if col = model.FindColumnInfo(ti.Columns, name); col != nil {
+				break
+			}
+		}
+		if col == nil {
+			continue
+		}
+		if col.IsGenerated() {
+			return nil, fmt.Errorf("generated column %s can't be changed automatically", name)
+		}
+		op := "ADD"
+		if existed {
+			op = "MODIFY"
+		}
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s %s COLUMN %s", dbutil.TableName(l.DownSchema, l.DownTable), op,
+			columnDefinition(col, newFt, nullable && !mysql.HasNotNullFlag(newFt.GetFlag()))))
+	}
+	return ddls, nil
+}
+
+func withoutKeyFlags(ft *types.FieldType) *types.FieldType {
+	ft = ft.Clone()
+	ft.DelFlag(mysql.PriKeyFlag | mysql.UniqueKeyFlag | mysql.MultipleKeyFlag)
+	return ft
+}
+
+// pruneKnownTables removes the table infos not used by the lock.
+func (l *Lock) pruneKnownTables() {
+	if len(l.knownTables) <= maxKnownTables {
+		return
+	}
+	used := map[string]struct{}{l.initTable.String(): {}}
+	for _, all := range []map[string]map[string]map[string]schemacmp.Table{l.tables, l.finalTables, l.conflictTables} {
+		for _, schemaTables := range all {
+			for _, tables := range schemaTables {
+				for _, t := range tables {
+					used[t.String()] = struct{}{}
+				}
+			}
+		}
+	}
+	for k := range l.knownTables {
+		if _, ok := used[k]; !ok {
+			delete(l.knownTables, k)
+		}
+	}
+}
+
+// AutoResolved returns the audit of the decisions made to resolve the conflicts automatically.
+func (l *Lock) AutoResolved() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.resolver == nil {
+		return nil
+	}
+	ret := make([]string, 0, len(l.resolver.decisions))
+	for _, d := range l.resolver.decisions {
+		ret = append(ret, d.String())
+	}
+	return ret
+}
+
+func copyTables(tables map[string]map[string]map[string]schemacmp.Table) map[string]map[string]map[string]schemacmp.Table {
+	ret := make(map[string]map[string]map[string]schemacmp.Table, len(tables))
+	for source, schemaTables := range tables {
+		ret[source] = make(map[string]map[string]schemacmp.Table, len(schemaTables))
+		for schema, ts := range schemaTables {
+			ret[source][schema] = make(map[string]schemacmp.Table, len(ts))
+			for table, t := range ts {
+				ret[source][schema][table] = t
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimism
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/util/mock"
+	"github.com/pingcap/tidb/pkg/util/schemacmp"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func (t *testLock) TestLockAutoResolve(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		ID               = "test_lock_auto_resolve-`db`.`bar`"
+		task             = "test_lock_auto_resolve"
+		source           = "mysql-replica-1"
+		downSchema       = "db"
+		downTable        = "bar"
+		db               = "db"
+		tbls             = []string{"bar1", "bar2"}
+		p                = parser.New()
+		se               = mock.NewContext()
+		tblID      int64 = 111
+		ti0              = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 DECIMAL(10,2), INDEX idx(c1))`)
+		ti1              = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 DECIMAL(12,2), INDEX idx(c1))`)
+		ti2              = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 DECIMAL(10,4), INDEX idx(c1))`)
+		ti3              = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 DECIMAL(10,4), c2 INT NOT NULL, INDEX idx(c1))`)
+		ti4              = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 DECIMAL(10,4), c2 INT NOT NULL, INDEX idx(id, c1))`)
+		ti5              = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 DECIMAL(12,2), c2 INT NOT NULL, INDEX idx(c1))`)
+		tables           = map[string]map[string]struct{}{
+			db: {tbls[0]: struct{}{}, tbls[1]: struct{}{}},
+		}
+		tts = []TargetTable{
+			newTargetTable(task, source, downSchema, downTable, tables),
+		}
+		strategies = []string{config.ShardAutoResolveWidenType, config.ShardAutoResolveUnionColumns, config.ShardAutoResolveIgnoreIndex}
+
+		l = NewLock(etcdTestCli, ID, task, downSchema, downTable, schemacmp.Encode(ti0), tts, nil)
+
+		vers = map[string]map[string]map[string]int64{
+			source: {
+				db: {tbls[0]: 0, tbls[1]: 0},
+			},
+		}
+	)
+
+	trySync := func(tbl string, ddls []string, before *model.TableInfo, afters []*model.TableInfo) []string {
+		info := newInfoWithVersion(task, source, db, tbl, downSchema, downTable, ddls, before, afters, vers)
+		info.AutoResolve = strategies
+		DDLs, cols, err := l.TrySync(info, tts)
+		c.Assert(err, IsNil)
+		c.Assert(cols, DeepEquals, []string{})
+		return DDLs
+	}
+
+	// the conflict is detected without strategies.
+	l2 := NewLock(etcdTestCli, ID, task, downSchema, downTable, schemacmp.Encode(ti0), tts, nil)
+	info := newInfoWithVersion(task, source, db, tbls[0], downSchema, downTable, []string{"ALTER TABLE bar MODIFY COLUMN c1 DECIMAL(12,2)"}, ti0, []*model.TableInfo{ti1}, vers)
+	_, _, err := l2.TrySync(info, tts)
+	c.Assert(terror.ErrShardDDLOptimismNeedSkipAndRedirect.Equal(err), IsTrue)
+	c.Assert(l2.AutoResolved(), HasLen, 0)
+
+	// widen `DECIMAL(10,2)` to `DECIMAL(12,2)`.
+	c.Assert(trySync(tbls[0], []string{"ALTER TABLE bar MODIFY COLUMN c1 DECIMAL(12,2)"}, ti0, []*model.TableInfo{ti1}),
+		DeepEquals, []string{"ALTER TABLE `db`.`bar` MODIFY COLUMN `c1` decimal(12,2) NULL"})
+	c.Assert(l.AutoResolved(), DeepEquals, []string{
+		"mysql-replica-1-`db`.`bar1`: widen column c1 to decimal(12,2), DDL: ALTER TABLE bar MODIFY COLUMN c1 DECIMAL(12,2)",
+	})
+
+	// `DECIMAL(10,4)` conflicts with `DECIMAL(12,2)`, widened to `DECIMAL(14,4)`.
+	c.Assert(trySync(tbls[1], []string{"ALTER TABLE bar MODIFY COLUMN c1 DECIMAL(10,4)"}, ti0, []*model.TableInfo{ti2}),
+		DeepEquals, []string{"ALTER TABLE `db`.`bar` MODIFY COLUMN `c1` decimal(14,4) NULL"})
+
+	// add a NOT NULL column without default value, added as a nullable column.
+	c.Assert(trySync(tbls[1], []string{"ALTER TABLE bar ADD COLUMN c2 INT NOT NULL"}, ti2, []*model.TableInfo{ti3}),
+		DeepEquals, []string{"ALTER TABLE `db`.`bar` ADD COLUMN `c2` int(11) NULL DEFAULT NULL"})
+
+	// redefine the index, no DDL is needed.
+	c.Assert(trySync(tbls[1], []string{"ALTER TABLE bar DROP INDEX idx, ADD INDEX idx(id, c1)"}, ti3, []*model.TableInfo{ti4}),
+		DeepEquals, []string{})
+	c.Assert(l.AutoResolved(), DeepEquals, []string{
+		"mysql-replica-1-`db`.`bar1`: widen column c1 to decimal(12,2), DDL: ALTER TABLE bar MODIFY COLUMN c1 DECIMAL(12,2)",
+		"mysql-replica-1-`db`.`bar2`: widen column c1 to decimal(14,4), DDL: ALTER TABLE bar MODIFY COLUMN c1 DECIMAL(10,4)",
+		"mysql-replica-1-`db`.`bar2`: make column c2 nullable with NULL as default, DDL: ALTER TABLE bar ADD COLUMN c2 INT NOT NULL",
+		"mysql-replica-1-`db`.`bar2`: ignore differences of index idx, DDL: ALTER TABLE bar DROP INDEX idx, ADD INDEX idx(id, c1)",
+	})
+	synced, remain := l.IsSynced()
+	c.Assert(synced, IsFalse)
+	c.Assert(remain, Equals, 1)
+
+	// the first table adds the same column, the tables are synced with the decisions applied.
+	c.Assert(trySync(tbls[0], []string{"ALTER TABLE bar ADD COLUMN c2 INT NOT NULL"}, ti1, []*model.TableInfo{ti5}),
+		DeepEquals, []string{"ALTER TABLE bar ADD COLUMN c2 INT NOT NULL"})
+	t.checkLockSynced(c, l)
+	joined, err := l.Joined()
+	c.Assert(err, IsNil)
+	c.Assert(joined.String(), Equals, "CREATE TABLE `tbl`(`c1` DECIMAL(14,4), `c2` INT(11), `id` INT(11) NOT NULL, PRIMARY KEY (`id`))")
+
+	// the decisions are persisted in etcd.
+	arm, _, err := GetAllAutoResolves(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(arm, HasLen, 1)
+	ar := arm[ID]
+	c.Assert(ar.NullableColumns, DeepEquals, []string{"c2"})
+	c.Assert(ar.IgnoredIndexes, DeepEquals, []string{"idx"})
+	c.Assert(ar.Decisions, HasLen, 4)
+	c.Assert(ar.Decisions[1].UpTable, Equals, tbls[1])
+	c.Assert(ar.Decisions[1].DownstreamDDLs, DeepEquals, []string{"ALTER TABLE `db`.`bar` MODIFY COLUMN `c1` decimal(14,4) NULL"})
+
+	// the lock rebuilt by a new leader keeps the decisions, the tables are synced without conflicts.
+	lk := NewLockKeeper(getDownstreamMeta)
+	lk.SetAutoResolves(arm)
+	for i, ti := range []*model.TableInfo{ti5, ti4} {
+		info = newInfoWithVersion(task, source, db, tbls[i], downSchema, downTable, []string{"ALTER TABLE bar COMMENT ''"}, ti, []*model.TableInfo{ti}, vers)
+		_, _, _, err = lk.TrySync(etcdTestCli, info, tts)
+		c.Assert(err, IsNil)
+	}
+	l3 := lk.FindLock(ID)
+	c.Assert(l3.AutoResolved(), DeepEquals, l.AutoResolved())
+	t.checkLockSynced(c, l3)
+
+	// the decisions are deleted with the lock.
+	_, _, err = DeleteInfosOperationsColumns(etcdTestCli, nil, nil, ID)
+	c.Assert(err, IsNil)
+	arm, _, err = GetAllAutoResolves(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(arm, HasLen, 0)
+}
+
+func (t *testLock) TestSupertype(c *C) {
+	var (
+		p  = parser.New()
+		se = mock.NewContext()
+		ti = createTableInfo(c, p, se, 111, `CREATE TABLE bar (
+			c1 TINYINT, c2 INT UNSIGNED, c3 BIGINT UNSIGNED, c4 DECIMAL(10,2), c5 FLOAT, c6 DOUBLE,
+			c7 VARCHAR(10), c8 CHAR(20), c9 TEXT, c10 VARCHAR(20000), c11 DATETIME)`)
+	)
+	cases := []struct {
+		a, b     string
+		expected string
+	}{
+		{"c1", "c2", "bigint(20)"},
+		{"c1", "c3", "decimal(20,0)"},
+		{"c2", "c4", "decimal(12,2)"},
+		{"c5", "c6", "double"},
+		{"c7", "c8", "varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin"},
+		{"c7", "c9", "text CHARACTER SET utf8mb4 COLLATE utf8mb4_bin"},
+		{"c9", "c10", "mediumtext CHARACTER SET utf8mb4 COLLATE utf8mb4_bin"},
+		{"c6", "c7", ""},
+		{"c4", "c11", ""},
+	}
+	for _, cs := range cases {
+		a := model.FindColumnInfo(ti.Columns, cs.a)
+		b := model.FindColumnInfo(ti.Columns, cs.b)
+		spec, ok := supertype(newTypeSpec(&a.FieldType), newTypeSpec(&b.FieldType), &a.FieldType, &b.FieldType)
+		if cs.expected == "" {
+			c.Assert(ok, IsFalse, Commentf("%s %s", cs.a, cs.b))
+			continue
+		}
+		c.Assert(ok, IsTrue, Commentf("%s %s", cs.a, cs.b))
+		c.Assert(specString(spec, &a.FieldType), Equals, cs.expected, Commentf("%s %s", cs.a, cs.b))
+	}
+}
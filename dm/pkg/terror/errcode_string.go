@@ -211,6 +211,16 @@ func _() {
 	_ = x[codeConfigInvalidLoadAnalyze-20065]
 	_ = x[codeConfigStrictOptimisticShardMode-20066]
 	_ = x[codeConfigSecretKeyPath-20067]
+	_ = x[codeConfigInvalidSyncerDelay-20068]
+	_ = x[codeConfigInvalidRelayArchiveStorage-20069]
+	_ = x[codeConfigInvalidThrottle-20070]
+	_ = x[codeConfigInvalidConflictRule-20071]
+	_ = x[codeConfigInvalidColumnTransform-20072]
+	_ = x[codeConfigInvalidPlacementLabel-20073]
+	_ = x[codeConfigInvalidTargetMQ-20074]
+	_ = x[codeConfigInvalidSchemaDriftCheckInterval-20075]
+	_ = x[codeConfigInvalidTaskSchedule-20076]
+	_ = x[codeConfigInvalidShardAutoResolve-20077]
 	_ = x[codeBinlogExtractPosition-22001]
 	_ = x[codeBinlogInvalidFilename-22002]
 	_ = x[codeBinlogParsePosFromStr-22003]
@@ -276,6 +286,10 @@ func _() {
 	_ = x[codeRelayPurgeArgsNotValid-30042]
 	_ = x[codePreviousGTIDsNotValid-30043]
 	_ = x[codeRotateEventWithDifferentServerID-30044]
+	_ = x[codeRelayArchiveFile-30045]
+	_ = x[codeRelayRestoreArchivedFile-30046]
+	_ = x[codeRelayIndexRead-30047]
+	_ = x[codeRelayIndexWrite-30048]
 	_ = x[codeDumpUnitRuntime-32001]
 	_ = x[codeDumpUnitGenTableRouter-32002]
 	_ = x[codeDumpUnitGenBAList-32003]
@@ -372,6 +386,16 @@ func _() {
 	_ = x[codeSyncerGetEvent-36069]
 	_ = x[codeSyncerDownstreamTableNotFound-36070]
 	_ = x[codeSyncerReprocessWithSafeModeFail-36071]
+	_ = x[codeSyncerDelayNotEnabled-36072]
+	_ = x[codeSyncerResyncTableUnsupported-36073]
+	_ = x[codeSyncerResyncTableInProgress-36074]
+	_ = x[codeSyncerResyncTableFailed-36075]
+	_ = x[codeSyncerResyncTableDDL-36076]
+	_ = x[codeSyncerUpdateRulesUnsupported-36077]
+	_ = x[codeSyncerUpdateRulesInProgress-36078]
+	_ = x[codeSyncerWriteMQ-36079]
+	_ = x[codeSyncerMQTableInfoNotFound-36080]
+	_ = x[codeSyncerUnsafePartialJSONUpdate-36081]
 	_ = x[codeMasterSQLOpNilRequest-38001]
 	_ = x[codeMasterSQLOpNotSupport-38002]
 	_ = x[codeMasterSQLOpWithoutSharding-38003]
@@ -430,6 +454,10 @@ func _() {
 	_ = x[codeMasterOptimisticDownstreamMetaNotFound-38056]
 	_ = x[codeMasterInvalidClusterID-38057]
 	_ = x[codeMasterStartTask-38058]
+	_ = x[codeMasterConfigRebalanceIntervalParse-38059]
+	_ = x[codeMasterCutoverNotExist-38060]
+	_ = x[codeMasterCutoverInProgress-38061]
+	_ = x[codeMasterCutoverValidation-38062]
 	_ = x[codeWorkerParseFlagSet-40001]
 	_ = x[codeWorkerInvalidFlag-40002]
 	_ = x[codeWorkerDecodeConfigFromFile-40003]
@@ -536,6 +564,7 @@ func _() {
 	_ = x[codeValidatorNotFound-43006]
 	_ = x[codeValidatorPanic-43007]
 	_ = x[codeValidatorTooMuchPending-43008]
+	_ = x[codeValidatorSnapshotCheck-43009]
 	_ = x[codeSchemaTrackerInvalidJSON-44001]
 	_ = x[codeSchemaTrackerCannotCreateSchema-44002]
 	_ = x[codeSchemaTrackerCannotCreateTable-44003]
@@ -599,7 +628,7 @@ func _() {
 	_ = x[codeNotSet-50000]
 }
 
-const _ErrCode_name = "DBDriverErrorDBBadConnDBInvalidConnDBUnExpectDBQueryFailedDBExecuteFailedParseMydumperMetaGetFileSizeDropMultipleTablesRenameMultipleTablesAlterMultipleTablesParseSQLUnknownTypeDDLRestoreASTNodeParseGTIDNotSupportedFlavorNotMySQLGTIDNotMariaDBGTIDNotUUIDStringMariaDBDomainIDInvalidServerIDGetSQLModeFromStrVerifySQLOperateArgsStatFileSizeReaderAlreadyRunningReaderAlreadyStartedReaderStateCannotCloseReaderShouldStartSyncEmptyRelayDirReadDirBaseFileNotFoundBinFileCmpCondNotSupportBinlogFileNotValidBinlogFilesNotFoundGetRelayLogStatAddWatchForRelayLogDirWatcherStartWatcherChanClosedWatcherChanRecvErrorRelayLogFileSizeSmallerBinlogFileNotSpecifiedNoRelayLogMatchPosFirstRelayLogNotMatchPosParserParseRelayLogNoSubdirToSwitchNeedSyncAgainSyncClosedSchemaTableNameNotValidGenTableRouterEncryptSecretKeyNotValidEncryptGenCipherEncryptGenIVCiphertextLenNotValidCiphertextContextNotValidInvalidBinlogPosStrEncCipherTextBase64DecodeBinlogWriteBinaryDataBinlogWriteDataToBufferBinlogHeaderLengthNotValidBinlogEventDecodeBinlogEmptyNextBinNameBinlogParseSIDBinlogEmptyGTIDBinlogGTIDSetNotValidBinlogGTIDMySQLNotValidBinlogGTIDMariaDBNotValidBinlogMariaDBServerIDMismatchBinlogOnlyOneGTIDSupportBinlogOnlyOneIntervalInUUIDBinlogIntervalValueNotValidBinlogEmptyQueryBinlogTableMapEvNotValidBinlogExpectFormatDescEvBinlogExpectTableMapEvBinlogExpectRowsEvBinlogUnexpectedEvBinlogParseSingleEvBinlogEventTypeNotValidBinlogEventNoRowsBinlogEventNoColumnsBinlogEventRowLengthNotEqBinlogColumnTypeNotSupportBinlogGoMySQLTypeNotSupportBinlogColumnTypeMisMatchBinlogDummyEvSizeTooSmallBinlogFlavorNotSupportBinlogDMLEmptyDataBinlogLatestGTIDNotInPrevBinlogReadFileByGTIDBinlogWriterNotStateNewBinlogWriterStateCannotCloseBinlogWriterNeedStartBinlogWriterOpenFileBinlogWriterGetFileStatBinlogWriterWriteDataLenBinlogWriterFileNotOpenedBinlogWriterFileSyncBinlogPrevGTIDEvNotValidBinlogDecodeMySQLGTIDSetBinlogNeedMariaDBGTIDSetBinlogParseMariaDBGTIDSetBinlogMariaDBAddGTIDSetTracingEventDataNotValidTracingUploadDataTracingEventTypeNotValidTracingGetTraceCodeTracingDataChecksumTracingGetTSOBackoffArgsNotValidInitLoggerFailGTIDTruncateInvalidRelayLogGivenPosTooBigElectionCampaignFailElectionGetLeaderIDFailBinlogInvalidFilenameWithUUIDSuffixDecodeEtcdKeyFailShardDDLOptimismTrySyncFailConnInvalidTLSConfigConnRegistryTLSConfigUpgradeVersionEtcdFailInvalidV1WorkerMetaPathFailUpdateV1DBSchemaBinlogStatusVarsParseVerifyHandleErrorArgsRewriteSQLNoUUIDDirMatchGTIDNoRelayPosMatchGTIDReaderReachEndOfFileMetadataNoBinlogLocPreviousGTIDNotExistNoMasterStatusBinlogNotLogColumnShardDDLOptimismNeedSkipAndRedirectShardDDLOptimismAddNotFullyDroppedColumnSyncerCancelledDDLIncorrectReturnColumnsNumConfigCheckItemNotSupportConfigTomlTransformConfigYamlTransformConfigTaskNameEmptyConfigEmptySourceIDConfigTooLongSourceIDConfigOnlineSchemeNotSupportConfigInvalidTimezoneConfigParseFlagSetConfigDecryptDBPasswordConfigMetaInvalidConfigMySQLInstNotFoundConfigMySQLInstsAtLeastOneConfigMySQLInstSameSourceIDConfigMydumperCfgConflictConfigLoaderCfgConflictConfigSyncerCfgConflictConfigReadCfgFromFileConfigNeedUniqueTaskNameConfigInvalidTaskModeConfigNeedTargetDBConfigMetadataNotSetConfigRouteRuleNotFoundConfigFilterRuleNotFoundConfigColumnMappingNotFoundConfigBAListNotFoundConfigMydumperCfgNotFoundConfigMydumperPathNotValidConfigLoaderCfgNotFoundConfigSyncerCfgNotFoundConfigSourceIDNotFoundConfigDuplicateCfgItemConfigShardModeNotSupportConfigMoreThanOneConfigEtcdParseConfigMissingForBoundConfigBinlogEventFilterConfigGlobalConfigsUnusedConfigExprFilterManyExprConfigExprFilterNotFoundConfigExprFilterWrongGrammarConfigExprFilterEmptyNameConfigCheckerMaxTooSmallConfigGenBAListConfigGenTableRouterConfigGenColumnMappingConfigInvalidChunkFileSizeConfigOnlineDDLInvalidRegexConfigOnlineDDLMistakeRegexConfigOpenAPITaskConfigExistConfigOpenAPITaskConfigNotExistCollationCompatibleNotSupportConfigInvalidLoadModeConfigInvalidLoadDuplicateResolutionConfigValidationModeContinuousValidatorCfgNotFoundConfigStartTimeTooLateConfigLoaderDirInvalidConfigLoaderS3NotSupportConfigInvalidSafeModeDurationConfigConfictSafeModeDurationAndSafeModeConfigInvalidLoadPhysicalDuplicateResolutionConfigInvalidLoadPhysicalChecksumConfigColumnMappingDeprecatedConfigInvalidLoadAnalyzeConfigStrictOptimisticShardModeConfigSecretKeyPathBinlogExtractPositionBinlogInvalidFilenameBinlogParsePosFromStrCheckpointInvalidTaskModeCheckpointSaveInvalidPosCheckpointInvalidTableFileCheckpointDBNotExistInFileCheckpointTableNotExistInFileCheckpointRestoreCountGreaterTaskCheckSameTableNameTaskCheckFailedOpenDBTaskCheckGenTableRouterTaskCheckGenColumnMappingTaskCheckSyncConfigErrorTaskCheckGenBAListSourceCheckGTIDRelayParseUUIDIndexRelayParseUUIDSuffixRelayUUIDWithSuffixNotFoundRelayGenFakeRotateEventRelayNoValidRelaySubDirRelayUUIDSuffixNotValidRelayUUIDSuffixLessThanPrevRelayLoadMetaDataRelayBinlogNameNotValidRelayNoCurrentUUIDRelayFlushLocalMetaRelayUpdateIndexFileRelayLogDirpathEmptyRelayReaderNotStateNewRelayReaderStateCannotCloseRelayReaderNeedStartRelayTCPReaderStartSyncRelayTCPReaderNilGTIDRelayTCPReaderStartSyncGTIDRelayTCPReaderGetEventRelayWriterNotStateNewRelayWriterStateCannotCloseRelayWriterNeedStartRelayWriterNotOpenedRelayWriterExpectRotateEvRelayWriterRotateEvWithNoWriterRelayWriterStatusNotValidRelayWriterGetFileStatRelayWriterLatestPosGTFileSizeRelayWriterFileOperateRelayCheckBinlogFileHeaderExistRelayCheckFormatDescEventExistRelayCheckFormatDescEventParseEvRelayCheckIsDuplicateEventRelayUpdateGTIDRelayNeedPrevGTIDEvBeforeGTIDEvRelayNeedMaGTIDListEvBeforeGTIDEvRelayMkdirRelaySwitchMasterNeedGTIDRelayThisStrategyIsPurgingRelayOtherStrategyIsPurgingRelayPurgeIsForbiddenRelayNoActiveRelayLogRelayPurgeRequestNotValidRelayTrimUUIDNotFoundRelayRemoveFileFailRelayPurgeArgsNotValidPreviousGTIDsNotValidRotateEventWithDifferentServerIDDumpUnitRuntimeDumpUnitGenTableRouterDumpUnitGenBAListDumpUnitGlobalLockLoadUnitCreateSchemaFileLoadUnitInvalidFileEndingLoadUnitParseQuoteValuesLoadUnitDoColumnMappingLoadUnitReadSchemaFileLoadUnitParseStatementLoadUnitNotCreateTableLoadUnitDispatchSQLFromFileLoadUnitInvalidInsertSQLLoadUnitGenTableRouterLoadUnitGenColumnMappingLoadUnitNoDBFileLoadUnitNoTableFileLoadUnitDumpDirNotFoundLoadUnitDuplicateTableFileLoadUnitGenBAListLoadTaskWorkerNotMatchLoadCheckPointNotMatchLoadLightningRuntimeLoadLightningHasDupLoadLightningChecksumSyncerUnitPanicSyncUnitInvalidTableNameSyncUnitTableNameQuerySyncUnitNotSupportedDMLSyncUnitAddTableInShardingSyncUnitDropSchemaTableInShardingSyncUnitInvalidShardMetaSyncUnitDDLWrongSequenceSyncUnitDDLActiveIndexLargerSyncUnitDupTableGroupSyncUnitShardingGroupNotFoundSyncUnitSafeModeSetCountSyncUnitCausalityConflictSyncUnitDMLStatementFoundSyncerUnitBinlogEventFilterSyncerUnitInvalidReplicaEventSyncerUnitParseStmtSyncerUnitUUIDNotLatestSyncerUnitDDLExecChanCloseOrBusySyncerUnitDDLChanDoneSyncerUnitDDLChanCanceledSyncerUnitDDLOnMultipleTableSyncerUnitInjectDDLOnlySyncerUnitInjectDDLWithoutSchemaSyncerUnitNotSupportedOperateSyncerUnitNilOperatorReqSyncerUnitDMLColumnNotMatchSyncerUnitDMLOldNewValueMismatchSyncerUnitDMLPruneColumnMismatchSyncerUnitGenBinlogEventFilterSyncerUnitGenTableRouterSyncerUnitGenColumnMappingSyncerUnitDoColumnMappingSyncerUnitCacheKeyNotFoundSyncerUnitHeartbeatCheckConfigSyncerUnitHeartbeatRecordExistsSyncerUnitHeartbeatRecordNotFoundSyncerUnitHeartbeatRecordNotValidSyncerUnitOnlineDDLInvalidMetaSyncerUnitOnlineDDLSchemeNotSupportSyncerUnitOnlineDDLOnMultipleTableSyncerUnitGhostApplyEmptyTableSyncerUnitGhostRenameTableNotValidSyncerUnitGhostRenameToGhostTableSyncerUnitGhostRenameGhostTblToOtherSyncerUnitGhostOnlineDDLOnGhostTblSyncerUnitPTApplyEmptyTableSyncerUnitPTRenameTableNotValidSyncerUnitPTRenameToPTTableSyncerUnitPTRenamePTTblToOtherSyncerUnitPTOnlineDDLOnPTTblSyncerUnitRemoteSteamerWithGTIDSyncerUnitRemoteSteamerStartSyncSyncerUnitGetTableFromDBSyncerUnitFirstEndPosNotFoundSyncerUnitResolveCasualityFailSyncerUnitReopenStreamNotSupportSyncerUnitUpdateConfigInShardingSyncerUnitExecWithNoBlockingDDLSyncerUnitGenBAListSyncerUnitHandleDDLFailedSyncerShardDDLConflictSyncerFailpointSyncerEventSyncerOperatorNotExistSyncerEventNotExistSyncerParseDDLSyncerUnsupportedStmtSyncerGetEventSyncerDownstreamTableNotFoundSyncerReprocessWithSafeModeFailMasterSQLOpNilRequestMasterSQLOpNotSupportMasterSQLOpWithoutShardingMasterGRPCCreateConnMasterGRPCSendOnCloseConnMasterGRPCClientCloseMasterGRPCInvalidReqTypeMasterGRPCRequestErrorMasterDeployMapperVerifyMasterConfigParseFlagSetMasterConfigUnknownItemMasterConfigInvalidFlagMasterConfigTomlTransformMasterConfigTimeoutParseMasterConfigUpdateCfgFileMasterShardingDDLDiffMasterStartServiceMasterNoEmitTokenMasterLockNotFoundMasterLockIsResolvingMasterWorkerCliNotFoundMasterWorkerNotWaitLockMasterHandleSQLReqFailMasterOwnerExecDDLMasterPartWorkerExecDDLFailMasterWorkerExistDDLLockMasterGetWorkerCfgExtractorMasterTaskConfigExtractorMasterWorkerArgsExtractorMasterQueryWorkerConfigMasterOperNotFoundMasterOperRespNotSuccessMasterOperRequestTimeoutMasterHandleHTTPApisMasterHostPortNotValidMasterGetHostnameFailMasterGenEmbedEtcdConfigFailMasterStartEmbedEtcdFailMasterParseURLFailMasterJoinEmbedEtcdFailMasterInvalidOperateOpMasterAdvertiseAddrNotValidMasterRequestIsNotForwardToLeaderMasterIsNotAsyncRequestMasterFailToGetExpectResultMasterPessimistNotStartedMasterOptimistNotStartedMasterMasterNameNotExistMasterInvalidOfflineTypeMasterAdvertisePeerURLsNotValidMasterTLSConfigNotValidMasterBoundChangingMasterFailToImportFromV10xMasterInconsistentOptimistDDLsAndInfoMasterOptimisticTableInfobeforeNotExistMasterOptimisticDownstreamMetaNotFoundMasterInvalidClusterIDMasterStartTaskWorkerParseFlagSetWorkerInvalidFlagWorkerDecodeConfigFromFileWorkerUndecodedItemFromFileWorkerNeedSourceIDWorkerTooLongSourceIDWorkerRelayBinlogNameWorkerWriteConfigFileWorkerLogInvalidHandlerWorkerLogPointerInvalidWorkerLogFetchPointerWorkerLogUnmarshalPointerWorkerLogClearPointerWorkerLogTaskKeyNotValidWorkerLogUnmarshalTaskKeyWorkerLogFetchLogIterWorkerLogGetTaskLogWorkerLogUnmarshalBinaryWorkerLogForwardPointerWorkerLogMarshalTaskWorkerLogSaveTaskWorkerLogDeleteKVWorkerLogDeleteKVIterWorkerLogUnmarshalTaskMetaWorkerLogFetchTaskFromMetaWorkerLogVerifyTaskMetaWorkerLogSaveTaskMetaWorkerLogGetTaskMetaWorkerLogDeleteTaskMetaWorkerMetaTomlTransformWorkerMetaOldFileStatWorkerMetaOldReadFileWorkerMetaEncodeTaskWorkerMetaRemoveOldDirWorkerMetaTaskLogNotFoundWorkerMetaHandleTaskOrderWorkerMetaOpenTxnWorkerMetaCommitTxnWorkerRelayStageNotValidWorkerRelayOperNotSupportWorkerOpenKVDBFileWorkerUpgradeCheckKVDirWorkerMarshalVerBinaryWorkerUnmarshalVerBinaryWorkerGetVersionFromKVWorkerSaveVersionToKVWorkerVerAutoDowngradeWorkerStartServiceWorkerAlreadyClosedWorkerNotRunningStageWorkerNotPausedStageWorkerUpdateTaskStageWorkerMigrateStopRelayWorkerSubTaskNotFoundWorkerSubTaskExistsWorkerOperSyncUnitOnlyWorkerRelayUnitStageWorkerNoSyncerRunningWorkerCannotUpdateSourceIDWorkerNoAvailUnitsWorkerDDLLockInfoNotFoundWorkerDDLLockInfoExistsWorkerCacheDDLInfoExistsWorkerExecSkipDDLConflictWorkerExecDDLSyncerOnlyWorkerExecDDLTimeoutWorkerWaitRelayCatchupTimeoutWorkerRelayIsPurgingWorkerHostPortNotValidWorkerNoStartWorkerAlreadyStartedWorkerSourceNotMatchWorkerFailToGetSubtaskConfigFromEtcdWorkerFailToGetSourceConfigFromEtcdWorkerDDLLockOpNotFoundWorkerTLSConfigNotValidWorkerFailConnectMasterWorkerWaitRelayCatchupGTIDWorkerRelayConfigChangingWorkerRouteTableDupMatchWorkerUpdateSubTaskConfigWorkerValidatorNotPausedWorkerServerClosedTracerParseFlagSetTracerConfigTomlTransformTracerConfigInvalidFlagTracerTraceEventNotFoundTracerTraceIDNotProvidedTracerParamNotValidTracerPostMethodOnlyTracerEventAssertionFailTracerEventTypeNotValidTracerStartServiceHAFailTxnOperationHAInvalidItemHAFailWatchEtcdHAFailLeaseOperationHAFailKeepaliveValidatorLoadPersistedDataValidatorPersistDataValidatorGetEventValidatorProcessRowEventValidatorValidateChangeValidatorNotFoundValidatorPanicValidatorTooMuchPendingSchemaTrackerInvalidJSONSchemaTrackerCannotCreateSchemaSchemaTrackerCannotCreateTableSchemaTrackerCannotSerializeSchemaTrackerCannotGetTableSchemaTrackerCannotExecDDLSchemaTrackerCannotFetchDownstreamTableSchemaTrackerCannotParseDownstreamTableSchemaTrackerInvalidCreateTableStmtSchemaTrackerRestoreStmtFailSchemaTrackerCannotDropTableSchemaTrackerInitSchemaTrackerMarshalJSONSchemaTrackerUnMarshalJSONSchemaTrackerUnSchemaNotExistSchemaTrackerCannotSetDownstreamSQLModeSchemaTrackerCannotInitDownstreamParserSchemaTrackerCannotMockDownstreamTableSchemaTrackerCannotFetchDownstreamCreateTableStmtSchemaTrackerIsClosedSchedulerNotStartedSchedulerStartedSchedulerWorkerExistSchedulerWorkerNotExistSchedulerWorkerOnlineSchedulerWorkerInvalidTransSchedulerSourceCfgExistSchedulerSourceCfgNotExistSchedulerSourcesUnboundSchedulerSourceOpTaskExistSchedulerRelayStageInvalidUpdateSchedulerRelayStageSourceNotExistSchedulerMultiTaskSchedulerSubTaskExistSchedulerSubTaskStageInvalidUpdateSchedulerSubTaskOpTaskNotExistSchedulerSubTaskOpSourceNotExistSchedulerTaskNotExistSchedulerRequireRunningTaskInSyncUnitSchedulerRelayWorkersBusySchedulerRelayWorkersBoundSchedulerRelayWorkersWrongRelaySchedulerSourceOpRelayExistSchedulerLatchInUseSchedulerSourceCfgUpdateSchedulerWrongWorkerInputSchedulerCantTransferToRelayWorkerSchedulerStartRelayOnSpecifiedSchedulerStopRelayOnSpecifiedSchedulerStartRelayOnBoundSchedulerStopRelayOnBoundSchedulerPauseTaskForTransferSourceSchedulerWorkerNotFreeSchedulerSubTaskNotExistSchedulerSubTaskCfgUpdateCtlGRPCCreateConnCtlInvalidTLSCfgCtlLoadTLSCfgOpenAPICommonOpenAPITaskSourceNotFoundNotSet"
+const _ErrCode_name = "DBDriverErrorDBBadConnDBInvalidConnDBUnExpectDBQueryFailedDBExecuteFailedParseMydumperMetaGetFileSizeDropMultipleTablesRenameMultipleTablesAlterMultipleTablesParseSQLUnknownTypeDDLRestoreASTNodeParseGTIDNotSupportedFlavorNotMySQLGTIDNotMariaDBGTIDNotUUIDStringMariaDBDomainIDInvalidServerIDGetSQLModeFromStrVerifySQLOperateArgsStatFileSizeReaderAlreadyRunningReaderAlreadyStartedReaderStateCannotCloseReaderShouldStartSyncEmptyRelayDirReadDirBaseFileNotFoundBinFileCmpCondNotSupportBinlogFileNotValidBinlogFilesNotFoundGetRelayLogStatAddWatchForRelayLogDirWatcherStartWatcherChanClosedWatcherChanRecvErrorRelayLogFileSizeSmallerBinlogFileNotSpecifiedNoRelayLogMatchPosFirstRelayLogNotMatchPosParserParseRelayLogNoSubdirToSwitchNeedSyncAgainSyncClosedSchemaTableNameNotValidGenTableRouterEncryptSecretKeyNotValidEncryptGenCipherEncryptGenIVCiphertextLenNotValidCiphertextContextNotValidInvalidBinlogPosStrEncCipherTextBase64DecodeBinlogWriteBinaryDataBinlogWriteDataToBufferBinlogHeaderLengthNotValidBinlogEventDecodeBinlogEmptyNextBinNameBinlogParseSIDBinlogEmptyGTIDBinlogGTIDSetNotValidBinlogGTIDMySQLNotValidBinlogGTIDMariaDBNotValidBinlogMariaDBServerIDMismatchBinlogOnlyOneGTIDSupportBinlogOnlyOneIntervalInUUIDBinlogIntervalValueNotValidBinlogEmptyQueryBinlogTableMapEvNotValidBinlogExpectFormatDescEvBinlogExpectTableMapEvBinlogExpectRowsEvBinlogUnexpectedEvBinlogParseSingleEvBinlogEventTypeNotValidBinlogEventNoRowsBinlogEventNoColumnsBinlogEventRowLengthNotEqBinlogColumnTypeNotSupportBinlogGoMySQLTypeNotSupportBinlogColumnTypeMisMatchBinlogDummyEvSizeTooSmallBinlogFlavorNotSupportBinlogDMLEmptyDataBinlogLatestGTIDNotInPrevBinlogReadFileByGTIDBinlogWriterNotStateNewBinlogWriterStateCannotCloseBinlogWriterNeedStartBinlogWriterOpenFileBinlogWriterGetFileStatBinlogWriterWriteDataLenBinlogWriterFileNotOpenedBinlogWriterFileSyncBinlogPrevGTIDEvNotValidBinlogDecodeMySQLGTIDSetBinlogNeedMariaDBGTIDSetBinlogParseMariaDBGTIDSetBinlogMariaDBAddGTIDSetTracingEventDataNotValidTracingUploadDataTracingEventTypeNotValidTracingGetTraceCodeTracingDataChecksumTracingGetTSOBackoffArgsNotValidInitLoggerFailGTIDTruncateInvalidRelayLogGivenPosTooBigElectionCampaignFailElectionGetLeaderIDFailBinlogInvalidFilenameWithUUIDSuffixDecodeEtcdKeyFailShardDDLOptimismTrySyncFailConnInvalidTLSConfigConnRegistryTLSConfigUpgradeVersionEtcdFailInvalidV1WorkerMetaPathFailUpdateV1DBSchemaBinlogStatusVarsParseVerifyHandleErrorArgsRewriteSQLNoUUIDDirMatchGTIDNoRelayPosMatchGTIDReaderReachEndOfFileMetadataNoBinlogLocPreviousGTIDNotExistNoMasterStatusBinlogNotLogColumnShardDDLOptimismNeedSkipAndRedirectShardDDLOptimismAddNotFullyDroppedColumnSyncerCancelledDDLIncorrectReturnColumnsNumConfigCheckItemNotSupportConfigTomlTransformConfigYamlTransformConfigTaskNameEmptyConfigEmptySourceIDConfigTooLongSourceIDConfigOnlineSchemeNotSupportConfigInvalidTimezoneConfigParseFlagSetConfigDecryptDBPasswordConfigMetaInvalidConfigMySQLInstNotFoundConfigMySQLInstsAtLeastOneConfigMySQLInstSameSourceIDConfigMydumperCfgConflictConfigLoaderCfgConflictConfigSyncerCfgConflictConfigReadCfgFromFileConfigNeedUniqueTaskNameConfigInvalidTaskModeConfigNeedTargetDBConfigMetadataNotSetConfigRouteRuleNotFoundConfigFilterRuleNotFoundConfigColumnMappingNotFoundConfigBAListNotFoundConfigMydumperCfgNotFoundConfigMydumperPathNotValidConfigLoaderCfgNotFoundConfigSyncerCfgNotFoundConfigSourceIDNotFoundConfigDuplicateCfgItemConfigShardModeNotSupportConfigMoreThanOneConfigEtcdParseConfigMissingForBoundConfigBinlogEventFilterConfigGlobalConfigsUnusedConfigExprFilterManyExprConfigExprFilterNotFoundConfigExprFilterWrongGrammarConfigExprFilterEmptyNameConfigCheckerMaxTooSmallConfigGenBAListConfigGenTableRouterConfigGenColumnMappingConfigInvalidChunkFileSizeConfigOnlineDDLInvalidRegexConfigOnlineDDLMistakeRegexConfigOpenAPITaskConfigExistConfigOpenAPITaskConfigNotExistCollationCompatibleNotSupportConfigInvalidLoadModeConfigInvalidLoadDuplicateResolutionConfigValidationModeContinuousValidatorCfgNotFoundConfigStartTimeTooLateConfigLoaderDirInvalidConfigLoaderS3NotSupportConfigInvalidSafeModeDurationConfigConfictSafeModeDurationAndSafeModeConfigInvalidLoadPhysicalDuplicateResolutionConfigInvalidLoadPhysicalChecksumConfigColumnMappingDeprecatedConfigInvalidLoadAnalyzeConfigStrictOptimisticShardModeConfigSecretKeyPathConfigInvalidSyncerDelayConfigInvalidRelayArchiveStorageConfigInvalidThrottleConfigInvalidConflictRuleConfigInvalidColumnTransformConfigInvalidPlacementLabelConfigInvalidTargetMQConfigInvalidSchemaDriftCheckIntervalConfigInvalidTaskScheduleConfigInvalidShardAutoResolveBinlogExtractPositionBinlogInvalidFilenameBinlogParsePosFromStrCheckpointInvalidTaskModeCheckpointSaveInvalidPosCheckpointInvalidTableFileCheckpointDBNotExistInFileCheckpointTableNotExistInFileCheckpointRestoreCountGreaterTaskCheckSameTableNameTaskCheckFailedOpenDBTaskCheckGenTableRouterTaskCheckGenColumnMappingTaskCheckSyncConfigErrorTaskCheckGenBAListSourceCheckGTIDRelayParseUUIDIndexRelayParseUUIDSuffixRelayUUIDWithSuffixNotFoundRelayGenFakeRotateEventRelayNoValidRelaySubDirRelayUUIDSuffixNotValidRelayUUIDSuffixLessThanPrevRelayLoadMetaDataRelayBinlogNameNotValidRelayNoCurrentUUIDRelayFlushLocalMetaRelayUpdateIndexFileRelayLogDirpathEmptyRelayReaderNotStateNewRelayReaderStateCannotCloseRelayReaderNeedStartRelayTCPReaderStartSyncRelayTCPReaderNilGTIDRelayTCPReaderStartSyncGTIDRelayTCPReaderGetEventRelayWriterNotStateNewRelayWriterStateCannotCloseRelayWriterNeedStartRelayWriterNotOpenedRelayWriterExpectRotateEvRelayWriterRotateEvWithNoWriterRelayWriterStatusNotValidRelayWriterGetFileStatRelayWriterLatestPosGTFileSizeRelayWriterFileOperateRelayCheckBinlogFileHeaderExistRelayCheckFormatDescEventExistRelayCheckFormatDescEventParseEvRelayCheckIsDuplicateEventRelayUpdateGTIDRelayNeedPrevGTIDEvBeforeGTIDEvRelayNeedMaGTIDListEvBeforeGTIDEvRelayMkdirRelaySwitchMasterNeedGTIDRelayThisStrategyIsPurgingRelayOtherStrategyIsPurgingRelayPurgeIsForbiddenRelayNoActiveRelayLogRelayPurgeRequestNotValidRelayTrimUUIDNotFoundRelayRemoveFileFailRelayPurgeArgsNotValidPreviousGTIDsNotValidRotateEventWithDifferentServerIDRelayArchiveFileRelayRestoreArchivedFileRelayIndexReadRelayIndexWriteDumpUnitRuntimeDumpUnitGenTableRouterDumpUnitGenBAListDumpUnitGlobalLockLoadUnitCreateSchemaFileLoadUnitInvalidFileEndingLoadUnitParseQuoteValuesLoadUnitDoColumnMappingLoadUnitReadSchemaFileLoadUnitParseStatementLoadUnitNotCreateTableLoadUnitDispatchSQLFromFileLoadUnitInvalidInsertSQLLoadUnitGenTableRouterLoadUnitGenColumnMappingLoadUnitNoDBFileLoadUnitNoTableFileLoadUnitDumpDirNotFoundLoadUnitDuplicateTableFileLoadUnitGenBAListLoadTaskWorkerNotMatchLoadCheckPointNotMatchLoadLightningRuntimeLoadLightningHasDupLoadLightningChecksumSyncerUnitPanicSyncUnitInvalidTableNameSyncUnitTableNameQuerySyncUnitNotSupportedDMLSyncUnitAddTableInShardingSyncUnitDropSchemaTableInShardingSyncUnitInvalidShardMetaSyncUnitDDLWrongSequenceSyncUnitDDLActiveIndexLargerSyncUnitDupTableGroupSyncUnitShardingGroupNotFoundSyncUnitSafeModeSetCountSyncUnitCausalityConflictSyncUnitDMLStatementFoundSyncerUnitBinlogEventFilterSyncerUnitInvalidReplicaEventSyncerUnitParseStmtSyncerUnitUUIDNotLatestSyncerUnitDDLExecChanCloseOrBusySyncerUnitDDLChanDoneSyncerUnitDDLChanCanceledSyncerUnitDDLOnMultipleTableSyncerUnitInjectDDLOnlySyncerUnitInjectDDLWithoutSchemaSyncerUnitNotSupportedOperateSyncerUnitNilOperatorReqSyncerUnitDMLColumnNotMatchSyncerUnitDMLOldNewValueMismatchSyncerUnitDMLPruneColumnMismatchSyncerUnitGenBinlogEventFilterSyncerUnitGenTableRouterSyncerUnitGenColumnMappingSyncerUnitDoColumnMappingSyncerUnitCacheKeyNotFoundSyncerUnitHeartbeatCheckConfigSyncerUnitHeartbeatRecordExistsSyncerUnitHeartbeatRecordNotFoundSyncerUnitHeartbeatRecordNotValidSyncerUnitOnlineDDLInvalidMetaSyncerUnitOnlineDDLSchemeNotSupportSyncerUnitOnlineDDLOnMultipleTableSyncerUnitGhostApplyEmptyTableSyncerUnitGhostRenameTableNotValidSyncerUnitGhostRenameToGhostTableSyncerUnitGhostRenameGhostTblToOtherSyncerUnitGhostOnlineDDLOnGhostTblSyncerUnitPTApplyEmptyTableSyncerUnitPTRenameTableNotValidSyncerUnitPTRenameToPTTableSyncerUnitPTRenamePTTblToOtherSyncerUnitPTOnlineDDLOnPTTblSyncerUnitRemoteSteamerWithGTIDSyncerUnitRemoteSteamerStartSyncSyncerUnitGetTableFromDBSyncerUnitFirstEndPosNotFoundSyncerUnitResolveCasualityFailSyncerUnitReopenStreamNotSupportSyncerUnitUpdateConfigInShardingSyncerUnitExecWithNoBlockingDDLSyncerUnitGenBAListSyncerUnitHandleDDLFailedSyncerShardDDLConflictSyncerFailpointSyncerEventSyncerOperatorNotExistSyncerEventNotExistSyncerParseDDLSyncerUnsupportedStmtSyncerGetEventSyncerDownstreamTableNotFoundSyncerReprocessWithSafeModeFailSyncerDelayNotEnabledSyncerResyncTableUnsupportedSyncerResyncTableInProgressSyncerResyncTableFailedSyncerResyncTableDDLSyncerUpdateRulesUnsupportedSyncerUpdateRulesInProgressSyncerWriteMQSyncerMQTableInfoNotFoundSyncerUnsafePartialJSONUpdateMasterSQLOpNilRequestMasterSQLOpNotSupportMasterSQLOpWithoutShardingMasterGRPCCreateConnMasterGRPCSendOnCloseConnMasterGRPCClientCloseMasterGRPCInvalidReqTypeMasterGRPCRequestErrorMasterDeployMapperVerifyMasterConfigParseFlagSetMasterConfigUnknownItemMasterConfigInvalidFlagMasterConfigTomlTransformMasterConfigTimeoutParseMasterConfigUpdateCfgFileMasterShardingDDLDiffMasterStartServiceMasterNoEmitTokenMasterLockNotFoundMasterLockIsResolvingMasterWorkerCliNotFoundMasterWorkerNotWaitLockMasterHandleSQLReqFailMasterOwnerExecDDLMasterPartWorkerExecDDLFailMasterWorkerExistDDLLockMasterGetWorkerCfgExtractorMasterTaskConfigExtractorMasterWorkerArgsExtractorMasterQueryWorkerConfigMasterOperNotFoundMasterOperRespNotSuccessMasterOperRequestTimeoutMasterHandleHTTPApisMasterHostPortNotValidMasterGetHostnameFailMasterGenEmbedEtcdConfigFailMasterStartEmbedEtcdFailMasterParseURLFailMasterJoinEmbedEtcdFailMasterInvalidOperateOpMasterAdvertiseAddrNotValidMasterRequestIsNotForwardToLeaderMasterIsNotAsyncRequestMasterFailToGetExpectResultMasterPessimistNotStartedMasterOptimistNotStartedMasterMasterNameNotExistMasterInvalidOfflineTypeMasterAdvertisePeerURLsNotValidMasterTLSConfigNotValidMasterBoundChangingMasterFailToImportFromV10xMasterInconsistentOptimistDDLsAndInfoMasterOptimisticTableInfobeforeNotExistMasterOptimisticDownstreamMetaNotFoundMasterInvalidClusterIDMasterStartTaskMasterConfigRebalanceIntervalParseMasterCutoverNotExistMasterCutoverInProgressMasterCutoverValidationWorkerParseFlagSetWorkerInvalidFlagWorkerDecodeConfigFromFileWorkerUndecodedItemFromFileWorkerNeedSourceIDWorkerTooLongSourceIDWorkerRelayBinlogNameWorkerWriteConfigFileWorkerLogInvalidHandlerWorkerLogPointerInvalidWorkerLogFetchPointerWorkerLogUnmarshalPointerWorkerLogClearPointerWorkerLogTaskKeyNotValidWorkerLogUnmarshalTaskKeyWorkerLogFetchLogIterWorkerLogGetTaskLogWorkerLogUnmarshalBinaryWorkerLogForwardPointerWorkerLogMarshalTaskWorkerLogSaveTaskWorkerLogDeleteKVWorkerLogDeleteKVIterWorkerLogUnmarshalTaskMetaWorkerLogFetchTaskFromMetaWorkerLogVerifyTaskMetaWorkerLogSaveTaskMetaWorkerLogGetTaskMetaWorkerLogDeleteTaskMetaWorkerMetaTomlTransformWorkerMetaOldFileStatWorkerMetaOldReadFileWorkerMetaEncodeTaskWorkerMetaRemoveOldDirWorkerMetaTaskLogNotFoundWorkerMetaHandleTaskOrderWorkerMetaOpenTxnWorkerMetaCommitTxnWorkerRelayStageNotValidWorkerRelayOperNotSupportWorkerOpenKVDBFileWorkerUpgradeCheckKVDirWorkerMarshalVerBinaryWorkerUnmarshalVerBinaryWorkerGetVersionFromKVWorkerSaveVersionToKVWorkerVerAutoDowngradeWorkerStartServiceWorkerAlreadyClosedWorkerNotRunningStageWorkerNotPausedStageWorkerUpdateTaskStageWorkerMigrateStopRelayWorkerSubTaskNotFoundWorkerSubTaskExistsWorkerOperSyncUnitOnlyWorkerRelayUnitStageWorkerNoSyncerRunningWorkerCannotUpdateSourceIDWorkerNoAvailUnitsWorkerDDLLockInfoNotFoundWorkerDDLLockInfoExistsWorkerCacheDDLInfoExistsWorkerExecSkipDDLConflictWorkerExecDDLSyncerOnlyWorkerExecDDLTimeoutWorkerWaitRelayCatchupTimeoutWorkerRelayIsPurgingWorkerHostPortNotValidWorkerNoStartWorkerAlreadyStartedWorkerSourceNotMatchWorkerFailToGetSubtaskConfigFromEtcdWorkerFailToGetSourceConfigFromEtcdWorkerDDLLockOpNotFoundWorkerTLSConfigNotValidWorkerFailConnectMasterWorkerWaitRelayCatchupGTIDWorkerRelayConfigChangingWorkerRouteTableDupMatchWorkerUpdateSubTaskConfigWorkerValidatorNotPausedWorkerServerClosedTracerParseFlagSetTracerConfigTomlTransformTracerConfigInvalidFlagTracerTraceEventNotFoundTracerTraceIDNotProvidedTracerParamNotValidTracerPostMethodOnlyTracerEventAssertionFailTracerEventTypeNotValidTracerStartServiceHAFailTxnOperationHAInvalidItemHAFailWatchEtcdHAFailLeaseOperationHAFailKeepaliveValidatorLoadPersistedDataValidatorPersistDataValidatorGetEventValidatorProcessRowEventValidatorValidateChangeValidatorNotFoundValidatorPanicValidatorTooMuchPendingValidatorSnapshotCheckSchemaTrackerInvalidJSONSchemaTrackerCannotCreateSchemaSchemaTrackerCannotCreateTableSchemaTrackerCannotSerializeSchemaTrackerCannotGetTableSchemaTrackerCannotExecDDLSchemaTrackerCannotFetchDownstreamTableSchemaTrackerCannotParseDownstreamTableSchemaTrackerInvalidCreateTableStmtSchemaTrackerRestoreStmtFailSchemaTrackerCannotDropTableSchemaTrackerInitSchemaTrackerMarshalJSONSchemaTrackerUnMarshalJSONSchemaTrackerUnSchemaNotExistSchemaTrackerCannotSetDownstreamSQLModeSchemaTrackerCannotInitDownstreamParserSchemaTrackerCannotMockDownstreamTableSchemaTrackerCannotFetchDownstreamCreateTableStmtSchemaTrackerIsClosedSchedulerNotStartedSchedulerStartedSchedulerWorkerExistSchedulerWorkerNotExistSchedulerWorkerOnlineSchedulerWorkerInvalidTransSchedulerSourceCfgExistSchedulerSourceCfgNotExistSchedulerSourcesUnboundSchedulerSourceOpTaskExistSchedulerRelayStageInvalidUpdateSchedulerRelayStageSourceNotExistSchedulerMultiTaskSchedulerSubTaskExistSchedulerSubTaskStageInvalidUpdateSchedulerSubTaskOpTaskNotExistSchedulerSubTaskOpSourceNotExistSchedulerTaskNotExistSchedulerRequireRunningTaskInSyncUnitSchedulerRelayWorkersBusySchedulerRelayWorkersBoundSchedulerRelayWorkersWrongRelaySchedulerSourceOpRelayExistSchedulerLatchInUseSchedulerSourceCfgUpdateSchedulerWrongWorkerInputSchedulerCantTransferToRelayWorkerSchedulerStartRelayOnSpecifiedSchedulerStopRelayOnSpecifiedSchedulerStartRelayOnBoundSchedulerStopRelayOnBoundSchedulerPauseTaskForTransferSourceSchedulerWorkerNotFreeSchedulerSubTaskNotExistSchedulerSubTaskCfgUpdateCtlGRPCCreateConnCtlInvalidTLSCfgCtlLoadTLSCfgOpenAPICommonOpenAPITaskSourceNotFoundNotSet"
 
 var _ErrCode_map = map[ErrCode]string{
 	10001: _ErrCode_name[0:13],
@@ -805,392 +834,421 @@ var _ErrCode_map = map[ErrCode]string{
 	20065: _ErrCode_name[4217:4241],
 	20066: _ErrCode_name[4241:4272],
 	20067: _ErrCode_name[4272:4291],
-	22001: _ErrCode_name[4291:4312],
-	22002: _ErrCode_name[4312:4333],
-	22003: _ErrCode_name[4333:4354],
-	24001: _ErrCode_name[4354:4379],
-	24002: _ErrCode_name[4379:4403],
-	24003: _ErrCode_name[4403:4429],
-	24004: _ErrCode_name[4429:4455],
-	24005: _ErrCode_name[4455:4484],
-	24006: _ErrCode_name[4484:4513],
-	26001: _ErrCode_name[4513:4535],
-	26002: _ErrCode_name[4535:4556],
-	26003: _ErrCode_name[4556:4579],
-	26004: _ErrCode_name[4579:4604],
-	26005: _ErrCode_name[4604:4628],
-	26006: _ErrCode_name[4628:4646],
-	26007: _ErrCode_name[4646:4661],
-	28001: _ErrCode_name[4661:4680],
-	28002: _ErrCode_name[4680:4700],
-	28003: _ErrCode_name[4700:4727],
-	28004: _ErrCode_name[4727:4750],
-	28005: _ErrCode_name[4750:4773],
-	30001: _ErrCode_name[4773:4796],
-	30002: _ErrCode_name[4796:4823],
-	30003: _ErrCode_name[4823:4840],
-	30004: _ErrCode_name[4840:4863],
-	30005: _ErrCode_name[4863:4881],
-	30006: _ErrCode_name[4881:4900],
-	30007: _ErrCode_name[4900:4920],
-	30008: _ErrCode_name[4920:4940],
-	30009: _ErrCode_name[4940:4962],
-	30010: _ErrCode_name[4962:4989],
-	30011: _ErrCode_name[4989:5009],
-	30012: _ErrCode_name[5009:5032],
-	30013: _ErrCode_name[5032:5053],
-	30014: _ErrCode_name[5053:5080],
-	30015: _ErrCode_name[5080:5102],
-	30016: _ErrCode_name[5102:5124],
-	30017: _ErrCode_name[5124:5151],
-	30018: _ErrCode_name[5151:5171],
-	30019: _ErrCode_name[5171:5191],
-	30020: _ErrCode_name[5191:5216],
-	30021: _ErrCode_name[5216:5247],
-	30022: _ErrCode_name[5247:5272],
-	30023: _ErrCode_name[5272:5294],
-	30024: _ErrCode_name[5294:5324],
-	30025: _ErrCode_name[5324:5346],
-	30026: _ErrCode_name[5346:5377],
-	30027: _ErrCode_name[5377:5407],
-	30028: _ErrCode_name[5407:5439],
-	30029: _ErrCode_name[5439:5465],
-	30030: _ErrCode_name[5465:5480],
-	30031: _ErrCode_name[5480:5511],
-	30032: _ErrCode_name[5511:5544],
-	30033: _ErrCode_name[5544:5554],
-	30034: _ErrCode_name[5554:5579],
-	30035: _ErrCode_name[5579:5605],
-	30036: _ErrCode_name[5605:5632],
-	30037: _ErrCode_name[5632:5653],
-	30038: _ErrCode_name[5653:5674],
-	30039: _ErrCode_name[5674:5699],
-	30040: _ErrCode_name[5699:5720],
-	30041: _ErrCode_name[5720:5739],
-	30042: _ErrCode_name[5739:5761],
-	30043: _ErrCode_name[5761:5782],
-	30044: _ErrCode_name[5782:5814],
-	32001: _ErrCode_name[5814:5829],
-	32002: _ErrCode_name[5829:5851],
-	32003: _ErrCode_name[5851:5868],
-	32004: _ErrCode_name[5868:5886],
-	34001: _ErrCode_name[5886:5910],
-	34002: _ErrCode_name[5910:5935],
-	34003: _ErrCode_name[5935:5959],
-	34004: _ErrCode_name[5959:5982],
-	34005: _ErrCode_name[5982:6004],
-	34006: _ErrCode_name[6004:6026],
-	34007: _ErrCode_name[6026:6048],
-	34008: _ErrCode_name[6048:6075],
-	34009: _ErrCode_name[6075:6099],
-	34010: _ErrCode_name[6099:6121],
-	34011: _ErrCode_name[6121:6145],
-	34012: _ErrCode_name[6145:6161],
-	34013: _ErrCode_name[6161:6180],
-	34014: _ErrCode_name[6180:6203],
-	34015: _ErrCode_name[6203:6229],
-	34016: _ErrCode_name[6229:6246],
-	34017: _ErrCode_name[6246:6268],
-	34018: _ErrCode_name[6268:6290],
-	34019: _ErrCode_name[6290:6310],
-	34020: _ErrCode_name[6310:6329],
-	34021: _ErrCode_name[6329:6350],
-	36001: _ErrCode_name[6350:6365],
-	36002: _ErrCode_name[6365:6389],
-	36003: _ErrCode_name[6389:6411],
-	36004: _ErrCode_name[6411:6434],
-	36005: _ErrCode_name[6434:6460],
-	36006: _ErrCode_name[6460:6493],
-	36007: _ErrCode_name[6493:6517],
-	36008: _ErrCode_name[6517:6541],
-	36009: _ErrCode_name[6541:6569],
-	36010: _ErrCode_name[6569:6590],
-	36011: _ErrCode_name[6590:6619],
-	36012: _ErrCode_name[6619:6643],
-	36013: _ErrCode_name[6643:6668],
-	36014: _ErrCode_name[6668:6693],
-	36015: _ErrCode_name[6693:6720],
-	36016: _ErrCode_name[6720:6749],
-	36017: _ErrCode_name[6749:6768],
-	36018: _ErrCode_name[6768:6791],
-	36019: _ErrCode_name[6791:6823],
-	36020: _ErrCode_name[6823:6844],
-	36021: _ErrCode_name[6844:6869],
-	36022: _ErrCode_name[6869:6897],
-	36023: _ErrCode_name[6897:6920],
-	36024: _ErrCode_name[6920:6952],
-	36025: _ErrCode_name[6952:6981],
-	36026: _ErrCode_name[6981:7005],
-	36027: _ErrCode_name[7005:7032],
-	36028: _ErrCode_name[7032:7064],
-	36029: _ErrCode_name[7064:7096],
-	36030: _ErrCode_name[7096:7126],
-	36031: _ErrCode_name[7126:7150],
-	36032: _ErrCode_name[7150:7176],
-	36033: _ErrCode_name[7176:7201],
-	36034: _ErrCode_name[7201:7227],
-	36035: _ErrCode_name[7227:7257],
-	36036: _ErrCode_name[7257:7288],
-	36037: _ErrCode_name[7288:7321],
-	36038: _ErrCode_name[7321:7354],
-	36039: _ErrCode_name[7354:7384],
-	36040: _ErrCode_name[7384:7419],
-	36041: _ErrCode_name[7419:7453],
-	36042: _ErrCode_name[7453:7483],
-	36043: _ErrCode_name[7483:7517],
-	36044: _ErrCode_name[7517:7550],
-	36045: _ErrCode_name[7550:7586],
-	36046: _ErrCode_name[7586:7620],
-	36047: _ErrCode_name[7620:7647],
-	36048: _ErrCode_name[7647:7678],
-	36049: _ErrCode_name[7678:7705],
-	36050: _ErrCode_name[7705:7735],
-	36051: _ErrCode_name[7735:7763],
-	36052: _ErrCode_name[7763:7794],
-	36053: _ErrCode_name[7794:7826],
-	36054: _ErrCode_name[7826:7850],
-	36055: _ErrCode_name[7850:7879],
-	36056: _ErrCode_name[7879:7909],
-	36057: _ErrCode_name[7909:7941],
-	36058: _ErrCode_name[7941:7973],
-	36059: _ErrCode_name[7973:8004],
-	36060: _ErrCode_name[8004:8023],
-	36061: _ErrCode_name[8023:8048],
-	36062: _ErrCode_name[8048:8070],
-	36063: _ErrCode_name[8070:8085],
-	36064: _ErrCode_name[8085:8096],
-	36065: _ErrCode_name[8096:8118],
-	36066: _ErrCode_name[8118:8137],
-	36067: _ErrCode_name[8137:8151],
-	36068: _ErrCode_name[8151:8172],
-	36069: _ErrCode_name[8172:8186],
-	36070: _ErrCode_name[8186:8215],
-	36071: _ErrCode_name[8215:8246],
-	38001: _ErrCode_name[8246:8267],
-	38002: _ErrCode_name[8267:8288],
-	38003: _ErrCode_name[8288:8314],
-	38004: _ErrCode_name[8314:8334],
-	38005: _ErrCode_name[8334:8359],
-	38006: _ErrCode_name[8359:8380],
-	38007: _ErrCode_name[8380:8404],
-	38008: _ErrCode_name[8404:8426],
-	38009: _ErrCode_name[8426:8450],
-	38010: _ErrCode_name[8450:8474],
-	38011: _ErrCode_name[8474:8497],
-	38012: _ErrCode_name[8497:8520],
-	38013: _ErrCode_name[8520:8545],
-	38014: _ErrCode_name[8545:8569],
-	38015: _ErrCode_name[8569:8594],
-	38016: _ErrCode_name[8594:8615],
-	38017: _ErrCode_name[8615:8633],
-	38018: _ErrCode_name[8633:8650],
-	38019: _ErrCode_name[8650:8668],
-	38020: _ErrCode_name[8668:8689],
-	38021: _ErrCode_name[8689:8712],
-	38022: _ErrCode_name[8712:8735],
-	38023: _ErrCode_name[8735:8757],
-	38024: _ErrCode_name[8757:8775],
-	38025: _ErrCode_name[8775:8802],
-	38026: _ErrCode_name[8802:8826],
-	38027: _ErrCode_name[8826:8853],
-	38028: _ErrCode_name[8853:8878],
-	38029: _ErrCode_name[8878:8903],
-	38030: _ErrCode_name[8903:8926],
-	38031: _ErrCode_name[8926:8944],
-	38032: _ErrCode_name[8944:8968],
-	38033: _ErrCode_name[8968:8992],
-	38034: _ErrCode_name[8992:9012],
-	38035: _ErrCode_name[9012:9034],
-	38036: _ErrCode_name[9034:9055],
-	38037: _ErrCode_name[9055:9083],
-	38038: _ErrCode_name[9083:9107],
-	38039: _ErrCode_name[9107:9125],
-	38040: _ErrCode_name[9125:9148],
-	38041: _ErrCode_name[9148:9170],
-	38042: _ErrCode_name[9170:9197],
-	38043: _ErrCode_name[9197:9230],
-	38044: _ErrCode_name[9230:9253],
-	38045: _ErrCode_name[9253:9280],
-	38046: _ErrCode_name[9280:9305],
-	38047: _ErrCode_name[9305:9329],
-	38048: _ErrCode_name[9329:9353],
-	38049: _ErrCode_name[9353:9377],
-	38050: _ErrCode_name[9377:9408],
-	38051: _ErrCode_name[9408:9431],
-	38052: _ErrCode_name[9431:9450],
-	38053: _ErrCode_name[9450:9476],
-	38054: _ErrCode_name[9476:9513],
-	38055: _ErrCode_name[9513:9552],
-	38056: _ErrCode_name[9552:9590],
-	38057: _ErrCode_name[9590:9612],
-	38058: _ErrCode_name[9612:9627],
-	40001: _ErrCode_name[9627:9645],
-	40002: _ErrCode_name[9645:9662],
-	40003: _ErrCode_name[9662:9688],
-	40004: _ErrCode_name[9688:9715],
-	40005: _ErrCode_name[9715:9733],
-	40006: _ErrCode_name[9733:9754],
-	40007: _ErrCode_name[9754:9775],
-	40008: _ErrCode_name[9775:9796],
-	40009: _ErrCode_name[9796:9819],
-	40010: _ErrCode_name[9819:9842],
-	40011: _ErrCode_name[9842:9863],
-	40012: _ErrCode_name[9863:9888],
-	40013: _ErrCode_name[9888:9909],
-	40014: _ErrCode_name[9909:9933],
-	40015: _ErrCode_name[9933:9958],
-	40016: _ErrCode_name[9958:9979],
-	40017: _ErrCode_name[9979:9998],
-	40018: _ErrCode_name[9998:10022],
-	40019: _ErrCode_name[10022:10045],
-	40020: _ErrCode_name[10045:10065],
-	40021: _ErrCode_name[10065:10082],
-	40022: _ErrCode_name[10082:10099],
-	40023: _ErrCode_name[10099:10120],
-	40024: _ErrCode_name[10120:10146],
-	40025: _ErrCode_name[10146:10172],
-	40026: _ErrCode_name[10172:10195],
-	40027: _ErrCode_name[10195:10216],
-	40028: _ErrCode_name[10216:10236],
-	40029: _ErrCode_name[10236:10259],
-	40030: _ErrCode_name[10259:10282],
-	40031: _ErrCode_name[10282:10303],
-	40032: _ErrCode_name[10303:10324],
-	40033: _ErrCode_name[10324:10344],
-	40034: _ErrCode_name[10344:10366],
-	40035: _ErrCode_name[10366:10391],
-	40036: _ErrCode_name[10391:10416],
-	40037: _ErrCode_name[10416:10433],
-	40038: _ErrCode_name[10433:10452],
-	40039: _ErrCode_name[10452:10476],
-	40040: _ErrCode_name[10476:10501],
-	40041: _ErrCode_name[10501:10519],
-	40042: _ErrCode_name[10519:10542],
-	40043: _ErrCode_name[10542:10564],
-	40044: _ErrCode_name[10564:10588],
-	40045: _ErrCode_name[10588:10610],
-	40046: _ErrCode_name[10610:10631],
-	40047: _ErrCode_name[10631:10653],
-	40048: _ErrCode_name[10653:10671],
-	40049: _ErrCode_name[10671:10690],
-	40050: _ErrCode_name[10690:10711],
-	40051: _ErrCode_name[10711:10731],
-	40052: _ErrCode_name[10731:10752],
-	40053: _ErrCode_name[10752:10774],
-	40054: _ErrCode_name[10774:10795],
-	40055: _ErrCode_name[10795:10814],
-	40056: _ErrCode_name[10814:10836],
-	40057: _ErrCode_name[10836:10856],
-	40058: _ErrCode_name[10856:10877],
-	40059: _ErrCode_name[10877:10903],
-	40060: _ErrCode_name[10903:10921],
-	40061: _ErrCode_name[10921:10946],
-	40062: _ErrCode_name[10946:10969],
-	40063: _ErrCode_name[10969:10993],
-	40064: _ErrCode_name[10993:11018],
-	40065: _ErrCode_name[11018:11041],
-	40066: _ErrCode_name[11041:11061],
-	40067: _ErrCode_name[11061:11090],
-	40068: _ErrCode_name[11090:11110],
-	40069: _ErrCode_name[11110:11132],
-	40070: _ErrCode_name[11132:11145],
-	40071: _ErrCode_name[11145:11165],
-	40072: _ErrCode_name[11165:11185],
-	40073: _ErrCode_name[11185:11221],
-	40074: _ErrCode_name[11221:11256],
-	40075: _ErrCode_name[11256:11279],
-	40076: _ErrCode_name[11279:11302],
-	40077: _ErrCode_name[11302:11325],
-	40078: _ErrCode_name[11325:11351],
-	40079: _ErrCode_name[11351:11376],
-	40080: _ErrCode_name[11376:11400],
-	40081: _ErrCode_name[11400:11425],
-	40082: _ErrCode_name[11425:11449],
-	40083: _ErrCode_name[11449:11467],
-	42001: _ErrCode_name[11467:11485],
-	42002: _ErrCode_name[11485:11510],
-	42003: _ErrCode_name[11510:11533],
-	42004: _ErrCode_name[11533:11557],
-	42005: _ErrCode_name[11557:11581],
-	42006: _ErrCode_name[11581:11600],
-	42007: _ErrCode_name[11600:11620],
-	42008: _ErrCode_name[11620:11644],
-	42009: _ErrCode_name[11644:11667],
-	42010: _ErrCode_name[11667:11685],
-	42501: _ErrCode_name[11685:11703],
-	42502: _ErrCode_name[11703:11716],
-	42503: _ErrCode_name[11716:11731],
-	42504: _ErrCode_name[11731:11751],
-	42505: _ErrCode_name[11751:11766],
-	43001: _ErrCode_name[11766:11792],
-	43002: _ErrCode_name[11792:11812],
-	43003: _ErrCode_name[11812:11829],
-	43004: _ErrCode_name[11829:11853],
-	43005: _ErrCode_name[11853:11876],
-	43006: _ErrCode_name[11876:11893],
-	43007: _ErrCode_name[11893:11907],
-	43008: _ErrCode_name[11907:11930],
-	44001: _ErrCode_name[11930:11954],
-	44002: _ErrCode_name[11954:11985],
-	44003: _ErrCode_name[11985:12015],
-	44004: _ErrCode_name[12015:12043],
-	44005: _ErrCode_name[12043:12070],
-	44006: _ErrCode_name[12070:12096],
-	44007: _ErrCode_name[12096:12135],
-	44008: _ErrCode_name[12135:12174],
-	44009: _ErrCode_name[12174:12209],
-	44010: _ErrCode_name[12209:12237],
-	44011: _ErrCode_name[12237:12265],
-	44012: _ErrCode_name[12265:12282],
-	44013: _ErrCode_name[12282:12306],
-	44014: _ErrCode_name[12306:12332],
-	44015: _ErrCode_name[12332:12361],
-	44016: _ErrCode_name[12361:12400],
-	44017: _ErrCode_name[12400:12439],
-	44018: _ErrCode_name[12439:12477],
-	44019: _ErrCode_name[12477:12526],
-	44020: _ErrCode_name[12526:12547],
-	46001: _ErrCode_name[12547:12566],
-	46002: _ErrCode_name[12566:12582],
-	46003: _ErrCode_name[12582:12602],
-	46004: _ErrCode_name[12602:12625],
-	46005: _ErrCode_name[12625:12646],
-	46006: _ErrCode_name[12646:12673],
-	46007: _ErrCode_name[12673:12696],
-	46008: _ErrCode_name[12696:12722],
-	46009: _ErrCode_name[12722:12745],
-	46010: _ErrCode_name[12745:12771],
-	46011: _ErrCode_name[12771:12803],
-	46012: _ErrCode_name[12803:12836],
-	46013: _ErrCode_name[12836:12854],
-	46014: _ErrCode_name[12854:12875],
-	46015: _ErrCode_name[12875:12909],
-	46016: _ErrCode_name[12909:12939],
-	46017: _ErrCode_name[12939:12971],
-	46018: _ErrCode_name[12971:12992],
-	46019: _ErrCode_name[12992:13029],
-	46020: _ErrCode_name[13029:13054],
-	46021: _ErrCode_name[13054:13080],
-	46022: _ErrCode_name[13080:13111],
-	46023: _ErrCode_name[13111:13138],
-	46024: _ErrCode_name[13138:13157],
-	46025: _ErrCode_name[13157:13181],
-	46026: _ErrCode_name[13181:13206],
-	46027: _ErrCode_name[13206:13240],
-	46028: _ErrCode_name[13240:13270],
-	46029: _ErrCode_name[13270:13299],
-	46030: _ErrCode_name[13299:13325],
-	46031: _ErrCode_name[13325:13350],
-	46032: _ErrCode_name[13350:13385],
-	46033: _ErrCode_name[13385:13407],
-	46034: _ErrCode_name[13407:13431],
-	46035: _ErrCode_name[13431:13456],
-	48001: _ErrCode_name[13456:13473],
-	48002: _ErrCode_name[13473:13489],
-	48003: _ErrCode_name[13489:13502],
-	49001: _ErrCode_name[13502:13515],
-	49002: _ErrCode_name[13515:13540],
-	50000: _ErrCode_name[13540:13546],
+	20068: _ErrCode_name[4291:4315],
+	20069: _ErrCode_name[4315:4347],
+	20070: _ErrCode_name[4347:4368],
+	20071: _ErrCode_name[4368:4393],
+	20072: _ErrCode_name[4393:4421],
+	20073: _ErrCode_name[4421:4448],
+	20074: _ErrCode_name[4448:4469],
+	20075: _ErrCode_name[4469:4506],
+	20076: _ErrCode_name[4506:4531],
+	20077: _ErrCode_name[4531:4560],
+	22001: _ErrCode_name[4560:4581],
+	22002: _ErrCode_name[4581:4602],
+	22003: _ErrCode_name[4602:4623],
+	24001: _ErrCode_name[4623:4648],
+	24002: _ErrCode_name[4648:4672],
+	24003: _ErrCode_name[4672:4698],
+	24004: _ErrCode_name[4698:4724],
+	24005: _ErrCode_name[4724:4753],
+	24006: _ErrCode_name[4753:4782],
+	26001: _ErrCode_name[4782:4804],
+	26002: _ErrCode_name[4804:4825],
+	26003: _ErrCode_name[4825:4848],
+	26004: _ErrCode_name[4848:4873],
+	26005: _ErrCode_name[4873:4897],
+	26006: _ErrCode_name[4897:4915],
+	26007: _ErrCode_name[4915:4930],
+	28001: _ErrCode_name[4930:4949],
+	28002: _ErrCode_name[4949:4969],
+	28003: _ErrCode_name[4969:4996],
+	28004: _ErrCode_name[4996:5019],
+	28005: _ErrCode_name[5019:5042],
+	30001: _ErrCode_name[5042:5065],
+	30002: _ErrCode_name[5065:5092],
+	30003: _ErrCode_name[5092:5109],
+	30004: _ErrCode_name[5109:5132],
+	30005: _ErrCode_name[5132:5150],
+	30006: _ErrCode_name[5150:5169],
+	30007: _ErrCode_name[5169:5189],
+	30008: _ErrCode_name[5189:5209],
+	30009: _ErrCode_name[5209:5231],
+	30010: _ErrCode_name[5231:5258],
+	30011: _ErrCode_name[5258:5278],
+	30012: _ErrCode_name[5278:5301],
+	30013: _ErrCode_name[5301:5322],
+	30014: _ErrCode_name[5322:5349],
+	30015: _ErrCode_name[5349:5371],
+	30016: _ErrCode_name[5371:5393],
+	30017: _ErrCode_name[5393:5420],
+	30018: _ErrCode_name[5420:5440],
+	30019: _ErrCode_name[5440:5460],
+	30020: _ErrCode_name[5460:5485],
+	30021: _ErrCode_name[5485:5516],
+	30022: _ErrCode_name[5516:5541],
+	30023: _ErrCode_name[5541:5563],
+	30024: _ErrCode_name[5563:5593],
+	30025: _ErrCode_name[5593:5615],
+	30026: _ErrCode_name[5615:5646],
+	30027: _ErrCode_name[5646:5676],
+	30028: _ErrCode_name[5676:5708],
+	30029: _ErrCode_name[5708:5734],
+	30030: _ErrCode_name[5734:5749],
+	30031: _ErrCode_name[5749:5780],
+	30032: _ErrCode_name[5780:5813],
+	30033: _ErrCode_name[5813:5823],
+	30034: _ErrCode_name[5823:5848],
+	30035: _ErrCode_name[5848:5874],
+	30036: _ErrCode_name[5874:5901],
+	30037: _ErrCode_name[5901:5922],
+	30038: _ErrCode_name[5922:5943],
+	30039: _ErrCode_name[5943:5968],
+	30040: _ErrCode_name[5968:5989],
+	30041: _ErrCode_name[5989:6008],
+	30042: _ErrCode_name[6008:6030],
+	30043: _ErrCode_name[6030:6051],
+	30044: _ErrCode_name[6051:6083],
+	30045: _ErrCode_name[6083:6099],
+	30046: _ErrCode_name[6099:6123],
+	30047: _ErrCode_name[6123:6137],
+	30048: _ErrCode_name[6137:6152],
+	32001: _ErrCode_name[6152:6167],
+	32002: _ErrCode_name[6167:6189],
+	32003: _ErrCode_name[6189:6206],
+	32004: _ErrCode_name[6206:6224],
+	34001: _ErrCode_name[6224:6248],
+	34002: _ErrCode_name[6248:6273],
+	34003: _ErrCode_name[6273:6297],
+	34004: _ErrCode_name[6297:6320],
+	34005: _ErrCode_name[6320:6342],
+	34006: _ErrCode_name[6342:6364],
+	34007: _ErrCode_name[6364:6386],
+	34008: _ErrCode_name[6386:6413],
+	34009: _ErrCode_name[6413:6437],
+	34010: _ErrCode_name[6437:6459],
+	34011: _ErrCode_name[6459:6483],
+	34012: _ErrCode_name[6483:6499],
+	34013: _ErrCode_name[6499:6518],
+	34014: _ErrCode_name[6518:6541],
+	34015: _ErrCode_name[6541:6567],
+	34016: _ErrCode_name[6567:6584],
+	34017: _ErrCode_name[6584:6606],
+	34018: _ErrCode_name[6606:6628],
+	34019: _ErrCode_name[6628:6648],
+	34020: _ErrCode_name[6648:6667],
+	34021: _ErrCode_name[6667:6688],
+	36001: _ErrCode_name[6688:6703],
+	36002: _ErrCode_name[6703:6727],
+	36003: _ErrCode_name[6727:6749],
+	36004: _ErrCode_name[6749:6772],
+	36005: _ErrCode_name[6772:6798],
+	36006: _ErrCode_name[6798:6831],
+	36007: _ErrCode_name[6831:6855],
+	36008: _ErrCode_name[6855:6879],
+	36009: _ErrCode_name[6879:6907],
+	36010: _ErrCode_name[6907:6928],
+	36011: _ErrCode_name[6928:6957],
+	36012: _ErrCode_name[6957:6981],
+	36013: _ErrCode_name[6981:7006],
+	36014: _ErrCode_name[7006:7031],
+	36015: _ErrCode_name[7031:7058],
+	36016: _ErrCode_name[7058:7087],
+	36017: _ErrCode_name[7087:7106],
+	36018: _ErrCode_name[7106:7129],
+	36019: _ErrCode_name[7129:7161],
+	36020: _ErrCode_name[7161:7182],
+	36021: _ErrCode_name[7182:7207],
+	36022: _ErrCode_name[7207:7235],
+	36023: _ErrCode_name[7235:7258],
+	36024: _ErrCode_name[7258:7290],
+	36025: _ErrCode_name[7290:7319],
+	36026: _ErrCode_name[7319:7343],
+	36027: _ErrCode_name[7343:7370],
+	36028: _ErrCode_name[7370:7402],
+	36029: _ErrCode_name[7402:7434],
+	36030: _ErrCode_name[7434:7464],
+	36031: _ErrCode_name[7464:7488],
+	36032: _ErrCode_name[7488:7514],
+	36033: _ErrCode_name[7514:7539],
+	36034: _ErrCode_name[7539:7565],
+	36035: _ErrCode_name[7565:7595],
+	36036: _ErrCode_name[7595:7626],
+	36037: _ErrCode_name[7626:7659],
+	36038: _ErrCode_name[7659:7692],
+	36039: _ErrCode_name[7692:7722],
+	36040: _ErrCode_name[7722:7757],
+	36041: _ErrCode_name[7757:7791],
+	36042: _ErrCode_name[7791:7821],
+	36043: _ErrCode_name[7821:7855],
+	36044: _ErrCode_name[7855:7888],
+	36045: _ErrCode_name[7888:7924],
+	36046: _ErrCode_name[7924:7958],
+	36047: _ErrCode_name[7958:7985],
+	36048: _ErrCode_name[7985:8016],
+	36049: _ErrCode_name[8016:8043],
+	36050: _ErrCode_name[8043:8073],
+	36051: _ErrCode_name[8073:8101],
+	36052: _ErrCode_name[8101:8132],
+	36053: _ErrCode_name[8132:8164],
+	36054: _ErrCode_name[8164:8188],
+	36055: _ErrCode_name[8188:8217],
+	36056: _ErrCode_name[8217:8247],
+	36057: _ErrCode_name[8247:8279],
+	36058: _ErrCode_name[8279:8311],
+	36059: _ErrCode_name[8311:8342],
+	36060: _ErrCode_name[8342:8361],
+	36061: _ErrCode_name[8361:8386],
+	36062: _ErrCode_name[8386:8408],
+	36063: _ErrCode_name[8408:8423],
+	36064: _ErrCode_name[8423:8434],
+	36065: _ErrCode_name[8434:8456],
+	36066: _ErrCode_name[8456:8475],
+	36067: _ErrCode_name[8475:8489],
+	36068: _ErrCode_name[8489:8510],
+	36069: _ErrCode_name[8510:8524],
+	36070: _ErrCode_name[8524:8553],
+	36071: _ErrCode_name[8553:8584],
+	36072: _ErrCode_name[8584:8605],
+	36073: _ErrCode_name[8605:8633],
+	36074: _ErrCode_name[8633:8660],
+	36075: _ErrCode_name[8660:8683],
+	36076: _ErrCode_name[8683:8703],
+	36077: _ErrCode_name[8703:8731],
+	36078: _ErrCode_name[8731:8758],
+	36079: _ErrCode_name[8758:8771],
+	36080: _ErrCode_name[8771:8796],
+	36081: _ErrCode_name[8796:8825],
+	38001: _ErrCode_name[8825:8846],
+	38002: _ErrCode_name[8846:8867],
+	38003: _ErrCode_name[8867:8893],
+	38004: _ErrCode_name[8893:8913],
+	38005: _ErrCode_name[8913:8938],
+	38006: _ErrCode_name[8938:8959],
+	38007: _ErrCode_name[8959:8983],
+	38008: _ErrCode_name[8983:9005],
+	38009: _ErrCode_name[9005:9029],
+	38010: _ErrCode_name[9029:9053],
+	38011: _ErrCode_name[9053:9076],
+	38012: _ErrCode_name[9076:9099],
+	38013: _ErrCode_name[9099:9124],
+	38014: _ErrCode_name[9124:9148],
+	38015: _ErrCode_name[9148:9173],
+	38016: _ErrCode_name[9173:9194],
+	38017: _ErrCode_name[9194:9212],
+	38018: _ErrCode_name[9212:9229],
+	38019: _ErrCode_name[9229:9247],
+	38020: _ErrCode_name[9247:9268],
+	38021: _ErrCode_name[9268:9291],
+	38022: _ErrCode_name[9291:9314],
+	38023: _ErrCode_name[9314:9336],
+	38024: _ErrCode_name[9336:9354],
+	38025: _ErrCode_name[9354:9381],
+	38026: _ErrCode_name[9381:9405],
+	38027: _ErrCode_name[9405:9432],
+	38028: _ErrCode_name[9432:9457],
+	38029: _ErrCode_name[9457:9482],
+	38030: _ErrCode_name[9482:9505],
+	38031: _ErrCode_name[9505:9523],
+	38032: _ErrCode_name[9523:9547],
+	38033: _ErrCode_name[9547:9571],
+	38034: _ErrCode_name[9571:9591],
+	38035: _ErrCode_name[9591:9613],
+	38036: _ErrCode_name[9613:9634],
+	38037: _ErrCode_name[9634:9662],
+	38038: _ErrCode_name[9662:9686],
+	38039: _ErrCode_name[9686:9704],
+	38040: _ErrCode_name[9704:9727],
+	38041: _ErrCode_name[9727:9749],
+	38042: _ErrCode_name[9749:9776],
+	38043: _ErrCode_name[9776:9809],
+	38044: _ErrCode_name[9809:9832],
+	38045: _ErrCode_name[9832:9859],
+	38046: _ErrCode_name[9859:9884],
+	38047: _ErrCode_name[9884:9908],
+	38048: _ErrCode_name[9908:9932],
+	38049: _ErrCode_name[9932:9956],
+	38050: _ErrCode_name[9956:9987],
+	38051: _ErrCode_name[9987:10010],
+	38052: _ErrCode_name[10010:10029],
+	38053: _ErrCode_name[10029:10055],
+	38054: _ErrCode_name[10055:10092],
+	38055: _ErrCode_name[10092:10131],
+	38056: _ErrCode_name[10131:10169],
+	38057: _ErrCode_name[10169:10191],
+	38058: _ErrCode_name[10191:10206],
+	38059: _ErrCode_name[10206:10240],
+	38060: _ErrCode_name[10240:10261],
+	38061: _ErrCode_name[10261:10284],
+	38062: _ErrCode_name[10284:10307],
+	40001: _ErrCode_name[10307:10325],
+	40002: _ErrCode_name[10325:10342],
+	40003: _ErrCode_name[10342:10368],
+	40004: _ErrCode_name[10368:10395],
+	40005: _ErrCode_name[10395:10413],
+	40006: _ErrCode_name[10413:10434],
+	40007: _ErrCode_name[10434:10455],
+	40008: _ErrCode_name[10455:10476],
+	40009: _ErrCode_name[10476:10499],
+	40010: _ErrCode_name[10499:10522],
+	40011: _ErrCode_name[10522:10543],
+	40012: _ErrCode_name[10543:10568],
+	40013: _ErrCode_name[10568:10589],
+	40014: _ErrCode_name[10589:10613],
+	40015: _ErrCode_name[10613:10638],
+	40016: _ErrCode_name[10638:10659],
+	40017: _ErrCode_name[10659:10678],
+	40018: _ErrCode_name[10678:10702],
+	40019: _ErrCode_name[10702:10725],
+	40020: _ErrCode_name[10725:10745],
+	40021: _ErrCode_name[10745:10762],
+	40022: _ErrCode_name[10762:10779],
+	40023: _ErrCode_name[10779:10800],
+	40024: _ErrCode_name[10800:10826],
+	40025: _ErrCode_name[10826:10852],
+	40026: _ErrCode_name[10852:10875],
+	40027: _ErrCode_name[10875:10896],
+	40028: _ErrCode_name[10896:10916],
+	40029: _ErrCode_name[10916:10939],
+	40030: _ErrCode_name[10939:10962],
+	40031: _ErrCode_name[10962:10983],
+	40032: _ErrCode_name[10983:11004],
+	40033: _ErrCode_name[11004:11024],
+	40034: _ErrCode_name[11024:11046],
+	40035: _ErrCode_name[11046:11071],
+	40036: _ErrCode_name[11071:11096],
+	40037: _ErrCode_name[11096:11113],
+	40038: _ErrCode_name[11113:11132],
+	40039: _ErrCode_name[11132:11156],
+	40040: _ErrCode_name[11156:11181],
+	40041: _ErrCode_name[11181:11199],
+	40042: _ErrCode_name[11199:11222],
+	40043: _ErrCode_name[11222:11244],
+	40044: _ErrCode_name[11244:11268],
+	40045: _ErrCode_name[11268:11290],
+	40046: _ErrCode_name[11290:11311],
+	40047: _ErrCode_name[11311:11333],
+	40048: _ErrCode_name[11333:11351],
+	40049: _ErrCode_name[11351:11370],
+	40050: _ErrCode_name[11370:11391],
+	40051: _ErrCode_name[11391:11411],
+	40052: _ErrCode_name[11411:11432],
+	40053: _ErrCode_name[11432:11454],
+	40054: _ErrCode_name[11454:11475],
+	40055: _ErrCode_name[11475:11494],
+	40056: _ErrCode_name[11494:11516],
+	40057: _ErrCode_name[11516:11536],
+	40058: _ErrCode_name[11536:11557],
+	40059: _ErrCode_name[11557:11583],
+	40060: _ErrCode_name[11583:11601],
+	40061: _ErrCode_name[11601:11626],
+	40062: _ErrCode_name[11626:11649],
+	40063: _ErrCode_name[11649:11673],
+	40064: _ErrCode_name[11673:11698],
+	40065: _ErrCode_name[11698:11721],
+	40066: _ErrCode_name[11721:11741],
+	40067: _ErrCode_name[11741:11770],
+	40068: _ErrCode_name[11770:11790],
+	40069: _ErrCode_name[11790:11812],
+	40070: _ErrCode_name[11812:11825],
+	40071: _ErrCode_name[11825:11845],
+	40072: _ErrCode_name[11845:11865],
+	40073: _ErrCode_name[11865:11901],
+	40074: _ErrCode_name[11901:11936],
+	40075: _ErrCode_name[11936:11959],
+	40076: _ErrCode_name[11959:11982],
+	40077: _ErrCode_name[11982:12005],
+	40078: _ErrCode_name[12005:12031],
+	40079: _ErrCode_name[12031:12056],
+	40080: _ErrCode_name[12056:12080],
+	40081: _ErrCode_name[12080:12105],
+	40082: _ErrCode_name[12105:12129],
+	40083: _ErrCode_name[12129:12147],
+	42001: _ErrCode_name[12147:12165],
+	42002: _ErrCode_name[12165:12190],
+	42003: _ErrCode_name[12190:12213],
+	42004: _ErrCode_name[12213:12237],
+	42005: _ErrCode_name[12237:12261],
+	42006: _ErrCode_name[12261:12280],
+	42007: _ErrCode_name[12280:12300],
+	42008: _ErrCode_name[12300:12324],
+	42009: _ErrCode_name[12324:12347],
+	42010: _ErrCode_name[12347:12365],
+	42501: _ErrCode_name[12365:12383],
+	42502: _ErrCode_name[12383:12396],
+	42503: _ErrCode_name[12396:12411],
+	42504: _ErrCode_name[12411:12431],
+	42505: _ErrCode_name[12431:12446],
+	43001: _ErrCode_name[12446:12472],
+	43002: _ErrCode_name[12472:12492],
+	43003: _ErrCode_name[12492:12509],
+	43004: _ErrCode_name[12509:12533],
+	43005: _ErrCode_name[12533:12556],
+	43006: _ErrCode_name[12556:12573],
+	43007: _ErrCode_name[12573:12587],
+	43008: _ErrCode_name[12587:12610],
+	43009: _ErrCode_name[12610:12632],
+	44001: _ErrCode_name[12632:12656],
+	44002: _ErrCode_name[12656:12687],
+	44003: _ErrCode_name[12687:12717],
+	44004: _ErrCode_name[12717:12745],
+	44005: _ErrCode_name[12745:12772],
+	44006: _ErrCode_name[12772:12798],
+	44007: _ErrCode_name[12798:12837],
+	44008: _ErrCode_name[12837:12876],
+	44009: _ErrCode_name[12876:12911],
+	44010: _ErrCode_name[12911:12939],
+	44011: _ErrCode_name[12939:12967],
+	44012: _ErrCode_name[12967:12984],
+	44013: _ErrCode_name[12984:13008],
+	44014: _ErrCode_name[13008:13034],
+	44015: _ErrCode_name[13034:13063],
+	44016: _ErrCode_name[13063:13102],
+	44017: _ErrCode_name[13102:13141],
+	44018: _ErrCode_name[13141:13179],
+	44019: _ErrCode_name[13179:13228],
+	44020: _ErrCode_name[13228:13249],
+	46001: _ErrCode_name[13249:13268],
+	46002: _ErrCode_name[13268:13284],
+	46003: _ErrCode_name[13284:13304],
+	46004: _ErrCode_name[13304:13327],
+	46005: _ErrCode_name[13327:13348],
+	46006: _ErrCode_name[13348:13375],
+	46007: _ErrCode_name[13375:13398],
+	46008: _ErrCode_name[13398:13424],
+	46009: _ErrCode_name[13424:13447],
+	46010: _ErrCode_name[13447:13473],
+	46011: _ErrCode_name[13473:13505],
+	46012: _ErrCode_name[13505:13538],
+	46013: _ErrCode_name[13538:13556],
+	46014: _ErrCode_name[13556:13577],
+	46015: _ErrCode_name[13577:13611],
+	46016: _ErrCode_name[13611:13641],
+	46017: _ErrCode_name[13641:13673],
+	46018: _ErrCode_name[13673:13694],
+	46019: _ErrCode_name[13694:13731],
+	46020: _ErrCode_name[13731:13756],
+	46021: _ErrCode_name[13756:13782],
+	46022: _ErrCode_name[13782:13813],
+	46023: _ErrCode_name[13813:13840],
+	46024: _ErrCode_name[13840:13859],
+	46025: _ErrCode_name[13859:13883],
+	46026: _ErrCode_name[13883:13908],
+	46027: _ErrCode_name[13908:13942],
+	46028: _ErrCode_name[13942:13972],
+	46029: _ErrCode_name[13972:14001],
+	46030: _ErrCode_name[14001:14027],
+	46031: _ErrCode_name[14027:14052],
+	46032: _ErrCode_name[14052:14087],
+	46033: _ErrCode_name[14087:14109],
+	46034: _ErrCode_name[14109:14133],
+	46035: _ErrCode_name[14133:14158],
+	48001: _ErrCode_name[14158:14175],
+	48002: _ErrCode_name[14175:14191],
+	48003: _ErrCode_name[14191:14204],
+	49001: _ErrCode_name[14204:14217],
+	49002: _ErrCode_name[14217:14242],
+	50000: _ErrCode_name[14242:14248],
 }
 
 func (i ErrCode) String() string {
@@ -272,6 +272,16 @@ const (
 	codeConfigInvalidLoadAnalyze
 	codeConfigStrictOptimisticShardMode
 	codeConfigSecretKeyPath
+	codeConfigInvalidSyncerDelay
+	codeConfigInvalidRelayArchiveStorage
+	codeConfigInvalidThrottle
+	codeConfigInvalidConflictRule
+	codeConfigInvalidColumnTransform
+	codeConfigInvalidPlacementLabel
+	codeConfigInvalidTargetMQ
+	codeConfigInvalidSchemaDriftCheckInterval
+	codeConfigInvalidTaskSchedule
+	codeConfigInvalidShardAutoResolve
 )
 
 // Binlog operation error code list.
@@ -357,6 +367,10 @@ const (
 	codeRelayPurgeArgsNotValid
 	codePreviousGTIDsNotValid
 	codeRotateEventWithDifferentServerID
+	codeRelayArchiveFile
+	codeRelayRestoreArchivedFile
+	codeRelayIndexRead
+	codeRelayIndexWrite
 )
 
 // Dump unit error code.
@@ -465,6 +479,16 @@ const (
 	codeSyncerGetEvent
 	codeSyncerDownstreamTableNotFound
 	codeSyncerReprocessWithSafeModeFail
+	codeSyncerDelayNotEnabled
+	codeSyncerResyncTableUnsupported
+	codeSyncerResyncTableInProgress
+	codeSyncerResyncTableFailed
+	codeSyncerResyncTableDDL
+	codeSyncerUpdateRulesUnsupported
+	codeSyncerUpdateRulesInProgress
+	codeSyncerWriteMQ
+	codeSyncerMQTableInfoNotFound
+	codeSyncerUnsafePartialJSONUpdate
 )
 
 // DM-master error code.
@@ -527,6 +551,10 @@ const (
 	codeMasterOptimisticDownstreamMetaNotFound
 	codeMasterInvalidClusterID
 	codeMasterStartTask
+	codeMasterConfigRebalanceIntervalParse
+	codeMasterCutoverNotExist
+	codeMasterCutoverInProgress
+	codeMasterCutoverValidation
 )
 
 // DM-worker error code.
@@ -649,6 +677,7 @@ const (
 	codeValidatorNotFound
 	codeValidatorPanic
 	codeValidatorTooMuchPending
+	codeValidatorSnapshotCheck
 )
 
 // Schema-tracker error code.
@@ -985,6 +1014,16 @@ var (
 	ErrConfigInvalidLoadAnalyze                 = New(codeConfigInvalidLoadAnalyze, ClassConfig, ScopeInternal, LevelMedium, "invalid load analyze option '%s'", "Please choose a valid value in ['required', 'optional', 'off'] or leave it empty.")
 	ErrConfigStrictOptimisticShardMode          = New(codeConfigStrictOptimisticShardMode, ClassConfig, ScopeInternal, LevelMedium, "cannot enable `strict-optimistic-shard-mode` while `shard-mode` is not `optimistic`", "Please set `shard-mode` to `optimistic` if you want to enable `strict-optimistic-shard-mode`.")
 	ErrConfigSecretKeyPath                      = New(codeConfigSecretKeyPath, ClassConfig, ScopeInternal, LevelHigh, "invalid secret key path or content: %v", "Please check whether the path is valid, and has required permission to read the file, and the key is correct.")
+	ErrConfigInvalidSyncerDelay                 = New(codeConfigInvalidSyncerDelay, ClassConfig, ScopeInternal, LevelMedium, "invalid syncer delay '%s'", "Please check the `delay` config in syncer configuration items, it should be a non-negative duration such as `1h` or `30m`.")
+	ErrConfigInvalidRelayArchiveStorage         = New(codeConfigInvalidRelayArchiveStorage, ClassConfig, ScopeInternal, LevelMedium, "invalid relay archive storage '%s'", "Please check the `storage` config in `relay-archive` of source configuration file, it should be a valid external storage URI such as `s3://bucket/prefix`.")
+	ErrConfigInvalidThrottle                    = New(codeConfigInvalidThrottle, ClassConfig, ScopeInternal, LevelMedium, "invalid throttle config: %s", "Please check the `load-throttle` config in loader and `sync-throttle` config in syncer configuration items, `rows-per-second` should be non-negative, `bytes-per-second` should be a size such as `10MiB` and `target-latency` should be a non-negative duration such as `100ms`.")
+	ErrConfigInvalidConflictRule                = New(codeConfigInvalidConflictRule, ClassConfig, ScopeInternal, LevelMedium, "invalid conflict rule '%s': %s", "Please check the `conflict-rules` config in task configuration file, `policy` should be one of ['last-writer-wins', 'source-priority', 'reject'], `timestamp-column` is required by `last-writer-wins`, and `source-column` is required by other policies when the route has no `extract-source`.")
+	ErrConfigInvalidColumnTransform             = New(codeConfigInvalidColumnTransform, ClassConfig, ScopeInternal, LevelMedium, "invalid column transform '%s': %s", "Please check the `column-transforms` config in task configuration file, `schema`, `table` and `column` are required, and `type` should be one of ['hash', 'mask', 'constant', 'expression'].")
+	ErrConfigInvalidPlacementLabel              = New(codeConfigInvalidPlacementLabel, ClassConfig, ScopeInternal, LevelMedium, "label name in placement constraints or preferences should not be empty", "Please check the `placement` config in source configuration file.")
+	ErrConfigInvalidTargetMQ                    = New(codeConfigInvalidTargetMQ, ClassConfig, ScopeInternal, LevelMedium, "invalid target-mq config: %s", "Please check the `target-mq` config in task configuration file, `sink-uri` should be a Kafka sink URI with the `protocol` parameter, and `task-mode` should be `incremental`.")
+	ErrConfigInvalidSchemaDriftCheckInterval    = New(codeConfigInvalidSchemaDriftCheckInterval, ClassConfig, ScopeInternal, LevelMedium, "invalid schema drift check interval '%s'", "Please check the `schema-drift-check-interval` config in syncer configuration items, it should be a non-negative duration such as `5m`.")
+	ErrConfigInvalidTaskSchedule                = New(codeConfigInvalidTaskSchedule, ClassConfig, ScopeInternal, LevelMedium, "invalid task schedule: %s", "Please check the `schedule` config in task configuration file, `start-time` should be like '2006-01-02 15:04:05', `cron` should be a standard cron expression with 5 fields, and `duration` should be a positive duration such as `2h`.")
+	ErrConfigInvalidShardAutoResolve            = New(codeConfigInvalidShardAutoResolve, ClassConfig, ScopeInternal, LevelMedium, "invalid shard-auto-resolve: %s", "Please check the `shard-auto-resolve` config in task configuration file, it's only supported when `shard-mode` is `optimistic`, and the strategies should be in ['widen-type', 'union-columns', 'ignore-index'].")
 
 	// Binlog operation error.
 	ErrBinlogExtractPosition = New(codeBinlogExtractPosition, ClassBinlogOp, ScopeInternal, LevelHigh, "", "")
@@ -1060,6 +1099,10 @@ var (
 	ErrRelayPurgeArgsNotValid            = New(codeRelayPurgeArgsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "args (%T) %+v not valid", "")
 	ErrPreviousGTIDsNotValid             = New(codePreviousGTIDsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "previousGTIDs %s not valid", "")
 	ErrRotateEventWithDifferentServerID  = New(codeRotateEventWithDifferentServerID, ClassRelayUnit, ScopeInternal, LevelHigh, "receive fake rotate event with different server_id", "Please use `resume-relay` command if upstream database has changed")
+	ErrRelayArchiveFile                  = New(codeRelayArchiveFile, ClassRelayUnit, ScopeInternal, LevelHigh, "archive relay log file %s to external storage", "Please check the `relay-archive` config in source configuration file and the permission of the external storage.")
+	ErrRelayRestoreArchivedFile          = New(codeRelayRestoreArchivedFile, ClassRelayUnit, ScopeInternal, LevelHigh, "restore archived relay log file %s from external storage", "Please check the `relay-archive` config in source configuration file and the permission of the external storage.")
+	ErrRelayIndexRead                    = New(codeRelayIndexRead, ClassRelayUnit, ScopeInternal, LevelHigh, "read relay index in %s", "")
+	ErrRelayIndexWrite                   = New(codeRelayIndexWrite, ClassRelayUnit, ScopeInternal, LevelHigh, "write relay index in %s", "")
 
 	// Dump unit error.
 	ErrDumpUnitRuntime        = New(codeDumpUnitRuntime, ClassDumpUnit, ScopeInternal, LevelHigh, "mydumper/dumpling runs with error, with output (may empty): %s", "")
@@ -1164,6 +1207,16 @@ var (
 	ErrSyncerDownstreamTableNotFound        = New(codeSyncerDownstreamTableNotFound, ClassSyncUnit, ScopeInternal, LevelHigh, "downstream table %s not found", "")
 	ErrSyncerCancelledDDL                   = New(codeSyncerCancelledDDL, ClassSyncUnit, ScopeInternal, LevelHigh, "DDL %s executed in background and met error", "Please manually check the error from TiDB and handle it.")
 	ErrSyncerReprocessWithSafeModeFail      = New(codeSyncerReprocessWithSafeModeFail, ClassSyncUnit, ScopeInternal, LevelMedium, "your `safe-mode-duration` in task.yaml is set to 0s, the task can't be re-processed without safe mode currently", "Please stop and re-start this task. If you want to start task successfully, you need set `safe-mode-duration` greater than `0s`.")
+	ErrSyncerDelayNotEnabled                = New(codeSyncerDelayNotEnabled, ClassSyncUnit, ScopeInternal, LevelLow, "delayed replication is not enabled for this subtask", "Please set `delay` in syncer configuration items first.")
+	ErrSyncerResyncTableUnsupported         = New(codeSyncerResyncTableUnsupported, ClassSyncUnit, ScopeInternal, LevelLow, "can't resync tables %v: %s", "")
+	ErrSyncerResyncTableInProgress          = New(codeSyncerResyncTableInProgress, ClassSyncUnit, ScopeInternal, LevelLow, "tables %v are being resynced", "Please wait until the running resync is finished.")
+	ErrSyncerResyncTableFailed              = New(codeSyncerResyncTableFailed, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to resync tables %v", "Please resume the task, the tables will be dumped and loaded again.")
+	ErrSyncerResyncTableDDL                 = New(codeSyncerResyncTableDDL, ClassSyncUnit, ScopeInternal, LevelHigh, "DDL %s on table %s is met when the table is being resynced", "Please resume the task and resync the table again after the DDL is replicated.")
+	ErrSyncerUpdateRulesUnsupported         = New(codeSyncerUpdateRulesUnsupported, ClassSyncUnit, ScopeInternal, LevelLow, "can't update rules of the running subtask: %s", "Please pause the task, update the task config and resume the task instead.")
+	ErrSyncerUpdateRulesInProgress          = New(codeSyncerUpdateRulesInProgress, ClassSyncUnit, ScopeInternal, LevelLow, "another update of rules is waiting to be applied", "Please wait until the pending update is applied or retry later.")
+	ErrSyncerWriteMQ                        = New(codeSyncerWriteMQ, ClassSyncUnit, ScopeDownstream, LevelHigh, "fail to write events to message queue", "Please check the status of the message queue and resume the task.")
+	ErrSyncerMQTableInfoNotFound            = New(codeSyncerMQTableInfoNotFound, ClassSyncUnit, ScopeInternal, LevelHigh, "table structure of %s at the binlog location is unknown when writing to message queue", "Please set the table structure at the binlog location by `binlog-schema update`, or by `binlog-schema update --from-source` if it's not changed since the location, and resume the task.")
+	ErrSyncerUnsafePartialJSONUpdate        = New(codeSyncerUnsafePartialJSONUpdate, ClassSyncUnit, ScopeUpstream, LevelHigh, "partial JSON update of column %s can't be executed repeatedly in safe mode, and its full value can't be computed because the value before update is not logged", "Please set `binlog_row_image` to FULL or `binlog_row_value_options` to '' in upstream, or disable the safe mode if the binlog events are not replicated before.")
 
 	// DM-master error.
 	ErrMasterSQLOpNilRequest        = New(codeMasterSQLOpNilRequest, ClassDMMaster, ScopeInternal, LevelMedium, "nil request not valid", "")
@@ -1229,6 +1282,10 @@ var (
 	ErrMasterOptimisticDownstreamMetaNotFound  = New(codeMasterOptimisticDownstreamMetaNotFound, ClassDMMaster, ScopeInternal, LevelHigh, "downstream database config and meta for task %s not found", "")
 	ErrMasterInvalidClusterID                  = New(codeMasterInvalidClusterID, ClassDMMaster, ScopeInternal, LevelHigh, "invalid cluster id: %v", "")
 	ErrMasterStartTask                         = New(codeMasterStartTask, ClassDMMaster, ScopeInternal, LevelHigh, "can not start task: %s reason: %s", "")
+	ErrMasterConfigRebalanceIntervalParse      = New(codeMasterConfigRebalanceIntervalParse, ClassDMMaster, ScopeInternal, LevelMedium, "parse rebalance interval str", "Please check the `rebalance-interval` config in master configuration file, it should be a duration such as `5m`.")
+	ErrMasterCutoverNotExist                   = New(codeMasterCutoverNotExist, ClassDMMaster, ScopeInternal, LevelMedium, "cutover of task %s not exist", "Please use `cutover start` command to start it.")
+	ErrMasterCutoverInProgress                 = New(codeMasterCutoverInProgress, ClassDMMaster, ScopeInternal, LevelMedium, "cutover of task %s is %s", "Please use `cutover status` command to see it, or `cutover rollback` command to roll it back.")
+	ErrMasterCutoverValidation                 = New(codeMasterCutoverValidation, ClassDMMaster, ScopeInternal, LevelMedium, "validation of source %s is not passed for cutover: %s", "Please use `validation status` and `validation show-error` commands to check the validator, or skip the validation.")
 
 	// DM-worker error.
 	ErrWorkerParseFlagSet            = New(codeWorkerParseFlagSet, ClassDMWorker, ScopeInternal, LevelMedium, "parse dm-worker config flag set", "")
@@ -1344,6 +1401,7 @@ var (
 	ErrValidatorNotFound          = New(codeValidatorNotFound, ClassValidator, ScopeNotSet, LevelMedium, "validator not found for task %s with source %s", "")
 	ErrValidatorPanic             = New(codeValidatorPanic, ClassValidator, ScopeInternal, LevelHigh, "panic error: %v", "")
 	ErrValidatorTooMuchPending    = New(codeValidatorTooMuchPending, ClassValidator, ScopeInternal, LevelMedium, "too much pending data, stop validator. row size(curr/max): %d/%d, row count(curr/max): %d/%d", "")
+	ErrValidatorSnapshotCheck     = New(codeValidatorSnapshotCheck, ClassValidator, ScopeInternal, LevelMedium, "fail to start snapshot check: %s", "Please use `validation status` command to check the validator.")
 
 	// Schema-tracker error.
 	ErrSchemaTrackerInvalidJSON        = New(codeSchemaTrackerInvalidJSON, ClassSchemaTracker, ScopeDownstream, LevelHigh, "saved schema of `%s`.`%s` is not proper JSON", "")
@@ -0,0 +1,210 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// the p99 latency is evaluated after at least adaptiveInterval and adaptiveMinSamples executions,
+	// or right after adaptiveMaxSamples executions.
+	adaptiveInterval   = 5 * time.Second
+	adaptiveMinSamples = 20
+	adaptiveMaxSamples = 10000
+
+	decreaseRatio   = 0.7
+	increaseRatio   = 1.2
+	minAdaptiveRows = 10
+)
+
+// Limiter limits the rows and bytes written to the downstream per second.
+// When a target latency is set, the rows limit is adjusted by the p99 latency
+// of downstream executions reported by Observe: it's lowered multiplicatively
+// when the p99 latency exceeds the target, and raised back gradually until it
+// reaches the static limit or is no longer reached.
+type Limiter struct {
+	mu     sync.Mutex
+	logger log.Logger
+
+	rowsPerSecond  int
+	bytesPerSecond int64
+	targetLatency  time.Duration
+
+	rows  *rate.Limiter
+	bytes *rate.Limiter
+
+	// adaptiveRows is the rows limit lowered by the downstream latency, 0 means not lowered.
+	adaptiveRows float64
+	latencies    []time.Duration
+	windowRows   int
+	windowStart  time.Time
+
+	now func() time.Time
+}
+
+// NewLimiter creates a Limiter, zero limit means no limit and zero targetLatency disables adaptive throttling.
+func NewLimiter(logger log.Logger, rowsPerSecond int, bytesPerSecond int64, targetLatency time.Duration) *Limiter {
+	l := &Limiter{
+		logger: logger.WithFields(zap.String("component", "throttle")),
+		rows:   rate.NewLimiter(rate.Inf, 0),
+		bytes:  rate.NewLimiter(rate.Inf, 0),
+		now:    time.Now,
+	}
+	l.Update(rowsPerSecond, bytesPerSecond, targetLatency)
+	return l
+}
+
+// Update updates the limits at runtime, the rows limit lowered by adaptive throttling is reset.
+func (l *Limiter) Update(rowsPerSecond int, bytesPerSecond int64, targetLatency time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rowsPerSecond = rowsPerSecond
+	l.bytesPerSecond = bytesPerSecond
+	l.targetLatency = targetLatency
+	l.adaptiveRows = 0
+	l.resetWindow()
+	setLimit(l.rows, float64(rowsPerSecond))
+	setLimit(l.bytes, float64(bytesPerSecond))
+	l.logger.Info("update throttle limits",
+		zap.Int("rows per second", rowsPerSecond),
+		zap.Int64("bytes per second", bytesPerSecond),
+		zap.Duration("target latency", targetLatency))
+}
+
+// RowsLimit returns the rows limit currently in effect, 0 means no limit.
+func (l *Limiter) RowsLimit() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rowsLimit()
+}
+
+// Wait blocks until rows and bytes are allowed to be written, or ctx is done.
+func (l *Limiter) Wait(ctx context.Context, rows, bytes int) error {
+	if err := waitN(ctx, l.rows, rows); err != nil {
+		return err
+	}
+	return waitN(ctx, l.bytes, bytes)
+}
+
+// Observe records the latency of a downstream execution which writes rows.
+func (l *Limiter) Observe(latency time.Duration, rows int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.targetLatency <= 0 {
+		return
+	}
+	l.latencies = append(l.latencies, latency)
+	l.windowRows += rows
+	elapsed := l.now().Sub(l.windowStart)
+	if len(l.latencies) < adaptiveMaxSamples &&
+		(elapsed < adaptiveInterval || len(l.latencies) < adaptiveMinSamples) {
+		return
+	}
+
+	p99 := percentile(l.latencies, 0.99)
+	observed := float64(l.windowRows) / math.Max(elapsed.Seconds(), 0.001)
+	l.resetWindow()
+
+	prev := l.adaptiveRows
+	switch {
+	case p99 > l.targetLatency:
+		limit := l.rowsLimit()
+		if limit == 0 || observed < limit {
+			limit = observed
+		}
+		l.adaptiveRows = math.Max(limit*decreaseRatio, minAdaptiveRows)
+	case l.adaptiveRows > 0:
+		l.adaptiveRows *= increaseRatio
+		// the lowered limit is not needed anymore.
+		if (l.rowsPerSecond > 0 && l.adaptiveRows >= float64(l.rowsPerSecond)) || l.adaptiveRows > 2*observed {
+			l.adaptiveRows = 0
+		}
+	}
+	if l.adaptiveRows == prev {
+		return
+	}
+	setLimit(l.rows, l.rowsLimit())
+	l.logger.Info("adjust rows limit by downstream latency",
+		zap.Duration("p99 latency", p99),
+		zap.Duration("target latency", l.targetLatency),
+		zap.Float64("observed rows per second", observed),
+		zap.Float64("rows limit", l.rowsLimit()))
+}
+
+func (l *Limiter) rowsLimit() float64 {
+	limit := float64(l.rowsPerSecond)
+	if l.adaptiveRows > 0 && (limit == 0 || l.adaptiveRows < limit) {
+		limit = l.adaptiveRows
+	}
+	return limit
+}
+
+func (l *Limiter) resetWindow() {
+	l.latencies = l.latencies[:0]
+	l.windowRows = 0
+	l.windowStart = l.now()
+}
+
+func setLimit(lim *rate.Limiter, limit float64) {
+	if limit <= 0 {
+		lim.SetLimit(rate.Inf)
+		return
+	}
+	lim.SetLimit(rate.Limit(limit))
+	lim.SetBurst(int(math.Ceil(limit)))
+}
+
+// waitN waits for n tokens in pieces no larger than the burst.
+func waitN(ctx context.Context, lim *rate.Limiter, n int) error {
+	for n > 0 {
+		m := n
+		if burst := lim.Burst(); lim.Limit() != rate.Inf && m > burst {
+			m = burst
+		}
+		if err := lim.WaitN(ctx, m); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// the limit may be updated concurrently, retry with the new burst.
+			if lim.Limit() == rate.Inf || m > lim.Burst() {
+				continue
+			}
+			return err
+		}
+		n -= m
+	}
+	return nil
+}
+
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	idx := int(math.Ceil(float64(len(sorted))*p)) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
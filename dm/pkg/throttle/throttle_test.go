@@ -0,0 +1,130 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterWait(t *testing.T) {
+	l := NewLimiter(log.L(), 0, 0, 0)
+	ctx := context.Background()
+	// no limit
+	require.NoError(t, l.Wait(ctx, 1000000, 1000000))
+
+	l.Update(100, 0, 0)
+	require.Equal(t, float64(100), l.RowsLimit())
+	start := time.Now()
+	// more rows than the burst are waited in pieces
+	require.NoError(t, l.Wait(ctx, 150, 1000000))
+	require.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+
+	l.Update(0, 10, 0)
+	require.Equal(t, float64(0), l.RowsLimit())
+	ctx2, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, l.Wait(ctx2, 0, 100), context.Canceled)
+
+	// update while waiting
+	l.Update(0, 1, 0)
+	done := make(chan error)
+	go func() {
+		done <- l.Wait(ctx, 0, 100)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	l.Update(0, 0, 0)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "wait is not released by update")
+	}
+}
+
+func TestLimiterAdaptive(t *testing.T) {
+	now := time.Now()
+	l := NewLimiter(log.L(), 0, 0, 0)
+	l.now = func() time.Time { return now }
+	observe := func(latency time.Duration, times, rows int) {
+		for i := 0; i < times; i++ {
+			l.Observe(latency, rows)
+		}
+	}
+
+	// adaptive throttling is disabled
+	now = now.Add(time.Minute)
+	observe(time.Second, adaptiveMinSamples, 100)
+	require.Equal(t, float64(0), l.RowsLimit())
+
+	l.Update(0, 0, 100*time.Millisecond)
+	// not enough samples
+	now = now.Add(10 * time.Second)
+	observe(time.Second, adaptiveMinSamples-1, 100)
+	require.Equal(t, float64(0), l.RowsLimit())
+	// 2000 rows in 10s and p99 exceeds the target, limit to 70% of observed rate
+	observe(time.Second, 1, 100)
+	require.InDelta(t, 140, l.RowsLimit(), 0.01)
+
+	// still slow, lowered again
+	now = now.Add(10 * time.Second)
+	observe(time.Second, adaptiveMinSamples, 70)
+	require.InDelta(t, 98, l.RowsLimit(), 0.01)
+
+	// fast again, raised gradually
+	now = now.Add(10 * time.Second)
+	observe(time.Millisecond, adaptiveMinSamples, 49)
+	require.InDelta(t, 117.6, l.RowsLimit(), 0.01)
+	// the limit is not reached, reset to no limit
+	now = now.Add(10 * time.Second)
+	observe(time.Millisecond, adaptiveMinSamples, 1)
+	require.Equal(t, float64(0), l.RowsLimit())
+
+	// the lowered limit never exceeds the static limit
+	l.Update(50, 0, 100*time.Millisecond)
+	now = now.Add(10 * time.Second)
+	observe(time.Second, adaptiveMinSamples, 100)
+	require.InDelta(t, 35, l.RowsLimit(), 0.01)
+	now = now.Add(10 * time.Second)
+	observe(time.Millisecond, adaptiveMinSamples, 20)
+	require.InDelta(t, 42, l.RowsLimit(), 0.01)
+	now = now.Add(10 * time.Second)
+	observe(time.Millisecond, adaptiveMinSamples, 25)
+	require.Equal(t, float64(50), l.RowsLimit())
+
+	// never lower than the minimal limit
+	now = now.Add(10 * time.Second)
+	observe(time.Second, adaptiveMinSamples, 1)
+	require.Equal(t, float64(minAdaptiveRows), l.RowsLimit())
+
+	// update resets the lowered limit
+	l.Update(0, 0, 100*time.Millisecond)
+	require.Equal(t, float64(0), l.RowsLimit())
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := make([]time.Duration, 0, 200)
+	for i := 200; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	require.Equal(t, 198*time.Millisecond, percentile(latencies, 0.99))
+	require.Equal(t, 100*time.Millisecond, percentile(latencies, 0.5))
+	require.Equal(t, time.Millisecond, percentile(latencies[199:], 0.99))
+	// the input is not modified
+	require.Equal(t, 200*time.Millisecond, latencies[0])
+}
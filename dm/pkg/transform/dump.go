@@ -0,0 +1,332 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/ddl"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	readBlockSize = 64 * 1024
+	// rows are grouped into INSERT statements of about this size, like dumpling does.
+	statementSize = 1024 * 1024
+	tmpFileSuffix = ".transform.tmp"
+)
+
+// DumpRewriter rewrites the SQL files dumped by dumpling for the tables which have
+// column transforms, so the loader imports the transformed values.
+type DumpRewriter struct {
+	storage     bstorage.ExternalStorage
+	transforms  []*config.ColumnTransform
+	sqlMode     mysql.SQLMode
+	sessionVars map[string]string
+	threads     int
+	logger      log.Logger
+}
+
+// NewDumpRewriter creates a DumpRewriter. `sessionVars` should be the session variables of
+// dumping, so the expressions are evaluated as in the sync unit.
+func NewDumpRewriter(
+	storage bstorage.ExternalStorage,
+	transforms []*config.ColumnTransform,
+	sqlMode string,
+	sessionVars map[string]string,
+	threads int,
+	logger log.Logger,
+) (*DumpRewriter, error) {
+	mode, err := mysql.GetSQLMode(sqlMode)
+	if err != nil {
+		return nil, err
+	}
+	if threads <= 0 {
+		threads = 1
+	}
+	return &DumpRewriter{
+		storage:     storage,
+		transforms:  transforms,
+		sqlMode:     mode,
+		sessionVars: sessionVars,
+		threads:     threads,
+		logger:      logger.WithFields(zap.String("component", "dump rewriter")),
+	}, nil
+}
+
+// Rewrite rewrites all data files of the transformed tables.
+func (r *DumpRewriter) Rewrite(ctx context.Context) error {
+	tables := make(map[string]*filter.Table, len(r.transforms))
+	for _, t := range r.transforms {
+		table := &filter.Table{Schema: t.Schema, Name: t.Table}
+		tables[utils.GenTableID(table)] = table
+	}
+
+	schemaFiles := make(map[string]string) // tableID -> schema file
+	dataFiles := make(map[string][]string) // tableID -> data files
+	err := r.storage.WalkDir(ctx, &bstorage.WalkOption{}, func(filePath string, _ int64) error {
+		name := path.Base(filePath)
+		if db, tb, ok := utils.GetTableFromDumpFilename(name); ok {
+			tableID := utils.GenTableID(&filter.Table{Schema: db, Name: tb})
+			if _, ok2 := tables[tableID]; ok2 {
+				schemaFiles[tableID] = filePath
+			}
+			return nil
+		}
+		for tableID, table := range tables {
+			if isDataFileOf(name, table) {
+				dataFiles[tableID] = append(dataFiles[tableID], filePath)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	tableInfos := make(map[string]*model.TableInfo, len(dataFiles))
+	for tableID, files := range dataFiles {
+		schemaFile, ok := schemaFiles[tableID]
+		if !ok {
+			return errors.Errorf("schema file of table %s not found", tableID)
+		}
+		for _, file := range files {
+			if !strings.HasSuffix(file, ".sql") {
+				return errors.Errorf("can't transform columns of table %s in %s, only uncompressed SQL files are supported", tableID, file)
+			}
+		}
+		if tableInfos[tableID], err = r.readTableInfo(ctx, schemaFile); err != nil {
+			return err
+		}
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(r.threads)
+	for tableID, files := range dataFiles {
+		table, ti := tables[tableID], tableInfos[tableID]
+		for _, file := range files {
+			file := file
+			eg.Go(func() error {
+				// each file uses its own group because expression evaluation is not thread safe
+				g := NewGroup(utils.NewSessionCtx(r.sessionVars), r.transforms, r.logger)
+				return r.rewriteFile(egCtx, g, table, ti, file)
+			})
+		}
+	}
+	return eg.Wait()
+}
+
+// isDataFileOf returns whether the dumped file is a data file of the table, its name is
+// `{db}.{table}.{index}.{ext}` or `{db}.{table}.{ext}`.
+func isDataFileOf(name string, table *filter.Table) bool {
+	prefix := table.Schema + "." + table.Name + "."
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	rest := strings.TrimPrefix(name, prefix)
+	if idx := strings.IndexByte(rest, '.'); idx >= 0 {
+		if strings.Trim(rest[:idx], "0123456789") == "" {
+			rest = rest[idx+1:]
+		}
+	}
+	return rest == "sql" || rest == "csv" || rest == "parquet" || strings.HasPrefix(rest, "sql.") || strings.HasPrefix(rest, "csv.")
+}
+
+// readTableInfo builds the table structure from the dumped schema file.
+func (r *DumpRewriter) readTableInfo(ctx context.Context, schemaFile string) (*model.TableInfo, error) {
+	content, err := r.storage.ReadFile(ctx, schemaFile)
+	if err != nil {
+		return nil, err
+	}
+	p := parser.New()
+	p.SetSQLMode(r.sqlMode)
+	stmts, _, err := p.Parse(string(content), "", "")
+	if err != nil {
+		return nil, errors.Annotatef(err, "parse schema file %s", schemaFile)
+	}
+	for _, stmt := range stmts {
+		if create, ok := stmt.(*ast.CreateTableStmt); ok {
+			return ddl.BuildTableInfoFromAST(create)
+		}
+	}
+	return nil, errors.Errorf("no CREATE TABLE statement in schema file %s", schemaFile)
+}
+
+// rewriteFile rewrites the data file to a temporary file and replaces the original one with it.
+func (r *DumpRewriter) rewriteFile(ctx context.Context, g *Group, table *filter.Table, ti *model.TableInfo, file string) error {
+	reader, err := r.storage.Open(ctx, file, nil)
+	if err != nil {
+		return err
+	}
+	ioWorkers := worker.NewPool(ctx, 1, "transform")
+	chunkParser := mydump.NewChunkParser(ctx, r.sqlMode, reader, readBlockSize, ioWorkers)
+	defer chunkParser.Close()
+
+	tmpFile := file + tmpFileSuffix
+	writer, err := r.storage.Create(ctx, tmpFile, nil)
+	if err != nil {
+		return err
+	}
+	var (
+		buf         bytes.Buffer
+		stmtColumns []string
+		stmtSize    int
+		rows        int
+	)
+	buf.WriteString("/*!40101 SET NAMES binary*/;\n")
+	flush := func() error {
+		if _, err2 := writer.Write(ctx, buf.Bytes()); err2 != nil {
+			return err2
+		}
+		buf.Reset()
+		return nil
+	}
+	for {
+		err = chunkParser.ReadRow()
+		if errors.Cause(err) == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = writer.Close(ctx)
+			return errors.Annotatef(err, "parse data file %s", file)
+		}
+		row := chunkParser.LastRow()
+		columns := chunkParser.Columns()
+		if err = g.transformDatums(table, ti, columns, row.Row); err != nil {
+			_ = writer.Close(ctx)
+			return err
+		}
+
+		start := buf.Len()
+		if stmtSize == 0 || stmtSize >= statementSize || !equalColumns(columns, stmtColumns) {
+			if stmtSize > 0 {
+				buf.WriteString(";\n")
+			}
+			writeInsertHeader(&buf, table.Name, columns)
+			stmtColumns = columns
+			stmtSize = 0
+		} else {
+			buf.WriteString(",\n")
+		}
+		writeRow(&buf, row.Row, r.sqlMode.HasNoBackslashEscapesMode())
+		stmtSize += buf.Len() - start
+		rows++
+		chunkParser.RecycleRow(row)
+
+		if buf.Len() >= statementSize {
+			if err = flush(); err != nil {
+				_ = writer.Close(ctx)
+				return err
+			}
+		}
+	}
+	if stmtSize > 0 {
+		buf.WriteString(";\n")
+	}
+	if err = flush(); err != nil {
+		_ = writer.Close(ctx)
+		return err
+	}
+	if err = writer.Close(ctx); err != nil {
+		return err
+	}
+	if err = r.storage.Rename(ctx, tmpFile, file); err != nil {
+		return err
+	}
+	r.logger.Info("rewrite dumped file", zap.String("file", file), zap.Int("rows", rows))
+	return nil
+}
+
+func equalColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeInsertHeader(buf *bytes.Buffer, table string, columns []string) {
+	buf.WriteString("INSERT INTO ")
+	buf.WriteString(quotes.QuoteName(table))
+	if len(columns) > 0 {
+		buf.WriteString(" (")
+		for i, col := range columns {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(quotes.QuoteName(col))
+		}
+		buf.WriteByte(')')
+	}
+	buf.WriteString(" VALUES\n")
+}
+
+func writeRow(buf *bytes.Buffer, row []types.Datum, noBackslashEscapes bool) {
+	buf.WriteByte('(')
+	for i := range row {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeDatum(buf, &row[i], noBackslashEscapes)
+	}
+	buf.WriteByte(')')
+}
+
+// writeDatum writes the datum as an SQL literal.
+func writeDatum(buf *bytes.Buffer, d *types.Datum, noBackslashEscapes bool) {
+	switch d.Kind() {
+	case types.KindNull:
+		buf.WriteString("NULL")
+	case types.KindInt64, types.KindUint64:
+		s, _ := d.ToString()
+		buf.WriteString(s)
+	case types.KindBytes, types.KindBinaryLiteral, types.KindMysqlBit:
+		buf.WriteString("x'")
+		buf.WriteString(hex.EncodeToString(d.GetBytes()))
+		buf.WriteByte('\'')
+	default:
+		s, _ := d.ToString()
+		if !noBackslashEscapes {
+			s = strings.ReplaceAll(s, `\`, `\\`)
+		}
+		buf.WriteByte('\'')
+		buf.WriteString(strings.ReplaceAll(s, "'", "''"))
+		buf.WriteByte('\'')
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	tidbtable "github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// columnExpr is a compiled column transform.
+type columnExpr struct {
+	offset int
+	expr   expression.Expression
+}
+
+// Group groups the column transforms of upstream tables. The expressions are evaluated on
+// the original row in the same way as expression filters, so the dump unit and the sync unit
+// generate the same values.
+type Group struct {
+	configs map[string][]*config.ColumnTransform // tableID -> raw config
+	exprs   map[string][]columnExpr              // tableID -> compiled expressions
+
+	tidbCtx sessionctx.Context
+	logger  log.Logger
+}
+
+// NewGroup creates a Group, it returns nil when there's no transform.
+func NewGroup(tidbCtx sessionctx.Context, transforms []*config.ColumnTransform, logger log.Logger) *Group {
+	if len(transforms) == 0 {
+		return nil
+	}
+	g := &Group{
+		configs: make(map[string][]*config.ColumnTransform),
+		exprs:   make(map[string][]columnExpr),
+		tidbCtx: tidbCtx,
+		logger:  logger.WithFields(zap.String("component", "column transform")),
+	}
+	for _, t := range transforms {
+		tableID := utils.GenTableID(&filter.Table{Schema: t.Schema, Name: t.Table})
+		g.configs[tableID] = append(g.configs[tableID], t)
+	}
+	return g
+}
+
+// HasTransform returns whether the upstream table has column transforms.
+func (g *Group) HasTransform(table *filter.Table) bool {
+	if g == nil {
+		return false
+	}
+	_, ok := g.configs[utils.GenTableID(table)]
+	return ok
+}
+
+// IsTransformed returns whether the column of the upstream table is transformed.
+func (g *Group) IsTransformed(table *filter.Table, column string) bool {
+	if g == nil {
+		return false
+	}
+	for _, c := range g.configs[utils.GenTableID(table)] {
+		if strings.EqualFold(c.Column, column) {
+			return true
+		}
+	}
+	return false
+}
+
+// getExprs returns the compiled transforms of the table, they are lazily compiled by
+// the table structure and cached until ResetExprs is called.
+func (g *Group) getExprs(table *filter.Table, ti *model.TableInfo) ([]columnExpr, error) {
+	tableID := utils.GenTableID(table)
+	if ret, ok := g.exprs[tableID]; ok {
+		return ret, nil
+	}
+
+	ret := make([]columnExpr, 0, len(g.configs[tableID]))
+	for _, c := range g.configs[tableID] {
+		offset := columnOffset(ti, c.Column)
+		if offset < 0 {
+			g.logger.Warn("transformed column doesn't exist in table, skip it",
+				zap.String("table", tableID), zap.String("column", c.Column))
+			continue
+		}
+		expr, err := expression.ParseSimpleExprWithTableInfo(g.tidbCtx.GetExprCtx(), c.Expression(), ti)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidColumnTransform.Delegate(err, c.Column, "fail to build expression of table "+tableID)
+		}
+		ret = append(ret, columnExpr{offset: offset, expr: expr})
+	}
+	g.exprs[tableID] = ret
+	return ret, nil
+}
+
+// ResetExprs deletes the expressions generated before. This should be called after table structure changed.
+func (g *Group) ResetExprs(table *filter.Table) {
+	if g == nil {
+		return
+	}
+	delete(g.exprs, utils.GenTableID(table))
+}
+
+// TransformRow returns the row with transformed values, `row` is the values in binlog
+// which is adjusted by the upstream table structure `ti`. The input row is not modified.
+func (g *Group) TransformRow(table *filter.Table, ti *model.TableInfo, row []interface{}) ([]interface{}, error) {
+	if !g.HasTransform(table) || row == nil {
+		return row, nil
+	}
+	exprs, err := g.getExprs(table, ti)
+	if err != nil || len(exprs) == 0 {
+		return row, err
+	}
+
+	values := row
+	if len(values) > len(ti.Columns) {
+		// the extended columns of routes are appended to the row
+		values = values[:len(ti.Columns)]
+	}
+	data, err := utils.AdjustBinaryProtocolForDatum(g.tidbCtx, values, ti.Columns)
+	if err != nil {
+		return nil, err
+	}
+	r := chunk.MutRowFromDatums(data).ToRow()
+	ret := make([]interface{}, len(row))
+	copy(ret, row)
+	for _, e := range exprs {
+		d, err := e.expr.Eval(g.tidbCtx.GetExprCtx(), r)
+		if err != nil {
+			return nil, err
+		}
+		ret[e.offset] = datumValue(d)
+	}
+	return ret, nil
+}
+
+// transformDatums transforms the values of a row in dumped files in place. `columns` are
+// the lower-case column names of `row`, and nil means all columns of the table in order.
+func (g *Group) transformDatums(table *filter.Table, ti *model.TableInfo, columns []string, row []types.Datum) error {
+	exprs, err := g.getExprs(table, ti)
+	if err != nil || len(exprs) == 0 {
+		return err
+	}
+
+	// offset in the table -> offset in the row
+	rowOffsets := make([]int, len(ti.Columns))
+	for i := range rowOffsets {
+		rowOffsets[i] = -1
+	}
+	if columns == nil {
+		for i := range ti.Columns {
+			if i < len(row) {
+				rowOffsets[i] = i
+			}
+		}
+	} else {
+		for i, col := range columns {
+			if offset := columnOffset(ti, col); offset >= 0 {
+				rowOffsets[offset] = i
+			}
+		}
+	}
+
+	data := make([]types.Datum, len(ti.Columns))
+	for i, col := range ti.Columns {
+		if rowOffsets[i] < 0 {
+			data[i].SetNull()
+			continue
+		}
+		data[i], err = tidbtable.CastValue(g.tidbCtx, row[rowOffsets[i]], col, false, false)
+		if err != nil {
+			return err
+		}
+	}
+	r := chunk.MutRowFromDatums(data).ToRow()
+	for _, e := range exprs {
+		if rowOffsets[e.offset] < 0 {
+			continue
+		}
+		d, err := e.expr.Eval(g.tidbCtx.GetExprCtx(), r)
+		if err != nil {
+			return err
+		}
+		row[rowOffsets[e.offset]] = d
+	}
+	return nil
+}
+
+// columnOffset returns the offset of the column in the table, or -1 if it doesn't exist.
+func columnOffset(ti *model.TableInfo, column string) int {
+	for i, col := range ti.Columns {
+		if strings.EqualFold(col.Name.O, column) {
+			return i
+		}
+	}
+	return -1
+}
+
+// datumValue converts the evaluated datum to the value used as SQL arguments.
+func datumValue(d types.Datum) interface{} {
+	switch d.Kind() {
+	case types.KindNull:
+		return nil
+	case types.KindInt64:
+		return d.GetInt64()
+	case types.KindUint64:
+		return d.GetUint64()
+	case types.KindFloat32, types.KindFloat64:
+		return d.GetFloat64()
+	case types.KindString:
+		return d.GetString()
+	case types.KindBytes, types.KindBinaryLiteral, types.KindMysqlBit:
+		return d.GetBytes()
+	default:
+		s, _ := d.ToString()
+		return s
+	}
+}
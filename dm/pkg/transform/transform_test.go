@@ -0,0 +1,156 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/ddl"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const createTable = "CREATE TABLE tb (id INT PRIMARY KEY, name VARCHAR(32), phone VARCHAR(32), email VARCHAR(64), note TEXT)"
+
+func mockTransforms(t *testing.T) []*config.ColumnTransform {
+	t.Helper()
+
+	transforms := []*config.ColumnTransform{
+		{Schema: "db", Table: "tb", Column: "name", Type: config.ColumnTransformHash, Salt: "s"},
+		{Schema: "db", Table: "tb", Column: "phone", Type: config.ColumnTransformMask, KeepPrefix: 3, KeepSuffix: 2},
+		{Schema: "db", Table: "tb", Column: "email", Type: config.ColumnTransformExpression, Expr: "CONCAT('user', id, '@example.com')"},
+		{Schema: "db", Table: "tb", Column: "note", Type: config.ColumnTransformConstant, Value: "it's"},
+		{Schema: "db", Table: "tb", Column: "not_exist", Type: config.ColumnTransformConstant},
+	}
+	for _, transform := range transforms {
+		require.NoError(t, transform.Verify(transform.Column))
+	}
+	return transforms
+}
+
+func mockTableInfo(t *testing.T) *model.TableInfo {
+	t.Helper()
+
+	stmt, err := parser.New().ParseOneStmt(createTable, "", "")
+	require.NoError(t, err)
+	ti, err := ddl.BuildTableInfoFromAST(stmt.(*ast.CreateTableStmt))
+	require.NoError(t, err)
+	return ti
+}
+
+func sha2(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestTransformRow(t *testing.T) {
+	t.Parallel()
+
+	g := NewGroup(utils.NewSessionCtx(nil), mockTransforms(t), log.L())
+	table := &filter.Table{Schema: "db", Name: "tb"}
+	ti := mockTableInfo(t)
+	require.True(t, g.HasTransform(table))
+	require.False(t, g.HasTransform(&filter.Table{Schema: "db", Name: "tb2"}))
+	require.True(t, g.IsTransformed(table, "NAME"))
+	require.False(t, g.IsTransformed(table, "id"))
+
+	row := []interface{}{int64(1), "alice", "13812345678", "alice@pingcap.com", "secret"}
+	ret, err := g.TransformRow(table, ti, row)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(1), sha2("salice"), "138******78", "user1@example.com", "it's"}, ret)
+	// the input row is not modified
+	require.Equal(t, "alice", row[1])
+
+	// NULL is kept by hash and mask, short values are fully masked
+	ret, err = g.TransformRow(table, ti, []interface{}{int64(2), nil, "1234", nil, nil})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(2), nil, "****", "user2@example.com", "it's"}, ret)
+
+	ret, err = g.TransformRow(&filter.Table{Schema: "db", Name: "tb2"}, ti, row)
+	require.NoError(t, err)
+	require.Equal(t, row, ret)
+
+	var nilGroup *Group
+	require.False(t, nilGroup.HasTransform(table))
+	require.False(t, nilGroup.IsTransformed(table, "name"))
+	nilGroup.ResetExprs(table)
+	require.Nil(t, NewGroup(utils.NewSessionCtx(nil), nil, log.L()))
+}
+
+func TestRewriteDumpFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"db.tb-schema.sql": "/*!40101 SET NAMES binary*/;\n" + createTable + ";\n",
+		"db.tb.000000000.sql": "/*!40101 SET NAMES binary*/;\n" +
+			"INSERT INTO `tb` (`id`,`name`,`phone`,`email`,`note`) VALUES\n" +
+			"(1,'alice','13812345678','alice@pingcap.com','a\\\\b'),\n" +
+			"(2,NULL,'1234',NULL,NULL);\n" +
+			"INSERT INTO `tb` (`id`,`name`) VALUES\n" +
+			"(3,'bob');\n",
+		"db.tb2.000000000.sql": "INSERT INTO `tb2` VALUES (1,'alice');\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	storage, err := bstorage.NewLocalStorage(dir)
+	require.NoError(t, err)
+	rewriter, err := NewDumpRewriter(storage, mockTransforms(t), "", nil, 2, log.L())
+	require.NoError(t, err)
+	require.NoError(t, rewriter.Rewrite(context.Background()))
+
+	content, err := os.ReadFile(filepath.Join(dir, "db.tb.000000000.sql"))
+	require.NoError(t, err)
+	require.Equal(t, "/*!40101 SET NAMES binary*/;\n"+
+		"INSERT INTO `tb` (`id`,`name`,`phone`,`email`,`note`) VALUES\n"+
+		"(1,'"+sha2("salice")+"','138******78','user1@example.com','it''s'),\n"+
+		"(2,NULL,'****','user2@example.com','it''s');\n"+
+		"INSERT INTO `tb` (`id`,`name`) VALUES\n"+
+		"(3,'"+sha2("sbob")+"');\n", string(content))
+	// other tables are not rewritten
+	content, err = os.ReadFile(filepath.Join(dir, "db.tb2.000000000.sql"))
+	require.NoError(t, err)
+	require.Equal(t, files["db.tb2.000000000.sql"], string(content))
+
+	// compressed files are not supported
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db.tb.000000001.sql.gz"), nil, 0o644))
+	require.ErrorContains(t, rewriter.Rewrite(context.Background()), "only uncompressed SQL files are supported")
+}
+
+func TestIsDataFileOf(t *testing.T) {
+	t.Parallel()
+
+	table := &filter.Table{Schema: "db", Name: "tb"}
+	require.True(t, isDataFileOf("db.tb.sql", table))
+	require.True(t, isDataFileOf("db.tb.000000001.sql", table))
+	require.True(t, isDataFileOf("db.tb.0001.csv", table))
+	require.True(t, isDataFileOf("db.tb.000000001.sql.zst", table))
+	require.False(t, isDataFileOf("db.tb-schema.sql", table))
+	require.False(t, isDataFileOf("db.tb2.000000001.sql", table))
+	require.False(t, isDataFileOf("db.tb.x.sql", table))
+}
@@ -0,0 +1,290 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// archiveHeadSize is the size of the head of an archived relay log file to check its previous
+// GTID set, which is in the first events of the file.
+const archiveHeadSize = 1024 * 1024
+
+// archiveReader reads relay log files archived by Archiver from the external storage.
+// archived files which are missing on local disk are restored into the relay directory,
+// so BinlogReader can read them like other local relay log files, and continue to read
+// the newer local relay log files seamlessly.
+type archiveReader struct {
+	storageURI string
+	relayDir   string
+	storage    bstorage.ExternalStorage
+
+	logger log.Logger
+}
+
+func newArchiveReader(logger log.Logger, storageURI, relayDir string) *archiveReader {
+	return &archiveReader{
+		storageURI: storageURI,
+		relayDir:   relayDir,
+		logger:     logger.WithFields(zap.String("component", "relay archive reader")),
+	}
+}
+
+func (a *archiveReader) init(ctx context.Context) error {
+	if a.storage != nil {
+		return nil
+	}
+	s, err := storage.CreateStorage(ctx, a.storageURI)
+	if err != nil {
+		return terror.ErrRelayRestoreArchivedFile.Delegate(err, a.storageURI)
+	}
+	a.storage = s
+	return nil
+}
+
+func (a *archiveReader) close() {
+	if a.storage != nil {
+		a.storage.Close()
+	}
+}
+
+// collectArchivedFiles returns archived relay log files in subDir, in binlog ascending order.
+func (a *archiveReader) collectArchivedFiles(ctx context.Context, subDir string) ([]utils.Filename, error) {
+	files := make([]utils.Filename, 0)
+	err := a.storage.WalkDir(ctx, &bstorage.WalkOption{SubDir: subDir}, func(filePath string, _ int64) error {
+		if path.Dir(filePath) != subDir {
+			return nil
+		}
+		if parsed, err := utils.ParseFilename(path.Base(filePath)); err == nil {
+			files = append(files, parsed)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, terror.ErrRelayRestoreArchivedFile.Delegate(err, subDir)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].BaseName != files[j].BaseName {
+			return files[i].BaseName < files[j].BaseName
+		}
+		return files[i].LessThan(files[j])
+	})
+	return files, nil
+}
+
+// collectMissingFiles returns archived relay log files in subDir which are earlier than
+// the earliest local relay log file, and whether there are local relay log files.
+func (a *archiveReader) collectMissingFiles(ctx context.Context, subDir string) ([]utils.Filename, bool, error) {
+	archived, err := a.collectArchivedFiles(ctx, subDir)
+	if err != nil {
+		return nil, false, err
+	}
+	dir := filepath.Join(a.relayDir, subDir)
+	if !utils.IsDirExists(dir) {
+		return archived, false, nil
+	}
+	local, err := CollectAllBinlogFiles(dir)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(local) == 0 {
+		return archived, false, nil
+	}
+	earliestLocal, err := utils.ParseFilename(local[0])
+	if err != nil {
+		return nil, false, err
+	}
+	missing := make([]utils.Filename, 0, len(archived))
+	for _, f := range archived {
+		if f.BaseName == earliestLocal.BaseName && f.LessThan(earliestLocal) {
+			missing = append(missing, f)
+		}
+	}
+	return missing, true, nil
+}
+
+// restoreFile restores the archived relay log file in subDir into the relay directory,
+// the relay meta of subDir is also restored if the sub directory has been purged.
+func (a *archiveReader) restoreFile(ctx context.Context, subDir, filename string) error {
+	dir := filepath.Join(a.relayDir, subDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return terror.ErrRelayRestoreArchivedFile.Delegate(err, dir)
+	}
+	metaPath := filepath.Join(dir, utils.MetaFilename)
+	if !utils.IsFileExists(metaPath) {
+		if err := a.downloadFile(ctx, path.Join(subDir, utils.MetaFilename), metaPath); err != nil {
+			return err
+		}
+	}
+	fullPath := filepath.Join(dir, filename)
+	if utils.IsFileExists(fullPath) {
+		return nil
+	}
+	a.logger.Info("restore archived relay log file", zap.String("file", fullPath))
+	return a.downloadFile(ctx, path.Join(subDir, filename), fullPath)
+}
+
+// downloadFile downloads name in the external storage to localPath atomically.
+func (a *archiveReader) downloadFile(ctx context.Context, name, localPath string) error {
+	r, err := a.storage.Open(ctx, name, nil)
+	if err != nil {
+		return terror.ErrRelayRestoreArchivedFile.Delegate(err, name)
+	}
+	defer r.Close()
+
+	tmpPath := localPath + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return terror.ErrRelayRestoreArchivedFile.Delegate(err, name)
+	}
+	_, err = io.Copy(f, r)
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, localPath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return terror.ErrRelayRestoreArchivedFile.Delegate(err, name)
+	}
+	return nil
+}
+
+// restoreFromPos restores archived relay log files from pos, until the earliest relay log
+// file on local disk. it's a no-op if the relay log file of pos exists on local disk.
+func (a *archiveReader) restoreFromPos(ctx context.Context, subDirs []string, pos mysql.Position) error {
+	subDir, _, realPos, err := binlog.ExtractPos(pos, subDirs)
+	if err != nil {
+		// let the caller report the invalid position
+		return nil
+	}
+	if utils.IsFileExists(filepath.Join(a.relayDir, subDir, realPos.Name)) {
+		return nil
+	}
+	startFile, err := utils.ParseFilename(realPos.Name)
+	if err != nil {
+		return nil
+	}
+	if err = a.init(ctx); err != nil {
+		return err
+	}
+
+	startIdx := 0
+	for i, dir := range subDirs {
+		if dir == subDir {
+			startIdx = i
+			break
+		}
+	}
+	var restored int
+	// later sub directories may be purged entirely, so also restore them until local relay log files met.
+	for i, dir := range subDirs[startIdx:] {
+		missing, hasLocal, err2 := a.collectMissingFiles(ctx, dir)
+		if err2 != nil {
+			return err2
+		}
+		for _, f := range missing {
+			if i == 0 && f.LessThan(startFile) {
+				continue
+			}
+			if err2 = a.restoreFile(ctx, dir, utils.ConstructFilename(f.BaseName, f.Seq)); err2 != nil {
+				return err2
+			}
+			restored++
+		}
+		if hasLocal {
+			break
+		}
+	}
+	a.logger.Info("restored archived relay log files", zap.Stringer("position", pos), zap.Int("count", restored))
+	return nil
+}
+
+// restoreByGTID finds the archived relay log file whose previous GTID set is covered by the
+// GTID set, by checking the head of archived files backward until covered returns true.
+// then the file and the later archived files are restored, and its position is returned.
+// nothing is restored if no archived file is covered.
+func (a *archiveReader) restoreByGTID(ctx context.Context, subDirs []string, covered func(filePath string) (bool, error)) (*mysql.Position, error) {
+	if err := a.init(ctx); err != nil {
+		return nil, err
+	}
+	for i := len(subDirs) - 1; i >= 0; i-- {
+		subDir := subDirs[i]
+		_, suffix, err := utils.ParseRelaySubDir(subDir)
+		if err != nil {
+			return nil, err
+		}
+		missing, _, err := a.collectMissingFiles(ctx, subDir)
+		if err != nil {
+			return nil, err
+		}
+		for j := len(missing) - 1; j >= 0; j-- {
+			file := utils.ConstructFilename(missing[j].BaseName, missing[j].Seq)
+			contain, err := a.checkFileHead(ctx, path.Join(subDir, file), covered)
+			if err != nil {
+				return nil, err
+			}
+			if !contain {
+				continue
+			}
+			pos := mysql.Position{
+				Name: utils.ConstructFilenameWithUUIDSuffix(missing[j], utils.SuffixIntToStr(suffix)),
+				Pos:  binlog.FileHeaderLen,
+			}
+			if err = a.restoreFromPos(ctx, subDirs, pos); err != nil {
+				return nil, err
+			}
+			return &pos, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkFileHead downloads the head of the archived relay log file to a temporary file, and
+// calls covered with it. the head contains the previous GTID set of the file.
+func (a *archiveReader) checkFileHead(ctx context.Context, name string, covered func(filePath string) (bool, error)) (bool, error) {
+	r, err := a.storage.Open(ctx, name, nil)
+	if err != nil {
+		return false, terror.ErrRelayRestoreArchivedFile.Delegate(err, name)
+	}
+	defer r.Close()
+	f, err := os.CreateTemp("", "relay-archive-head-*")
+	if err != nil {
+		return false, terror.ErrRelayRestoreArchivedFile.Delegate(err, name)
+	}
+	defer os.Remove(f.Name())
+	_, err = io.CopyN(f, r, archiveHeadSize)
+	if err2 := f.Close(); err == nil || err == io.EOF {
+		err = err2
+	}
+	if err != nil {
+		return false, terror.ErrRelayRestoreArchivedFile.Delegate(err, name)
+	}
+	return covered(f.Name())
+}
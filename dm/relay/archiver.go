@@ -0,0 +1,443 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/streamer"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	defaultArchiveInterval = 60 * time.Second
+	archiveBufferSize      = 4 * 1024 * 1024
+	// archiveManifestFilename is the file in the external storage which records the archived files.
+	archiveManifestFilename = "relay-archive.manifest"
+	// archiveVerifiedFilename is the file in the relay directory which records the local relay log
+	// files known to be archived, so they're not hashed again after the archiver is restarted.
+	archiveVerifiedFilename = "relay-archive.verified"
+)
+
+// archivedFile is an archived file recorded in the manifest.
+type archivedFile struct {
+	Size     int64  `json:"size"`
+	Checksum string `json:"checksum"` // hex encoded SHA-256 of the content
+}
+
+// localFileStamp identifies an unchanged local file without reading it.
+type localFileStamp struct {
+	Size    int64 `json:"size"`
+	ModTime int64 `json:"mod-time"` // unix nanoseconds
+}
+
+// verifiedFiles is the content of the verified file.
+type verifiedFiles struct {
+	// Storage is the external storage the files are archived to, the files
+	// are verified again if the archiver is configured with another storage.
+	Storage string                    `json:"storage"`
+	Files   map[string]localFileStamp `json:"files"`
+}
+
+// Archiver archives sealed relay log files and their meta to the external storage,
+// so they can still be read after being purged from local disk.
+// it's also an Operator and a PurgeInterceptor, to prevent the purger from purging
+// relay log files which are not archived yet.
+type Archiver interface {
+	Operator
+	PurgeInterceptor
+	// Start starts archiving in the background
+	Start()
+	// Close stops archiving
+	Close()
+}
+
+// NewArchiver creates a new archiver.
+var NewArchiver = NewRelayArchiver
+
+// relayArchiver archives relay log files periodically. a relay log file is sealed when it's
+// earlier than the active relay log of the relay unit, and it will never be changed.
+// the layout in the external storage is the same as the relay directory, like
+// `server-uuid.index`, `<sub-dir>/relay.meta`, `<sub-dir>/mysql-bin.000001`, and the
+// size and checksum of archived relay log files are recorded in `relay-archive.manifest`.
+// if relay log files failed to be archived for PurgeBlockTimeout, the archiver stops
+// protecting them from purging, so the relay directory will not run out of disk space.
+type relayArchiver struct {
+	lock    sync.RWMutex
+	wg      sync.WaitGroup
+	cancel  context.CancelFunc
+	running atomic.Int32
+
+	cfg          config.RelayArchiveConfig
+	baseRelayDir string
+	indexPath    string // server-uuid.index file path
+	relay        Operator
+
+	storage bstorage.ExternalStorage
+	// archived relay log files, keyed by the path in the external storage.
+	archived map[string]archivedFile
+	// local relay log files which are known to be archived, it's persisted in verifiedPath.
+	verified     map[string]localFileStamp
+	verifiedPath string
+	// the earliest relay log file which is not archived yet, nil before the first archiving.
+	earliest *streamer.RelayLogInfo
+	// the time of the latest successful archiving, or the time the archiver is created.
+	lastArchived time.Time
+
+	logger log.Logger
+}
+
+// NewRelayArchiver creates a new archiver, relay is used to get the active relay log.
+func NewRelayArchiver(cfg config.RelayArchiveConfig, baseRelayDir string, relay Operator) Archiver {
+	return &relayArchiver{
+		cfg:          cfg,
+		baseRelayDir: baseRelayDir,
+		indexPath:    filepath.Join(baseRelayDir, utils.UUIDIndexFilename),
+		verifiedPath: filepath.Join(baseRelayDir, archiveVerifiedFilename),
+		relay:        relay,
+		lastArchived: time.Now(),
+		logger:       log.With(zap.String("component", "relay archiver")),
+	}
+}
+
+// Start implements Archiver.Start.
+func (a *relayArchiver) Start() {
+	if !a.running.CAS(stageNew, stageRunning) {
+		return
+	}
+
+	a.logger.Info("starting relay log archiver", zap.String("storage", a.cfg.Storage), zap.Int64("interval", a.cfg.Interval))
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		a.run()
+	}()
+}
+
+// run archives relay log files until `Close`.
+func (a *relayArchiver) run() {
+	interval := time.Duration(a.cfg.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultArchiveInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var ctx context.Context
+	a.lock.Lock()
+	ctx, a.cancel = context.WithCancel(context.Background())
+	a.lock.Unlock()
+	for {
+		if err := a.archive(ctx); err != nil {
+			a.logger.Error("archive relay log files", zap.Error(err), zap.Time("last archived", a.getLastArchived()))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close implements Archiver.Close.
+func (a *relayArchiver) Close() {
+	if !a.running.CAS(stageRunning, stageClosed) {
+		return
+	}
+
+	a.logger.Info("closing relay log archiver")
+
+	a.lock.RLock()
+	if a.cancel != nil {
+		a.cancel()
+	}
+	a.lock.RUnlock()
+	a.wg.Wait()
+
+	if a.storage != nil {
+		a.storage.Close()
+	}
+}
+
+// EarliestActiveRelayLog implements Operator.EarliestActiveRelayLog.
+// relay log files not earlier than it are not archived yet, so they should not be purged.
+func (a *relayArchiver) EarliestActiveRelayLog() *streamer.RelayLogInfo {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if a.purgeBlockTimeoutLocked() {
+		return nil
+	}
+	return a.earliest
+}
+
+// ForbidPurge implements PurgeInterceptor.ForbidPurge.
+func (a *relayArchiver) ForbidPurge() (bool, string) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if a.purgeBlockTimeoutLocked() {
+		a.logger.Warn("relay log files failed to be archived for a long time, allow purging them without archived",
+			zap.Time("last archived", a.lastArchived), zap.Int64("purge block timeout(seconds)", a.cfg.PurgeBlockTimeout))
+		return false, ""
+	}
+	if a.earliest == nil {
+		return true, "relay log files have not been archived to external storage yet"
+	}
+	return false, ""
+}
+
+// purgeBlockTimeoutLocked returns whether relay log files failed to be archived for PurgeBlockTimeout.
+func (a *relayArchiver) purgeBlockTimeoutLocked() bool {
+	timeout := time.Duration(a.cfg.PurgeBlockTimeout) * time.Second
+	return timeout > 0 && time.Since(a.lastArchived) > timeout
+}
+
+func (a *relayArchiver) getLastArchived() time.Time {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.lastArchived
+}
+
+func (a *relayArchiver) setEarliest(subDir, filename string) {
+	_, suffix, _ := utils.ParseRelaySubDir(subDir)
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.earliest = &streamer.RelayLogInfo{
+		SubDir:       subDir,
+		SubDirSuffix: suffix,
+		Filename:     filename,
+	}
+}
+
+// archive archives all sealed relay log files which are not archived yet.
+func (a *relayArchiver) archive(ctx context.Context) error {
+	if a.storage == nil {
+		s, err := storage.CreateStorage(ctx, a.cfg.Storage)
+		if err != nil {
+			return terror.ErrRelayArchiveFile.Delegate(err, a.cfg.Storage)
+		}
+		archived, err := loadArchiveManifest(ctx, s)
+		if err != nil {
+			s.Close()
+			return terror.ErrRelayArchiveFile.Delegate(err, a.cfg.Storage)
+		}
+		a.storage, a.archived = s, archived
+		a.verified = a.loadVerified()
+	}
+
+	active := a.relay.EarliestActiveRelayLog()
+	if active == nil {
+		// no relay log file written yet
+		return nil
+	}
+	subDirs, err := utils.ParseUUIDIndex(a.indexPath)
+	if err != nil {
+		return terror.Annotatef(err, "parse UUID index file %s", a.indexPath)
+	}
+	files, err := getRelayFilesBeforeFile(a.logger, a.baseRelayDir, subDirs, active)
+	if err != nil {
+		return terror.Annotatef(err, "get relay files from directory %s before file %+v with UUIDs %v", a.baseRelayDir, active, subDirs)
+	}
+
+	var archivedCount int
+	// only the files still in the relay directory are kept.
+	verified := make(map[string]localFileStamp, len(a.verified))
+	for _, subRelay := range files {
+		subDir := filepath.Base(subRelay.dir)
+		var subDirArchived bool
+		for _, fp := range subRelay.files {
+			filename := filepath.Base(fp)
+			name := path.Join(subDir, filename)
+			fi, err2 := os.Stat(fp)
+			if err2 != nil {
+				return terror.ErrGetRelayLogStat.Delegate(err2, fp)
+			}
+			stamp := localFileStamp{Size: fi.Size(), ModTime: fi.ModTime().UnixNano()}
+			if a.verified[name] == stamp && a.archived[name].Size == stamp.Size {
+				verified[name] = stamp
+				continue
+			}
+			checksum, err2 := fileChecksum(fp)
+			if err2 != nil {
+				return terror.ErrRelayArchiveFile.Delegate(err2, fp)
+			}
+			file := archivedFile{Size: fi.Size(), Checksum: checksum}
+			if a.archived[name] != file {
+				// protect the file from purging until it's archived
+				a.setEarliest(subDir, filename)
+				if err2 = a.uploadFile(ctx, fp, name); err2 != nil {
+					return err2
+				}
+				a.archived[name] = file
+				subDirArchived = true
+				archivedCount++
+			}
+			verified[name] = stamp
+		}
+		if subDirArchived {
+			metaPath := filepath.Join(subRelay.dir, utils.MetaFilename)
+			if err = a.uploadFile(ctx, metaPath, path.Join(subDir, utils.MetaFilename)); err != nil {
+				return err
+			}
+		}
+	}
+	if archivedCount > 0 {
+		if err = a.uploadFile(ctx, a.indexPath, utils.UUIDIndexFilename); err != nil {
+			return err
+		}
+		// files uploaded but not recorded in the manifest are archived again next time.
+		if err = a.saveManifest(ctx); err != nil {
+			return err
+		}
+		a.logger.Info("archived relay log files", zap.Int("count", archivedCount), zap.Stringer("before", active))
+	}
+	if !maps.Equal(verified, a.verified) {
+		// the files are verified again next time if failed to save.
+		if err = a.saveVerified(verified); err != nil {
+			a.logger.Warn("save verified relay log files", zap.String("file", a.verifiedPath), zap.Error(err))
+		}
+		a.verified = verified
+	}
+
+	a.setEarliest(active.SubDir, active.Filename)
+	a.lock.Lock()
+	a.lastArchived = time.Now()
+	a.lock.Unlock()
+	return nil
+}
+
+// loadVerified loads the local relay log files known to be archived, nothing is loaded
+// if the file doesn't exist or is broken, then all files are verified again.
+func (a *relayArchiver) loadVerified() map[string]localFileStamp {
+	data, err := os.ReadFile(a.verifiedPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			a.logger.Warn("load verified relay log files", zap.String("file", a.verifiedPath), zap.Error(err))
+		}
+		return make(map[string]localFileStamp)
+	}
+	var v verifiedFiles
+	if err = json.Unmarshal(data, &v); err != nil {
+		a.logger.Warn("load verified relay log files", zap.String("file", a.verifiedPath), zap.Error(err))
+		return make(map[string]localFileStamp)
+	}
+	if v.Storage != a.cfg.Storage || v.Files == nil {
+		return make(map[string]localFileStamp)
+	}
+	return v.Files
+}
+
+func (a *relayArchiver) saveVerified(files map[string]localFileStamp) error {
+	data, err := json.Marshal(verifiedFiles{Storage: a.cfg.Storage, Files: files})
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(a.verifiedPath, data, 0o644)
+}
+
+// loadArchiveManifest loads the archived relay log files recorded in the manifest.
+func loadArchiveManifest(ctx context.Context, s bstorage.ExternalStorage) (map[string]archivedFile, error) {
+	archived := make(map[string]archivedFile)
+	exists, err := s.FileExists(ctx, archiveManifestFilename)
+	if err != nil || !exists {
+		return archived, err
+	}
+	data, err := s.ReadFile(ctx, archiveManifestFilename)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &archived); err != nil {
+		return nil, err
+	}
+	return archived, nil
+}
+
+func (a *relayArchiver) saveManifest(ctx context.Context) error {
+	data, err := json.Marshal(a.archived)
+	if err != nil {
+		return terror.ErrRelayArchiveFile.Delegate(err, archiveManifestFilename)
+	}
+	if err = a.storage.WriteFile(ctx, archiveManifestFilename, data); err != nil {
+		return terror.ErrRelayArchiveFile.Delegate(err, archiveManifestFilename)
+	}
+	return nil
+}
+
+// fileChecksum returns the hex encoded SHA-256 of the file.
+func fileChecksum(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.CopyBuffer(h, f, make([]byte, archiveBufferSize)); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// uploadFile uploads the local file to name in the external storage.
+func (a *relayArchiver) uploadFile(ctx context.Context, localPath, name string) error {
+	a.logger.Debug("archiving relay log file", zap.String("file", localPath))
+	f, err := os.Open(localPath)
+	if err != nil {
+		return terror.ErrRelayArchiveFile.Delegate(err, localPath)
+	}
+	defer f.Close()
+
+	w, err := a.storage.Create(ctx, name, nil)
+	if err != nil {
+		return terror.ErrRelayArchiveFile.Delegate(err, localPath)
+	}
+	buf := make([]byte, archiveBufferSize)
+	for {
+		n, err2 := f.Read(buf)
+		if n > 0 {
+			if _, err3 := w.Write(ctx, buf[:n]); err3 != nil {
+				// a partially uploaded file is not recorded in the manifest, so it will be archived again.
+				_ = w.Close(ctx)
+				return terror.ErrRelayArchiveFile.Delegate(err3, localPath)
+			}
+		}
+		if err2 == io.EOF {
+			break
+		}
+		if err2 != nil {
+			_ = w.Close(ctx)
+			return terror.ErrRelayArchiveFile.Delegate(err2, localPath)
+		}
+	}
+	if err = w.Close(ctx); err != nil {
+		return terror.ErrRelayArchiveFile.Delegate(err, localPath)
+	}
+	return nil
+}
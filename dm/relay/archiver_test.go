@@ -0,0 +1,196 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/streamer"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeActiveRelayLog struct {
+	info *streamer.RelayLogInfo
+}
+
+func (f *fakeActiveRelayLog) EarliestActiveRelayLog() *streamer.RelayLogInfo {
+	return f.info
+}
+
+func TestArchiveAndRestoreRelayLog(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		relayDir   = t.TempDir()
+		archiveDir = t.TempDir()
+		subDirs    = []string{
+			"c6ae5afe-c7a3-11e8-a19d-0242ac130006.000001",
+			"e9540a0d-f16d-11e8-8cb7-0242ac130008.000002",
+		}
+		files = []string{"mysql-bin.000001", "mysql-bin.000002", "mysql-bin.000003"}
+	)
+	require.NoError(t, utils.WriteFileAtomic(filepath.Join(relayDir, utils.UUIDIndexFilename),
+		[]byte(strings.Join(subDirs, "\n")+"\n"), 0o644))
+	for _, subDir := range subDirs {
+		dir := filepath.Join(relayDir, subDir)
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, utils.MetaFilename), []byte(subDir), 0o644))
+		for _, f := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, f), []byte(subDir+f), 0o644))
+		}
+	}
+
+	active := &fakeActiveRelayLog{}
+	archiver := NewRelayArchiver(config.RelayArchiveConfig{Storage: archiveDir}, relayDir, active).(*relayArchiver)
+	defer archiver.Close()
+
+	// nothing archived before relay writes any file
+	require.NoError(t, archiver.archive(ctx))
+	require.Nil(t, archiver.EarliestActiveRelayLog())
+	forbidden, _ := archiver.ForbidPurge()
+	require.True(t, forbidden)
+
+	// archive relay log files before the active one
+	active.info = &streamer.RelayLogInfo{SubDir: subDirs[1], SubDirSuffix: 2, Filename: files[1]}
+	require.NoError(t, archiver.archive(ctx))
+	require.Equal(t, active.info, archiver.EarliestActiveRelayLog())
+	forbidden, _ = archiver.ForbidPurge()
+	require.False(t, forbidden)
+	require.FileExists(t, filepath.Join(archiveDir, utils.UUIDIndexFilename))
+	for _, f := range append(files, utils.MetaFilename) {
+		require.FileExists(t, filepath.Join(archiveDir, subDirs[0], f))
+	}
+	require.FileExists(t, filepath.Join(archiveDir, subDirs[1], files[0]))
+	require.FileExists(t, filepath.Join(archiveDir, subDirs[1], utils.MetaFilename))
+	require.NoFileExists(t, filepath.Join(archiveDir, subDirs[1], files[1]))
+	require.NoFileExists(t, filepath.Join(archiveDir, subDirs[1], files[2]))
+
+	// purge the archived relay log files from local disk
+	require.NoError(t, purgeRelayFilesBeforeFile(log.L(), relayDir, subDirs, active.info))
+	require.NoDirExists(t, filepath.Join(relayDir, subDirs[0]))
+	require.NoFileExists(t, filepath.Join(relayDir, subDirs[1], files[0]))
+
+	// restore from the second file of the first sub directory
+	reader := newArchiveReader(log.L(), archiveDir, relayDir)
+	defer reader.close()
+	pos := mysql.Position{Name: "mysql-bin|000001.000002", Pos: 4}
+	require.NoError(t, reader.restoreFromPos(ctx, subDirs, pos))
+	require.NoFileExists(t, filepath.Join(relayDir, subDirs[0], files[0]))
+	for _, f := range []string{files[1], files[2], utils.MetaFilename} {
+		require.FileExists(t, filepath.Join(relayDir, subDirs[0], f))
+	}
+	content, err := os.ReadFile(filepath.Join(relayDir, subDirs[1], files[0]))
+	require.NoError(t, err)
+	require.Equal(t, subDirs[1]+files[0], string(content))
+
+	// check the head of archived files backward until the file covers the GTID set,
+	// nothing is restored if no file covers it.
+	var checked []string
+	covered := func(target string) func(filePath string) (bool, error) {
+		return func(filePath string) (bool, error) {
+			content, err2 := os.ReadFile(filePath)
+			if err2 != nil {
+				return false, err2
+			}
+			checked = append(checked, string(content))
+			return string(content) == target, nil
+		}
+	}
+	gtidPos, err := reader.restoreByGTID(ctx, subDirs, covered("not-exist"))
+	require.NoError(t, err)
+	require.Nil(t, gtidPos)
+	require.Equal(t, []string{subDirs[0] + files[0]}, checked)
+	require.NoFileExists(t, filepath.Join(relayDir, subDirs[0], files[0]))
+
+	checked = nil
+	gtidPos, err = reader.restoreByGTID(ctx, subDirs, covered(subDirs[0]+files[0]))
+	require.NoError(t, err)
+	require.Equal(t, &mysql.Position{Name: "mysql-bin|000001.000001", Pos: 4}, gtidPos)
+	require.Equal(t, []string{subDirs[0] + files[0]}, checked)
+	require.FileExists(t, filepath.Join(relayDir, subDirs[0], files[0]))
+
+	// restored files are not archived again
+	require.NoError(t, archiver.archive(ctx))
+	require.Equal(t, active.info, archiver.EarliestActiveRelayLog())
+
+	// a file with the same name and size but different content is archived again,
+	// and the archived files are loaded from the manifest after restarting.
+	rewritten := filepath.Join(relayDir, subDirs[1], files[0])
+	require.NoError(t, os.WriteFile(rewritten, []byte(strings.ToUpper(subDirs[1]+files[0])), 0o644))
+	archiver2 := NewRelayArchiver(config.RelayArchiveConfig{Storage: archiveDir}, relayDir, active).(*relayArchiver)
+	defer archiver2.Close()
+	require.NoError(t, archiver2.archive(ctx))
+	require.Len(t, archiver2.archived, len(files)+1)
+	content, err = os.ReadFile(filepath.Join(archiveDir, subDirs[1], files[0]))
+	require.NoError(t, err)
+	require.Equal(t, strings.ToUpper(subDirs[1]+files[0]), string(content))
+
+	// the verified local files are persisted, so they're not hashed again after restarting.
+	// a file changed without changing its size and modification time is not archived again.
+	require.FileExists(t, filepath.Join(relayDir, archiveVerifiedFilename))
+	require.Equal(t, archiver2.verified, archiver2.loadVerified())
+	fi, err := os.Stat(rewritten)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(rewritten, []byte(subDirs[1]+files[0]), 0o644))
+	require.NoError(t, os.Chtimes(rewritten, fi.ModTime(), fi.ModTime()))
+	archiver3 := NewRelayArchiver(config.RelayArchiveConfig{Storage: archiveDir}, relayDir, active).(*relayArchiver)
+	defer archiver3.Close()
+	require.NoError(t, archiver3.archive(ctx))
+	content, err = os.ReadFile(filepath.Join(archiveDir, subDirs[1], files[0]))
+	require.NoError(t, err)
+	require.Equal(t, strings.ToUpper(subDirs[1]+files[0]), string(content))
+
+	// the files are verified again when archiving to another storage.
+	archiveDir2 := t.TempDir()
+	archiver4 := NewRelayArchiver(config.RelayArchiveConfig{Storage: archiveDir2}, relayDir, active).(*relayArchiver)
+	defer archiver4.Close()
+	require.NoError(t, archiver4.archive(ctx))
+	content, err = os.ReadFile(filepath.Join(archiveDir2, subDirs[1], files[0]))
+	require.NoError(t, err)
+	require.Equal(t, subDirs[1]+files[0], string(content))
+}
+
+func TestRelayArchiverPurgeBlockTimeout(t *testing.T) {
+	active := &fakeActiveRelayLog{info: &streamer.RelayLogInfo{SubDir: "c6ae5afe-c7a3-11e8-a19d-0242ac130006.000001", SubDirSuffix: 1, Filename: "mysql-bin.000002"}}
+	archiver := NewRelayArchiver(config.RelayArchiveConfig{Storage: t.TempDir(), PurgeBlockTimeout: 60}, t.TempDir(), active).(*relayArchiver)
+	defer archiver.Close()
+
+	// purging is forbidden before archived
+	forbidden, _ := archiver.ForbidPurge()
+	require.True(t, forbidden)
+	archiver.setEarliest(active.info.SubDir, "mysql-bin.000001")
+	forbidden, _ = archiver.ForbidPurge()
+	require.False(t, forbidden)
+	require.NotNil(t, archiver.EarliestActiveRelayLog())
+
+	// relay log files failed to be archived for a long time are not protected
+	archiver.lastArchived = time.Now().Add(-time.Minute - time.Second)
+	require.Nil(t, archiver.EarliestActiveRelayLog())
+	archiver.earliest = nil
+	forbidden, _ = archiver.ForbidPurge()
+	require.False(t, forbidden)
+
+	// 0 means purging is blocked until archived
+	archiver.cfg.PurgeBlockTimeout = 0
+	forbidden, _ = archiver.ForbidPurge()
+	require.True(t, forbidden)
+}
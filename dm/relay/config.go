@@ -40,6 +40,9 @@ type Config struct {
 
 	// for binlog reader retry
 	ReaderRetry ReaderRetryConfig `toml:"reader-retry" json:"reader-retry"`
+
+	// external storage which relay log files are archived to
+	ArchiveStorage string `toml:"archive-storage" json:"archive-storage"`
 }
 
 func (c *Config) String() string {
@@ -70,6 +73,7 @@ func FromSourceCfg(sourceCfg *config.SourceConfig) *Config {
 			BackoffJitter:   clone.Checker.BackoffJitter,
 			BackoffFactor:   clone.Checker.BackoffFactor,
 		},
+		ArchiveStorage: clone.RelayArchive.Storage,
 	}
 	return cfg
 }
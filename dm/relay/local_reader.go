@@ -74,6 +74,9 @@ type BinlogReader struct {
 	currentSubDir string // current UUID(with suffix)
 
 	lastFileGracefulEnd bool
+
+	// archive is used to restore archived relay log files purged from local disk, nil if not archived.
+	archive *archiveReader
 }
 
 // newBinlogReader creates a new BinlogReader.
@@ -169,7 +172,7 @@ func (r *BinlogReader) IsGTIDCoverPreviousFiles(ctx context.Context, filePath st
 	}
 }
 
-// getPosByGTID gets file position by gtid, result should be (filename, 4).
+// getPosByGTID gets file position by gtid, result should be (filename, 4) unless it's found by the relay index.
 func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error) {
 	// start from newest uuid dir
 	for i := len(r.subDirs) - 1; i >= 0; i-- {
@@ -180,6 +183,23 @@ func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error)
 		}
 
 		dir := path.Join(r.cfg.RelayDir, subDir)
+		// seek the relay log file by the relay index directly if possible.
+		entry, err := binlog.FindRelayIndexByGTID(dir, r.cfg.Flavor, gset)
+		if err != nil {
+			r.tctx.L().Warn("fail to find relay log position by relay index, will check the relay log files",
+				zap.String("directory", dir), zap.Error(err))
+		} else if entry != nil {
+			fileName, err2 := utils.ParseFilename(entry.Name)
+			if err2 != nil {
+				return nil, err2
+			}
+			r.tctx.L().Info("find relay log position by relay index", zap.String("directory", dir), zap.Reflect("entry", entry))
+			return &mysql.Position{
+				Name: utils.ConstructFilenameWithUUIDSuffix(fileName, utils.SuffixIntToStr(suffix)),
+				Pos:  entry.Pos,
+			}, nil
+		}
+
 		allFiles, err := CollectAllBinlogFiles(dir)
 		if err != nil {
 			return nil, err
@@ -209,6 +229,14 @@ func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error)
 			}
 		}
 	}
+	if r.archive != nil {
+		pos, err := r.archive.restoreByGTID(r.tctx.Context(), r.subDirs, func(filePath string) (bool, error) {
+			return r.IsGTIDCoverPreviousFiles(r.tctx.Ctx, filePath, gset)
+		})
+		if err != nil || pos != nil {
+			return pos, err
+		}
+	}
 	return nil, terror.ErrNoRelayPosMatchGTID.Generate(gset.String())
 }
 
@@ -228,6 +256,11 @@ func (r *BinlogReader) StartSyncByPos(pos mysql.Position) (reader.Streamer, erro
 	if err != nil {
 		return nil, err
 	}
+	if r.archive != nil {
+		if err = r.archive.restoreFromPos(r.tctx.Context(), r.subDirs, pos); err != nil {
+			return nil, err
+		}
+	}
 	err = r.checkRelayPos(pos)
 	if err != nil {
 		return nil, err
@@ -771,6 +804,9 @@ func (r *BinlogReader) Close() {
 	r.parser.Stop()
 	r.wg.Wait()
 	r.relay.UnRegisterListener(r)
+	if r.archive != nil {
+		r.archive.close()
+	}
 	r.tctx.L().Info("binlog reader closed")
 }
 
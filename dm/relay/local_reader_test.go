@@ -34,6 +34,7 @@ import (
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
@@ -853,6 +854,90 @@ func (t *testReaderSuite) TestStartSyncByGTID(c *C) {
 	cancel()
 }
 
+func (t *testReaderSuite) TestStartSyncByGTIDWithRelayIndex(c *C) {
+	var (
+		baseDir    = c.MkDir()
+		cfg        = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		subDir     = "ba8f633f-1f15-11eb-b1c7-0242ac110002.000001"
+		uuidDir    = path.Join(baseDir, subDir)
+		filenames  = []string{"mysql.000001", "mysql.000002"}
+		eventTypes = [][]replication.EventType{
+			{replication.PREVIOUS_GTIDS_EVENT, replication.QUERY_EVENT, replication.XID_EVENT, replication.ROTATE_EVENT},
+			{replication.PREVIOUS_GTIDS_EVENT, replication.XID_EVENT, replication.QUERY_EVENT, replication.XID_EVENT},
+		}
+		indexWriter     = newRelayIndexWriter(log.L(), baseDir, 1)
+		previousGset, _ = gtid.ParserGTID(gmysql.MySQLFlavor, "")
+		latestGTID, _   = gtid.ParserGTID(gmysql.MySQLFlavor, "ba8f633f-1f15-11eb-b1c7-0242ac110002:1")
+		gset            = previousGset.Clone()
+		events          []*replication.BinlogEvent
+		lastPos         uint32
+		targetGset      gmysql.GTIDSet
+		targetPos       uint32
+		remainEvents    []*replication.BinlogEvent
+	)
+	t.writeUUIDs(c, baseDir, []string{subDir})
+	c.Assert(os.MkdirAll(uuidDir, 0o700), IsNil)
+
+	for i, filename := range filenames {
+		events, lastPos, latestGTID, previousGset = t.genEvents(c, eventTypes[i], 4, latestGTID, previousGset)
+		f, err := os.OpenFile(path.Join(uuidDir, filename), os.O_CREATE|os.O_WRONLY, 0o600)
+		c.Assert(err, IsNil)
+		_, err = f.Write(replication.BinLogFileHeader)
+		c.Assert(err, IsNil)
+		for _, ev := range events {
+			_, err = f.Write(ev.RawData)
+			c.Assert(err, IsNil)
+		}
+		c.Assert(f.Close(), IsNil)
+
+		// write the relay index like the relay unit
+		for j, ev := range events {
+			boundary := false
+			switch e := ev.Event.(type) {
+			case *replication.GTIDEvent:
+				gtidStr, _ := event.GetGTIDStr(ev)
+				c.Assert(gset.Update(gtidStr), IsNil)
+			case *replication.XIDEvent:
+				boundary = true
+			case *replication.QueryEvent:
+				boundary = string(e.Query) != "BEGIN"
+			}
+			c.Assert(indexWriter.onEvent(subDir, filename, ev.Header.LogPos, ev.Header.Timestamp, boundary, gset), IsNil)
+			// start from the first transaction boundary in the second file
+			if i == 1 && boundary && targetGset == nil {
+				targetGset, targetPos, remainEvents = gset.Clone(), ev.Header.LogPos, events[j+1:]
+			}
+		}
+	}
+	indexWriter.close()
+	t.createMetaFile(c, uuidDir, filenames[1], lastPos, previousGset.String())
+
+	r := newBinlogReaderForTest(log.L(), cfg, true, "")
+	c.Assert(r.updateSubDirs(), IsNil)
+	pos, err := r.getPosByGTID(targetGset.Clone())
+	c.Assert(err, IsNil)
+	c.Assert(*pos, DeepEquals, gmysql.Position{Name: "mysql|000001.000002", Pos: targetPos})
+
+	s, err := r.StartSyncByGTID(targetGset.Clone())
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	obtainEvents := readNEvents(ctx, c, s, len(remainEvents), true)
+	for i, ev := range obtainEvents {
+		c.Assert(ev.Header, DeepEquals, remainEvents[i].Header)
+	}
+	r.Close()
+
+	// start from the beginning of the file without the relay index
+	c.Assert(os.Remove(path.Join(uuidDir, binlog.RelayIndexFilename)), IsNil)
+	r = newBinlogReaderForTest(log.L(), cfg, true, "")
+	c.Assert(r.updateSubDirs(), IsNil)
+	pos, err = r.getPosByGTID(targetGset.Clone())
+	c.Assert(err, IsNil)
+	c.Assert(*pos, DeepEquals, gmysql.Position{Name: "mysql|000001.000002", Pos: 4})
+	r.Close()
+}
+
 func (t *testReaderSuite) TestStartSyncError(c *C) {
 	var (
 		baseDir = c.MkDir()
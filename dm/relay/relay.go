@@ -129,8 +129,9 @@ type Relay struct {
 		info *pkgstreamer.RelayLogInfo
 	}
 
-	writer    Writer
-	listeners map[Listener]struct{} // make it a set to make it easier to remove listener
+	writer      Writer
+	indexWriter *relayIndexWriter
+	listeners   map[Listener]struct{} // make it a set to make it easier to remove listener
 }
 
 // NewRealRelay creates an instance of Relay.
@@ -142,6 +143,7 @@ func NewRealRelay(cfg *Config) Process {
 		listeners: make(map[Listener]struct{}),
 	}
 	r.writer = NewFileWriter(r.logger, cfg.RelayDir)
+	r.indexWriter = newRelayIndexWriter(r.logger, cfg.RelayDir, defaultRelayIndexInterval)
 	return r
 }
 
@@ -303,6 +305,8 @@ func (r *Relay) process(ctx context.Context) error {
 			r.logger.Error("fail to close binlog event writer", zap.Error(err))
 		}
 	}()
+	// the relay index is re-opened after the relay log file is recovered.
+	defer r.indexWriter.close()
 
 	readerRetry, err := NewReaderRetry(r.cfg.ReaderRetry)
 	if err != nil {
@@ -729,6 +733,15 @@ func (r *Relay) handleEvents(
 		} else {
 			eventIndex++
 		}
+		// 5. update relay index, it's only used to seek the relay log files faster so the error is ignored.
+		boundary := e.Header.EventType == replication.XID_EVENT
+		if r.cfg.EnableGTID {
+			boundary = tResult.CanSaveGTID && lastGTID != nil
+		}
+		if err2 := r.indexWriter.onEvent(r.meta.SubDir(), lastPos.Name, lastPos.Pos, e.Header.Timestamp, boundary, lastGTID); err2 != nil {
+			r.logger.Warn("fail to update relay index", log.ShortError(err2))
+		}
+
 		if tResult.NextLogName != "" && !utils.IsFakeRotateEvent(e.Header) {
 			// if the binlog is rotated, we need to save and flush the next binlog filename to meta
 			lastPos.Name = tResult.NextLogName
@@ -1217,7 +1230,12 @@ func (r *Relay) notify(e *replication.BinlogEvent) {
 }
 
 func (r *Relay) NewReader(logger log.Logger, cfg *BinlogReaderConfig) *BinlogReader {
-	return newBinlogReader(logger, cfg, r)
+	reader := newBinlogReader(logger, cfg, r)
+	// relay log files purged from local disk can be restored from the archive
+	if r.cfg != nil && r.cfg.ArchiveStorage != "" {
+		reader.archive = newArchiveReader(reader.tctx.L(), r.cfg.ArchiveStorage, cfg.RelayDir)
+	}
+	return reader
 }
 
 // RegisterListener implements Process.RegisterListener.
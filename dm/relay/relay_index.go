@@ -0,0 +1,129 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// defaultRelayIndexInterval is the min size of relay log between two entries of the relay index in the same file.
+const defaultRelayIndexInterval = 4 * 1024 * 1024
+
+// relayIndexWriter maintains the relay index (see binlog.RelayIndexFilename) when writing relay log files.
+// An entry is appended when a relay log file begins and every `interval` bytes at the transaction boundary.
+type relayIndexWriter struct {
+	logger   log.Logger
+	relayDir string
+	interval uint32
+
+	subDir   string // the sub directory of the index, with UUID suffix
+	f        *os.File
+	lastName string
+	lastPos  uint32
+	maxTS    uint32
+}
+
+func newRelayIndexWriter(logger log.Logger, relayDir string, interval uint32) *relayIndexWriter {
+	return &relayIndexWriter{
+		logger:   logger.WithFields(zap.String("sub component", "relay index")),
+		relayDir: relayDir,
+		interval: interval,
+	}
+}
+
+// onEvent is called after an event is written into the relay log file `name` in `subDir`.
+// boundary means a transaction is finished at `pos`, and gset is the GTID set of all transactions before `pos`.
+func (w *relayIndexWriter) onEvent(subDir, name string, pos, ts uint32, boundary bool, gset mysql.GTIDSet) error {
+	if subDir != w.subDir {
+		if err := w.open(subDir); err != nil {
+			return err
+		}
+	}
+	if ts > w.maxTS {
+		w.maxTS = ts
+	}
+	// the position may be reverted after recovering the relay log file.
+	if w.f == nil || !boundary || (name == w.lastName && (pos <= w.lastPos || pos-w.lastPos < w.interval)) {
+		return nil
+	}
+
+	entry := binlog.RelayIndexEntry{Name: name, Pos: pos, Timestamp: w.maxTS}
+	if gset != nil {
+		entry.GTIDSet = gset.String()
+	}
+	data, err := json.Marshal(&entry)
+	if err != nil {
+		return terror.ErrRelayIndexWrite.Delegate(err, w.subDir)
+	}
+	if _, err = w.f.Write(append(data, '\n')); err != nil {
+		return terror.ErrRelayIndexWrite.Delegate(err, w.subDir)
+	}
+	w.lastName, w.lastPos = name, pos
+	return nil
+}
+
+// open opens the relay index in the directory for appending. The entries of the purged relay log files are removed.
+func (w *relayIndexWriter) open(subDir string) error {
+	w.close()
+	// don't retry for every event if failed.
+	w.subDir = subDir
+	dir := path.Join(w.relayDir, subDir)
+
+	entries, err := binlog.ReadRelayIndex(dir)
+	if err != nil {
+		return err
+	}
+	valid := entries[:0]
+	for _, entry := range entries {
+		if utils.IsFileExists(path.Join(dir, entry.Name)) {
+			valid = append(valid, entry)
+		}
+	}
+	// rewrite the index to remove the purged entries and the broken entry at the end.
+	if err = binlog.WriteRelayIndex(dir, valid); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path.Join(dir, binlog.RelayIndexFilename), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return terror.ErrRelayIndexWrite.Delegate(err, dir)
+	}
+
+	w.f = f
+	w.lastName, w.lastPos, w.maxTS = "", 0, 0
+	if len(valid) > 0 {
+		last := valid[len(valid)-1]
+		w.lastName, w.lastPos, w.maxTS = last.Name, last.Pos, last.Timestamp
+	}
+	w.logger.Info("open relay index", zap.String("directory", dir), zap.Int("entries", len(valid)), zap.Int("purged entries", len(entries)-len(valid)))
+	return nil
+}
+
+func (w *relayIndexWriter) close() {
+	if w.f == nil {
+		return
+	}
+	if err := w.f.Close(); err != nil {
+		w.logger.Warn("fail to close relay index", zap.String("directory", w.subDir), zap.Error(err))
+	}
+	w.subDir, w.f = "", nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayIndexWriter(t *testing.T) {
+	var (
+		relayDir = t.TempDir()
+		subDir   = "c6ae5afe-c7a3-11e8-a19d-0242ac130006.000001"
+		dir      = filepath.Join(relayDir, subDir)
+		w        = newRelayIndexWriter(log.L(), relayDir, 100)
+		gs       = func(s string) mysql.GTIDSet {
+			gset, err := gtid.ParserGTID(mysql.MySQLFlavor, s)
+			require.NoError(t, err)
+			return gset
+		}
+	)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	for _, f := range []string{"mysql-bin.000001", "mysql-bin.000002"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, f), make([]byte, 1000), 0o644))
+	}
+
+	// the first boundary in a file is always recorded, others are recorded every `interval` bytes.
+	require.NoError(t, w.onEvent(subDir, "mysql-bin.000001", 50, 10, true, gs("c6ae5afe-c7a3-11e8-a19d-0242ac130006:1")))
+	require.NoError(t, w.onEvent(subDir, "mysql-bin.000001", 120, 12, true, gs("c6ae5afe-c7a3-11e8-a19d-0242ac130006:1-2")))
+	require.NoError(t, w.onEvent(subDir, "mysql-bin.000001", 200, 11, true, gs("c6ae5afe-c7a3-11e8-a19d-0242ac130006:1-3")))
+	require.NoError(t, w.onEvent(subDir, "mysql-bin.000001", 400, 13, false, nil))
+	require.NoError(t, w.onEvent(subDir, "mysql-bin.000002", 60, 14, true, gs("c6ae5afe-c7a3-11e8-a19d-0242ac130006:1-4")))
+	expected := []binlog.RelayIndexEntry{
+		{Name: "mysql-bin.000001", Pos: 50, GTIDSet: "c6ae5afe-c7a3-11e8-a19d-0242ac130006:1", Timestamp: 10},
+		{Name: "mysql-bin.000001", Pos: 200, GTIDSet: "c6ae5afe-c7a3-11e8-a19d-0242ac130006:1-3", Timestamp: 12},
+		{Name: "mysql-bin.000002", Pos: 60, GTIDSet: "c6ae5afe-c7a3-11e8-a19d-0242ac130006:1-4", Timestamp: 14},
+	}
+	entries, err := binlog.ReadRelayIndex(dir)
+	require.NoError(t, err)
+	require.Equal(t, expected, entries)
+
+	// the broken entry and the entries of the purged file are removed when opening the index again.
+	w.close()
+	f, err := os.OpenFile(filepath.Join(dir, binlog.RelayIndexFilename), os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"name":"mysql-bin.00`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.NoError(t, os.Remove(filepath.Join(dir, "mysql-bin.000001")))
+
+	// the position reverted after recovering is not recorded.
+	require.NoError(t, w.onEvent(subDir, "mysql-bin.000002", 40, 15, true, gs("c6ae5afe-c7a3-11e8-a19d-0242ac130006:1-4")))
+	require.NoError(t, w.onEvent(subDir, "mysql-bin.000002", 160, 15, true, gs("c6ae5afe-c7a3-11e8-a19d-0242ac130006:1-5")))
+	entries, err = binlog.ReadRelayIndex(dir)
+	require.NoError(t, err)
+	require.Equal(t, []binlog.RelayIndexEntry{
+		expected[2],
+		{Name: "mysql-bin.000002", Pos: 160, GTIDSet: "c6ae5afe-c7a3-11e8-a19d-0242ac130006:1-5", Timestamp: 15},
+	}, entries)
+	w.close()
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replay replays the workload recorded in binlog files or relay logs against a target database.
+package replay
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/dm/config/dbconfig"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+const (
+	defaultWorkerCount = 16
+	defaultQueueSize   = 128
+)
+
+// Config is the configuration of a replayer.
+type Config struct {
+	// Source is a binlog file, a directory of binlog files, or a relay log directory which contains
+	// server-uuid.index and relay log subdirectories.
+	Source string
+	// StartFile and StartPos is where to start replaying, empty StartFile means the first binlog file
+	// in Source and zero StartPos means the beginning of the file.
+	StartFile string
+	StartPos  uint32
+	// Target is the database to replay the workload against.
+	Target dbconfig.DBConfig
+	// Speed multiplies the speed of the original workload, zero means replaying as fast as possible.
+	Speed float64
+	// WorkerCount is the number of concurrent connections to the target, transactions having causal
+	// relations are executed sequentially.
+	WorkerCount int
+	// ReportInterval is the interval of logging the progress, zero disables it.
+	ReportInterval time.Duration
+}
+
+// Adjust validates the config and fills the default values.
+func (c *Config) Adjust() error {
+	if c.Source == "" {
+		return errors.New("source of binlog is not specified")
+	}
+	if c.Speed < 0 {
+		return errors.Errorf("speed should not be negative, got %v", c.Speed)
+	}
+	if c.WorkerCount <= 0 {
+		c.WorkerCount = defaultWorkerCount
+	}
+	if c.StartPos > 0 && c.StartPos < binlog.FileHeaderLen {
+		c.StartPos = binlog.FileHeaderLen
+	}
+	return nil
+}
+
+// listBinlogFiles returns the binlog files to replay in order.
+func listBinlogFiles(source, startFile string) ([]string, error) {
+	fi, err := os.Stat(source)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !fi.IsDir() {
+		return []string{source}, nil
+	}
+
+	dirs := []string{source}
+	subDirs, err := utils.ParseUUIDIndex(filepath.Join(source, utils.UUIDIndexFilename))
+	if err != nil {
+		return nil, err
+	}
+	if len(subDirs) > 0 {
+		dirs = dirs[:0]
+		for _, subDir := range subDirs {
+			dirs = append(dirs, filepath.Join(source, subDir))
+		}
+	}
+
+	var files []string
+	for _, dir := range dirs {
+		names, err2 := binlog.ReadSortedBinlogFromDir(dir)
+		if err2 != nil {
+			return nil, err2
+		}
+		for _, name := range names {
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+	if len(files) == 0 {
+		return nil, errors.Errorf("no binlog file found in %s", source)
+	}
+	if startFile == "" {
+		return files, nil
+	}
+	for i, file := range files {
+		if filepath.Base(file) == startFile {
+			return files[i:], nil
+		}
+	}
+	return nil, errors.Errorf("start file %s not found in %s", startFile, source)
+}
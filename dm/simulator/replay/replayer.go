@@ -0,0 +1,411 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/syncer"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// the causality relations are cleared after all workers finish when they have too many keys.
+const maxCausalityKeys = 1 << 20
+
+// txn is a transaction reconstructed from binlog events.
+type txn struct {
+	timestamp uint32
+	changes   []*sqlmodel.RowChange
+}
+
+// Replayer reads binlog events, reconstructs the transactions and replays them against the target.
+type Replayer struct {
+	cfg    *Config
+	db     *conn.BaseDB
+	parser *parser.Parser
+	logger log.Logger
+
+	tables   map[string]*model.TableInfo
+	detector *syncer.CausalityDetector
+	workers  []chan *txn
+	inflight sync.WaitGroup
+	recorder recorder
+
+	// the timestamp of the first transaction and when it's replayed, used to control the speed.
+	firstTS   int64
+	firstTime time.Time
+	lastTS    int64
+}
+
+// NewReplayer creates a Replayer, the config should be adjusted.
+func NewReplayer(cfg *Config, db *conn.BaseDB) *Replayer {
+	return &Replayer{
+		cfg:      cfg,
+		db:       db,
+		parser:   parser.New(),
+		logger:   log.With(zap.String("component", "binlog replayer")),
+		tables:   make(map[string]*model.TableInfo),
+		detector: syncer.NewCausalityDetector(),
+	}
+}
+
+// Run replays all binlog events until the end of the last binlog file, and returns the report.
+func (r *Replayer) Run(ctx context.Context) (*Report, error) {
+	files, err := listBinlogFiles(r.cfg.Source, r.cfg.StartFile)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	eg, egCtx := errgroup.WithContext(ctx)
+	r.workers = make([]chan *txn, r.cfg.WorkerCount)
+	for i := range r.workers {
+		ch := make(chan *txn, defaultQueueSize)
+		r.workers[i] = ch
+		eg.Go(func() error {
+			return r.runWorker(egCtx, cancel, ch)
+		})
+	}
+	eg.Go(func() error {
+		defer func() {
+			for _, ch := range r.workers {
+				close(ch)
+			}
+		}()
+		return r.readFiles(egCtx, files)
+	})
+	if r.cfg.ReportInterval > 0 {
+		done := make(chan struct{})
+		defer close(done)
+		go r.logProgress(done, start)
+	}
+
+	err = eg.Wait()
+	r.recorder.update(func(report *Report) {
+		report.WorkloadDuration = time.Duration(r.lastTS-r.firstTS) * time.Second
+	})
+	return r.recorder.finish(time.Since(start)), err
+}
+
+func (r *Replayer) logProgress(done chan struct{}, start time.Time) {
+	ticker := time.NewTicker(r.cfg.ReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			txns, rows := r.recorder.snapshot()
+			r.logger.Info("replay progress", zap.Int64("transactions", txns), zap.Int64("rows", rows),
+				zap.Duration("elapsed", time.Since(start)))
+		}
+	}
+}
+
+// runWorker executes the transactions in order. After an error occurs the replay is canceled and the
+// remaining transactions are drained without being executed, so the dispatcher won't wait forever.
+func (r *Replayer) runWorker(ctx context.Context, cancel context.CancelFunc, ch chan *txn) error {
+	var err error
+	for t := range ch {
+		if err == nil && ctx.Err() == nil {
+			if err = r.execTxn(ctx, t); err != nil {
+				cancel()
+			}
+		}
+		r.inflight.Done()
+	}
+	return err
+}
+
+func (r *Replayer) readFiles(ctx context.Context, files []string) error {
+	var cur *txn
+	for i, file := range files {
+		pos := uint32(binlog.FileHeaderLen)
+		if i == 0 && r.cfg.StartFile != "" && r.cfg.StartPos > 0 {
+			pos = r.cfg.StartPos
+		}
+		r.logger.Info("start to replay binlog file", zap.String("file", file), zap.Uint32("pos", pos))
+
+		// events are sent without buffer so that all of them are read before reaching the end of file.
+		fileReader := reader.NewFileReader(&reader.FileReaderConfig{EchBufferSize: 1})
+		if err := fileReader.StartSyncByPos(gmysql.Position{Name: file, Pos: pos}); err != nil {
+			return err
+		}
+		for {
+			ev, err := fileReader.GetEvent(ctx)
+			if err != nil {
+				_ = fileReader.Close()
+				if terror.ErrReaderReachEndOfFile.Equal(err) {
+					break
+				}
+				return err
+			}
+			if cur, err = r.handleEvent(ctx, ev, cur); err != nil {
+				_ = fileReader.Close()
+				return err
+			}
+		}
+	}
+	// the last transaction may be uncommitted, it's not replayed
+	if cur != nil && len(cur.changes) > 0 {
+		r.logger.Warn("the last transaction is not committed in binlog, skip it", zap.Int("rows", len(cur.changes)))
+	}
+	r.inflight.Wait()
+	return nil
+}
+
+// handleEvent handles a binlog event and returns the current transaction.
+func (r *Replayer) handleEvent(ctx context.Context, ev *replication.BinlogEvent, cur *txn) (*txn, error) {
+	switch e := ev.Event.(type) {
+	case *replication.QueryEvent:
+		query := strings.TrimSpace(string(e.Query))
+		switch strings.ToUpper(query) {
+		case "BEGIN":
+			return &txn{timestamp: ev.Header.Timestamp}, nil
+		case "COMMIT":
+			// transactions of non-transactional engines are committed by a query event
+			return nil, r.dispatch(ctx, cur)
+		}
+		return cur, r.handleQuery(ctx, ev.Header.Timestamp, string(e.Schema), query)
+	case *replication.RowsEvent:
+		if cur == nil {
+			// the start position may be in the middle of a transaction, or a GTID event begins the transaction in MariaDB
+			cur = &txn{timestamp: ev.Header.Timestamp}
+		}
+		changes, err := r.rowChanges(ctx, ev.Header.EventType, e)
+		if err != nil {
+			return nil, err
+		}
+		cur.changes = append(cur.changes, changes...)
+		return cur, nil
+	case *replication.XIDEvent:
+		return nil, r.dispatch(ctx, cur)
+	}
+	return cur, nil
+}
+
+// handleQuery replays DDL after all previous transactions are finished, other statements are skipped.
+func (r *Replayer) handleQuery(ctx context.Context, timestamp uint32, schema, query string) error {
+	stmt, err := r.parser.ParseOneStmt(query, "", "")
+	if err != nil {
+		r.logger.Warn("fail to parse query event, skip it", zap.String("query", query), zap.Error(err))
+		r.recorder.update(func(report *Report) { report.SkippedEvents++ })
+		return nil
+	}
+	if _, ok := stmt.(ast.DDLNode); !ok {
+		r.logger.Debug("skip query event which is not DDL", zap.String("query", query))
+		r.recorder.update(func(report *Report) { report.SkippedEvents++ })
+		return nil
+	}
+	if err = r.waitForSpeed(ctx, timestamp); err != nil {
+		return err
+	}
+
+	r.inflight.Wait()
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	if err = r.execDDL(ctx, schema, query); err != nil {
+		return err
+	}
+	// table structures may be changed, and no transaction is running now
+	r.tables = make(map[string]*model.TableInfo)
+	r.detector.Clear()
+	r.recorder.update(func(report *Report) { report.DDLs++ })
+	return nil
+}
+
+func (r *Replayer) execDDL(ctx context.Context, schema, query string) error {
+	dbConn, err := r.db.DB.Conn(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer dbConn.Close()
+	if schema != "" {
+		if _, err = dbConn.ExecContext(ctx, "USE "+dbutil.ColumnName(schema)); err != nil {
+			return errors.Annotatef(err, "use database %s", schema)
+		}
+	}
+	if _, err = dbConn.ExecContext(ctx, query); err != nil {
+		return errors.Annotatef(err, "execute DDL %s", query)
+	}
+	return nil
+}
+
+// rowChanges converts the rows event to row changes by the table structure in the target.
+func (r *Replayer) rowChanges(ctx context.Context, tp replication.EventType, e *replication.RowsEvent) ([]*sqlmodel.RowChange, error) {
+	table := &filter.Table{Schema: string(e.Table.Schema), Name: string(e.Table.Table)}
+	ti, err := r.getTableInfo(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	tableName := &cdcmodel.TableName{Schema: table.Schema, Table: table.Name}
+
+	var pairs [][2][]interface{}
+	switch tp {
+	case replication.WRITE_ROWS_EVENTv0, replication.WRITE_ROWS_EVENTv1, replication.WRITE_ROWS_EVENTv2:
+		for _, row := range e.Rows {
+			pairs = append(pairs, [2][]interface{}{nil, row})
+		}
+	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+		for i := 0; i+1 < len(e.Rows); i += 2 {
+			pairs = append(pairs, [2][]interface{}{e.Rows[i], e.Rows[i+1]})
+		}
+	case replication.DELETE_ROWS_EVENTv0, replication.DELETE_ROWS_EVENTv1, replication.DELETE_ROWS_EVENTv2:
+		for _, row := range e.Rows {
+			pairs = append(pairs, [2][]interface{}{row, nil})
+		}
+	default:
+		return nil, errors.Errorf("unsupported rows event type %s of table %s", tp, utils.GenTableID(table))
+	}
+
+	changes := make([]*sqlmodel.RowChange, 0, len(pairs))
+	for _, pair := range pairs {
+		var values [2][]interface{}
+		for i, row := range pair {
+			if row == nil {
+				continue
+			}
+			if values[i], err = syncer.AdjustValueFromBinlogData(row, ti); err != nil {
+				return nil, errors.Annotatef(err, "table %s", utils.GenTableID(table))
+			}
+		}
+		changes = append(changes, sqlmodel.NewRowChange(tableName, nil, values[0], values[1], ti, nil, nil))
+	}
+	return changes, nil
+}
+
+func (r *Replayer) getTableInfo(ctx context.Context, table *filter.Table) (*model.TableInfo, error) {
+	tableID := utils.GenTableID(table)
+	if ti, ok := r.tables[tableID]; ok {
+		return ti, nil
+	}
+	ti, err := dbutil.GetTableInfo(ctx, r.db.DB, table.Schema, table.Name)
+	if err != nil {
+		return nil, errors.Annotatef(err, "fetch structure of table %s from target", tableID)
+	}
+	r.tables[tableID] = ti
+	return ti, nil
+}
+
+// dispatch sends the transaction to a worker by its causality keys.
+func (r *Replayer) dispatch(ctx context.Context, t *txn) error {
+	if t == nil || len(t.changes) == 0 {
+		return nil
+	}
+	if err := r.waitForSpeed(ctx, t.timestamp); err != nil {
+		return err
+	}
+
+	var keys []string
+	for _, change := range t.changes {
+		keys = append(keys, change.CausalityKeys()...)
+	}
+	if r.detector.Len() > maxCausalityKeys {
+		r.inflight.Wait()
+		r.detector.Clear()
+	}
+	key, conflict := r.detector.Detect(keys)
+	if conflict {
+		r.recorder.update(func(report *Report) { report.Conflicts++ })
+		r.inflight.Wait()
+	}
+
+	idx := int(utils.GenHashKey(key) % uint32(len(r.workers)))
+	r.inflight.Add(1)
+	select {
+	case r.workers[idx] <- t:
+		return nil
+	case <-ctx.Done():
+		r.inflight.Done()
+		return ctx.Err()
+	}
+}
+
+// waitForSpeed blocks until the time to replay the event of the timestamp.
+func (r *Replayer) waitForSpeed(ctx context.Context, timestamp uint32) error {
+	ts := int64(timestamp)
+	if r.firstTime.IsZero() {
+		r.firstTS, r.firstTime = ts, time.Now()
+	}
+	if ts > r.lastTS {
+		r.lastTS = ts
+	}
+	if r.cfg.Speed <= 0 {
+		return nil
+	}
+	due := r.firstTime.Add(time.Duration(float64(ts-r.firstTS) * float64(time.Second) / r.cfg.Speed))
+	wait := time.Until(due)
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// execTxn executes the row changes of the transaction in a database transaction.
+func (r *Replayer) execTxn(ctx context.Context, t *txn) error {
+	start := time.Now()
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, change := range t.changes {
+		var tp sqlmodel.DMLType
+		switch change.Type() {
+		case sqlmodel.RowChangeInsert:
+			tp = sqlmodel.DMLInsert
+		case sqlmodel.RowChangeUpdate:
+			tp = sqlmodel.DMLUpdate
+		default:
+			tp = sqlmodel.DMLDelete
+		}
+		query, args := change.GenSQL(tp)
+		if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+			_ = tx.Rollback()
+			return errors.Annotatef(err, "execute %s", query)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return errors.Trace(err)
+	}
+	r.recorder.recordTxn(len(t.changes), time.Since(start))
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func genBinlogFile(t *testing.T, dir, name string, start time.Time) {
+	t.Helper()
+	generator, err := event.NewGeneratorV2(mysql.MySQLFlavor, "5.7.0", "ffffffff-ffff-ffff-ffff-ffffffffffff:1", false)
+	require.NoError(t, err)
+	columnType := []byte{mysql.MYSQL_TYPE_LONG, mysql.MYSQL_TYPE_LONG}
+	dmlData := func(rows ...[]interface{}) []*event.DMLData {
+		return []*event.DMLData{{TableID: 1, Schema: "db", Table: "t", ColumnType: columnType, Rows: rows}}
+	}
+
+	var buf bytes.Buffer
+	_, data, err := generator.GenFileHeader(start.Unix())
+	require.NoError(t, err)
+	buf.Write(data)
+	_, data, err = generator.GenDDLEvents("db", "CREATE TABLE t (id INT PRIMARY KEY, c INT)", start.Unix())
+	require.NoError(t, err)
+	buf.Write(data)
+	_, data, err = generator.GenDMLEvents(replication.WRITE_ROWS_EVENTv2,
+		dmlData([]interface{}{int32(1), int32(1)}, []interface{}{int32(2), int32(2)}), start.Add(time.Second).Unix())
+	require.NoError(t, err)
+	buf.Write(data)
+	_, data, err = generator.GenDMLEvents(replication.UPDATE_ROWS_EVENTv2,
+		dmlData([]interface{}{int32(1), int32(1)}, []interface{}{int32(1), int32(10)}), start.Add(2*time.Second).Unix())
+	require.NoError(t, err)
+	buf.Write(data)
+	_, data, err = generator.GenDMLEvents(replication.DELETE_ROWS_EVENTv2,
+		dmlData([]interface{}{int32(2), int32(2)}), start.Add(3*time.Second).Unix())
+	require.NoError(t, err)
+	buf.Write(data)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0o644))
+}
+
+func TestReplayer(t *testing.T) {
+	dir := t.TempDir()
+	genBinlogFile(t, dir, "mysql-bin.000001", time.Now())
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectExec("USE `db`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE t").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SHOW CREATE TABLE `db`.`t`").WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).
+			AddRow("t", "CREATE TABLE `t` (`id` INT PRIMARY KEY, `c` INT)"))
+	mock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `db`.`t`").WithArgs(int32(1), int32(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO `db`.`t`").WithArgs(int32(2), int32(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `db`.`t`").WithArgs(int32(1), int32(10), int32(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `db`.`t`").WithArgs(int32(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	cfg := &Config{Source: dir, WorkerCount: 1}
+	require.NoError(t, cfg.Adjust())
+	report, err := NewReplayer(cfg, conn.NewBaseDBForTest(db)).Run(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, int64(3), report.Transactions)
+	require.Equal(t, int64(4), report.Rows)
+	require.Equal(t, int64(1), report.DDLs)
+	require.Equal(t, 3*time.Second, report.WorkloadDuration)
+	require.Len(t, report.latencies, 3)
+	require.Contains(t, report.String(), "replayed 3 transactions (4 rows) and 1 DDLs")
+
+	// replaying fails when the target returns an error
+	mock.ExpectExec("USE `db`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE t").WillReturnError(mysql.ErrBadConn)
+	_, err = NewReplayer(cfg, conn.NewBaseDBForTest(db)).Run(context.Background())
+	require.ErrorContains(t, err, "execute DDL CREATE TABLE t")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListBinlogFiles(t *testing.T) {
+	dir := t.TempDir()
+	_, err := listBinlogFiles(dir, "")
+	require.ErrorContains(t, err, "no binlog file found")
+
+	for _, name := range []string{"mysql-bin.000002", "mysql-bin.000001", "mysql-bin.index"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o644))
+	}
+	files, err := listBinlogFiles(dir, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "mysql-bin.000001"), filepath.Join(dir, "mysql-bin.000002")}, files)
+	files, err = listBinlogFiles(filepath.Join(dir, "mysql-bin.000002"), "")
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "mysql-bin.000002")}, files)
+
+	// relay log directory
+	relayDir := t.TempDir()
+	subDirs := []string{"server-uuid.000001", "server-uuid.000002"}
+	for i, subDir := range subDirs {
+		require.NoError(t, os.Mkdir(filepath.Join(relayDir, subDir), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(relayDir, subDir, fmt.Sprintf("mysql-bin.%06d", i+1)), nil, 0o644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(relayDir, utils.UUIDIndexFilename), []byte("server-uuid.000001\nserver-uuid.000002\n"), 0o644))
+	files, err = listBinlogFiles(relayDir, "mysql-bin.000002")
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(relayDir, "server-uuid.000002", "mysql-bin.000002")}, files)
+	_, err = listBinlogFiles(relayDir, "mysql-bin.000003")
+	require.ErrorContains(t, err, "start file mysql-bin.000003 not found")
+}
+
+func TestReport(t *testing.T) {
+	report := &Report{Transactions: 100, Rows: 200, Duration: 10 * time.Second, WorkloadDuration: 20 * time.Second}
+	for i := 1; i <= 100; i++ {
+		report.latencies = append(report.latencies, time.Duration(i)*time.Millisecond)
+	}
+	require.Equal(t, 50*time.Millisecond, report.Percentile(50))
+	require.Equal(t, 99*time.Millisecond, report.Percentile(99))
+	require.Equal(t, 100*time.Millisecond, report.Percentile(100))
+	require.Equal(t, 50500*time.Microsecond, report.AvgLatency())
+	s := report.String()
+	require.Contains(t, s, "throughput: 10.0 txn/s, 20.0 rows/s")
+	require.Contains(t, s, "replayed at 2.00x speed")
+
+	require.Zero(t, (&Report{}).Percentile(99))
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Report is the latency and throughput report of a replay.
+type Report struct {
+	Transactions int64
+	Rows         int64
+	DDLs         int64
+	// SkippedEvents is the number of query events which are neither transaction control nor DDL,
+	// such as statement-based DML or account management statements.
+	SkippedEvents int64
+	// Conflicts is the number of times waiting for all workers because of causality conflicts.
+	Conflicts int64
+	// Duration is the wall time of replaying.
+	Duration time.Duration
+	// WorkloadDuration is the time span of the replayed transactions in the original workload.
+	WorkloadDuration time.Duration
+
+	// latencies of executing transactions, sorted when the replay finishes.
+	latencies []time.Duration
+}
+
+// Percentile returns the p-th (0 < p <= 100) percentile of transaction latencies.
+func (r *Report) Percentile(p float64) time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	idx := int(float64(len(r.latencies))*p/100+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(r.latencies) {
+		idx = len(r.latencies) - 1
+	}
+	return r.latencies[idx]
+}
+
+// AvgLatency returns the average latency of transactions.
+func (r *Report) AvgLatency() time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	var sum time.Duration
+	for _, l := range r.latencies {
+		sum += l
+	}
+	return sum / time.Duration(len(r.latencies))
+}
+
+// String implements fmt.Stringer.
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "replayed %d transactions (%d rows) and %d DDLs in %s\n",
+		r.Transactions, r.Rows, r.DDLs, r.Duration.Round(time.Millisecond))
+	seconds := r.Duration.Seconds()
+	if seconds > 0 {
+		fmt.Fprintf(&b, "throughput: %.1f txn/s, %.1f rows/s\n", float64(r.Transactions)/seconds, float64(r.Rows)/seconds)
+	}
+	if r.WorkloadDuration > 0 && seconds > 0 {
+		fmt.Fprintf(&b, "original workload spans %s, replayed at %.2fx speed\n",
+			r.WorkloadDuration, r.WorkloadDuration.Seconds()/seconds)
+	}
+	fmt.Fprintf(&b, "latency: avg %s, p50 %s, p95 %s, p99 %s, max %s\n",
+		r.AvgLatency(), r.Percentile(50), r.Percentile(95), r.Percentile(99), r.Percentile(100))
+	fmt.Fprintf(&b, "causality conflicts: %d, skipped events: %d", r.Conflicts, r.SkippedEvents)
+	return b.String()
+}
+
+// recorder collects the statistics from concurrent workers.
+type recorder struct {
+	mu     sync.Mutex
+	report Report
+}
+
+func (r *recorder) recordTxn(rows int, latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.report.Transactions++
+	r.report.Rows += int64(rows)
+	r.report.latencies = append(r.report.latencies, latency)
+}
+
+func (r *recorder) update(fn func(report *Report)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fn(&r.report)
+}
+
+// snapshot returns the transactions and rows replayed so far.
+func (r *recorder) snapshot() (int64, int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.report.Transactions, r.report.Rows
+}
+
+// finish returns the final report, it should be called after all workers exit.
+func (r *recorder) finish(duration time.Duration) *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report := r.report
+	report.Duration = duration
+	sort.Slice(report.latencies, func(i, j int) bool {
+		return report.latencies[i] < report.latencies[j]
+	})
+	return &report
+}
@@ -104,45 +104,44 @@ func (c *causality) close() {
 
 // add adds keys relation and return the relation. The keys must `detectConflict` first to ensure correctness.
 func (c *causality) add(keys []string) string {
-	if len(keys) == 0 {
-		return ""
-	}
-
-	// find causal key
-	selectedRelation := keys[0]
-	var nonExistKeys []string
-	for _, key := range keys {
-		if val, ok := c.relation.get(key); ok {
-			selectedRelation = val
-		} else {
-			nonExistKeys = append(nonExistKeys, key)
-		}
-	}
-	// set causal relations for those non-exist keys
-	for _, key := range nonExistKeys {
-		c.relation.set(key, selectedRelation)
-	}
-
-	return selectedRelation
+	return c.relation.add(keys)
 }
 
 // detectConflict detects whether there is a conflict.
 func (c *causality) detectConflict(keys []string) bool {
-	if len(keys) == 0 {
-		return false
-	}
+	return c.relation.detectConflict(keys)
+}
 
-	var existedRelation string
-	for _, key := range keys {
-		if val, ok := c.relation.get(key); ok {
-			if existedRelation != "" && val != existedRelation {
-				return true
-			}
-			existedRelation = val
-		}
+// CausalityDetector detects the causal relations of row changes outside the syncer, such as replaying binlog.
+// Row changes having the same causality key must be executed sequentially.
+type CausalityDetector struct {
+	relation *causalityRelation
+}
+
+// NewCausalityDetector creates a CausalityDetector.
+func NewCausalityDetector() *CausalityDetector {
+	return &CausalityDetector{relation: newCausalityRelation()}
+}
+
+// Detect returns the causality key of the keys. When conflict is true, the keys relate to more than one
+// causality key and the caller should wait for all previous row changes to finish, the relations are
+// cleared in this case.
+func (d *CausalityDetector) Detect(keys []string) (key string, conflict bool) {
+	if d.relation.detectConflict(keys) {
+		conflict = true
+		d.relation.clear()
 	}
+	return d.relation.add(keys), conflict
+}
 
-	return false
+// Clear removes all relations, it should be called when all previous row changes are finished.
+func (d *CausalityDetector) Clear() {
+	d.relation.clear()
+}
+
+// Len returns the number of keys in relations.
+func (d *CausalityDetector) Len() int {
+	return d.relation.len()
 }
 
 // dmlJobKeyRelationGroup stores a group of dml job key relations as data, and a flush job seq representing last flush job before adding any job keys.
@@ -175,6 +174,49 @@ func (m *causalityRelation) set(key string, val string) {
 	m.groups[len(m.groups)-1].data[key] = val
 }
 
+// add adds keys relation and return the relation. The keys must `detectConflict` first to ensure correctness.
+func (m *causalityRelation) add(keys []string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+
+	// find causal key
+	selectedRelation := keys[0]
+	var nonExistKeys []string
+	for _, key := range keys {
+		if val, ok := m.get(key); ok {
+			selectedRelation = val
+		} else {
+			nonExistKeys = append(nonExistKeys, key)
+		}
+	}
+	// set causal relations for those non-exist keys
+	for _, key := range nonExistKeys {
+		m.set(key, selectedRelation)
+	}
+
+	return selectedRelation
+}
+
+// detectConflict detects whether the keys relate to more than one existing relation.
+func (m *causalityRelation) detectConflict(keys []string) bool {
+	if len(keys) == 0 {
+		return false
+	}
+
+	var existedRelation string
+	for _, key := range keys {
+		if val, ok := m.get(key); ok {
+			if existedRelation != "" && val != existedRelation {
+				return true
+			}
+			existedRelation = val
+		}
+	}
+
+	return false
+}
+
 func (m *causalityRelation) len() int {
 	cnt := 0
 	for _, d := range m.groups {
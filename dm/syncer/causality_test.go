@@ -64,6 +64,34 @@ func (s *testSyncerSuite) TestDetectConflict(c *C) {
 	c.Assert(ca.relation.len(), Equals, 0)
 }
 
+func TestCausalityDetector(t *testing.T) {
+	t.Parallel()
+
+	d := NewCausalityDetector()
+	key, conflict := d.Detect([]string{"a", "b"})
+	require.Equal(t, "a", key)
+	require.False(t, conflict)
+	key, conflict = d.Detect([]string{"c"})
+	require.Equal(t, "c", key)
+	require.False(t, conflict)
+	key, conflict = d.Detect([]string{"b", "d"})
+	require.Equal(t, "a", key)
+	require.False(t, conflict)
+	require.Equal(t, 4, d.Len())
+
+	// relate to both "a" and "c"
+	key, conflict = d.Detect([]string{"c", "d"})
+	require.Equal(t, "c", key)
+	require.True(t, conflict)
+	require.Equal(t, 2, d.Len())
+
+	d.Clear()
+	require.Equal(t, 0, d.Len())
+	key, conflict = d.Detect(nil)
+	require.Equal(t, "", key)
+	require.False(t, conflict)
+}
+
 func TestCausality(t *testing.T) {
 	t.Parallel()
 
@@ -274,7 +274,7 @@ type CheckPoint interface {
 
 	// FlushPointsExcept flushes the global checkpoint and tables'
 	// checkpoints except exceptTables, it also flushes SQLs with Args providing
-	// by extraSQLs and extraArgs. Currently extraSQLs contain shard meta only.
+	// by extraSQLs and extraArgs. Currently extraSQLs contain shard meta and the deletion of table resync state.
 	// @exceptTables: [[schema, table]... ]
 	// corresponding to Meta.Flush
 	FlushPointsExcept(tctx *tcontext.Context, snapshotID int, exceptTables []*filter.Table, extraSQLs []string, extraArgs [][]interface{}) error
@@ -324,6 +324,20 @@ type CheckPoint interface {
 
 	// CheckAndUpdate check the checkpoint data consistency and try to fix them if possible
 	CheckAndUpdate(ctx context.Context, schemas map[string]string, tables map[string]map[string]string) error
+
+	// ResyncState returns the table resync state loaded by Load, nil if no table is being resynced
+	ResyncState() *resyncState
+
+	// SaveResyncState saves the table resync state into the checkpoint table
+	SaveResyncState(tctx *tcontext.Context, state *resyncState) error
+
+	// GenDeleteResyncStateSQL generates SQL and arguments to delete the saved table resync state,
+	// it should be flushed along with the checkpoints by FlushPointsExcept
+	GenDeleteResyncStateSQL() (string, []interface{})
+
+	// FetchOtherSourcesTables returns the upstream tables whose table checkpoints are saved by
+	// the other sources of the task
+	FetchOtherSourcesTables(tctx *tcontext.Context) ([]*filter.Table, error)
 }
 
 // remoteCheckpointSnapshot contains info needed to flush checkpoint to downstream by FlushPointsExcept method.
@@ -372,6 +386,9 @@ type RemoteCheckPoint struct {
 	safeModeExitPoint          *binlog.Location
 	needFlushSafeModeExitPoint atomic.Bool
 
+	// resyncState is the table resync state loaded from downstream DB, it's nil if no table is being resynced.
+	resyncState *resyncState
+
 	logCtx *tcontext.Context
 
 	snapshots   []*remoteCheckpointSnapshot
@@ -530,6 +547,7 @@ func (cp *RemoteCheckPoint) Clear(tctx *tcontext.Context) error {
 	cp.points = make(map[string]map[string]*binlogPoint)
 	cp.snapshots = make([]*remoteCheckpointSnapshot, 0)
 	cp.safeModeExitPoint = nil
+	cp.resyncState = nil
 
 	return nil
 }
@@ -626,6 +644,7 @@ func (cp *RemoteCheckPoint) DeleteAllTablePoint(tctx *tcontext.Context) error {
 		return err
 	}
 	cp.points = make(map[string]map[string]*binlogPoint)
+	cp.resyncState = nil
 	return nil
 }
 
@@ -1115,6 +1134,15 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 			}
 			continue // skip global checkpoint
 		}
+		if cpSchema == globalCpSchema && cpTable == resyncCpTable {
+			state, err2 := parseResyncState(location, tiBytes, cp.cfg.Flavor)
+			if err2 != nil {
+				return err2
+			}
+			cp.resyncState = state
+			cp.logCtx.L().Info("fetch table resync state from DB", zap.Stringers("tables", state.tables), zap.Stringer("hold location", location))
+			continue
+		}
 
 		var ti *model.TableInfo
 		if !bytes.Equal(tiBytes, []byte("null")) {
@@ -1197,6 +1225,54 @@ func (cp *RemoteCheckPoint) CheckAndUpdate(ctx context.Context, schemas map[stri
 	return nil
 }
 
+// ResyncState implements CheckPoint.ResyncState.
+func (cp *RemoteCheckPoint) ResyncState() *resyncState {
+	cp.RLock()
+	defer cp.RUnlock()
+	return cp.resyncState
+}
+
+// SaveResyncState implements CheckPoint.SaveResyncState.
+func (cp *RemoteCheckPoint) SaveResyncState(tctx *tcontext.Context, state *resyncState) error {
+	stateBytes, err := state.marshal()
+	if err != nil {
+		return err
+	}
+	sql2, arg := cp.genUpdateSQL(globalCpSchema, resyncCpTable, state.holdLocation, nil, stateBytes, false)
+
+	// use a new context apart from syncer, to make sure when syncer call `cancel` checkpoint could update
+	tctx2, cancel := tctx.WithContext(context.Background()).WithTimeout(maxDMLConnectionDuration)
+	defer cancel()
+	_, err = cp.dbConn.ExecuteSQL(tctx2, cp.metricProxies, []string{sql2}, arg)
+	return err
+}
+
+// GenDeleteResyncStateSQL implements CheckPoint.GenDeleteResyncStateSQL.
+func (cp *RemoteCheckPoint) GenDeleteResyncStateSQL() (string, []interface{}) {
+	return `DELETE FROM ` + cp.tableName + ` WHERE id = ? AND cp_schema = ? AND cp_table = ?`,
+		[]interface{}{cp.id, globalCpSchema, resyncCpTable}
+}
+
+// FetchOtherSourcesTables implements CheckPoint.FetchOtherSourcesTables.
+func (cp *RemoteCheckPoint) FetchOtherSourcesTables(tctx *tcontext.Context) ([]*filter.Table, error) {
+	query := `SELECT DISTINCT cp_schema, cp_table FROM ` + cp.tableName + ` WHERE id <> ? AND is_global = 0 AND cp_schema <> ?`
+	rows, err := cp.dbConn.QuerySQL(tctx, cp.metricProxies, query, cp.id, globalCpSchema)
+	if err != nil {
+		return nil, terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	var tables []*filter.Table
+	for rows.Next() {
+		table := &filter.Table{}
+		if err = rows.Scan(&table.Schema, &table.Name); err != nil {
+			return nil, terror.DBErrorAdapt(err, cp.dbConn.Scope(), terror.ErrDBDriverError)
+		}
+		tables = append(tables, table)
+	}
+	return tables, terror.DBErrorAdapt(rows.Err(), cp.dbConn.Scope(), terror.ErrDBDriverError)
+}
+
 // LoadMeta implements CheckPoint.LoadMeta.
 func (cp *RemoteCheckPoint) LoadMeta(ctx context.Context) error {
 	cp.Lock()
@@ -35,6 +35,8 @@ type checkpointFlushTask struct {
 	asyncflushJob *job
 	// error chan for sync flush
 	syncFlushErrCh chan error
+	// commit ts of the last event written to the message queue before the snapshot
+	mqCheckpointTs uint64
 }
 
 type checkpointFlushWorker struct {
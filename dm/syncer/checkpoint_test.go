@@ -476,14 +476,30 @@ func (s *testCheckpointSuite) testTableCheckPoint(c *C, cp CheckPoint) {
 	columns := []string{"cp_schema", "cp_table", "binlog_name", "binlog_pos", "binlog_gtid", "exit_safe_binlog_name", "exit_safe_binlog_pos", "exit_safe_binlog_gtid", "table_info", "is_global"}
 	s.mock.ExpectQuery(loadCheckPointSQL).WithArgs(cpid).WillReturnRows(
 		sqlmock.NewRows(columns).AddRow("", "", pos2.Name, pos2.Pos, gs.String(), pos2.Name, pos2.Pos, gs.String(), "null", true).
-			AddRow(schemaName, tableName, pos2.Name, pos2.Pos, gs.String(), "", 0, "", tiBytes, false))
+			AddRow(schemaName, tableName, pos2.Name, pos2.Pos, gs.String(), "", 0, "", tiBytes, false).
+			AddRow("", resyncCpTable, pos2.Name, pos2.Pos, gs.String(), "", 0, "",
+				`{"stage":1,"tables":[{"db-name":"db","tbl-name":"tb1"}],"dump-binlog-name":"","dump-binlog-pos":0,"dump-binlog-gtid":""}`, false))
 	err = cp.Load(tctx)
 	c.Assert(err, IsNil)
 	c.Assert(cp.GlobalPoint(), DeepEquals, binlog.NewLocation(pos2, gs))
+	// table resync state is not loaded as a table checkpoint
+	resync := cp.ResyncState()
+	c.Assert(resync, NotNil)
+	c.Assert(resync.stage, Equals, resyncStageLoading)
+	c.Assert(resync.tables, DeepEquals, []*filter.Table{{Schema: "db", Name: "tb1"}})
+	c.Assert(resync.holdLocation, DeepEquals, binlog.NewLocation(pos2, gs))
 	rcp = cp.(*RemoteCheckPoint)
+	c.Assert(rcp.points, HasLen, 1)
 	c.Assert(rcp.points[schemaName][tableName].TableInfo(), NotNil)
 	c.Assert(rcp.points[schemaName][tableName].flushedPoint.ti, NotNil)
 	c.Assert(*rcp.safeModeExitPoint, DeepEquals, binlog.NewLocation(pos2, gs))
+
+	// fetch the tables of the other sources from their table checkpoints
+	s.mock.ExpectQuery("SELECT DISTINCT cp_schema, cp_table FROM .* WHERE id <> \\? AND is_global = 0 AND cp_schema <> \\?").
+		WithArgs(cpid, "").WillReturnRows(sqlmock.NewRows([]string{"cp_schema", "cp_table"}).AddRow("db", "tb2"))
+	otherTables, err := cp.FetchOtherSourcesTables(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(otherTables, DeepEquals, []*filter.Table{{Schema: "db", Name: "tb2"}})
 }
 
 func TestRemoteCheckPointLoadIntoSchemaTracker(t *testing.T) {
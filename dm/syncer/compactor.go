@@ -88,6 +88,13 @@ func (c *compactor) run() {
 				continue
 			}
 
+			// the row change with partial image can't be merged with others, keep the order of it.
+			if j.dml.HasPartialImage() {
+				c.flushBuffer()
+				c.outCh <- j
+				continue
+			}
+
 			// set safeMode when receive first job
 			if len(c.buffer) == 0 {
 				c.safeMode = j.safeMode
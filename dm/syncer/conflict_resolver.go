@@ -0,0 +1,231 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tiflow/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"go.uber.org/zap"
+)
+
+// conflictResolver resolves conflicts of rows with the same key from different sources
+// in the target tables of conflict rules. The changes of these tables are written by
+// conditional SQLs which keep the existing row when it wins over the change, and the
+// discarded changes are recorded in the conflict log table.
+type conflictResolver struct {
+	rules    []*config.ConflictRule
+	source   string
+	logTable string
+	logger   log.Logger
+
+	// target tables which lack the column needed by the rule, they are warned once.
+	warnedTables sync.Map
+}
+
+func newConflictResolver(cfg *config.SubTaskConfig, logger log.Logger) *conflictResolver {
+	if len(cfg.ConflictRules) == 0 {
+		return nil
+	}
+	return &conflictResolver{
+		rules:    cfg.ConflictRules,
+		source:   cfg.SourceID,
+		logTable: dbutil.TableName(cfg.MetaSchema, cputil.SyncerConflict(cfg.Name)),
+		logger:   logger.WithFields(zap.String("component", "conflict resolver")),
+	}
+}
+
+// createTable creates the conflict log table.
+func (c *conflictResolver) createTable(tctx *tcontext.Context, db *dbconn.DBConn, metricProxies *metrics.Proxies) error {
+	if c == nil {
+		return nil
+	}
+	sqls := []string{
+		`CREATE TABLE IF NOT EXISTS ` + c.logTable + ` (
+			id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			source VARCHAR(32) NOT NULL,
+			src_schema_name VARCHAR(128) NOT NULL,
+			src_table_name VARCHAR(128) NOT NULL,
+			dst_schema_name VARCHAR(128) NOT NULL,
+			dst_table_name VARCHAR(128) NOT NULL,
+			policy VARCHAR(32) NOT NULL,
+			dml_type VARCHAR(16) NOT NULL,
+			data JSON NOT NULL,
+			binlog_location TEXT,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX idx_dst_schema_table(dst_schema_name, dst_table_name),
+			INDEX idx_create_time(create_time)
+		)`,
+	}
+	_, err := db.ExecuteSQL(tctx, metricProxies, sqls)
+	c.logger.Info("create conflict log table", zap.Strings("statements", sqls))
+	return err
+}
+
+// match returns the conflict rule of the target table of the DML, or nil if there's none.
+func (c *conflictResolver) match(dml *sqlmodel.RowChange) *config.ConflictRule {
+	if c == nil || dml == nil {
+		return nil
+	}
+	target := dml.GetTargetTable()
+	for _, rule := range c.rules {
+		if !rule.Match(target.Schema, target.Table) {
+			continue
+		}
+		if _, ok := dml.RowValue(decisionColumn(rule)); !ok {
+			if _, warned := c.warnedTables.LoadOrStore(target.String(), struct{}{}); !warned {
+				c.logger.Warn("table doesn't have the column needed by conflict rule, skip resolving conflicts",
+					zap.Stringer("table", target), zap.String("rule", rule.Route), zap.String("column", decisionColumn(rule)))
+			}
+			return nil
+		}
+		return rule
+	}
+	return nil
+}
+
+// decisionColumn returns the column which decides the winner of a conflict.
+func decisionColumn(rule *config.ConflictRule) string {
+	if rule.Policy == config.ConflictLastWriterWins {
+		return rule.TimestampColumn
+	}
+	return rule.SourceColumn
+}
+
+// winCond returns the condition which is true when the change, whose value of the decision
+// column is `incoming`, overwrites the existing row. `incoming` is an SQL expression, and when
+// it's a placeholder, its argument should be put before the returned arguments.
+func winCond(rule *config.ConflictRule, incoming string) (string, []interface{}) {
+	col := quotes.QuoteName(decisionColumn(rule))
+	switch rule.Policy {
+	case config.ConflictLastWriterWins:
+		return fmt.Sprintf("(%s IS NULL OR %s >= %s)", col, incoming, col), nil
+	case config.ConflictSourcePriority:
+		// FIELD returns 0 for sources not in the list, so the list is from the lowest priority.
+		holders := strings.Repeat(",?", len(rule.SourcePriority))
+		args := make([]interface{}, 0, 2*len(rule.SourcePriority))
+		for i := len(rule.SourcePriority) - 1; i >= 0; i-- {
+			args = append(args, rule.SourcePriority[i])
+		}
+		args = append(args, args...)
+		return fmt.Sprintf("(%s IS NULL OR FIELD(%s%s) >= FIELD(%s%s))", col, incoming, holders, col, holders), args
+	default:
+		return fmt.Sprintf("(%s IS NULL OR %s = %s)", col, col, incoming), nil
+	}
+}
+
+// genSQLs generates the conditional SQLs of the DML. INSERT and UPDATE are converted to
+// upserts which only overwrite the losing row, DELETE only deletes the losing row.
+func (c *conflictResolver) genSQLs(rule *config.ConflictRule, dml *sqlmodel.RowChange) ([]string, [][]interface{}) {
+	var (
+		queries []string
+		args    [][]interface{}
+		col     = decisionColumn(rule)
+	)
+	genDelete := func() {
+		value, _ := dml.RowValue(col)
+		cond, condArgs := winCond(rule, "?")
+		query, arg := dml.GenConditionalDeleteSQL(cond, append([]interface{}{value}, condArgs...)...)
+		queries = append(queries, query)
+		args = append(args, arg)
+	}
+	genUpsert := func() {
+		cond, condArgs := winCond(rule, "VALUES("+quotes.QuoteName(col)+")")
+		query, arg := dml.GenConditionalUpsertSQL(cond, condArgs, col)
+		queries = append(queries, query)
+		args = append(args, arg)
+	}
+
+	switch dml.Type() {
+	case sqlmodel.RowChangeInsert:
+		genUpsert()
+	case sqlmodel.RowChangeUpdate:
+		if dml.IsIdentityUpdated() {
+			// the old row is deleted only when the new values win over it
+			genDelete()
+		}
+		genUpsert()
+	case sqlmodel.RowChangeDelete:
+		genDelete()
+	}
+	return queries, args
+}
+
+// genLogSQL generates the SQL which records the job in the conflict log table when
+// the existing row with the same key wins over it.
+func (c *conflictResolver) genLogSQL(rule *config.ConflictRule, j *job) (string, []interface{}, error) {
+	dml := j.dml
+	data, err := json.Marshal(dml.RowValues())
+	if err != nil {
+		return "", nil, err
+	}
+	var dmlType string
+	switch dml.Type() {
+	case sqlmodel.RowChangeInsert:
+		dmlType = "insert"
+	case sqlmodel.RowChangeUpdate:
+		dmlType = "update"
+	case sqlmodel.RowChangeDelete:
+		dmlType = "delete"
+	}
+	sourceTable, targetTable := dml.GetSourceTable(), dml.GetTargetTable()
+	args := []interface{}{
+		c.source, sourceTable.Schema, sourceTable.Table, targetTable.Schema, targetTable.Table,
+		string(rule.Policy), dmlType, string(data), j.currentLocation.String(),
+	}
+
+	where, whereArgs := dml.GenRowWhere()
+	args = append(args, whereArgs...)
+	value, _ := dml.RowValue(decisionColumn(rule))
+	cond, condArgs := winCond(rule, "?")
+	args = append(args, value)
+	args = append(args, condArgs...)
+
+	query := `INSERT INTO ` + c.logTable + `
+		(source, src_schema_name, src_table_name, dst_schema_name, dst_table_name, policy, dml_type, data, binlog_location)
+		SELECT ?, ?, ?, ?, ?, ?, ?, ?, ? FROM ` + targetTable.QuoteString() + ` WHERE ` + where + ` AND ` + cond + ` IS NOT TRUE LIMIT 1`
+	return query, args, nil
+}
+
+// genLogSQLs generates the SQLs to record the discarded jobs in the conflict log table.
+func (c *conflictResolver) genLogSQLs(jobs []*job) ([]string, [][]interface{}, error) {
+	var (
+		queries []string
+		args    [][]interface{}
+	)
+	for _, j := range jobs {
+		rule := c.match(j.dml)
+		if rule == nil {
+			continue
+		}
+		query, arg, err := c.genLogSQL(rule, j)
+		if err != nil {
+			return nil, nil, err
+		}
+		queries = append(queries, query)
+		args = append(args, arg)
+	}
+	return queries, args, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConflictWinCond(t *testing.T) {
+	t.Parallel()
+
+	cond, args := winCond(&config.ConflictRule{Policy: config.ConflictLastWriterWins, TimestampColumn: "ts"}, "VALUES(`ts`)")
+	require.Equal(t, "(`ts` IS NULL OR VALUES(`ts`) >= `ts`)", cond)
+	require.Len(t, args, 0)
+
+	cond, args = winCond(&config.ConflictRule{
+		Policy:         config.ConflictSourcePriority,
+		SourceColumn:   "src",
+		SourcePriority: []string{"s1", "s2"},
+	}, "?")
+	require.Equal(t, "(`src` IS NULL OR FIELD(?,?,?) >= FIELD(`src`,?,?))", cond)
+	require.Equal(t, []interface{}{"s2", "s1", "s2", "s1"}, args)
+
+	cond, args = winCond(&config.ConflictRule{Policy: config.ConflictReject, SourceColumn: "src"}, "?")
+	require.Equal(t, "(`src` IS NULL OR `src` = ?)", cond)
+	require.Len(t, args, 0)
+}
+
+func TestConflictResolverGenSQLs(t *testing.T) {
+	t.Parallel()
+
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.ConflictRules = []*config.ConflictRule{
+		{Route: "route-1", Policy: config.ConflictReject, SourceColumn: "src", TargetSchema: "db", TargetTable: "tb"},
+		{Route: "route-2", Policy: config.ConflictLastWriterWins, TimestampColumn: "ts", TargetSchema: "db2"},
+	}
+	resolver := newConflictResolver(cfg, log.L())
+	require.Equal(t, "`test`.`syncer_ut_syncer_conflict`", resolver.logTable)
+	worker := &DMLWorker{conflicts: resolver}
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb1"}
+	tableInfo := mockTableInfo(t, "create table db.tb1(id int primary key, name varchar(24), src varchar(24))")
+	insert := sqlmodel.NewRowChange(source, &cdcmodel.TableName{Schema: "db", Table: "tb"}, nil, []interface{}{1, "a", "s1"}, tableInfo, nil, nil)
+	update := sqlmodel.NewRowChange(source, &cdcmodel.TableName{Schema: "db", Table: "tb"}, []interface{}{1, "a", "s1"}, []interface{}{2, "b", "s1"}, tableInfo, nil, nil)
+	other := sqlmodel.NewRowChange(source, &cdcmodel.TableName{Schema: "db", Table: "other"}, nil, []interface{}{1, "a", "s1"}, tableInfo, nil, nil)
+	// db2 tables don't have column `ts`
+	noColumn := sqlmodel.NewRowChange(source, &cdcmodel.TableName{Schema: "db2", Table: "tb"}, []interface{}{1, "a", "s1"}, nil, tableInfo, nil, nil)
+	require.Equal(t, cfg.ConflictRules[0], resolver.match(insert))
+	require.Nil(t, resolver.match(other))
+	require.Nil(t, resolver.match(noColumn))
+
+	jobs := []*job{newDMLJob(other, ec), newDMLJob(insert, ec), newDMLJob(update, ec), newDMLJob(noColumn, ec)}
+	queries, args := worker.genSQLs(jobs)
+	require.Equal(t, []string{
+		"INSERT INTO `db`.`other` (`id`,`name`,`src`) VALUES (?,?,?)",
+		"INSERT INTO `db`.`tb` (`id`,`name`,`src`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE " +
+			"`id`=IF((`src` IS NULL OR `src` = VALUES(`src`)),VALUES(`id`),`id`)," +
+			"`name`=IF((`src` IS NULL OR `src` = VALUES(`src`)),VALUES(`name`),`name`)," +
+			"`src`=IF((`src` IS NULL OR `src` = VALUES(`src`)),VALUES(`src`),`src`)",
+		"DELETE FROM `db`.`tb` WHERE `id` = ? AND (`src` IS NULL OR `src` = ?) LIMIT 1",
+		"INSERT INTO `db`.`tb` (`id`,`name`,`src`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE " +
+			"`id`=IF((`src` IS NULL OR `src` = VALUES(`src`)),VALUES(`id`),`id`)," +
+			"`name`=IF((`src` IS NULL OR `src` = VALUES(`src`)),VALUES(`name`),`name`)," +
+			"`src`=IF((`src` IS NULL OR `src` = VALUES(`src`)),VALUES(`src`),`src`)",
+		"DELETE FROM `db2`.`tb` WHERE `id` = ? LIMIT 1",
+	}, queries)
+	require.Equal(t, [][]interface{}{
+		{1, "a", "s1"},
+		{1, "a", "s1"},
+		{1, "s1"},
+		{2, "b", "s1"},
+		{1},
+	}, args)
+	// conditional SQLs may affect no rows
+	require.False(t, worker.judgeKeyNotFound(0, jobs))
+
+	queries, args, err := resolver.genLogSQLs(jobs)
+	require.NoError(t, err)
+	require.Len(t, queries, 2)
+	require.Equal(t, "INSERT INTO `test`.`syncer_ut_syncer_conflict`\n"+
+		"\t\t(source, src_schema_name, src_table_name, dst_schema_name, dst_table_name, policy, dml_type, data, binlog_location)\n"+
+		"\t\tSELECT ?, ?, ?, ?, ?, ?, ?, ?, ? FROM `db`.`tb` WHERE `id` = ? AND (`src` IS NULL OR `src` = ?) IS NOT TRUE LIMIT 1", queries[0])
+	require.Equal(t, []interface{}{
+		cfg.SourceID, "db", "tb1", "db", "tb", "reject", "update", `[2,"b","s1"]`, jobs[2].currentLocation.String(), 2, "s1",
+	}, args[1])
+
+	require.Nil(t, newConflictResolver(genDefaultSubTaskConfig4Test(), log.L()))
+}
+
+func TestConflictLogInBatchTxn(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.ConflictRules = []*config.ConflictRule{
+		{Route: "route-1", Policy: config.ConflictReject, SourceColumn: "src", TargetSchema: "db", TargetTable: "tb"},
+	}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	worker := &DMLWorker{
+		task:          cfg.Name,
+		source:        cfg.SourceID,
+		conflicts:     newConflictResolver(cfg, log.L()),
+		metricProxies: metrics.DefaultMetricsProxies.CacheForOneTask(cfg.Name, "worker", cfg.SourceID),
+	}
+	toDB := dbconn.NewDBConn(cfg, conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}))
+	discarded := worker.metricProxies.DiscardedConflictsTotal.WithLabelValues(cfg.Name, cfg.SourceID)
+	discardedBefore := testutil.ToFloat64(discarded)
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb1"}
+	tableInfo := mockTableInfo(t, "create table db.tb1(id int primary key, name varchar(24), src varchar(24))")
+	insert := sqlmodel.NewRowChange(source, &cdcmodel.TableName{Schema: "db", Table: "tb"}, nil, []interface{}{1, "a", "s1"}, tableInfo, nil, nil)
+	jobs := []*job{newDMLJob(insert, ec)}
+	queries, args := worker.genSQLs(jobs)
+	tctx := tcontext.Background()
+
+	// the log SQL is executed in the same transaction with the DML, and is rolled back
+	// together with the DML when the transaction is retried.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `test`.`syncer_ut_syncer_conflict`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO `db`.`tb`").WillReturnError(&mysql.MySQLError{Number: 1213, Message: "Deadlock found"})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `test`.`syncer_ut_syncer_conflict`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO `db`.`tb`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	affect, err := worker.executeSQLs(tctx, toDB, jobs, queries, args)
+	require.NoError(t, err)
+	require.Equal(t, 1, affect)
+	require.Equal(t, discardedBefore+1, testutil.ToFloat64(discarded))
+
+	// a failed DML rolls back the log SQL, and the failed index points to the DML.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `test`.`syncer_ut_syncer_conflict`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO `db`.`tb`").WillReturnError(errors.New("mock error"))
+	mock.ExpectRollback()
+	affect, err = worker.executeSQLs(tctx, toDB, jobs, queries, args)
+	require.ErrorContains(t, err, "mock error")
+	require.Equal(t, 0, affect)
+	require.Equal(t, discardedBefore+1, testutil.ToFloat64(discarded))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -200,6 +200,8 @@ type DataValidator struct {
 
 	workers   []*validateWorker
 	workerCnt int
+	// snapshotChecker runs when validator starts in ValidationSnapshot mode, or on StartSnapshotCheck.
+	snapshotChecker *snapshotChecker
 
 	// whether we start to mark failed rows as error rows
 	// if it's false, we don't mark failed row change as error to reduce false-positive
@@ -212,6 +214,9 @@ type DataValidator struct {
 	flushedLoc  *binlog.Location
 	result      pb.ProcessResult
 	tableStatus map[string]*tableValidateStatus
+	// stage of the last snapshot check since validator starts, InvalidStage if not started.
+	snapshotCheckStage pb.Stage
+	snapshotCheckMsg   string
 
 	processedRowCounts   []atomic.Int64 // all processed row count since the beginning of validator
 	pendingRowCounts     []atomic.Int64
@@ -249,6 +254,8 @@ func NewContinuousDataValidator(cfg *config.SubTaskConfig, syncerObj *Syncer, st
 func (v *DataValidator) reset() {
 	v.errChan = make(chan error, 10)
 	v.workers = []*validateWorker{}
+	v.snapshotChecker = newSnapshotChecker(v)
+	v.setSnapshotCheckStage(pb.Stage_InvalidStage, "")
 
 	v.markErrorStarted.Store(false)
 	v.resetResult()
@@ -371,6 +378,10 @@ func (v *DataValidator) Start(expect pb.Stage) {
 	v.wg.Add(1)
 	go v.routineWrapper(v.printStatusRoutine)
 
+	if v.cfg.ValidatorCfg.Mode == config.ValidationSnapshot {
+		v.runSnapshotCheck()
+	}
+
 	v.wg.Add(1)
 	go utils.GoLogWrapper(v.L, v.markErrorStartedRoutine)
 
@@ -383,6 +394,29 @@ func (v *DataValidator) Start(expect pb.Stage) {
 	v.L.Info("started")
 }
 
+// StartSnapshotCheck starts a snapshot check of all tables on the running validator.
+func (v *DataValidator) StartSnapshotCheck() error {
+	v.Lock()
+	defer v.Unlock()
+
+	if v.Stage() != pb.Stage_Running || v.ctx.Err() != nil {
+		return terror.ErrValidatorSnapshotCheck.Generate("validator is not running")
+	}
+	if stage, _ := v.getSnapshotCheckStage(); stage == pb.Stage_Running {
+		return terror.ErrValidatorSnapshotCheck.Generate("another snapshot check is running")
+	}
+	v.runSnapshotCheck()
+	v.L.Info("snapshot check started")
+	return nil
+}
+
+// runSnapshotCheck should be called with the lock of validator held.
+func (v *DataValidator) runSnapshotCheck() {
+	v.setSnapshotCheckStage(pb.Stage_Running, "")
+	v.wg.Add(1)
+	go v.routineWrapper(v.snapshotChecker.run)
+}
+
 func (v *DataValidator) markErrorStartedRoutine() {
 	defer v.wg.Done()
 
@@ -865,10 +899,6 @@ func (v *DataValidator) processRowsEvent(header *replication.EventHeader, ev *re
 
 	failpoint.Inject("ValidatorPanic", func() {})
 
-	if err := checkLogColumns(ev.SkippedColumns); err != nil {
-		return terror.Annotate(err, sourceTable.String())
-	}
-
 	needSkip, err := v.syncer.skipRowsEvent(sourceTable, header.EventType)
 	if err != nil {
 		return err
@@ -916,14 +946,18 @@ func (v *DataValidator) processRowsEvent(header *replication.EventHeader, ev *re
 	}
 	estimatedRowSize := int32(header.EventSize) / int32(len(ev.Rows))
 	for i := 0; i < len(ev.Rows); i += step {
-		var beforeImage, afterImage []interface{}
+		var (
+			beforeImage, afterImage     []interface{}
+			beforeSkipped, afterSkipped []int
+		)
 		switch changeType {
 		case rowInsert:
-			afterImage = ev.Rows[i]
+			afterImage, afterSkipped = ev.Rows[i], skippedColumnsOfRow(ev, i)
 		case rowUpdated:
-			beforeImage, afterImage = ev.Rows[i], ev.Rows[i+1]
+			beforeImage, beforeSkipped = ev.Rows[i], skippedColumnsOfRow(ev, i)
+			afterImage, afterSkipped = partialAfterImage(ev.Rows[i+1], skippedColumnsOfRow(ev, i+1), beforeImage, beforeSkipped)
 		default: // rowDeleted
-			beforeImage = ev.Rows[i]
+			beforeImage, beforeSkipped = ev.Rows[i], skippedColumnsOfRow(ev, i)
 		}
 
 		rowChange := sqlmodel.NewRowChange(
@@ -934,6 +968,10 @@ func (v *DataValidator) processRowsEvent(header *replication.EventHeader, ev *re
 			nil,
 		)
 		rowChange.SetWhereHandle(downstreamTableInfo.WhereHandle)
+		if len(beforeSkipped) > 0 || len(afterSkipped) > 0 {
+			// only the logged columns are compared.
+			rowChange.SetSkippedColumns(beforeSkipped, afterSkipped)
+		}
 		size := estimatedRowSize
 		if changeType == rowUpdated && rowChange.IsIdentityUpdated() {
 			delRow, insRow := rowChange.SplitUpdate()
@@ -994,6 +1032,11 @@ func (v *DataValidator) persistCheckpointAndData(loc binlog.Location) error {
 		zap.Int64("pending count", v.getAllPendingRowCount()),
 		zap.Int64("new error", v.newErrorRowCount.Load()))
 
+	// snapshot checker runs concurrently, block it from adding error rows until they're reset.
+	if v.snapshotChecker != nil {
+		v.snapshotChecker.persistMu.Lock()
+		defer v.snapshotChecker.persistMu.Unlock()
+	}
 	err := v.persistHelper.persist(v.tctx, loc)
 	if err != nil {
 		return err
@@ -1003,6 +1046,9 @@ func (v *DataValidator) persistCheckpointAndData(loc binlog.Location) error {
 	for _, worker := range v.workers {
 		worker.resetErrorRows()
 	}
+	if v.snapshotChecker != nil {
+		v.snapshotChecker.resetErrorRows()
+	}
 	v.newErrorRowCount.Store(0)
 	v.setFlushedLoc(&loc)
 	return nil
@@ -1030,27 +1076,35 @@ func (v *DataValidator) loadPersistedData() error {
 		// aggregate using target table just as worker did.
 		pendingChanges[validateTbl.targetTable.String()] = pendingTblChange
 		for _, row := range tblChange.rows {
-			var beforeImage, afterImage []interface{}
+			var (
+				beforeImage, afterImage     []interface{}
+				beforeSkipped, afterSkipped []int
+			)
 			switch row.Tp {
 			case rowInsert:
-				afterImage = row.Data
+				afterImage, afterSkipped = row.Data, row.Skipped
 			case rowUpdated:
 				// set both to row.Data, since we only save one image on persist in order to save space
 				beforeImage, afterImage = row.Data, row.Data
+				beforeSkipped, afterSkipped = row.Skipped, row.Skipped
 			default:
 				// rowDeleted
-				beforeImage = row.Data
+				beforeImage, beforeSkipped = row.Data, row.Skipped
+			}
+			rowChange := sqlmodel.NewRowChange(
+				&cdcmodel.TableName{Schema: sourceTable.Schema, Table: sourceTable.Name},
+				&cdcmodel.TableName{Schema: validateTbl.targetTable.Schema, Table: validateTbl.targetTable.Name},
+				beforeImage, afterImage,
+				validateTbl.srcTableInfo, validateTbl.downstreamTableInfo.TableInfo,
+				nil,
+			)
+			if len(row.Skipped) > 0 {
+				rowChange.SetSkippedColumns(beforeSkipped, afterSkipped)
 			}
 			pendingTblChange.jobs[row.Key] = &rowValidationJob{
-				Key: row.Key,
-				Tp:  row.Tp,
-				row: sqlmodel.NewRowChange(
-					&cdcmodel.TableName{Schema: sourceTable.Schema, Table: sourceTable.Name},
-					&cdcmodel.TableName{Schema: validateTbl.targetTable.Schema, Table: validateTbl.targetTable.Name},
-					beforeImage, afterImage,
-					validateTbl.srcTableInfo, validateTbl.downstreamTableInfo.TableInfo,
-					nil,
-				),
+				Key:       row.Key,
+				Tp:        row.Tp,
+				row:       rowChange,
 				size:      row.Size,
 				FailedCnt: row.FailedCnt,
 			}
@@ -1092,6 +1146,19 @@ func (v *DataValidator) setStage(stage pb.Stage) {
 	v.stage = stage
 }
 
+func (v *DataValidator) getSnapshotCheckStage() (pb.Stage, string) {
+	v.stateMutex.RLock()
+	defer v.stateMutex.RUnlock()
+	return v.snapshotCheckStage, v.snapshotCheckMsg
+}
+
+func (v *DataValidator) setSnapshotCheckStage(stage pb.Stage, msg string) {
+	v.stateMutex.Lock()
+	defer v.stateMutex.Unlock()
+	v.snapshotCheckStage = stage
+	v.snapshotCheckMsg = msg
+}
+
 func (v *DataValidator) getFlushedLoc() *binlog.Location {
 	v.stateMutex.RLock()
 	defer v.stateMutex.RUnlock()
@@ -1217,7 +1284,8 @@ func getRowChangeType(t replication.EventType) rowChangeJobType {
 	switch t {
 	case replication.WRITE_ROWS_EVENTv0, replication.WRITE_ROWS_EVENTv1, replication.WRITE_ROWS_EVENTv2:
 		return rowInsert
-	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2,
+		replication.PARTIAL_UPDATE_ROWS_EVENT:
 		return rowUpdated
 	default:
 		// replication.DELETE_ROWS_EVENTv0, replication.DELETE_ROWS_EVENTv1, replication.DELETE_ROWS_EVENTv2:
@@ -1225,6 +1293,30 @@ func getRowChangeType(t replication.EventType) rowChangeJobType {
 	}
 }
 
+// skippedColumnsOfRow returns the offsets of the columns not logged of the i-th row of the event.
+func skippedColumnsOfRow(ev *replication.RowsEvent, i int) []int {
+	if i < len(ev.SkippedColumns) {
+		return ev.SkippedColumns[i]
+	}
+	return nil
+}
+
+// partialAfterImage returns a copy of the after image of an update, where the columns not logged are filled by
+// the before image if possible, and the partially updated JSON columns are marked as not logged because their
+// full values are unknown.
+func partialAfterImage(after []interface{}, afterSkipped []int, before []interface{}, beforeSkipped []int) ([]interface{}, []int) {
+	image := make([]interface{}, len(after))
+	copy(image, after)
+	skipped := fillSkippedColumns(image, afterSkipped, before, beforeSkipped)
+	for i, v := range image {
+		if _, ok := v.(*replication.JsonDiff); ok {
+			image[i] = nil
+			skipped = append(skipped, i)
+		}
+	}
+	return image, skipped
+}
+
 func genRowKey(row *sqlmodel.RowChange) string {
 	vals := row.RowStrIdentity()
 	return genRowKeyByString(vals)
@@ -1319,6 +1411,9 @@ func (v *DataValidator) OperateValidatorError(validateOp pb.ValidationErrOp, err
 }
 
 func (v *DataValidator) UpdateValidator(req *pb.UpdateValidationWorkerRequest) error {
+	if req.SnapshotCheck {
+		return v.StartSnapshotCheck()
+	}
 	var (
 		pos = mysql.Position{}
 		gs  mysql.GTIDSet
@@ -1372,11 +1467,20 @@ func (v *DataValidator) getErrorRowCount(timeout time.Duration) ([errorStateType
 }
 
 func (v *DataValidator) GetValidatorStatus() *pb.ValidationStatus {
-	var extraMsg string
+	var (
+		extraMsg         string
+		errorRowCountMsg *pb.ValidationErrorRowCount
+	)
 	errorRowCount, err := v.getErrorRowCount(validatorDmctlOpTimeout)
 	if err != nil {
 		// nolint:nilerr
 		extraMsg = fmt.Sprintf(" (failed to load error count from meta db: %s)", err.Error())
+	} else {
+		errorRowCountMsg = &pb.ValidationErrorRowCount{
+			NewRows:      errorRowCount[pb.ValidateErrorState_NewErr],
+			IgnoredRows:  errorRowCount[pb.ValidateErrorState_IgnoredErr],
+			ResolvedRows: errorRowCount[pb.ValidateErrorState_ResolvedErr],
+		}
 	}
 	// if we print those state in a structured way, there would be at least 9 lines for each subtask,
 	// which is hard to read, so print them into one line.
@@ -1412,6 +1516,7 @@ func (v *DataValidator) GetValidatorStatus() *pb.ValidationStatus {
 			cutoverBinlogGTID = cutOverLoc.GetGTID().String()
 		}
 	}
+	snapshotCheckStage, snapshotCheckMsg := v.getSnapshotCheckStage()
 
 	return &pb.ValidationStatus{
 		Task:                v.cfg.Name,
@@ -1426,5 +1531,8 @@ func (v *DataValidator) GetValidatorStatus() *pb.ValidationStatus {
 		ErrorRowsStatus:     errorRows,
 		CutoverBinlogPos:    cutoverBinlogPos,
 		CutoverBinlogGtid:   cutoverBinlogGTID,
+		SnapshotCheckStage:  snapshotCheckStage,
+		SnapshotCheckMsg:    snapshotCheckMsg,
+		ErrorRowCount:       errorRowCountMsg,
 	}
 }
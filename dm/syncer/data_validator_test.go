@@ -566,11 +566,27 @@ func TestValidatorGetRowChangeType(t *testing.T) {
 	require.Equal(t, rowUpdated, getRowChangeType(replication.UPDATE_ROWS_EVENTv0))
 	require.Equal(t, rowUpdated, getRowChangeType(replication.UPDATE_ROWS_EVENTv1))
 	require.Equal(t, rowUpdated, getRowChangeType(replication.UPDATE_ROWS_EVENTv2))
+	require.Equal(t, rowUpdated, getRowChangeType(replication.PARTIAL_UPDATE_ROWS_EVENT))
 	require.Equal(t, rowDeleted, getRowChangeType(replication.DELETE_ROWS_EVENTv0))
 	require.Equal(t, rowDeleted, getRowChangeType(replication.DELETE_ROWS_EVENTv1))
 	require.Equal(t, rowDeleted, getRowChangeType(replication.DELETE_ROWS_EVENTv2))
 }
 
+func TestValidatorPartialAfterImage(t *testing.T) {
+	// UPDATE tb SET a = 2, j = JSON_SET(j, '$.a', 1) WHERE id = 1 with binlog_row_image=MINIMAL
+	before := []interface{}{int32(1), nil, nil, nil}
+	after := []interface{}{nil, int32(2), nil, &replication.JsonDiff{Op: replication.JsonDiffOperationReplace, Path: "$.a", Value: "1"}}
+	image, skipped := partialAfterImage(after, []int{0, 2}, before, []int{1, 2, 3})
+	require.Equal(t, []interface{}{int32(1), int32(2), nil, nil}, image)
+	require.Equal(t, []int{2, 3}, skipped)
+	// the after image of the event is not changed
+	require.Nil(t, after[0])
+
+	ev := &replication.RowsEvent{SkippedColumns: [][]int{{1}}}
+	require.Equal(t, []int{1}, skippedColumnsOfRow(ev, 0))
+	require.Nil(t, skippedColumnsOfRow(ev, 1))
+}
+
 func TestValidatorGenRowKey(t *testing.T) {
 	require.Equal(t, "a", genRowKeyByString([]string{"a"}))
 	require.Equal(t, "a\tb", genRowKeyByString([]string{"a", "b"}))
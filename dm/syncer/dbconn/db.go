@@ -144,6 +144,29 @@ func (conn *DBConn) ExecuteSQLWithIgnore(
 	ignoreError func(error) bool,
 	queries []string,
 	args ...[]interface{},
+) (int, error) {
+	return conn.executeSQL(tctx, metricProxies, ignoreError, nil, queries, args...)
+}
+
+// ExecuteSQLWithRowsAffected does some SQL executions like ExecuteSQL, and also records the
+// rows affected by each query into `rowsAffected`, which should have the same length as `queries`.
+func (conn *DBConn) ExecuteSQLWithRowsAffected(
+	tctx *tcontext.Context,
+	metricProxies *metrics.Proxies,
+	rowsAffected []int64,
+	queries []string,
+	args ...[]interface{},
+) (int, error) {
+	return conn.executeSQL(tctx, metricProxies, nil, rowsAffected, queries, args...)
+}
+
+func (conn *DBConn) executeSQL(
+	tctx *tcontext.Context,
+	metricProxies *metrics.Proxies,
+	ignoreError func(error) bool,
+	rowsAffected []int64,
+	queries []string,
+	args ...[]interface{},
 ) (int, error) {
 	failpoint.Inject("ExecuteSQLWithIgnoreFailed", func(val failpoint.Value) {
 		queryPattern := val.(string)
@@ -178,7 +201,15 @@ func (conn *DBConn) ExecuteSQLWithIgnore(
 			if metricProxies != nil {
 				histProxy = metricProxies.StmtHistogram
 			}
-			ret, err := conn.baseConn.ExecuteSQLWithIgnoreError(ctx, histProxy, conn.cfg.Name, ignoreError, queries, args...)
+			var (
+				ret int
+				err error
+			)
+			if rowsAffected != nil {
+				ret, err = conn.baseConn.ExecuteSQLWithRowsAffected(ctx, histProxy, conn.cfg.Name, rowsAffected, queries, args...)
+			} else {
+				ret, err = conn.baseConn.ExecuteSQLWithIgnoreError(ctx, histProxy, conn.cfg.Name, ignoreError, queries, args...)
+			}
 			if err == nil {
 				cost := time.Since(startTime)
 				// duration seconds
@@ -133,6 +133,7 @@ type Normal struct {
 	onlineDDL     onlineddl.OnlinePlugin
 	handleJobFunc func(*job) (bool, error)
 	execError     *atomic.Error
+	tableResyncer *tableResyncer
 }
 
 func NewNormalDDL(pLogger *log.Logger, syncer *Syncer) *Normal {
@@ -142,6 +143,7 @@ func NewNormalDDL(pLogger *log.Logger, syncer *Syncer) *Normal {
 		onlineDDL:     syncer.onlineDDL,
 		handleJobFunc: syncer.handleJobFunc,
 		execError:     &syncer.execError,
+		tableResyncer: syncer.tableResyncer,
 	}
 }
 
@@ -409,7 +411,12 @@ func (ddl *DDLWorker) HandleQueryEvent(ev *replication.QueryEvent, ec eventConte
 	return ddl.strategy.handleDDL(qec)
 }
 
-func (ddl *Normal) preFilter(*ddlInfo, *queryEventContext, *filter.Table, *filter.Table) (bool, error) {
+func (ddl *Normal) preFilter(ddlInfo *ddlInfo, _ *queryEventContext, sourceTable *filter.Table, _ *filter.Table) (bool, error) {
+	// the dumped table structure may be conflict with the DDL, so the resync is stopped.
+	if ddl.tableResyncer != nil && ddl.tableResyncer.skipping(sourceTable) {
+		ddl.tableResyncer.abort()
+		return false, terror.ErrSyncerResyncTableDDL.Generate(ddlInfo.originDDL, sourceTable)
+	}
 	return false, nil
 }
 
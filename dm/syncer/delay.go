@@ -0,0 +1,143 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pb"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// delayCheckInterval is the max interval to re-check the delayed window and
+// flush outdated checkpoint when holding a binlog event.
+var delayCheckInterval = 5 * time.Second
+
+// delayController holds binlog events until `event.Header.Timestamp + delay` has passed,
+// so the downstream intentionally trails the upstream by the delay.
+// the timestamp of binlog event is in the clock of upstream, so tsOffset is used to
+// translate it into the local clock.
+// the state of the delayed window is kept in memory, so it's reset when the subtask is restarted.
+type delayController struct {
+	delay    time.Duration
+	tsOffset *atomic.Int64
+
+	mu sync.Mutex
+	// paused means all binlog events are held until resumed.
+	paused bool
+	// binlog events whose timestamp is not larger than fastForwardTS are released
+	// immediately, it's in the clock of upstream.
+	fastForwardTS int64
+	// notifyCh is closed and replaced when the state is changed.
+	notifyCh chan struct{}
+
+	now func() time.Time
+}
+
+func newDelayController(delay time.Duration, tsOffset *atomic.Int64) *delayController {
+	return &delayController{
+		delay:    delay,
+		tsOffset: tsOffset,
+		notifyCh: make(chan struct{}),
+		now:      time.Now,
+	}
+}
+
+// Operate pauses, resumes or fast-forwards the delayed window.
+func (d *delayController) Operate(op pb.SyncDelayOp) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch op {
+	case pb.SyncDelayOp_PauseSyncDelay:
+		d.paused = true
+	case pb.SyncDelayOp_ResumeSyncDelay:
+		d.paused = false
+	case pb.SyncDelayOp_FastForwardSyncDelay:
+		// fast-forward also resumes the window, otherwise it makes no sense.
+		d.paused = false
+		d.fastForwardTS = d.now().Unix() - d.tsOffset.Load()
+	default:
+		return terror.ErrSyncerUnitNotSupportedOperate.Generate(op)
+	}
+	close(d.notifyCh)
+	d.notifyCh = make(chan struct{})
+	return nil
+}
+
+// releaseIn returns how long the binlog event with timestamp ts should still be held,
+// and a channel which is closed when the delayed window is operated.
+// a negative duration means the event is held until the channel is closed.
+func (d *delayController) releaseIn(ts int64) (time.Duration, <-chan struct{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.paused {
+		return -1, d.notifyCh
+	}
+	if ts <= d.fastForwardTS {
+		return 0, d.notifyCh
+	}
+	releaseTime := time.Unix(ts+d.tsOffset.Load(), 0).Add(d.delay)
+	wait := releaseTime.Sub(d.now())
+	if wait < 0 {
+		wait = 0
+	}
+	return wait, d.notifyCh
+}
+
+// wait blocks until the binlog event with timestamp ts can be released or ctx is done.
+// onHold is called at most every delayCheckInterval while holding the event, which
+// gives syncer a chance to flush checkpoint of the already executed jobs.
+func (d *delayController) wait(tctx *tcontext.Context, ts int64, onHold func() error) error {
+	logged := false
+	for {
+		wait, notifyCh := d.releaseIn(ts)
+		if wait == 0 {
+			return nil
+		}
+		if !logged {
+			tctx.L().Info("hold binlog event for delayed replication",
+				zap.Int64("binlog timestamp", ts), zap.Duration("delay", d.delay), zap.Duration("release in", wait))
+			logged = true
+		}
+		if wait < 0 || wait > delayCheckInterval {
+			wait = delayCheckInterval
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-tctx.Context().Done():
+			timer.Stop()
+			return tctx.Context().Err()
+		case <-notifyCh:
+			timer.Stop()
+		case <-timer.C:
+		}
+		if err := onHold(); err != nil {
+			return err
+		}
+	}
+}
+
+// OperateSyncDelay pauses, resumes or fast-forwards the delayed window of delayed replication.
+func (s *Syncer) OperateSyncDelay(op pb.SyncDelayOp) error {
+	if s.delay == nil {
+		return terror.ErrSyncerDelayNotEnabled.Generate()
+	}
+	s.tctx.L().Info("operate delayed window", zap.Stringer("op", op))
+	return s.delay.Operate(op)
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pb"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestDelayControllerReleaseIn(t *testing.T) {
+	now := time.Unix(10000, 0)
+	tsOffset := atomic.NewInt64(0)
+	d := newDelayController(time.Minute, tsOffset)
+	d.now = func() time.Time { return now }
+
+	wait, _ := d.releaseIn(now.Unix() - 20)
+	require.Equal(t, 40*time.Second, wait)
+	wait, _ = d.releaseIn(now.Unix() - 100)
+	require.Equal(t, time.Duration(0), wait)
+
+	// DM is 10s ahead of upstream
+	tsOffset.Store(10)
+	wait, _ = d.releaseIn(now.Unix() - 20)
+	require.Equal(t, 50*time.Second, wait)
+
+	// pause holds all events
+	require.NoError(t, d.Operate(pb.SyncDelayOp_PauseSyncDelay))
+	wait, _ = d.releaseIn(now.Unix() - 100)
+	require.Less(t, wait, time.Duration(0))
+
+	// fast-forward releases events written before now, and resumes the window
+	require.NoError(t, d.Operate(pb.SyncDelayOp_FastForwardSyncDelay))
+	wait, _ = d.releaseIn(now.Unix() - 10)
+	require.Equal(t, time.Duration(0), wait)
+	now = now.Add(5 * time.Second)
+	wait, _ = d.releaseIn(now.Unix() - 10)
+	require.Equal(t, time.Minute, wait)
+
+	require.NoError(t, d.Operate(pb.SyncDelayOp_PauseSyncDelay))
+	require.NoError(t, d.Operate(pb.SyncDelayOp_ResumeSyncDelay))
+	wait, _ = d.releaseIn(now.Unix() - 10)
+	require.Equal(t, time.Minute, wait)
+
+	require.True(t, terror.ErrSyncerUnitNotSupportedOperate.Equal(d.Operate(pb.SyncDelayOp_InvalidSyncDelayOp)))
+}
+
+func TestDelayControllerWait(t *testing.T) {
+	backup := delayCheckInterval
+	delayCheckInterval = 10 * time.Millisecond
+	defer func() {
+		delayCheckInterval = backup
+	}()
+
+	d := newDelayController(time.Hour, atomic.NewInt64(0))
+	ts := time.Now().Unix()
+
+	// the event is held and onHold is called periodically until fast-forward
+	holdCnt := atomic.NewInt32(0)
+	errCh := make(chan error)
+	go func() {
+		errCh <- d.wait(tcontext.Background(), ts, func() error {
+			holdCnt.Inc()
+			return nil
+		})
+	}()
+	require.Eventually(t, func() bool {
+		return holdCnt.Load() >= 2
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, d.Operate(pb.SyncDelayOp_FastForwardSyncDelay))
+	require.NoError(t, <-errCh)
+
+	// error of onHold is returned
+	holdErr := terror.ErrSyncerDelayNotEnabled.Generate()
+	err := d.wait(tcontext.Background(), time.Now().Unix()+1, func() error {
+		return holdErr
+	})
+	require.Equal(t, holdErr, err)
+
+	// quit when context is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = d.wait(tcontext.Background().WithContext(ctx), time.Now().Unix()+1, func() error {
+		return nil
+	})
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestSyncerOperateSyncDelay(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	syncer := NewSyncer(cfg, nil, nil)
+	require.Nil(t, syncer.delay)
+	require.True(t, terror.ErrSyncerDelayNotEnabled.Equal(syncer.OperateSyncDelay(pb.SyncDelayOp_PauseSyncDelay)))
+
+	cfg.Delay = "1h"
+	syncer = NewSyncer(cfg, nil, nil)
+	require.NotNil(t, syncer.delay)
+	require.Equal(t, time.Hour, syncer.delay.delay)
+	require.NoError(t, syncer.OperateSyncDelay(pb.SyncDelayOp_PauseSyncDelay))
+	require.True(t, syncer.delay.paused)
+}
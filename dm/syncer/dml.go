@@ -16,6 +16,7 @@ package syncer
 import (
 	"encoding/binary"
 
+	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/parser/charset"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -41,16 +42,18 @@ type genDMLParam struct {
 	originalData    [][]interface{}  // all data
 	sourceTableInfo *model.TableInfo // all table info
 	extendData      [][]interface{}  // all data include extend data
+	// offsets of the columns not logged of every row, see binlog_row_image.
+	skippedColumns [][]int
 }
 
 // latin1Decider is not usually ISO8859_1 in MySQL.
 // ref https://dev.mysql.com/doc/refman/8.0/en/charset-we-sets.html
 var latin1Decoder = charmap.Windows1252.NewDecoder()
 
-// adjustValueFromBinlogData adjust the values obtained from go-mysql so that
+// AdjustValueFromBinlogData adjust the values obtained from go-mysql so that
 // - the values can be correctly converted to TiDB datum
 // - the values are in the correct type that go-sql-driver/mysql uses.
-func adjustValueFromBinlogData(
+func AdjustValueFromBinlogData(
 	data []interface{},
 	sourceTI *model.TableInfo,
 ) ([]interface{}, error) {
@@ -88,6 +91,8 @@ func adjustValueFromBinlogData(
 			d = uint64(v)
 		case decimal.Decimal:
 			d = v.String()
+		case *replication.JsonDiff:
+			d = sqlmodel.JSONDiffs{adjustJSONDiff(v)}
 		case []byte:
 			if isLatin1 {
 				d, err = latin1Decoder.Bytes(v)
@@ -128,6 +133,50 @@ func adjustValueFromBinlogData(
 	return value, nil
 }
 
+// adjustJSONDiff converts a partial JSON update of binlog to sqlmodel.
+func adjustJSONDiff(diff *replication.JsonDiff) sqlmodel.JSONDiff {
+	ret := sqlmodel.JSONDiff{Path: diff.Path, Value: diff.Value}
+	switch diff.Op {
+	case replication.JsonDiffOperationInsert:
+		ret.Op = sqlmodel.JSONDiffInsert
+	case replication.JsonDiffOperationRemove:
+		ret.Op = sqlmodel.JSONDiffRemove
+	default:
+		ret.Op = sqlmodel.JSONDiffReplace
+	}
+	return ret
+}
+
+// skippedColumnsOfRow returns the offsets of the columns not logged of the i-th row.
+func (p *genDMLParam) skippedColumnsOfRow(i int) []int {
+	if i < len(p.skippedColumns) {
+		return p.skippedColumns[i]
+	}
+	return nil
+}
+
+// isPartialRow returns true when some columns of the row are not logged or partially updated.
+func isPartialRow(skipped []int, values []interface{}) bool {
+	if len(skipped) > 0 {
+		return true
+	}
+	for _, v := range values {
+		if _, ok := v.(sqlmodel.JSONDiffs); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPartialRowFilter returns error when a partial row should be evaluated by the binlog value expression filters,
+// because the values of the absent columns are unknown.
+func checkPartialRowFilter(hasFilter bool, skipped []int, values []interface{}) error {
+	if hasFilter && isPartialRow(skipped, values) {
+		return terror.Annotate(terror.ErrBinlogNotLogColumn, "expression filter needs full row image")
+	}
+	return nil
+}
+
 // nolint:dupl
 func (s *Syncer) genAndFilterInsertDMLs(tctx *tcontext.Context, param *genDMLParam, filterExprs []expression.Expression) ([]*sqlmodel.RowChange, error) {
 	var (
@@ -139,7 +188,7 @@ func (s *Syncer) genAndFilterInsertDMLs(tctx *tcontext.Context, param *genDMLPar
 	)
 
 	// if downstream pk/uk(not null) exits, then use downstream pk/uk(not null)
-	downstreamTableInfo, err := s.schemaTracker.GetDownStreamTableInfo(tctx, tableID, ti)
+	downstreamTableInfo, err := s.getDownStreamTableInfo(tctx, tableID, ti)
 	if err != nil {
 		return nil, err
 	}
@@ -149,11 +198,15 @@ func (s *Syncer) genAndFilterInsertDMLs(tctx *tcontext.Context, param *genDMLPar
 	}
 
 RowLoop:
-	for _, data := range originalDataSeq {
-		originalValue, err := adjustValueFromBinlogData(data, ti)
+	for i, data := range originalDataSeq {
+		originalValue, err := AdjustValueFromBinlogData(data, ti)
 		if err != nil {
 			return nil, err
 		}
+		skipped := param.skippedColumnsOfRow(i)
+		if err = checkPartialRowFilter(len(filterExprs) > 0, skipped, originalValue); err != nil {
+			return nil, err
+		}
 
 		for _, expr := range filterExprs {
 			skip, err := SkipDMLByExpression(s.sessCtx, originalValue, expr, ti.Columns)
@@ -166,6 +219,11 @@ RowLoop:
 			}
 		}
 
+		originalValue, err = s.columnTransforms.TransformRow(param.sourceTable, ti, originalValue)
+		if err != nil {
+			return nil, err
+		}
+
 		rowChange := sqlmodel.NewRowChange(
 			&cdcmodel.TableName{Schema: param.sourceTable.Schema, Table: param.sourceTable.Name},
 			&cdcmodel.TableName{Schema: param.targetTable.Schema, Table: param.targetTable.Name},
@@ -176,6 +234,9 @@ RowLoop:
 			s.sessCtx,
 		)
 		rowChange.SetWhereHandle(downstreamTableInfo.WhereHandle)
+		if len(skipped) > 0 {
+			rowChange.SetSkippedColumns(nil, skipped)
+		}
 		dmls = append(dmls, rowChange)
 	}
 
@@ -198,7 +259,7 @@ func (s *Syncer) genAndFilterUpdateDMLs(
 	)
 
 	// if downstream pk/uk(not null) exits, then use downstream pk/uk(not null)
-	downstreamTableInfo, err := s.schemaTracker.GetDownStreamTableInfo(tctx, tableID, ti)
+	downstreamTableInfo, err := s.getDownStreamTableInfo(tctx, tableID, ti)
 	if err != nil {
 		return nil, err
 	}
@@ -216,14 +277,28 @@ RowLoop:
 			return nil, terror.ErrSyncerUnitDMLOldNewValueMismatch.Generate(len(oriOldData), len(oriChangedData))
 		}
 
-		oriOldValues, err := adjustValueFromBinlogData(oriOldData, ti)
+		oriOldValues, err := AdjustValueFromBinlogData(oriOldData, ti)
 		if err != nil {
 			return nil, err
 		}
-		oriChangedValues, err := adjustValueFromBinlogData(oriChangedData, ti)
+		oriChangedValues, err := AdjustValueFromBinlogData(oriChangedData, ti)
 		if err != nil {
 			return nil, err
 		}
+		oldSkipped, changedSkipped := param.skippedColumnsOfRow(i), param.skippedColumnsOfRow(i+1)
+		if err = checkPartialRowFilter(len(oldValueFilters) > 0, oldSkipped, oriOldValues); err != nil {
+			return nil, err
+		}
+		if err = checkPartialRowFilter(len(newValueFilters) > 0, changedSkipped, oriChangedValues); err != nil {
+			return nil, err
+		}
+		// the columns not logged in the after image are not changed, use the values of the before image if logged.
+		changedSkipped = fillSkippedColumns(oriChangedValues, changedSkipped, oriOldValues, oldSkipped)
+		if param.safeMode {
+			if err = fillUnsafeJSONDiffs(ti, oriChangedValues, oriOldValues, oldSkipped); err != nil {
+				return nil, err
+			}
+		}
 
 		for j := range oldValueFilters {
 			// AND logic
@@ -243,6 +318,15 @@ RowLoop:
 			}
 		}
 
+		oriOldValues, err = s.columnTransforms.TransformRow(param.sourceTable, ti, oriOldValues)
+		if err != nil {
+			return nil, err
+		}
+		oriChangedValues, err = s.columnTransforms.TransformRow(param.sourceTable, ti, oriChangedValues)
+		if err != nil {
+			return nil, err
+		}
+
 		rowChange := sqlmodel.NewRowChange(
 			&cdcmodel.TableName{Schema: param.sourceTable.Schema, Table: param.sourceTable.Name},
 			&cdcmodel.TableName{Schema: param.targetTable.Schema, Table: param.targetTable.Name},
@@ -253,6 +337,9 @@ RowLoop:
 			s.sessCtx,
 		)
 		rowChange.SetWhereHandle(downstreamTableInfo.WhereHandle)
+		if len(oldSkipped) > 0 || len(changedSkipped) > 0 {
+			rowChange.SetSkippedColumns(oldSkipped, changedSkipped)
+		}
 		dmls = append(dmls, rowChange)
 	}
 
@@ -270,7 +357,7 @@ func (s *Syncer) genAndFilterDeleteDMLs(tctx *tcontext.Context, param *genDMLPar
 	)
 
 	// if downstream pk/uk(not null) exits, then use downstream pk/uk(not null)
-	downstreamTableInfo, err := s.schemaTracker.GetDownStreamTableInfo(tctx, tableID, ti)
+	downstreamTableInfo, err := s.getDownStreamTableInfo(tctx, tableID, ti)
 	if err != nil {
 		return nil, err
 	}
@@ -280,11 +367,15 @@ func (s *Syncer) genAndFilterDeleteDMLs(tctx *tcontext.Context, param *genDMLPar
 	}
 
 RowLoop:
-	for _, data := range dataSeq {
-		value, err := adjustValueFromBinlogData(data, ti)
+	for i, data := range dataSeq {
+		value, err := AdjustValueFromBinlogData(data, ti)
 		if err != nil {
 			return nil, err
 		}
+		skipped := param.skippedColumnsOfRow(i)
+		if err = checkPartialRowFilter(len(filterExprs) > 0, skipped, value); err != nil {
+			return nil, err
+		}
 
 		for _, expr := range filterExprs {
 			skip, err := SkipDMLByExpression(s.sessCtx, value, expr, ti.Columns)
@@ -297,6 +388,11 @@ RowLoop:
 			}
 		}
 
+		value, err = s.columnTransforms.TransformRow(param.sourceTable, ti, value)
+		if err != nil {
+			return nil, err
+		}
+
 		rowChange := sqlmodel.NewRowChange(
 			&cdcmodel.TableName{Schema: param.sourceTable.Schema, Table: param.sourceTable.Name},
 			&cdcmodel.TableName{Schema: param.targetTable.Schema, Table: param.targetTable.Name},
@@ -307,12 +403,68 @@ RowLoop:
 			s.sessCtx,
 		)
 		rowChange.SetWhereHandle(downstreamTableInfo.WhereHandle)
+		if len(skipped) > 0 {
+			rowChange.SetSkippedColumns(skipped, nil)
+		}
 		dmls = append(dmls, rowChange)
 	}
 
 	return dmls, nil
 }
 
+// fillSkippedColumns fills the columns skipped in dst with the values of src if they are logged in src, and returns
+// the offsets of the columns still skipped.
+func fillSkippedColumns(dst []interface{}, dstSkipped []int, src []interface{}, srcSkipped []int) []int {
+	if len(dstSkipped) == 0 {
+		return dstSkipped
+	}
+	inSrcSkipped := make(map[int]struct{}, len(srcSkipped))
+	for _, offset := range srcSkipped {
+		inSrcSkipped[offset] = struct{}{}
+	}
+	ret := make([]int, 0, len(dstSkipped))
+	for _, offset := range dstSkipped {
+		if _, ok := inSrcSkipped[offset]; ok || offset >= len(src) || offset >= len(dst) {
+			ret = append(ret, offset)
+			continue
+		}
+		dst[offset] = src[offset]
+	}
+	return ret
+}
+
+// fillUnsafeJSONDiffs replaces the partial JSON updates which are not idempotent with the full values computed from
+// the before image, because the row change may be executed more than once in safe mode.
+func fillUnsafeJSONDiffs(ti *model.TableInfo, changed []interface{}, old []interface{}, oldSkipped []int) error {
+	for i, v := range changed {
+		diffs, ok := v.(sqlmodel.JSONDiffs)
+		if !ok || diffs.IsIdempotent() {
+			continue
+		}
+		var doc string
+		switch before := old[i].(type) {
+		case string:
+			doc = before
+		case []byte:
+			doc = string(before)
+		default:
+			// the before value is not logged or is NULL
+			return terror.ErrSyncerUnsafePartialJSONUpdate.Generate(ti.Columns[i].Name.O)
+		}
+		for _, offset := range oldSkipped {
+			if offset == i {
+				return terror.ErrSyncerUnsafePartialJSONUpdate.Generate(ti.Columns[i].Name.O)
+			}
+		}
+		full, err := diffs.Apply(doc)
+		if err != nil {
+			return terror.ErrSyncerUnsafePartialJSONUpdate.Delegate(err, ti.Columns[i].Name.O)
+		}
+		changed[i] = full
+	}
+	return nil
+}
+
 func castUnsigned(data interface{}, ft *types.FieldType) interface{} {
 	if !mysql.HasUnsignedFlag(ft.GetFlag()) {
 		return data
@@ -342,18 +494,6 @@ func castUnsigned(data interface{}, ft *types.FieldType) interface{} {
 	return data
 }
 
-// checkLogColumns returns error when not all rows in skipped is empty, which means the binlog doesn't contain all
-// columns.
-// TODO: don't return error when all skipped columns is non-PK.
-func checkLogColumns(skipped [][]int) error {
-	for _, row := range skipped {
-		if len(row) > 0 {
-			return terror.ErrBinlogNotLogColumn
-		}
-	}
-	return nil
-}
-
 // genSQLMultipleRows generates multiple rows SQL with different dmlOpType.
 func genSQLMultipleRows(op sqlmodel.DMLType, dmls []*sqlmodel.RowChange) (queries string, args []interface{}) {
 	if len(dmls) > 1 {
@@ -17,6 +17,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/go-mysql-org/go-mysql/replication"
 	tiddl "github.com/pingcap/tidb/pkg/ddl"
 	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
@@ -27,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/mock"
 	cdcmodel "github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/pkg/sqlmodel"
 	"github.com/stretchr/testify/require"
 )
@@ -70,6 +72,44 @@ func TestCastUnsigned(t *testing.T) {
 	}
 }
 
+func TestPartialRowValues(t *testing.T) {
+	t.Parallel()
+
+	ti := mockTableInfo(t, "create table db.tb(id int primary key, a int, j json)")
+	values, err := AdjustValueFromBinlogData([]interface{}{int32(1), nil, &replication.JsonDiff{
+		Op:    replication.JsonDiffOperationInsert,
+		Path:  "$.a",
+		Value: "1",
+	}}, ti)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(1), nil, sqlmodel.JSONDiffs{{Op: sqlmodel.JSONDiffInsert, Path: "$.a", Value: "1"}}}, values)
+	require.True(t, isPartialRow(nil, values))
+	require.False(t, isPartialRow(nil, []interface{}{int64(1), nil, "{}"}))
+	require.NoError(t, checkPartialRowFilter(false, []int{1}, values))
+	require.True(t, terror.ErrBinlogNotLogColumn.Equal(checkPartialRowFilter(true, []int{1}, nil)))
+
+	// UPDATE tb SET a = 2 WHERE id = 1 with binlog_row_image=MINIMAL
+	pre := []interface{}{int64(1), nil, nil}
+	post := []interface{}{nil, int64(2), nil}
+	skipped := fillSkippedColumns(post, []int{0, 2}, pre, []int{1, 2})
+	require.Equal(t, []int{2}, skipped)
+	require.Equal(t, []interface{}{int64(1), int64(2), nil}, post)
+	require.Empty(t, fillSkippedColumns(post, nil, pre, []int{1, 2}))
+
+	// the partial JSON updates which are not idempotent use the full value in safe mode
+	arrayInsert := sqlmodel.JSONDiffs{{Op: sqlmodel.JSONDiffInsert, Path: "$.b[0]", Value: "0"}}
+	setMember := sqlmodel.JSONDiffs{{Op: sqlmodel.JSONDiffReplace, Path: "$.a", Value: "2"}}
+	post = []interface{}{int64(1), int64(2), arrayInsert}
+	require.NoError(t, fillUnsafeJSONDiffs(ti, post, []interface{}{int64(1), int64(1), []byte(`{"b": [1]}`)}, nil))
+	require.Equal(t, []interface{}{int64(1), int64(2), `{"b": [0, 1]}`}, post)
+	post = []interface{}{int64(1), int64(2), setMember}
+	require.NoError(t, fillUnsafeJSONDiffs(ti, post, []interface{}{int64(1), int64(1), nil}, []int{2}))
+	require.Equal(t, setMember, post[2])
+	// the before value is not logged
+	err = fillUnsafeJSONDiffs(ti, []interface{}{int64(1), nil, arrayInsert}, []interface{}{int64(1), nil, nil}, []int{1, 2})
+	require.True(t, terror.ErrSyncerUnsafePartialJSONUpdate.Equal(err))
+}
+
 func createTableInfo(p *parser.Parser, se sessionctx.Context, tableID int64, sql string) (*model.TableInfo, error) {
 	node, err := p.ParseOneStmt(sql, "utf8mb4", "utf8mb4_bin")
 	if err != nil {
@@ -344,7 +384,7 @@ func TestGBKExtractValueFromData(t *testing.T) {
 
 	row := []interface{}{1, "\xc4\xe3\xba\xc3"}
 	expect := []interface{}{1, []byte("\xc4\xe3\xba\xc3")}
-	got, err := adjustValueFromBinlogData(row, ti)
+	got, err := AdjustValueFromBinlogData(row, ti)
 	require.NoError(t, err)
 	require.Equal(t, expect, got)
 }
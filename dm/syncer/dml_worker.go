@@ -22,6 +22,7 @@ import (
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/throttle"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
@@ -37,6 +38,9 @@ type DMLWorker struct {
 	chanSize      int
 	multipleRows  bool
 	toDBConns     []*dbconn.DBConn
+	mqSink        *mqSink
+	throttle      *throttle.Limiter
+	conflicts     *conflictResolver
 	syncCtx       *tcontext.Context
 	logger        log.Logger
 	metricProxies *metrics.Proxies
@@ -48,7 +52,7 @@ type DMLWorker struct {
 
 	// callback func
 	// TODO: refine callback func
-	successFunc          func(int, int, []*job)
+	successFunc          func(int, int, []*job, time.Duration)
 	fatalFunc            func(*job, error)
 	lagFunc              func(*job, int)
 	updateJobMetricsFunc func(bool, string, *job)
@@ -81,6 +85,9 @@ func dmlWorkerWrap(inCh chan *job, syncer *Syncer) chan *job {
 		syncCtx:              syncer.syncCtx, // this ctx can be used to cancel all the workers
 		metricProxies:        syncer.metricsProxies,
 		toDBConns:            syncer.toDBConns,
+		mqSink:               syncer.mqSink,
+		throttle:             syncer.throttle,
+		conflicts:            syncer.conflictResolver,
 		inCh:                 inCh,
 		flushCh:              make(chan *job),
 	}
@@ -198,18 +205,24 @@ func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 
 // executeBatchJobs execute jobs with batch size.
 func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
+	if w.mqSink != nil {
+		w.writeBatchJobsToMQ(queueID, jobs)
+		return
+	}
+
 	var (
-		affect  int
-		queries []string
-		args    [][]interface{}
-		db      = w.toDBConns[queueID]
-		err     error
-		dmls    = make([]*sqlmodel.RowChange, 0, len(jobs))
+		affect      int
+		queries     []string
+		args        [][]interface{}
+		db          = w.toDBConns[queueID]
+		err         error
+		dmls        = make([]*sqlmodel.RowChange, 0, len(jobs))
+		execLatency time.Duration
 	)
 
 	defer func() {
 		if err == nil {
-			w.successFunc(queueID, len(dmls), jobs)
+			w.successFunc(queueID, len(dmls), jobs, execLatency)
 		} else {
 			if len(queries) == len(jobs) {
 				w.fatalFunc(jobs[affect], err)
@@ -251,12 +264,20 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 		t := v.(int)
 		time.Sleep(time.Duration(t) * time.Second)
 	})
+	if err = w.throttle.Wait(w.syncCtx.Ctx, len(jobs), sqlsSize(queries, args)); err != nil {
+		return
+	}
 	// use background context to execute sqls as much as possible
 	// set timeout to maxDMLConnectionDuration to make sure dmls can be replicated to downstream event if the latency is high
 	// if users need to quit this asap, we can support pause-task/stop-task --force in the future
 	ctx, cancel := w.syncCtx.WithTimeout(maxDMLConnectionDuration)
 	defer cancel()
-	affect, err = db.ExecuteSQL(ctx, w.metricProxies, queries, args...)
+	startTime := time.Now()
+	affect, err = w.executeSQLs(ctx, db, jobs, queries, args)
+	execLatency = time.Since(startTime)
+	if err == nil {
+		w.throttle.Observe(execLatency, len(jobs))
+	}
 	failpoint.Inject("SafeModeExit", func(val failpoint.Value) {
 		if intVal, ok := val.(int); ok && intVal == 4 && len(jobs) > 0 {
 			w.logger.Warn("fail to exec DML", zap.String("failpoint", "SafeModeExit"))
@@ -278,9 +299,106 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 	}
 }
 
-// genSQLs generate SQLs in single row mode or multiple rows mode.
+// writeBatchJobsToMQ writes jobs to the message queue and waits until all of them are acknowledged.
+func (w *DMLWorker) writeBatchJobsToMQ(queueID int, jobs []*job) {
+	if len(jobs) == 0 {
+		w.successFunc(queueID, 0, jobs, 0)
+		return
+	}
+
+	var writeLatency time.Duration
+	txns, size := w.mqSink.genTxns(jobs)
+	err := w.throttle.Wait(w.syncCtx.Ctx, len(jobs), size)
+	if err == nil {
+		startTime := time.Now()
+		err = w.mqSink.writeTxns(w.syncCtx.Ctx, txns)
+		writeLatency = time.Since(startTime)
+		if err == nil {
+			w.throttle.Observe(writeLatency, len(jobs))
+		}
+	}
+	if err != nil {
+		w.fatalFunc(&job{
+			startLocation:   jobs[0].startLocation,
+			currentLocation: jobs[len(jobs)-1].currentLocation,
+		}, err)
+		return
+	}
+	w.successFunc(queueID, len(txns), jobs, writeLatency)
+}
+
+// executeSQLs executes the SQLs of jobs. The jobs which will be discarded by conflict rules are
+// recorded in the conflict log table in the same transaction, so they are recorded only when the
+// jobs are applied, and a failed execution which will be retried records nothing. The return
+// values are the same as ExecuteSQL, where the log SQLs are not counted.
+func (w *DMLWorker) executeSQLs(ctx *tcontext.Context, db *dbconn.DBConn, jobs []*job, queries []string, args [][]interface{}) (int, error) {
+	if w.conflicts == nil {
+		return db.ExecuteSQL(ctx, w.metricProxies, queries, args...)
+	}
+	logQueries, logArgs, err := w.conflicts.genLogSQLs(jobs)
+	if err != nil {
+		return 0, err
+	}
+	if len(logQueries) == 0 {
+		return db.ExecuteSQL(ctx, w.metricProxies, queries, args...)
+	}
+
+	// the log SQLs must be executed before the DMLs to compare with the existing rows.
+	logCnt := len(logQueries)
+	rowsAffected := make([]int64, logCnt+len(queries))
+	affect, err := db.ExecuteSQLWithRowsAffected(ctx, w.metricProxies, rowsAffected, append(logQueries, queries...), append(logArgs, args...)...)
+	if err != nil {
+		// a failed log SQL is reported on the first DML.
+		if affect < logCnt {
+			return 0, err
+		}
+		return affect - logCnt, err
+	}
+	var discarded int64
+	for _, rows := range rowsAffected[:logCnt] {
+		discarded += rows
+	}
+	if discarded > 0 {
+		w.metricProxies.DiscardedConflictsTotal.WithLabelValues(w.task, w.source).Add(float64(discarded))
+	}
+	return affect - int(discarded), nil
+}
+
+// genSQLs generate SQLs in single row mode or multiple rows mode, the jobs matching
+// conflict rules are converted to conditional SQLs one by one.
 func (w *DMLWorker) genSQLs(jobs []*job) ([]string, [][]interface{}) {
-	if w.multipleRows {
+	if w.conflicts == nil {
+		return w.genNormalSQLs(jobs)
+	}
+
+	queries := make([]string, 0, len(jobs))
+	args := make([][]interface{}, 0, len(jobs))
+	appendNormalSQLs := func(jobs []*job) {
+		if len(jobs) > 0 {
+			query, arg := w.genNormalSQLs(jobs)
+			queries = append(queries, query...)
+			args = append(args, arg...)
+		}
+	}
+	start := 0
+	for i, j := range jobs {
+		rule := w.conflicts.match(j.dml)
+		if rule == nil {
+			continue
+		}
+		appendNormalSQLs(jobs[start:i])
+		query, arg := w.conflicts.genSQLs(rule, j.dml)
+		queries = append(queries, query...)
+		args = append(args, arg...)
+		start = i + 1
+	}
+	appendNormalSQLs(jobs[start:])
+	return queries, args
+}
+
+// genNormalSQLs generate SQLs in single row mode or multiple rows mode.
+func (w *DMLWorker) genNormalSQLs(jobs []*job) ([]string, [][]interface{}) {
+	if w.multipleRows && !hasPartialImageJob(jobs) {
 		return genDMLsWithSameOp(jobs)
 	}
 
@@ -296,6 +414,9 @@ func (w *DMLWorker) genSQLs(jobs []*job) ([]string, [][]interface{}) {
 
 		switch j.dml.Type() {
 		case sqlmodel.RowChangeInsert:
+			// the columns not logged by binlog_row_image=MINIMAL are omitted in both INSERT and REPLACE, so they
+			// take the default values of downstream, which differ from upstream for non-deterministic defaults
+			// such as CURRENT_TIMESTAMP.
 			if j.safeMode {
 				query, arg = j.dml.GenSQL(sqlmodel.DMLReplace)
 			} else {
@@ -303,7 +424,11 @@ func (w *DMLWorker) genSQLs(jobs []*job) ([]string, [][]interface{}) {
 			}
 
 		case sqlmodel.RowChangeUpdate:
-			if j.safeMode {
+			// the row can't be rebuilt by DELETE and REPLACE when some columns are absent, so UPDATE is used
+			// even in safe mode. It's idempotent because the non-idempotent partial JSON updates are replaced
+			// by full values in safe mode, but it can't recreate the row if it's missing in downstream, in
+			// which case the row change affects nothing.
+			if j.safeMode && !j.dml.HasPartialImage() {
 				query, arg = j.dml.GenSQL(sqlmodel.DMLDelete)
 				appendQueryAndArg()
 				query, arg = j.dml.GenSQL(sqlmodel.DMLReplace)
@@ -320,6 +445,35 @@ func (w *DMLWorker) genSQLs(jobs []*job) ([]string, [][]interface{}) {
 	return queries, args
 }
 
+// hasPartialImageJob returns true when any row change of the jobs has partial image.
+func hasPartialImageJob(jobs []*job) bool {
+	for _, j := range jobs {
+		if j.dml.HasPartialImage() {
+			return true
+		}
+	}
+	return false
+}
+
+// sqlsSize returns the approximate size of queries and args sent to the downstream.
+func sqlsSize(queries []string, args [][]interface{}) int {
+	size := 0
+	for i, query := range queries {
+		size += len(query)
+		for _, arg := range args[i] {
+			switch v := arg.(type) {
+			case string:
+				size += len(v)
+			case []byte:
+				size += len(v)
+			default:
+				size += 8
+			}
+		}
+	}
+	return size
+}
+
 func (w *DMLWorker) judgeKeyNotFound(affect int, jobs []*job) bool {
 	// TODO: support compact and multiple rows
 	// In compact mode, we need to calculate the expected affected rows based on the compacted job
@@ -328,7 +482,8 @@ func (w *DMLWorker) judgeKeyNotFound(affect int, jobs []*job) bool {
 		return false
 	}
 	for _, j := range jobs {
-		if j.safeMode {
+		// conditional SQLs of conflict rules may affect no rows
+		if j.safeMode || w.conflicts.match(j.dml) != nil {
 			return false
 		}
 	}
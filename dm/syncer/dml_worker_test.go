@@ -111,6 +111,25 @@ func TestGenSQL(t *testing.T) {
 	}
 }
 
+func TestGenSQLWithPartialImage(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	tableInfo := mockTableInfo(t, "create table db.tb(id int primary key, a int, j json)")
+
+	partial := sqlmodel.NewRowChange(source, nil, []interface{}{1, nil, nil}, []interface{}{1, 2, nil}, tableInfo, nil, nil)
+	partial.SetSkippedColumns([]int{1, 2}, []int{2})
+	insert := sqlmodel.NewRowChange(source, nil, nil, []interface{}{3, 4, "{}"}, tableInfo, nil, nil)
+
+	worker := &DMLWorker{multipleRows: true}
+	queries, args := worker.genSQLs([]*job{newDMLJob(partial, ecWithSafeMode), newDMLJob(insert, ecWithSafeMode)})
+	require.Equal(t, []string{
+		"UPDATE `db`.`tb` SET `id` = ?, `a` = ? WHERE `id` = ? LIMIT 1",
+		"REPLACE INTO `db`.`tb` (`id`,`a`,`j`) VALUES (?,?,?)",
+	}, queries)
+	require.Equal(t, [][]interface{}{{1, 2, 1}, {3, 4, "{}"}}, args)
+}
+
 func TestJudgeKeyNotFound(t *testing.T) {
 	dmlWorker := &DMLWorker{
 		compact:      true,
@@ -128,3 +147,10 @@ func TestJudgeKeyNotFound(t *testing.T) {
 	require.False(t, dmlWorker.judgeKeyNotFound(2, jobs))
 	require.False(t, dmlWorker.judgeKeyNotFound(4, jobs))
 }
+
+func TestSQLsSize(t *testing.T) {
+	queries := []string{"INSERT INTO `db`.`tb` (`id`,`name`) VALUES (?,?)", "DELETE FROM `db`.`tb` WHERE `id` = ? LIMIT 1"}
+	args := [][]interface{}{{1, "abc"}, {[]byte("xy")}}
+	require.Equal(t, len(queries[0])+8+3+len(queries[1])+2, sqlsSize(queries, args))
+	require.Equal(t, 0, sqlsSize(nil, nil))
+}
@@ -121,8 +121,8 @@ create table t (
 		require.Len(t, exprs, 1)
 		expr := exprs[0]
 
-		ca.skippedRow = util.Must(adjustValueFromBinlogData(ca.skippedRow, ti))
-		ca.passedRow = util.Must(adjustValueFromBinlogData(ca.passedRow, ti))
+		ca.skippedRow = util.Must(AdjustValueFromBinlogData(ca.skippedRow, ti))
+		ca.passedRow = util.Must(AdjustValueFromBinlogData(ca.passedRow, ti))
 
 		skip, err := SkipDMLByExpression(sessCtx, ca.skippedRow, expr, ti.Columns)
 		require.NoError(t, err)
@@ -385,8 +385,8 @@ create table t (
 		require.Len(t, exprs, 1)
 		expr := exprs[0]
 
-		ca.skippedRow = util.Must(adjustValueFromBinlogData(ca.skippedRow, ti))
-		ca.passedRow = util.Must(adjustValueFromBinlogData(ca.passedRow, ti))
+		ca.skippedRow = util.Must(AdjustValueFromBinlogData(ca.skippedRow, ti))
+		ca.passedRow = util.Must(AdjustValueFromBinlogData(ca.passedRow, ti))
 
 		skip, err := SkipDMLByExpression(sessCtx, ca.skippedRow, expr, ti.Columns)
 		require.NoError(t, err)
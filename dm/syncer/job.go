@@ -93,6 +93,7 @@ type job struct {
 	flushWg     *sync.WaitGroup // wait group for sync, async and conflict job
 	timestamp   uint32
 	timezone    string
+	commitTs    uint64 // commit ts of the row change or the first DDL when writing to a message queue
 }
 
 func (j *job) clone() *job {
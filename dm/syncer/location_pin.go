@@ -0,0 +1,96 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"sync"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// locationPin holds binlog events starting at or after the pinned location until
+// the pin is released, so the downstream stays at the pinned location meanwhile.
+// it's used by the snapshot checker to compare the downstream with a consistent
+// snapshot of the upstream taken at the pinned location.
+type locationPin struct {
+	mu       sync.Mutex
+	location *binlog.Location
+	// notifyCh is closed and replaced when the pin is released.
+	notifyCh chan struct{}
+}
+
+func newLocationPin() *locationPin {
+	return &locationPin{notifyCh: make(chan struct{})}
+}
+
+// pin pins the location, it replaces the location pinned before.
+func (p *locationPin) pin(location binlog.Location) {
+	location = location.Clone()
+	location.Position.Name = utils.ExtractRealName(location.Position.Name)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.location = &location
+}
+
+// release releases the pinned location and the held binlog event.
+func (p *locationPin) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.location = nil
+	close(p.notifyCh)
+	p.notifyCh = make(chan struct{})
+}
+
+// holds returns whether the binlog event starting at location should be held, and
+// a channel which is closed when the pin is released.
+func (p *locationPin) holds(location binlog.Location, enableGTID bool) (bool, <-chan struct{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.location == nil {
+		return false, p.notifyCh
+	}
+	location.Position.Name = utils.ExtractRealName(location.Position.Name)
+	return binlog.CompareLocation(location, *p.location, enableGTID) >= 0, p.notifyCh
+}
+
+// wait blocks until the binlog event starting at location can be processed or ctx is done.
+// onHold is called once before holding the event, which gives syncer a chance to
+// execute the jobs before the pinned location and flush checkpoint.
+func (p *locationPin) wait(tctx *tcontext.Context, location binlog.Location, enableGTID bool, onHold func() error) error {
+	held := false
+	for {
+		hold, notifyCh := p.holds(location, enableGTID)
+		if !hold {
+			if held {
+				tctx.L().Info("release binlog event held at pinned location", zap.Stringer("location", location))
+			}
+			return nil
+		}
+		if !held {
+			tctx.L().Info("hold binlog event at pinned location", zap.Stringer("location", location))
+			if err := onHold(); err != nil {
+				return err
+			}
+			held = true
+		}
+		select {
+		case <-tctx.Context().Done():
+			return tctx.Context().Err()
+		case <-notifyCh:
+		}
+	}
+}
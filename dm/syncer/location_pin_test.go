@@ -0,0 +1,71 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationPin(t *testing.T) {
+	p := newLocationPin()
+	loc := func(name string, pos uint32) binlog.Location {
+		return binlog.NewLocation(mysql.Position{Name: name, Pos: pos}, nil)
+	}
+	hold, _ := p.holds(loc("mysql-bin.000001", 100), false)
+	require.False(t, hold)
+
+	p.pin(loc("mysql-bin.000001", 100))
+	hold, _ = p.holds(loc("mysql-bin.000001", 99), false)
+	require.False(t, hold)
+	hold, _ = p.holds(loc("mysql-bin.000001", 100), false)
+	require.True(t, hold)
+	// the suffix of relay log is ignored
+	hold, _ = p.holds(loc("mysql-bin|000001.000002", 4), false)
+	require.True(t, hold)
+
+	// the event is held and syncer flushes once until released
+	flushed := 0
+	onHold := func() error {
+		flushed++
+		return nil
+	}
+	tctx := tcontext.Background()
+	require.NoError(t, p.wait(tctx, loc("mysql-bin.000001", 50), false, onHold))
+	require.Equal(t, 0, flushed)
+	done := make(chan error)
+	go func() {
+		done <- p.wait(tctx, loc("mysql-bin.000001", 200), false, onHold)
+	}()
+	select {
+	case <-done:
+		t.Fatal("event should be held")
+	case <-time.After(100 * time.Millisecond):
+	}
+	p.release()
+	require.NoError(t, <-done)
+	require.Equal(t, 1, flushed)
+
+	p.pin(loc("mysql-bin.000001", 100))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := p.wait(tctx.WithContext(ctx), loc("mysql-bin.000001", 200), false, onHold)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -84,6 +84,13 @@ type Proxies struct {
 	finishedTransactionTotal        *prometheus.CounterVec
 	ReplicationTransactionBatch     *prometheus.HistogramVec
 	flushCheckPointsTimeInterval    *prometheus.HistogramVec
+	DiscardedConflictsTotal         *prometheus.CounterVec
+	SchemaDriftGauge                *prometheus.GaugeVec
+	// per-table metrics, the number of tables is limited by `table-metrics-limit`.
+	TableRowsTotal            *prometheus.CounterVec
+	TableBytesTotal           *prometheus.CounterVec
+	TableExecLatencyHistogram *prometheus.HistogramVec
+	TableLastAppliedTSGauge   *prometheus.GaugeVec
 }
 
 var DefaultMetricsProxies *Proxies
@@ -291,6 +298,49 @@ func (m *Proxies) Init(f promutil.Factory) {
 			Help:      "checkpoint flushed time interval in seconds",
 			Buckets:   prometheus.LinearBuckets(1, 50, 21), // linear from 1 to 1001, i think this is enough
 		}, []string{"worker", "task", "source_id"})
+	m.DiscardedConflictsTotal = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "discarded_conflicts_total",
+			Help:      "total number of changes discarded by conflict rules because the existing rows from other sources win",
+		}, []string{"task", "source_id"})
+	m.SchemaDriftGauge = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "schema_drift_number",
+			Help:      "number of differences between the tracked table structures and downstream tables",
+		}, []string{"task", "source_id", "type"})
+	m.TableRowsTotal = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "table_rows_total",
+			Help:      "total number of row changes replicated to the downstream table",
+		}, []string{"task", "source_id", "target_schema", "target_table", "type"})
+	m.TableBytesTotal = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "table_bytes_total",
+			Help:      "approximate size of row changes replicated to the downstream table",
+		}, []string{"task", "source_id", "target_schema", "target_table"})
+	m.TableExecLatencyHistogram = f.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "table_exec_latency",
+			Help:      "Bucketed histogram of the time (s) to execute the row changes in the downstream table, excluding the time waiting in the job queues",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 18),
+		}, []string{"task", "source_id", "target_schema", "target_table"})
+	m.TableLastAppliedTSGauge = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "table_last_applied_binlog_ts",
+			Help:      "binlog event timestamp of the last row change applied to the downstream table",
+		}, []string{"task", "source_id", "target_schema", "target_table"})
 }
 
 // CacheForOneTask returns a new Proxies with m.Metrics filled. It is used
@@ -348,6 +398,12 @@ func (m *Proxies) RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(m.finishedTransactionTotal)
 	registry.MustRegister(m.ReplicationTransactionBatch)
 	registry.MustRegister(m.flushCheckPointsTimeInterval)
+	registry.MustRegister(m.DiscardedConflictsTotal)
+	registry.MustRegister(m.SchemaDriftGauge)
+	registry.MustRegister(m.TableRowsTotal)
+	registry.MustRegister(m.TableBytesTotal)
+	registry.MustRegister(m.TableExecLatencyHistogram)
+	registry.MustRegister(m.TableLastAppliedTSGauge)
 }
 
 // RemoveLabelValuesWithTaskInMetrics cleans all Metrics related to the task.
@@ -378,4 +434,10 @@ func (m *Proxies) RemoveLabelValuesWithTaskInMetrics(task string) {
 	m.finishedTransactionTotal.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.ReplicationTransactionBatch.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.flushCheckPointsTimeInterval.DeletePartialMatch(prometheus.Labels{"task": task})
+	m.DiscardedConflictsTotal.DeletePartialMatch(prometheus.Labels{"task": task})
+	m.SchemaDriftGauge.DeletePartialMatch(prometheus.Labels{"task": task})
+	m.TableRowsTotal.DeletePartialMatch(prometheus.Labels{"task": task})
+	m.TableBytesTotal.DeletePartialMatch(prometheus.Labels{"task": task})
+	m.TableExecLatencyHistogram.DeletePartialMatch(prometheus.Labels{"task": task})
+	m.TableLastAppliedTSGauge.DeletePartialMatch(prometheus.Labels{"task": task})
 }
@@ -0,0 +1,557 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	timodel "github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	ddlmq "github.com/pingcap/tiflow/cdc/sink/ddlsink/mq"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq/ddlproducer"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	dmlmq "github.com/pingcap/tiflow/cdc/sink/dmlsink/mq"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	cdcconfig "github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// mqSink writes the row changes and DDLs of the sync unit to Kafka through the TiCDC MQ sinks, the
+// events are encoded by the codec of the protocol in the sink URI. The events after the last checkpoint
+// are written again after the subtask is resumed, so the consumers should tolerate duplicated events. After a
+// checkpoint is flushed, the commit ts of the last event before it is written as the checkpoint ts (resolved ts).
+type mqSink struct {
+	changefeedID  cdcmodel.ChangeFeedID
+	sinkURI       *url.URL
+	replicaConfig *cdcconfig.ReplicaConfig
+	logger        log.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	factoryCreator     kafka.FactoryCreator
+	ddlProducerCreator ddlproducer.Factory
+
+	// the sinks are created lazily and recreated after they fail, so the subtask can be resumed.
+	mu      sync.Mutex
+	dmlSink *mqDMLSink
+	ddlSink *ddlmq.DDLSink
+
+	// source table -> table info of the target table
+	tableInfos sync.Map
+
+	// the commit ts of the last event
+	tsMu     sync.Mutex
+	commitTs uint64
+}
+
+// mqDMLSink is a DML sink and the first error it meets.
+type mqDMLSink struct {
+	sink   dmlsink.EventSink[*cdcmodel.SingleTableTxn]
+	cancel context.CancelFunc
+	failed chan struct{}
+	err    error // set before failed is closed
+}
+
+type mqTableInfo struct {
+	source *timodel.TableInfo
+	target *cdcmodel.TableInfo
+}
+
+func newMQSink(cfg *config.SubTaskConfig, logger log.Logger) (*mqSink, error) {
+	sinkURI, replicaConfig, err := cfg.TargetMQ.ReplicaConfig()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &mqSink{
+		changefeedID:  cdcmodel.ChangeFeedID{Namespace: "dm", ID: cfg.Name + "_" + cfg.SourceID},
+		sinkURI:       sinkURI,
+		replicaConfig: replicaConfig,
+		logger:        logger.WithFields(zap.String("component", "mq sink")),
+		ctx:           ctx,
+		cancel:        cancel,
+
+		factoryCreator:     kafka.NewSaramaFactory,
+		ddlProducerCreator: ddlproducer.NewKafkaDDLProducer,
+	}, nil
+}
+
+func (m *mqSink) getDMLSink() (*mqDMLSink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.dmlSink != nil {
+		select {
+		case <-m.dmlSink.failed:
+			m.dmlSink.close()
+			m.dmlSink = nil
+		default:
+			return m.dmlSink, nil
+		}
+	}
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	errCh := make(chan error, 16)
+	sink, err := dmlmq.NewKafkaDMLSink(ctx, m.changefeedID, m.sinkURI, m.replicaConfig, errCh,
+		m.factoryCreator, dmlproducer.NewKafkaDMLProducer)
+	if err != nil {
+		cancel()
+		return nil, terror.ErrSyncerWriteMQ.Delegate(err)
+	}
+	d := &mqDMLSink{sink: sink, cancel: cancel, failed: make(chan struct{})}
+	go func() {
+		select {
+		case err := <-errCh:
+			d.err = terror.ErrSyncerWriteMQ.Delegate(err)
+		case <-sink.Dead():
+			d.err = terror.ErrSyncerWriteMQ.Generate()
+		case <-ctx.Done():
+			d.err = terror.ErrSyncerWriteMQ.Delegate(ctx.Err())
+		}
+		close(d.failed)
+	}()
+	m.dmlSink = d
+	m.logger.Info("kafka DML sink created", zap.String("sink uri", m.sinkURI.Redacted()))
+	return d, nil
+}
+
+func (d *mqDMLSink) close() {
+	d.sink.Close()
+	d.cancel()
+}
+
+func (m *mqSink) getDDLSink() (*ddlmq.DDLSink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.ddlSink != nil {
+		return m.ddlSink, nil
+	}
+	sink, err := ddlmq.NewKafkaDDLSink(m.ctx, m.changefeedID, m.sinkURI, m.replicaConfig,
+		m.factoryCreator, m.ddlProducerCreator)
+	if err != nil {
+		return nil, terror.ErrSyncerWriteMQ.Delegate(err)
+	}
+	m.ddlSink = sink
+	m.logger.Info("kafka DDL sink created", zap.String("sink uri", m.sinkURI.Redacted()))
+	return sink, nil
+}
+
+// closeDDLSink closes the DDL sink after it fails, it will be recreated when writing the next DDL.
+func (m *mqSink) closeDDLSink() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ddlSink != nil {
+		m.ddlSink.Close()
+		m.ddlSink = nil
+	}
+}
+
+func (m *mqSink) close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.dmlSink != nil {
+		m.dmlSink.close()
+		m.dmlSink = nil
+	}
+	if m.ddlSink != nil {
+		m.ddlSink.Close()
+		m.ddlSink = nil
+	}
+	m.cancel()
+}
+
+// genTxns converts the DML jobs to transactions of TiCDC, successive jobs of the same target table are
+// grouped to one transaction. It also returns the approximate size of the row changes.
+func (m *mqSink) genTxns(jobs []*job) ([]*cdcmodel.SingleTableTxn, int) {
+	var (
+		txns []*cdcmodel.SingleTableTxn
+		txn  *cdcmodel.SingleTableTxn
+		size int
+	)
+	for _, j := range jobs {
+		row := m.genRowChangedEvent(j)
+		size += int(row.ApproximateDataSize)
+		if txn == nil || txn.TableInfo != row.TableInfo {
+			txn = &cdcmodel.SingleTableTxn{
+				PhysicalTableID: row.PhysicalTableID,
+				TableInfo:       row.TableInfo,
+				StartTs:         row.StartTs,
+			}
+			txns = append(txns, txn)
+		}
+		txn.CommitTs = row.CommitTs
+		txn.Rows = append(txn.Rows, row)
+	}
+	return txns, size
+}
+
+// writeTxns writes the transactions and waits until all of them are acknowledged by Kafka, so the checkpoint
+// never goes beyond the row changes which are not acknowledged.
+func (m *mqSink) writeTxns(ctx context.Context, txns []*cdcmodel.SingleTableTxn) error {
+	if len(txns) == 0 {
+		return nil
+	}
+	sink, err := m.getDMLSink()
+	if err != nil {
+		return err
+	}
+
+	var (
+		pending   = atomic.NewInt64(int64(len(txns)))
+		done      = make(chan struct{})
+		sinkState = state.TableSinkSinking
+		events    = make([]*dmlsink.TxnCallbackableEvent, 0, len(txns))
+	)
+	for _, txn := range txns {
+		events = append(events, &dmlsink.TxnCallbackableEvent{
+			Event: txn,
+			Callback: func() {
+				if pending.Dec() == 0 {
+					close(done)
+				}
+			},
+			SinkState: &sinkState,
+		})
+	}
+	if err = sink.sink.WriteEvents(events...); err != nil {
+		return terror.ErrSyncerWriteMQ.Delegate(err)
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-sink.failed:
+		return sink.err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-m.ctx.Done():
+		return terror.ErrSyncerWriteMQ.Delegate(m.ctx.Err())
+	}
+}
+
+// writeDDLs writes the DDLs of the job one by one, getTableInfo is used to get the table info of the source
+// table after the DDL is tracked.
+func (m *mqSink) writeDDLs(ctx context.Context, j *job, getTableInfo func(*filter.Table) (*timodel.TableInfo, error)) error {
+	events, err := m.genDDLEvents(j, getTableInfo)
+	if err != nil {
+		return err
+	}
+	sink, err := m.getDDLSink()
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		if err = sink.WriteDDLEvent(ctx, event); err != nil {
+			m.closeDDLSink()
+			return terror.ErrSyncerWriteMQ.Delegate(err)
+		}
+		m.logger.Info("write DDL to kafka", zap.String("DDL", event.Query), zap.Stringer("type", event.Type))
+	}
+	return nil
+}
+
+func (m *mqSink) genRowChangedEvent(j *job) *cdcmodel.RowChangedEvent {
+	var (
+		dml       = j.dml
+		tableInfo = m.getTableInfo(dml.GetSourceTable(), dml.GetTargetTable(), dml.SourceTableInfo())
+		ts        = j.commitTs
+		size      int
+	)
+	row := &cdcmodel.RowChangedEvent{
+		StartTs:         ts,
+		CommitTs:        ts,
+		PhysicalTableID: tableInfo.ID,
+		TableInfo:       tableInfo,
+	}
+	row.PreColumns, size = genMQColumns(dml.GetPreValues(), tableInfo)
+	row.ApproximateDataSize += int64(size)
+	row.Columns, size = genMQColumns(dml.GetPostValues(), tableInfo)
+	row.ApproximateDataSize += int64(size)
+	return row
+}
+
+// getTableInfo returns the TiCDC table info of the target table, which is the same as the table info of the
+// source table except the names.
+func (m *mqSink) getTableInfo(source, target *cdcmodel.TableName, ti *timodel.TableInfo) *cdcmodel.TableInfo {
+	key := source.QuoteString()
+	if v, ok := m.tableInfos.Load(key); ok {
+		if info := v.(*mqTableInfo); info.source == ti && info.target.TableName.Schema == target.Schema &&
+			info.target.TableName.Table == target.Table {
+			return info.target
+		}
+	}
+	info := &mqTableInfo{source: ti, target: wrapMQTableInfo(target.Schema, target.Table, ti)}
+	m.tableInfos.Store(key, info)
+	return info.target
+}
+
+func wrapMQTableInfo(schema, table string, ti *timodel.TableInfo) *cdcmodel.TableInfo {
+	cloned := ti.Clone()
+	cloned.Name = timodel.NewCIStr(table)
+	return cdcmodel.WrapTableInfo(0, schema, 0, cloned)
+}
+
+// genMQColumns converts the values of the row to the columns of TiCDC, values of virtual generated columns are
+// skipped. It also returns the approximate size of the values.
+func genMQColumns(values []interface{}, tableInfo *cdcmodel.TableInfo) ([]*cdcmodel.ColumnData, int) {
+	if values == nil {
+		return nil, 0
+	}
+	var (
+		columns = make([]*cdcmodel.ColumnData, 0, len(values))
+		size    int
+		i       int
+	)
+	for _, col := range tableInfo.Columns {
+		if col.Hidden {
+			continue
+		}
+		if i >= len(values) {
+			break
+		}
+		value := values[i]
+		i++
+		if !cdcmodel.IsColCDCVisible(col) {
+			continue
+		}
+		value = formatMQValue(value, col)
+		columns = append(columns, &cdcmodel.ColumnData{ColumnID: col.ID, Value: value})
+		size += mqValueSize(value)
+	}
+	return columns, size
+}
+
+// formatMQValue converts the value from binlog to the same type of the value which TiCDC reads from TiKV,
+// because the encoders depend on the type.
+func formatMQValue(value interface{}, col *timodel.ColumnInfo) interface{} {
+	if value == nil {
+		return nil
+	}
+	switch col.GetType() {
+	case mysql.TypeEnum, mysql.TypeSet, mysql.TypeBit:
+		switch v := value.(type) {
+		case int64:
+			return uint64(v)
+		case int:
+			return uint64(v)
+		}
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if v, ok := value.(string); ok {
+			return []byte(v)
+		}
+	case mysql.TypeFloat:
+		if v, ok := value.(float64); ok {
+			return float32(v)
+		}
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeNewDate, mysql.TypeTimestamp,
+		mysql.TypeDuration, mysql.TypeJSON, mysql.TypeNewDecimal:
+		if v, ok := value.([]byte); ok {
+			return string(v)
+		}
+	default:
+		// YEAR is int in binlog
+		if v, ok := value.(int); ok {
+			return int64(v)
+		}
+	}
+	return value
+}
+
+func mqValueSize(value interface{}) int {
+	switch v := value.(type) {
+	case string:
+		return len(v)
+	case []byte:
+		return len(v)
+	case nil:
+		return 0
+	default:
+		return 8
+	}
+}
+
+// nextCommitTs reserves n (at least one) strictly increasing TSO-like commit ts for the events of the binlog event
+// and returns the first one. The physical part is the timestamp of the binlog event, and the logical part is the
+// sequence of the events in the same second.
+func (m *mqSink) nextCommitTs(header *replication.EventHeader, n int) uint64 {
+	var ts uint64
+	if header != nil {
+		ts = oracle.ComposeTS(int64(header.Timestamp)*1000, 0)
+	}
+	if n < 1 {
+		n = 1
+	}
+
+	m.tsMu.Lock()
+	defer m.tsMu.Unlock()
+	if ts <= m.commitTs {
+		ts = m.commitTs + 1
+	}
+	m.commitTs = ts + uint64(n) - 1
+	return ts
+}
+
+// lastCommitTs returns the commit ts of the last event.
+func (m *mqSink) lastCommitTs() uint64 {
+	m.tsMu.Lock()
+	defer m.tsMu.Unlock()
+	return m.commitTs
+}
+
+// writeCheckpointTs writes the checkpoint ts to the topics of all written tables, so the consumers know all events
+// with smaller or equal commit ts have been written.
+func (m *mqSink) writeCheckpointTs(ctx context.Context, ts uint64) error {
+	var tables []*cdcmodel.TableInfo
+	m.tableInfos.Range(func(_, v interface{}) bool {
+		tables = append(tables, v.(*mqTableInfo).target)
+		return true
+	})
+	sink, err := m.getDDLSink()
+	if err != nil {
+		return err
+	}
+	if err = sink.WriteCheckpointTs(ctx, ts, tables); err != nil {
+		m.closeDDLSink()
+		return terror.ErrSyncerWriteMQ.Delegate(err)
+	}
+	return nil
+}
+
+// genDDLEvents converts the DDLs of the job to DDL events of TiCDC.
+func (m *mqSink) genDDLEvents(j *job, getTableInfo func(*filter.Table) (*timodel.TableInfo, error)) ([]*cdcmodel.DDLEvent, error) {
+	// the table info is only available when the job comes from a single source table.
+	var sourceTable *filter.Table
+	if len(j.sourceTbls) == 1 {
+		for _, tables := range j.sourceTbls {
+			if len(tables) == 1 {
+				sourceTable = tables[0]
+			}
+		}
+	}
+
+	p := parser.New()
+	events := make([]*cdcmodel.DDLEvent, 0, len(j.ddls))
+	for i, ddl := range j.ddls {
+		stmt, err := p.ParseOneStmt(ddl, "", "")
+		if err != nil {
+			return nil, terror.ErrSyncerParseDDL.Delegate(err, ddl)
+		}
+		tables, err := parserpkg.FetchDDLTables("", stmt, conn.LCTableNamesSensitive)
+		if err != nil {
+			return nil, err
+		}
+
+		ts := j.commitTs + uint64(i)
+		event := &cdcmodel.DDLEvent{
+			StartTs:  ts,
+			CommitTs: ts,
+			Query:    ddl,
+			Type:     ddlActionType(stmt),
+		}
+		target := tables[len(tables)-1]
+		event.TableInfo = &cdcmodel.TableInfo{TableName: cdcmodel.TableName{Schema: target.Schema, Table: target.Name}}
+		if len(tables) > 1 {
+			// RENAME TABLE
+			event.PreTableInfo = &cdcmodel.TableInfo{TableName: cdcmodel.TableName{Schema: tables[0].Schema, Table: tables[0].Name}}
+		}
+		if sourceTable != nil && target.Name != "" {
+			// the table info is not found when the table is dropped.
+			if ti, err2 := getTableInfo(sourceTable); err2 == nil {
+				event.TableInfo = wrapMQTableInfo(target.Schema, target.Name, ti)
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// ddlActionType returns the action type of the DDL, the DDLs have been split to one change per statement.
+func ddlActionType(stmt ast.StmtNode) timodel.ActionType {
+	switch v := stmt.(type) {
+	case *ast.CreateDatabaseStmt:
+		return timodel.ActionCreateSchema
+	case *ast.DropDatabaseStmt:
+		return timodel.ActionDropSchema
+	case *ast.AlterDatabaseStmt:
+		return timodel.ActionModifySchemaCharsetAndCollate
+	case *ast.CreateTableStmt:
+		return timodel.ActionCreateTable
+	case *ast.DropTableStmt:
+		if v.IsView {
+			return timodel.ActionDropView
+		}
+		return timodel.ActionDropTable
+	case *ast.CreateViewStmt:
+		return timodel.ActionCreateView
+	case *ast.TruncateTableStmt:
+		return timodel.ActionTruncateTable
+	case *ast.RenameTableStmt:
+		if len(v.TableToTables) > 1 {
+			return timodel.ActionRenameTables
+		}
+		return timodel.ActionRenameTable
+	case *ast.CreateIndexStmt:
+		return timodel.ActionAddIndex
+	case *ast.DropIndexStmt:
+		return timodel.ActionDropIndex
+	case *ast.AlterTableStmt:
+		if len(v.Specs) == 0 {
+			return timodel.ActionNone
+		}
+		spec := v.Specs[0]
+		switch spec.Tp {
+		case ast.AlterTableAddColumns:
+			return timodel.ActionAddColumn
+		case ast.AlterTableDropColumn:
+			return timodel.ActionDropColumn
+		case ast.AlterTableModifyColumn, ast.AlterTableChangeColumn, ast.AlterTableRenameColumn:
+			return timodel.ActionModifyColumn
+		case ast.AlterTableAlterColumn:
+			return timodel.ActionSetDefaultValue
+		case ast.AlterTableAddConstraint:
+			if spec.Constraint != nil && spec.Constraint.Tp == ast.ConstraintPrimaryKey {
+				return timodel.ActionAddPrimaryKey
+			}
+			return timodel.ActionAddIndex
+		case ast.AlterTableDropPrimaryKey:
+			return timodel.ActionDropPrimaryKey
+		case ast.AlterTableDropIndex:
+			return timodel.ActionDropIndex
+		case ast.AlterTableRenameIndex:
+			return timodel.ActionRenameIndex
+		case ast.AlterTableRenameTable:
+			return timodel.ActionRenameTable
+		}
+	}
+	return timodel.ActionNone
+}
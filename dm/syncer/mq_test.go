@@ -0,0 +1,261 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	timodel "github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq/ddlproducer"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func newTestMQSink(t *testing.T) *mqSink {
+	t.Helper()
+
+	cfg := &config.SubTaskConfig{
+		Name:     "test",
+		SourceID: "source1",
+		TargetMQ: &config.MQConfig{SinkURI: "kafka://127.0.0.1:9092/dm-topic?protocol=canal-json"},
+	}
+	m, err := newMQSink(cfg, log.L())
+	require.NoError(t, err)
+	t.Cleanup(m.close)
+	return m
+}
+
+func TestMQGenTxns(t *testing.T) {
+	t.Parallel()
+
+	var (
+		m      = newTestMQSink(t)
+		source = &cdcmodel.TableName{Schema: "db", Table: "tb"}
+		target = &cdcmodel.TableName{Schema: "db_target", Table: "tb_target"}
+		ti     = mockTableInfo(t, "create table db.tb(id int primary key, name varchar(24), price decimal(10,2), "+
+			"e enum('a','b'), f float, v int as (id + 1) virtual, y year)")
+		ti2    = mockTableInfo(t, "create table db.tb2(id bigint unsigned primary key)")
+		header = &replication.EventHeader{Timestamp: 1700000000}
+	)
+
+	newJob := func(row *sqlmodel.RowChange) *job {
+		return &job{tp: dml, dml: row, eventHeader: header, commitTs: m.nextCommitTs(header, 0)}
+	}
+	row1 := []interface{}{int64(1), "a", "1.50", int64(2), float64(1.5), int64(2), 2024}
+	row2 := []interface{}{int64(1), "b", nil, int64(1), float64(2), int64(2), 2024}
+	jobs := []*job{
+		newJob(sqlmodel.NewRowChange(source, target, nil, row1, ti, nil, nil)),
+		newJob(sqlmodel.NewRowChange(source, target, row1, row2, ti, nil, nil)),
+		newJob(sqlmodel.NewRowChange(&cdcmodel.TableName{Schema: "db", Table: "tb2"}, nil, []interface{}{uint64(3)}, nil, ti2, nil, nil)),
+		newJob(sqlmodel.NewRowChange(source, target, row2, nil, ti, nil, nil)),
+	}
+	txns, size := m.genTxns(jobs)
+	require.Len(t, txns, 3)
+	require.Greater(t, size, 0)
+	require.Len(t, txns[0].Rows, 2)
+	require.Len(t, txns[1].Rows, 1)
+	require.Len(t, txns[2].Rows, 1)
+	// the table info is reused for the same source table
+	require.Same(t, txns[0].TableInfo, txns[2].TableInfo)
+
+	tableInfo := txns[0].TableInfo
+	require.Equal(t, "db_target", tableInfo.GetSchemaName())
+	require.Equal(t, "tb_target", tableInfo.GetTableName())
+	require.Equal(t, "tb", ti.Name.O)
+	require.Equal(t, "db", txns[1].TableInfo.GetSchemaName())
+	require.Equal(t, "tb2", txns[1].TableInfo.GetTableName())
+
+	insert := txns[0].Rows[0]
+	require.True(t, insert.IsInsert())
+	require.Equal(t, oracle.ComposeTS(1700000000000, 0), insert.CommitTs)
+	require.Equal(t, insert.CommitTs+1, txns[0].Rows[1].CommitTs)
+	require.Equal(t, insert.CommitTs+1, txns[0].CommitTs)
+	columns := insert.GetColumns()
+	// the virtual generated column is skipped
+	require.Len(t, columns, 6)
+	names := make([]string, 0, len(columns))
+	values := make([]interface{}, 0, len(columns))
+	for _, col := range columns {
+		names = append(names, col.Name)
+		values = append(values, col.Value)
+	}
+	require.Equal(t, []string{"id", "name", "price", "e", "f", "y"}, names)
+	require.Equal(t, []interface{}{int64(1), []byte("a"), "1.50", uint64(2), float32(1.5), int64(2024)}, values)
+	require.True(t, columns[0].Flag.IsPrimaryKey())
+
+	update := txns[0].Rows[1]
+	require.True(t, update.IsUpdate())
+	require.Equal(t, []byte("a"), update.GetPreColumns()[1].Value)
+	require.Equal(t, []byte("b"), update.GetColumns()[1].Value)
+	require.Nil(t, update.GetColumns()[2].Value)
+
+	require.True(t, txns[1].Rows[0].IsDelete())
+	require.Equal(t, uint64(3), txns[1].Rows[0].GetPreColumns()[0].Value)
+	require.True(t, txns[2].Rows[0].IsDelete())
+
+	// the table info is rebuilt after the table is changed
+	ti3 := mockTableInfo(t, "create table db.tb(id int primary key)")
+	txns, _ = m.genTxns([]*job{newJob(sqlmodel.NewRowChange(source, target, nil, []interface{}{int64(1)}, ti3, nil, nil))})
+	require.NotSame(t, tableInfo, txns[0].TableInfo)
+	require.Len(t, txns[0].TableInfo.Columns, 1)
+}
+
+func TestMQGenDDLEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		m           = newTestMQSink(t)
+		sourceTable = &filter.Table{Schema: "db", Name: "tb"}
+		ti          = mockTableInfo(t, "create table db.tb(id int primary key, c int)")
+	)
+	getTableInfo := func(table *filter.Table) (*timodel.TableInfo, error) {
+		if *table == *sourceTable {
+			return ti, nil
+		}
+		return nil, errors.New("table not found")
+	}
+
+	j := &job{
+		tp:          ddl,
+		sourceTbls:  map[string][]*filter.Table{"db": {sourceTable}},
+		eventHeader: &replication.EventHeader{Timestamp: 1700000000},
+		ddls: []string{
+			"CREATE DATABASE IF NOT EXISTS `db_target`",
+			"ALTER TABLE `db_target`.`tb_target` ADD COLUMN `c` INT",
+			"RENAME TABLE `db_target`.`tb_old` TO `db_target`.`tb_target`",
+			"ALTER TABLE `db_target`.`tb_target` ADD PRIMARY KEY(`id`)",
+		},
+	}
+	j.commitTs = m.nextCommitTs(j.eventHeader, len(j.ddls))
+	events, err := m.genDDLEvents(j, getTableInfo)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, events[3].CommitTs, m.lastCommitTs())
+
+	require.Equal(t, timodel.ActionCreateSchema, events[0].Type)
+	require.Equal(t, "db_target", events[0].TableInfo.TableName.Schema)
+	require.Equal(t, "", events[0].TableInfo.TableName.Table)
+	require.Nil(t, events[0].TableInfo.TableInfo)
+
+	require.Equal(t, timodel.ActionAddColumn, events[1].Type)
+	require.Equal(t, j.ddls[1], events[1].Query)
+	require.Equal(t, oracle.ComposeTS(1700000000000, 1), events[1].CommitTs)
+	require.Equal(t, "tb_target", events[1].TableInfo.GetTableName())
+	require.Len(t, events[1].TableInfo.Columns, 2)
+	require.Nil(t, events[1].PreTableInfo)
+
+	require.Equal(t, timodel.ActionRenameTable, events[2].Type)
+	require.Equal(t, "tb_old", events[2].PreTableInfo.TableName.Table)
+	require.Equal(t, "tb_target", events[2].TableInfo.TableName.Table)
+
+	require.Equal(t, timodel.ActionAddPrimaryKey, events[3].Type)
+
+	// the table info is not available for DDLs from multiple source tables
+	j.sourceTbls["db"] = append(j.sourceTbls["db"], &filter.Table{Schema: "db", Name: "tb2"})
+	j.ddls = []string{"DROP TABLE `db_target`.`tb_target`"}
+	events, err = m.genDDLEvents(j, getTableInfo)
+	require.NoError(t, err)
+	require.Equal(t, timodel.ActionDropTable, events[0].Type)
+	require.Nil(t, events[0].TableInfo.TableInfo)
+
+	j.ddls = []string{"ALTER TABLE"}
+	_, err = m.genDDLEvents(j, getTableInfo)
+	require.Error(t, err)
+}
+
+func TestMQCommitTs(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMQSink(t)
+	require.Equal(t, uint64(0), m.lastCommitTs())
+
+	// the events in the same second are ordered by the sequence
+	header := &replication.EventHeader{Timestamp: 1700000000}
+	require.Equal(t, oracle.ComposeTS(1700000000000, 0), m.nextCommitTs(header, 1))
+	require.Equal(t, oracle.ComposeTS(1700000000000, 1), m.nextCommitTs(header, 3))
+	require.Equal(t, oracle.ComposeTS(1700000000000, 4), m.nextCommitTs(header, 0))
+	require.Equal(t, oracle.ComposeTS(1700000000000, 4), m.lastCommitTs())
+
+	// the commit ts is still increasing when the timestamp of the binlog event goes back
+	require.Equal(t, oracle.ComposeTS(1700000001000, 0), m.nextCommitTs(&replication.EventHeader{Timestamp: 1700000001}, 1))
+	require.Equal(t, oracle.ComposeTS(1700000001000, 1), m.nextCommitTs(header, 1))
+	require.Equal(t, oracle.ComposeTS(1700000001000, 2), m.nextCommitTs(nil, 1))
+}
+
+func TestMQWriteCheckpointTs(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SubTaskConfig{
+		Name:     "test",
+		SourceID: "source1",
+		TargetMQ: &config.MQConfig{SinkURI: "kafka://127.0.0.1:9092/" + kafka.DefaultMockTopicName +
+			"?protocol=open-protocol&partition-num=2&auto-create-topic=false"},
+	}
+	m, err := newMQSink(cfg, log.L())
+	require.NoError(t, err)
+	defer m.close()
+	m.ctx = context.WithValue(m.ctx, "testing.T", t)
+	m.factoryCreator = kafka.NewMockFactory
+	var producer *ddlproducer.MockDDLProducer
+	m.ddlProducerCreator = func(ctx context.Context, changefeedID cdcmodel.ChangeFeedID, syncProducer kafka.SyncProducer) ddlproducer.DDLProducer {
+		producer = ddlproducer.NewMockDDLProducer(ctx, changefeedID, syncProducer).(*ddlproducer.MockDDLProducer)
+		return producer
+	}
+
+	// the checkpoint is broadcast to all partitions of the topic
+	ts := oracle.ComposeTS(1700000000000, 1)
+	require.NoError(t, m.writeCheckpointTs(context.Background(), ts))
+	for i := int32(0); i < 2; i++ {
+		events := producer.GetEvents(kafka.DefaultMockTopicName, i)
+		require.Len(t, events, 1)
+		require.Equal(t, cdcmodel.MessageTypeResolved, events[0].Type)
+		require.Equal(t, ts, events[0].Ts)
+	}
+}
+
+func TestMQTrackTableInfoFromCheckpoint(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	syncer := NewSyncer(cfg, nil, nil)
+	syncer.mqSink = newTestMQSink(t)
+	var err error
+	syncer.schemaTracker, err = schema.NewTestTracker(context.Background(), cfg.Name, nil, log.L())
+	require.NoError(t, err)
+	defer syncer.schemaTracker.Close()
+
+	// the current upstream table is not used, because it may be changed after the binlog location
+	table := &filter.Table{Schema: "db", Name: "tb"}
+	_, err = syncer.getTableInfo(tcontext.Background(), table, table)
+	require.True(t, terror.ErrSyncerMQTableInfoNotFound.Equal(err))
+
+	ti := mockTableInfo(t, "create table db.tb(id int primary key, name varchar(24))")
+	syncer.checkpoint.SaveTablePoint(table, binlog.MustZeroLocation(mysql.MySQLFlavor), ti)
+	ti2, err := syncer.getTableInfo(tcontext.Background(), table, table)
+	require.NoError(t, err)
+	require.Len(t, ti2.Columns, 2)
+	require.Equal(t, "name", ti2.Columns[1].Name.O)
+}
@@ -0,0 +1,643 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/dumpling"
+	"github.com/pingcap/tiflow/dm/loader"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	dumpmeta "github.com/pingcap/tiflow/dm/pkg/dumpling"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/unit"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// resyncTaskSuffix is appended to the task name for the dump and load units of table resync,
+// so they have their own dump directory and load checkpoint.
+const resyncTaskSuffix = "_resync"
+
+type resyncStage int
+
+const (
+	resyncStageNone resyncStage = iota
+	// the tables are being dumped and loaded in the background, the main stream skips their events.
+	resyncStageLoading
+	// the tables are loaded, waiting for the main stream to take them back.
+	resyncStageLoaded
+	// the main stream is re-replicating the tables from the dump location in safe mode.
+	resyncStageCatchingUp
+	// failed to dump or load the tables.
+	resyncStageFailed
+	// the main stream skips events of the tables, and waits to flush their queued jobs. it's
+	// never saved in the checkpoint table, the saved stage is resyncStageLoading instead.
+	resyncStagePending
+)
+
+// resyncCpTable is the cp_table of the row which saves the table resync state in the checkpoint table,
+// its cp_schema is the same as the global checkpoint.
+const resyncCpTable = "__table_resync"
+
+// tableResyncer tracks the tables which are being resynced inside a running sync unit.
+// resyncing tables works like this:
+//  1. the main stream skips events of the tables, and the global checkpoint is held back.
+//     the queued jobs of the tables and the checkpoint are flushed by the main stream, then
+//     the target tables are dropped.
+//  2. the tables are dumped and loaded in the background by the dump and load units.
+//  3. the table checkpoints are saved as the dump location, if the main stream has passed the
+//     dump location, it's redirected to re-replicate the tables from there in safe mode, like
+//     re-syncing a sharding group.
+//  4. the tables rejoin the main stream and the global checkpoint is not held anymore.
+//
+// the state is saved in the checkpoint table when the resync starts and when the tables are loaded,
+// and it's deleted along with the checkpoints after the tables rejoin the main stream. if the sync
+// unit is restarted, the tables are dumped and loaded again if they were not loaded, otherwise
+// they catch up with the main stream from the saved dump location.
+type tableResyncer struct {
+	sync.RWMutex
+	logger     log.Logger
+	enableGTID bool
+	// run dumps and loads the tables and returns the dump location.
+	run func(ctx context.Context, tables []*filter.Table) (binlog.Location, error)
+	// saveState saves the state into the checkpoint table.
+	saveState func(state *resyncState) error
+
+	// ctx is set only when the sync unit is running.
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	active atomic.Bool
+	stage  resyncStage
+	tables map[string]*filter.Table
+	// the global checkpoint is not saved after holdLocation until the tables rejoin the main
+	// stream, so their table checkpoints are never older than the global checkpoint.
+	holdLocation binlog.Location
+	dumpLocation binlog.Location
+	err          error
+	// aborted is set if the resync is given up, the state is dropped when the sync unit exits.
+	aborted bool
+
+	// saved is true if the state is saved in the checkpoint table and not deleted yet.
+	saved bool
+	// deletingSnapshot is the ID of the first checkpoint snapshot which deletes the saved state.
+	deletingSnapshot int
+}
+
+func newTableResyncer(
+	logger log.Logger,
+	enableGTID bool,
+	run func(ctx context.Context, tables []*filter.Table) (binlog.Location, error),
+	saveState func(state *resyncState) error,
+) *tableResyncer {
+	return &tableResyncer{
+		logger:     logger.WithFields(zap.String("component", "table resyncer")),
+		enableGTID: enableGTID,
+		run:        run,
+		saveState:  saveState,
+	}
+}
+
+// restore restores the state loaded from the checkpoint table, it's called before the sync unit runs.
+func (r *tableResyncer) restore(state *resyncState) {
+	if state == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.tables = make(map[string]*filter.Table, len(state.tables))
+	for _, table := range state.tables {
+		r.tables[table.String()] = table
+	}
+	r.stage, r.holdLocation, r.dumpLocation = state.stage, state.holdLocation, state.dumpLocation
+	r.saved = true
+	r.active.Store(true)
+	r.logger.Info("restore table resync", zap.Stringers("tables", state.tables),
+		zap.Stringer("hold location", state.holdLocation), zap.Stringer("dump location", state.dumpLocation))
+}
+
+// open is called when the sync unit starts to run, it continues the resync of the last run.
+func (r *tableResyncer) open(ctx context.Context) {
+	r.Lock()
+	defer r.Unlock()
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	switch r.stage {
+	case resyncStageLoading, resyncStageFailed:
+		// the target tables are dropped again before loading, so the dump and load can be redone.
+		r.logger.Info("dump and load resyncing tables again", zap.Stringers("tables", r.tableList()))
+		r.load()
+	case resyncStageCatchingUp:
+		// the redirected streams of the last run are gone, so catch up from the dump location again.
+		r.stage = resyncStageLoaded
+	}
+}
+
+// close stops the running resync, it's called when the sync unit exits.
+// the state is kept, so the resync is continued when the sync unit runs again.
+func (r *tableResyncer) close() {
+	r.Lock()
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.ctx, r.cancel = nil, nil
+	r.Unlock()
+
+	r.wg.Wait()
+
+	r.Lock()
+	aborted := r.aborted
+	r.Unlock()
+	if aborted {
+		r.finish()
+	}
+}
+
+// start starts to resync tables, hold is the current global checkpoint.
+func (r *tableResyncer) start(tables []*filter.Table, hold binlog.Location) error {
+	r.Lock()
+	defer r.Unlock()
+	if r.ctx == nil {
+		return terror.ErrSyncerResyncTableUnsupported.Generate(tables, "the sync unit is not running")
+	}
+	if r.stage != resyncStageNone {
+		return terror.ErrSyncerResyncTableInProgress.Generate(r.tableList())
+	}
+	// the last resync is finished but its state is not deleted from the checkpoint table yet.
+	if r.saved {
+		return terror.ErrSyncerResyncTableInProgress.Generate(tables)
+	}
+
+	r.tables = make(map[string]*filter.Table, len(tables))
+	for _, table := range tables {
+		r.tables[table.String()] = table
+	}
+	// save the state before the target tables are dropped, so the resync isn't lost if the sync unit restarts.
+	state := &resyncState{stage: resyncStageLoading, tables: r.tableList(), holdLocation: hold.Clone()}
+	if err := r.saveState(state); err != nil {
+		r.tables = nil
+		return err
+	}
+	r.saved = true
+	r.holdLocation = state.holdLocation
+	// the tables are dumped and loaded after the main stream flushes their jobs.
+	r.stage = resyncStagePending
+	r.active.Store(true)
+	return nil
+}
+
+// startLoad starts to dump and load the pending tables, it's called by the main stream
+// after the queued jobs of the tables are flushed.
+func (r *tableResyncer) startLoad() {
+	r.Lock()
+	defer r.Unlock()
+	if r.stage != resyncStagePending || r.ctx == nil {
+		return
+	}
+	r.load()
+}
+
+// load dumps and loads the tables in the background, caller should hold the lock.
+func (r *tableResyncer) load() {
+	r.stage, r.err = resyncStageLoading, nil
+	r.active.Store(true)
+
+	ctx, tables, hold := r.ctx, r.tableList(), r.holdLocation.Clone()
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		loc, err := r.run(ctx, tables)
+		r.Lock()
+		defer r.Unlock()
+		if r.stage != resyncStageLoading || r.aborted {
+			return
+		}
+		if err == nil {
+			// the tables are not dumped and loaded again after the dump location is saved.
+			err = r.saveState(&resyncState{stage: resyncStageLoaded, tables: tables, holdLocation: hold, dumpLocation: loc})
+		}
+		if err != nil {
+			r.logger.Error("fail to dump and load tables", zap.Stringers("tables", tables), zap.Error(err))
+			r.stage, r.err = resyncStageFailed, err
+			return
+		}
+		r.logger.Info("tables are dumped and loaded", zap.Stringers("tables", tables), zap.Stringer("location", loc))
+		r.stage, r.dumpLocation = resyncStageLoaded, loc
+	}()
+}
+
+// skipping returns whether the events of table should be skipped by the main stream.
+func (r *tableResyncer) skipping(table *filter.Table) bool {
+	if !r.active.Load() {
+		return false
+	}
+	r.RLock()
+	defer r.RUnlock()
+	if r.stage == resyncStageNone || r.stage == resyncStageCatchingUp {
+		return false
+	}
+	_, ok := r.tables[table.String()]
+	return ok
+}
+
+// adjustGlobalLocation holds back the global checkpoint to the location when the resync started.
+func (r *tableResyncer) adjustGlobalLocation(loc binlog.Location) binlog.Location {
+	if !r.active.Load() {
+		return loc
+	}
+	r.RLock()
+	defer r.RUnlock()
+	if r.stage == resyncStageNone {
+		return loc
+	}
+	if binlog.CompareLocation(loc, r.holdLocation, r.enableGTID) > 0 {
+		return r.holdLocation.Clone()
+	}
+	return loc
+}
+
+// current returns the stage, the resyncing tables, the dump location and the error of dump or load.
+func (r *tableResyncer) current() (resyncStage, []*filter.Table, binlog.Location, error) {
+	r.RLock()
+	defer r.RUnlock()
+	return r.stage, r.tableList(), r.dumpLocation, r.err
+}
+
+func (r *tableResyncer) catchUp() {
+	r.Lock()
+	defer r.Unlock()
+	r.stage = resyncStageCatchingUp
+}
+
+// abort gives up the resync, the saved state is deleted by the next checkpoint flush.
+func (r *tableResyncer) abort() {
+	r.Lock()
+	defer r.Unlock()
+	r.aborted = true
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
+func (r *tableResyncer) finish() {
+	r.Lock()
+	defer r.Unlock()
+	r.active.Store(false)
+	r.stage = resyncStageNone
+	r.tables = nil
+	r.holdLocation = binlog.Location{}
+	r.dumpLocation = binlog.Location{}
+	r.err = nil
+	r.aborted = false
+}
+
+// needDeleteState returns whether the checkpoint snapshot should delete the saved state.
+func (r *tableResyncer) needDeleteState(snapshotID int) bool {
+	r.Lock()
+	defer r.Unlock()
+	if !r.saved || (r.stage != resyncStageNone && !r.aborted) {
+		return false
+	}
+	if r.deletingSnapshot == 0 {
+		r.deletingSnapshot = snapshotID
+	}
+	return true
+}
+
+// afterFlush is called after the checkpoint snapshot is flushed.
+func (r *tableResyncer) afterFlush(snapshotID int) {
+	r.Lock()
+	defer r.Unlock()
+	if r.deletingSnapshot != 0 && snapshotID >= r.deletingSnapshot {
+		r.saved, r.deletingSnapshot = false, 0
+	}
+}
+
+// tableList returns the resyncing tables in order, caller should hold the lock.
+func (r *tableResyncer) tableList() []*filter.Table {
+	tables := make([]*filter.Table, 0, len(r.tables))
+	for _, table := range r.tables {
+		tables = append(tables, table)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].String() < tables[j].String()
+	})
+	return tables
+}
+
+// resyncState is the table resync state saved in the checkpoint table.
+type resyncState struct {
+	stage  resyncStage
+	tables []*filter.Table
+	// holdLocation is saved in the binlog columns of the checkpoint table.
+	holdLocation binlog.Location
+	dumpLocation binlog.Location
+}
+
+// resyncStateJSON is saved in the table_info column of the checkpoint table.
+type resyncStateJSON struct {
+	Stage          resyncStage     `json:"stage"`
+	Tables         []*filter.Table `json:"tables"`
+	DumpBinlogName string          `json:"dump-binlog-name"`
+	DumpBinlogPos  uint32          `json:"dump-binlog-pos"`
+	DumpBinlogGTID string          `json:"dump-binlog-gtid"`
+}
+
+func (s *resyncState) marshal() ([]byte, error) {
+	data, err := json.Marshal(resyncStateJSON{
+		Stage:          s.stage,
+		Tables:         s.tables,
+		DumpBinlogName: s.dumpLocation.Position.Name,
+		DumpBinlogPos:  s.dumpLocation.Position.Pos,
+		DumpBinlogGTID: s.dumpLocation.GTIDSetStr(),
+	})
+	return data, errors.Annotate(err, "marshal table resync state")
+}
+
+func parseResyncState(holdLocation binlog.Location, data []byte, flavor string) (*resyncState, error) {
+	var stateJSON resyncStateJSON
+	if err := json.Unmarshal(data, &stateJSON); err != nil {
+		return nil, errors.Annotate(err, "parse table resync state")
+	}
+	gset, err := gtid.ParserGTID(flavor, stateJSON.DumpBinlogGTID)
+	if err != nil {
+		return nil, err
+	}
+	return &resyncState{
+		stage:        stateJSON.Stage,
+		tables:       stateJSON.Tables,
+		holdLocation: holdLocation,
+		dumpLocation: binlog.NewLocation(
+			mysql.Position{Name: stateJSON.DumpBinlogName, Pos: stateJSON.DumpBinlogPos},
+			gset,
+		),
+	}, nil
+}
+
+// ResyncTables dumps and loads the tables again while other tables keep replicating,
+// then the tables catch up with the main stream from the dump location in safe mode.
+// it only starts the resync and returns, the progress can be found in the log.
+func (s *Syncer) ResyncTables(tables []*filter.Table) error {
+	if s.cfg.ShardMode != "" {
+		return terror.ErrSyncerResyncTableUnsupported.Generate(tables, "the task is in shard mode "+s.cfg.ShardMode)
+	}
+	for _, table := range tables {
+		if s.skipByTable(table) {
+			return terror.ErrSyncerResyncTableUnsupported.Generate(tables, table.String()+" is filtered by block-allow list")
+		}
+	}
+	if err := s.checkResyncTargets(tables); err != nil {
+		return err
+	}
+	s.tctx.L().Info("start to resync tables", zap.Stringers("tables", tables))
+	return s.tableResyncer.start(tables, s.checkpoint.GlobalPoint())
+}
+
+// checkResyncTargets rejects the resync if the target table of any table is shared by another
+// upstream table, e.g. tables merged by route rules, because dropping the target table loses
+// the rows of the other tables. the upstream tables of this source are fetched from upstream,
+// and the ones of the other sources are fetched from their table checkpoints.
+func (s *Syncer) checkResyncTargets(tables []*filter.Table) error {
+	tctx, cancel := s.tctx.WithTimeout(maxDMLConnectionDuration)
+	defer cancel()
+	doTables, err := conn.FetchAllDoTables(tctx.Ctx, s.fromDB.BaseDB, s.baList)
+	if err != nil {
+		return err
+	}
+	otherTables, err := s.checkpoint.FetchOtherSourcesTables(tctx)
+	if err != nil {
+		return err
+	}
+
+	resyncing := make(map[string]struct{}, len(tables))
+	targets := make(map[string]*filter.Table, len(tables))
+	for _, table := range tables {
+		resyncing[table.String()] = struct{}{}
+		targets[s.route(table).String()] = table
+	}
+	checkShared := func(upstream *filter.Table, otherSource bool) error {
+		if _, ok := resyncing[upstream.String()]; ok && !otherSource {
+			return nil
+		}
+		if table, ok := targets[s.route(upstream).String()]; ok {
+			return terror.ErrSyncerResyncTableUnsupported.Generate(tables, fmt.Sprintf(
+				"the target table %s of %s is shared by %s", s.route(table), table, upstream))
+		}
+		return nil
+	}
+	for schema, names := range doTables {
+		for _, name := range names {
+			if err = checkShared(&filter.Table{Schema: schema, Name: name}, false); err != nil {
+				return err
+			}
+		}
+	}
+	for _, upstream := range otherTables {
+		if err = checkShared(upstream, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Syncer) saveResyncState(state *resyncState) error {
+	return s.checkpoint.SaveResyncState(s.tctx, state)
+}
+
+// dumpAndLoadTables dumps the tables from upstream and loads them into the re-created
+// downstream tables, and returns the location of the dump.
+func (s *Syncer) dumpAndLoadTables(ctx context.Context, tables []*filter.Table) (binlog.Location, error) {
+	var location binlog.Location
+	cfg, err := s.newResyncSubTaskConfig(tables)
+	if err != nil {
+		return location, err
+	}
+
+	for _, table := range tables {
+		if _, err = dbutil.GetCreateTableSQL(ctx, s.fromDB.BaseDB.DB, table.Schema, table.Name); err != nil {
+			return location, terror.WithScope(terror.Annotatef(err, "get table structure of %s", table), terror.ScopeUpstream)
+		}
+	}
+	// drop the target tables, so they are created by the load unit with the dumped structure and data.
+	targets := make(map[string]struct{}, len(tables))
+	sqls := make([]string, 0, len(tables))
+	for _, table := range tables {
+		target := s.route(table)
+		if _, ok := targets[target.String()]; ok {
+			continue
+		}
+		targets[target.String()] = struct{}{}
+		sqls = append(sqls, "DROP TABLE IF EXISTS "+target.String())
+	}
+	if err = s.dropResyncLoadCheckpoint(ctx, cfg); err != nil {
+		return location, err
+	}
+	defer func() {
+		if err2 := s.dropResyncLoadCheckpoint(context.Background(), cfg); err2 != nil {
+			s.tctx.L().Warn("fail to drop load checkpoint of table resync", zap.Error(err2))
+		}
+	}()
+	for _, sql := range sqls {
+		s.tctx.L().Info("drop downstream table for resync", zap.String("sql", sql))
+		if _, err = s.toDB.DB.ExecContext(ctx, sql); err != nil {
+			return location, terror.DBErrorAdapt(err, terror.ScopeDownstream, terror.ErrDBDriverError)
+		}
+	}
+
+	if err = runResyncUnit(ctx, dumpling.NewDumpling(cfg)); err != nil {
+		return location, err
+	}
+	// `metadata` is mydumper's output meta file name
+	loc, _, err := dumpmeta.ParseMetaData(ctx, cfg.LoaderConfig.Dir, "metadata", nil)
+	if err != nil {
+		return location, err
+	}
+	// the load unit doesn't coordinate with other sources, so no etcd client is needed.
+	if err = runResyncUnit(ctx, loader.NewLightning(cfg, nil, s.cfg.WorkerName)); err != nil {
+		return location, err
+	}
+	return *loc, nil
+}
+
+// newResyncSubTaskConfig returns the config for the dump and load units of table resync.
+func (s *Syncer) newResyncSubTaskConfig(tables []*filter.Table) (*config.SubTaskConfig, error) {
+	cfg, err := s.cfg.Clone()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Name = s.cfg.Name + resyncTaskSuffix
+	cfg.Mode = config.ModeFull
+	schemas := make([]string, 0, len(tables))
+	for _, table := range tables {
+		schemas = append(schemas, table.Schema)
+	}
+	cfg.BAList = &filter.Rules{DoDBs: schemas, DoTables: tables}
+	// physical import mode can't be used while the task is replicating other tables.
+	cfg.LoaderConfig.ImportMode = config.LoadModeLogical
+	cfg.CleanDumpFile = true
+	dir := strings.TrimSuffix(s.cfg.LoaderConfig.Dir, "."+s.cfg.Name)
+	cfg.LoaderConfig.Dir, err = storage.AdjustPath(dir, "."+cfg.Name)
+	if err != nil {
+		return nil, terror.ErrConfigLoaderDirInvalid.Delegate(err, dir)
+	}
+	return cfg, nil
+}
+
+// dropResyncLoadCheckpoint drops the load checkpoint of table resync, so the tables are always
+// loaded again by the next resync.
+func (s *Syncer) dropResyncLoadCheckpoint(ctx context.Context, cfg *config.SubTaskConfig) error {
+	sql := "DROP TABLE IF EXISTS " + dbutil.TableName(cfg.MetaSchema, cputil.LightningCheckpoint(cfg.Name))
+	if _, err := s.toDB.DB.ExecContext(ctx, sql); err != nil {
+		return terror.DBErrorAdapt(err, terror.ScopeDownstream, terror.ErrDBDriverError)
+	}
+	return nil
+}
+
+// runResyncUnit runs the dump or load unit of table resync until it's finished.
+func runResyncUnit(ctx context.Context, u unit.Unit) error {
+	if err := u.Init(ctx); err != nil {
+		return err
+	}
+	defer u.Close()
+
+	pr := make(chan pb.ProcessResult, 1)
+	u.Process(ctx, pr)
+	result := <-pr
+	if len(result.Errors) > 0 {
+		return errors.New(unit.JoinProcessErrors(result.Errors))
+	}
+	if result.IsCanceled {
+		return context.Canceled
+	}
+	return nil
+}
+
+// checkTableResync moves forward the table resync in the main routine, it should be called only
+// when the main stream is at the end of a transaction and not re-syncing a sharding group.
+func (s *Syncer) checkTableResync(location binlog.Location, shardingReSyncCh *chan *ShardingReSync) error {
+	stage, tables, dumpLocation, err := s.tableResyncer.current()
+	switch stage {
+	case resyncStagePending:
+		// the main stream skips events of the tables now, so flush their queued jobs and the checkpoint
+		// before the target tables are dropped, otherwise the jobs fail on the missing tables or are
+		// applied on top of the reloaded data.
+		if err = s.flushJobs(); err != nil {
+			return err
+		}
+		s.tableResyncer.startLoad()
+		s.tctx.L().Info("jobs of resyncing tables are flushed, start to dump and load them", zap.Stringers("tables", tables))
+		return nil
+	case resyncStageFailed:
+		// the state is kept, so the tables are dumped and loaded again when the task is resumed.
+		return terror.ErrSyncerResyncTableFailed.Delegate(err, tables)
+	case resyncStageLoaded:
+		// events of the tables before the dump location are skipped by their table checkpoints.
+		for _, table := range tables {
+			s.saveTablePoint(table, dumpLocation)
+		}
+		if binlog.CompareLocation(dumpLocation, location, s.cfg.EnableGTID) >= 0 {
+			s.tableResyncer.finish()
+			s.tctx.L().Info("resync tables finished", zap.Stringers("tables", tables), zap.Stringer("dump location", dumpLocation))
+			return nil
+		}
+
+		targets := make([]*filter.Table, 0, len(tables))
+		seen := make(map[string]struct{}, len(tables))
+		for _, table := range tables {
+			target := s.route(table)
+			if _, ok := seen[target.String()]; !ok {
+				seen[target.String()] = struct{}{}
+				targets = append(targets, target)
+			}
+		}
+		if cap(*shardingReSyncCh) < len(targets) {
+			*shardingReSyncCh = make(chan *ShardingReSync, len(targets))
+		}
+		for _, target := range targets {
+			*shardingReSyncCh <- &ShardingReSync{
+				currLocation:   dumpLocation.Clone(),
+				latestLocation: location.Clone(),
+				targetTable:    target,
+				allResolved:    true,
+			}
+		}
+		s.tableResyncer.catchUp()
+		s.tctx.L().Info("start to catch up resynced tables", zap.Stringers("tables", tables),
+			zap.Stringer("dump location", dumpLocation), zap.Stringer("location", location))
+		return s.safeMode.Add(s.runCtx, 1)
+	case resyncStageCatchingUp:
+		// all redirected streams are finished.
+		s.tableResyncer.finish()
+		s.tctx.L().Info("resync tables finished", zap.Stringers("tables", tables), zap.Stringer("location", location))
+		return s.safeMode.Add(s.runCtx, -1)
+	}
+	return nil
+}
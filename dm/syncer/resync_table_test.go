@@ -0,0 +1,268 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	router "github.com/pingcap/tidb/pkg/util/table-router"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableResyncer(t *testing.T) {
+	var (
+		tb1    = &filter.Table{Schema: "db", Name: "tb1"}
+		tb2    = &filter.Table{Schema: "db", Name: "tb2"}
+		loc1   = binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 100}, nil)
+		loc2   = binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 200}, nil)
+		loc3   = binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 300}, nil)
+		loadCh = make(chan error)
+		saved  []*resyncState
+	)
+	run := func(context.Context, []*filter.Table) (binlog.Location, error) {
+		return loc2, <-loadCh
+	}
+	r := newTableResyncer(log.L(), false, func(ctx context.Context, tables []*filter.Table) (binlog.Location, error) {
+		return run(ctx, tables)
+	}, func(state *resyncState) error {
+		saved = append(saved, state)
+		return nil
+	})
+	waitStage := func(r *tableResyncer, stage resyncStage) {
+		require.Eventually(t, func() bool {
+			s, _, _, _ := r.current()
+			return s == stage
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// not running
+	require.True(t, terror.ErrSyncerResyncTableUnsupported.Equal(r.start([]*filter.Table{tb1}, loc1)))
+	require.Equal(t, loc3, r.adjustGlobalLocation(loc3))
+
+	r.open(context.Background())
+	require.NoError(t, r.start([]*filter.Table{tb1}, loc1))
+	require.True(t, terror.ErrSyncerResyncTableInProgress.Equal(r.start([]*filter.Table{tb2}, loc1)))
+	require.True(t, r.skipping(tb1))
+	require.False(t, r.skipping(tb2))
+	// the state is saved before the tables are dumped and loaded
+	require.Len(t, saved, 1)
+	require.Equal(t, &resyncState{stage: resyncStageLoading, tables: []*filter.Table{tb1}, holdLocation: loc1}, saved[0])
+	// global checkpoint is held back
+	require.Equal(t, loc1, r.adjustGlobalLocation(loc3))
+	require.Equal(t, loc1, r.adjustGlobalLocation(loc2))
+	// the tables are not dumped and loaded until the main stream flushes their jobs
+	stage, tables, dumpLocation, err := r.current()
+	require.Equal(t, resyncStagePending, stage)
+	r.startLoad()
+	stage, _, _, _ = r.current()
+	require.Equal(t, resyncStageLoading, stage)
+
+	loadCh <- nil
+	waitStage(r, resyncStageLoaded)
+	stage, tables, dumpLocation, err = r.current()
+	require.Equal(t, resyncStageLoaded, stage)
+	require.Equal(t, []*filter.Table{tb1}, tables)
+	require.Equal(t, loc2, dumpLocation)
+	require.NoError(t, err)
+	require.True(t, r.skipping(tb1))
+	require.Len(t, saved, 2)
+	require.Equal(t, &resyncState{stage: resyncStageLoaded, tables: []*filter.Table{tb1}, holdLocation: loc1, dumpLocation: loc2}, saved[1])
+
+	// the table is re-replicated by the main stream
+	r.catchUp()
+	require.False(t, r.skipping(tb1))
+	require.Equal(t, loc1, r.adjustGlobalLocation(loc3))
+	require.False(t, r.needDeleteState(1))
+	r.finish()
+	require.Equal(t, loc3, r.adjustGlobalLocation(loc3))
+
+	// the saved state is deleted along with the checkpoints
+	require.True(t, r.needDeleteState(2))
+	require.True(t, r.needDeleteState(3))
+	require.True(t, terror.ErrSyncerResyncTableInProgress.Equal(r.start([]*filter.Table{tb2}, loc1)))
+	r.afterFlush(1)
+	require.True(t, r.needDeleteState(4))
+	r.afterFlush(2)
+	require.False(t, r.needDeleteState(5))
+
+	// dump or load failed, they are redone when the sync unit runs again
+	require.NoError(t, r.start([]*filter.Table{tb1, tb2}, loc1))
+	r.startLoad()
+	loadCh <- errors.New("load failed")
+	waitStage(r, resyncStageFailed)
+	_, tables, _, err = r.current()
+	require.Equal(t, []*filter.Table{tb1, tb2}, tables)
+	require.EqualError(t, err, "load failed")
+	r.close()
+	stage, _, _, _ = r.current()
+	require.Equal(t, resyncStageFailed, stage)
+	require.True(t, r.skipping(tb1))
+	r.open(context.Background())
+	stage, _, _, err = r.current()
+	require.Equal(t, resyncStageLoading, stage)
+	require.NoError(t, err)
+	loadCh <- nil
+	waitStage(r, resyncStageLoaded)
+
+	// the saved state is restored after the sync unit is restarted
+	r2 := newTableResyncer(log.L(), false, run, func(*resyncState) error { return nil })
+	r2.restore(saved[len(saved)-1])
+	require.True(t, r2.skipping(tb2))
+	require.Equal(t, loc1, r2.adjustGlobalLocation(loc3))
+	require.False(t, r2.needDeleteState(1))
+	r2.open(context.Background())
+	r2.catchUp()
+	r2.close()
+	// catch up from the dump location again
+	r2.open(context.Background())
+	stage, tables, dumpLocation, _ = r2.current()
+	require.Equal(t, resyncStageLoaded, stage)
+	require.Equal(t, []*filter.Table{tb1, tb2}, tables)
+	require.Equal(t, loc2, dumpLocation)
+	r2.close()
+
+	// abort cancels the running resync and drops it when the sync unit exits
+	r.finish()
+	require.True(t, r.needDeleteState(6))
+	r.afterFlush(6)
+	run = func(ctx context.Context, _ []*filter.Table) (binlog.Location, error) {
+		<-ctx.Done()
+		return binlog.Location{}, ctx.Err()
+	}
+	require.NoError(t, r.start([]*filter.Table{tb2}, loc1))
+	r.startLoad()
+	r.abort()
+	require.True(t, r.needDeleteState(10))
+	// global checkpoint is still held until the sync unit exits
+	require.Equal(t, loc1, r.adjustGlobalLocation(loc3))
+	r.close()
+	require.False(t, r.skipping(tb2))
+	stage, _, _, _ = r.current()
+	require.Equal(t, resyncStageNone, stage)
+	require.True(t, terror.ErrSyncerResyncTableUnsupported.Equal(r.start([]*filter.Table{tb1}, loc1)))
+}
+
+func TestResyncStateMarshal(t *testing.T) {
+	gset, err := gtid.ParserGTID(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14")
+	require.NoError(t, err)
+	state := &resyncState{
+		stage:        resyncStageLoaded,
+		tables:       []*filter.Table{{Schema: "db", Name: "tb1"}},
+		holdLocation: binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 100}, nil),
+		dumpLocation: binlog.NewLocation(mysql.Position{Name: "mysql-bin.000002", Pos: 200}, gset),
+	}
+	data, err := state.marshal()
+	require.NoError(t, err)
+	parsed, err := parseResyncState(state.holdLocation, data, mysql.MySQLFlavor)
+	require.NoError(t, err)
+	require.Equal(t, state.stage, parsed.stage)
+	require.Equal(t, state.tables, parsed.tables)
+	require.Equal(t, state.holdLocation, parsed.holdLocation)
+	require.Equal(t, state.dumpLocation.String(), parsed.dumpLocation.String())
+
+	_, err = parseResyncState(state.holdLocation, []byte("null"), mysql.MySQLFlavor)
+	require.NoError(t, err)
+	_, err = parseResyncState(state.holdLocation, []byte("{"), mysql.MySQLFlavor)
+	require.Error(t, err)
+}
+
+type mockedCheckPointForResync struct {
+	CheckPoint
+	otherTables []*filter.Table
+}
+
+func (c *mockedCheckPointForResync) FetchOtherSourcesTables(*tcontext.Context) ([]*filter.Table, error) {
+	return c.otherTables, nil
+}
+
+func (c *mockedCheckPointForResync) GlobalPoint() binlog.Location {
+	return binlog.MustZeroLocation(mysql.MySQLFlavor)
+}
+
+func TestSyncerResyncTables(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.BAList = &filter.Rules{DoDBs: []string{"db"}}
+	tb1 := &filter.Table{Schema: "db", Name: "tb1"}
+
+	syncer := NewSyncer(cfg, nil, nil)
+	var err error
+	syncer.baList, err = filter.New(cfg.CaseSensitive, cfg.BAList)
+	require.NoError(t, err)
+	require.True(t, terror.ErrSyncerResyncTableUnsupported.Equal(
+		syncer.ResyncTables([]*filter.Table{{Schema: "db2", Name: "tb1"}})))
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	syncer.fromDB = &dbconn.UpStreamConn{BaseDB: conn.NewBaseDBForTest(db)}
+	checkpoint := &mockedCheckPointForResync{}
+	syncer.checkpoint = checkpoint
+	expectFetchTables := func() {
+		mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"Database"}).AddRow("db"))
+		mock.ExpectQuery("SHOW FULL TABLES IN `db`").WillReturnRows(sqlmock.NewRows([]string{"Tables_in_db", "Table_type"}).
+			AddRow("tb1", "BASE TABLE").AddRow("tb2", "BASE TABLE"))
+	}
+
+	// not running
+	require.NoError(t, syncer.genRouter())
+	expectFetchTables()
+	err = syncer.ResyncTables([]*filter.Table{tb1})
+	require.True(t, terror.ErrSyncerResyncTableUnsupported.Equal(err))
+	require.Contains(t, err.Error(), "the sync unit is not running")
+
+	// the target table is shared by another table of the source
+	cfg.RouteRules = []*router.TableRule{{SchemaPattern: "db", TablePattern: "tb*", TargetSchema: "db", TargetTable: "tb"}}
+	require.NoError(t, syncer.genRouter())
+	expectFetchTables()
+	err = syncer.ResyncTables([]*filter.Table{tb1})
+	require.True(t, terror.ErrSyncerResyncTableUnsupported.Equal(err))
+	require.Contains(t, err.Error(), "the target table `db`.`tb` of `db`.`tb1` is shared by `db`.`tb2`")
+
+	// the target table is shared by the table of another source
+	cfg.RouteRules = nil
+	require.NoError(t, syncer.genRouter())
+	checkpoint.otherTables = []*filter.Table{{Schema: "db", Name: "tb2"}, tb1}
+	expectFetchTables()
+	err = syncer.ResyncTables([]*filter.Table{tb1})
+	require.True(t, terror.ErrSyncerResyncTableUnsupported.Equal(err))
+	require.Contains(t, err.Error(), "the target table `db`.`tb1` of `db`.`tb1` is shared by `db`.`tb1`")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	subCfg, err := syncer.newResyncSubTaskConfig([]*filter.Table{tb1})
+	require.NoError(t, err)
+	require.Equal(t, cfg.Name+resyncTaskSuffix, subCfg.Name)
+	require.Equal(t, config.ModeFull, subCfg.Mode)
+	require.Equal(t, config.LoadModeLogical, subCfg.LoaderConfig.ImportMode)
+	require.Equal(t, &filter.Rules{DoDBs: []string{"db"}, DoTables: []*filter.Table{tb1}}, subCfg.BAList)
+	require.Equal(t, cfg.LoaderConfig.Dir+"."+subCfg.Name, subCfg.LoaderConfig.Dir)
+	require.True(t, subCfg.CleanDumpFile)
+
+	cfg.ShardMode = config.ShardOptimistic
+	syncer = NewSyncer(cfg, nil, nil)
+	require.True(t, terror.ErrSyncerResyncTableUnsupported.Equal(syncer.ResyncTables([]*filter.Table{tb1})))
+}
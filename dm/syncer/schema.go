@@ -126,6 +126,7 @@ func (s *Syncer) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaR
 		newSQL := newCreateSQLBuilder.String()
 
 		s.exprFilterGroup.ResetExprs(sourceTable)
+		s.columnTransforms.ResetExprs(sourceTable)
 
 		if !req.Flush {
 			s.tctx.L().Info("overwrite --flush to true for operate-schema")
@@ -162,6 +163,8 @@ func (s *Syncer) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaR
 		// as the doc says, `operate-schema remove` will let DM-worker use table structure in checkpoint, which does not
 		// need further actions.
 		return "", nil
+	case pb.SchemaOp_ReconcileSchema:
+		return s.reconcileSchema(ctx, sourceTable)
 	}
 	return "", nil
 }
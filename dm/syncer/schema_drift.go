@@ -0,0 +1,297 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tidb/pkg/executor"
+	"github.com/pingcap/tidb/pkg/meta/autoid"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/format"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/pingcap/tiflow/dm/pb"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// schemaDriftChecker keeps the differences between the tracked table structures and the downstream tables
+// found by the last check, which are shown in query-status.
+type schemaDriftChecker struct {
+	// interval of the periodic check, 0 means disabled.
+	interval time.Duration
+
+	mu sync.RWMutex
+	// drifts maps the source table to its differences, tables without differences are not stored.
+	drifts map[string][]*pb.SchemaDrift
+}
+
+func newSchemaDriftChecker(interval string) *schemaDriftChecker {
+	// interval has been checked in SubTaskConfig.Adjust
+	d, _ := time.ParseDuration(interval)
+	return &schemaDriftChecker{
+		interval: d,
+		drifts:   make(map[string][]*pb.SchemaDrift),
+	}
+}
+
+// all returns the differences of all tables sorted by the source table.
+func (c *schemaDriftChecker) all() []*pb.SchemaDrift {
+	if c == nil {
+		return nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	tables := make([]string, 0, len(c.drifts))
+	for table := range c.drifts {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	var ret []*pb.SchemaDrift
+	for _, table := range tables {
+		ret = append(ret, c.drifts[table]...)
+	}
+	return ret
+}
+
+// countByType returns the number of differences of each type.
+func (c *schemaDriftChecker) countByType() map[schema.DriftType]int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	counts := make(map[schema.DriftType]int, len(schema.AllDriftTypes))
+	for _, drifts := range c.drifts {
+		for _, drift := range drifts {
+			counts[schema.DriftType(drift.Type)]++
+		}
+	}
+	return counts
+}
+
+func (c *schemaDriftChecker) reset(drifts map[string][]*pb.SchemaDrift) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.drifts = drifts
+}
+
+func (c *schemaDriftChecker) setTable(sourceTable string, drifts []*pb.SchemaDrift) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(drifts) == 0 {
+		delete(c.drifts, sourceTable)
+	} else {
+		c.drifts[sourceTable] = drifts
+	}
+}
+
+func (s *Syncer) checkSchemaDriftCronJob(ctx context.Context) {
+	defer s.runWg.Done()
+	ticker := time.NewTicker(s.schemaDrift.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.checkSchemaDrift(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkSchemaDrift compares the tracked table structures of all synced tables with the downstream tables.
+func (s *Syncer) checkSchemaDrift(ctx context.Context) {
+	tctx := s.tctx.WithContext(ctx)
+	drifts := make(map[string][]*pb.SchemaDrift)
+	for schemaName, tables := range s.checkpoint.TablePoint() {
+		for tableName := range tables {
+			if ctx.Err() != nil {
+				return
+			}
+			sourceTable := &filter.Table{Schema: schemaName, Name: tableName}
+			ti, err := s.schemaTracker.GetTableInfo(sourceTable)
+			if err != nil {
+				// the table may be dropped or the tracker is being re-initialized, check it next time.
+				s.tctx.L().Debug("skip checking schema drift", zap.Stringer("table", sourceTable), zap.Error(err))
+				continue
+			}
+			tableDrifts, err := s.checkTableSchemaDrift(tctx, sourceTable, ti)
+			if err != nil {
+				s.tctx.L().Warn("fail to check schema drift", zap.Stringer("table", sourceTable), zap.Error(err))
+				continue
+			}
+			if len(tableDrifts) > 0 {
+				s.tctx.L().Warn("found schema drift", zap.Stringer("table", sourceTable), zap.Any("drifts", tableDrifts))
+				drifts[sourceTable.String()] = tableDrifts
+			}
+		}
+	}
+	s.schemaDrift.reset(drifts)
+	s.updateSchemaDriftMetric()
+}
+
+// checkTableSchemaDrift compares the table info with the latest structure of the downstream table.
+// The differences made on purpose by the task configuration are not reported.
+func (s *Syncer) checkTableSchemaDrift(tctx *tcontext.Context, sourceTable *filter.Table, ti *model.TableInfo) ([]*pb.SchemaDrift, error) {
+	// the table structure changes are not replicated, so the downstream table is expected to differ.
+	if skip, err := s.skipByFilter(sourceTable, bf.AlterTable, ""); err == nil && skip {
+		return nil, nil
+	}
+	targetTable := s.route(sourceTable)
+	downstreamTI, err := s.schemaTracker.FetchDownStreamTableInfo(tctx, s.schemaDriftConn, utils.GenTableID(targetTable), ti)
+	if err != nil {
+		return nil, err
+	}
+	drifts := schema.CompareTableInfo(ti, downstreamTI)
+	extendCols := make(map[string]struct{})
+	cols, _ := s.tableRouter.FetchExtendColumn(sourceTable.Schema, sourceTable.Name, s.cfg.SourceID)
+	for _, col := range cols {
+		extendCols[strings.ToLower(col)] = struct{}{}
+	}
+	ret := make([]*pb.SchemaDrift, 0, len(drifts))
+	for _, drift := range drifts {
+		switch drift.Type {
+		case schema.DriftExtraColumn:
+			// the extended columns are added by route rules, and the merged table of a shard group
+			// may have the columns of other shards.
+			if _, ok := extendCols[strings.ToLower(drift.Name)]; ok || s.cfg.ShardMode != "" {
+				continue
+			}
+		case schema.DriftTypeChange:
+			// the downstream column may be widened to hold the transformed values.
+			if s.columnTransforms.IsTransformed(sourceTable, drift.Name) {
+				continue
+			}
+		}
+		ret = append(ret, &pb.SchemaDrift{
+			SourceTable: sourceTable.String(),
+			TargetTable: targetTable.String(),
+			Type:        string(drift.Type),
+			Name:        drift.Name,
+			Tracked:     drift.Tracked,
+			Downstream:  drift.Downstream,
+		})
+	}
+	return ret, nil
+}
+
+func (s *Syncer) updateSchemaDriftMetric() {
+	counts := s.schemaDrift.countByType()
+	for _, tp := range schema.AllDriftTypes {
+		s.metricsProxies.SchemaDriftGauge.WithLabelValues(s.cfg.Name, s.cfg.SourceID, string(tp)).Set(float64(counts[tp]))
+	}
+}
+
+// schemaReconcileResult is the result of `operate-schema reconcile`.
+type schemaReconcileResult struct {
+	// Applied are the DDLs executed in downstream.
+	Applied []string `json:"applied"`
+	// Drifts are the remaining differences which should be resolved manually.
+	Drifts []*pb.SchemaDrift `json:"drifts"`
+}
+
+// reconcileSchema adds the missing columns and modifies the changed columns of the downstream table to match
+// the table structure in checkpoint. The extra columns and mismatched keys are only reported, because dropping
+// columns or changing keys of downstream may lose data.
+func (s *Syncer) reconcileSchema(ctx context.Context, sourceTable *filter.Table) (string, error) {
+	// the task is paused, so we get the table structure from checkpoint like `get` operation.
+	ti := s.checkpoint.GetTableInfo(sourceTable.Schema, sourceTable.Name)
+	if ti == nil {
+		return "", terror.ErrSchemaTrackerCannotGetTable.Generate(sourceTable)
+	}
+	tctx := s.tctx.WithContext(ctx)
+	targetTable := s.route(sourceTable)
+	drifts, err := s.checkTableSchemaDrift(tctx, sourceTable, ti)
+	if err != nil {
+		return "", err
+	}
+
+	result := &schemaReconcileResult{}
+	var columnDefs map[string]string
+	for _, drift := range drifts {
+		var action string
+		switch schema.DriftType(drift.Type) {
+		case schema.DriftMissingColumn:
+			action = "ADD COLUMN"
+		case schema.DriftTypeChange:
+			action = "MODIFY COLUMN"
+		default:
+			continue
+		}
+		if columnDefs == nil {
+			if columnDefs, err = s.genColumnDefs(ti); err != nil {
+				return "", err
+			}
+		}
+		result.Applied = append(result.Applied,
+			fmt.Sprintf("ALTER TABLE %s %s %s", targetTable, action, columnDefs[strings.ToLower(drift.Name)]))
+	}
+
+	if len(result.Applied) > 0 {
+		s.tctx.L().Info("reconcile downstream table", zap.Stringer("table", targetTable), zap.Strings("DDLs", result.Applied))
+		if _, err = s.ddlDBConn.ExecuteSQL(tctx, s.metricsProxies, result.Applied); err != nil {
+			return "", err
+		}
+		s.schemaTracker.RemoveDownstreamSchema(tctx, []*filter.Table{targetTable})
+		if drifts, err = s.checkTableSchemaDrift(tctx, sourceTable, ti); err != nil {
+			return "", err
+		}
+	}
+	result.Drifts = drifts
+	s.schemaDrift.setTable(sourceTable.String(), drifts)
+	s.updateSchemaDriftMetric()
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", terror.ErrSchemaTrackerMarshalJSON.Delegate(err, result)
+	}
+	return string(resultJSON), nil
+}
+
+// genColumnDefs generates the column definitions from the `CREATE TABLE` statement of the table info,
+// mapped to the lower case column names.
+func (s *Syncer) genColumnDefs(ti *model.TableInfo) (map[string]string, error) {
+	createTable := bytes.NewBuffer(make([]byte, 0, 512))
+	if err := executor.ConstructResultOfShowCreateTable(s.sessCtx, ti, autoid.Allocators{}, createTable); err != nil {
+		return nil, terror.ErrSchemaTrackerRestoreStmtFail.Delegate(err)
+	}
+	node, err := parser.New().ParseOneStmt(createTable.String(), "", "")
+	if err != nil {
+		return nil, terror.ErrSchemaTrackerInvalidCreateTableStmt.Delegate(err, createTable.String())
+	}
+	stmt, ok := node.(*ast.CreateTableStmt)
+	if !ok {
+		return nil, terror.ErrSchemaTrackerInvalidCreateTableStmt.Generate(createTable.String())
+	}
+	defs := make(map[string]string, len(stmt.Cols))
+	for _, col := range stmt.Cols {
+		var sb strings.Builder
+		if err = col.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+			return nil, terror.ErrSchemaTrackerRestoreStmtFail.Delegate(err)
+		}
+		defs[col.Name.Name.L] = sb.String()
+	}
+	return defs, nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	regexprrouter "github.com/pingcap/tidb/pkg/util/regexpr-router"
+	router "github.com/pingcap/tidb/pkg/util/table-router"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/transform"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type driftCheckpoint struct {
+	CheckPoint
+	table *filter.Table
+	ti    *model.TableInfo
+}
+
+func (c *driftCheckpoint) TablePoint() map[string]map[string]binlog.Location {
+	return map[string]map[string]binlog.Location{c.table.Schema: {c.table.Name: binlog.Location{}}}
+}
+
+func (c *driftCheckpoint) GetTableInfo(schema string, table string) *model.TableInfo {
+	if schema == c.table.Schema && table == c.table.Name {
+		return c.ti
+	}
+	return nil
+}
+
+func (c *driftCheckpoint) FlushedGlobalPoint() binlog.Location {
+	return binlog.Location{}
+}
+
+func TestSchemaDrift(t *testing.T) {
+	ctx := context.Background()
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.SchemaDriftCheckInterval = "1m"
+	s := NewSyncer(cfg, nil, nil)
+	require.Equal(t, time.Minute, s.schemaDrift.interval)
+
+	var err error
+	s.tableRouter, err = regexprrouter.NewRegExprRouter(false, []*router.TableRule{
+		{SchemaPattern: "db", TablePattern: "tb", TargetSchema: "db", TargetTable: "tb_target"},
+	})
+	require.NoError(t, err)
+	s.sessCtx = utils.NewSessionCtx(nil)
+	s.metricsProxies = metrics.DefaultMetricsProxies.CacheForOneTask(cfg.Name, "worker", cfg.SourceID)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	dbConn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	s.ddlDBConn = dbconn.NewDBConn(cfg, conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}))
+	s.schemaDriftConn = s.ddlDBConn
+	s.schemaTracker, err = schema.NewTestTracker(ctx, cfg.Name, s.ddlDBConn, log.L())
+	require.NoError(t, err)
+	defer s.schemaTracker.Close()
+
+	sourceTable := &filter.Table{Schema: "db", Name: "tb"}
+	require.NoError(t, s.schemaTracker.CreateSchemaIfNotExists("db"))
+	stmt, err := parser.New().ParseOneStmt("create table db.tb (id int primary key, a varchar(20), b int)", "", "")
+	require.NoError(t, err)
+	require.NoError(t, s.schemaTracker.Exec(ctx, "db", stmt))
+	ti, err := s.schemaTracker.GetTableInfo(sourceTable)
+	require.NoError(t, err)
+	s.checkpoint = &driftCheckpoint{table: sourceTable, ti: ti}
+
+	showCreateTable := regexp.QuoteMeta("SHOW CREATE TABLE `db`.`tb_target`")
+	// every check sets the sql_mode and fetches the downstream table structure
+	expectFetch := func(createTable string) {
+		mock.ExpectBegin()
+		mock.ExpectExec(fmt.Sprintf("SET SESSION SQL_MODE = '%s'", mysql.DefaultSQLMode)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectQuery(showCreateTable).WillReturnRows(
+			sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("tb_target", createTable))
+	}
+	driftedTable := "CREATE TABLE `tb_target` (`id` int NOT NULL, `a` varchar(10), `c` int, PRIMARY KEY (`id`))"
+	expectFetch(driftedTable)
+
+	// the periodic check
+	s.checkSchemaDrift(ctx)
+	expected := []*pb.SchemaDrift{
+		{
+			SourceTable: "`db`.`tb`", TargetTable: "`db`.`tb_target`", Type: string(schema.DriftTypeChange),
+			Name: "a", Tracked: "varchar(20)", Downstream: "varchar(10)",
+		},
+		{
+			SourceTable: "`db`.`tb`", TargetTable: "`db`.`tb_target`", Type: string(schema.DriftMissingColumn),
+			Name: "b", Tracked: "int(11)",
+		},
+		{
+			SourceTable: "`db`.`tb`", TargetTable: "`db`.`tb_target`", Type: string(schema.DriftExtraColumn),
+			Name: "c", Downstream: "int(11)",
+		},
+	}
+	require.Equal(t, expected, s.Status(nil).(*pb.SyncStatus).SchemaDrifts)
+	gauge := s.metricsProxies.SchemaDriftGauge
+	require.Equal(t, 1.0, testutil.ToFloat64(gauge.WithLabelValues(cfg.Name, cfg.SourceID, string(schema.DriftMissingColumn))))
+	require.Equal(t, 0.0, testutil.ToFloat64(gauge.WithLabelValues(cfg.Name, cfg.SourceID, string(schema.DriftIndexMismatch))))
+
+	// reconcile adds the missing column and modifies the changed column
+	expectFetch(driftedTable)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `db`.`tb_target` MODIFY COLUMN `a` VARCHAR(20) DEFAULT NULL")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `db`.`tb_target` ADD COLUMN `b` INT(11) DEFAULT NULL")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	reconciledTable := "CREATE TABLE `tb_target` (`id` int NOT NULL, `a` varchar(20), `c` int, `b` int, PRIMARY KEY (`id`))"
+	expectFetch(reconciledTable)
+	msg, err := s.OperateSchema(ctx, &pb.OperateWorkerSchemaRequest{Op: pb.SchemaOp_ReconcileSchema, Database: "db", Table: "tb"})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	var result schemaReconcileResult
+	require.NoError(t, json.Unmarshal([]byte(msg), &result))
+	require.Len(t, result.Applied, 2)
+	require.Equal(t, expected[2:], result.Drifts)
+	require.Equal(t, expected[2:], s.Status(nil).(*pb.SyncStatus).SchemaDrifts)
+	require.Equal(t, 0.0, testutil.ToFloat64(gauge.WithLabelValues(cfg.Name, cfg.SourceID, string(schema.DriftMissingColumn))))
+	require.Equal(t, 1.0, testutil.ToFloat64(gauge.WithLabelValues(cfg.Name, cfg.SourceID, string(schema.DriftExtraColumn))))
+
+	// the extended column added by route rules is not a drift
+	s.tableRouter, err = regexprrouter.NewRegExprRouter(false, []*router.TableRule{
+		{
+			SchemaPattern: "db", TablePattern: "tb", TargetSchema: "db", TargetTable: "tb_target",
+			TableExtractor: &router.TableExtractor{TargetColumn: "c", TableRegexp: "(.*)"},
+		},
+	})
+	require.NoError(t, err)
+	expectFetch(reconciledTable)
+	s.checkSchemaDrift(ctx)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, s.Status(nil).(*pb.SyncStatus).SchemaDrifts)
+
+	// the type change of transformed column is not a drift
+	s.schemaTracker.RemoveDownstreamSchema(s.tctx, []*filter.Table{{Schema: "db", Name: "tb_target"}})
+	widenedTable := "CREATE TABLE `tb_target` (`id` int NOT NULL, `a` varchar(64), `c` int, `b` int, PRIMARY KEY (`id`))"
+	expectFetch(widenedTable)
+	s.columnTransforms = transform.NewGroup(s.sessCtx, []*config.ColumnTransform{
+		{Schema: "db", Table: "tb", Column: "a", Type: config.ColumnTransformHash},
+	}, log.L())
+	s.checkSchemaDrift(ctx)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, s.Status(nil).(*pb.SyncStatus).SchemaDrifts)
+
+	// the extra columns of a shard-merged table are not drifts
+	s.columnTransforms = nil
+	s.tableRouter, err = regexprrouter.NewRegExprRouter(false, []*router.TableRule{
+		{SchemaPattern: "db", TablePattern: "tb", TargetSchema: "db", TargetTable: "tb_target"},
+	})
+	require.NoError(t, err)
+	s.cfg.ShardMode = config.ShardOptimistic
+	expectFetch(widenedTable)
+	s.checkSchemaDrift(ctx)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, []*pb.SchemaDrift{
+		{
+			SourceTable: "`db`.`tb`", TargetTable: "`db`.`tb_target`", Type: string(schema.DriftTypeChange),
+			Name: "a", Tracked: "varchar(20)", Downstream: "varchar(64)",
+		},
+	}, s.Status(nil).(*pb.SyncStatus).SchemaDrifts)
+
+	// the table whose structure changes are filtered is not checked
+	s.binlogFilter, err = bf.NewBinlogEvent(false, []*bf.BinlogEventRule{
+		{SchemaPattern: "db", TablePattern: "tb", Events: []bf.EventType{bf.AlterTable}, Action: bf.Ignore},
+	})
+	require.NoError(t, err)
+	s.checkSchemaDrift(ctx)
+	require.Empty(t, s.Status(nil).(*pb.SyncStatus).SchemaDrifts)
+
+	// the table is not in checkpoint
+	_, err = s.OperateSchema(ctx, &pb.OperateWorkerSchemaRequest{Op: pb.SchemaOp_ReconcileSchema, Database: "db", Table: "tb2"})
+	require.Error(t, err)
+}
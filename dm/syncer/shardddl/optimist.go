@@ -37,6 +37,8 @@ type Optimist struct {
 	cli    *clientv3.Client
 	task   string
 	source string
+	// strategies to resolve the conflicts of shard DDLs automatically.
+	autoResolve []string
 
 	tables optimism.SourceTables
 
@@ -51,12 +53,13 @@ type Optimist struct {
 }
 
 // NewOptimist creates a new Optimist instance.
-func NewOptimist(pLogger *log.Logger, cli *clientv3.Client, task, source string) *Optimist {
+func NewOptimist(pLogger *log.Logger, cli *clientv3.Client, task, source string, autoResolve []string) *Optimist {
 	return &Optimist{
-		logger: pLogger.WithFields(zap.String("component", "shard DDL optimist")),
-		cli:    cli,
-		task:   task,
-		source: source,
+		logger:      pLogger.WithFields(zap.String("component", "shard DDL optimist")),
+		cli:         cli,
+		task:        task,
+		source:      source,
+		autoResolve: autoResolve,
 	}
 }
 
@@ -112,7 +115,9 @@ func (o *Optimist) Reset() {
 func (o *Optimist) ConstructInfo(upSchema, upTable, downSchema, downTable string,
 	ddls []string, tiBefore *model.TableInfo, tisAfter []*model.TableInfo,
 ) optimism.Info {
-	return optimism.NewInfo(o.task, o.source, upSchema, upTable, downSchema, downTable, ddls, tiBefore, tisAfter)
+	info := optimism.NewInfo(o.task, o.source, upSchema, upTable, downSchema, downTable, ddls, tiBefore, tisAfter)
+	info.AutoResolve = o.autoResolve
+	return info
 }
 
 // PutInfo puts the shard DDL info into etcd and returns the revision.
@@ -60,6 +60,8 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/streamer"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/throttle"
+	"github.com/pingcap/tiflow/dm/pkg/transform"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/relay"
 	"github.com/pingcap/tiflow/dm/syncer/binlogstream"
@@ -151,6 +153,10 @@ type Syncer struct {
 	ddlDB               *conn.BaseDB
 	ddlDBConn           *dbconn.DBConn
 	downstreamTrackConn *dbconn.DBConn
+	// used by the schema drift check, so the check doesn't block the downstream tracker
+	schemaDriftConn *dbconn.DBConn
+	// not nil when writing to a message queue, then the target database only stores the meta data
+	mqSink *mqSink
 
 	dmlJobCh            chan *job
 	ddlJobCh            chan *job
@@ -164,7 +170,9 @@ type Syncer struct {
 	binlogFilter    *bf.BinlogEvent
 	baList          *filter.Filter
 	exprFilterGroup *ExprFilterGroup
-	sessCtx         sessionctx.Context
+	// rewrite column values after expression filters, nil if there's no column transform
+	columnTransforms *transform.Group
+	sessCtx          sessionctx.Context
 
 	running atomic.Bool
 	closed  atomic.Bool
@@ -234,6 +242,26 @@ type Syncer struct {
 	// the minimal timestamp of currently processing binlog events.
 	// this lag will consider time difference between upstream and DM nodes
 	secondsBehindMaster atomic.Int64
+	// delay holds binlog events in delayed replication mode, nil if not enabled.
+	delay *delayController
+	// locationPin holds binlog events at the location pinned by the snapshot checker.
+	locationPin *locationPin
+	// tableResyncer tracks the tables which are being resynced.
+	tableResyncer *tableResyncer
+	// schemaDrift keeps the differences between the tracked tables and the downstream tables.
+	schemaDrift *schemaDriftChecker
+	// tableStats tracks the replication statistics of every downstream table.
+	tableStats *tableStatsTracker
+
+	rulesUpdateMu sync.Mutex
+	// pendingRulesUpdate is the update of rules waiting to be prepared by the main routine.
+	pendingRulesUpdate *rulesUpdate
+	// preparedRulesUpdate is the update of rules waiting to be committed or aborted.
+	preparedRulesUpdate *rulesUpdate
+	// throttle limits the rate of DML workers writing to the downstream.
+	throttle *throttle.Limiter
+	// conflictResolver resolves conflicts of rows from different sources, nil if there's no conflict rule.
+	conflictResolver *conflictResolver
 	// stores the last job TS(binlog event timestamp) of each worker,
 	// if there's no active job, the corresponding worker's TS is reset to 0.
 	// since DML worker runs jobs in batch, the TS is the TS of the first job in the batch.
@@ -273,7 +301,7 @@ func NewSyncer(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, relay rel
 
 	syncer := &Syncer{
 		pessimist: shardddl.NewPessimist(&logger, etcdClient, cfg.Name, cfg.SourceID),
-		optimist:  shardddl.NewOptimist(&logger, etcdClient, cfg.Name, cfg.SourceID),
+		optimist:  shardddl.NewOptimist(&logger, etcdClient, cfg.Name, cfg.SourceID, cfg.ShardAutoResolve),
 	}
 	syncer.cfg = cfg
 	syncer.tctx = tcontext.Background().WithLogger(logger)
@@ -307,6 +335,16 @@ func NewSyncer(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, relay rel
 	syncer.lastCheckpointFlushedTime = time.Time{}
 	syncer.relay = relay
 	syncer.safeMode = sm.NewSafeMode()
+	// delay has been checked in SubTaskConfig.Adjust
+	if delay, err := time.ParseDuration(cfg.Delay); err == nil && delay > 0 {
+		syncer.delay = newDelayController(delay, &syncer.tsOffset)
+	}
+	syncer.locationPin = newLocationPin()
+	syncer.tableResyncer = newTableResyncer(logger, cfg.EnableGTID, syncer.dumpAndLoadTables, syncer.saveResyncState)
+	syncer.schemaDrift = newSchemaDriftChecker(cfg.SchemaDriftCheckInterval)
+	syncer.tableStats = newTableStatsTracker(cfg.TableMetricsLimit)
+	syncer.throttle = throttle.NewLimiter(logger, cfg.SyncerConfig.Throttle.RowsPerSecond,
+		cfg.SyncerConfig.Throttle.BytesLimit(), cfg.SyncerConfig.Throttle.TargetLatency.Duration)
 
 	return syncer
 }
@@ -397,6 +435,14 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	rollbackHolder.Add(fr.FuncRollback{Name: "close-DBs", Fn: s.closeDBs})
 
+	if s.cfg.TargetMQ != nil {
+		s.mqSink, err = newMQSink(s.cfg, s.tctx.L())
+		if err != nil {
+			return err
+		}
+		rollbackHolder.Add(fr.FuncRollback{Name: "close-MQ-sink", Fn: s.closeMQSink})
+	}
+
 	if s.cfg.CollationCompatible == config.StrictCollationCompatible {
 		s.charsetAndDefaultCollation, s.idAndCollationMap, err = dbconn.GetCharsetAndCollationInfo(tctx, s.fromConn)
 		if err != nil {
@@ -424,6 +470,7 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	s.sessCtx = utils.NewSessionCtx(vars)
 	s.exprFilterGroup = NewExprFilterGroup(s.tctx, s.sessCtx, s.cfg.ExprFilter)
+	s.columnTransforms = transform.NewGroup(s.sessCtx, s.cfg.ColumnTransforms, s.tctx.L())
 	// create an empty Tracker and will be initialized in `Run`
 	s.schemaTracker = schema.NewTracker()
 
@@ -479,6 +526,7 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	s.tableResyncer.restore(s.checkpoint.ResyncState())
 	if s.SourceTableNamesFlavor == conn.LCTableNamesSensitive {
 		if err = s.checkpoint.CheckAndUpdate(ctx, schemaMap, tableMap); err != nil {
 			return err
@@ -508,6 +556,11 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	s.metricsProxies = metricProxies.CacheForOneTask(s.cfg.Name, s.cfg.WorkerName, s.cfg.SourceID)
 
+	s.conflictResolver = newConflictResolver(s.cfg, s.tctx.Logger)
+	if err = s.conflictResolver.createTable(tctx, s.ddlDBConn, s.metricsProxies); err != nil {
+		return err
+	}
+
 	s.ddlWorker = NewDDLWorker(&s.tctx.Logger, s)
 	return nil
 }
@@ -692,6 +745,11 @@ func (s *Syncer) resetDBs(tctx *tcontext.Context) error {
 		return terror.WithScope(err, terror.ScopeDownstream)
 	}
 
+	err = s.schemaDriftConn.ResetConn(tctx)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+
 	err = s.checkpoint.ResetConn(tctx)
 	if err != nil {
 		return terror.WithScope(err, terror.ScopeDownstream)
@@ -808,7 +866,12 @@ func (s *Syncer) getTableInfo(tctx *tcontext.Context, sourceTable, targetTable *
 	}
 
 	// if the table does not exist (IsTableNotExists(err)), continue to fetch the table from downstream and create it.
-	err = s.trackTableInfoFromDownstream(tctx, sourceTable, targetTable)
+	// there is no downstream table when writing to a message queue, so use the table info in the checkpoint.
+	if s.mqSink != nil {
+		err = s.trackTableInfoFromCheckpoint(tctx, sourceTable)
+	} else {
+		err = s.trackTableInfoFromDownstream(tctx, sourceTable, targetTable)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -822,6 +885,10 @@ func (s *Syncer) getTableInfo(tctx *tcontext.Context, sourceTable, targetTable *
 
 // getDBInfoFromDownstream tries to track the db info from the downstream. It will not overwrite existing table.
 func (s *Syncer) getDBInfoFromDownstream(tctx *tcontext.Context, sourceTable, targetTable *filter.Table) (*model.DBInfo, error) {
+	if s.mqSink != nil {
+		// there is no downstream database when writing to a message queue.
+		return nil, nil
+	}
 	// TODO: Switch to use the HTTP interface to retrieve the TableInfo directly if HTTP port is available
 	// use parser for downstream.
 	parser2, err := dbconn.GetParserForConn(tctx, s.ddlDBConn)
@@ -908,6 +975,21 @@ func (s *Syncer) trackTableInfoFromDownstream(tctx *tcontext.Context, sourceTabl
 	return nil
 }
 
+// trackTableInfoFromCheckpoint tracks the table info saved in the checkpoint, which is the table structure
+// at the binlog location. It's used when writing to a message queue, because there is no downstream table
+// and the current upstream table may be changed after the location.
+func (s *Syncer) trackTableInfoFromCheckpoint(tctx *tcontext.Context, sourceTable *filter.Table) error {
+	ti := s.checkpoint.GetTableInfo(sourceTable.Schema, sourceTable.Name)
+	if ti == nil {
+		return terror.ErrSyncerMQTableInfoNotFound.Generate(sourceTable)
+	}
+	tctx.L().Debug("track table schema from checkpoint", zap.Stringer("sourceTable", sourceTable))
+	if err := s.schemaTracker.CreateTableIfNotExists(sourceTable, ti); err != nil {
+		return terror.ErrSchemaTrackerCannotCreateTable.Delegate(err, sourceTable)
+	}
+	return nil
+}
+
 var dmlMetric = map[sqlmodel.RowChangeType]string{
 	sqlmodel.RowChangeInsert: "insert",
 	sqlmodel.RowChangeUpdate: "update",
@@ -1057,6 +1139,11 @@ func (s *Syncer) addJob(job *job) {
 	// avoid job.type data race with compactor.run()
 	// simply copy the opType for performance, though copy a new job in compactor is better
 	tp := job.tp
+	// the commit ts is assigned in the order of binlog events, so the events after a checkpoint always have greater
+	// commit ts than the checkpoint ts written after the checkpoint is flushed.
+	if s.mqSink != nil && (tp == dml || tp == ddl) {
+		job.commitTs = s.mqSink.nextCommitTs(job.eventHeader, len(job.ddls))
+	}
 	switch tp {
 	case flush:
 		s.jobWg.Add(1)
@@ -1238,6 +1325,7 @@ func (s *Syncer) saveGlobalPoint(globalLocation binlog.Location) {
 	} else if s.cfg.ShardMode == config.ShardOptimistic {
 		globalLocation = s.osgk.AdjustGlobalLocation(globalLocation)
 	}
+	globalLocation = s.tableResyncer.adjustGlobalLocation(globalLocation)
 	s.checkpoint.SaveGlobalPoint(globalLocation)
 }
 
@@ -1293,6 +1381,9 @@ func (s *Syncer) flushCheckPoints() error {
 		asyncflushJob:  nil,
 		syncFlushErrCh: syncFlushErrCh,
 	}
+	if s.mqSink != nil {
+		task.mqCheckpointTs = s.mqSink.lastCommitTs()
+	}
 	s.checkpointFlushWorker.Add(task)
 
 	return <-syncFlushErrCh
@@ -1327,6 +1418,9 @@ func (s *Syncer) flushCheckPointsAsync(asyncFlushJob *job) {
 		asyncflushJob:  asyncFlushJob,
 		syncFlushErrCh: nil,
 	}
+	if s.mqSink != nil {
+		task.mqCheckpointTs = s.mqSink.lastCommitTs()
+	}
 	s.checkpointFlushWorker.Add(task)
 }
 
@@ -1352,11 +1446,19 @@ func (s *Syncer) createCheckpointSnapshot(isSyncFlush bool) (*SnapshotInfo, []*f
 		shardMetaSQLs, shardMetaArgs = s.sgk.PrepareFlushSQLs(exceptTableIDs)
 		s.tctx.L().Info("prepare flush sqls", zap.Strings("shard meta sqls", shardMetaSQLs), zap.Reflect("shard meta arguments", shardMetaArgs))
 	}
+	// the saved table resync state is deleted along with the checkpoints after the tables rejoin the main stream.
+	if s.tableResyncer.needDeleteState(snapshotInfo.id) {
+		sql2, arg := s.checkpoint.GenDeleteResyncStateSQL()
+		shardMetaSQLs = append(shardMetaSQLs, sql2)
+		shardMetaArgs = append(shardMetaArgs, arg)
+	}
 
 	return snapshotInfo, exceptTables, shardMetaSQLs, shardMetaArgs
 }
 
 func (s *Syncer) afterFlushCheckpoint(task *checkpointFlushTask) error {
+	s.tableResyncer.afterFlush(task.snapshotInfo.id)
+
 	// add a gc job to let causality module gc outdated kvs.
 	if task.asyncflushJob != nil {
 		s.tctx.L().Info("after async flushed checkpoint, gc stale causality keys", zap.Int64("flush job seq", task.asyncflushJob.flushSeq))
@@ -1366,6 +1468,13 @@ func (s *Syncer) afterFlushCheckpoint(task *checkpointFlushTask) error {
 		s.addJob(newGCJob(math.MaxInt64))
 	}
 
+	// let the consumers of the message queue know all events before the checkpoint have been written.
+	if s.mqSink != nil && task.mqCheckpointTs > 0 {
+		if err := s.mqSink.writeCheckpointTs(s.syncCtx.Ctx, task.mqCheckpointTs); err != nil {
+			s.tctx.L().Warn("failed to write checkpoint ts to kafka", zap.Uint64("checkpoint ts", task.mqCheckpointTs), zap.Error(err))
+		}
+	}
+
 	// update current active relay log after checkpoint flushed
 	err := s.updateActiveRelayLog(task.snapshotInfo.globalPos.Position)
 	if err != nil {
@@ -1452,7 +1561,9 @@ func (s *Syncer) syncDDL(queueBucket string, db *dbconn.DBConn, ddlJobChan chan
 			failpoint.Goto("bypass")
 		})
 
-		if !ignore {
+		if !ignore && s.mqSink != nil {
+			err = s.mqSink.writeDDLs(s.syncCtx.Ctx, ddlJob, s.getTrackedTableInfo)
+		} else if !ignore {
 			failpoint.Inject("SkipSaveGlobalPoint", func() {
 				s.tctx.L().Info("skip save global point", zap.String("failpoint", "SkipSaveGlobalPoint"))
 				panic("SkipSaveGlobalPoint")
@@ -1573,7 +1684,7 @@ func (s *Syncer) syncDDL(queueBucket string, db *dbconn.DBConn, ddlJobChan chan
 	}
 }
 
-func (s *Syncer) successFunc(queueID int, statementsCnt int, jobs []*job) {
+func (s *Syncer) successFunc(queueID int, statementsCnt int, jobs []*job, execLatency time.Duration) {
 	queueBucket := queueBucketName(queueID)
 	if len(jobs) > 0 {
 		// NOTE: we can use the first job of job queue to calculate lag because when this job committed,
@@ -1593,6 +1704,9 @@ func (s *Syncer) successFunc(queueID int, statementsCnt int, jobs []*job) {
 	for _, sqlJob := range jobs {
 		s.updateJobMetrics(true, queueBucket, sqlJob)
 	}
+	if s.tableStats != nil {
+		s.updateTableStats(jobs, execLatency)
+	}
 	s.updateReplicationJobTS(nil, dmlWorkerJobIdx(queueID))
 	s.metricsProxies.ReplicationTransactionBatch.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID, queueBucket, "statements").Observe(float64(statementsCnt))
 	s.metricsProxies.ReplicationTransactionBatch.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID, queueBucket, "rows").Observe(float64(len(jobs)))
@@ -1737,8 +1851,11 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	s.runCtx, s.runCancel = tcontext.NewContext(runCtx, s.tctx.L()), runCancel
 	syncCtx, syncCancel := context.WithCancel(context.Background())
 	s.syncCtx, s.syncCancel = tcontext.NewContext(syncCtx, s.tctx.L()), syncCancel
+	s.tableResyncer.open(runCtx)
 	defer func() {
 		s.runCancel()
+		s.tableResyncer.close()
+		s.cancelRulesUpdate()
 		s.closeJobChans()
 		s.checkpointFlushWorker.Close()
 		s.runWg.Wait()
@@ -1817,6 +1934,11 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	go s.updateLagCronJob(s.runCtx.Ctx)
 	s.runWg.Add(1)
 	go s.updateTSOffsetCronJob(s.runCtx.Ctx)
+	// there's no downstream table to compare with when writing to message queue.
+	if s.schemaDrift.interval > 0 && s.mqSink == nil {
+		s.runWg.Add(1)
+		go s.checkSchemaDriftCronJob(s.runCtx.Ctx)
+	}
 
 	// some prepare work before the binlog event loop:
 	// 1. first we flush checkpoint as needed, so in next resume we won't go to Load unit.
@@ -2075,6 +2197,21 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 				}
 			}
 		})
+		// move forward the table resync at the end of a transaction, it may redirect global
+		// stream to re-replicate the resynced tables.
+		if len(shardingReSyncCh) == 0 && shardingReSync == nil &&
+			binlog.ComparePosition(endLocation.Position, lastTxnEndLocation.Position) == 0 {
+			if err = s.checkTableResync(lastTxnEndLocation, &shardingReSyncCh); err != nil {
+				return err
+			}
+			// apply the new rules at the end of a transaction, it redirects global stream
+			// to re-read the binlog from lastTxnEndLocation.
+			if len(shardingReSyncCh) == 0 {
+				if err = s.checkRulesUpdate(lastTxnEndLocation); err != nil {
+					return err
+				}
+			}
+		}
 		// fetch from sharding resync channel if needed, and redirect global
 		// stream to current binlog position recorded by ShardingReSync
 		if len(shardingReSyncCh) > 0 && shardingReSync == nil {
@@ -2227,6 +2364,18 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 
 		// time duration for reading an event from relay log or upstream master.
 		s.metricsProxies.Metrics.BinlogReadDurationHistogram.Observe(time.Since(startTime).Seconds())
+
+		// in delayed replication mode, hold the event before processing it, so checkpoint and
+		// safe mode are not affected. only flush the checkpoint of executed jobs when holding.
+		if s.delay != nil && e.Header.Timestamp != 0 {
+			if err = s.delay.wait(s.runCtx, int64(e.Header.Timestamp), s.flushIfOutdated); err != nil {
+				if err == context.Canceled {
+					s.tctx.L().Info("binlog replication main routine quit(context canceled) when holding delayed event!", zap.Stringer("last location", lastTxnEndLocation))
+					return nil
+				}
+				return err
+			}
+		}
 		startTime = time.Now() // reset start time for the next metric.
 
 		s.metricsProxies.Metrics.BinlogSyncerPosGauge.Set(float64(e.Header.LogPos))
@@ -2244,6 +2393,14 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		s.tctx.L().Debug("receive binlog event", zap.Reflect("header", e.Header))
 
 		startLocation := s.streamerController.GetCurStartLocation()
+		// hold the event while the snapshot checker compares the downstream at the pinned location.
+		if err = s.locationPin.wait(s.runCtx, startLocation, s.cfg.EnableGTID, s.flushJobs); err != nil {
+			if err == context.Canceled {
+				s.tctx.L().Info("binlog replication main routine quit(context canceled) when holding event at pinned location!", zap.Stringer("last location", lastTxnEndLocation))
+				return nil
+			}
+			return err
+		}
 		endLocation = s.streamerController.GetCurEndLocation()
 		lastTxnEndLocation = s.streamerController.GetTxnEndLocation()
 
@@ -2587,6 +2744,11 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		}
 	}
 
+	if s.tableResyncer.skipping(sourceTable) {
+		ec.tctx.L().Debug("skip event of resyncing table", zap.String("event", "row"), zap.Stringer("source table", sourceTable))
+		return nil, s.recordSkipSQLsLocation(&ec)
+	}
+
 	// For DML position before table checkpoint, ignore it. When the position equals to table checkpoint, this event may
 	// be partially replicated to downstream, we rely on safe-mode to handle it.
 	if s.checkpoint.IsOlderThanTablePoint(sourceTable, ec.endLocation) {
@@ -2630,10 +2792,6 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		return nil, terror.WithScope(err, terror.ScopeDownstream)
 	}
 	originRows := ev.Rows
-	if err2 := checkLogColumns(ev.SkippedColumns); err2 != nil {
-		return nil, err2
-	}
-
 	extRows := generateExtendColumn(originRows, s.tableRouter, sourceTable, s.cfg.SourceID)
 
 	var dmls []*sqlmodel.RowChange
@@ -2644,6 +2802,7 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		sourceTableInfo: tableInfo,
 		sourceTable:     sourceTable,
 		extendData:      extRows,
+		skippedColumns:  ev.SkippedColumns,
 	}
 
 	switch ec.header.EventType {
@@ -2660,7 +2819,8 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		}
 		s.metricsProxies.BinlogEventCost.WithLabelValues(metrics.BinlogEventCostStageGenWriteRows, s.cfg.Name, s.cfg.WorkerName, s.cfg.SourceID).Observe(time.Since(ec.startTime).Seconds())
 
-	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2,
+		replication.PARTIAL_UPDATE_ROWS_EVENT:
 		oldExprFilter, newExprFilter, err2 := s.exprFilterGroup.GetUpdateExprs(sourceTable, tableInfo)
 		if err2 != nil {
 			return nil, err2
@@ -2901,6 +3061,7 @@ func (s *Syncer) trackDDL(usedSchema string, trackInfo *ddlInfo, ec *eventContex
 			return terror.ErrSchemaTrackerCannotExecDDL.Delegate(err, trackInfo.originDDL)
 		}
 		s.exprFilterGroup.ResetExprs(srcTable)
+		s.columnTransforms.ResetExprs(srcTable)
 	}
 
 	return nil
@@ -3152,7 +3313,7 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 	dbCfg.RawDBCfg = dbconfig.DefaultRawDBConfig().SetReadTimeout(maxDDLConnectionTimeout)
 
 	var ddlDBConns []*dbconn.DBConn
-	s.ddlDB, ddlDBConns, err = dbconn.CreateConns(s.tctx, s.cfg, conn.DownstreamDBConfig(&dbCfg), 2, s.cfg.IOTotalBytes, s.cfg.UUID)
+	s.ddlDB, ddlDBConns, err = dbconn.CreateConns(s.tctx, s.cfg, conn.DownstreamDBConfig(&dbCfg), 3, s.cfg.IOTotalBytes, s.cfg.UUID)
 	if err != nil {
 		dbconn.CloseUpstreamConn(s.tctx, s.fromDB)
 		dbconn.CloseBaseDB(s.tctx, s.toDB)
@@ -3160,6 +3321,7 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 	}
 	s.ddlDBConn = ddlDBConns[0]
 	s.downstreamTrackConn = ddlDBConns[1]
+	s.schemaDriftConn = ddlDBConns[2]
 	printServerVersion(s.tctx, s.fromDB.BaseDB, "upstream")
 	printServerVersion(s.tctx, s.toDB, "downstream")
 
@@ -3173,6 +3335,12 @@ func (s *Syncer) closeDBs() {
 	dbconn.CloseBaseDB(s.tctx, s.ddlDB)
 }
 
+func (s *Syncer) closeMQSink() {
+	if s.mqSink != nil {
+		s.mqSink.close()
+	}
+}
+
 // record skip ddl/dml sqls' position
 // make newJob's sql argument empty to distinguish normal sql and skips sql.
 func (s *Syncer) recordSkipSQLsLocation(ec *eventContext) error {
@@ -3229,6 +3397,7 @@ func (s *Syncer) Close() {
 	}
 	s.stopSync()
 	s.closeDBs()
+	s.closeMQSink()
 	s.checkpoint.Close()
 	s.schemaTracker.Close()
 	if s.sgk != nil {
@@ -3447,6 +3616,7 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 	}
 	// update syncer config
 	s.cfg.SyncerConfig = cfg.SyncerConfig
+	s.UpdateThrottle(cfg.SyncerConfig.Throttle)
 
 	// updated fileds that changed in func `copyConfigFromSource`
 	s.cfg.From = cfg.From
@@ -3459,6 +3629,11 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 	return nil
 }
 
+// UpdateThrottle updates the throttle limits of DML workers at runtime.
+func (s *Syncer) UpdateThrottle(cfg config.ThrottleConfig) {
+	s.throttle.Update(cfg.RowsPerSecond, cfg.BytesLimit(), cfg.TargetLatency.Duration)
+}
+
 // checkpointID returns ID which used for checkpoint table.
 func (s *Syncer) checkpointID() string {
 	if len(s.cfg.SourceID) > 0 {
@@ -3673,6 +3848,13 @@ func (s *Syncer) getTrackedTableInfo(table *filter.Table) (*model.TableInfo, err
 }
 
 func (s *Syncer) getDownStreamTableInfo(tctx *tcontext.Context, tableID string, originTI *model.TableInfo) (*schema.DownstreamTableInfo, error) {
+	if s.mqSink != nil {
+		// the events written to the message queue have the same structure as the upstream table.
+		return &schema.DownstreamTableInfo{
+			TableInfo:   originTI,
+			WhereHandle: sqlmodel.GetWhereHandle(originTI, originTI),
+		}, nil
+	}
 	return s.schemaTracker.GetDownStreamTableInfo(tctx, tableID, originTI)
 }
 
@@ -0,0 +1,207 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+)
+
+// othersTableLabel is the `target_table` label of the tables exceeding `table-metrics-limit`.
+const othersTableLabel = "_others"
+
+// tableSyncStats is the replication statistics of a downstream table.
+type tableSyncStats struct {
+	rows          map[sqlmodel.RowChangeType]int64
+	bytes         int64
+	batches       int64
+	totalLatency  float64
+	maxLatency    float64
+	lastAppliedTS int64
+	lag           int64
+	// schema and table labels of the per-table metrics, empty if the table has no metrics.
+	metricLabels []string
+}
+
+// tableStatsTracker tracks the replication statistics of every downstream table of the sync unit.
+type tableStatsTracker struct {
+	// max number of tables which have their own metrics.
+	metricsLimit int
+
+	mu           sync.Mutex
+	tables       map[string]*tableSyncStats
+	metricTables int
+	// last applied binlog timestamp of the tables exceeding the limit.
+	othersLastAppliedTS int64
+}
+
+func newTableStatsTracker(metricsLimit int) *tableStatsTracker {
+	return &tableStatsTracker{
+		metricsLimit: metricsLimit,
+		tables:       make(map[string]*tableSyncStats),
+	}
+}
+
+// tableBatch is the row changes of a downstream table in a batch of finished jobs.
+type tableBatch struct {
+	key           string
+	schema, table string
+	rows          map[sqlmodel.RowChangeType]int64
+	bytes         int64
+	lastTS        int64
+}
+
+// groupDMLJobsByTable groups the finished DML jobs by the downstream table, in the order of first appearance.
+func groupDMLJobsByTable(jobs []*job) []*tableBatch {
+	var (
+		batches []*tableBatch
+		index   = make(map[string]*tableBatch)
+	)
+	for _, j := range jobs {
+		if j.tp != dml || j.dml == nil || j.targetTable == nil {
+			continue
+		}
+		key := j.targetTable.String()
+		b, ok := index[key]
+		if !ok {
+			b = &tableBatch{
+				key:    key,
+				schema: j.targetTable.Schema,
+				table:  j.targetTable.Name,
+				rows:   make(map[sqlmodel.RowChangeType]int64, 3),
+			}
+			index[key] = b
+			batches = append(batches, b)
+		}
+		b.rows[j.dml.Type()]++
+		b.bytes += rowChangeSize(j.dml)
+		if j.eventHeader != nil && int64(j.eventHeader.Timestamp) > b.lastTS {
+			b.lastTS = int64(j.eventHeader.Timestamp)
+		}
+	}
+	return batches
+}
+
+// rowChangeSize returns the approximate size of the values of a row change.
+func rowChangeSize(row *sqlmodel.RowChange) int64 {
+	var size int64
+	for _, values := range [][]interface{}{row.GetPreValues(), row.GetPostValues()} {
+		for _, v := range values {
+			switch v := v.(type) {
+			case string:
+				size += int64(len(v))
+			case []byte:
+				size += int64(len(v))
+			default:
+				size += 8
+			}
+		}
+	}
+	return size
+}
+
+// updateTableStats updates the statistics and metrics of the downstream tables of the finished jobs.
+// The row changes of a batch are executed in one transaction, so all tables in it share the execution latency,
+// which doesn't include the time waiting in the job queues.
+func (s *Syncer) updateTableStats(jobs []*job, execLatency time.Duration) {
+	batches := groupDMLJobsByTable(jobs)
+	if len(batches) == 0 {
+		return
+	}
+	latency := execLatency.Seconds()
+	t := s.tableStats
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, b := range batches {
+		stats, ok := t.tables[b.key]
+		if !ok {
+			stats = &tableSyncStats{rows: make(map[sqlmodel.RowChangeType]int64, 3)}
+			if t.metricTables < t.metricsLimit {
+				stats.metricLabels = []string{b.schema, b.table}
+				t.metricTables++
+			} else if t.metricsLimit > 0 {
+				stats.metricLabels = []string{"", othersTableLabel}
+			}
+			t.tables[b.key] = stats
+		}
+
+		for tp, count := range b.rows {
+			stats.rows[tp] += count
+		}
+		stats.bytes += b.bytes
+		stats.batches++
+		stats.totalLatency += latency
+		if latency > stats.maxLatency {
+			stats.maxLatency = latency
+		}
+		if b.lastTS > 0 && b.lastTS >= stats.lastAppliedTS {
+			stats.lastAppliedTS = b.lastTS
+			stats.lag = s.calcReplicationLag(b.lastTS)
+		}
+
+		if stats.metricLabels == nil {
+			continue
+		}
+		labels := append([]string{s.cfg.Name, s.cfg.SourceID}, stats.metricLabels...)
+		for tp, count := range b.rows {
+			s.metricsProxies.TableRowsTotal.WithLabelValues(append(labels, dmlMetric[tp])...).Add(float64(count))
+		}
+		s.metricsProxies.TableBytesTotal.WithLabelValues(labels...).Add(float64(b.bytes))
+		s.metricsProxies.TableExecLatencyHistogram.WithLabelValues(labels...).Observe(latency)
+		if stats.metricLabels[1] == othersTableLabel {
+			// tables exceeding the limit share the gauge, keep the latest one.
+			if b.lastTS <= t.othersLastAppliedTS {
+				continue
+			}
+			t.othersLastAppliedTS = b.lastTS
+		}
+		if b.lastTS > 0 {
+			s.metricsProxies.TableLastAppliedTSGauge.WithLabelValues(labels...).Set(float64(b.lastTS))
+		}
+	}
+}
+
+// TableStatus returns the replication status of every downstream table, sorted by the table name.
+func (s *Syncer) TableStatus() []*pb.TableSyncStatus {
+	t := s.tableStats
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ret := make([]*pb.TableSyncStatus, 0, len(t.tables))
+	for table, stats := range t.tables {
+		st := &pb.TableSyncStatus{
+			Table:               table,
+			InsertRows:          stats.rows[sqlmodel.RowChangeInsert],
+			UpdateRows:          stats.rows[sqlmodel.RowChangeUpdate],
+			DeleteRows:          stats.rows[sqlmodel.RowChangeDelete],
+			Bytes:               stats.bytes,
+			MaxLatency:          stats.maxLatency,
+			LastAppliedTs:       stats.lastAppliedTS,
+			SecondsBehindMaster: stats.lag,
+		}
+		if stats.batches > 0 {
+			st.AvgLatency = stats.totalLatency / float64(stats.batches)
+		}
+		ret = append(ret, st)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Table < ret[j].Table })
+	return ret
+}
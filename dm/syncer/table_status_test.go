@@ -0,0 +1,93 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableStatus(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.Name = "test-table-status"
+	cfg.TableMetricsLimit = 1
+	cfg.WorkerCount = 2
+	s := NewSyncer(cfg, nil, nil)
+	s.metricsProxies = metrics.DefaultMetricsProxies.CacheForOneTask(cfg.Name, "worker", cfg.SourceID)
+	defer s.metricsProxies.RemoveLabelValuesWithTaskInMetrics(cfg.Name)
+	require.Empty(t, s.TableStatus())
+
+	ti := mockTableInfo(t, "create table db.tb(id int primary key, name varchar(24))")
+	newJob := func(table string, pre, post []interface{}, ts uint32, addTime time.Time) *job {
+		source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+		target := &cdcmodel.TableName{Schema: "db", Table: table}
+		return &job{
+			tp:          dml,
+			targetTable: &filter.Table{Schema: "db", Name: table},
+			dml:         sqlmodel.NewRowChange(source, target, pre, post, ti, nil, nil),
+			eventHeader: &replication.EventHeader{Timestamp: ts},
+			jobAddTime:  addTime,
+		}
+	}
+	now := time.Now()
+	// the time waiting in the job queues is not counted in the latency
+	s.successFunc(0, 3, []*job{
+		newJob("t1", nil, []interface{}{int64(1), "a"}, 1700000000, now.Add(-time.Minute)),
+		newJob("t2", []interface{}{int64(3), nil}, nil, 1700000001, now),
+		newJob("t1", []interface{}{int64(1), "a"}, []interface{}{int64(1), "bb"}, 1700000002, now),
+	}, 1500*time.Millisecond)
+	s.successFunc(0, 1, []*job{
+		newJob("t1", []interface{}{int64(1), "bb"}, nil, 1700000002, now.Add(-time.Minute)),
+	}, 500*time.Millisecond)
+	// the latest timestamp of the tables exceeding the limit is kept
+	s.successFunc(1, 1, []*job{
+		newJob("t3", nil, []interface{}{int64(4), "c"}, 1699999999, now),
+	}, time.Second)
+
+	status := s.TableStatus()
+	require.Len(t, status, 3)
+	require.Equal(t, "`db`.`t1`", status[0].Table)
+	require.Equal(t, int64(1), status[0].InsertRows)
+	require.Equal(t, int64(1), status[0].UpdateRows)
+	require.Equal(t, int64(1), status[0].DeleteRows)
+	require.Equal(t, int64(9+19+10), status[0].Bytes)
+	require.Equal(t, int64(1700000002), status[0].LastAppliedTs)
+	require.Equal(t, 1.0, status[0].AvgLatency)
+	require.Equal(t, 1.5, status[0].MaxLatency)
+	require.Greater(t, status[0].SecondsBehindMaster, int64(0))
+	require.Equal(t, "`db`.`t2`", status[1].Table)
+	require.Equal(t, int64(1), status[1].DeleteRows)
+	require.Equal(t, int64(16), status[1].Bytes)
+	require.Equal(t, "`db`.`t3`", status[2].Table)
+	require.Equal(t, int64(1699999999), status[2].LastAppliedTs)
+
+	m := s.metricsProxies
+	require.Equal(t, 1.0, testutil.ToFloat64(m.TableRowsTotal.WithLabelValues(cfg.Name, cfg.SourceID, "db", "t1", "insert")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.TableRowsTotal.WithLabelValues(cfg.Name, cfg.SourceID, "db", "t1", "update")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.TableRowsTotal.WithLabelValues(cfg.Name, cfg.SourceID, "", othersTableLabel, "delete")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.TableRowsTotal.WithLabelValues(cfg.Name, cfg.SourceID, "", othersTableLabel, "insert")))
+	require.Equal(t, 38.0, testutil.ToFloat64(m.TableBytesTotal.WithLabelValues(cfg.Name, cfg.SourceID, "db", "t1")))
+	require.Equal(t, 1700000002.0, testutil.ToFloat64(m.TableLastAppliedTSGauge.WithLabelValues(cfg.Name, cfg.SourceID, "db", "t1")))
+	require.Equal(t, 1700000001.0, testutil.ToFloat64(m.TableLastAppliedTSGauge.WithLabelValues(cfg.Name, cfg.SourceID, "", othersTableLabel)))
+	// only the tables within the limit and `_others` have metrics
+	require.Equal(t, 2, testutil.CollectAndCount(m.TableBytesTotal))
+}
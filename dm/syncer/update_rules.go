@@ -0,0 +1,520 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	regexprrouter "github.com/pingcap/tidb/pkg/util/regexpr-router"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+)
+
+// rules decide which upstream tables are replicated and how their events are replicated.
+type rules struct {
+	baList          *filter.Filter
+	tableRouter     *regexprrouter.RouteTable
+	binlogFilter    *bf.BinlogEvent
+	exprFilterGroup *ExprFilterGroup
+}
+
+// rulesUpdateHoldTimeout is the max duration the main routine is held by prepared rules,
+// the rules are aborted if they're neither committed nor aborted in time.
+var rulesUpdateHoldTimeout = time.Minute
+
+// rulesUpdate is an update of rules waiting to be prepared and applied by the main routine.
+type rulesUpdate struct {
+	cfg    *config.SubTaskConfig
+	rules  *rules
+	tables []*pb.RuleChangedTable
+	// included is the upstream tables which are replicated to new target tables.
+	included []*filter.Table
+	backfill bool
+	// held is true if the main routine is held at location until the update is committed or aborted.
+	held bool
+
+	// location is set before prepared is closed, err is set before done is closed.
+	location binlog.Location
+	prepared chan struct{}
+	// commit receives whether the prepared update is committed or aborted.
+	commit chan bool
+	err    error
+	done   chan struct{}
+}
+
+func newRulesUpdate(cfg *config.SubTaskConfig, newRules *rules, tables []*pb.RuleChangedTable, included []*filter.Table, backfill bool) *rulesUpdate {
+	return &rulesUpdate{
+		cfg:      cfg,
+		rules:    newRules,
+		tables:   tables,
+		included: included,
+		backfill: backfill,
+		prepared: make(chan struct{}),
+		commit:   make(chan bool, 1),
+		done:     make(chan struct{}),
+	}
+}
+
+func (u *rulesUpdate) finish(err error) {
+	u.err = err
+	close(u.done)
+}
+
+// UpdateRules prepares an update of the block-allow list, routes, binlog event filters and expression
+// filters of the syncer without pausing it, and returns the upstream tables whose target tables are changed.
+//
+// when preview is true, only the changed tables are returned. Otherwise the main routine flushes all jobs
+// at the end of the next transaction and is held at the returned location, until the update is applied
+// by CommitRules or dropped by AbortRules, so the subtasks of a task can switch to the new rules together.
+// the events before the location are replicated with the old rules and the events after it with the new rules.
+// the tables replicated to new target tables are created in the downstream with their current
+// upstream structure if they don't exist, or dumped and loaded like ResyncTables if backfill is true.
+func (s *Syncer) UpdateRules(ctx context.Context, cfg *config.SubTaskConfig, preview, backfill bool) ([]*pb.RuleChangedTable, binlog.Location, error) {
+	var location binlog.Location
+	if err := s.checkCanUpdateRules(cfg); err != nil {
+		return nil, location, err
+	}
+	newRules, err := s.newRules(cfg)
+	if err != nil {
+		return nil, location, err
+	}
+	s.RLock()
+	oldBAList, oldTableRouter := s.baList, s.tableRouter
+	s.RUnlock()
+	tables, included, err := s.diffRules(ctx, oldBAList, oldTableRouter, newRules)
+	if err != nil || preview {
+		return tables, location, err
+	}
+
+	running := s.IsRunning()
+	if !running && backfill {
+		return nil, location, terror.ErrSyncerUpdateRulesUnsupported.Generate("tables can only be backfilled when the sync unit is running")
+	}
+	u := newRulesUpdate(cfg, newRules, tables, included, backfill)
+	s.rulesUpdateMu.Lock()
+	if s.pendingRulesUpdate != nil || s.preparedRulesUpdate != nil {
+		s.rulesUpdateMu.Unlock()
+		return nil, location, terror.ErrSyncerUpdateRulesInProgress.Generate()
+	}
+	if !running {
+		// the rules take effect from the checkpoint when the sync unit runs again.
+		u.location = s.checkpoint.GlobalPoint()
+		close(u.prepared)
+		s.preparedRulesUpdate = u
+		s.rulesUpdateMu.Unlock()
+		s.tctx.L().Info("rules are prepared", zap.Any("changed tables", tables))
+		return tables, u.location, nil
+	}
+	s.pendingRulesUpdate = u
+	s.rulesUpdateMu.Unlock()
+	s.tctx.L().Info("wait for the main routine to prepare rules", zap.Any("changed tables", tables), zap.Bool("backfill", backfill))
+
+	select {
+	case <-u.prepared:
+		return tables, u.location, nil
+	case <-u.done:
+		return nil, location, u.err
+	case <-ctx.Done():
+		s.rulesUpdateMu.Lock()
+		pending := s.pendingRulesUpdate == u
+		if pending {
+			s.pendingRulesUpdate = nil
+		}
+		s.rulesUpdateMu.Unlock()
+		if !pending {
+			// the main routine is preparing the update, release it.
+			select {
+			case <-u.prepared:
+				s.abortRules(u)
+			case <-u.done:
+			}
+		}
+		return nil, location, ctx.Err()
+	}
+}
+
+// CommitRules applies the rules prepared by UpdateRules, and returns the upstream tables whose
+// target tables are changed and the location from which the new rules take effect.
+func (s *Syncer) CommitRules() ([]*pb.RuleChangedTable, binlog.Location, error) {
+	s.rulesUpdateMu.Lock()
+	u := s.preparedRulesUpdate
+	s.preparedRulesUpdate = nil
+	s.rulesUpdateMu.Unlock()
+	if u == nil {
+		return nil, binlog.Location{}, terror.ErrSyncerUpdateRulesUnsupported.Generate("no rules are prepared, they may be aborted because they're not committed in time")
+	}
+
+	if !u.held {
+		if err := s.applyRules(u); err != nil {
+			return nil, u.location, err
+		}
+		s.tctx.L().Info("rules are updated", zap.Any("changed tables", u.tables))
+		return u.tables, u.location, nil
+	}
+	u.commit <- true
+	<-u.done
+	return u.tables, u.location, u.err
+}
+
+// AbortRules drops the rules prepared by UpdateRules, the main routine continues with the old rules.
+func (s *Syncer) AbortRules() {
+	s.rulesUpdateMu.Lock()
+	u := s.preparedRulesUpdate
+	s.rulesUpdateMu.Unlock()
+	if u != nil {
+		s.abortRules(u)
+	}
+}
+
+func (s *Syncer) abortRules(u *rulesUpdate) {
+	s.rulesUpdateMu.Lock()
+	prepared := s.preparedRulesUpdate == u
+	if prepared {
+		s.preparedRulesUpdate = nil
+	}
+	s.rulesUpdateMu.Unlock()
+	if !prepared {
+		return
+	}
+	if u.held {
+		u.commit <- false
+	}
+	s.tctx.L().Info("rules are aborted", zap.Any("changed tables", u.tables))
+}
+
+// checkCanUpdateRules checks only the rules are different in the new config.
+func (s *Syncer) checkCanUpdateRules(newCfg *config.SubTaskConfig) error {
+	s.RLock()
+	defer s.RUnlock()
+	if s.cfg.ShardMode != "" {
+		return terror.ErrSyncerUpdateRulesUnsupported.Generate("the task is in shard mode " + s.cfg.ShardMode)
+	}
+	if stage, tables, _, _ := s.tableResyncer.current(); stage != resyncStageNone {
+		return terror.ErrSyncerResyncTableInProgress.Generate(tables)
+	}
+
+	oldCfg, err := s.cfg.Clone()
+	if err != nil {
+		return err
+	}
+	oldCfg.BAList = newCfg.BAList
+	oldCfg.BWList = newCfg.BWList
+	oldCfg.RouteRules = newCfg.RouteRules
+	oldCfg.FilterRules = newCfg.FilterRules
+	oldCfg.ExprFilter = newCfg.ExprFilter
+	oldCfg.To.Session = newCfg.To.Session // session is adjusted in `createDBs`
+
+	// fields that changed in func `copyConfigFromSource`
+	oldCfg.From = newCfg.From
+	oldCfg.Flavor = newCfg.Flavor
+	oldCfg.ServerID = newCfg.ServerID
+	oldCfg.RelayDir = newCfg.RelayDir
+	oldCfg.UseRelay = newCfg.UseRelay
+	oldCfg.EnableGTID = newCfg.EnableGTID
+	oldCfg.CaseSensitive = newCfg.CaseSensitive
+
+	if oldCfg.String() != newCfg.String() {
+		s.tctx.L().Warn("can not update rules", zap.Stringer("old cfg", oldCfg), zap.Stringer("new cfg", newCfg))
+		return terror.ErrSyncerUpdateRulesUnsupported.Generate("only block-allow list, routes, filters and expression filters can be changed")
+	}
+	return nil
+}
+
+func (s *Syncer) newRules(cfg *config.SubTaskConfig) (*rules, error) {
+	baList, err := filter.New(cfg.CaseSensitive, cfg.BAList)
+	if err != nil {
+		return nil, terror.ErrSyncerUnitGenBAList.Delegate(err)
+	}
+	tableRouter, err := regexprrouter.NewRegExprRouter(cfg.CaseSensitive, cfg.RouteRules)
+	if err != nil {
+		return nil, terror.ErrSyncerUnitGenTableRouter.Delegate(err)
+	}
+	binlogFilter, err := bf.NewBinlogEvent(cfg.CaseSensitive, cfg.FilterRules)
+	if err != nil {
+		return nil, terror.ErrSyncerUnitGenBinlogEventFilter.Delegate(err)
+	}
+	return &rules{
+		baList:          baList,
+		tableRouter:     tableRouter,
+		binlogFilter:    binlogFilter,
+		exprFilterGroup: NewExprFilterGroup(s.tctx, s.sessCtx, cfg.ExprFilter),
+	}, nil
+}
+
+// diffRules returns the upstream tables whose target tables are changed by the new rules,
+// and the ones among them which are replicated to new target tables.
+func (s *Syncer) diffRules(ctx context.Context, oldBAList *filter.Filter, oldTableRouter *regexprrouter.RouteTable, newRules *rules) ([]*pb.RuleChangedTable, []*filter.Table, error) {
+	allowAll, err := filter.New(s.cfg.CaseSensitive, &filter.Rules{})
+	if err != nil {
+		return nil, nil, terror.ErrSyncerUnitGenBAList.Delegate(err)
+	}
+	allTables, err := conn.FetchAllDoTables(ctx, s.fromDB.BaseDB, allowAll)
+	if err != nil {
+		return nil, nil, err
+	}
+	changed, included := diffTables(allTables, oldBAList, oldTableRouter, newRules.baList, newRules.tableRouter)
+	return changed, included, nil
+}
+
+func diffTables(
+	allTables map[string][]string,
+	oldBAList *filter.Filter, oldTableRouter *regexprrouter.RouteTable,
+	newBAList *filter.Filter, newTableRouter *regexprrouter.RouteTable,
+) ([]*pb.RuleChangedTable, []*filter.Table) {
+	targetTable := func(baList *filter.Filter, tableRouter *regexprrouter.RouteTable, table *filter.Table) string {
+		if skipByTable(baList, table) {
+			return ""
+		}
+		return route(tableRouter, table).String()
+	}
+
+	tables := make([]*filter.Table, 0, len(allTables))
+	for schema, names := range allTables {
+		for _, name := range names {
+			tables = append(tables, &filter.Table{Schema: schema, Name: name})
+		}
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Schema != tables[j].Schema {
+			return tables[i].Schema < tables[j].Schema
+		}
+		return tables[i].Name < tables[j].Name
+	})
+
+	changed := make([]*pb.RuleChangedTable, 0)
+	included := make([]*filter.Table, 0)
+	for _, table := range tables {
+		oldTarget := targetTable(oldBAList, oldTableRouter, table)
+		newTarget := targetTable(newBAList, newTableRouter, table)
+		if oldTarget == newTarget {
+			continue
+		}
+		changed = append(changed, &pb.RuleChangedTable{
+			SourceTable:    table.String(),
+			OldTargetTable: oldTarget,
+			NewTargetTable: newTarget,
+		})
+		if newTarget != "" {
+			included = append(included, table)
+		}
+	}
+	return changed, included
+}
+
+// applyRules replaces the rules and the config of the syncer, the binlog streamer decodes
+// rows events with the new block-allow list after it's reset.
+func (s *Syncer) applyRules(u *rulesUpdate) error {
+	syncCfg, err := subtaskCfg2BinlogSyncerCfg(u.cfg, s.timezone, u.rules.baList)
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.baList = u.rules.baList
+	s.tableRouter = u.rules.tableRouter
+	s.binlogFilter = u.rules.binlogFilter
+	s.exprFilterGroup = u.rules.exprFilterGroup
+	if s.ddlWorker != nil {
+		s.ddlWorker.baList = u.rules.baList
+		s.ddlWorker.tableRouter = u.rules.tableRouter
+		s.ddlWorker.binlogFilter = u.rules.binlogFilter
+	}
+	s.cfg.BAList = u.cfg.BAList
+	s.cfg.BWList = u.cfg.BWList
+	s.cfg.RouteRules = u.cfg.RouteRules
+	s.cfg.FilterRules = u.cfg.FilterRules
+	s.cfg.ExprFilter = u.cfg.ExprFilter
+
+	// the table structures of newly included tables may be outdated in the schema tracker,
+	// they are tracked from the downstream again when needed.
+	for _, table := range u.included {
+		if err = s.schemaTracker.DropTable(table); err != nil {
+			s.tctx.L().Debug("table is not tracked", zap.Stringer("table", table), zap.Error(err))
+		}
+	}
+
+	s.syncCfg = syncCfg
+	if s.streamerController != nil {
+		s.streamerController.UpdateSyncCfg(syncCfg, s.fromDB)
+	}
+	return nil
+}
+
+// checkRulesUpdate prepares the pending update of rules in the main routine and applies it if it's
+// committed, it should be called only when the main stream is at the end of a transaction and not
+// re-syncing a sharding group.
+func (s *Syncer) checkRulesUpdate(location binlog.Location) error {
+	s.rulesUpdateMu.Lock()
+	u := s.pendingRulesUpdate
+	s.pendingRulesUpdate = nil
+	s.rulesUpdateMu.Unlock()
+	if u == nil {
+		return nil
+	}
+
+	// all events before location are replicated with the old rules.
+	if err := s.flushJobs(); err != nil {
+		u.finish(err)
+		return err
+	}
+	s.rulesUpdateMu.Lock()
+	u.held = true
+	u.location = location
+	s.preparedRulesUpdate = u
+	s.rulesUpdateMu.Unlock()
+	close(u.prepared)
+	s.tctx.L().Info("rules are prepared, wait for them to be committed", zap.Stringer("location", location))
+
+	if !s.waitRulesCommit(u) {
+		u.finish(terror.ErrSyncerUpdateRulesUnsupported.Generate("the rules are aborted"))
+		s.tctx.L().Info("continue with the old rules", zap.Stringer("location", location))
+		return nil
+	}
+	err := s.applyRulesAt(u, location)
+	u.finish(err)
+	return err
+}
+
+// waitRulesCommit holds the main routine until the prepared update is committed or aborted,
+// it's aborted if the sync unit exits or the decision isn't made in time.
+func (s *Syncer) waitRulesCommit(u *rulesUpdate) bool {
+	timer := time.NewTimer(rulesUpdateHoldTimeout)
+	defer timer.Stop()
+	select {
+	case commit := <-u.commit:
+		return commit
+	case <-timer.C:
+		s.tctx.L().Warn("prepared rules are not committed in time", zap.Duration("timeout", rulesUpdateHoldTimeout))
+	case <-s.runCtx.Ctx.Done():
+	}
+
+	s.rulesUpdateMu.Lock()
+	taken := s.preparedRulesUpdate != u
+	if !taken {
+		s.preparedRulesUpdate = nil
+	}
+	s.rulesUpdateMu.Unlock()
+	if taken {
+		// CommitRules or AbortRules is sending the decision.
+		return <-u.commit
+	}
+	return false
+}
+
+// applyRulesAt applies the committed rules from location, the old rules are restored if it fails,
+// so the failed update takes no effect after the sync unit is resumed.
+func (s *Syncer) applyRulesAt(u *rulesUpdate, location binlog.Location) (err error) {
+	oldCfg, err := s.cfg.Clone()
+	if err != nil {
+		return err
+	}
+	s.RLock()
+	old := &rulesUpdate{
+		cfg: oldCfg,
+		rules: &rules{
+			baList:          s.baList,
+			tableRouter:     s.tableRouter,
+			binlogFilter:    s.binlogFilter,
+			exprFilterGroup: s.exprFilterGroup,
+		},
+		included: u.included,
+	}
+	s.RUnlock()
+	if err = s.applyRules(u); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if err2 := s.applyRules(old); err2 != nil {
+			s.tctx.L().Error("fail to restore the old rules", zap.Error(err2))
+			return
+		}
+		s.tctx.L().Warn("the old rules are restored because the update fails", zap.Error(err))
+	}()
+
+	// read the binlog again from location, so the rows events of newly included tables are decoded.
+	if err = s.streamerController.ResetReplicationSyncer(s.runCtx, location); err != nil {
+		return err
+	}
+	s.tctx.L().Info("rules are updated", zap.Stringer("location", location), zap.Any("changed tables", u.tables))
+
+	tables := u.included
+	if len(tables) == 0 {
+		return nil
+	}
+	if u.backfill {
+		s.tctx.L().Info("start to backfill tables", zap.Stringers("tables", tables))
+		return s.tableResyncer.start(tables, s.checkpoint.GlobalPoint())
+	}
+	return s.createTargetTables(s.runCtx.Ctx, tables)
+}
+
+// cancelRulesUpdate fails the pending update of rules when the main routine exits.
+func (s *Syncer) cancelRulesUpdate() {
+	s.rulesUpdateMu.Lock()
+	u := s.pendingRulesUpdate
+	s.pendingRulesUpdate = nil
+	s.rulesUpdateMu.Unlock()
+	if u != nil {
+		u.finish(terror.ErrSyncerUpdateRulesUnsupported.Generate("the sync unit exits before the rules are prepared"))
+	}
+}
+
+// createTargetTables creates the target tables which don't exist in the downstream with the current
+// structure of the upstream tables, the existing rows of the tables are not replicated.
+func (s *Syncer) createTargetTables(ctx context.Context, tables []*filter.Table) error {
+	if s.mqSink != nil {
+		// there is no target table when writing to a message queue.
+		return nil
+	}
+	p := parser.New()
+	for _, table := range tables {
+		target := s.route(table)
+		createSQL, err := dbutil.GetCreateTableSQL(ctx, s.fromDB.BaseDB.DB, table.Schema, table.Name)
+		if err != nil {
+			return terror.WithScope(terror.Annotatef(err, "get table structure of %s", table), terror.ScopeUpstream)
+		}
+		stmt, err := p.ParseOneStmt(createSQL, "", "")
+		if err != nil {
+			return terror.ErrSyncerParseDDL.Delegate(err, createSQL)
+		}
+		stmt.(*ast.CreateTableStmt).IfNotExists = true
+		createSQL, err = parserpkg.RenameDDLTable(stmt, []*filter.Table{target})
+		if err != nil {
+			return err
+		}
+		for _, sql := range []string{"CREATE DATABASE IF NOT EXISTS " + dbutil.ColumnName(target.Schema), createSQL} {
+			s.tctx.L().Info("create downstream table for newly included table", zap.Stringer("table", table), zap.String("sql", sql))
+			if _, err = s.toDB.DB.ExecContext(ctx, sql); err != nil {
+				return terror.DBErrorAdapt(err, terror.ScopeDownstream, terror.ErrDBDriverError)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+
+	"github.com/pingcap/tidb/pkg/util/filter"
+	regexprrouter "github.com/pingcap/tidb/pkg/util/regexpr-router"
+	router "github.com/pingcap/tidb/pkg/util/table-router"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTables(t *testing.T) {
+	allTables := map[string][]string{
+		"db":  {"tb1", "tb2", "tb3"},
+		"db2": {"tb1", "tb2"},
+	}
+	oldBAList, err := filter.New(false, &filter.Rules{DoDBs: []string{"db"}})
+	require.NoError(t, err)
+	oldRouter, err := regexprrouter.NewRegExprRouter(false, nil)
+	require.NoError(t, err)
+	newBAList, err := filter.New(false, &filter.Rules{
+		DoDBs:     []string{"db", "db2"},
+		DoTables:  []*filter.Table{{Schema: "db", Name: "tb1"}, {Schema: "db", Name: "tb3"}, {Schema: "db2", Name: "tb1"}},
+		IgnoreDBs: []string{"db3"},
+	})
+	require.NoError(t, err)
+	newRouter, err := regexprrouter.NewRegExprRouter(false, []*router.TableRule{
+		{SchemaPattern: "db", TablePattern: "tb3", TargetSchema: "target", TargetTable: "tb"},
+	})
+	require.NoError(t, err)
+
+	changed, included := diffTables(allTables, oldBAList, oldRouter, newBAList, newRouter)
+	require.Equal(t, []*pb.RuleChangedTable{
+		{SourceTable: "`db`.`tb2`", OldTargetTable: "`db`.`tb2`"},
+		{SourceTable: "`db`.`tb3`", OldTargetTable: "`db`.`tb3`", NewTargetTable: "`target`.`tb`"},
+		{SourceTable: "`db2`.`tb1`", NewTargetTable: "`db2`.`tb1`"},
+	}, changed)
+	require.Equal(t, []*filter.Table{{Schema: "db", Name: "tb3"}, {Schema: "db2", Name: "tb1"}}, included)
+
+	// nothing changed
+	changed, included = diffTables(allTables, oldBAList, oldRouter, oldBAList, oldRouter)
+	require.Len(t, changed, 0)
+	require.Len(t, included, 0)
+}
+
+func TestSyncerCheckCanUpdateRules(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.BAList = &filter.Rules{DoDBs: []string{"db"}}
+	syncer := NewSyncer(cfg, nil, nil)
+
+	newCfg, err := cfg.Clone()
+	require.NoError(t, err)
+	newCfg.BAList = &filter.Rules{DoDBs: []string{"db", "db2"}}
+	newCfg.RouteRules = []*router.TableRule{{SchemaPattern: "db2", TargetSchema: "db"}}
+	newCfg.ExprFilter = []*config.ExpressionFilter{{Schema: "db", Table: "tb1", InsertValueExpr: "c > 1"}}
+	require.NoError(t, syncer.checkCanUpdateRules(newCfg))
+
+	newCfg.WorkerCount++
+	require.True(t, terror.ErrSyncerUpdateRulesUnsupported.Equal(syncer.checkCanUpdateRules(newCfg)))
+
+	cfg.ShardMode = config.ShardOptimistic
+	syncer = NewSyncer(cfg, nil, nil)
+	require.True(t, terror.ErrSyncerUpdateRulesUnsupported.Equal(syncer.checkCanUpdateRules(cfg)))
+}
+
+func TestSyncerApplyRules(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.BAList = &filter.Rules{DoDBs: []string{"db"}}
+	syncer := NewSyncer(cfg, nil, nil)
+	var err error
+	syncer.baList, err = filter.New(cfg.CaseSensitive, cfg.BAList)
+	require.NoError(t, err)
+	require.True(t, syncer.skipByTable(&filter.Table{Schema: "db2", Name: "tb1"}))
+
+	newCfg, err := cfg.Clone()
+	require.NoError(t, err)
+	newCfg.BAList = &filter.Rules{DoDBs: []string{"db", "db2"}}
+	newCfg.RouteRules = []*router.TableRule{{SchemaPattern: "db2", TargetSchema: "db"}}
+	newRules, err := syncer.newRules(newCfg)
+	require.NoError(t, err)
+	require.NoError(t, syncer.applyRules(&rulesUpdate{cfg: newCfg, rules: newRules}))
+	require.False(t, syncer.skipByTable(&filter.Table{Schema: "db2", Name: "tb1"}))
+	require.Equal(t, &filter.Table{Schema: "db", Name: "tb1"}, syncer.route(&filter.Table{Schema: "db2", Name: "tb1"}))
+	require.Equal(t, newCfg.BAList, syncer.cfg.BAList)
+	require.Equal(t, newCfg.RouteRules, syncer.cfg.RouteRules)
+	require.Equal(t, syncer.baList, newRules.baList)
+
+	// the pending update fails when the main routine exits
+	syncer.cancelRulesUpdate()
+	u := newRulesUpdate(newCfg, newRules, nil, nil, false)
+	syncer.pendingRulesUpdate = u
+	syncer.cancelRulesUpdate()
+	<-u.done
+	require.True(t, terror.ErrSyncerUpdateRulesUnsupported.Equal(u.err))
+	require.Nil(t, syncer.pendingRulesUpdate)
+	require.NoError(t, syncer.checkRulesUpdate(binlog.Location{}))
+}
+
+func TestSyncerCommitRules(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.BAList = &filter.Rules{DoDBs: []string{"db"}}
+	syncer := NewSyncer(cfg, nil, nil)
+	var err error
+	syncer.baList, err = filter.New(cfg.CaseSensitive, cfg.BAList)
+	require.NoError(t, err)
+	syncer.runCtx = tcontext.Background()
+
+	newCfg, err := cfg.Clone()
+	require.NoError(t, err)
+	newCfg.BAList = &filter.Rules{DoDBs: []string{"db", "db2"}}
+	newRules, err := syncer.newRules(newCfg)
+	require.NoError(t, err)
+
+	// nothing to commit or abort
+	_, _, err = syncer.CommitRules()
+	require.True(t, terror.ErrSyncerUpdateRulesUnsupported.Equal(err))
+	syncer.AbortRules()
+
+	// prepared when the sync unit is not running
+	location := binlog.MustZeroLocation(mysql.MySQLFlavor)
+	u := newRulesUpdate(newCfg, newRules, []*pb.RuleChangedTable{{SourceTable: "`db2`.`tb1`"}}, nil, false)
+	u.location = location
+	syncer.preparedRulesUpdate = u
+	tables, loc, err := syncer.CommitRules()
+	require.NoError(t, err)
+	require.Equal(t, u.tables, tables)
+	require.Equal(t, location, loc)
+	require.Nil(t, syncer.preparedRulesUpdate)
+	require.False(t, syncer.skipByTable(&filter.Table{Schema: "db2", Name: "tb1"}))
+
+	// the held main routine continues with the old rules after the update is aborted
+	u = newRulesUpdate(newCfg, newRules, nil, nil, false)
+	u.held = true
+	syncer.preparedRulesUpdate = u
+	syncer.AbortRules()
+	require.Nil(t, syncer.preparedRulesUpdate)
+	require.False(t, syncer.waitRulesCommit(u))
+
+	// the held main routine applies the committed update
+	u = newRulesUpdate(newCfg, newRules, nil, nil, false)
+	u.held = true
+	syncer.preparedRulesUpdate = u
+	go func() {
+		if syncer.waitRulesCommit(u) {
+			u.finish(nil)
+		} else {
+			u.finish(terror.ErrSyncerUpdateRulesUnsupported.Generate("the rules are aborted"))
+		}
+	}()
+	_, _, err = syncer.CommitRules()
+	require.NoError(t, err)
+
+	// the update is aborted if it's not committed in time
+	backup := rulesUpdateHoldTimeout
+	rulesUpdateHoldTimeout = 10 * time.Millisecond
+	defer func() {
+		rulesUpdateHoldTimeout = backup
+	}()
+	u = newRulesUpdate(newCfg, newRules, nil, nil, false)
+	u.held = true
+	syncer.preparedRulesUpdate = u
+	require.False(t, syncer.waitRulesCommit(u))
+	require.Nil(t, syncer.preparedRulesUpdate)
+	_, _, err = syncer.CommitRules()
+	require.True(t, terror.ErrSyncerUpdateRulesUnsupported.Equal(err))
+}
@@ -377,8 +377,8 @@ func (vw *validateWorker) validateInsertAndUpdateRows(rows []*rowValidationJob,
 			failedRows[key] = &validateFailedRow{tp: rowNotExist}
 			continue
 		}
-		if vw.cfg.Mode == config.ValidationFull {
-			// only compare the whole row in full mode
+		if vw.cfg.Mode == config.ValidationFull || vw.cfg.Mode == config.ValidationSnapshot {
+			// only compare the whole row in full or snapshot mode
 			eq, err2 := validateContext.compareData(key, sourceRow, targetRow)
 			if err2 != nil {
 				return nil, err2
@@ -480,6 +480,10 @@ type validateCompareContext struct {
 func (c *validateCompareContext) compareData(key string, sourceData, targetData []*sql.NullString) (bool, error) {
 	for i, column := range c.columns {
 		data1, data2 := sourceData[i], targetData[i]
+		if data1 == nil {
+			// the column is not logged in binlog
+			continue
+		}
 		if data1.Valid != data2.Valid {
 			return false, nil
 		}
@@ -555,7 +559,15 @@ func getSourceRowsForCompare(jobs []*rowValidationJob) map[string][]*sql.NullStr
 		r := j.row
 		colValues := make([]*sql.NullString, r.ColumnCount())
 		rowValues := r.RowValues()
+		skipped := make(map[int]struct{})
+		for _, offset := range r.RowSkippedColumns() {
+			skipped[offset] = struct{}{}
+		}
 		for i := range rowValues {
+			// the value of the column not logged is unknown, leave it nil so it's not compared.
+			if _, ok := skipped[i]; ok {
+				continue
+			}
 			var colData string
 			if rowValues[i] != nil {
 				colData = sqlmodel.ColValAsStr(rowValues[i])
@@ -523,6 +523,17 @@ func TestValidatorWorkerGetSourceRowsForCompare(t *testing.T) {
 	require.Equal(t, "1", rows["a"][1].String)
 	require.Equal(t, "1", rows["b"][0].String)
 	require.Equal(t, "2", rows["b"][1].String)
+
+	// the column not logged is not compared
+	partial := genRowChangeJob(tbl1, tableInfo1, "c", rowUpdated, []interface{}{3, nil})
+	partial.row.SetSkippedColumns([]int{1}, []int{1})
+	rows = getSourceRowsForCompare([]*rowValidationJob{partial})
+	require.Equal(t, "3", rows["c"][0].String)
+	require.Nil(t, rows["c"][1])
+	compareContext := validateCompareContext{logger: log.L(), columns: tableInfo1.Columns}
+	eq, err := compareContext.compareData("c", rows["c"], []*sql.NullString{{String: "3", Valid: true}, {String: "4", Valid: true}})
+	require.NoError(t, err)
+	require.True(t, eq)
 }
 
 func TestValidatorIsRetryableDBError(t *testing.T) {
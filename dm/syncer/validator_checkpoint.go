@@ -209,6 +209,8 @@ type rowChangeDataForPersist struct {
 	Size      int32            `json:"size"`
 	Data      []interface{}    `json:"data"`
 	FailedCnt int              `json:"failed-cnt"` // failed count
+	// offsets of the columns not logged in binlog, they're not compared.
+	Skipped []int `json:"skipped,omitempty"`
 }
 
 var triggeredFailOnPersistForIntegrationTest bool
@@ -269,47 +271,52 @@ func (c *validatorPersistHelper) persistTableStatusAndErrors(tctx *tcontext.Cont
 		)
 	}
 	// upsert error rows
+	errorRows := make([]*validateFailedRow, 0, c.validator.getNewErrorRowCount())
 	for _, worker := range c.validator.getWorkers() {
-		for _, r := range worker.getErrorRows() {
-			query := `INSERT INTO ` + c.errorChangeTableName + `
-					(source, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, data, dst_data, error_type, status)
-					VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE
-					source = VALUES(source),
-					src_schema_name = VALUES(src_schema_name),
-					src_table_name = VALUES(src_table_name),
-					row_pk = VALUES(row_pk),
-					dst_schema_name = VALUES(dst_schema_name),
-					dst_table_name = VALUES(dst_table_name),
-					data = VALUES(data),
-					dst_data = VALUES(dst_data),
-					error_type = VALUES(error_type),
-					status = VALUES(status)
-			`
-			queries = append(queries, query)
-
-			row := r.srcJob.row
-			srcDataBytes, err := json.Marshal(row.RowValues())
-			if err != nil {
-				return err
-			}
-			dstData := make([]interface{}, len(r.dstData))
-			for i, d := range r.dstData {
-				if d.Valid {
-					dstData[i] = d.String
-				}
-			}
-			dstDataBytes, err := json.Marshal(dstData)
-			if err != nil {
-				return err
+		errorRows = append(errorRows, worker.getErrorRows()...)
+	}
+	if c.validator.snapshotChecker != nil {
+		errorRows = append(errorRows, c.validator.snapshotChecker.getErrorRows()...)
+	}
+	for _, r := range errorRows {
+		query := `INSERT INTO ` + c.errorChangeTableName + `
+				(source, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, data, dst_data, error_type, status)
+				VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE
+				source = VALUES(source),
+				src_schema_name = VALUES(src_schema_name),
+				src_table_name = VALUES(src_table_name),
+				row_pk = VALUES(row_pk),
+				dst_schema_name = VALUES(dst_schema_name),
+				dst_table_name = VALUES(dst_table_name),
+				data = VALUES(data),
+				dst_data = VALUES(dst_data),
+				error_type = VALUES(error_type),
+				status = VALUES(status)
+		`
+		queries = append(queries, query)
+
+		row := r.srcJob.row
+		srcDataBytes, err := json.Marshal(row.RowValues())
+		if err != nil {
+			return err
+		}
+		dstData := make([]interface{}, len(r.dstData))
+		for i, d := range r.dstData {
+			if d.Valid {
+				dstData[i] = d.String
 			}
-			sourceTable := row.GetSourceTable()
-			targetTable := row.GetTargetTable()
-			args = append(args, []interface{}{
-				c.cfg.SourceID, sourceTable.Schema, sourceTable.Table, r.srcJob.Key,
-				targetTable.Schema, targetTable.Table,
-				string(srcDataBytes), string(dstDataBytes), r.tp, pb.ValidateErrorState_NewErr,
-			})
 		}
+		dstDataBytes, err := json.Marshal(dstData)
+		if err != nil {
+			return err
+		}
+		sourceTable := row.GetSourceTable()
+		targetTable := row.GetTargetTable()
+		args = append(args, []interface{}{
+			c.cfg.SourceID, sourceTable.Schema, sourceTable.Table, r.srcJob.Key,
+			targetTable.Schema, targetTable.Table,
+			string(srcDataBytes), string(dstDataBytes), r.tp, pb.ValidateErrorState_NewErr,
+		})
 	}
 
 	return c.execQueriesWithRetry(tctx, queries, args)
@@ -335,6 +342,7 @@ func (c *validatorPersistHelper) persistPendingRows(tctx *tcontext.Context, rev
 					Size:      j.size,
 					Data:      row.RowValues(),
 					FailedCnt: j.FailedCnt,
+					Skipped:   row.RowSkippedColumns(),
 				}
 				rowJSON, err := json.Marshal(&rowForPersist)
 				if err != nil {
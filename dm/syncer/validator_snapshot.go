@@ -0,0 +1,589 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	tmysql "github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"go.uber.org/zap"
+)
+
+// snapshotTable is a table to be checked by the snapshotChecker.
+type snapshotTable struct {
+	source *filter.Table
+	target *filter.Table
+	// tableInfo is the structure of the upstream table. like the continuous validator,
+	// downstream is queried by the same column names, so the columns only exist in
+	// downstream, e.g. added by extract rules, are not compared.
+	tableInfo *model.TableInfo
+	// offsets of the columns of the handle in tableInfo.Columns.
+	pkOffsets []int
+}
+
+// snapshotChunk is a range of the handle, lower is exclusive and upper is
+// inclusive, nil means unbounded.
+type snapshotChunk struct {
+	lower []string
+	upper []string
+}
+
+type snapshotChecksum struct {
+	count    int64
+	checksum uint64
+}
+
+// snapshotQuerier is a *sql.DB or a *sql.Conn.
+type snapshotQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// snapshotChecker checks all rows of the tables once. It runs when the validator
+// starts in ValidationSnapshot mode, or when a snapshot check is requested, e.g.
+// by the validate step of cutover.
+// It splits a table into chunks by the handle and compares the checksum of each
+// chunk in upstream and downstream. A consistent snapshot of upstream is started
+// together with its binlog location, and syncer is pinned at that location. The
+// checksum of upstream is taken in the snapshot, and the checksum of downstream is
+// taken after syncer has flushed the pinned location and holds the later binlog
+// events, so both sides are at the same location. Mismatched chunks are narrowed
+// down to rows in the same snapshot, and the rows are saved as error rows of the
+// validator.
+type snapshotChecker struct {
+	sync.Mutex
+	v         *DataValidator
+	L         log.Logger
+	chunkSize int
+
+	// persistMu makes adding error rows wait for persisting, so the error rows
+	// persisted are exactly the ones reset after persisting.
+	persistMu sync.Mutex
+	errorRows []*validateFailedRow
+}
+
+func newSnapshotChecker(v *DataValidator) *snapshotChecker {
+	return &snapshotChecker{
+		v:         v,
+		L:         v.L.WithFields(zap.String("component", "snapshot checker")),
+		chunkSize: v.cfg.ValidatorCfg.SnapshotChunkSize,
+		errorRows: make([]*validateFailedRow, 0),
+	}
+}
+
+// run runs in a separate goroutine.
+func (c *snapshotChecker) run() {
+	defer c.v.wg.Done()
+
+	msg, err := c.checkAllTables()
+	if err != nil {
+		c.v.setSnapshotCheckStage(pb.Stage_Paused, err.Error())
+		c.v.sendError(err)
+		return
+	}
+	c.v.setSnapshotCheckStage(pb.Stage_Finished, msg)
+}
+
+// checkAllTables checks all tables of the subtask, the returned message is not
+// empty if the check is skipped.
+func (c *snapshotChecker) checkAllTables() (string, error) {
+	if err := c.v.waitSyncerRunning(); err != nil {
+		return "", err
+	}
+	if c.v.cfg.IsSharding {
+		c.L.Warn("skip snapshot check since rows of sharding tables are merged in downstream")
+		return "snapshot check is skipped since rows of sharding tables are merged in downstream", nil
+	}
+
+	tables, err := conn.FetchAllDoTables(c.v.ctx, c.v.fromDB, c.v.syncer.baList)
+	if err != nil {
+		return "", terror.Annotate(err, "fail to fetch tables for snapshot check")
+	}
+	c.L.Info("start snapshot check", zap.Int("schemas", len(tables)))
+	for schemaName, tableNames := range tables {
+		for _, tableName := range tableNames {
+			source := &filter.Table{Schema: schemaName, Name: tableName}
+			if err = c.checkTable(source); err != nil {
+				return "", terror.Annotate(err, "fail to check snapshot of "+source.String())
+			}
+		}
+	}
+	c.L.Info("snapshot check finished")
+	return "", nil
+}
+
+func (c *snapshotChecker) checkTable(source *filter.Table) error {
+	tbl, msg, err := c.genSnapshotTable(source)
+	if err != nil {
+		return err
+	}
+	if msg != "" {
+		c.L.Warn("skip snapshot check of table", zap.Stringer("table", source), zap.String("reason", msg))
+		return nil
+	}
+
+	chunks, err := c.splitChunks(tbl)
+	if err != nil {
+		return err
+	}
+	// all chunks of the table are checked in one snapshot of upstream, so the global
+	// read lock is only taken once per table when starting the snapshot.
+	mismatched, failedRows, err := c.checkChunks(tbl, chunks)
+	if err != nil {
+		return err
+	}
+	c.addErrorRows(failedRows)
+	c.L.Info("table snapshot checked", zap.Stringer("table", source), zap.Int("chunks", len(chunks)),
+		zap.Int("mismatched chunks", mismatched), zap.Int("error rows", len(failedRows)))
+	return nil
+}
+
+func (c *snapshotChecker) genSnapshotTable(source *filter.Table) (*snapshotTable, string, error) {
+	target := c.v.syncer.route(source)
+	ctx, cancel := context.WithTimeout(c.v.ctx, queryTimeout)
+	defer cancel()
+	tableInfo, err := dbutil.GetTableInfo(ctx, c.v.fromDB.DB, source.Schema, source.Name)
+	if err != nil {
+		if conn.IsMySQLError(errors.Cause(err), tmysql.ErrNoSuchTable) {
+			return nil, tableNotSyncedOrDropped, nil
+		}
+		return nil, "", err
+	}
+	if _, err = dbutil.GetCreateTableSQL(ctx, c.v.toDB.DB, target.Schema, target.Name); err != nil {
+		if conn.IsMySQLError(errors.Cause(err), tmysql.ErrNoSuchTable) {
+			return nil, tableNotSyncedOrDropped, nil
+		}
+		return nil, "", err
+	}
+	pk := getSnapshotHandle(tableInfo)
+	if pk == nil {
+		return nil, tableWithoutPrimaryKeyMsg, nil
+	}
+	pkOffsets := make([]int, 0, len(pk.Columns))
+	for _, idxCol := range pk.Columns {
+		col := dbutil.FindColumnByName(tableInfo.Columns, idxCol.Name.O)
+		pkOffsets = append(pkOffsets, col.Offset)
+	}
+	return &snapshotTable{
+		source:    source,
+		target:    target,
+		tableInfo: tableInfo,
+		pkOffsets: pkOffsets,
+	}, "", nil
+}
+
+// getSnapshotHandle returns the primary key, or the first unique key whose
+// columns are all not null.
+func getSnapshotHandle(tableInfo *model.TableInfo) *model.IndexInfo {
+	for _, idx := range dbutil.FindAllIndex(tableInfo) {
+		if idx.Primary {
+			return idx
+		}
+		if !idx.Unique {
+			continue
+		}
+		notNull := true
+		for _, idxCol := range idx.Columns {
+			col := dbutil.FindColumnByName(tableInfo.Columns, idxCol.Name.O)
+			if col == nil || !tmysql.HasNotNullFlag(col.GetFlag()) {
+				notNull = false
+				break
+			}
+		}
+		if notNull {
+			return idx
+		}
+	}
+	return nil
+}
+
+// splitChunks splits the upstream table into chunks of chunkSize rows.
+func (c *snapshotChecker) splitChunks(tbl *snapshotTable) ([]*snapshotChunk, error) {
+	var (
+		chunks []*snapshotChunk
+		lower  []string
+	)
+	pkNames := tbl.pkColumnNames()
+	for {
+		chunk := &snapshotChunk{lower: lower}
+		where, args := chunk.where(pkNames)
+		query := fmt.Sprintf("SELECT /*!40001 SQL_NO_CACHE */ %s FROM %s WHERE %s ORDER BY %s LIMIT 1 OFFSET %d",
+			strings.Join(pkNames, ", "), tbl.source, where, strings.Join(pkNames, ", "), c.chunkSize-1)
+		rows, err := c.query(c.v.fromDB.DB, query, args)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			chunks = append(chunks, chunk)
+			return chunks, nil
+		}
+		upper := make([]string, 0, len(pkNames))
+		for _, val := range rows[0] {
+			upper = append(upper, val.String)
+		}
+		chunk.upper = upper
+		chunks = append(chunks, chunk)
+		lower = upper
+	}
+}
+
+// checkChunks checks the chunks in one snapshot of upstream, it returns the number
+// of the mismatched chunks and the mismatched rows of them.
+func (c *snapshotChecker) checkChunks(tbl *snapshotTable, chunks []*snapshotChunk) (int, []*validateFailedRow, error) {
+	var (
+		mismatched int
+		failedRows []*validateFailedRow
+	)
+	err := c.inSnapshot(func(source *sql.Conn, waitSyncer func() error) error {
+		sourceSums := make([]snapshotChecksum, 0, len(chunks))
+		for _, chunk := range chunks {
+			sum, err := c.checksum(source, tbl.source, tbl, chunk)
+			if err != nil {
+				return err
+			}
+			sourceSums = append(sourceSums, sum)
+		}
+		if err := waitSyncer(); err != nil {
+			return err
+		}
+		for i, chunk := range chunks {
+			sum, err := c.checksum(c.v.toDB.DB, tbl.target, tbl, chunk)
+			if err != nil {
+				return err
+			}
+			if sum == sourceSums[i] {
+				continue
+			}
+			c.L.Debug("chunk mismatched", zap.Stringer("table", tbl.source),
+				zap.Strings("lower", chunk.lower), zap.Strings("upper", chunk.upper),
+				zap.Int64("source count", sourceSums[i].count), zap.Int64("target count", sum.count))
+			mismatched++
+			rows, err := c.compareChunkRows(source, tbl, chunk)
+			if err != nil {
+				return err
+			}
+			failedRows = append(failedRows, rows...)
+		}
+		return nil
+	})
+	return mismatched, failedRows, err
+}
+
+// inSnapshot starts a consistent snapshot of upstream and pins syncer at its binlog
+// location, then calls fn with the connection of the snapshot. waitSyncer waits
+// until syncer has flushed the pinned location, after that the downstream stays
+// at the location until fn returns.
+func (c *snapshotChecker) inSnapshot(fn func(source *sql.Conn, waitSyncer func() error) error) error {
+	baseConn, err := c.v.fromDB.GetBaseConn(c.v.ctx)
+	if err != nil {
+		return err
+	}
+	// the connection is in the snapshot transaction, don't put it back to the pool.
+	defer c.v.fromDB.ForceCloseConnWithoutErr(baseConn)
+
+	location, err := c.startSnapshot(baseConn.DBConn)
+	if err != nil {
+		return err
+	}
+	defer c.v.syncer.locationPin.release()
+	return fn(baseConn.DBConn, func() error {
+		return c.waitSyncerReach(location)
+	})
+}
+
+// startSnapshot starts a consistent snapshot transaction on the connection and pins
+// syncer at its binlog location. like dumpling, the global read lock is held while
+// starting the transaction so the snapshot matches the binlog location, it's released
+// right after the location is recorded. it needs the RELOAD privilege, which is
+// checked by the snapshot_privilege checking item.
+func (c *snapshotChecker) startSnapshot(source *sql.Conn) (binlog.Location, error) {
+	var location binlog.Location
+	ctx, cancel := context.WithTimeout(c.v.ctx, queryTimeout)
+	defer cancel()
+	if _, err := source.ExecContext(ctx, "FLUSH TABLES WITH READ LOCK"); err != nil {
+		return location, terror.Annotate(errors.Trace(err), "fail to lock upstream for consistent snapshot")
+	}
+	// UNLOCK TABLES doesn't end the transaction started after locking.
+	defer func() {
+		if _, err := source.ExecContext(ctx, "UNLOCK TABLES"); err != nil {
+			c.L.Warn("fail to unlock upstream tables", zap.Error(err))
+		}
+	}()
+	for _, query := range []string{
+		"SET SESSION TRANSACTION ISOLATION LEVEL REPEATABLE READ",
+		"START TRANSACTION /*!40108 WITH CONSISTENT SNAPSHOT */",
+	} {
+		if _, err := source.ExecContext(ctx, query); err != nil {
+			return location, errors.Trace(err)
+		}
+	}
+	// upstream can't commit before unlocking, so the location matches the snapshot.
+	pos, gs, err := conn.GetPosAndGs(tcontext.NewContext(ctx, c.L), c.v.fromDB, c.v.cfg.Flavor)
+	if err != nil {
+		return location, err
+	}
+	location = binlog.NewLocation(pos, gs)
+	// pin before unlocking, otherwise syncer may pass the location.
+	c.v.syncer.locationPin.pin(location)
+	return location, nil
+}
+
+func (c *snapshotChecker) checksum(db snapshotQuerier, table *filter.Table, tbl *snapshotTable, chunk *snapshotChunk) (snapshotChecksum, error) {
+	var sum snapshotChecksum
+	columnNames := tbl.columnNames()
+	columnIsNull := make([]string, 0, len(columnNames))
+	for _, name := range columnNames {
+		columnIsNull = append(columnIsNull, fmt.Sprintf("ISNULL(%s)", name))
+	}
+	where, args := chunk.where(tbl.pkColumnNames())
+	query := fmt.Sprintf("SELECT /*!40001 SQL_NO_CACHE */ COUNT(*), "+
+		"BIT_XOR(CAST(CRC32(CONCAT_WS(',', %s, CONCAT(%s))) AS UNSIGNED)) FROM %s WHERE %s",
+		strings.Join(columnNames, ", "), strings.Join(columnIsNull, ", "), table, where)
+	rows, err := c.query(db, query, args)
+	if err != nil {
+		return sum, err
+	}
+	if len(rows) != 1 || len(rows[0]) != 2 {
+		return sum, errors.Errorf("unexpected checksum result of %s", table)
+	}
+	if _, err = fmt.Sscan(rows[0][0].String, &sum.count); err != nil {
+		return sum, errors.Trace(err)
+	}
+	// BIT_XOR returns 0 when there's no row, keep it in case of other implementations.
+	if rows[0][1].Valid {
+		if _, err = fmt.Sscan(rows[0][1].String, &sum.checksum); err != nil {
+			return sum, errors.Trace(err)
+		}
+	}
+	return sum, nil
+}
+
+// compareChunkRows compares the rows of the chunk one by one and returns the mismatched rows.
+// it's called in the snapshot of upstream after syncer has reached the pinned location.
+func (c *snapshotChecker) compareChunkRows(source snapshotQuerier, tbl *snapshotTable, chunk *snapshotChunk) ([]*validateFailedRow, error) {
+	sourceRows, err := c.getChunkRows(source, tbl.source, tbl, chunk)
+	if err != nil {
+		return nil, err
+	}
+	targetRows, err := c.getChunkRows(c.v.toDB.DB, tbl.target, tbl, chunk)
+	if err != nil {
+		return nil, err
+	}
+	sourceTable := &cdcmodel.TableName{Schema: tbl.source.Schema, Table: tbl.source.Name}
+	targetTable := &cdcmodel.TableName{Schema: tbl.target.Schema, Table: tbl.target.Name}
+	compareContext := &validateCompareContext{
+		logger:      c.L,
+		sourceTable: sourceTable,
+		targetTable: targetTable,
+		columns:     tbl.tableInfo.Columns,
+	}
+	failedRows := make([]*validateFailedRow, 0)
+	for key, sourceRow := range sourceRows {
+		targetRow, ok := targetRows[key]
+		if !ok {
+			failedRows = append(failedRows, &validateFailedRow{
+				tp:     rowNotExist,
+				srcJob: tbl.genRowJob(key, rowInsert, sourceRow),
+			})
+			continue
+		}
+		eq, err2 := compareContext.compareData(key, sourceRow, targetRow)
+		if err2 != nil {
+			return nil, err2
+		}
+		if !eq {
+			failedRows = append(failedRows, &validateFailedRow{
+				tp:      rowDifferent,
+				dstData: targetRow,
+				srcJob:  tbl.genRowJob(key, rowInsert, sourceRow),
+			})
+		}
+	}
+	for key, targetRow := range targetRows {
+		if _, ok := sourceRows[key]; !ok {
+			failedRows = append(failedRows, &validateFailedRow{
+				tp:      deletedRowExists,
+				dstData: targetRow,
+				srcJob:  tbl.genRowJob(key, rowDeleted, targetRow),
+			})
+		}
+	}
+	return failedRows, nil
+}
+
+func (c *snapshotChecker) getChunkRows(db snapshotQuerier, table *filter.Table, tbl *snapshotTable, chunk *snapshotChunk) (map[string][]*sql.NullString, error) {
+	where, args := chunk.where(tbl.pkColumnNames())
+	query := fmt.Sprintf("SELECT /*!40001 SQL_NO_CACHE */ %s FROM %s WHERE %s",
+		strings.Join(tbl.columnNames(), ", "), table, where)
+	rows, err := c.query(db, query, args)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]*sql.NullString, len(rows))
+	for _, row := range rows {
+		pkValues := make([]string, 0, len(tbl.pkOffsets))
+		for _, offset := range tbl.pkOffsets {
+			pkValues = append(pkValues, row[offset].String)
+		}
+		result[genRowKeyByString(pkValues)] = row
+	}
+	return result, nil
+}
+
+func (c *snapshotChecker) query(db snapshotQuerier, query string, args []interface{}) ([][]*sql.NullString, error) {
+	ctx, cancel := context.WithTimeout(c.v.ctx, queryTimeout)
+	defer cancel()
+	c.L.Debug("query statement", zap.String("query", query), zap.Reflect("args", args))
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	var result [][]*sql.NullString
+	for rows.Next() {
+		row, err2 := scanRow(rows)
+		if err2 != nil {
+			return nil, err2
+		}
+		result = append(result, row)
+	}
+	return result, errors.Trace(rows.Err())
+}
+
+// waitSyncerReach waits until syncer has flushed the location.
+func (c *snapshotChecker) waitSyncerReach(location binlog.Location) error {
+	for {
+		syncLoc := c.v.syncer.getFlushedGlobalPoint()
+		syncLoc.Position.Name = utils.ExtractRealName(syncLoc.Position.Name)
+		if binlog.CompareLocation(location, syncLoc, c.v.cfg.EnableGTID) <= 0 {
+			return nil
+		}
+		c.L.Debug("wait syncer reach pinned location", zap.Stringer("location", location), zap.Stringer("syncer", syncLoc))
+		select {
+		case <-c.v.ctx.Done():
+			return c.v.ctx.Err()
+		case <-time.After(c.v.checkInterval):
+		}
+	}
+}
+
+func (c *snapshotChecker) addErrorRows(rows []*validateFailedRow) {
+	if len(rows) == 0 {
+		return
+	}
+	c.persistMu.Lock()
+	defer c.persistMu.Unlock()
+	c.Lock()
+	c.errorRows = append(c.errorRows, rows...)
+	c.Unlock()
+	c.v.incrErrorRowCount(len(rows))
+}
+
+func (c *snapshotChecker) getErrorRows() []*validateFailedRow {
+	c.Lock()
+	defer c.Unlock()
+	return append([]*validateFailedRow(nil), c.errorRows...)
+}
+
+// resetErrorRows removes the error rows after they're persisted, the caller should hold persistMu.
+func (c *snapshotChecker) resetErrorRows() {
+	c.Lock()
+	defer c.Unlock()
+	c.errorRows = make([]*validateFailedRow, 0)
+}
+
+func (t *snapshotTable) columnNames() []string {
+	names := make([]string, 0, len(t.tableInfo.Columns))
+	for _, col := range t.tableInfo.Columns {
+		names = append(names, dbutil.ColumnName(col.Name.O))
+	}
+	return names
+}
+
+func (t *snapshotTable) pkColumnNames() []string {
+	names := make([]string, 0, len(t.pkOffsets))
+	for _, offset := range t.pkOffsets {
+		names = append(names, dbutil.ColumnName(t.tableInfo.Columns[offset].Name.O))
+	}
+	return names
+}
+
+func (t *snapshotTable) genRowJob(key string, tp rowChangeJobType, data []*sql.NullString) *rowValidationJob {
+	values := make([]interface{}, len(data))
+	for i, d := range data {
+		if d.Valid {
+			values[i] = d.String
+		}
+	}
+	var beforeImage, afterImage []interface{}
+	if tp == rowDeleted {
+		beforeImage = values
+	} else {
+		afterImage = values
+	}
+	return &rowValidationJob{
+		Key: key,
+		Tp:  tp,
+		row: sqlmodel.NewRowChange(
+			&cdcmodel.TableName{Schema: t.source.Schema, Table: t.source.Name},
+			&cdcmodel.TableName{Schema: t.target.Schema, Table: t.target.Name},
+			beforeImage, afterImage,
+			t.tableInfo, t.tableInfo,
+			nil,
+		),
+	}
+}
+
+func (c *snapshotChunk) where(pkNames []string) (string, []interface{}) {
+	var (
+		conds []string
+		args  []interface{}
+	)
+	columns := "(" + strings.Join(pkNames, ", ") + ")"
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(pkNames)), ", ") + ")"
+	if c.lower != nil {
+		conds = append(conds, columns+" > "+placeholders)
+		for _, v := range c.lower {
+			args = append(args, v)
+		}
+	}
+	if c.upper != nil {
+		conds = append(conds, columns+" <= "+placeholders)
+		for _, v := range c.upper {
+			args = append(args, v)
+		}
+	}
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(conds, " AND "), args
+}
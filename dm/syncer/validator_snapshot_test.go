@@ -0,0 +1,185 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotChunkWhere(t *testing.T) {
+	pkNames := []string{"`a`", "`b`"}
+	where, args := (&snapshotChunk{}).where(pkNames)
+	require.Equal(t, "TRUE", where)
+	require.Nil(t, args)
+
+	where, args = (&snapshotChunk{lower: []string{"1", "x"}}).where(pkNames)
+	require.Equal(t, "(`a`, `b`) > (?, ?)", where)
+	require.Equal(t, []interface{}{"1", "x"}, args)
+
+	where, args = (&snapshotChunk{lower: []string{"1", "x"}, upper: []string{"3", "y"}}).where(pkNames)
+	require.Equal(t, "(`a`, `b`) > (?, ?) AND (`a`, `b`) <= (?, ?)", where)
+	require.Equal(t, []interface{}{"1", "x", "3", "y"}, args)
+}
+
+func TestGetSnapshotHandle(t *testing.T) {
+	tableInfo := genValidateTableInfo(t, "create table t(a int primary key, b int not null, unique key(b))")
+	require.Equal(t, "a", getSnapshotHandle(tableInfo).Columns[0].Name.O)
+
+	tableInfo = genValidateTableInfo(t, "create table t(a int, b int not null, c int, unique key(a), unique key(b), key(c))")
+	require.Equal(t, "b", getSnapshotHandle(tableInfo).Columns[0].Name.O)
+
+	tableInfo = genValidateTableInfo(t, "create table t(a int, b int, unique key(a))")
+	require.Nil(t, getSnapshotHandle(tableInfo))
+}
+
+func TestSnapshotCheckerCheckChunks(t *testing.T) {
+	require.Nil(t, failpoint.Enable("github.com/pingcap/tiflow/dm/syncer/ValidatorMockUpstreamTZ", `return()`))
+	defer func() {
+		require.Nil(t, failpoint.Disable("github.com/pingcap/tiflow/dm/syncer/ValidatorMockUpstreamTZ"))
+	}()
+
+	cfg := genSubtaskConfig(t)
+	cfg.ValidatorCfg.Mode = config.ValidationSnapshot
+	cfg.ValidatorCfg.SnapshotChunkSize = 2
+	_, mock, err := conn.InitMockDBFull()
+	require.NoError(t, err)
+	defer func() {
+		conn.DefaultDBProvider = &conn.DefaultDBProviderImpl{}
+	}()
+	syncerLoc := binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 200}, nil)
+	syncerObj := NewSyncer(cfg, nil, nil)
+	syncerObj.checkpoint = &mockedCheckPointForValidator{currLoc: syncerLoc, nextLoc: syncerLoc}
+	validator := NewContinuousDataValidator(cfg, syncerObj, false)
+	validator.persistHelper.schemaInitialized.Store(true)
+	require.NoError(t, validator.initialize())
+	defer validator.cancel()
+	checker := validator.snapshotChecker
+	require.NotNil(t, checker)
+
+	tbl := &snapshotTable{
+		source:    &filter.Table{Schema: "test", Name: "tbl"},
+		target:    &filter.Table{Schema: "test", Name: "tbl"},
+		tableInfo: genValidateTableInfo(t, "create table tbl(a int primary key, b varchar(100))"),
+		pkOffsets: []int{0},
+	}
+	// split into [-inf, 2], (2, +inf)
+	mock.ExpectQuery("SELECT .* `a` FROM `test`.`tbl` WHERE TRUE ORDER BY `a` LIMIT 1 OFFSET 1").
+		WillReturnRows(sqlmock.NewRows([]string{"a"}).AddRow("2"))
+	mock.ExpectQuery("SELECT .* `a` FROM `test`.`tbl` WHERE \\(`a`\\) > \\(\\?\\) ORDER BY `a` LIMIT 1 OFFSET 1").
+		WithArgs("2").WillReturnRows(sqlmock.NewRows([]string{"a"}))
+	chunks, err := checker.splitChunks(tbl)
+	require.NoError(t, err)
+	require.Equal(t, []*snapshotChunk{{upper: []string{"2"}}, {lower: []string{"2"}}}, chunks)
+
+	// the snapshot is started under the global read lock, and syncer is pinned at its location.
+	mock.ExpectExec("FLUSH TABLES WITH READ LOCK").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SET SESSION TRANSACTION ISOLATION LEVEL REPEATABLE READ").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("START TRANSACTION").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SHOW MASTER STATUS").WillReturnRows(
+		sqlmock.NewRows([]string{"File", "Position", "Binlog_Do_DB", "Binlog_Ignore_DB", "Executed_Gtid_Set"}).
+			AddRow("mysql-bin.000001", 100, "", "", ""))
+	mock.ExpectExec("UNLOCK TABLES").WillReturnResult(sqlmock.NewResult(0, 0))
+	// the second chunk is mismatched
+	checksumRows := func(cnt, sum int) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(cnt, sum)
+	}
+	mock.ExpectQuery("SELECT .* COUNT\\(\\*\\), BIT_XOR.* FROM `test`.`tbl` WHERE \\(`a`\\) <= \\(\\?\\)").
+		WithArgs("2").WillReturnRows(checksumRows(2, 100))
+	mock.ExpectQuery("SELECT .* COUNT\\(\\*\\), BIT_XOR.* FROM `test`.`tbl` WHERE \\(`a`\\) > \\(\\?\\)").
+		WithArgs("2").WillReturnRows(checksumRows(2, 200))
+	mock.ExpectQuery("SELECT .* COUNT\\(\\*\\), BIT_XOR.* FROM `test`.`tbl` WHERE \\(`a`\\) <= \\(\\?\\)").
+		WithArgs("2").WillReturnRows(checksumRows(2, 100))
+	mock.ExpectQuery("SELECT .* COUNT\\(\\*\\), BIT_XOR.* FROM `test`.`tbl` WHERE \\(`a`\\) > \\(\\?\\)").
+		WithArgs("2").WillReturnRows(checksumRows(2, 300))
+	// narrow down to rows in the same snapshot: 3 is different, 4 is missing and 5 is redundant in downstream
+	mock.ExpectQuery("SELECT .* `a`, `b` FROM `test`.`tbl` WHERE \\(`a`\\) > \\(\\?\\)").
+		WithArgs("2").WillReturnRows(sqlmock.NewRows([]string{"a", "b"}).
+		AddRow("3", "c").AddRow("4", "d").AddRow("6", nil))
+	mock.ExpectQuery("SELECT .* `a`, `b` FROM `test`.`tbl` WHERE \\(`a`\\) > \\(\\?\\)").
+		WithArgs("2").WillReturnRows(sqlmock.NewRows([]string{"a", "b"}).
+		AddRow("3", "x").AddRow("5", "e").AddRow("6", nil))
+	mismatched, failedRows, err := checker.checkChunks(tbl, chunks)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 1, mismatched)
+	// the pin is released after checking.
+	hold, _ := syncerObj.locationPin.holds(syncerLoc, false)
+	require.False(t, hold)
+	sort.Slice(failedRows, func(i, j int) bool {
+		return failedRows[i].srcJob.Key < failedRows[j].srcJob.Key
+	})
+	require.Len(t, failedRows, 3)
+	require.Equal(t, rowDifferent, failedRows[0].tp)
+	require.Equal(t, "3", failedRows[0].srcJob.Key)
+	require.Equal(t, []interface{}{"3", "c"}, failedRows[0].srcJob.row.RowValues())
+	require.Equal(t, "x", failedRows[0].dstData[1].String)
+	require.Equal(t, rowNotExist, failedRows[1].tp)
+	require.Equal(t, "4", failedRows[1].srcJob.Key)
+	require.Equal(t, deletedRowExists, failedRows[2].tp)
+	require.Equal(t, "5", failedRows[2].srcJob.Key)
+	require.Equal(t, []interface{}{"5", "e"}, failedRows[2].srcJob.row.RowValues())
+
+	checker.addErrorRows(failedRows)
+	require.Equal(t, int64(3), validator.getNewErrorRowCount())
+	require.Len(t, checker.getErrorRows(), 3)
+	checker.resetErrorRows()
+	require.Len(t, checker.getErrorRows(), 0)
+}
+
+func TestStartSnapshotCheck(t *testing.T) {
+	cfg := genSubtaskConfig(t)
+	cfg.ValidatorCfg.Mode = config.ValidationFull
+	cfg.IsSharding = true
+	syncerObj := NewSyncer(cfg, nil, nil)
+	syncerObj.running.Store(true)
+	validator := NewContinuousDataValidator(cfg, syncerObj, false)
+	validator.reset()
+	stage, _ := validator.getSnapshotCheckStage()
+	require.Equal(t, pb.Stage_InvalidStage, stage)
+
+	// validator is not running
+	err := validator.UpdateValidator(&pb.UpdateValidationWorkerRequest{SnapshotCheck: true})
+	require.True(t, terror.ErrValidatorSnapshotCheck.Equal(err))
+
+	validator.ctx, validator.cancel = context.WithCancel(context.Background())
+	defer validator.cancel()
+	validator.setStage(pb.Stage_Running)
+	validator.setSnapshotCheckStage(pb.Stage_Running, "")
+	err = validator.StartSnapshotCheck()
+	require.True(t, terror.ErrValidatorSnapshotCheck.Equal(err))
+	require.Contains(t, err.Error(), "another snapshot check is running")
+
+	// rows of sharding tables are not checked, the check finishes with a message.
+	validator.setSnapshotCheckStage(pb.Stage_Finished, "")
+	require.NoError(t, validator.UpdateValidator(&pb.UpdateValidationWorkerRequest{SnapshotCheck: true}))
+	validator.wg.Wait()
+	stage, msg := validator.getSnapshotCheckStage()
+	require.Equal(t, pb.Stage_Finished, stage)
+	require.Contains(t, msg, "skipped")
+	// the cutover location is not changed by a snapshot check request.
+	require.Nil(t, validator.cutOverLocation.Load())
+}
@@ -105,6 +105,11 @@ function test_privilege_precheck() {
 	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
 		"check-task $cur/conf/task-priv.yaml" \
 		"\"msg\": \"pre-check is passed. \"" 1
+	# the estimate of migration is displayed only when it's enabled
+	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
+		"check-task $cur/conf/task-priv.yaml --estimate" \
+		"pre-check is passed. " 1 \
+		"estimated duration of full data migration" 1
 	run_sql_tidb "drop user 'test1'@'%';"
 	echo "pass test_privilege_precheck"
 }
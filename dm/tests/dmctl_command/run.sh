@@ -8,8 +8,8 @@ WORK_DIR=$TEST_DIR/$TEST_NAME
 
 db_name=$TEST_NAME
 
-# 43 normal help message + 1 "PASS" line
-help_cnt=44
+# 47 normal help message + 1 "PASS" line
+help_cnt=48
 
 function get_uuid() {
 	uuid=$(echo "show variables like '%server_uuid%';" | MYSQL_PWD=123456 mysql -uroot -h$1 -P$2 | awk 'FNR == 2 {print $2}')
@@ -229,7 +229,7 @@ function run_validation_start_stop_cmd {
 	echo "--> (fail) validation start: invalid mode"
 	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
 		"validation start --mode xxx" \
-		"Error: mode should be either \`full\` or \`fast\`" 1
+		"Error: mode should be one of \`full\`, \`fast\` or \`snapshot\`" 1
 
 	echo "--> (fail) validation start: missing start-time value"
 	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
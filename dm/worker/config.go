@@ -102,6 +102,12 @@ type Config struct {
 
 	RelayDir string `toml:"relay-dir" json:"relay-dir"`
 
+	// labels like zone and host, the DM-master binds sources to DM-workers according to their placement config
+	Labels map[string]string `toml:"labels" json:"labels"`
+	// the max load this DM-worker is expected to handle, 0 means unlimited. each running subtask and relay counts as
+	// one, plus one for every 1000 rows per second a subtask synced recently and plus one when relay is catching up.
+	Capacity int64 `toml:"capacity" json:"capacity"`
+
 	// tls config
 	security.Security
 
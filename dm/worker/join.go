@@ -49,8 +49,10 @@ func (s *Server) JoinMaster(endpoints []string) error {
 	defer cancel()
 
 	req := &pb.RegisterWorkerRequest{
-		Name:    s.cfg.Name,
-		Address: s.cfg.AdvertiseAddr,
+		Name:     s.cfg.Name,
+		Address:  s.cfg.AdvertiseAddr,
+		Labels:   s.cfg.Labels,
+		Capacity: s.cfg.Capacity,
 	}
 
 	var errorStr string
@@ -62,8 +62,9 @@ type realRelayHolder struct {
 	sync.RWMutex
 	wg sync.WaitGroup
 
-	relay relay.Process
-	cfg   *config.SourceConfig
+	relay    relay.Process
+	archiver relay.Archiver // nil if relay log archiving not enabled
+	cfg      *config.SourceConfig
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -98,6 +99,12 @@ func (h *realRelayHolder) Init(ctx context.Context, interceptors []relay.PurgeIn
 		h,
 		streamer.GetReaderHub(),
 	}
+	// relay log files should not be purged before archived
+	if h.cfg.RelayArchive.Storage != "" {
+		h.archiver = relay.NewArchiver(h.cfg.RelayArchive, h.cfg.RelayDir, h)
+		operators = append(operators, h.archiver)
+		interceptors = append(interceptors, h.archiver)
+	}
 
 	if err := h.relay.Init(ctx); err != nil {
 		return nil, terror.Annotate(err, "initial relay unit")
@@ -113,6 +120,9 @@ func (h *realRelayHolder) Start() {
 		defer h.wg.Done()
 		h.run()
 	}()
+	if h.archiver != nil {
+		h.archiver.Start()
+	}
 }
 
 // Close closes the holder.
@@ -126,6 +136,9 @@ func (h *realRelayHolder) Close() {
 	}
 	h.wg.Wait() // wait process return
 
+	if h.archiver != nil {
+		h.archiver.Close()
+	}
 	h.relay.Close()
 }
 
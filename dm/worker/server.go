@@ -803,6 +803,9 @@ func (s *Server) QueryStatus(ctx context.Context, req *pb.QueryStatusRequest) (*
 
 	var err error
 	resp.SubTaskStatus, sourceStatus.RelayStatus, err = w.QueryStatus(ctx, req.Name)
+	if req.TableStatus {
+		w.fillTableStatus(resp.SubTaskStatus)
+	}
 
 	if err != nil {
 		resp.Msg = fmt.Sprintf("error when get master status: %v", err)
@@ -971,6 +974,102 @@ func (s *Server) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReque
 	}, nil
 }
 
+// OperateSyncDelay operates the delayed window of delayed replication.
+func (s *Server) OperateSyncDelay(ctx context.Context, req *pb.OperateSyncDelayWorkerRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "OperateSyncDelay"), zap.Stringer("payload", req))
+
+	w := s.getSourceWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call OperateSyncDelay, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	err := w.OperateSyncDelay(req)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	return &pb.CommonWorkerResponse{
+		Result: true,
+		Source: w.cfg.SourceID,
+		Worker: s.cfg.Name,
+	}, nil
+}
+
+// ResyncTables dumps and loads the tables again inside a running subtask.
+func (s *Server) ResyncTables(ctx context.Context, req *pb.ResyncTablesWorkerRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "ResyncTables"), zap.Stringer("payload", req))
+
+	w := s.getSourceWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call ResyncTables, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	err := w.ResyncTables(req)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	return &pb.CommonWorkerResponse{
+		Result: true,
+		Source: w.cfg.SourceID,
+		Worker: s.cfg.Name,
+	}, nil
+}
+
+// UpdateThrottle updates the throttle limits of a subtask at runtime.
+func (s *Server) UpdateThrottle(ctx context.Context, req *pb.UpdateThrottleWorkerRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "UpdateThrottle"), zap.Stringer("payload", req))
+
+	w := s.getSourceWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call UpdateThrottle, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	err := w.UpdateThrottle(req)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	return &pb.CommonWorkerResponse{
+		Result: true,
+		Source: w.cfg.SourceID,
+		Worker: s.cfg.Name,
+	}, nil
+}
+
+// UpdateRules previews, prepares, commits or aborts the block-allow list, routes and filters of a running subtask.
+func (s *Server) UpdateRules(ctx context.Context, req *pb.UpdateRulesWorkerRequest) (*pb.UpdateRulesWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "UpdateRules"), zap.Stringer("payload", req))
+
+	resp := &pb.UpdateRulesWorkerResponse{Worker: s.cfg.Name}
+	w := s.getSourceWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call UpdateRules, because no mysql source is being handled in the worker")
+		resp.Msg = terror.ErrWorkerNoStart.Generate().Error()
+		return resp, nil
+	}
+	resp.Source = w.cfg.SourceID
+
+	cfg := config.NewSubTaskConfig()
+	if err := cfg.Decode(req.SubtaskCfgTomlString, false); err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	tables, location, err := w.UpdateRules(ctx, cfg, req.Preview, req.Backfill, req.Op)
+	if err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	resp.Result = true
+	resp.Tables = tables
+	if !req.Preview {
+		resp.Location = location.String()
+	}
+	return resp, nil
+}
+
 // GetWorkerCfg get worker config.
 func (s *Server) GetWorkerCfg(ctx context.Context, req *pb.GetWorkerCfgRequest) (*pb.GetWorkerCfgResponse, error) {
 	log.L().Info("", zap.String("request", "GetWorkerCfg"), zap.Stringer("payload", req))
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tidb/pkg/util/filter"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
@@ -1128,6 +1129,96 @@ func (w *SourceWorker) HandleError(ctx context.Context, req *pb.HandleWorkerErro
 	return st.HandleError(ctx, req, w.getRelayWithoutLock())
 }
 
+// OperateSyncDelay operates the delayed window of the sync unit in the specified subtask.
+func (w *SourceWorker) OperateSyncDelay(req *pb.OperateSyncDelayWorkerRequest) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(req.TaskName)
+	if st == nil {
+		return terror.ErrWorkerSubTaskNotFound.Generate(req.TaskName)
+	}
+
+	return st.OperateSyncDelay(req.Op)
+}
+
+// ResyncTables dumps and loads the tables again inside the specified subtask.
+func (w *SourceWorker) ResyncTables(req *pb.ResyncTablesWorkerRequest) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(req.TaskName)
+	if st == nil {
+		return terror.ErrWorkerSubTaskNotFound.Generate(req.TaskName)
+	}
+
+	tables := make([]*filter.Table, 0, len(req.Tables))
+	for _, table := range req.Tables {
+		tables = append(tables, &filter.Table{Schema: req.Database, Name: table})
+	}
+	return st.ResyncTables(tables)
+}
+
+// UpdateThrottle updates the throttle limits of the load and sync units in the specified subtask.
+func (w *SourceWorker) UpdateThrottle(req *pb.UpdateThrottleWorkerRequest) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(req.TaskName)
+	if st == nil {
+		return terror.ErrWorkerSubTaskNotFound.Generate(req.TaskName)
+	}
+
+	throttleCfg := config.ThrottleConfig{
+		RowsPerSecond:  int(req.RowsPerSecond),
+		BytesPerSecond: req.BytesPerSecond,
+	}
+	if req.TargetLatency != "" {
+		if err := throttleCfg.TargetLatency.UnmarshalText([]byte(req.TargetLatency)); err != nil {
+			return terror.ErrConfigInvalidThrottle.Generate(fmt.Sprintf("invalid `target-latency` '%s'", req.TargetLatency))
+		}
+	}
+	if err := throttleCfg.Adjust(); err != nil {
+		return err
+	}
+	st.UpdateThrottle(throttleCfg)
+	return nil
+}
+
+// UpdateRules previews, prepares, commits or aborts the block-allow list, routes and filters of cfg in the subtask.
+func (w *SourceWorker) UpdateRules(ctx context.Context, cfg *config.SubTaskConfig, preview, backfill bool, op pb.UpdateRulesOp) ([]*pb.RuleChangedTable, binlog.Location, error) {
+	w.RLock()
+	if w.closed.Load() {
+		w.RUnlock()
+		return nil, binlog.Location{}, terror.ErrWorkerAlreadyClosed.Generate()
+	}
+	st := w.subTaskHolder.findSubTask(cfg.Name)
+	if st == nil {
+		w.RUnlock()
+		return nil, binlog.Location{}, terror.ErrWorkerSubTaskNotFound.Generate(cfg.Name)
+	}
+	// copy some config item from dm-worker's source config
+	err := copyConfigFromSource(cfg, w.cfg, w.relayEnabled.Load())
+	w.RUnlock()
+	if err != nil {
+		return nil, binlog.Location{}, err
+	}
+	// the subtask may wait for the sync unit to prepare or apply the rules, so the worker is not locked.
+	return st.UpdateRules(ctx, cfg, preview, backfill, op)
+}
+
 func (w *SourceWorker) observeValidatorStage(ctx context.Context, lastUsedRev int64) error {
 	var wg sync.WaitGroup
 
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tiflow/dm/common"
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/syncer"
 	"go.uber.org/zap"
 )
 
@@ -113,6 +114,23 @@ func (w *SourceWorker) Status(stName string, sourceStatus *binlog.SourceStatus)
 	return status
 }
 
+// fillTableStatus fills the replication status of downstream tables into the status of sync units.
+func (w *SourceWorker) fillTableStatus(status []*pb.SubTaskStatus) {
+	for _, stStatus := range status {
+		syncStatus := stStatus.GetSync()
+		if syncStatus == nil {
+			continue
+		}
+		st := w.subTaskHolder.findSubTask(stStatus.Name)
+		if st == nil {
+			continue
+		}
+		if syncUnit, ok := st.CurrUnit().(*syncer.Syncer); ok {
+			syncStatus.Tables = syncUnit.TableStatus()
+		}
+	}
+}
+
 // GetUnitAndSourceStatusJSON returns the status of the worker and its unit as json string.
 // This function will also cause every unit to print its status to log.
 func (w *SourceWorker) GetUnitAndSourceStatusJSON(stName string, sourceStatus *binlog.SourceStatus) string {
@@ -20,6 +20,7 @@ import (
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/util/filter"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/dumpling"
 	"github.com/pingcap/tiflow/dm/loader"
@@ -250,7 +251,8 @@ func (st *SubTask) StartValidator(expect pb.Stage, startWithSubtask bool) {
 	st.Lock()
 	defer st.Unlock()
 
-	if st.cfg.ValidatorCfg.Mode != config.ValidationFast && st.cfg.ValidatorCfg.Mode != config.ValidationFull {
+	if st.cfg.ValidatorCfg.Mode != config.ValidationFast && st.cfg.ValidatorCfg.Mode != config.ValidationFull &&
+		st.cfg.ValidatorCfg.Mode != config.ValidationSnapshot {
 		return
 	}
 	var syncerObj *syncer.Syncer
@@ -823,6 +825,78 @@ func (st *SubTask) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReq
 	return msg, err
 }
 
+// OperateSyncDelay operates the delayed window of the sync unit.
+func (st *SubTask) OperateSyncDelay(op pb.SyncDelayOp) error {
+	cu := st.CurrUnit()
+	syncUnit, ok := cu.(*syncer.Syncer)
+	if !ok {
+		return terror.ErrWorkerOperSyncUnitOnly.Generate(cu.Type())
+	}
+	return syncUnit.OperateSyncDelay(op)
+}
+
+// ResyncTables dumps and loads the tables again in the sync unit.
+func (st *SubTask) ResyncTables(tables []*filter.Table) error {
+	cu := st.CurrUnit()
+	syncUnit, ok := cu.(*syncer.Syncer)
+	if !ok {
+		return terror.ErrWorkerOperSyncUnitOnly.Generate(cu.Type())
+	}
+	return syncUnit.ResyncTables(tables)
+}
+
+// UpdateThrottle updates the throttle limits of the load and sync units.
+func (st *SubTask) UpdateThrottle(throttleCfg config.ThrottleConfig) {
+	st.Lock()
+	defer st.Unlock()
+
+	for _, u := range st.units {
+		switch u := u.(type) {
+		case *loader.LightningLoader:
+			u.UpdateThrottle(throttleCfg)
+		case *syncer.Syncer:
+			u.UpdateThrottle(throttleCfg)
+		}
+	}
+	cfg := *st.cfg
+	cfg.LoaderConfig.Throttle = throttleCfg
+	cfg.SyncerConfig.Throttle = throttleCfg
+	st.cfg = &cfg
+}
+
+// UpdateRules previews, prepares, commits or aborts the block-allow list, routes and filters of cfg
+// in the sync unit, and returns the upstream tables whose target tables are changed.
+// the config of the subtask is updated when the rules are committed.
+func (st *SubTask) UpdateRules(ctx context.Context, cfg *config.SubTaskConfig, preview, backfill bool, op pb.UpdateRulesOp) ([]*pb.RuleChangedTable, binlog.Location, error) {
+	cu := st.CurrUnit()
+	syncUnit, ok := cu.(*syncer.Syncer)
+	if !ok {
+		return nil, binlog.Location{}, terror.ErrWorkerOperSyncUnitOnly.Generate(cu.Type())
+	}
+	switch {
+	case preview || op == pb.UpdateRulesOp_PrepareRules:
+		return syncUnit.UpdateRules(ctx, cfg, preview, backfill)
+	case op == pb.UpdateRulesOp_AbortRules:
+		syncUnit.AbortRules()
+		return nil, binlog.Location{}, nil
+	}
+	tables, location, err := syncUnit.CommitRules()
+	if err != nil {
+		return tables, location, err
+	}
+
+	st.Lock()
+	defer st.Unlock()
+	newCfg := *st.cfg
+	newCfg.BAList = cfg.BAList
+	newCfg.BWList = cfg.BWList
+	newCfg.RouteRules = cfg.RouteRules
+	newCfg.FilterRules = cfg.FilterRules
+	newCfg.ExprFilter = cfg.ExprFilter
+	st.cfg = &newCfg
+	return tables, location, nil
+}
+
 func (st *SubTask) getCfg() *config.SubTaskConfig {
 	st.RLock()
 	defer st.RUnlock()
@@ -592,3 +592,33 @@ func TestSubtaskRace(t *testing.T) {
 	st.markResultCanceled()
 	// this test is to test data race, so don't need assert here
 }
+
+func TestSubTaskUpdateThrottle(t *testing.T) {
+	cfg := &config.SubTaskConfig{
+		Name: "test-update-throttle",
+	}
+	st := NewSubTaskWithStage(cfg, pb.Stage_Running, nil, "worker")
+	st.units = []unit.Unit{loader.NewLightning(cfg, nil, "worker"), syncer.NewSyncer(cfg, nil, nil)}
+
+	throttleCfg := config.ThrottleConfig{
+		RowsPerSecond:  1000,
+		BytesPerSecond: "10MiB",
+		TargetLatency:  config.Duration{Duration: 100 * time.Millisecond},
+	}
+	st.UpdateThrottle(throttleCfg)
+	require.Equal(t, throttleCfg, st.getCfg().LoaderConfig.Throttle)
+	require.Equal(t, throttleCfg, st.getCfg().SyncerConfig.Throttle)
+	// the original config is not modified
+	require.Equal(t, config.ThrottleConfig{}, cfg.SyncerConfig.Throttle)
+}
+
+func TestSubTaskUpdateRules(t *testing.T) {
+	cfg := &config.SubTaskConfig{
+		Name: "test-update-rules",
+	}
+	st := NewSubTaskWithStage(cfg, pb.Stage_Running, nil, "worker")
+	st.setCurrUnit(loader.NewLightning(cfg, nil, "worker"))
+
+	_, _, err := st.UpdateRules(context.Background(), cfg, true, false, pb.UpdateRulesOp_PrepareRules)
+	require.True(t, terror.ErrWorkerOperSyncUnitOnly.Equal(err))
+}
@@ -1860,6 +1860,10 @@ var doc = `{
                     "description": "Debezium only. Whether schema should be excluded in the output.",
                     "type": "boolean"
                 },
+                "debezium-output-key-and-schema-change": {
+                    "description": "Debezium only. Whether the message keys and the schema change events are output like\nthe Debezium MySQL connector, default to false for the compatibility of the existing output.",
+                    "type": "boolean"
+                },
                 "delete-only-output-handle-key-columns": {
                     "description": "DeleteOnlyOutputHandleKeyColumns is only available when the downstream is MQ.",
                     "type": "boolean"
@@ -3109,6 +3113,9 @@ var doc = `{
                 "debezium_disable_schema": {
                     "type": "boolean"
                 },
+                "debezium_output_key_and_schema_change": {
+                    "type": "boolean"
+                },
                 "delete_only_output_handle_key_columns": {
                     "type": "boolean"
                 },
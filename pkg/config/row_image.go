@@ -0,0 +1,69 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	// RowImageFull means outputting all columns of the before image.
+	RowImageFull string = "full"
+	// RowImageChangedOnly means only outputting the columns changed by the update event.
+	RowImageChangedOnly string = "changed-only"
+	// RowImageKeysOnly means only outputting the handle key columns of the before image.
+	RowImageKeysOnly string = "keys-only"
+)
+
+// RowImageRule controls the before image of the update and delete events
+// for the tables matched by the rule.
+// An empty field inherits the changefeed level `only-output-updated-columns`
+// or `delete-only-output-handle-key-columns` configuration.
+type RowImageRule struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	// UpdateOldValue controls the old value of the update event,
+	// can be "full", "changed-only" or "keys-only".
+	UpdateOldValue string `toml:"update-old-value" json:"update-old-value"`
+	// Delete controls the value of the delete event, can be "full" or "keys-only".
+	Delete string `toml:"delete" json:"delete"`
+}
+
+func (r *RowImageRule) validate(protocol Protocol) error {
+	if len(r.Matcher) == 0 {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"matcher of the row image rule should not be empty")
+	}
+
+	switch r.UpdateOldValue {
+	case "", RowImageFull, RowImageChangedOnly, RowImageKeysOnly:
+	default:
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"invalid update-old-value %s of the row image rule, matcher: %v",
+			r.UpdateOldValue, r.Matcher)
+	}
+	switch r.Delete {
+	case "", RowImageFull, RowImageKeysOnly:
+	default:
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"invalid delete %s of the row image rule, matcher: %v", r.Delete, r.Matcher)
+	}
+
+	// avro does not carry the old value of the update event at all.
+	if protocol == ProtocolAvro && r.UpdateOldValue != "" {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"avro protocol does not output the old value of the update event, "+
+				"do not set update-old-value of the row image rule, matcher: %v", r.Matcher)
+	}
+	return nil
+}
@@ -158,11 +158,21 @@ type SinkConfig struct {
 	// ContentCompatible is only available when the downstream is MQ.
 	ContentCompatible *bool `toml:"content-compatible" json:"content-compatible,omitempty"`
 
+	// RowImageRules is only available when the downstream is MQ or Storage.
+	// It overrides `only-output-updated-columns` and `delete-only-output-handle-key-columns`
+	// for the matched tables, the first matched rule is used.
+	RowImageRules []*RowImageRule `toml:"row-image-rules" json:"row-image-rules,omitempty"`
+
 	// TiDBSourceID is the source ID of the upstream TiDB,
 	// which is used to set the `tidb_cdc_write_source` session variable.
 	// Note: This field is only used internally and only used in the MySQL sink.
 	TiDBSourceID uint64 `toml:"-" json:"-"`
 
+	// ChangefeedEpoch is the epoch of the changefeed, which is used to generate
+	// the transactional IDs of the Kafka sink with exactly-once semantics.
+	// Note: This field is only used internally and only used in the Kafka sink.
+	ChangefeedEpoch uint64 `toml:"-" json:"-"`
+
 	// SafeMode is only available when the downstream is DB.
 	SafeMode           *bool               `toml:"safe-mode" json:"safe-mode,omitempty"`
 	KafkaConfig        *KafkaConfig        `toml:"kafka-config" json:"kafka-config,omitempty"`
@@ -188,6 +198,9 @@ type SinkConfig struct {
 
 	// Debezium only. Whether schema should be excluded in the output.
 	DebeziumDisableSchema *bool `toml:"debezium-disable-schema" json:"debezium-disable-schema,omitempty"`
+	// Debezium only. Whether the message keys and the schema change events are output like
+	// the Debezium MySQL connector, default to false for the compatibility of the existing output.
+	DebeziumOutputKeyAndSchemaChange *bool `toml:"debezium-output-key-and-schema-change" json:"debezium-output-key-and-schema-change,omitempty"`
 }
 
 // MaskSensitiveData masks sensitive data in SinkConfig
@@ -420,6 +433,7 @@ type KafkaConfig struct {
 	Cert                         *string                   `toml:"cert" json:"cert,omitempty"`
 	Key                          *string                   `toml:"key" json:"key,omitempty"`
 	InsecureSkipVerify           *bool                     `toml:"insecure-skip-verify" json:"insecure-skip-verify,omitempty"`
+	EnableExactlyOnce            *bool                     `toml:"enable-exactly-once" json:"enable-exactly-once,omitempty"`
 	CodecConfig                  *CodecConfig              `toml:"codec-config" json:"codec-config,omitempty"`
 	LargeMessageHandle           *LargeMessageHandleConfig `toml:"large-message-handle" json:"large-message-handle,omitempty"`
 	GlueSchemaRegistryConfig     *GlueSchemaRegistryConfig `toml:"glue-schema-registry-config" json:"glue-schema-registry-config"`
@@ -577,6 +591,24 @@ type PulsarConfig struct {
 	// and 'type' always use 'client_credentials'
 	OAuth2 *OAuth2 `toml:"oauth2" json:"oauth2,omitempty"`
 
+	// EnableTransaction sends the messages in pulsar transactions, the transactions
+	// are committed at the flush boundaries, so the consumers never read the messages
+	// of a flush which is not finished.
+	EnableTransaction *bool `toml:"enable-transaction" json:"enable-transaction,omitempty"`
+	// TransactionTimeout is the timeout of the pulsar transaction (default: 60 seconds)
+	TransactionTimeout *TimeSec `toml:"transaction-timeout" json:"transaction-timeout,omitempty"`
+
+	// BatchingByKey groups the messages into batches by the key, so the messages with
+	// different keys are not mixed up in a batch for the key-shared subscriptions.
+	BatchingByKey *bool `toml:"batching-by-key" json:"batching-by-key,omitempty"`
+
+	// EnableChunking splits the large message into chunks, which is an alternative to
+	// the claim-check. It can not be enabled with batching, so batching is disabled.
+	EnableChunking *bool `toml:"enable-chunking" json:"enable-chunking,omitempty"`
+	// ChunkMaxMessageSize is the max size of a chunk, it only takes effect if it is
+	// smaller than the maxMessageSize of the broker.
+	ChunkMaxMessageSize *uint `toml:"chunk-max-message-size" json:"chunk-max-message-size,omitempty"`
+
 	// BrokerURL is used to configure service brokerUrl for the Pulsar service.
 	// This parameter is a part of the `sink-uri`. Internal use only.
 	BrokerURL string `toml:"-" json:"-"`
@@ -604,6 +636,10 @@ func (c *PulsarConfig) validate() (err error) {
 			return err
 		}
 	}
+	if util.GetOrZero(c.EnableChunking) && util.GetOrZero(c.BatchingByKey) {
+		return cerror.ErrPulsarInvalidConfig.GenWithStackByArgs(
+			"`batching-by-key` can not be used with `enable-chunking`, since batching is disabled")
+	}
 	return nil
 }
 
@@ -688,6 +724,19 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL) error {
 		}
 	}
 
+	// kafka-go doesn't implement the idempotent and transactional producer.
+	if util.GetOrZero(s.EnableKafkaSinkV2) {
+		enableExactlyOnce, err := s.kafkaExactlyOnceEnabled(sinkURI)
+		if err != nil {
+			return err
+		}
+		if enableExactlyOnce {
+			return cerror.ErrSinkInvalidConfig.GenWithStack(
+				"`enable-exactly-once` is not supported by the kafka sink v2, " +
+					"please set `enable-kafka-sink-v2` to false")
+		}
+	}
+
 	if sink.IsPulsarScheme(sinkURI.Scheme) && s.PulsarConfig == nil {
 		s.PulsarConfig = &PulsarConfig{
 			SinkURI: sinkURI,
@@ -731,6 +780,12 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL) error {
 				"do not set `delete-only-output-handle-key-columns` to true")
 	}
 
+	for _, rule := range s.RowImageRules {
+		if err := rule.validate(protocol); err != nil {
+			return err
+		}
+	}
+
 	// validate storage sink related config
 	if sinkURI != nil && sink.IsStorageScheme(sinkURI.Scheme) {
 		// validate date separator
@@ -764,6 +819,22 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL) error {
 }
 
 // validateAndAdjustSinkURI validate and adjust `Protocol` and `TxnAtomicity` by sinkURI.
+// kafkaExactlyOnceEnabled returns whether the kafka sink sends the messages in
+// transactions, the parameter in the sink URI takes precedence over the config.
+func (s *SinkConfig) kafkaExactlyOnceEnabled(sinkURI *url.URL) (bool, error) {
+	if sinkURI.Scheme != sink.KafkaScheme && sinkURI.Scheme != sink.KafkaSSLScheme {
+		return false, nil
+	}
+	if v := sinkURI.Query().Get("enable-exactly-once"); v != "" {
+		enable, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+		}
+		return enable, nil
+	}
+	return s.KafkaConfig != nil && util.GetOrZero(s.KafkaConfig.EnableExactlyOnce), nil
+}
+
 func (s *SinkConfig) validateAndAdjustSinkURI(sinkURI *url.URL) error {
 	if sinkURI == nil {
 		return nil
@@ -437,3 +437,116 @@ func TestValidateAndAdjustStorageConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 16, util.GetOrZero(s.Sink.FileIndexWidth))
 }
+
+func TestValidateAndAdjustRowImageRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		sinkURI string
+		rule    *RowImageRule
+		wantErr string
+	}{
+		{
+			sinkURI: "kafka://127.0.0.1:9092?protocol=canal-json",
+			rule: &RowImageRule{
+				Matcher:        []string{"test.*"},
+				UpdateOldValue: RowImageChangedOnly,
+				Delete:         RowImageKeysOnly,
+			},
+		},
+		{
+			sinkURI: "s3://bucket?protocol=csv",
+			rule: &RowImageRule{
+				Matcher: []string{"test.*"},
+				Delete:  RowImageKeysOnly,
+			},
+		},
+		{
+			sinkURI: "kafka://127.0.0.1:9092?protocol=avro",
+			rule: &RowImageRule{
+				Matcher: []string{"test.*"},
+				Delete:  RowImageFull,
+			},
+		},
+		{
+			sinkURI: "kafka://127.0.0.1:9092?protocol=canal-json",
+			rule: &RowImageRule{
+				Delete: RowImageKeysOnly,
+			},
+			wantErr: ".*matcher of the row image rule should not be empty.*",
+		},
+		{
+			sinkURI: "kafka://127.0.0.1:9092?protocol=canal-json",
+			rule: &RowImageRule{
+				Matcher:        []string{"test.*"},
+				UpdateOldValue: "none",
+			},
+			wantErr: ".*invalid update-old-value none.*",
+		},
+		{
+			sinkURI: "kafka://127.0.0.1:9092?protocol=canal-json",
+			rule: &RowImageRule{
+				Matcher: []string{"test.*"},
+				Delete:  RowImageChangedOnly,
+			},
+			wantErr: ".*invalid delete changed-only.*",
+		},
+		{
+			sinkURI: "kafka://127.0.0.1:9092?protocol=avro",
+			rule: &RowImageRule{
+				Matcher:        []string{"test.*"},
+				UpdateOldValue: RowImageFull,
+			},
+			wantErr: ".*avro protocol does not output the old value of the update event.*",
+		},
+	}
+
+	for _, tc := range testCases {
+		sinkURI, err := url.Parse(tc.sinkURI)
+		require.NoError(t, err)
+		s := GetDefaultReplicaConfig()
+		s.Sink.RowImageRules = []*RowImageRule{tc.rule}
+		err = s.ValidateAndAdjust(sinkURI)
+		if tc.wantErr == "" {
+			require.NoError(t, err)
+		} else {
+			require.Regexp(t, tc.wantErr, err)
+		}
+	}
+}
+
+func TestValidateAndAdjustPulsarChunking(t *testing.T) {
+	t.Parallel()
+
+	sinkURI, err := url.Parse("pulsar://127.0.0.1:6650/test?protocol=canal-json")
+	require.NoError(t, err)
+	s := GetDefaultReplicaConfig()
+	s.Sink.PulsarConfig = &PulsarConfig{
+		EnableChunking: util.AddressOf(true),
+	}
+	require.NoError(t, s.ValidateAndAdjust(sinkURI))
+
+	s.Sink.PulsarConfig.BatchingByKey = util.AddressOf(true)
+	err = s.ValidateAndAdjust(sinkURI)
+	require.Regexp(t, ".*`batching-by-key` can not be used with `enable-chunking`.*", err)
+}
+
+func TestValidateAndAdjustKafkaExactlyOnce(t *testing.T) {
+	t.Parallel()
+
+	sinkURI, err := url.Parse("kafka://127.0.0.1:9092/test?protocol=canal-json&enable-exactly-once=true")
+	require.NoError(t, err)
+	s := GetDefaultReplicaConfig()
+	require.NoError(t, s.ValidateAndAdjust(sinkURI))
+
+	s.Sink.EnableKafkaSinkV2 = util.AddressOf(true)
+	err = s.ValidateAndAdjust(sinkURI)
+	require.Regexp(t, ".*`enable-exactly-once` is not supported by the kafka sink v2.*", err)
+
+	sinkURI, err = url.Parse("kafka://127.0.0.1:9092/test?protocol=canal-json")
+	require.NoError(t, err)
+	require.NoError(t, s.ValidateAndAdjust(sinkURI))
+	s.Sink.KafkaConfig = &KafkaConfig{EnableExactlyOnce: util.AddressOf(true)}
+	err = s.ValidateAndAdjust(sinkURI)
+	require.Regexp(t, ".*`enable-exactly-once` is not supported by the kafka sink v2.*", err)
+}
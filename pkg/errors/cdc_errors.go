@@ -171,6 +171,10 @@ var (
 		"kafka async send message failed",
 		errors.RFCCodeText("CDC:ErrKafkaAsyncSendMessage"),
 	)
+	ErrKafkaTransaction = errors.Normalize(
+		"kafka transaction failed",
+		errors.RFCCodeText("CDC:ErrKafkaTransaction"),
+	)
 	ErrKafkaInvalidPartitionNum = errors.Normalize(
 		"invalid partition num %d",
 		errors.RFCCodeText("CDC:ErrKafkaInvalidPartitionNum"),
@@ -264,6 +268,10 @@ var (
 	ErrPulsarTopicNotExists = errors.Normalize("pulsar topic not exists after creation",
 		errors.RFCCodeText("CDC:ErrPulsarTopicNotExists"),
 	)
+	ErrPulsarTransaction = errors.Normalize(
+		"pulsar transaction failed",
+		errors.RFCCodeText("CDC:ErrPulsarTransaction"),
+	)
 
 	ErrRedoConfigInvalid = errors.Normalize(
 		"redo log config invalid",
@@ -420,6 +428,10 @@ var (
 		"debezium encode failed",
 		errors.RFCCodeText("CDC:ErrDebeziumEncodeFailed"),
 	)
+	ErrDebeziumDecodeFailed = errors.Normalize(
+		"debezium decode failed",
+		errors.RFCCodeText("CDC:ErrDebeziumDecodeFailed"),
+	)
 	ErrStorageSinkInvalidConfig = errors.Normalize(
 		"storage sink config invalid",
 		errors.RFCCodeText("CDC:ErrStorageSinkInvalidConfig"),
@@ -159,12 +159,18 @@ func (a *BatchEncoder) getKeySchemaCodec(
 }
 
 func (a *BatchEncoder) encodeValue(ctx context.Context, topic string, e *model.RowChangedEvent) ([]byte, error) {
+	columns := e.GetColumns()
 	if e.IsDelete() {
-		return nil, nil
+		// the delete event is encoded as a tombstone message which only has the key part,
+		// unless the row image of the table requires all columns of the delete event.
+		if a.config.RowImage(e.TableInfo).DeleteOnlyHandleKeyColumns() {
+			return nil, nil
+		}
+		columns = e.GetPreColumns()
 	}
 
 	input := &avroEncodeInput{
-		columns:  e.GetColumns(),
+		columns:  columns,
 		colInfos: e.TableInfo.GetColInfosForRowChangedEvent(),
 	}
 	if len(input.columns) == 0 {
@@ -312,6 +318,7 @@ func (a *BatchEncoder) Build() (messages []*common.Message) {
 const (
 	insertOperation = "c"
 	updateOperation = "u"
+	deleteOperation = "d"
 )
 
 func getOperation(e *model.RowChangedEvent) string {
@@ -319,6 +326,8 @@ func getOperation(e *model.RowChangedEvent) string {
 		return insertOperation
 	} else if e.IsUpdate() {
 		return updateOperation
+	} else if e.IsDelete() {
+		return deleteOperation
 	}
 	return ""
 }
@@ -840,6 +840,45 @@ func TestAvroEncode(t *testing.T) {
 	}
 }
 
+func TestAvroEncodeDeleteWithRowImageRules(t *testing.T) {
+	codecConfig := common.NewConfig(config.ProtocolAvro)
+	codecConfig.EnableTiDBExtension = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	encoder, err := SetupEncoderAndSchemaRegistry4Testing(ctx, codecConfig)
+	defer TeardownEncoderAndSchemaRegistry4Testing()
+	require.NoError(t, err)
+	require.NotNil(t, encoder)
+
+	_, _, deleteEvent := utils.NewRowImageEvent4Test(t, config.GetDefaultReplicaConfig())
+	topic := "default"
+
+	// the delete event is encoded as a tombstone message by default.
+	bin, err := encoder.encodeValue(ctx, topic, deleteEvent)
+	require.NoError(t, err)
+	require.Nil(t, bin)
+
+	codecConfig.RowImageRules = utils.NewRowImageRules4Test(t, "", config.RowImageFull)
+	bin, err = encoder.encodeValue(ctx, topic, deleteEvent)
+	require.NoError(t, err)
+	require.NotNil(t, bin)
+
+	cid, data, err := extractConfluentSchemaIDAndBinaryData(bin)
+	require.NoError(t, err)
+
+	avroValueCodec, err := encoder.schemaM.Lookup(ctx, topic, schemaID{confluentSchemaID: cid})
+	require.NoError(t, err)
+
+	res, _, err := avroValueCodec.NativeFromBinary(data)
+	require.NoError(t, err)
+	value := res.(map[string]interface{})
+	require.Equal(t, deleteOperation, value[tidbOp])
+	require.Equal(t, int32(1), value["id"])
+	require.Equal(t, map[string]interface{}{"int": int32(1)}, value["a"])
+}
+
 func TestAvroEnvelope(t *testing.T) {
 	t.Parallel()
 	cManager := &confluentSchemaManager{}
@@ -112,6 +112,10 @@ func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		// the delete event which holds all columns, it's sent if the row image of the table requires.
+		if op, ok := valueMap[tidbOp]; ok && op == deleteOperation {
+			isDelete = true
+		}
 	}
 
 	event, err := assembleEvent(keyMap, valueMap, valueSchema, isDelete)
@@ -232,12 +236,11 @@ func assembleEvent(
 	tableName := schema["name"].(string)
 
 	var commitTs int64
-	if !isDelete {
-		o, ok := valueMap[tidbCommitTs]
-		if !ok {
-			return nil, errors.New("commit ts not found")
-		}
+	o, ok := valueMap[tidbCommitTs]
+	if ok {
 		commitTs = o.(int64)
+	} else if !isDelete {
+		return nil, errors.New("commit ts not found")
 	}
 
 	event := new(model.RowChangedEvent)
@@ -86,9 +86,10 @@ func newJSONMessageForDML(
 	claimCheckFileName string,
 ) ([]byte, error) {
 	isDelete := e.IsDelete()
+	rowImage := config.RowImage(e.TableInfo)
 
 	onlyHandleKey := messageTooLarge
-	if isDelete && config.DeleteOnlyHandleKeyColumns {
+	if isDelete && rowImage.DeleteOnlyHandleKeyColumns() {
 		onlyHandleKey = true
 	}
 
@@ -233,8 +234,9 @@ func newJSONMessageForDML(
 			return nil, err
 		}
 	} else if e.IsUpdate() {
+		onlyOutputUpdatedColumns := rowImage.OnlyOutputUpdatedColumns()
 		var newColsMap map[string]*model.Column
-		if config.OnlyOutputUpdatedColumns {
+		if onlyOutputUpdatedColumns {
 			newColsMap = make(map[string]*model.Column, len(e.Columns))
 			for _, col := range e.GetColumns() {
 				newColsMap[col.Name] = col
@@ -243,7 +245,8 @@ func newJSONMessageForDML(
 		out.RawString(",\"old\":")
 		if err := fillColumns(
 			e.GetPreColumns(),
-			config.OnlyOutputUpdatedColumns, onlyHandleKey, newColsMap, out, builder,
+			onlyOutputUpdatedColumns, onlyHandleKey || rowImage.UpdateOnlyHandleKeyColumns(),
+			newColsMap, out, builder,
 		); err != nil {
 			return nil, err
 		}
@@ -667,6 +667,49 @@ func TestCanalJSONContentCompatibleE2E(t *testing.T) {
 	}
 }
 
+func TestCanalJSONRowImageRules(t *testing.T) {
+	_, updateEvent, deleteEvent := utils.NewRowImageEvent4Test(t, config.GetDefaultReplicaConfig())
+
+	ctx := context.Background()
+	for _, tc := range []struct {
+		updateOldValue string
+		deleteValue    string
+		expectedOld    []string
+		expectedDelete []string
+	}{
+		{config.RowImageFull, config.RowImageFull, []string{"id", "a", "b"}, []string{"id", "a", "b"}},
+		{config.RowImageChangedOnly, config.RowImageKeysOnly, []string{"a"}, []string{"id"}},
+		{config.RowImageKeysOnly, config.RowImageKeysOnly, []string{"id"}, []string{"id"}},
+	} {
+		codecConfig := common.NewConfig(config.ProtocolCanalJSON)
+		codecConfig.RowImageRules = utils.NewRowImageRules4Test(t, tc.updateOldValue, tc.deleteValue)
+		builder, err := NewJSONRowEventEncoderBuilder(ctx, codecConfig)
+		require.NoError(t, err)
+		encoder := builder.Build()
+
+		err = encoder.AppendRowChangedEvent(ctx, "", updateEvent, nil)
+		require.NoError(t, err)
+		var msg JSONMessage
+		err = json.Unmarshal(encoder.Build()[0].Value, &msg)
+		require.NoError(t, err)
+		require.Len(t, msg.Data[0], 3)
+		require.Len(t, msg.Old[0], len(tc.expectedOld))
+		for _, name := range tc.expectedOld {
+			require.Contains(t, msg.Old[0], name)
+		}
+
+		err = encoder.AppendRowChangedEvent(ctx, "", deleteEvent, nil)
+		require.NoError(t, err)
+		msg = JSONMessage{}
+		err = json.Unmarshal(encoder.Build()[0].Value, &msg)
+		require.NoError(t, err)
+		require.Len(t, msg.Data[0], len(tc.expectedDelete))
+		for _, name := range tc.expectedDelete {
+			require.Contains(t, msg.Data[0], name)
+		}
+	}
+}
+
 func TestNewCanalJSONBatchDecoder4RowMessage(t *testing.T) {
 	_, insertEvent, _, _ := utils.NewLargeEvent4Test(t, config.GetDefaultReplicaConfig())
 	ctx := context.Background()
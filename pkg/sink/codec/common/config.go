@@ -45,6 +45,10 @@ type Config struct {
 	// DeleteOnlyHandleKeyColumns is true, for the delete event only output the handle key columns.
 	DeleteOnlyHandleKeyColumns bool
 
+	// RowImageRules overrides `OnlyOutputUpdatedColumns` and `DeleteOnlyHandleKeyColumns`
+	// for the matched tables, use `RowImage` to get the policy of a table.
+	RowImageRules *RowImageRules
+
 	LargeMessageHandle *config.LargeMessageHandleConfig
 
 	EnableTiDBExtension bool
@@ -85,6 +89,8 @@ type Config struct {
 
 	// Debezium only. Whether schema should be excluded in the output.
 	DebeziumDisableSchema bool
+	// Debezium only. Whether the message keys and the schema change events are output.
+	DebeziumOutputKeyAndSchemaChange bool
 }
 
 // EncodingFormatType is the type of encoding format
@@ -158,7 +164,8 @@ type urlConfig struct {
 	OnlyOutputUpdatedColumns *bool  `form:"only-output-updated-columns"`
 	ContentCompatible        *bool  `form:"content-compatible"`
 
-	DebeziumDisableSchema *bool `form:"debezium-disable-schema"`
+	DebeziumDisableSchema            *bool `form:"debezium-disable-schema"`
+	DebeziumOutputKeyAndSchemaChange *bool `form:"debezium-output-key-and-schema-change"`
 	// EncodingFormatType is only works for the simple protocol,
 	// can be `json` and `avro`, default to `json`.
 	EncodingFormatType *string `form:"encoding-format"`
@@ -248,6 +255,17 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 			`force-replicate must be disabled when configuration "delete-only-output-handle-key-columns" is true.`)
 	}
 
+	if replicaConfig.Sink != nil && len(replicaConfig.Sink.RowImageRules) != 0 {
+		c.RowImageRules, err = NewRowImageRules(replicaConfig.Sink.RowImageRules, replicaConfig.CaseSensitive)
+		if err != nil {
+			return err
+		}
+		if c.RowImageRules.hasDeleteOnlyHandleKeyColumns() && replicaConfig.ForceReplicate {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`force-replicate must be disabled when the delete of any row image rule is "keys-only".`)
+		}
+	}
+
 	if c.Protocol == config.ProtocolCanalJSON {
 		c.ContentCompatible = util.GetOrZero(urlParameter.ContentCompatible)
 		if c.ContentCompatible {
@@ -271,6 +289,9 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 	if urlParameter.DebeziumDisableSchema != nil {
 		c.DebeziumDisableSchema = *urlParameter.DebeziumDisableSchema
 	}
+	if urlParameter.DebeziumOutputKeyAndSchemaChange != nil {
+		c.DebeziumOutputKeyAndSchemaChange = *urlParameter.DebeziumOutputKeyAndSchemaChange
+	}
 
 	return nil
 }
@@ -302,6 +323,9 @@ func mergeConfig(
 		if replicaConfig.Sink.DebeziumDisableSchema != nil {
 			dest.DebeziumDisableSchema = replicaConfig.Sink.DebeziumDisableSchema
 		}
+		if replicaConfig.Sink.DebeziumOutputKeyAndSchemaChange != nil {
+			dest.DebeziumOutputKeyAndSchemaChange = replicaConfig.Sink.DebeziumOutputKeyAndSchemaChange
+		}
 	}
 	if err := mergo.Merge(dest, urlParameters, mergo.WithOverride); err != nil {
 		return nil, err
@@ -324,9 +348,10 @@ func (c *Config) WithChangefeedID(id model.ChangeFeedID) *Config {
 // Validate the Config
 func (c *Config) Validate() error {
 	if c.EnableTiDBExtension &&
-		!(c.Protocol == config.ProtocolCanalJSON || c.Protocol == config.ProtocolAvro) {
+		!(c.Protocol == config.ProtocolCanalJSON || c.Protocol == config.ProtocolAvro ||
+			c.Protocol == config.ProtocolDebezium) {
 		log.Warn("ignore invalid config, enable-tidb-extension"+
-			"only supports canal-json/avro protocol",
+			"only supports canal-json/avro/debezium protocol",
 			zap.Bool("enableTidbExtension", c.EnableTiDBExtension),
 			zap.String("protocol", c.Protocol.String()))
 	}
@@ -368,6 +393,12 @@ func (c *Config) Validate() error {
 			)
 		}
 
+		if c.RowImageRules != nil && c.RowImageRules.hasDeleteFull() && !c.EnableTiDBExtension {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`Avro protocol requires "%s" to output the full columns of the delete event`,
+				codecOPTEnableTiDBExtension)
+		}
+
 		if c.EnableRowChecksum {
 			if !(c.EnableTiDBExtension && c.AvroDecimalHandlingMode == DecimalHandlingModeString &&
 				c.AvroBigintUnsignedHandlingMode == BigintUnsignedHandlingModeString) {
@@ -0,0 +1,143 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// RowImage is the before image policy applied to the events of one table.
+type RowImage struct {
+	// UpdateOldValue is one of config.RowImageFull, config.RowImageChangedOnly
+	// and config.RowImageKeysOnly.
+	UpdateOldValue string
+	// Delete is one of config.RowImageFull and config.RowImageKeysOnly.
+	Delete string
+}
+
+// OnlyOutputUpdatedColumns returns true if only the changed columns
+// should be output as the old value of the update event.
+func (r RowImage) OnlyOutputUpdatedColumns() bool {
+	return r.UpdateOldValue == config.RowImageChangedOnly
+}
+
+// UpdateOnlyHandleKeyColumns returns true if only the handle key columns
+// should be output as the old value of the update event.
+func (r RowImage) UpdateOnlyHandleKeyColumns() bool {
+	return r.UpdateOldValue == config.RowImageKeysOnly
+}
+
+// DeleteOnlyHandleKeyColumns returns true if only the handle key columns
+// should be output for the delete event.
+func (r RowImage) DeleteOnlyHandleKeyColumns() bool {
+	return r.Delete == config.RowImageKeysOnly
+}
+
+type rowImageRule struct {
+	tableF filter.Filter
+	image  RowImage
+}
+
+// RowImageRules holds the per table row image rules, the first rule
+// matches the table is used.
+type RowImageRules struct {
+	rules []*rowImageRule
+}
+
+// NewRowImageRules creates a RowImageRules from the given config.
+func NewRowImageRules(rules []*config.RowImageRule, caseSensitive bool) (*RowImageRules, error) {
+	result := make([]*rowImageRule, 0, len(rules))
+	for _, rule := range rules {
+		tableF, err := filter.Parse(rule.Matcher)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, rule.Matcher)
+		}
+		if !caseSensitive {
+			tableF = filter.CaseInsensitive(tableF)
+		}
+		result = append(result, &rowImageRule{
+			tableF: tableF,
+			image: RowImage{
+				UpdateOldValue: rule.UpdateOldValue,
+				Delete:         rule.Delete,
+			},
+		})
+	}
+	return &RowImageRules{rules: result}, nil
+}
+
+// match returns the row image of the first matched rule.
+func (r *RowImageRules) match(schema, table string) (RowImage, bool) {
+	for _, rule := range r.rules {
+		if rule.tableF.MatchTable(schema, table) {
+			return rule.image, true
+		}
+	}
+	return RowImage{}, false
+}
+
+// RowImage returns the row image policy of the given table.
+// The fields not set by the matched rule fall back to the changefeed level configuration.
+func (c *Config) RowImage(tableInfo *model.TableInfo) RowImage {
+	result := RowImage{
+		UpdateOldValue: config.RowImageFull,
+		Delete:         config.RowImageFull,
+	}
+	if c.OnlyOutputUpdatedColumns {
+		result.UpdateOldValue = config.RowImageChangedOnly
+	}
+	// avro encodes the delete event as a tombstone message, which only holds the handle key columns.
+	if c.DeleteOnlyHandleKeyColumns || c.Protocol == config.ProtocolAvro {
+		result.Delete = config.RowImageKeysOnly
+	}
+	if c.RowImageRules == nil || tableInfo == nil {
+		return result
+	}
+
+	matched, ok := c.RowImageRules.match(tableInfo.GetSchemaName(), tableInfo.GetTableName())
+	if !ok {
+		return result
+	}
+	if matched.UpdateOldValue != "" {
+		result.UpdateOldValue = matched.UpdateOldValue
+	}
+	if matched.Delete != "" {
+		result.Delete = matched.Delete
+	}
+	return result
+}
+
+// hasDeleteOnlyHandleKeyColumns returns true if any rule outputs only
+// the handle key columns for the delete event.
+func (r *RowImageRules) hasDeleteOnlyHandleKeyColumns() bool {
+	for _, rule := range r.rules {
+		if rule.image.DeleteOnlyHandleKeyColumns() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDeleteFull returns true if any rule outputs the full columns for the delete event.
+func (r *RowImageRules) hasDeleteFull() bool {
+	for _, rule := range r.rules {
+		if rule.image.Delete == config.RowImageFull {
+			return true
+		}
+	}
+	return false
+}
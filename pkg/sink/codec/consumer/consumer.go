@@ -0,0 +1,399 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consumer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"go.uber.org/zap"
+)
+
+// Transaction is a group of rows committed by the same upstream transaction
+// on the same table.
+type Transaction struct {
+	// TableID is the fake table ID generated by the consumer.
+	TableID  int64
+	CommitTs uint64
+	Rows     []*model.RowChangedEvent
+}
+
+// ResolvedEvents is the events which are ready to be written to the downstream.
+type ResolvedEvents struct {
+	// Transactions are ordered by the commit ts, the rows of a
+	// transaction are deduplicated and in the order they are received.
+	Transactions []*Transaction
+	// DDL is not nil if the events are resolved by a DDL barrier, it must be
+	// executed after all the Transactions are written to the downstream.
+	DDL *model.DDLEvent
+	// ResolvedTs is the ts before which all events are included,
+	// it equals to the commit ts of the DDL if the DDL is not nil.
+	// It's in the batch mode if the events at the ts may be incomplete.
+	ResolvedTs model.ResolvedTs
+}
+
+type eventsGroup struct {
+	events []*model.RowChangedEvent
+}
+
+func newEventsGroup() *eventsGroup {
+	return &eventsGroup{
+		events: make([]*model.RowChangedEvent, 0),
+	}
+}
+
+func (g *eventsGroup) Append(e *model.RowChangedEvent) {
+	g.events = append(g.events, e)
+}
+
+// Resolve returns the events whose commit ts is not greater than the resolveTs,
+// the relative order of the events with the same commit ts is kept.
+func (g *eventsGroup) Resolve(resolveTs uint64) []*model.RowChangedEvent {
+	sort.SliceStable(g.events, func(i, j int) bool {
+		return g.events[i].CommitTs < g.events[j].CommitTs
+	})
+
+	i := sort.Search(len(g.events), func(i int) bool {
+		return g.events[i].CommitTs > resolveTs
+	})
+	result := g.events[:i]
+	g.events = g.events[i:]
+
+	return result
+}
+
+// hasEvents returns true if there is any event whose commit ts is not greater than the ts.
+func (g *eventsGroup) hasEvents(ts uint64) bool {
+	for _, e := range g.events {
+		if e.CommitTs <= ts {
+			return true
+		}
+	}
+	return false
+}
+
+// ddlKey identifies a DDL, a rename tables DDL job
+// contains multiple DDL events with same CommitTs.
+type ddlKey struct {
+	commitTs uint64
+	query    string
+}
+
+// Consumer reassembles the events received from multiple partitions into
+// ordered transactions and DDL barriers, it is protocol agnostic, the
+// messages should be decoded before appended to the consumer.
+//
+// The producer sends the resolved ts to all partitions, once all partitions
+// have received the resolved ts, events before it are complete. The events
+// fall behind the watermark are duplicates caused by retrying or rebalancing,
+// and are dropped.
+type Consumer struct {
+	mu sync.Mutex
+
+	// watermarks record the maximum resolved ts received from each partition.
+	watermarks []model.ResolvedTs
+	// eventGroups buffers the rows not resolved yet, keyed by the fake table ID.
+	eventGroups      map[int64]*eventsGroup
+	tableIDGenerator *TableIDGenerator
+
+	// ddlList buffers the DDLs not resolved yet, ordered by the commit ts.
+	ddlList []*model.DDLEvent
+	// ddlSeen records the DDLs in the ddlList and the ones resolved at the
+	// global resolved ts, the DDL may be dispatched to all partitions.
+	ddlSeen map[ddlKey]struct{}
+
+	// globalResolvedTs is the resolved ts of the events returned by Next.
+	globalResolvedTs model.ResolvedTs
+}
+
+// New creates a new Consumer for the given number of partitions.
+func New(partitionNum int32) *Consumer {
+	return NewWithTableIDGenerator(partitionNum, NewTableIDGenerator())
+}
+
+// NewWithTableIDGenerator creates a new Consumer which shares the table ID generator
+// with other consumers, so the fake table IDs are unique among them.
+func NewWithTableIDGenerator(partitionNum int32, generator *TableIDGenerator) *Consumer {
+	watermarks := make([]model.ResolvedTs, partitionNum)
+	for i := range watermarks {
+		watermarks[i] = model.NewResolvedTs(0)
+	}
+	return &Consumer{
+		watermarks:       watermarks,
+		eventGroups:      make(map[int64]*eventsGroup),
+		tableIDGenerator: generator,
+		ddlSeen:          make(map[ddlKey]struct{}),
+		globalResolvedTs: model.NewResolvedTs(0),
+	}
+}
+
+func (c *Consumer) checkPartition(partition int32) error {
+	if partition < 0 || int(partition) >= len(c.watermarks) {
+		return errors.Errorf("partition %d out of range, the partition number is %d",
+			partition, len(c.watermarks))
+	}
+	return nil
+}
+
+// isResolved returns true if the events at the commit ts are all resolved.
+func isResolved(commitTs uint64, resolved model.ResolvedTs) bool {
+	if resolved.IsBatchMode() {
+		return commitTs < resolved.Ts
+	}
+	return commitTs <= resolved.Ts
+}
+
+// AppendRow appends a row received from the partition. The table ID of the row
+// is replaced by the fake one. It returns false if the row is dropped since it
+// falls behind the watermark of the partition.
+func (c *Consumer) AppendRow(partition int32, row *model.RowChangedEvent) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkPartition(partition); err != nil {
+		return false, err
+	}
+
+	watermark := c.watermarks[partition]
+	if isResolved(row.CommitTs, c.globalResolvedTs) || isResolved(row.CommitTs, watermark) {
+		log.Warn("RowChangedEvent fallback row, ignore it",
+			zap.Uint64("commitTs", row.CommitTs),
+			zap.Any("globalResolvedTs", c.globalResolvedTs),
+			zap.Any("partitionResolvedTs", watermark),
+			zap.Int32("partition", partition),
+			zap.Any("row", row))
+		return false, nil
+	}
+
+	var partitionID int64
+	if row.TableInfo.IsPartitionTable() {
+		partitionID = row.PhysicalTableID
+	}
+	tableID := c.tableIDGenerator.GenerateFakeTableID(
+		row.TableInfo.GetSchemaName(), row.TableInfo.GetTableName(), partitionID)
+	row.TableInfo.TableName.TableID = tableID
+
+	group, ok := c.eventGroups[tableID]
+	if !ok {
+		group = newEventsGroup()
+		c.eventGroups[tableID] = group
+	}
+	group.Append(row)
+	return true, nil
+}
+
+// AppendDDL appends a DDL, the DDL may be received from all partitions.
+// It returns false if the DDL is dropped since it's redundant or fallback.
+func (c *Consumer) AppendDDL(ddl *model.DDLEvent) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := ddlKey{commitTs: ddl.CommitTs, query: ddl.Query}
+	if _, ok := c.ddlSeen[key]; ok {
+		log.Info("ignore redundant DDL, the DDL is already received",
+			zap.Uint64("commitTs", ddl.CommitTs), zap.String("DDL", ddl.Query))
+		return false
+	}
+	// The DDL is received after the events after it have been resolved.
+	if isResolved(ddl.CommitTs, c.globalResolvedTs) {
+		log.Warn("DDL CommitTs fallback, ignore it",
+			zap.Uint64("commitTs", ddl.CommitTs),
+			zap.Any("globalResolvedTs", c.globalResolvedTs),
+			zap.String("DDL", ddl.Query))
+		return false
+	}
+
+	// The DDLs received from different partitions may be out of order.
+	i := sort.Search(len(c.ddlList), func(i int) bool {
+		return c.ddlList[i].CommitTs > ddl.CommitTs
+	})
+	c.ddlList = append(c.ddlList, nil)
+	copy(c.ddlList[i+1:], c.ddlList[i:])
+	c.ddlList[i] = ddl
+	c.ddlSeen[key] = struct{}{}
+	log.Info("DDL event received", zap.Uint64("commitTs", ddl.CommitTs), zap.String("DDL", ddl.Query))
+	return true
+}
+
+// UpdateResolvedTs updates the watermark of the partition, all events whose
+// commit ts is not greater than the ts are received. It returns false if the
+// resolved ts is ignored since it falls behind.
+func (c *Consumer) UpdateResolvedTs(partition int32, ts uint64) (bool, error) {
+	return c.updateWatermark(partition, model.NewResolvedTs(ts))
+}
+
+// UpdateBatchResolvedTs updates the watermark of the partition, all events whose
+// commit ts is less than the ts are received, and the events at the ts received
+// so far can be written as a batch, the rest of them may be received later.
+func (c *Consumer) UpdateBatchResolvedTs(partition int32, ts uint64) (bool, error) {
+	return c.updateWatermark(partition, model.ResolvedTs{
+		Mode:    model.BatchResolvedMode,
+		Ts:      ts,
+		BatchID: 1,
+	})
+}
+
+func (c *Consumer) updateWatermark(partition int32, resolved model.ResolvedTs) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkPartition(partition); err != nil {
+		return false, err
+	}
+
+	watermark := c.watermarks[partition]
+	if resolved.Ts < c.globalResolvedTs.Ts || resolved.Less(watermark) {
+		log.Warn("partition resolved ts fallback, skip it",
+			zap.Any("resolvedTs", resolved),
+			zap.Any("partitionResolvedTs", watermark),
+			zap.Any("globalResolvedTs", c.globalResolvedTs),
+			zap.Int32("partition", partition))
+		return false, nil
+	}
+	c.watermarks[partition] = resolved
+	return true, nil
+}
+
+// GlobalResolvedTs returns the resolved ts of the events returned by Next.
+func (c *Consumer) GlobalResolvedTs() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.globalResolvedTs.Ts
+}
+
+// Next returns the events resolved since the last call, it returns nil if the
+// global resolved ts is not advanced. Once a DDL is resolved, the events before
+// it are returned with the DDL, and the events after it are left to the next call.
+func (c *Consumer) Next() *ResolvedEvents {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	minWatermark := c.watermarks[0]
+	for _, watermark := range c.watermarks[1:] {
+		if watermark.Less(minWatermark) {
+			minWatermark = watermark
+		}
+	}
+
+	if len(c.ddlList) > 0 && isResolved(c.ddlList[0].CommitTs, minWatermark) {
+		ddl := c.ddlList[0]
+		c.ddlList = c.ddlList[1:]
+		if ddl.CommitTs > c.globalResolvedTs.Ts {
+			c.globalResolvedTs = model.NewResolvedTs(ddl.CommitTs)
+			// Forget the DDLs resolved before, since the copies of
+			// them are dropped by the global resolved ts from now on.
+			for key := range c.ddlSeen {
+				if key.commitTs < ddl.CommitTs {
+					delete(c.ddlSeen, key)
+				}
+			}
+		}
+		return &ResolvedEvents{
+			Transactions: c.resolve(ddl.CommitTs),
+			DDL:          ddl,
+			ResolvedTs:   model.NewResolvedTs(ddl.CommitTs),
+		}
+	}
+
+	resolved := minWatermark
+	if resolved.IsBatchMode() && resolved.Ts == c.globalResolvedTs.Ts {
+		// More events of the batch are received after the last call.
+		if !c.hasEvents(resolved.Ts) {
+			return nil
+		}
+		if c.globalResolvedTs.IsBatchMode() {
+			resolved = c.globalResolvedTs.AdvanceBatch()
+		}
+	} else if !resolved.Greater(c.globalResolvedTs) {
+		return nil
+	}
+	c.globalResolvedTs = resolved
+	return &ResolvedEvents{
+		Transactions: c.resolve(resolved.Ts),
+		ResolvedTs:   resolved,
+	}
+}
+
+func (c *Consumer) hasEvents(ts uint64) bool {
+	for _, group := range c.eventGroups {
+		if group.hasEvents(ts) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve groups the rows whose commit ts is not greater than
+// the resolvedTs into transactions, and drops the duplicate rows.
+func (c *Consumer) resolve(resolvedTs uint64) []*Transaction {
+	var txns []*Transaction
+	for tableID, group := range c.eventGroups {
+		var txn *Transaction
+		var seen map[string]struct{}
+		for _, row := range group.Resolve(resolvedTs) {
+			if txn == nil || txn.CommitTs != row.CommitTs {
+				txn = &Transaction{TableID: tableID, CommitTs: row.CommitTs}
+				txns = append(txns, txn)
+				seen = make(map[string]struct{})
+			}
+			// There is at most one change for each key in the same transaction,
+			// so the same change is a duplicate one, which is received if the
+			// message is resent by the producer or redelivered by the broker
+			// before the watermark is advanced.
+			if key := rowKey(row); key != "" {
+				if _, ok := seen[key]; ok {
+					log.Warn("duplicate RowChangedEvent, ignore it",
+						zap.Uint64("commitTs", row.CommitTs),
+						zap.Int64("tableID", tableID),
+						zap.Any("row", row))
+					continue
+				}
+				seen[key] = struct{}{}
+			}
+			txn.Rows = append(txn.Rows, row)
+		}
+	}
+	sort.SliceStable(txns, func(i, j int) bool {
+		if txns[i].CommitTs != txns[j].CommitTs {
+			return txns[i].CommitTs < txns[j].CommitTs
+		}
+		return txns[i].TableID < txns[j].TableID
+	})
+	return txns
+}
+
+// rowKey returns the key to identify the change of the row, which consists of
+// the values of all columns before and after the change. It returns an empty
+// string if the table has no handle key, since the same row may be inserted
+// more than once into such table in a transaction.
+func rowKey(row *model.RowChangedEvent) string {
+	if len(row.GetHandleKeyColumnValues()) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	for _, cols := range [][]*model.ColumnData{row.PreColumns, row.Columns} {
+		b.WriteString("(")
+		for _, col := range cols {
+			if col == nil {
+				continue
+			}
+			fmt.Fprintf(&b, "%d:%q,", col.ColumnID, model.ColumnValueString(col.Value))
+		}
+		b.WriteString(")")
+	}
+	return b.String()
+}
@@ -0,0 +1,276 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consumer
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func newRow(tableInfo *model.TableInfo, commitTs uint64, id int64) *model.RowChangedEvent {
+	return &model.RowChangedEvent{
+		CommitTs:  commitTs,
+		TableInfo: tableInfo,
+		Columns: model.Columns2ColumnDatas([]*model.Column{{
+			Name:  "id",
+			Value: id,
+		}}, tableInfo),
+	}
+}
+
+func appendRow(t *testing.T, c *Consumer, partition int32, row *model.RowChangedEvent) bool {
+	ok, err := c.AppendRow(partition, row)
+	require.NoError(t, err)
+	return ok
+}
+
+func updateResolvedTs(t *testing.T, c *Consumer, partition int32, ts uint64) bool {
+	ok, err := c.UpdateResolvedTs(partition, ts)
+	require.NoError(t, err)
+	return ok
+}
+
+func newTableInfo(table string, withHandleKey bool) *model.TableInfo {
+	if !withHandleKey {
+		return model.BuildTableInfo("test", table, []*model.Column{{
+			Name: "id",
+			Type: mysql.TypeLonglong,
+		}}, nil)
+	}
+	return model.BuildTableInfo("test", table, []*model.Column{{
+		Name: "id",
+		Type: mysql.TypeLonglong,
+		Flag: model.HandleKeyFlag | model.PrimaryKeyFlag,
+	}}, [][]int{{0}})
+}
+
+func TestTableIDGenerator(t *testing.T) {
+	g := NewTableIDGenerator()
+	t1 := g.GenerateFakeTableID("test", "t1", 0)
+	t2 := g.GenerateFakeTableID("test", "t2", 0)
+	p1 := g.GenerateFakeTableID("test", "t1", 1)
+	require.NotEqual(t, t1, t2)
+	require.NotEqual(t, t1, p1)
+	require.Equal(t, t1, g.GenerateFakeTableID("test", "t1", 0))
+	require.Equal(t, p1, g.GenerateFakeTableID("test", "t1", 1))
+}
+
+func TestConsumerOutOfOrder(t *testing.T) {
+	c := New(2)
+	t1 := newTableInfo("t1", true)
+	t2 := newTableInfo("t2", true)
+
+	// Rows of the same table are dispatched to different partitions,
+	// and the partitions are consumed at different paces.
+	require.True(t, appendRow(t, c, 1, newRow(t1, 20, 2)))
+	require.True(t, appendRow(t, c, 0, newRow(t2, 30, 3)))
+	require.True(t, appendRow(t, c, 0, newRow(t1, 10, 1)))
+	require.True(t, appendRow(t, c, 1, newRow(t1, 10, 4)))
+	require.True(t, updateResolvedTs(t, c, 1, 25))
+	// The events are not complete until all partitions are resolved.
+	require.Nil(t, c.Next())
+
+	require.True(t, updateResolvedTs(t, c, 0, 35))
+	events := c.Next()
+	require.NotNil(t, events)
+	require.Nil(t, events.DDL)
+	require.Equal(t, uint64(25), events.ResolvedTs.Ts)
+	require.Equal(t, uint64(25), c.GlobalResolvedTs())
+	require.Len(t, events.Transactions, 2)
+	require.Equal(t, uint64(10), events.Transactions[0].CommitTs)
+	require.Len(t, events.Transactions[0].Rows, 2)
+	require.Equal(t, uint64(20), events.Transactions[1].CommitTs)
+	require.Len(t, events.Transactions[1].Rows, 1)
+	require.Equal(t, events.Transactions[0].TableID, events.Transactions[1].TableID)
+	require.Nil(t, c.Next())
+
+	require.True(t, updateResolvedTs(t, c, 1, 40))
+	events = c.Next()
+	require.Equal(t, uint64(35), events.ResolvedTs.Ts)
+	require.Len(t, events.Transactions, 1)
+	require.Equal(t, uint64(30), events.Transactions[0].CommitTs)
+	require.NotEqual(t, events.Transactions[0].TableID, t1.TableName.TableID)
+}
+
+func TestConsumerDuplicate(t *testing.T) {
+	c := New(2)
+	t1 := newTableInfo("t1", true)
+	t2 := newTableInfo("t2", false)
+
+	require.True(t, appendRow(t, c, 0, newRow(t1, 10, 1)))
+	// The message is resent before the watermark is advanced.
+	require.True(t, appendRow(t, c, 0, newRow(t1, 10, 1)))
+	// The same row may be inserted more than once into the table without handle key.
+	require.True(t, appendRow(t, c, 0, newRow(t2, 10, 1)))
+	require.True(t, appendRow(t, c, 0, newRow(t2, 10, 1)))
+	require.True(t, updateResolvedTs(t, c, 0, 15))
+	require.True(t, updateResolvedTs(t, c, 1, 15))
+
+	events := c.Next()
+	require.Len(t, events.Transactions, 2)
+	require.Len(t, events.Transactions[0].Rows, 1)
+	require.Len(t, events.Transactions[1].Rows, 2)
+
+	// The messages are redelivered after the watermark is advanced.
+	require.False(t, appendRow(t, c, 0, newRow(t1, 10, 1)))
+	require.False(t, appendRow(t, c, 1, newRow(t1, 15, 2)))
+	require.False(t, updateResolvedTs(t, c, 0, 10))
+	require.True(t, updateResolvedTs(t, c, 0, 15))
+	require.Nil(t, c.Next())
+}
+
+func TestConsumerDuplicateFullImage(t *testing.T) {
+	c := New(1)
+	tableInfo := model.BuildTableInfo("test", "t", []*model.Column{{
+		Name: "id",
+		Type: mysql.TypeLonglong,
+		Flag: model.HandleKeyFlag | model.PrimaryKeyFlag,
+	}, {
+		Name: "v",
+		Type: mysql.TypeLonglong,
+	}}, [][]int{{0}})
+	newUpdate := func(pre, cur int64) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			CommitTs:  10,
+			TableInfo: tableInfo,
+			PreColumns: model.Columns2ColumnDatas([]*model.Column{
+				{Name: "id", Value: 1}, {Name: "v", Value: pre},
+			}, tableInfo),
+			Columns: model.Columns2ColumnDatas([]*model.Column{
+				{Name: "id", Value: 1}, {Name: "v", Value: cur},
+			}, tableInfo),
+		}
+	}
+
+	// The changes share the key and the type, but they are different changes.
+	require.True(t, appendRow(t, c, 0, newUpdate(1, 2)))
+	require.True(t, appendRow(t, c, 0, newUpdate(2, 3)))
+	// Only the change with the same images is a duplicate one.
+	require.True(t, appendRow(t, c, 0, newUpdate(1, 2)))
+	require.True(t, updateResolvedTs(t, c, 0, 10))
+
+	events := c.Next()
+	require.Len(t, events.Transactions, 1)
+	require.Len(t, events.Transactions[0].Rows, 2)
+}
+
+func TestConsumerPartitionOutOfRange(t *testing.T) {
+	c := New(2)
+	t1 := newTableInfo("t1", true)
+
+	_, err := c.AppendRow(2, newRow(t1, 10, 1))
+	require.Error(t, err)
+	_, err = c.UpdateResolvedTs(-1, 10)
+	require.Error(t, err)
+	_, err = c.UpdateBatchResolvedTs(2, 10)
+	require.Error(t, err)
+	require.Nil(t, c.Next())
+}
+
+func TestConsumerBatchResolvedTs(t *testing.T) {
+	c := New(1)
+	t1 := newTableInfo("t1", true)
+
+	// The transaction at ts 10 is split into multiple batches.
+	require.True(t, appendRow(t, c, 0, newRow(t1, 5, 1)))
+	require.True(t, appendRow(t, c, 0, newRow(t1, 10, 2)))
+	ok, err := c.UpdateBatchResolvedTs(0, 10)
+	require.NoError(t, err)
+	require.True(t, ok)
+	events := c.Next()
+	require.True(t, events.ResolvedTs.IsBatchMode())
+	require.Equal(t, uint64(10), events.ResolvedTs.Ts)
+	require.Len(t, events.Transactions, 2)
+	require.Nil(t, c.Next())
+
+	// The rest of the transaction is not dropped.
+	require.True(t, appendRow(t, c, 0, newRow(t1, 10, 3)))
+	ok, err = c.UpdateBatchResolvedTs(0, 10)
+	require.NoError(t, err)
+	require.True(t, ok)
+	next := c.Next()
+	require.True(t, next.ResolvedTs.Greater(events.ResolvedTs))
+	require.Len(t, next.Transactions, 1)
+	require.Equal(t, int64(3), next.Transactions[0].Rows[0].Columns[0].Value)
+	require.Nil(t, c.Next())
+
+	// The transaction is complete once the resolved ts is in the normal mode.
+	require.True(t, updateResolvedTs(t, c, 0, 10))
+	events = c.Next()
+	require.False(t, events.ResolvedTs.IsBatchMode())
+	require.Empty(t, events.Transactions)
+	require.False(t, appendRow(t, c, 0, newRow(t1, 10, 4)))
+}
+
+func TestConsumerDDLBarrier(t *testing.T) {
+	c := New(2)
+	t1 := newTableInfo("t1", true)
+
+	require.True(t, appendRow(t, c, 0, newRow(t1, 10, 1)))
+	require.True(t, appendRow(t, c, 1, newRow(t1, 30, 2)))
+	// The DDL is sent to all partitions.
+	ddl := &model.DDLEvent{CommitTs: 20, Query: "alter table t1 add column c int"}
+	require.True(t, c.AppendDDL(ddl))
+	require.False(t, c.AppendDDL(&model.DDLEvent{CommitTs: 20, Query: ddl.Query}))
+	// A rename tables DDL job contains multiple DDL events with same CommitTs.
+	rename := []*model.DDLEvent{
+		{CommitTs: 25, Query: "rename table t2 to t3"},
+		{CommitTs: 25, Query: "rename table t4 to t5"},
+	}
+	require.True(t, c.AppendDDL(rename[0]))
+	require.True(t, c.AppendDDL(rename[1]))
+	require.False(t, c.AppendDDL(&model.DDLEvent{CommitTs: 25, Query: rename[0].Query}))
+	require.False(t, c.AppendDDL(&model.DDLEvent{CommitTs: 20, Query: ddl.Query}))
+
+	require.True(t, updateResolvedTs(t, c, 0, 40))
+	require.True(t, updateResolvedTs(t, c, 1, 40))
+
+	events := c.Next()
+	require.Equal(t, ddl, events.DDL)
+	require.Equal(t, uint64(20), events.ResolvedTs.Ts)
+	require.Len(t, events.Transactions, 1)
+	require.Equal(t, uint64(10), events.Transactions[0].CommitTs)
+
+	events = c.Next()
+	require.Equal(t, rename[0], events.DDL)
+	require.Empty(t, events.Transactions)
+	events = c.Next()
+	require.Equal(t, rename[1], events.DDL)
+	require.Empty(t, events.Transactions)
+
+	events = c.Next()
+	require.Nil(t, events.DDL)
+	require.Equal(t, uint64(40), events.ResolvedTs.Ts)
+	require.Len(t, events.Transactions, 1)
+	require.Equal(t, uint64(30), events.Transactions[0].CommitTs)
+
+	// The DDLs received from the partitions are out of order.
+	require.True(t, c.AppendDDL(&model.DDLEvent{CommitTs: 60, Query: "drop table t2"}))
+	require.True(t, c.AppendDDL(&model.DDLEvent{CommitTs: 50, Query: "drop table t3"}))
+	require.False(t, c.AppendDDL(&model.DDLEvent{CommitTs: 60, Query: "drop table t2"}))
+	require.False(t, c.AppendDDL(&model.DDLEvent{CommitTs: 50, Query: "drop table t3"}))
+	require.True(t, updateResolvedTs(t, c, 0, 70))
+	require.True(t, updateResolvedTs(t, c, 1, 70))
+	require.Equal(t, uint64(50), c.Next().DDL.CommitTs)
+	require.Equal(t, uint64(60), c.Next().DDL.CommitTs)
+	require.False(t, c.AppendDDL(&model.DDLEvent{CommitTs: 50, Query: "drop table t3"}))
+	require.Nil(t, c.Next().DDL)
+
+	// The DDL falls behind the global resolved ts.
+	require.False(t, c.AppendDDL(&model.DDLEvent{CommitTs: 35, Query: "drop table t1"}))
+	require.Nil(t, c.Next())
+}
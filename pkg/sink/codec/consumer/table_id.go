@@ -0,0 +1,54 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consumer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pingcap/tiflow/pkg/quotes"
+)
+
+// TableIDGenerator generates fake table IDs for the consumers. The table ID
+// carried by the messages is the one in the upstream, which may be absent or
+// changed by DDLs, so the consumers identify a table by its name instead.
+type TableIDGenerator struct {
+	tableIDs       map[string]int64
+	currentTableID int64
+	mu             sync.Mutex
+}
+
+// NewTableIDGenerator creates a new TableIDGenerator.
+func NewTableIDGenerator() *TableIDGenerator {
+	return &TableIDGenerator{
+		tableIDs: make(map[string]int64),
+	}
+}
+
+// GenerateFakeTableID returns the fake table ID of the table, the same
+// schema, table and partition always get the same table ID.
+func (g *TableIDGenerator) GenerateFakeTableID(schema, table string, partition int64) int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	key := quotes.QuoteSchema(schema, table)
+	if partition != 0 {
+		key = fmt.Sprintf("%s.`%d`", key, partition)
+	}
+	if tableID, ok := g.tableIDs[key]; ok {
+		return tableID
+	}
+	g.currentTableID++
+	g.tableIDs[key] = g.currentTableID
+	return g.currentTableID
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1007,6 +1008,29 @@ func TestRowChangeEventConversion(t *testing.T) {
 	}
 }
 
+func TestRowChangeEventConversionWithRowImageRules(t *testing.T) {
+	_, updateEvent, deleteEvent := utils.NewRowImageEvent4Test(t, config.GetDefaultReplicaConfig())
+
+	csvConfig := &common.Config{
+		Delimiter:       ",",
+		Quote:           "\"",
+		Terminator:      "\n",
+		NullString:      "\\N",
+		OutputOldValue:  true,
+		RowImageRules:   utils.NewRowImageRules4Test(t, config.RowImageChangedOnly, config.RowImageKeysOnly),
+		IncludeCommitTs: true,
+	}
+
+	csvMsg, err := rowChangedEvent2CSVMsg(csvConfig, deleteEvent)
+	require.NoError(t, err)
+	require.Equal(t, []any{int64(1), nil, nil}, csvMsg.columns)
+
+	csvMsg, err = rowChangedEvent2CSVMsg(csvConfig, updateEvent)
+	require.NoError(t, err)
+	require.Equal(t, []any{nil, int64(1), nil}, csvMsg.preColumns)
+	require.Equal(t, []any{int64(1), int64(2), "b"}, csvMsg.columns)
+}
+
 func TestCSVMessageDecode(t *testing.T) {
 	// datums := make([][]types.Datum, 0, 4)
 	testCases := []struct {
@@ -23,12 +23,17 @@ import (
 	"time"
 
 	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	timodel "github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/hack"
 	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
+	"github.com/pingcap/tiflow/pkg/sink/codec/utils"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
@@ -523,35 +528,11 @@ func (c *dbzCodec) EncodeRowChangedEvent(
 	jWriter := util.BorrowJSONWriter(dest)
 	defer util.ReturnJSONWriter(jWriter)
 
-	commitTime := oracle.GetTimeFromTS(e.CommitTs)
-
 	var err error
 
 	jWriter.WriteObject(func() {
 		jWriter.WriteObjectField("payload", func() {
-			jWriter.WriteObjectField("source", func() {
-				jWriter.WriteStringField("version", "2.4.0.Final")
-				jWriter.WriteStringField("connector", "TiCDC")
-				jWriter.WriteStringField("name", c.clusterID)
-				// ts_ms: In the source object, ts_ms indicates the time that the change was made in the database.
-				// https://debezium.io/documentation/reference/stable/connectors/mysql.html#mysql-create-events
-				jWriter.WriteInt64Field("ts_ms", commitTime.UnixMilli())
-				// snapshot field is a string of true,last,false,incremental
-				jWriter.WriteStringField("snapshot", "false")
-				jWriter.WriteStringField("db", e.TableInfo.GetSchemaName())
-				jWriter.WriteStringField("table", e.TableInfo.GetTableName())
-				jWriter.WriteInt64Field("server_id", 0)
-				jWriter.WriteNullField("gtid")
-				jWriter.WriteStringField("file", "")
-				jWriter.WriteInt64Field("pos", 0)
-				jWriter.WriteInt64Field("row", 0)
-				jWriter.WriteInt64Field("thread", 0)
-				jWriter.WriteNullField("query")
-
-				// The followings are TiDB extended fields
-				jWriter.WriteUint64Field("commit_ts", e.CommitTs)
-				jWriter.WriteStringField("cluster_id", c.clusterID)
-			})
+			c.writeSource(jWriter, e.CommitTs, e.TableInfo.GetSchemaName(), e.TableInfo.GetTableName())
 
 			// ts_ms: displays the time at which the connector processed the event
 			// https://debezium.io/documentation/reference/stable/connectors/mysql.html#mysql-create-events
@@ -581,10 +562,10 @@ func (c *dbzCodec) EncodeRowChangedEvent(
 			} else if e.IsDelete() {
 				jWriter.WriteStringField("op", "d")
 				jWriter.WriteNullField("after")
-				err = c.writeDebeziumFieldValues(jWriter, "before", e.GetPreColumns(), e.TableInfo)
+				err = c.writeDebeziumFieldValues(jWriter, "before", codec.BeforeImageColumns(e, c.config.RowImage(e.TableInfo)), e.TableInfo)
 			} else if e.IsUpdate() {
 				jWriter.WriteStringField("op", "u")
-				err = c.writeDebeziumFieldValues(jWriter, "before", e.GetPreColumns(), e.TableInfo)
+				err = c.writeDebeziumFieldValues(jWriter, "before", codec.BeforeImageColumns(e, c.config.RowImage(e.TableInfo)), e.TableInfo)
 				if err == nil {
 					err = c.writeDebeziumFieldValues(jWriter, "after", e.GetColumns(), e.TableInfo)
 				}
@@ -646,106 +627,7 @@ func (c *dbzCodec) EncodeRowChangedEvent(
 							jWriter.WriteRaw(fieldsJSON)
 						})
 					})
-					jWriter.WriteObjectElement(func() {
-						jWriter.WriteStringField("type", "struct")
-						jWriter.WriteArrayField("fields", func() {
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", false)
-								jWriter.WriteStringField("field", "version")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", false)
-								jWriter.WriteStringField("field", "connector")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", false)
-								jWriter.WriteStringField("field", "name")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "int64")
-								jWriter.WriteBoolField("optional", false)
-								jWriter.WriteStringField("field", "ts_ms")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", true)
-								jWriter.WriteStringField("name", "io.debezium.data.Enum")
-								jWriter.WriteIntField("version", 1)
-								jWriter.WriteObjectField("parameters", func() {
-									jWriter.WriteStringField("allowed", "true,last,false,incremental")
-								})
-								jWriter.WriteStringField("default", "false")
-								jWriter.WriteStringField("field", "snapshot")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", false)
-								jWriter.WriteStringField("field", "db")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", true)
-								jWriter.WriteStringField("field", "sequence")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", true)
-								jWriter.WriteStringField("field", "table")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "int64")
-								jWriter.WriteBoolField("optional", false)
-								jWriter.WriteStringField("field", "server_id")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", true)
-								jWriter.WriteStringField("field", "gtid")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", false)
-								jWriter.WriteStringField("field", "file")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "int64")
-								jWriter.WriteBoolField("optional", false)
-								jWriter.WriteStringField("field", "pos")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "int32")
-								jWriter.WriteBoolField("optional", false)
-								jWriter.WriteStringField("field", "row")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "int64")
-								jWriter.WriteBoolField("optional", true)
-								jWriter.WriteStringField("field", "thread")
-							})
-							jWriter.WriteObjectElement(func() {
-								jWriter.WriteStringField("type", "string")
-								jWriter.WriteBoolField("optional", true)
-								jWriter.WriteStringField("field", "query")
-							})
-							// Below are extra TiDB fields
-							// jWriter.WriteObjectElement(func() {
-							// 	jWriter.WriteStringField("type", "int64")
-							// 	jWriter.WriteBoolField("optional", false)
-							// 	jWriter.WriteStringField("field", "commit_ts")
-							// })
-							// jWriter.WriteObjectElement(func() {
-							// 	jWriter.WriteStringField("type", "string")
-							// 	jWriter.WriteBoolField("optional", false)
-							// 	jWriter.WriteStringField("field", "cluster_id")
-							// })
-						})
-						jWriter.WriteBoolField("optional", false)
-						jWriter.WriteStringField("name", "io.debezium.connector.mysql.Source")
-						jWriter.WriteStringField("field", "source")
-					})
+					c.writeSourceSchema(jWriter)
 					jWriter.WriteObjectElement(func() {
 						jWriter.WriteStringField("type", "string")
 						jWriter.WriteBoolField("optional", false)
@@ -787,3 +669,440 @@ func (c *dbzCodec) EncodeRowChangedEvent(
 
 	return err
 }
+
+// EncodeKey encodes the handle key columns of the row changed event as the message key,
+// nothing is written if the table has no handle key.
+// See https://debezium.io/documentation/reference/stable/connectors/mysql.html#mysql-change-event-keys
+func (c *dbzCodec) EncodeKey(
+	e *model.RowChangedEvent,
+	dest io.Writer,
+) error {
+	cols := e.GetColumns()
+	if e.IsDelete() {
+		cols = e.GetPreColumns()
+	}
+	colInfos := e.TableInfo.GetColInfosForRowChangedEvent()
+	keyCols := make([]*model.Column, 0, 1)
+	keyFts := make([]*types.FieldType, 0, 1)
+	for i, col := range cols {
+		if col != nil && col.Flag.IsHandleKey() {
+			keyCols = append(keyCols, col)
+			keyFts = append(keyFts, colInfos[i].Ft)
+		}
+	}
+	if len(keyCols) == 0 {
+		return nil
+	}
+
+	jWriter := util.BorrowJSONWriter(dest)
+	defer util.ReturnJSONWriter(jWriter)
+
+	var err error
+	jWriter.WriteObject(func() {
+		jWriter.WriteObjectField("payload", func() {
+			for i, col := range keyCols {
+				err = c.writeDebeziumFieldValue(jWriter, col, keyFts[i])
+				if err != nil {
+					break
+				}
+			}
+		})
+		if !c.config.DebeziumDisableSchema {
+			jWriter.WriteObjectField("schema", func() {
+				jWriter.WriteStringField("type", "struct")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("name", fmt.Sprintf("%s.%s.%s.Key",
+					c.clusterID,
+					e.TableInfo.GetSchemaName(),
+					e.TableInfo.GetTableName()))
+				jWriter.WriteArrayField("fields", func() {
+					for i, col := range keyCols {
+						c.writeDebeziumFieldSchema(jWriter, col, keyFts[i])
+					}
+				})
+			})
+		}
+	})
+	return err
+}
+
+// EncodeDDLEvent encodes the DDL event as a Debezium schema change event.
+// See https://debezium.io/documentation/reference/stable/connectors/mysql.html#mysql-schema-change-topic
+func (c *dbzCodec) EncodeDDLEvent(
+	e *model.DDLEvent,
+	dest io.Writer,
+) error {
+	jWriter := util.BorrowJSONWriter(dest)
+	defer util.ReturnJSONWriter(jWriter)
+
+	schemaName := e.TableInfo.GetSchemaName()
+	changeType := getTableChangeType(e)
+	jWriter.WriteObject(func() {
+		jWriter.WriteObjectField("payload", func() {
+			c.writeSource(jWriter, e.CommitTs, schemaName, e.TableInfo.GetTableName())
+			jWriter.WriteInt64Field("ts_ms", c.nowFunc().UnixMilli())
+			jWriter.WriteStringField("databaseName", schemaName)
+			jWriter.WriteNullField("schemaName")
+			jWriter.WriteStringField("ddl", e.Query)
+			jWriter.WriteArrayField("tableChanges", func() {
+				if changeType == "" {
+					return
+				}
+				jWriter.WriteObjectElement(func() {
+					jWriter.WriteStringField("type", changeType)
+					jWriter.WriteStringField("id", fmt.Sprintf("\"%s\".\"%s\"",
+						schemaName, e.TableInfo.GetTableName()))
+					jWriter.WriteObjectField("table", func() {
+						c.writeTableChangeTable(jWriter, e.TableInfo)
+					})
+				})
+			})
+		})
+
+		if !c.config.DebeziumDisableSchema {
+			jWriter.WriteObjectField("schema", func() {
+				c.writeSchemaChangeSchema(jWriter)
+			})
+		}
+	})
+	return nil
+}
+
+// EncodeCheckpointEvent encodes the checkpoint ts as a watermark event. It's a TiDB extension
+// which only carries the `source` and `ts_ms` fields, the consumer can tell it from the row
+// changed event and the schema change event by the absence of the `op` and `ddl` fields.
+func (c *dbzCodec) EncodeCheckpointEvent(
+	ts uint64,
+	dest io.Writer,
+) error {
+	jWriter := util.BorrowJSONWriter(dest)
+	defer util.ReturnJSONWriter(jWriter)
+
+	jWriter.WriteObject(func() {
+		jWriter.WriteObjectField("payload", func() {
+			c.writeSource(jWriter, ts, "", "")
+			jWriter.WriteInt64Field("ts_ms", c.nowFunc().UnixMilli())
+		})
+	})
+	return nil
+}
+
+// getTableChangeType returns the type of the table change caused by the DDL,
+// an empty string is returned if the DDL does not change any table.
+func getTableChangeType(e *model.DDLEvent) string {
+	if e.TableInfo == nil || e.TableInfo.TableInfo == nil || len(e.TableInfo.Columns) == 0 {
+		return ""
+	}
+	switch e.Type {
+	case timodel.ActionCreateTable, timodel.ActionCreateTables, timodel.ActionRecoverTable:
+		return "CREATE"
+	case timodel.ActionDropTable:
+		return "DROP"
+	case timodel.ActionCreateSchema, timodel.ActionDropSchema, timodel.ActionModifySchemaCharsetAndCollate,
+		timodel.ActionCreateView, timodel.ActionDropView:
+		return ""
+	}
+	return "ALTER"
+}
+
+func (c *dbzCodec) writeTableChangeTable(jWriter *util.JSONWriter, tableInfo *model.TableInfo) {
+	jWriter.WriteStringField("defaultCharsetName", tableInfo.Charset)
+	jWriter.WriteArrayField("primaryKeyColumnNames", func() {
+		for _, name := range tableInfo.GetPrimaryKeyColumnNames() {
+			jWriter.WriteStringElement(name)
+		}
+	})
+	jWriter.WriteArrayField("columns", func() {
+		position := 1
+		for _, col := range tableInfo.Columns {
+			if !model.IsColCDCVisible(col) {
+				continue
+			}
+			c.writeTableChangeColumn(jWriter, col, position)
+			position++
+		}
+	})
+	if tableInfo.Comment == "" {
+		jWriter.WriteNullField("comment")
+	} else {
+		jWriter.WriteStringField("comment", tableInfo.Comment)
+	}
+}
+
+func (c *dbzCodec) writeTableChangeColumn(
+	jWriter *util.JSONWriter,
+	col *timodel.ColumnInfo,
+	position int,
+) {
+	mysqlType := utils.GetMySQLType(col, false)
+	jWriter.WriteObjectElement(func() {
+		jWriter.WriteStringField("name", col.Name.O)
+		jWriter.WriteIntField("jdbcType",
+			int(internal.MySQLType2JavaType(col.GetType(), utils.IsBinaryMySQLType(mysqlType))))
+		jWriter.WriteNullField("nativeType")
+		jWriter.WriteStringField("typeName", strings.ToUpper(mysqlType))
+		jWriter.WriteStringField("typeExpression", strings.ToUpper(mysqlType))
+		if col.GetCharset() == "" || col.GetCharset() == charset.CharsetBin {
+			jWriter.WriteNullField("charsetName")
+		} else {
+			jWriter.WriteStringField("charsetName", col.GetCharset())
+		}
+		if col.GetFlen() == types.UnspecifiedLength {
+			jWriter.WriteNullField("length")
+		} else {
+			jWriter.WriteIntField("length", col.GetFlen())
+		}
+		switch col.GetType() {
+		case mysql.TypeNewDecimal, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+			jWriter.WriteIntField("scale", col.GetDecimal())
+		default:
+			jWriter.WriteNullField("scale")
+		}
+		jWriter.WriteIntField("position", position)
+		jWriter.WriteBoolField("optional", !mysql.HasNotNullFlag(col.GetFlag()))
+		jWriter.WriteBoolField("autoIncremented", mysql.HasAutoIncrementFlag(col.GetFlag()))
+		jWriter.WriteBoolField("generated", col.IsGenerated())
+		if col.Comment == "" {
+			jWriter.WriteNullField("comment")
+		} else {
+			jWriter.WriteStringField("comment", col.Comment)
+		}
+		defaultValue := model.GetColumnDefaultValue(col)
+		jWriter.WriteBoolField("hasDefaultValue", defaultValue != nil)
+		if defaultValue == nil {
+			jWriter.WriteNullField("defaultValueExpression")
+		} else {
+			jWriter.WriteStringField("defaultValueExpression", fmt.Sprintf("%v", defaultValue))
+		}
+		switch col.GetType() {
+		case mysql.TypeEnum, mysql.TypeSet:
+			jWriter.WriteArrayField("enumValues", func() {
+				for _, elem := range col.GetElems() {
+					jWriter.WriteStringElement(elem)
+				}
+			})
+		default:
+			jWriter.WriteNullField("enumValues")
+		}
+	})
+}
+
+// writeSchemaChangeSchema writes the schema of the schema change event.
+func (c *dbzCodec) writeSchemaChangeSchema(jWriter *util.JSONWriter) {
+	writeField := func(tp string, optional bool, field string) {
+		jWriter.WriteObjectElement(func() {
+			jWriter.WriteStringField("type", tp)
+			jWriter.WriteBoolField("optional", optional)
+			jWriter.WriteStringField("field", field)
+		})
+	}
+	writeArrayField := func(optional bool, field string, itemsFn func()) {
+		jWriter.WriteObjectElement(func() {
+			jWriter.WriteStringField("type", "array")
+			jWriter.WriteBoolField("optional", optional)
+			jWriter.WriteObjectField("items", itemsFn)
+			jWriter.WriteStringField("field", field)
+		})
+	}
+
+	jWriter.WriteStringField("type", "struct")
+	jWriter.WriteBoolField("optional", false)
+	jWriter.WriteStringField("name", "io.debezium.connector.mysql.SchemaChangeValue")
+	jWriter.WriteIntField("version", 1)
+	jWriter.WriteArrayField("fields", func() {
+		c.writeSourceSchema(jWriter)
+		writeField("int64", false, "ts_ms")
+		writeField("string", true, "databaseName")
+		writeField("string", true, "schemaName")
+		writeField("string", true, "ddl")
+		writeArrayField(false, "tableChanges", func() {
+			jWriter.WriteStringField("type", "struct")
+			jWriter.WriteBoolField("optional", false)
+			jWriter.WriteStringField("name", "io.debezium.connector.schema.Change")
+			jWriter.WriteIntField("version", 1)
+			jWriter.WriteArrayField("fields", func() {
+				writeField("string", false, "type")
+				writeField("string", false, "id")
+				jWriter.WriteObjectElement(func() {
+					jWriter.WriteStringField("type", "struct")
+					jWriter.WriteBoolField("optional", true)
+					jWriter.WriteStringField("name", "io.debezium.connector.schema.Table")
+					jWriter.WriteIntField("version", 1)
+					jWriter.WriteArrayField("fields", func() {
+						writeField("string", true, "defaultCharsetName")
+						writeArrayField(true, "primaryKeyColumnNames", func() {
+							jWriter.WriteStringField("type", "string")
+							jWriter.WriteBoolField("optional", false)
+						})
+						writeArrayField(false, "columns", func() {
+							jWriter.WriteStringField("type", "struct")
+							jWriter.WriteBoolField("optional", false)
+							jWriter.WriteStringField("name", "io.debezium.connector.schema.Column")
+							jWriter.WriteIntField("version", 1)
+							jWriter.WriteArrayField("fields", func() {
+								writeField("string", false, "name")
+								writeField("int32", false, "jdbcType")
+								writeField("int32", true, "nativeType")
+								writeField("string", false, "typeName")
+								writeField("string", true, "typeExpression")
+								writeField("string", true, "charsetName")
+								writeField("int32", true, "length")
+								writeField("int32", true, "scale")
+								writeField("int32", false, "position")
+								writeField("boolean", true, "optional")
+								writeField("boolean", true, "autoIncremented")
+								writeField("boolean", true, "generated")
+								writeField("string", true, "comment")
+								writeField("boolean", true, "hasDefaultValue")
+								writeField("string", true, "defaultValueExpression")
+								writeArrayField(true, "enumValues", func() {
+									jWriter.WriteStringField("type", "string")
+									jWriter.WriteBoolField("optional", false)
+								})
+							})
+						})
+						writeField("string", true, "comment")
+					})
+					jWriter.WriteStringField("field", "table")
+				})
+			})
+		})
+	})
+}
+
+// writeSource writes the source object of the event, which is shared by the
+// row changed event, the schema change event and the checkpoint event.
+func (c *dbzCodec) writeSource(jWriter *util.JSONWriter, commitTs uint64, schema, table string) {
+	commitTime := oracle.GetTimeFromTS(commitTs)
+	jWriter.WriteObjectField("source", func() {
+		jWriter.WriteStringField("version", "2.4.0.Final")
+		jWriter.WriteStringField("connector", "TiCDC")
+		jWriter.WriteStringField("name", c.clusterID)
+		// ts_ms: In the source object, ts_ms indicates the time that the change was made in the database.
+		// https://debezium.io/documentation/reference/stable/connectors/mysql.html#mysql-create-events
+		jWriter.WriteInt64Field("ts_ms", commitTime.UnixMilli())
+		// snapshot field is a string of true,last,false,incremental
+		jWriter.WriteStringField("snapshot", "false")
+		jWriter.WriteStringField("db", schema)
+		if table == "" {
+			jWriter.WriteNullField("table")
+		} else {
+			jWriter.WriteStringField("table", table)
+		}
+		jWriter.WriteInt64Field("server_id", 0)
+		jWriter.WriteNullField("gtid")
+		jWriter.WriteStringField("file", "")
+		jWriter.WriteInt64Field("pos", 0)
+		jWriter.WriteInt64Field("row", 0)
+		jWriter.WriteInt64Field("thread", 0)
+		jWriter.WriteNullField("query")
+
+		// The followings are TiDB extended fields
+		jWriter.WriteUint64Field("commit_ts", commitTs)
+		jWriter.WriteStringField("cluster_id", c.clusterID)
+	})
+}
+
+// writeSourceSchema writes the schema of the source object.
+func (c *dbzCodec) writeSourceSchema(jWriter *util.JSONWriter) {
+	jWriter.WriteObjectElement(func() {
+		jWriter.WriteStringField("type", "struct")
+		jWriter.WriteArrayField("fields", func() {
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("field", "version")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("field", "connector")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("field", "name")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "int64")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("field", "ts_ms")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", true)
+				jWriter.WriteStringField("name", "io.debezium.data.Enum")
+				jWriter.WriteIntField("version", 1)
+				jWriter.WriteObjectField("parameters", func() {
+					jWriter.WriteStringField("allowed", "true,last,false,incremental")
+				})
+				jWriter.WriteStringField("default", "false")
+				jWriter.WriteStringField("field", "snapshot")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("field", "db")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", true)
+				jWriter.WriteStringField("field", "sequence")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", true)
+				jWriter.WriteStringField("field", "table")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "int64")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("field", "server_id")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", true)
+				jWriter.WriteStringField("field", "gtid")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("field", "file")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "int64")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("field", "pos")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "int32")
+				jWriter.WriteBoolField("optional", false)
+				jWriter.WriteStringField("field", "row")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "int64")
+				jWriter.WriteBoolField("optional", true)
+				jWriter.WriteStringField("field", "thread")
+			})
+			jWriter.WriteObjectElement(func() {
+				jWriter.WriteStringField("type", "string")
+				jWriter.WriteBoolField("optional", true)
+				jWriter.WriteStringField("field", "query")
+			})
+			// Below are extra TiDB fields
+			// jWriter.WriteObjectElement(func() {
+			// 	jWriter.WriteStringField("type", "int64")
+			// 	jWriter.WriteBoolField("optional", false)
+			// 	jWriter.WriteStringField("field", "commit_ts")
+			// })
+			// jWriter.WriteObjectElement(func() {
+			// 	jWriter.WriteStringField("type", "string")
+			// 	jWriter.WriteBoolField("optional", false)
+			// 	jWriter.WriteStringField("field", "cluster_id")
+			// })
+		})
+		jWriter.WriteBoolField("optional", false)
+		jWriter.WriteStringField("name", "io.debezium.connector.mysql.Source")
+		jWriter.WriteStringField("field", "source")
+	})
+}
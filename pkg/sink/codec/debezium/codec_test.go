@@ -15,6 +15,7 @@ package debezium
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/utils"
 	"github.com/stretchr/testify/require"
 	"github.com/thanhpk/randstr"
 )
@@ -611,3 +613,36 @@ func BenchmarkEncodeLargeBinary(b *testing.B) {
 		codec.EncodeRowChangedEvent(e, buf)
 	}
 }
+
+func TestEncodeWithRowImageRules(t *testing.T) {
+	_, updateEvent, deleteEvent := utils.NewRowImageEvent4Test(t, config.GetDefaultReplicaConfig())
+
+	codec := &dbzCodec{
+		config:    common.NewConfig(config.ProtocolDebezium),
+		clusterID: "test-cluster",
+		nowFunc:   func() time.Time { return time.Unix(1701326309, 0) },
+	}
+	codec.config.DebeziumDisableSchema = true
+
+	getBefore := func(e *model.RowChangedEvent) map[string]interface{} {
+		buf := bytes.NewBuffer(nil)
+		err := codec.EncodeRowChangedEvent(e, buf)
+		require.NoError(t, err)
+		var value struct {
+			Payload struct {
+				Before map[string]interface{} `json:"before"`
+			} `json:"payload"`
+		}
+		err = json.Unmarshal(buf.Bytes(), &value)
+		require.NoError(t, err)
+		return value.Payload.Before
+	}
+
+	codec.config.RowImageRules = utils.NewRowImageRules4Test(t, config.RowImageKeysOnly, config.RowImageKeysOnly)
+	require.Equal(t, map[string]interface{}{"id": float64(1), "a": nil, "b": nil}, getBefore(updateEvent))
+	require.Equal(t, map[string]interface{}{"id": float64(1), "a": nil, "b": nil}, getBefore(deleteEvent))
+
+	codec.config.RowImageRules = utils.NewRowImageRules4Test(t, config.RowImageChangedOnly, config.RowImageFull)
+	require.Equal(t, map[string]interface{}{"id": nil, "a": float64(1), "b": nil}, getBefore(updateEvent))
+	require.Equal(t, map[string]interface{}{"id": float64(1), "a": float64(1), "b": "b"}, getBefore(deleteEvent))
+}
@@ -0,0 +1,729 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	timodel "github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/utils"
+	"go.uber.org/zap"
+)
+
+// field is the schema of a field in the Debezium message.
+type field struct {
+	Type       string            `json:"type"`
+	Optional   bool              `json:"optional"`
+	Name       string            `json:"name"`
+	Field      string            `json:"field"`
+	Parameters map[string]string `json:"parameters"`
+	Fields     []*field          `json:"fields"`
+}
+
+type source struct {
+	DB       string  `json:"db"`
+	Table    *string `json:"table"`
+	CommitTs uint64  `json:"commit_ts"`
+}
+
+// tableChangeColumn is the column of the table in the schema change event.
+type tableChangeColumn struct {
+	Name            string   `json:"name"`
+	TypeName        string   `json:"typeName"`
+	CharsetName     *string  `json:"charsetName"`
+	Length          *int     `json:"length"`
+	Scale           *int     `json:"scale"`
+	Position        int      `json:"position"`
+	Optional        bool     `json:"optional"`
+	AutoIncremented bool     `json:"autoIncremented"`
+	Comment         *string  `json:"comment"`
+	EnumValues      []string `json:"enumValues"`
+}
+
+type tableChangeTable struct {
+	DefaultCharsetName    string               `json:"defaultCharsetName"`
+	PrimaryKeyColumnNames []string             `json:"primaryKeyColumnNames"`
+	Columns               []*tableChangeColumn `json:"columns"`
+}
+
+type tableChange struct {
+	Type  string            `json:"type"`
+	ID    string            `json:"id"`
+	Table *tableChangeTable `json:"table"`
+}
+
+type payload struct {
+	Source *source `json:"source"`
+
+	// for the row changed event
+	Op     string         `json:"op"`
+	Before map[string]any `json:"before"`
+	After  map[string]any `json:"after"`
+
+	// for the schema change event
+	DDL          *string        `json:"ddl"`
+	TableChanges []*tableChange `json:"tableChanges"`
+}
+
+type message struct {
+	Payload *payload `json:"payload"`
+	Schema  *field   `json:"schema"`
+}
+
+func (m *message) messageType() model.MessageType {
+	if m.Payload.DDL != nil {
+		return model.MessageTypeDDL
+	}
+	if m.Payload.Op != "" {
+		return model.MessageTypeRow
+	}
+	return model.MessageTypeResolved
+}
+
+// Decoder implement the RowEventDecoder interface
+type Decoder struct {
+	config *common.Config
+
+	keyNames []string
+	value    []byte
+	msg      *message
+
+	// tableInfos caches the table info built from the schema change events,
+	// it's keyed by the schema name and then the table name.
+	tableInfos map[string]map[string]*model.TableInfo
+}
+
+// NewDecoder return a decoder for the Debezium protocol.
+func NewDecoder(config *common.Config) codec.RowEventDecoder {
+	return &Decoder{
+		config:     config,
+		tableInfos: make(map[string]map[string]*model.TableInfo),
+	}
+}
+
+// AddKeyValue implements the RowEventDecoder interface
+func (d *Decoder) AddKeyValue(key, value []byte) error {
+	if d.value != nil {
+		return cerror.ErrDebeziumDecodeFailed.GenWithStack(
+			"decoder value already exists, not consumed yet")
+	}
+	value, err := common.Decompress(d.config.LargeMessageHandle.LargeMessageHandleCompression, value)
+	if err != nil {
+		log.Error("decompress data failed",
+			zap.String("compression", d.config.LargeMessageHandle.LargeMessageHandleCompression),
+			zap.Error(err))
+		return errors.Trace(err)
+	}
+
+	// the key only holds the handle key columns, which are used to build the
+	// primary key if the table info is not provided by the schema change event.
+	d.keyNames = nil
+	if len(key) != 0 {
+		var keyMsg struct {
+			Payload map[string]any `json:"payload"`
+		}
+		if err = unmarshal(key, &keyMsg); err != nil {
+			return err
+		}
+		for name := range keyMsg.Payload {
+			d.keyNames = append(d.keyNames, name)
+		}
+	}
+	d.value = value
+	return nil
+}
+
+// HasNext implements the RowEventDecoder interface
+func (d *Decoder) HasNext() (model.MessageType, bool, error) {
+	if d.value == nil {
+		return model.MessageTypeUnknown, false, nil
+	}
+	msg := new(message)
+	err := unmarshal(d.value, msg)
+	d.value = nil
+	if err != nil {
+		return model.MessageTypeUnknown, false, err
+	}
+	if msg.Payload == nil || msg.Payload.Source == nil {
+		return model.MessageTypeUnknown, false, cerror.ErrDebeziumDecodeFailed.GenWithStack(
+			"payload or source not found in the message")
+	}
+	d.msg = msg
+	return msg.messageType(), true, nil
+}
+
+// NextResolvedEvent implements the RowEventDecoder interface
+func (d *Decoder) NextResolvedEvent() (uint64, error) {
+	if d.msg == nil || d.msg.messageType() != model.MessageTypeResolved {
+		return 0, cerror.ErrDebeziumDecodeFailed.GenWithStack(
+			"not found resolved event message")
+	}
+	ts := d.msg.Payload.Source.CommitTs
+	d.msg = nil
+	return ts, nil
+}
+
+// NextDDLEvent implements the RowEventDecoder interface
+func (d *Decoder) NextDDLEvent() (*model.DDLEvent, error) {
+	if d.msg == nil || d.msg.messageType() != model.MessageTypeDDL {
+		return nil, cerror.ErrDebeziumDecodeFailed.GenWithStack(
+			"not found ddl event message")
+	}
+	p := d.msg.Payload
+	d.msg = nil
+
+	schema, table := p.Source.DB, ""
+	if p.Source.Table != nil {
+		table = *p.Source.Table
+	}
+	result := &model.DDLEvent{
+		StartTs:  p.Source.CommitTs,
+		CommitTs: p.Source.CommitTs,
+		Query:    *p.DDL,
+	}
+
+	var changeType string
+	for _, change := range p.TableChanges {
+		changeType = change.Type
+		if change.Table == nil {
+			continue
+		}
+		result.TableInfo = newTableInfoFromTableChange(schema, table, change.Table)
+	}
+	if result.TableInfo == nil {
+		result.TableInfo = &model.TableInfo{
+			TableName: model.TableName{
+				Schema: schema,
+				Table:  table,
+			},
+		}
+	}
+	result.Type = getDDLActionType(changeType, result.Query)
+
+	switch changeType {
+	case "CREATE", "ALTER":
+		if _, ok := d.tableInfos[schema]; !ok {
+			d.tableInfos[schema] = make(map[string]*model.TableInfo)
+		}
+		d.tableInfos[schema][table] = result.TableInfo
+	case "DROP":
+		delete(d.tableInfos[schema], table)
+	default:
+		if result.Type == timodel.ActionDropSchema {
+			delete(d.tableInfos, schema)
+		}
+	}
+	return result, nil
+}
+
+// NextRowChangedEvent implements the RowEventDecoder interface
+func (d *Decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	if d.msg == nil || d.msg.messageType() != model.MessageTypeRow {
+		return nil, cerror.ErrDebeziumDecodeFailed.GenWithStack(
+			"not found row changed event message")
+	}
+	msg := d.msg
+	d.msg = nil
+
+	p := msg.Payload
+	table := ""
+	if p.Source.Table != nil {
+		table = *p.Source.Table
+	}
+	tableInfo, err := d.getTableInfo(msg, p.Source.DB, table)
+	if err != nil {
+		return nil, err
+	}
+	result := &model.RowChangedEvent{
+		CommitTs:  p.Source.CommitTs,
+		TableInfo: tableInfo,
+	}
+	switch p.Op {
+	case "c", "r":
+		result.Columns, err = d.decodeColumns(p.After, tableInfo)
+	case "u":
+		result.PreColumns, err = d.decodeColumns(p.Before, tableInfo)
+		if err == nil {
+			result.Columns, err = d.decodeColumns(p.After, tableInfo)
+		}
+	case "d":
+		result.PreColumns, err = d.decodeColumns(p.Before, tableInfo)
+	default:
+		return nil, cerror.ErrDebeziumDecodeFailed.GenWithStack("unknown op %s", p.Op)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getTableInfo returns the table info of the row changed event, the table info built from
+// the schema change event is preferred, then the one built from the embedded schema, and
+// at last the one inferred from the values, which is lossy.
+func (d *Decoder) getTableInfo(msg *message, schema, table string) (*model.TableInfo, error) {
+	if tableInfo, ok := d.tableInfos[schema][table]; ok &&
+		containsAllColumns(tableInfo, msg.Payload.Before) &&
+		containsAllColumns(tableInfo, msg.Payload.After) {
+		return tableInfo, nil
+	}
+
+	if msg.Schema != nil {
+		for _, f := range msg.Schema.Fields {
+			if f.Field != "after" && f.Field != "before" {
+				continue
+			}
+			columns := make([]*timodel.ColumnInfo, 0, len(f.Fields))
+			for _, colField := range f.Fields {
+				col, err := newColumnInfoFromSchema(colField)
+				if err != nil {
+					return nil, err
+				}
+				columns = append(columns, col)
+			}
+			return newTableInfo(schema, table, columns, d.keyNames), nil
+		}
+	}
+
+	values := msg.Payload.After
+	if values == nil {
+		values = msg.Payload.Before
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	columns := make([]*timodel.ColumnInfo, 0, len(names))
+	for _, name := range names {
+		columns = append(columns, newColumnInfoFromValue(name, values[name]))
+	}
+	return newTableInfo(schema, table, columns, d.keyNames), nil
+}
+
+func containsAllColumns(tableInfo *model.TableInfo, values map[string]any) bool {
+	for name := range values {
+		if tableInfo.FindPublicColumnByName(name) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *Decoder) decodeColumns(
+	values map[string]any, tableInfo *model.TableInfo,
+) ([]*model.ColumnData, error) {
+	if values == nil {
+		return nil, nil
+	}
+	result := make([]*model.ColumnData, 0, len(tableInfo.Columns))
+	for _, col := range tableInfo.Columns {
+		if !model.IsColCDCVisible(col) {
+			continue
+		}
+		value, err := d.decodeValue(values[col.Name.O], &col.FieldType)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+		}
+		result = append(result, &model.ColumnData{
+			ColumnID: col.ID,
+			Value:    value,
+		})
+	}
+	return result, nil
+}
+
+// decodeValue converts the Debezium field value back to the value of the mounted column,
+// it's the reverse of `writeDebeziumFieldValue`.
+func (d *Decoder) decodeValue(value any, ft *types.FieldType) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return uint64(1), nil
+		}
+		return uint64(0), nil
+	case json.Number:
+		return decodeNumber(v, ft)
+	case string:
+		return d.decodeString(v, ft)
+	}
+	return nil, errors.Errorf("unexpected value type %T", value)
+}
+
+func decodeNumber(v json.Number, ft *types.FieldType) (any, error) {
+	switch ft.GetType() {
+	case mysql.TypeFloat:
+		f, err := strconv.ParseFloat(v.String(), 32)
+		return float32(f), err
+	case mysql.TypeDouble:
+		return strconv.ParseFloat(v.String(), 64)
+	case mysql.TypeNewDecimal:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return nil, err
+		}
+		decimal := ft.GetDecimal()
+		if decimal == types.UnspecifiedLength {
+			decimal = -1
+		}
+		return strconv.FormatFloat(f, 'f', decimal, 64), nil
+	}
+
+	i, err := v.Int64()
+	if err != nil {
+		return nil, err
+	}
+	switch ft.GetType() {
+	case mysql.TypeDate, mysql.TypeNewDate:
+		return time.Unix(i*60*60*24, 0).UTC().Format("2006-01-02"), nil
+	case mysql.TypeDatetime:
+		var t time.Time
+		if ft.GetDecimal() <= 3 {
+			t = time.UnixMilli(i)
+		} else {
+			t = time.UnixMicro(i)
+		}
+		return formatTime(t.UTC(), ft.GetDecimal()), nil
+	case mysql.TypeDuration:
+		return types.Duration{
+			Duration: time.Duration(i) * time.Microsecond,
+			Fsp:      ft.GetDecimal(),
+		}.String(), nil
+	case mysql.TypeBit, mysql.TypeEnum, mysql.TypeSet:
+		return uint64(i), nil
+	}
+	if mysql.HasUnsignedFlag(ft.GetFlag()) {
+		// BIGINT UNSIGNED is encoded as INT64.
+		return uint64(i), nil
+	}
+	return i, nil
+}
+
+func (d *Decoder) decodeString(v string, ft *types.FieldType) (any, error) {
+	switch ft.GetType() {
+	case mysql.TypeBit:
+		b, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, err
+		}
+		var buf [8]byte
+		copy(buf[:], b)
+		return binary.LittleEndian.Uint64(buf[:]), nil
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString, mysql.TypeTinyBlob,
+		mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if mysql.HasBinaryFlag(ft.GetFlag()) {
+			return base64.StdEncoding.DecodeString(v)
+		}
+		return []byte(v), nil
+	case mysql.TypeEnum:
+		if v == "" {
+			return uint64(0), nil
+		}
+		enum, err := types.ParseEnumName(ft.GetElems(), v, ft.GetCollate())
+		if err != nil {
+			return nil, err
+		}
+		return enum.Value, nil
+	case mysql.TypeSet:
+		if v == "" {
+			return uint64(0), nil
+		}
+		set, err := types.ParseSetName(ft.GetElems(), v, ft.GetCollate())
+		if err != nil {
+			return nil, err
+		}
+		return set.Value, nil
+	case mysql.TypeTimestamp:
+		t, err := time.Parse("2006-01-02T15:04:05.999999Z", v)
+		if err != nil {
+			return nil, err
+		}
+		if d.config.TimeZone != nil {
+			t = t.In(d.config.TimeZone)
+		}
+		return formatTime(t, ft.GetDecimal()), nil
+	}
+	return v, nil
+}
+
+func formatTime(t time.Time, fsp int) string {
+	str := t.Format("2006-01-02 15:04:05")
+	if fsp > 0 {
+		tmp := fmt.Sprintf(".%06d", t.Nanosecond()/1000)
+		str = str + tmp[:1+fsp]
+	}
+	return str
+}
+
+// newColumnInfoFromTableChange converts the column of the schema change event to the column info,
+// it's the reverse of `writeTableChangeColumn`.
+func newColumnInfoFromTableChange(col *tableChangeColumn) *timodel.ColumnInfo {
+	typeName := strings.ToLower(col.TypeName)
+	result := &timodel.ColumnInfo{
+		Name:  timodel.NewCIStr(col.Name),
+		State: timodel.StatePublic,
+	}
+	result.FieldType = *types.NewFieldType(utils.ExtractBasicMySQLType(typeName))
+	if strings.Contains(typeName, "unsigned") {
+		result.AddFlag(mysql.UnsignedFlag)
+	}
+	if strings.Contains(typeName, "zerofill") {
+		result.AddFlag(mysql.ZerofillFlag)
+	}
+	if utils.IsBinaryMySQLType(typeName) {
+		result.AddFlag(mysql.BinaryFlag)
+		result.SetCharset(charset.CharsetBin)
+		result.SetCollate(charset.CollationBin)
+	} else if col.CharsetName != nil {
+		result.SetCharset(*col.CharsetName)
+	}
+	if col.Length != nil {
+		result.SetFlen(*col.Length)
+	}
+	if col.Scale != nil {
+		result.SetDecimal(*col.Scale)
+	}
+	result.SetElems(col.EnumValues)
+	if !col.Optional {
+		result.AddFlag(mysql.NotNullFlag)
+	}
+	if col.AutoIncremented {
+		result.AddFlag(mysql.AutoIncrementFlag)
+	}
+	if col.Comment != nil {
+		result.Comment = *col.Comment
+	}
+	return result
+}
+
+func newTableInfoFromTableChange(
+	schema, table string, t *tableChangeTable,
+) *model.TableInfo {
+	columns := make([]*timodel.ColumnInfo, 0, len(t.Columns))
+	sort.SliceStable(t.Columns, func(i, j int) bool {
+		return t.Columns[i].Position < t.Columns[j].Position
+	})
+	for _, col := range t.Columns {
+		columns = append(columns, newColumnInfoFromTableChange(col))
+	}
+	tableInfo := newTableInfo(schema, table, columns, t.PrimaryKeyColumnNames)
+	tableInfo.Charset = t.DefaultCharsetName
+	return tableInfo
+}
+
+// newColumnInfoFromSchema converts the field of the embedded schema to the column info,
+// it's the reverse of `writeDebeziumFieldSchema`.
+func newColumnInfoFromSchema(f *field) (*timodel.ColumnInfo, error) {
+	var tp byte
+	flen, decimal := types.UnspecifiedLength, types.UnspecifiedLength
+	var (
+		elems  []string
+		binary bool
+	)
+	switch f.Type {
+	case "boolean":
+		tp, flen = mysql.TypeBit, 1
+	case "bytes":
+		if f.Name == "io.debezium.data.Bits" {
+			tp = mysql.TypeBit
+			n, err := strconv.Atoi(f.Parameters["length"])
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+			}
+			flen = n
+		} else {
+			tp, binary = mysql.TypeBlob, true
+		}
+	case "string":
+		switch f.Name {
+		case "io.debezium.data.Enum":
+			tp, elems = mysql.TypeEnum, strings.Split(f.Parameters["allowed"], ",")
+		case "io.debezium.data.EnumSet":
+			tp, elems = mysql.TypeSet, strings.Split(f.Parameters["allowed"], ",")
+		case "io.debezium.data.Json":
+			tp = mysql.TypeJSON
+		case "io.debezium.time.ZonedTimestamp":
+			tp, decimal = mysql.TypeTimestamp, types.MaxFsp
+		default:
+			tp = mysql.TypeVarchar
+		}
+	case "int16":
+		tp = mysql.TypeShort
+	case "int32":
+		switch f.Name {
+		case "io.debezium.time.Date":
+			tp = mysql.TypeDate
+		case "io.debezium.time.Year":
+			tp = mysql.TypeYear
+		default:
+			tp = mysql.TypeLong
+		}
+	case "int64":
+		switch f.Name {
+		case "io.debezium.time.Timestamp":
+			tp, decimal = mysql.TypeDatetime, 3
+		case "io.debezium.time.MicroTimestamp":
+			tp, decimal = mysql.TypeDatetime, types.MaxFsp
+		case "io.debezium.time.MicroTime":
+			tp, decimal = mysql.TypeDuration, types.MaxFsp
+		default:
+			tp = mysql.TypeLonglong
+		}
+	case "float":
+		tp = mysql.TypeFloat
+	case "double":
+		tp = mysql.TypeDouble
+	default:
+		return nil, cerror.ErrDebeziumDecodeFailed.GenWithStack(
+			"unsupported field type %s, field: %s", f.Type, f.Field)
+	}
+
+	result := &timodel.ColumnInfo{
+		Name:  timodel.NewCIStr(f.Field),
+		State: timodel.StatePublic,
+	}
+	result.FieldType = *types.NewFieldType(tp)
+	result.SetFlen(flen)
+	result.SetDecimal(decimal)
+	result.SetElems(elems)
+	if binary {
+		result.AddFlag(mysql.BinaryFlag)
+		result.SetCharset(charset.CharsetBin)
+		result.SetCollate(charset.CollationBin)
+	}
+	if !f.Optional {
+		result.AddFlag(mysql.NotNullFlag)
+	}
+	return result, nil
+}
+
+// newColumnInfoFromValue infers the column info from the value,
+// it's used when neither the schema change event nor the embedded schema is available.
+func newColumnInfoFromValue(name string, value any) *timodel.ColumnInfo {
+	tp := mysql.TypeVarchar
+	switch v := value.(type) {
+	case bool:
+		tp = mysql.TypeBit
+	case json.Number:
+		tp = mysql.TypeLonglong
+		if _, err := v.Int64(); err != nil {
+			tp = mysql.TypeDouble
+		}
+	}
+	result := &timodel.ColumnInfo{
+		Name:  timodel.NewCIStr(name),
+		State: timodel.StatePublic,
+	}
+	result.FieldType = *types.NewFieldType(tp)
+	if tp == mysql.TypeBit {
+		result.SetFlen(1)
+	}
+	return result
+}
+
+// newTableInfo builds the table info from the columns, the primary key is added
+// as the primary index, so that the handle key of the table can be found.
+func newTableInfo(
+	schema, table string, columns []*timodel.ColumnInfo, pkNames []string,
+) *model.TableInfo {
+	tidbTableInfo := &timodel.TableInfo{
+		Name:    timodel.NewCIStr(table),
+		Columns: columns,
+	}
+	nextMockID := int64(100)
+	for i, col := range columns {
+		col.ID = nextMockID
+		col.Offset = i
+		nextMockID += 100
+	}
+
+	if len(pkNames) != 0 {
+		index := &timodel.IndexInfo{
+			ID:      1,
+			Name:    timodel.NewCIStr("primary"),
+			Primary: true,
+			Unique:  true,
+			State:   timodel.StatePublic,
+		}
+		for _, name := range pkNames {
+			for _, col := range columns {
+				if col.Name.O != name {
+					continue
+				}
+				col.AddFlag(mysql.PriKeyFlag | mysql.NotNullFlag)
+				index.Columns = append(index.Columns, &timodel.IndexColumn{
+					Name:   col.Name,
+					Offset: col.Offset,
+					Length: types.UnspecifiedLength,
+				})
+				break
+			}
+		}
+		if len(index.Columns) != 0 {
+			tidbTableInfo.Indices = append(tidbTableInfo.Indices, index)
+		}
+	}
+	return model.WrapTableInfo(100, schema, 100, tidbTableInfo)
+}
+
+// getDDLActionType returns the DDL action type by the table change type,
+// the schema level DDL has no table change, so the query is checked.
+func getDDLActionType(changeType string, query string) timodel.ActionType {
+	switch changeType {
+	case "CREATE":
+		return timodel.ActionCreateTable
+	case "DROP":
+		return timodel.ActionDropTable
+	case "ALTER":
+		return timodel.ActionNone
+	}
+	query = strings.ToLower(strings.TrimSpace(query))
+	if strings.HasPrefix(query, "create schema") || strings.HasPrefix(query, "create database") {
+		return timodel.ActionCreateSchema
+	}
+	if strings.HasPrefix(query, "drop schema") || strings.HasPrefix(query, "drop database") {
+		return timodel.ActionDropSchema
+	}
+	return timodel.ActionNone
+}
+
+func unmarshal(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		log.Error("debezium decoder unmarshal data failed",
+			zap.Error(err), zap.ByteString("data", data))
+		return cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	return nil
+}
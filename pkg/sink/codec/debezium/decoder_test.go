@@ -0,0 +1,205 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	timodel "github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+const decoderTestTableDDL = `create table test.t(
+	id int primary key,
+	c_tinyint tinyint,
+	c_bigint_unsigned bigint unsigned,
+	c_float float,
+	c_double double,
+	c_decimal decimal(10, 2),
+	c_varchar varchar(255),
+	c_blob blob,
+	c_binary binary(4),
+	c_date date,
+	c_datetime datetime(6),
+	c_timestamp timestamp(3) null,
+	c_time time(2),
+	c_year year,
+	c_enum enum('a', 'b', 'c'),
+	c_set set('x', 'y'),
+	c_bit bit(10),
+	c_bit1 bit(1),
+	c_json json)`
+
+const decoderTestTableDML = `insert into test.t values (
+	1, -1, 18446744073709551615, 3.5, 2.25, 123.45, 'hello', x'0102', x'01020304',
+	'2024-01-02', '2024-01-02 03:04:05.123456', '2024-01-02 03:04:05.123', '12:34:56.78',
+	2024, 'b', 'x,y', b'1010101010', b'1', '{"a": 1}')`
+
+func decodeOneMessage(
+	t *testing.T, decoder codec.RowEventDecoder, m *common.Message, expectedType model.MessageType,
+) {
+	err := decoder.AddKeyValue(m.Key, m.Value)
+	require.NoError(t, err)
+	tp, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, expectedType, tp)
+}
+
+func requireColumnsEqual(
+	t *testing.T,
+	expected []*model.ColumnData, expectedTableInfo *model.TableInfo,
+	actual []*model.ColumnData, actualTableInfo *model.TableInfo,
+) {
+	require.Len(t, actual, len(expected))
+	values := make(map[string]interface{}, len(actual))
+	for _, col := range actual {
+		values[actualTableInfo.ForceGetColumnName(col.ColumnID)] = col.Value
+	}
+	for _, col := range expected {
+		name := expectedTableInfo.ForceGetColumnName(col.ColumnID)
+		require.Equal(t, col.Value, values[name], name)
+	}
+}
+
+func TestDecodeRoundTrip(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ddlEvent := helper.DDL2Event(decoderTestTableDDL)
+	insertEvent := helper.DML2Event(decoderTestTableDML, "test", "t")
+	updateEvent := *insertEvent
+	updateEvent.PreColumns = insertEvent.Columns
+	deleteEvent := *insertEvent
+	deleteEvent.PreColumns = insertEvent.Columns
+	deleteEvent.Columns = nil
+
+	for _, disableSchema := range []bool{false, true} {
+		codecConfig := common.NewConfig(config.ProtocolDebezium)
+		codecConfig.TimeZone = time.UTC
+		codecConfig.EnableTiDBExtension = true
+		codecConfig.DebeziumDisableSchema = disableSchema
+		codecConfig.DebeziumOutputKeyAndSchemaChange = true
+		encoder := NewBatchEncoderBuilder(codecConfig, "dbserver1").Build()
+		decoder := NewDecoder(codecConfig)
+
+		m, err := encoder.EncodeDDLEvent(ddlEvent)
+		require.NoError(t, err)
+		decodeOneMessage(t, decoder, m, model.MessageTypeDDL)
+		decodedDDL, err := decoder.NextDDLEvent()
+		require.NoError(t, err)
+		require.Equal(t, ddlEvent.Query, decodedDDL.Query)
+		require.Equal(t, ddlEvent.CommitTs, decodedDDL.CommitTs)
+		require.Equal(t, timodel.ActionCreateTable, decodedDDL.Type)
+		require.Equal(t, "test", decodedDDL.TableInfo.GetSchemaName())
+		require.Equal(t, "t", decodedDDL.TableInfo.GetTableName())
+		require.Equal(t, []string{"id"}, decodedDDL.TableInfo.GetPrimaryKeyColumnNames())
+
+		for _, event := range []*model.RowChangedEvent{insertEvent, &updateEvent, &deleteEvent} {
+			err = encoder.AppendRowChangedEvent(context.Background(), "", event, nil)
+			require.NoError(t, err)
+			messages := encoder.Build()
+			require.Len(t, messages, 1)
+			require.NotNil(t, messages[0].Key)
+
+			decodeOneMessage(t, decoder, messages[0], model.MessageTypeRow)
+			decoded, err := decoder.NextRowChangedEvent()
+			require.NoError(t, err)
+			require.Equal(t, event.CommitTs, decoded.CommitTs)
+			require.Equal(t, event.IsInsert(), decoded.IsInsert())
+			require.Equal(t, event.IsUpdate(), decoded.IsUpdate())
+			require.Equal(t, event.IsDelete(), decoded.IsDelete())
+			requireColumnsEqual(t, event.Columns, event.TableInfo, decoded.Columns, decoded.TableInfo)
+			requireColumnsEqual(t, event.PreColumns, event.TableInfo, decoded.PreColumns, decoded.TableInfo)
+		}
+
+		m, err = encoder.EncodeCheckpointEvent(insertEvent.CommitTs + 1)
+		require.NoError(t, err)
+		decodeOneMessage(t, decoder, m, model.MessageTypeResolved)
+		ts, err := decoder.NextResolvedEvent()
+		require.NoError(t, err)
+		require.Equal(t, insertEvent.CommitTs+1, ts)
+
+		dropEvent := &model.DDLEvent{
+			CommitTs:  insertEvent.CommitTs + 2,
+			Query:     "drop table test.t",
+			Type:      timodel.ActionDropTable,
+			TableInfo: ddlEvent.TableInfo,
+		}
+		m, err = encoder.EncodeDDLEvent(dropEvent)
+		require.NoError(t, err)
+		decodeOneMessage(t, decoder, m, model.MessageTypeDDL)
+		decodedDDL, err = decoder.NextDDLEvent()
+		require.NoError(t, err)
+		require.Equal(t, timodel.ActionDropTable, decodedDDL.Type)
+		require.Empty(t, decoder.(*Decoder).tableInfos["test"])
+	}
+}
+
+func TestDecodeWithoutSchemaChangeEvent(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ddlEvent := helper.DDL2Event(`create table test.t(id int primary key, a varchar(255), b bigint, c datetime(3))`)
+	insertEvent := helper.DML2Event(`insert into test.t values (1, 'a', 2, '2024-01-02 03:04:05.123')`, "test", "t")
+
+	for _, disableSchema := range []bool{false, true} {
+		codecConfig := common.NewConfig(config.ProtocolDebezium)
+		codecConfig.EnableTiDBExtension = true
+		codecConfig.DebeziumDisableSchema = disableSchema
+		encoder := NewBatchEncoderBuilder(codecConfig, "dbserver1").Build()
+		decoder := NewDecoder(codecConfig)
+
+		// the key, the schema change events and the checkpoint events are not output by default.
+		m, err := encoder.EncodeDDLEvent(ddlEvent)
+		require.NoError(t, err)
+		require.Nil(t, m)
+		m, err = encoder.EncodeCheckpointEvent(insertEvent.CommitTs + 1)
+		require.NoError(t, err)
+		require.Nil(t, m)
+
+		err = encoder.AppendRowChangedEvent(context.Background(), "", insertEvent, nil)
+		require.NoError(t, err)
+		messages := encoder.Build()
+		require.Len(t, messages, 1)
+		require.Nil(t, messages[0].Key)
+
+		decodeOneMessage(t, decoder, messages[0], model.MessageTypeRow)
+		decoded, err := decoder.NextRowChangedEvent()
+		require.NoError(t, err)
+		// the handle key is unknown without the message key.
+		require.Empty(t, decoded.TableInfo.GetPrimaryKeyColumnNames())
+
+		values := make(map[string]interface{})
+		for _, col := range decoded.Columns {
+			values[decoded.TableInfo.ForceGetColumnName(col.ColumnID)] = col.Value
+		}
+		require.Equal(t, int64(1), values["id"])
+		require.Equal(t, []byte("a"), values["a"])
+		require.Equal(t, int64(2), values["b"])
+		if disableSchema {
+			// the type of the column is inferred from the value, which is lossy.
+			require.Equal(t, int64(1704164645123), values["c"])
+		} else {
+			require.Equal(t, "2024-01-02 03:04:05.123", values["c"])
+		}
+	}
+}
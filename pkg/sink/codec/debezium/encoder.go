@@ -35,8 +35,25 @@ type BatchEncoder struct {
 
 // EncodeCheckpointEvent implements the RowEventEncoder interface
 func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
-	// Currently ignored. Debezium MySQL Connector does not emit such event.
-	return nil, nil
+	// Debezium MySQL Connector does not emit such event, so it's only sent when
+	// both the TiDB extension and the output of the schema change events are enabled.
+	if !d.config.EnableTiDBExtension || !d.config.DebeziumOutputKeyAndSchemaChange {
+		return nil, nil
+	}
+	valueBuf := bytes.Buffer{}
+	err := d.codec.EncodeCheckpointEvent(ts, &valueBuf)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	value, err := common.Compress(
+		d.config.ChangefeedID,
+		d.config.LargeMessageHandle.LargeMessageHandleCompression,
+		valueBuf.Bytes(),
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return common.NewResolvedMsg(config.ProtocolDebezium, nil, value, ts), nil
 }
 
 // AppendRowChangedEvent implements the RowEventEncoder interface
@@ -46,6 +63,13 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	e *model.RowChangedEvent,
 	callback func(),
 ) error {
+	// the key is kept nil unless enabled, for the compatibility of the existing output.
+	keyBuf := bytes.Buffer{}
+	if d.config.DebeziumOutputKeyAndSchemaChange {
+		if err := d.codec.EncodeKey(e, &keyBuf); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	valueBuf := bytes.Buffer{}
 	err := d.codec.EncodeRowChangedEvent(e, &valueBuf)
 	if err != nil {
@@ -60,8 +84,12 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	if err != nil {
 		return errors.Trace(err)
 	}
+	var key []byte
+	if keyBuf.Len() != 0 {
+		key = keyBuf.Bytes()
+	}
 	m := &common.Message{
-		Key:      nil,
+		Key:      key,
 		Value:    value,
 		Ts:       e.CommitTs,
 		Schema:   e.TableInfo.GetSchemaNamePtr(),
@@ -79,8 +107,23 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 // EncodeDDLEvent implements the RowEventEncoder interface
 // DDL message unresolved tso
 func (d *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
-	// Schema Change Events are currently not supported.
-	return nil, nil
+	if !d.config.DebeziumOutputKeyAndSchemaChange {
+		return nil, nil
+	}
+	valueBuf := bytes.Buffer{}
+	err := d.codec.EncodeDDLEvent(e, &valueBuf)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	value, err := common.Compress(
+		d.config.ChangefeedID,
+		d.config.LargeMessageHandle.LargeMessageHandleCompression,
+		valueBuf.Bytes(),
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return common.NewDDLMsg(config.ProtocolDebezium, nil, value, e), nil
 }
 
 // Build implements the RowEventEncoder interface
@@ -87,6 +87,47 @@ func IsColumnValueEqual(preValue, updatedValue interface{}) bool {
 	return preValue == updatedValue
 }
 
+// IsBeforeImageColumnRetained checks whether the column at the given offset of the
+// `PreColumns` should be encoded, according to the row image of the table.
+// It should only be called for the update and delete event.
+func IsBeforeImageColumnRetained(
+	e *model.RowChangedEvent, offset int, rowImage common.RowImage,
+) bool {
+	col := e.PreColumns[offset]
+	if col == nil {
+		return false
+	}
+	flag := e.TableInfo.ForceGetColumnFlagType(col.ColumnID)
+	if e.IsDelete() {
+		return !rowImage.DeleteOnlyHandleKeyColumns() || flag.IsHandleKey()
+	}
+
+	if rowImage.UpdateOnlyHandleKeyColumns() {
+		return flag.IsHandleKey()
+	}
+	if rowImage.OnlyOutputUpdatedColumns() &&
+		offset < len(e.Columns) && e.Columns[offset] != nil {
+		return !IsColumnValueEqual(col.Value, e.Columns[offset].Value)
+	}
+	return true
+}
+
+// BeforeImageColumns returns the `PreColumns` of the update or delete event, the value
+// of the columns omitted by the row image is set to null. It's used by the protocols
+// which have a fixed column layout, such as csv and debezium.
+func BeforeImageColumns(e *model.RowChangedEvent, rowImage common.RowImage) []*model.Column {
+	cols := e.GetPreColumns()
+	for i, col := range cols {
+		if col == nil || IsBeforeImageColumnRetained(e, i, rowImage) {
+			continue
+		}
+		omitted := *col
+		omitted.Value = nil
+		cols[i] = &omitted
+	}
+	return cols
+}
+
 // MockRowEventEncoderBuilder is a mock implementation of RowEventEncoderBuilder
 type MockRowEventEncoderBuilder struct{}
 
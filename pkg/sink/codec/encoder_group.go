@@ -210,6 +210,20 @@ func newFuture(key model.TopicPartitionKey,
 	}
 }
 
+// PhysicalTableID returns the physical table ID of the events in the future.
+// It's only meaningful if the caller adds the events of one table each time.
+func (p *future) PhysicalTableID() int64 {
+	if len(p.events) == 0 {
+		return 0
+	}
+	return p.events[0].Event.PhysicalTableID
+}
+
+// EventCount returns the number of the events in the future.
+func (p *future) EventCount() int {
+	return len(p.events)
+}
+
 // Ready waits until the response is ready, should be called before consuming the future.
 func (p *future) Ready(ctx context.Context) error {
 	select {
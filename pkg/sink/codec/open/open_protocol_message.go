@@ -114,19 +114,21 @@ func rowChangeToMsg(
 		OnlyHandleKey: largeMessageOnlyHandleKeyColumns,
 	}
 	value := &messageRow{}
+	rowImage := config.RowImage(e.TableInfo)
 	if e.IsDelete() {
-		onlyHandleKeyColumns := config.DeleteOnlyHandleKeyColumns || largeMessageOnlyHandleKeyColumns
+		onlyHandleKeyColumns := rowImage.DeleteOnlyHandleKeyColumns() || largeMessageOnlyHandleKeyColumns
 		value.Delete = rowChangeColumns2CodecColumns(e.GetPreColumns(), onlyHandleKeyColumns)
 		if onlyHandleKeyColumns && len(value.Delete) == 0 {
 			return nil, nil, cerror.ErrOpenProtocolCodecInvalidData.GenWithStack("not found handle key columns for the delete event")
 		}
 	} else if e.IsUpdate() {
+		preOnlyHandleKeyColumns := rowImage.UpdateOnlyHandleKeyColumns() || largeMessageOnlyHandleKeyColumns
 		value.Update = rowChangeColumns2CodecColumns(e.GetColumns(), largeMessageOnlyHandleKeyColumns)
-		value.PreColumns = rowChangeColumns2CodecColumns(e.GetPreColumns(), largeMessageOnlyHandleKeyColumns)
-		if largeMessageOnlyHandleKeyColumns && (len(value.Update) == 0 || len(value.PreColumns) == 0) {
+		value.PreColumns = rowChangeColumns2CodecColumns(e.GetPreColumns(), preOnlyHandleKeyColumns)
+		if preOnlyHandleKeyColumns && (len(value.Update) == 0 || len(value.PreColumns) == 0) {
 			return nil, nil, cerror.ErrOpenProtocolCodecInvalidData.GenWithStack("not found handle key columns for the update event")
 		}
-		if config.OnlyOutputUpdatedColumns {
+		if rowImage.OnlyOutputUpdatedColumns() {
 			value.dropNotUpdatedColumns()
 		}
 	} else {
@@ -22,6 +22,7 @@ import (
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
+	"github.com/pingcap/tiflow/pkg/sink/codec/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -324,3 +325,41 @@ func TestRowChanged2MsgOnlyHandleKeyColumns(t *testing.T) {
 	_, _, err = rowChangeToMsg(deleteEventNoHandleKey, config, true)
 	require.Error(t, err, cerror.ErrOpenProtocolCodecInvalidData)
 }
+
+func TestRowChanged2MsgRowImageRules(t *testing.T) {
+	_, updateEvent, deleteEvent := utils.NewRowImageEvent4Test(t, config.GetDefaultReplicaConfig())
+
+	codecConfig := common.NewConfig(config.ProtocolOpen)
+	codecConfig.RowImageRules = utils.NewRowImageRules4Test(t, config.RowImageKeysOnly, config.RowImageKeysOnly)
+	_, value, err := rowChangeToMsg(updateEvent, codecConfig, false)
+	require.NoError(t, err)
+	require.Len(t, value.Update, 3)
+	require.Len(t, value.PreColumns, 1)
+	require.Contains(t, value.PreColumns, "id")
+
+	_, value, err = rowChangeToMsg(deleteEvent, codecConfig, false)
+	require.NoError(t, err)
+	require.Len(t, value.Delete, 1)
+	require.Contains(t, value.Delete, "id")
+
+	codecConfig.RowImageRules = utils.NewRowImageRules4Test(t, config.RowImageChangedOnly, config.RowImageFull)
+	_, value, err = rowChangeToMsg(updateEvent, codecConfig, false)
+	require.NoError(t, err)
+	require.Len(t, value.PreColumns, 1)
+	require.Contains(t, value.PreColumns, "a")
+
+	_, value, err = rowChangeToMsg(deleteEvent, codecConfig, false)
+	require.NoError(t, err)
+	require.Len(t, value.Delete, 3)
+
+	// the rule overrides the changefeed level configuration.
+	codecConfig.DeleteOnlyHandleKeyColumns = true
+	codecConfig.OnlyOutputUpdatedColumns = true
+	codecConfig.RowImageRules = utils.NewRowImageRules4Test(t, config.RowImageFull, config.RowImageFull)
+	_, value, err = rowChangeToMsg(updateEvent, codecConfig, false)
+	require.NoError(t, err)
+	require.Len(t, value.PreColumns, 3)
+	_, value, err = rowChangeToMsg(deleteEvent, codecConfig, false)
+	require.NoError(t, err)
+	require.Len(t, value.Delete, 3)
+}
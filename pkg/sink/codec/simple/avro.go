@@ -273,13 +273,13 @@ func (a *avroMarshaller) newDMLMessageMap(
 		m["data"] = data
 		m["type"] = string(DMLTypeInsert)
 	} else if event.IsDelete() {
-		old := a.collectColumns(event.PreColumns, event.TableInfo, onlyHandleKey)
+		old := a.collectColumns(beforeImage(event, a.config), event.TableInfo, onlyHandleKey)
 		m["old"] = old
 		m["type"] = string(DMLTypeDelete)
 	} else if event.IsUpdate() {
 		data := a.collectColumns(event.Columns, event.TableInfo, onlyHandleKey)
 		m["data"] = data
-		old := a.collectColumns(event.PreColumns, event.TableInfo, onlyHandleKey)
+		old := a.collectColumns(beforeImage(event, a.config), event.TableInfo, onlyHandleKey)
 		m["old"] = old
 		m["type"] = string(DMLTypeUpdate)
 	} else {
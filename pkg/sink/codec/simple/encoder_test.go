@@ -1386,3 +1386,76 @@ func TestLargeMessageHandleKeyOnly(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeDMLWithRowImageRules(t *testing.T) {
+	createTableDDL, updateEvent, deleteEvent := utils.NewRowImageEvent4Test(t, config.GetDefaultReplicaConfig())
+
+	ctx := context.Background()
+	codecConfig := common.NewConfig(config.ProtocolSimple)
+	codecConfig.RowImageRules = utils.NewRowImageRules4Test(t, config.RowImageChangedOnly, config.RowImageKeysOnly)
+	for _, format := range []common.EncodingFormatType{
+		common.EncodingFormatAvro,
+		common.EncodingFormatJSON,
+	} {
+		codecConfig.EncodingFormat = format
+		b, err := NewBuilder(ctx, codecConfig)
+		require.NoError(t, err)
+		enc := b.Build()
+
+		dec, err := NewDecoder(ctx, codecConfig, nil)
+		require.NoError(t, err)
+
+		m, err := enc.EncodeDDLEvent(createTableDDL)
+		require.NoError(t, err)
+		err = dec.AddKeyValue(m.Key, m.Value)
+		require.NoError(t, err)
+		messageType, hasNext, err := dec.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeDDL, messageType)
+		_, err = dec.NextDDLEvent()
+		require.NoError(t, err)
+
+		err = enc.AppendRowChangedEvent(ctx, "", updateEvent, func() {})
+		require.NoError(t, err)
+		messages := enc.Build()
+		require.Len(t, messages, 1)
+		err = dec.AddKeyValue(messages[0].Key, messages[0].Value)
+		require.NoError(t, err)
+		messageType, hasNext, err = dec.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, messageType)
+
+		decodedRow, err := dec.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.True(t, decodedRow.IsUpdate())
+		// the omitted columns of the old value are filled by the new value.
+		require.Len(t, decodedRow.PreColumns, 3)
+		for idx, col := range decodedRow.PreColumns {
+			colName := decodedRow.TableInfo.ForceGetColumnName(col.ColumnID)
+			if colName == "a" {
+				require.EqualValues(t, 1, col.Value)
+				continue
+			}
+			require.Equal(t, decodedRow.Columns[idx].Value, col.Value)
+		}
+
+		err = enc.AppendRowChangedEvent(ctx, "", deleteEvent, func() {})
+		require.NoError(t, err)
+		messages = enc.Build()
+		require.Len(t, messages, 1)
+		err = dec.AddKeyValue(messages[0].Key, messages[0].Value)
+		require.NoError(t, err)
+		messageType, hasNext, err = dec.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, messageType)
+
+		decodedRow, err = dec.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.True(t, decodedRow.IsDelete())
+		require.Len(t, decodedRow.PreColumns, 1)
+		require.Equal(t, "id", decodedRow.TableInfo.ForceGetColumnName(decodedRow.PreColumns[0].ColumnID))
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/integrity"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/utils"
 	"go.uber.org/zap"
@@ -403,7 +404,7 @@ func buildRowChangedEvent(
 	}
 
 	result.Columns = decodeColumns(msg.Data, tableInfo)
-	result.PreColumns = decodeColumns(msg.Old, tableInfo)
+	result.PreColumns = decodeBeforeImage(msg, tableInfo)
 
 	if enableRowChecksum && msg.Checksum != nil {
 		var (
@@ -490,6 +491,45 @@ func adjustTimestampValue(column *model.ColumnData, flag types.FieldType) {
 	column.Value = ts
 }
 
+// decodeBeforeImage decodes the `Old` of the message, the columns omitted by the row image
+// are filled by the new value for the update event, and skipped for the delete event.
+func decodeBeforeImage(msg *message, tableInfo *model.TableInfo) []*model.ColumnData {
+	if msg.Old == nil || len(msg.Old) == len(tableInfo.Columns) {
+		return decodeColumns(msg.Old, tableInfo)
+	}
+
+	old := make(map[string]interface{}, len(tableInfo.Columns))
+	for _, info := range tableInfo.Columns {
+		name := info.Name.O
+		if value, ok := msg.Old[name]; ok {
+			old[name] = value
+			continue
+		}
+		if value, ok := msg.Data[name]; ok {
+			old[name] = value
+		}
+	}
+	if msg.Type == DMLTypeUpdate {
+		return decodeColumns(old, tableInfo)
+	}
+
+	var result []*model.ColumnData
+	for _, info := range tableInfo.Columns {
+		value, ok := old[info.Name.O]
+		if !ok {
+			continue
+		}
+		columnID := tableInfo.ForceGetColumnIDByName(info.Name.O)
+		col := decodeColumn(value, columnID, &info.FieldType)
+		if col == nil {
+			log.Panic("cannot decode column",
+				zap.String("name", info.Name.O), zap.Any("data", value))
+		}
+		result = append(result, col)
+	}
+	return result
+}
+
 func decodeColumns(
 	rawData map[string]interface{}, tableInfo *model.TableInfo,
 ) []*model.ColumnData {
@@ -618,11 +658,11 @@ func (a *jsonMarshaller) newDMLMessage(
 		m.Data = a.formatColumns(event.Columns, event.TableInfo, onlyHandleKey)
 	} else if event.IsDelete() {
 		m.Type = DMLTypeDelete
-		m.Old = a.formatColumns(event.PreColumns, event.TableInfo, onlyHandleKey)
+		m.Old = a.formatColumns(beforeImage(event, a.config), event.TableInfo, onlyHandleKey)
 	} else if event.IsUpdate() {
 		m.Type = DMLTypeUpdate
 		m.Data = a.formatColumns(event.Columns, event.TableInfo, onlyHandleKey)
-		m.Old = a.formatColumns(event.PreColumns, event.TableInfo, onlyHandleKey)
+		m.Old = a.formatColumns(beforeImage(event, a.config), event.TableInfo, onlyHandleKey)
 	} else {
 		log.Panic("invalid event type, this should not hit", zap.Any("event", event))
 	}
@@ -639,6 +679,19 @@ func (a *jsonMarshaller) newDMLMessage(
 	return m
 }
 
+// beforeImage returns the `PreColumns` of the event,
+// the columns omitted by the row image of the table are set to nil.
+func beforeImage(event *model.RowChangedEvent, config *common.Config) []*model.ColumnData {
+	rowImage := config.RowImage(event.TableInfo)
+	result := make([]*model.ColumnData, len(event.PreColumns))
+	for i, col := range event.PreColumns {
+		if codec.IsBeforeImageColumnRetained(event, i, rowImage) {
+			result[i] = col
+		}
+	}
+	return result
+}
+
 func (a *jsonMarshaller) formatColumns(
 	columns []*model.ColumnData, tableInfo *model.TableInfo, onlyHandleKey bool,
 ) map[string]interface{} {
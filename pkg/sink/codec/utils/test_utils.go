@@ -19,6 +19,8 @@ import (
 	"github.com/pingcap/tiflow/cdc/entry"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
 )
 
 // NewLargeEvent4Test creates large events for test
@@ -149,3 +151,36 @@ func NewLargeEvent4Test(t *testing.T, replicaConfig *config.ReplicaConfig) (*mod
 
 	return ddlEvent, insert, &update, &deleteE
 }
+
+// NewRowImageEvent4Test creates the update and delete events for the row image test,
+// the update event only changes the non-handle key column `a`.
+func NewRowImageEvent4Test(t *testing.T, replicaConfig *config.ReplicaConfig) (*model.DDLEvent, *model.RowChangedEvent, *model.RowChangedEvent) {
+	helper := entry.NewSchemaTestHelperWithReplicaConfig(t, replicaConfig)
+	defer helper.Close()
+
+	ddlEvent := helper.DDL2Event(`create table test.t(id int primary key, a int, b varchar(255))`)
+	insert := helper.DML2Event(`insert into test.t values (1, 1, 'b')`, "test", "t")
+	updated := helper.DML2Event(`update test.t set a = 2 where id = 1`, "test", "t")
+
+	update := *updated
+	update.PreColumns = insert.Columns
+
+	deleteE := *insert
+	deleteE.PreColumns = deleteE.Columns
+	deleteE.Columns = nil
+
+	return ddlEvent, &update, &deleteE
+}
+
+// NewRowImageRules4Test creates the row image rules which match all tables.
+func NewRowImageRules4Test(t *testing.T, updateOldValue, deleteValue string) *common.RowImageRules {
+	rules, err := common.NewRowImageRules([]*config.RowImageRule{
+		{
+			Matcher:        []string{"*.*"},
+			UpdateOldValue: updateOldValue,
+			Delete:         deleteValue,
+		},
+	}, false)
+	require.NoError(t, err)
+	return rules
+}
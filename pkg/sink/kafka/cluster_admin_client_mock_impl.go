@@ -42,6 +42,10 @@ const (
 
 	// defaultMinInsyncReplicas specifies the default `min.insync.replicas` for broker and topic.
 	defaultMinInsyncReplicas = "1"
+
+	// defaultTransactionStateLogReplicationFactor specifies the default
+	// `transaction.state.log.replication.factor` for broker, the mock cluster has only one broker.
+	defaultTransactionStateLogReplicationFactor = "1"
 )
 
 var (
@@ -81,6 +85,7 @@ func NewClusterAdminClientMockImpl() *ClusterAdminClientMockImpl {
 	brokerConfigs := make(map[string]string)
 	brokerConfigs[BrokerMessageMaxBytesConfigName] = BrokerMessageMaxBytes
 	brokerConfigs[MinInsyncReplicasConfigName] = MinInSyncReplicas
+	brokerConfigs[TransactionStateLogReplicationFactorConfigName] = defaultTransactionStateLogReplicationFactor
 
 	topicConfigs := make(map[string]map[string]string)
 	topicConfigs[DefaultMockTopicName] = make(map[string]string)
@@ -97,7 +102,7 @@ func NewClusterAdminClientMockImpl() *ClusterAdminClientMockImpl {
 
 // GetAllBrokers implement the ClusterAdminClient interface
 func (c *ClusterAdminClientMockImpl) GetAllBrokers(context.Context) ([]Broker, error) {
-	return nil, nil
+	return []Broker{{ID: int32(c.controllerID)}}, nil
 }
 
 // GetBrokerConfig implement the ClusterAdminClient interface
@@ -210,6 +215,11 @@ func (c *ClusterAdminClientMockImpl) SetMinInsyncReplicas(minInsyncReplicas stri
 	c.brokerConfigs[MinInsyncReplicasConfigName] = minInsyncReplicas
 }
 
+// SetTransactionStateLogReplicationFactor sets the `transaction.state.log.replication.factor` for broker.
+func (c *ClusterAdminClientMockImpl) SetTransactionStateLogReplicationFactor(replicationFactor string) {
+	c.brokerConfigs[TransactionStateLogReplicationFactorConfigName] = replicationFactor
+}
+
 // GetDefaultMockTopicName returns the default topic name
 func (c *ClusterAdminClientMockImpl) GetDefaultMockTopicName() string {
 	return DefaultMockTopicName
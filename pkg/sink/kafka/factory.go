@@ -15,6 +15,7 @@ package kafka
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/IBM/sarama"
@@ -34,6 +35,9 @@ type Factory interface {
 	SyncProducer(ctx context.Context) (SyncProducer, error)
 	// AsyncProducer creates an async producer to writer message to kafka
 	AsyncProducer(ctx context.Context, failpointCh chan error) (AsyncProducer, error)
+	// TxnAsyncProducer creates a transactional async producer to write message to kafka,
+	// the producer fences all the previous producers with the same `transactionalID`.
+	TxnAsyncProducer(ctx context.Context, transactionalID string) (TxnAsyncProducer, error)
 	// MetricsCollector returns the kafka metrics collector
 	MetricsCollector(role util.Role, adminClient ClusterAdminClient) MetricsCollector
 }
@@ -85,6 +89,24 @@ type AsyncProducer interface {
 	AsyncRunCallback(ctx context.Context) error
 }
 
+// TxnAsyncProducer is the kafka async producer which sends messages in transactions.
+// The messages sent in one transaction are visible to the `read_committed` consumers
+// atomically, and the attached callbacks are called only after the transaction committed.
+type TxnAsyncProducer interface {
+	AsyncProducer
+
+	// BeginTxn starts a new transaction, it must be called before sending messages.
+	BeginTxn() error
+
+	// CommitTxn commits the ongoing transaction, and runs the callbacks
+	// of all messages sent in the transaction.
+	CommitTxn() error
+
+	// AbortTxn aborts the ongoing transaction, the callbacks of the messages
+	// sent in the transaction are dropped.
+	AbortTxn() error
+}
+
 type saramaSyncProducer struct {
 	id       model.ChangeFeedID
 	client   sarama.Client
@@ -280,3 +302,92 @@ func (p *saramaAsyncProducer) AsyncSend(ctx context.Context,
 	}
 	return nil
 }
+
+type saramaTxnAsyncProducer struct {
+	*saramaAsyncProducer
+	transactionalID string
+
+	// callbacks holds the callbacks of the messages sent in the ongoing transaction.
+	callbacksMu sync.Mutex
+	callbacks   []func()
+}
+
+func (p *saramaTxnAsyncProducer) BeginTxn() error {
+	if err := p.producer.BeginTxn(); err != nil {
+		return cerror.WrapError(cerror.ErrKafkaTransaction, err)
+	}
+	return nil
+}
+
+func (p *saramaTxnAsyncProducer) CommitTxn() error {
+	if err := p.producer.CommitTxn(); err != nil {
+		return cerror.WrapError(cerror.ErrKafkaTransaction, err)
+	}
+	p.callbacksMu.Lock()
+	callbacks := p.callbacks
+	p.callbacks = nil
+	p.callbacksMu.Unlock()
+	for _, callback := range callbacks {
+		if callback != nil {
+			callback()
+		}
+	}
+	return nil
+}
+
+func (p *saramaTxnAsyncProducer) AbortTxn() error {
+	p.callbacksMu.Lock()
+	p.callbacks = nil
+	p.callbacksMu.Unlock()
+	if err := p.producer.AbortTxn(); err != nil {
+		return cerror.WrapError(cerror.ErrKafkaTransaction, err)
+	}
+	return nil
+}
+
+// AsyncRunCallback only reports the error, the callbacks are called after the
+// transaction committed, since the acknowledged messages are still invisible
+// to the consumers before that.
+func (p *saramaTxnAsyncProducer) AsyncRunCallback(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("transactional async producer exit since context is done",
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.String("transactionalID", p.transactionalID))
+			return errors.Trace(ctx.Err())
+		case <-p.producer.Successes():
+		case err := <-p.producer.Errors():
+			// See saramaAsyncProducer.AsyncRunCallback for the nil check.
+			if err == nil {
+				return nil
+			}
+			return cerror.WrapError(cerror.ErrKafkaAsyncSendMessage, err)
+		}
+	}
+}
+
+func (p *saramaTxnAsyncProducer) AsyncSend(ctx context.Context,
+	topic string,
+	partition int32,
+	key []byte,
+	value []byte,
+	callback func(),
+) error {
+	msg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Partition: partition,
+		Key:       sarama.StringEncoder(key),
+		Value:     sarama.ByteEncoder(value),
+	}
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	case p.producer.Input() <- msg:
+	}
+	p.callbacksMu.Lock()
+	p.callbacks = append(p.callbacks, callback)
+	p.callbacksMu.Unlock()
+	return nil
+}
@@ -15,6 +15,7 @@ package kafka
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/IBM/sarama"
@@ -29,16 +30,14 @@ import (
 type MockFactory struct {
 	o            *Options
 	changefeedID model.ChangeFeedID
+	coordinator  *MockTxnCoordinator
 }
 
 // NewMockFactory constructs a Factory with mock implementation.
 func NewMockFactory(
 	o *Options, changefeedID model.ChangeFeedID,
 ) (Factory, error) {
-	return &MockFactory{
-		o:            o,
-		changefeedID: changefeedID,
-	}, nil
+	return NewMockTxnCoordinator().NewMockFactory(o, changefeedID)
 }
 
 // AdminClient return a mocked admin client
@@ -77,6 +76,23 @@ func (f *MockFactory) AsyncProducer(
 	}, nil
 }
 
+// TxnAsyncProducer creates a transactional async producer,
+// which fences the previous producers with the same transactional ID.
+func (f *MockFactory) TxnAsyncProducer(
+	_ context.Context,
+	transactionalID string,
+) (TxnAsyncProducer, error) {
+	if !f.o.EnableExactlyOnce {
+		return nil, cerror.ErrKafkaInvalidConfig.GenWithStack(
+			"transactional producer requires `enable-exactly-once` to be true")
+	}
+	return &MockTxnAsyncProducer{
+		coordinator:     f.coordinator,
+		transactionalID: transactionalID,
+		epoch:           f.coordinator.initProducerID(transactionalID),
+	}, nil
+}
+
 // MetricsCollector returns the metric collector
 func (f *MockFactory) MetricsCollector(
 	_ util.Role, _ ClusterAdminClient,
@@ -194,6 +210,180 @@ func (p *MockSaramaAsyncProducer) Close() {
 	p.closed = true
 }
 
+// MockMessage is a message committed to the mock kafka cluster.
+type MockMessage struct {
+	Topic     string
+	Partition int32
+	Key       []byte
+	Value     []byte
+}
+
+// MockTxnCoordinator simulates the transaction coordinator of a kafka cluster,
+// the messages are visible only after the transaction is committed.
+type MockTxnCoordinator struct {
+	mu sync.Mutex
+	// epochs holds the latest producer epoch of each transactional ID.
+	epochs    map[string]int
+	committed []*MockMessage
+	aborted   int
+}
+
+// NewMockTxnCoordinator creates a MockTxnCoordinator.
+func NewMockTxnCoordinator() *MockTxnCoordinator {
+	return &MockTxnCoordinator{epochs: make(map[string]int)}
+}
+
+// NewMockFactory constructs a Factory which shares the transaction coordinator,
+// it can be used as the FactoryCreator to simulate multiple captures.
+func (c *MockTxnCoordinator) NewMockFactory(
+	o *Options, changefeedID model.ChangeFeedID,
+) (Factory, error) {
+	return &MockFactory{
+		o:            o,
+		changefeedID: changefeedID,
+		coordinator:  c,
+	}, nil
+}
+
+// CommittedMessages returns all committed messages in order.
+func (c *MockTxnCoordinator) CommittedMessages() []*MockMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*MockMessage(nil), c.committed...)
+}
+
+// AbortedTxns returns the number of the transactions aborted by the producers.
+func (c *MockTxnCoordinator) AbortedTxns() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.aborted
+}
+
+func (c *MockTxnCoordinator) initProducerID(transactionalID string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.epochs[transactionalID]++
+	return c.epochs[transactionalID]
+}
+
+func (c *MockTxnCoordinator) isFenced(transactionalID string, epoch int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.epochs[transactionalID] != epoch
+}
+
+func (c *MockTxnCoordinator) commit(
+	transactionalID string, epoch int, messages []*MockMessage,
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.epochs[transactionalID] != epoch {
+		return sarama.ErrProducerFenced
+	}
+	c.committed = append(c.committed, messages...)
+	return nil
+}
+
+// MockTxnAsyncProducer is a mock implementation of TxnAsyncProducer interface.
+type MockTxnAsyncProducer struct {
+	coordinator     *MockTxnCoordinator
+	transactionalID string
+	epoch           int
+
+	mu        sync.Mutex
+	inTxn     bool
+	pending   []*MockMessage
+	callbacks []func()
+	closed    bool
+}
+
+// BeginTxn implement the TxnAsyncProducer interface.
+func (p *MockTxnAsyncProducer) BeginTxn() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.coordinator.isFenced(p.transactionalID, p.epoch) {
+		return cerror.WrapError(cerror.ErrKafkaTransaction, sarama.ErrProducerFenced)
+	}
+	if p.inTxn {
+		return cerror.ErrKafkaTransaction.GenWithStack("transaction already started")
+	}
+	p.inTxn = true
+	return nil
+}
+
+// CommitTxn implement the TxnAsyncProducer interface.
+func (p *MockTxnAsyncProducer) CommitTxn() error {
+	p.mu.Lock()
+	if !p.inTxn {
+		p.mu.Unlock()
+		return cerror.ErrKafkaTransaction.GenWithStack("transaction not started")
+	}
+	err := p.coordinator.commit(p.transactionalID, p.epoch, p.pending)
+	callbacks := p.callbacks
+	p.inTxn = false
+	p.pending = nil
+	p.callbacks = nil
+	p.mu.Unlock()
+	if err != nil {
+		return cerror.WrapError(cerror.ErrKafkaTransaction, err)
+	}
+	for _, callback := range callbacks {
+		if callback != nil {
+			callback()
+		}
+	}
+	return nil
+}
+
+// AbortTxn implement the TxnAsyncProducer interface.
+func (p *MockTxnAsyncProducer) AbortTxn() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.coordinator.mu.Lock()
+	p.coordinator.aborted++
+	p.coordinator.mu.Unlock()
+	p.inTxn = false
+	p.pending = nil
+	p.callbacks = nil
+	return nil
+}
+
+// AsyncSend implement the AsyncProducer interface.
+func (p *MockTxnAsyncProducer) AsyncSend(_ context.Context, topic string,
+	partition int32, key []byte, value []byte,
+	callback func(),
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return cerror.ErrKafkaProducerClosed.GenWithStackByArgs()
+	}
+	if !p.inTxn {
+		return cerror.ErrKafkaTransaction.GenWithStack("transaction not started")
+	}
+	p.pending = append(p.pending, &MockMessage{
+		Topic:     topic,
+		Partition: partition,
+		Key:       key,
+		Value:     value,
+	})
+	p.callbacks = append(p.callbacks, callback)
+	return nil
+}
+
+// AsyncRunCallback implement the AsyncProducer interface.
+func (p *MockTxnAsyncProducer) AsyncRunCallback(ctx context.Context) error {
+	<-ctx.Done()
+	return errors.Trace(ctx.Err())
+}
+
+// Close implement the AsyncProducer interface.
+func (p *MockTxnAsyncProducer) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+}
+
 type mockMetricsCollector struct{}
 
 // Run implements the MetricsCollector interface.
@@ -62,6 +62,11 @@ const (
 	// See: https://kafka.apache.org/documentation/#brokerconfigs_min.insync.replicas and
 	// https://kafka.apache.org/documentation/#topicconfigs_min.insync.replicas
 	MinInsyncReplicasConfigName = "min.insync.replicas"
+	// TransactionStateLogReplicationFactorConfigName is the replication factor of the
+	// internal topic which stores the transaction states. The transaction coordinator
+	// is unavailable if there are fewer brokers than the replication factor.
+	// See: https://kafka.apache.org/documentation/#brokerconfigs_transaction.state.log.replication.factor
+	TransactionStateLogReplicationFactorConfigName = "transaction.state.log.replication.factor"
 )
 
 const (
@@ -138,6 +143,7 @@ type urlConfig struct {
 	Cert                         *string `form:"cert"`
 	Key                          *string `form:"key"`
 	InsecureSkipVerify           *bool   `form:"insecure-skip-verify"`
+	EnableExactlyOnce            *bool   `form:"enable-exactly-once"`
 }
 
 // Options stores user specified configurations
@@ -168,6 +174,11 @@ type Options struct {
 	DialTimeout  time.Duration
 	WriteTimeout time.Duration
 	ReadTimeout  time.Duration
+
+	// EnableExactlyOnce indicates whether to send the DML events by the idempotent
+	// and transactional producers, so that the consumers with `read_committed`
+	// isolation level never see the duplicate events written before a failover.
+	EnableExactlyOnce bool
 }
 
 // NewOptions returns a default Kafka configuration
@@ -305,6 +316,16 @@ func (o *Options) Apply(changefeedID model.ChangeFeedID,
 		o.RequiredAcks = r
 	}
 
+	if urlParameter.EnableExactlyOnce != nil {
+		o.EnableExactlyOnce = *urlParameter.EnableExactlyOnce
+	}
+	// The idempotent producer requires the acknowledgement from all in-sync replicas.
+	if o.EnableExactlyOnce && o.RequiredAcks != WaitForAll {
+		return cerror.ErrKafkaInvalidConfig.GenWithStack(
+			"`required-acks` must be -1 when `enable-exactly-once` is true, but got %d",
+			o.RequiredAcks)
+	}
+
 	err = o.applySASL(urlParameter, replicaConfig)
 	if err != nil {
 		return err
@@ -352,6 +373,7 @@ func mergeConfig(
 		dest.Cert = fileConifg.Cert
 		dest.Key = fileConifg.Key
 		dest.InsecureSkipVerify = fileConifg.InsecureSkipVerify
+		dest.EnableExactlyOnce = fileConifg.EnableExactlyOnce
 	}
 	if err := mergo.Merge(dest, urlParameters, mergo.WithOverride); err != nil {
 		return nil, err
@@ -585,6 +607,12 @@ func AdjustOptions(
 		}
 	}
 
+	if options.EnableExactlyOnce {
+		if err = validateTransactionCoordinator(ctx, admin); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	info, exists := topics[topic]
 	// once we have found the topic, no matter `auto-create-topic`,
 	// make sure user input parameters are valid.
@@ -739,6 +767,38 @@ func validateMinInsyncReplicas(
 	return nil
 }
 
+// validateTransactionCoordinator makes sure that the transactional producers can be
+// initialized, the transaction coordinator is not available if the number of brokers
+// is smaller than the replication factor of the transaction state log.
+func validateTransactionCoordinator(ctx context.Context, admin ClusterAdminClient) error {
+	replicationFactorStr, err := admin.GetBrokerConfig(ctx,
+		TransactionStateLogReplicationFactorConfigName)
+	if err != nil {
+		if cerror.ErrKafkaConfigNotFound.Equal(err) {
+			log.Warn("TiCDC cannot find `transaction.state.log.replication.factor` " +
+				"from broker's configuration, please make sure that the number of brokers " +
+				"is greater than or equal to it if you want to use `enable-exactly-once`")
+			return nil
+		}
+		return err
+	}
+	replicationFactor, err := strconv.Atoi(replicationFactorStr)
+	if err != nil {
+		return err
+	}
+	brokers, err := admin.GetAllBrokers(ctx)
+	if err != nil {
+		return err
+	}
+	if len(brokers) < replicationFactor {
+		return cerror.ErrKafkaInvalidConfig.GenWithStack(
+			"TiCDC cannot use the transactional producer when the number of brokers %d "+
+				"is smaller than the `%s` %d of broker",
+			len(brokers), TransactionStateLogReplicationFactorConfigName, replicationFactor)
+	}
+	return nil
+}
+
 // getTopicConfig gets topic config by name.
 // If the topic does not have this configuration,
 // we will try to get it from the broker's configuration.
@@ -191,6 +191,36 @@ func TestTimeout(t *testing.T) {
 	require.Equal(t, 2*time.Minute, options.WriteTimeout)
 }
 
+func TestEnableExactlyOnce(t *testing.T) {
+	options := NewOptions()
+	uri := "kafka://127.0.0.1:9092/kafka-test?enable-exactly-once=true"
+	sinkURI, err := url.Parse(uri)
+	require.NoError(t, err)
+	err = options.Apply(model.DefaultChangeFeedID("test"), sinkURI, config.GetDefaultReplicaConfig())
+	require.NoError(t, err)
+	require.True(t, options.EnableExactlyOnce)
+
+	// the idempotent producer requires `required-acks` to be -1.
+	options = NewOptions()
+	uri = "kafka://127.0.0.1:9092/kafka-test?enable-exactly-once=true&required-acks=1"
+	sinkURI, err = url.Parse(uri)
+	require.NoError(t, err)
+	err = options.Apply(model.DefaultChangeFeedID("test"), sinkURI, config.GetDefaultReplicaConfig())
+	require.ErrorContains(t, err, "`required-acks` must be -1")
+
+	// the configuration in the changefeed config file also works.
+	options = NewOptions()
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.KafkaConfig = &config.KafkaConfig{
+		EnableExactlyOnce: aws.Bool(true),
+	}
+	sinkURI, err = url.Parse("kafka://127.0.0.1:9092/kafka-test")
+	require.NoError(t, err)
+	err = options.Apply(model.DefaultChangeFeedID("test"), sinkURI, replicaConfig)
+	require.NoError(t, err)
+	require.True(t, options.EnableExactlyOnce)
+}
+
 func TestAdjustConfigTopicNotExist(t *testing.T) {
 	// When the topic does not exist, use the broker's configuration to create the topic.
 	adminClient := NewClusterAdminClientMockImpl()
@@ -377,6 +407,38 @@ func TestAdjustConfigMinInsyncReplicas(t *testing.T) {
 	)
 }
 
+func TestAdjustConfigTransactionCoordinator(t *testing.T) {
+	adminClient := NewClusterAdminClientMockImpl()
+	defer adminClient.Close()
+
+	options := NewOptions()
+	options.BrokerEndpoints = []string{"127.0.0.1:9092"}
+	options.EnableExactlyOnce = true
+
+	ctx := context.Background()
+	err := AdjustOptions(ctx, adminClient, options, adminClient.GetDefaultMockTopicName())
+	require.NoError(t, err)
+
+	// The transaction coordinator is unavailable since there is only one broker.
+	adminClient.SetTransactionStateLogReplicationFactor("3")
+	err = AdjustOptions(ctx, adminClient, options, adminClient.GetDefaultMockTopicName())
+	require.Regexp(t,
+		".*number of brokers 1 is smaller than the `transaction.state.log.replication.factor` 3.*",
+		errors.Cause(err),
+	)
+
+	// Skip the check if exactly-once is not enabled.
+	options.EnableExactlyOnce = false
+	err = AdjustOptions(ctx, adminClient, options, adminClient.GetDefaultMockTopicName())
+	require.NoError(t, err)
+
+	// `transaction.state.log.replication.factor` not found in broker's configuration.
+	options.EnableExactlyOnce = true
+	adminClient.DropBrokerConfig(TransactionStateLogReplicationFactorConfigName)
+	err = AdjustOptions(ctx, adminClient, options, adminClient.GetDefaultMockTopicName())
+	require.NoError(t, err)
+}
+
 func TestSkipAdjustConfigMinInsyncReplicasWhenRequiredAcksIsNotWailAll(t *testing.T) {
 	adminClient := NewClusterAdminClientMockImpl()
 	defer adminClient.Close()
@@ -76,6 +76,17 @@ func NewSaramaConfig(ctx context.Context, o *Options) (*sarama.Config, error) {
 	config.Producer.Return.Successes = true
 	config.Producer.Return.Errors = true
 	config.Producer.RequiredAcks = sarama.RequiredAcks(o.RequiredAcks)
+	if o.EnableExactlyOnce {
+		if !version.IsAtLeast(sarama.V0_11_0_0) {
+			return nil, cerror.ErrKafkaInvalidConfig.GenWithStack(
+				"`enable-exactly-once` requires the kafka version at least 0.11.0.0, but got %s",
+				o.Version)
+		}
+		// The idempotent producer guarantees that the retried messages are not duplicated,
+		// it requires only one in-flight request for each broker to keep the order.
+		config.Producer.Idempotent = true
+		config.Net.MaxOpenRequests = 1
+	}
 	compression := strings.ToLower(strings.TrimSpace(o.Compression))
 	switch compression {
 	case "none":
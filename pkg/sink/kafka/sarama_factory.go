@@ -117,6 +117,44 @@ func (f *saramaFactory) AsyncProducer(
 	}, nil
 }
 
+// TxnAsyncProducer return a transactional Async Producer,
+// it should be the caller's responsibility to close the producer
+func (f *saramaFactory) TxnAsyncProducer(
+	ctx context.Context,
+	transactionalID string,
+) (TxnAsyncProducer, error) {
+	if !f.option.EnableExactlyOnce {
+		return nil, errors.ErrKafkaInvalidConfig.GenWithStack(
+			"transactional producer requires `enable-exactly-once` to be true")
+	}
+	config, err := NewSaramaConfig(ctx, f.option)
+	if err != nil {
+		return nil, err
+	}
+	config.MetricRegistry = f.registry
+	config.Producer.Transaction.ID = transactionalID
+
+	client, err := sarama.NewClient(f.option.BrokerEndpoints, config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// The producer ID and epoch are initialized here, which fences
+	// the zombie producers with the same transactional ID.
+	p, err := sarama.NewAsyncProducerFromClient(client)
+	if err != nil {
+		_ = client.Close()
+		return nil, errors.WrapError(errors.ErrKafkaTransaction, err)
+	}
+	return &saramaTxnAsyncProducer{
+		saramaAsyncProducer: &saramaAsyncProducer{
+			client:       client,
+			producer:     p,
+			changefeedID: f.changefeedID,
+		},
+		transactionalID: transactionalID,
+	}, nil
+}
+
 func (f *saramaFactory) MetricsCollector(
 	role util.Role,
 	adminClient ClusterAdminClient,
@@ -80,6 +80,25 @@ func TestNewSaramaConfig(t *testing.T) {
 	require.Equal(t, sarama.SASLMechanism("SCRAM-SHA-256"), cfg.Net.SASL.Mechanism)
 }
 
+func TestNewSaramaConfigEnableExactlyOnce(t *testing.T) {
+	options := NewOptions()
+	options.ClientID = "test-kafka-client"
+	options.EnableExactlyOnce = true
+	ctx := context.Background()
+	cfg, err := NewSaramaConfig(ctx, options)
+	require.NoError(t, err)
+	require.True(t, cfg.Producer.Idempotent)
+	require.Equal(t, 1, cfg.Net.MaxOpenRequests)
+	require.Equal(t, sarama.WaitForAll, cfg.Producer.RequiredAcks)
+
+	cfg.Producer.Transaction.ID = "test-transactional-id"
+	require.NoError(t, cfg.Validate())
+
+	options.Version = "0.10.2.0"
+	_, err = NewSaramaConfig(ctx, options)
+	require.ErrorContains(t, err, "requires the kafka version at least 0.11.0.0")
+}
+
 func TestApplySASL(t *testing.T) {
 	t.Parallel()
 
@@ -259,6 +259,15 @@ func (f *factory) AsyncProducer(
 	return aw, nil
 }
 
+// TxnAsyncProducer is not supported, since kafka-go does not implement
+// the idempotent and transactional producer.
+func (f *factory) TxnAsyncProducer(
+	_ context.Context, _ string,
+) (pkafka.TxnAsyncProducer, error) {
+	return nil, errors.ErrKafkaInvalidConfig.GenWithStack(
+		"`enable-exactly-once` is not supported by the kafka sink v2")
+}
+
 // MetricsCollector returns the kafka metrics collector
 func (f *factory) MetricsCollector(
 	role util.Role,
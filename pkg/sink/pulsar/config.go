@@ -35,6 +35,8 @@ const (
 	// defaultSendTimeout 30s
 	defaultSendTimeout = 30 // 30s
 
+	// defaultTransactionTimeout 60s
+	defaultTransactionTimeout = 60 // 60s
 )
 
 func checkSinkURI(sinkURI *url.URL) error {
@@ -96,6 +98,10 @@ func NewPulsarConfig(sinkURI *url.URL, pulsarConfig *config.PulsarConfig) (*conf
 	if pulsarConfig.SendTimeout == nil {
 		pulsarConfig.SendTimeout = c.SendTimeout
 	}
+	if pulsarConfig.EnableTransaction != nil && *pulsarConfig.EnableTransaction &&
+		pulsarConfig.TransactionTimeout == nil {
+		pulsarConfig.TransactionTimeout = toSec(defaultTransactionTimeout)
+	}
 
 	log.L().Debug("new pulsar config success", zap.Any("config", pulsarConfig))
 
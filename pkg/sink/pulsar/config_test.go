@@ -116,3 +116,22 @@ func TestGetDefaultTopicName(t *testing.T) {
 	config, _ = NewPulsarConfig(sink, replicaConfig.Sink.PulsarConfig)
 	assert.Equal(t, config.GetDefaultTopicName(), "persistent://tenant/namespace/test-topic")
 }
+
+func TestTransactionTimeout(t *testing.T) {
+	sink, _ := url.Parse("pulsar://localhost:6650/test")
+	c, err := NewPulsarConfig(sink, &config.PulsarConfig{})
+	assert.NoError(t, err)
+	assert.Nil(t, c.TransactionTimeout)
+
+	enable := true
+	c, err = NewPulsarConfig(sink, &config.PulsarConfig{EnableTransaction: &enable})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultTransactionTimeout*time.Second, c.TransactionTimeout.Duration())
+
+	c, err = NewPulsarConfig(sink, &config.PulsarConfig{
+		EnableTransaction:  &enable,
+		TransactionTimeout: config.NewTimeSec(10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Second, c.TransactionTimeout.Duration())
+}
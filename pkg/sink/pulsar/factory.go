@@ -40,6 +40,8 @@ func NewCreatorFactory(config *config.PulsarConfig, changefeedID model.ChangeFee
 		// add pulsar default metrics
 		MetricsRegisterer: mq.GetMetricRegistry(),
 		Logger:            NewPulsarLogger(log.L()),
+		// the transaction coordinator is required to send messages in transactions.
+		EnableTransaction: config.EnableTransaction != nil && *config.EnableTransaction,
 	}
 
 	var err error
@@ -34,10 +34,10 @@ func (r *RowChange) CausalityKeys() []string {
 
 	ret := make([]string, 0, 1)
 	if r.preValues != nil {
-		ret = append(ret, r.getCausalityString(r.preValues)...)
+		ret = append(ret, r.getCausalityString(r.preValues, r.preSkipped)...)
 	}
 	if r.postValues != nil {
-		ret = append(ret, r.getCausalityString(r.postValues)...)
+		ret = append(ret, r.getCausalityString(r.postValues, r.postSkipped)...)
 	}
 	return ret
 }
@@ -153,7 +153,7 @@ func truncateIndexValues(
 	return values
 }
 
-func (r *RowChange) getCausalityString(values []interface{}) []string {
+func (r *RowChange) getCausalityString(values []interface{}, skipped []bool) []string {
 	pkAndUks := r.whereHandle.UniqueIdxs
 	if len(pkAndUks) == 0 {
 		// the table has no PK/UK, all values of the row consists the causality key
@@ -161,6 +161,7 @@ func (r *RowChange) getCausalityString(values []interface{}) []string {
 	}
 
 	ret := make([]string, 0, len(pkAndUks))
+	hasSkippedIdx := false
 
 	for _, indexCols := range pkAndUks {
 		// TODO: should not support multi value index and generate the value
@@ -168,6 +169,11 @@ func (r *RowChange) getCausalityString(values []interface{}) []string {
 		if indexCols.MVIndex {
 			continue
 		}
+		// the key is unknown when some columns are not logged
+		if indexHasSkippedColumn(indexCols, skipped) {
+			hasSkippedIdx = true
+			continue
+		}
 		cols, vals := getColsAndValuesOfIdx(r.sourceTableInfo.Columns, indexCols, values)
 		// handle prefix index
 		truncVals := truncateIndexValues(r.tiSessionCtx, r.sourceTableInfo, indexCols, cols, vals)
@@ -179,6 +185,10 @@ func (r *RowChange) getCausalityString(values []interface{}) []string {
 		}
 	}
 
+	if len(ret) == 0 && hasSkippedIdx {
+		// the row is identified by the other image
+		return ret
+	}
 	if len(ret) == 0 {
 		// the table has no PK/UK, or all UK are NULL. all values of the row
 		// consists the causality key
@@ -187,3 +197,12 @@ func (r *RowChange) getCausalityString(values []interface{}) []string {
 
 	return ret
 }
+
+func indexHasSkippedColumn(index *timodel.IndexInfo, skipped []bool) bool {
+	for _, col := range index.Columns {
+		if isSkipped(skipped, col.Offset) {
+			return true
+		}
+	}
+	return false
+}
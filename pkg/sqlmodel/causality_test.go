@@ -211,6 +211,6 @@ func TestGetCausalityString(t *testing.T) {
 		ti := mockTableInfo(t, ca.schema)
 		change := NewRowChange(source, nil, nil, ca.values, ti, nil, nil)
 		change.lazyInitWhereHandle()
-		require.Equal(t, ca.keys, change.getCausalityString(ca.values))
+		require.Equal(t, ca.keys, change.getCausalityString(ca.values, nil))
 	}
 }
@@ -0,0 +1,145 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"strings"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	"go.uber.org/zap"
+)
+
+// RowValue returns the value of the column in RowValues, and false if the column
+// doesn't exist.
+func (r *RowChange) RowValue(column string) (interface{}, bool) {
+	values := r.RowValues()
+	column = strings.ToLower(column)
+	for i, col := range r.sourceTableInfo.Columns {
+		if col.Name.L == column && i < len(values) {
+			return values[i], true
+		}
+	}
+	return nil, false
+}
+
+// GenRowWhere generates the condition to identify the row of RowValues in the
+// target table, i.e. the row after INSERT and UPDATE, or the row before DELETE.
+func (r *RowChange) GenRowWhere() (string, []interface{}) {
+	r.lazyInitWhereHandle()
+
+	columns, values := r.sourceTableInfo.Columns, r.RowValues()
+	uniqueIndex := r.whereHandle.getWhereIdxByData(values)
+	if uniqueIndex != nil {
+		columns, values = getColsAndValuesOfIdx(r.sourceTableInfo.Columns, uniqueIndex, values)
+	}
+
+	var buf strings.Builder
+	for i, col := range columns {
+		if i != 0 {
+			buf.WriteString(" AND ")
+		}
+		buf.WriteString(quotes.QuoteName(col.Name.O))
+		if values[i] == nil {
+			buf.WriteString(" IS ?")
+		} else {
+			buf.WriteString(" = ?")
+		}
+	}
+	return buf.String(), values
+}
+
+// GenConditionalUpsertSQL generates an INSERT ... ON DUPLICATE KEY UPDATE SQL for
+// INSERT and UPDATE, the existing row with the same key is overwritten only when
+// `overwrite` is true. `overwrite` refers to the incoming values by VALUES(`col`)
+// and to the existing values by `col`. Because the assignments are evaluated in
+// order, `condColumn` which `overwrite` depends on is assigned last.
+func (r *RowChange) GenConditionalUpsertSQL(
+	overwrite string, overwriteArgs []interface{}, condColumn string,
+) (string, []interface{}) {
+	if r.tp != RowChangeInsert && r.tp != RowChangeUpdate {
+		log.L().DPanic("illegal type for GenConditionalUpsertSQL",
+			zap.String("sourceTable", r.sourceTable.String()),
+			zap.Stringer("changeType", r.tp))
+		return "", nil
+	}
+
+	var buf strings.Builder
+	buf.Grow(2048)
+	buf.WriteString("INSERT INTO ")
+	buf.WriteString(r.targetTable.QuoteString())
+	buf.WriteString(" (")
+
+	generatedColumns := generatedColumnsNameSet(r.targetTableInfo.Columns)
+	args := make([]interface{}, 0, len(r.postValues)*(1+len(overwriteArgs)))
+	colNames := make([]string, 0, len(r.sourceTableInfo.Columns))
+	condColumn = strings.ToLower(condColumn)
+	var condColName string
+	numValues := 0
+	for i, col := range r.sourceTableInfo.Columns {
+		if _, ok := generatedColumns[col.Name.L]; ok {
+			continue
+		}
+		if numValues > 0 {
+			buf.WriteByte(',')
+		}
+		numValues++
+		colName := quotes.QuoteName(col.Name.O)
+		buf.WriteString(colName)
+		args = append(args, r.postValues[i])
+		if col.Name.L == condColumn {
+			condColName = colName
+			continue
+		}
+		colNames = append(colNames, colName)
+	}
+	buf.WriteString(") VALUES ")
+	buf.WriteString(valuesHolder(numValues))
+	if condColName != "" {
+		colNames = append(colNames, condColName)
+	}
+
+	buf.WriteString(" ON DUPLICATE KEY UPDATE ")
+	for i, colName := range colNames {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(colName + "=IF(" + overwrite + ",VALUES(" + colName + ")," + colName + ")")
+		args = append(args, overwriteArgs...)
+	}
+	return buf.String(), args
+}
+
+// GenConditionalDeleteSQL generates a DELETE SQL which deletes the row of the
+// pre values only when `cond` is true for the existing row.
+func (r *RowChange) GenConditionalDeleteSQL(cond string, condArgs ...interface{}) (string, []interface{}) {
+	if r.tp != RowChangeDelete && r.tp != RowChangeUpdate {
+		log.L().DPanic("illegal type for GenConditionalDeleteSQL",
+			zap.String("sourceTable", r.sourceTable.String()),
+			zap.Stringer("changeType", r.tp))
+		return "", nil
+	}
+
+	var buf strings.Builder
+	buf.Grow(1024)
+	buf.WriteString("DELETE FROM ")
+	buf.WriteString(r.targetTable.QuoteString())
+	buf.WriteString(" WHERE ")
+	args := r.genWhere(&buf)
+	buf.WriteString(" AND ")
+	buf.WriteString(cond)
+	buf.WriteString(" LIMIT 1")
+
+	return buf.String(), append(args, condArgs...)
+}
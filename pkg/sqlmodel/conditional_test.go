@@ -0,0 +1,71 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"testing"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenConditionalSQL(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb1"}
+	target := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	sourceTI := mockTableInfo(t, "CREATE TABLE tb1 (ts INT, id INT PRIMARY KEY, name INT)")
+	targetTI := mockTableInfo(t, "CREATE TABLE tb (ts INT, id INT PRIMARY KEY, name INT, gen INT AS (name + 1))")
+
+	insert := NewRowChange(source, target, nil, []interface{}{10, 1, 2}, sourceTI, targetTI, nil)
+	v, ok := insert.RowValue("TS")
+	require.True(t, ok)
+	require.Equal(t, 10, v)
+	_, ok = insert.RowValue("not_exist")
+	require.False(t, ok)
+
+	sql, args := insert.GenConditionalUpsertSQL("(`ts` IS NULL OR VALUES(`ts`) >= `ts`)", nil, "ts")
+	require.Equal(t, "INSERT INTO `db`.`tb` (`ts`,`id`,`name`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE "+
+		"`id`=IF((`ts` IS NULL OR VALUES(`ts`) >= `ts`),VALUES(`id`),`id`),"+
+		"`name`=IF((`ts` IS NULL OR VALUES(`ts`) >= `ts`),VALUES(`name`),`name`),"+
+		"`ts`=IF((`ts` IS NULL OR VALUES(`ts`) >= `ts`),VALUES(`ts`),`ts`)", sql)
+	require.Equal(t, []interface{}{10, 1, 2}, args)
+
+	where, args := insert.GenRowWhere()
+	require.Equal(t, "`id` = ?", where)
+	require.Equal(t, []interface{}{1}, args)
+
+	update := NewRowChange(source, target, []interface{}{10, 1, 2}, []interface{}{11, 2, 3}, sourceTI, targetTI, nil)
+	sql, args = update.GenConditionalUpsertSQL("FIELD(VALUES(`name`),?,?) >= FIELD(`name`,?,?)", []interface{}{"a", "b", "a", "b"}, "name")
+	require.Equal(t, "INSERT INTO `db`.`tb` (`ts`,`id`,`name`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE "+
+		"`ts`=IF(FIELD(VALUES(`name`),?,?) >= FIELD(`name`,?,?),VALUES(`ts`),`ts`),"+
+		"`id`=IF(FIELD(VALUES(`name`),?,?) >= FIELD(`name`,?,?),VALUES(`id`),`id`),"+
+		"`name`=IF(FIELD(VALUES(`name`),?,?) >= FIELD(`name`,?,?),VALUES(`name`),`name`)", sql)
+	require.Equal(t, []interface{}{11, 2, 3, "a", "b", "a", "b", "a", "b", "a", "b", "a", "b", "a", "b"}, args)
+
+	sql, args = update.GenConditionalDeleteSQL("(`ts` IS NULL OR ? >= `ts`)", 11)
+	require.Equal(t, "DELETE FROM `db`.`tb` WHERE `id` = ? AND (`ts` IS NULL OR ? >= `ts`) LIMIT 1", sql)
+	require.Equal(t, []interface{}{1, 11}, args)
+	where, args = update.GenRowWhere()
+	require.Equal(t, "`id` = ?", where)
+	require.Equal(t, []interface{}{2}, args)
+
+	del := NewRowChange(source, target, []interface{}{10, 1, 2}, nil, sourceTI, targetTI, nil)
+	where, args = del.GenRowWhere()
+	require.Equal(t, "`id` = ?", where)
+	require.Equal(t, []interface{}{1}, args)
+	v, ok = del.RowValue("ts")
+	require.True(t, ok)
+	require.Equal(t, 10, v)
+}
@@ -34,6 +34,9 @@ func SameTypeTargetAndColumns(lhs *RowChange, rhs *RowChange) bool {
 	if lhs.tp != rhs.tp {
 		return false
 	}
+	if lhs.HasPartialImage() || rhs.HasPartialImage() {
+		return false
+	}
 	if lhs.sourceTable.Schema == rhs.sourceTable.Schema &&
 		lhs.sourceTable.Table == rhs.sourceTable.Table {
 		return true
@@ -253,6 +256,11 @@ func GenInsertSQL(tp DMLType, changes ...*RowChange) (string, []interface{}) {
 			skipColIdx = append(skipColIdx, i)
 			continue
 		}
+		// columns not logged by binlog_row_image=MINIMAL use the default value
+		if isSkipped(first.postSkipped, i) {
+			skipColIdx = append(skipColIdx, i)
+			continue
+		}
 
 		if columnNum != 0 {
 			buf.WriteByte(',')
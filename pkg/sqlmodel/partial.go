@@ -0,0 +1,184 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/pkg/quotes"
+)
+
+// JSONDiffOp is the operation of a JSONDiff.
+type JSONDiffOp int
+
+// these constants represent operations of JSONDiff.
+const (
+	JSONDiffReplace JSONDiffOp = iota
+	JSONDiffInsert
+	JSONDiffRemove
+)
+
+// JSONDiff is a modification on a path of a JSON document, it's decoded from
+// the partial JSON update of MySQL 8 (binlog_row_value_options=PARTIAL_JSON).
+type JSONDiff struct {
+	Op   JSONDiffOp
+	Path string
+	// Value is the JSON text of the new value, it's empty for JSONDiffRemove.
+	Value string
+}
+
+// JSONDiffs can be used as a post value of a JSON column of an UPDATE row
+// change, the diffs are applied in order on the current value in downstream.
+type JSONDiffs []JSONDiff
+
+// isArrayElement returns true when the path points to an element of an array.
+func (d JSONDiff) isArrayElement() bool {
+	return strings.HasSuffix(d.Path, "]")
+}
+
+// IsIdempotent returns true when applying the diffs more than once gets the
+// same value. Inserting into an array or removing an array element shifts the
+// following elements every time it's applied, so it's not idempotent.
+func (ds JSONDiffs) IsIdempotent() bool {
+	for _, d := range ds {
+		if d.Op != JSONDiffReplace && d.isArrayElement() {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply applies the diffs on the JSON text in the same way as the expression
+// generated for them, and returns the result as JSON text.
+func (ds JSONDiffs) Apply(doc string) (string, error) {
+	bj, err := types.ParseBinaryJSONFromString(doc)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	for _, d := range ds {
+		path, err := types.ParseJSONPathExpr(d.Path)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		if d.Op == JSONDiffRemove {
+			if bj, err = bj.Remove([]types.JSONPathExpression{path}); err != nil {
+				return "", errors.Trace(err)
+			}
+			continue
+		}
+		value, err := types.ParseBinaryJSONFromString(d.Value)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		if d.Op == JSONDiffInsert && d.isArrayElement() {
+			bj, err = bj.ArrayInsert(path, value)
+		} else {
+			bj, err = bj.Modify([]types.JSONPathExpression{path}, []types.BinaryJSON{value}, types.JSONModifySet)
+		}
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+	}
+	return bj.String(), nil
+}
+
+// SetSkippedColumns marks the columns that are not logged in the before image
+// and after image, such as binlog_row_image=MINIMAL/NOBLOB of MySQL. The
+// values of the skipped columns are ignored when generating DML. The
+// parameters are the offsets of the skipped columns and can be empty.
+func (r *RowChange) SetSkippedColumns(preSkipped, postSkipped []int) {
+	r.preSkipped = r.skippedSet(preSkipped)
+	r.postSkipped = r.skippedSet(postSkipped)
+}
+
+func (r *RowChange) skippedSet(offsets []int) []bool {
+	if len(offsets) == 0 {
+		return nil
+	}
+	ret := make([]bool, r.ColumnCount())
+	for _, offset := range offsets {
+		if offset < len(ret) {
+			ret[offset] = true
+		}
+	}
+	return ret
+}
+
+// HasPartialImage returns true when some columns of this row change are not
+// logged or are partially updated. Such row change can only be converted to
+// the single row DML of its own type.
+func (r *RowChange) HasPartialImage() bool {
+	if r.preSkipped != nil || r.postSkipped != nil {
+		return true
+	}
+	if r.tp != RowChangeUpdate {
+		return false
+	}
+	for _, v := range r.postValues {
+		if _, ok := v.(JSONDiffs); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// RowSkippedColumns returns the offsets of the columns not logged in the
+// image of RowValues.
+func (r *RowChange) RowSkippedColumns() []int {
+	skipped := r.preSkipped
+	if r.tp == RowChangeInsert || r.tp == RowChangeUpdate {
+		skipped = r.postSkipped
+	}
+	var ret []int
+	for i, s := range skipped {
+		if s {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
+
+func isSkipped(skipped []bool, offset int) bool {
+	return offset < len(skipped) && skipped[offset]
+}
+
+// genJSONDiffsExpr generates the expression to apply JSON diffs on a column
+// and its arguments. The expression is not idempotent when JSON_ARRAY_INSERT
+// or JSON_REMOVE is applied on array elements, see JSONDiffs.IsIdempotent.
+func genJSONDiffsExpr(column string, diffs JSONDiffs) (string, []interface{}) {
+	expr := quotes.QuoteName(column)
+	args := make([]interface{}, 0, len(diffs)*2)
+	for _, diff := range diffs {
+		switch diff.Op {
+		case JSONDiffRemove:
+			expr = "JSON_REMOVE(" + expr + ", ?)"
+			args = append(args, diff.Path)
+			continue
+		case JSONDiffInsert:
+			// JSON_SET can't insert into the middle of an array
+			if diff.isArrayElement() {
+				expr = "JSON_ARRAY_INSERT(" + expr
+			} else {
+				expr = "JSON_SET(" + expr
+			}
+		default:
+			expr = "JSON_SET(" + expr
+		}
+		expr += ", ?, CAST(? AS JSON))"
+		args = append(args, diff.Path, diff.Value)
+	}
+	return expr, args
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"testing"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinimalImage(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "CREATE TABLE tb (id INT PRIMARY KEY, a INT, b INT UNIQUE, c VARCHAR(10))")
+
+	// UPDATE tb SET a = 2 WHERE id = 1
+	change := NewRowChange(source, nil,
+		[]interface{}{1, nil, nil, nil},
+		[]interface{}{nil, 2, nil, nil},
+		ti, nil, nil)
+	require.False(t, change.HasPartialImage())
+	change.SetSkippedColumns([]int{1, 2, 3}, []int{0, 2, 3})
+	require.True(t, change.HasPartialImage())
+	sql, args := change.GenSQL(DMLUpdate)
+	require.Equal(t, "UPDATE `db`.`tb` SET `a` = ? WHERE `id` = ? LIMIT 1", sql)
+	require.Equal(t, []interface{}{2, 1}, args)
+	require.Equal(t, []string{"1.id.db.tb"}, change.CausalityKeys())
+	require.Equal(t, []int{0, 2, 3}, change.RowSkippedColumns())
+	pre, post := change.SplitUpdate()
+	require.Equal(t, []int{1, 2, 3}, pre.RowSkippedColumns())
+	require.Equal(t, []int{0, 2, 3}, post.RowSkippedColumns())
+
+	// DELETE of a table without PK logs all columns in the before image
+	noPK := mockTableInfo(t, "CREATE TABLE tb (id INT, a INT, b INT UNIQUE, c VARCHAR(10))")
+	change = NewRowChange(source, nil, []interface{}{1, 2, nil, "c"}, nil, noPK, nil, nil)
+	change.SetSkippedColumns([]int{2}, nil)
+	sql, args = change.GenSQL(DMLDelete)
+	require.Equal(t, "DELETE FROM `db`.`tb` WHERE `id` = ? AND `a` = ? AND `c` = ? LIMIT 1", sql)
+	require.Equal(t, []interface{}{1, 2, "c"}, args)
+	require.Equal(t, []int{2}, change.RowSkippedColumns())
+
+	// INSERT INTO tb (id, c) VALUES (1, 'c')
+	change = NewRowChange(source, nil, nil, []interface{}{1, nil, nil, "c"}, ti, nil, nil)
+	change.SetSkippedColumns(nil, []int{1, 2})
+	sql, args = change.GenSQL(DMLInsert)
+	require.Equal(t, "INSERT INTO `db`.`tb` (`id`,`c`) VALUES (?,?)", sql)
+	require.Equal(t, []interface{}{1, "c"}, args)
+
+	other := NewRowChange(source, nil, nil, []interface{}{2, nil, nil, "c"}, ti, nil, nil)
+	require.False(t, SameTypeTargetAndColumns(change, other))
+}
+
+func TestPartialJSON(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "CREATE TABLE tb (id INT PRIMARY KEY, j JSON)")
+
+	diffs := JSONDiffs{
+		{Op: JSONDiffReplace, Path: "$.a", Value: "1"},
+		{Op: JSONDiffInsert, Path: "$.b[0]", Value: `"x"`},
+		{Op: JSONDiffInsert, Path: "$.c", Value: "{}"},
+		{Op: JSONDiffRemove, Path: "$.d"},
+	}
+	change := NewRowChange(source, nil, []interface{}{1, nil}, []interface{}{1, diffs}, ti, nil, nil)
+	require.True(t, change.HasPartialImage())
+	sql, args := change.GenSQL(DMLUpdate)
+	require.Equal(t, "UPDATE `db`.`tb` SET `id` = ?, `j` = JSON_REMOVE(JSON_SET(JSON_ARRAY_INSERT("+
+		"JSON_SET(`j`, ?, CAST(? AS JSON)), ?, CAST(? AS JSON)), ?, CAST(? AS JSON)), ?) WHERE `id` = ? LIMIT 1", sql)
+	require.Equal(t, []interface{}{1, "$.a", "1", "$.b[0]", `"x"`, "$.c", "{}", "$.d", 1}, args)
+}
+
+func TestApplyJSONDiffs(t *testing.T) {
+	t.Parallel()
+
+	diffs := JSONDiffs{
+		{Op: JSONDiffReplace, Path: "$.a", Value: "1"},
+		{Op: JSONDiffInsert, Path: "$.c", Value: "{}"},
+		{Op: JSONDiffRemove, Path: "$.d"},
+	}
+	require.True(t, diffs.IsIdempotent())
+	doc, err := diffs.Apply(`{"a": 0, "b": [1, 2], "d": true}`)
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 1, "b": [1, 2], "c": {}}`, doc)
+	// applied again gets the same value
+	doc, err = diffs.Apply(doc)
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 1, "b": [1, 2], "c": {}}`, doc)
+
+	diffs = JSONDiffs{
+		{Op: JSONDiffInsert, Path: "$.b[0]", Value: `"x"`},
+		{Op: JSONDiffRemove, Path: "$.b[2]"},
+	}
+	require.False(t, diffs.IsIdempotent())
+	require.False(t, JSONDiffs{{Op: JSONDiffRemove, Path: "$[0]"}}.IsIdempotent())
+	doc, err = diffs.Apply(`{"b": [1, 2]}`)
+	require.NoError(t, err)
+	require.Equal(t, `{"b": ["x", 1]}`, doc)
+
+	_, err = diffs.Apply("not json")
+	require.Error(t, err)
+	_, err = JSONDiffs{{Op: JSONDiffReplace, Path: "a", Value: "1"}}.Apply("{}")
+	require.Error(t, err)
+}
@@ -165,6 +165,7 @@ func (r *RowChange) SplitUpdate() (*RowChange, *RowChange) {
 		sourceTable:     r.sourceTable,
 		targetTable:     r.targetTable,
 		preValues:       r.preValues,
+		preSkipped:      r.preSkipped,
 		sourceTableInfo: r.sourceTableInfo,
 		targetTableInfo: r.targetTableInfo,
 		tiSessionCtx:    r.tiSessionCtx,
@@ -175,6 +176,7 @@ func (r *RowChange) SplitUpdate() (*RowChange, *RowChange) {
 		sourceTable:     r.sourceTable,
 		targetTable:     r.targetTable,
 		postValues:      r.postValues,
+		postSkipped:     r.postSkipped,
 		sourceTableInfo: r.sourceTableInfo,
 		targetTableInfo: r.targetTableInfo,
 		tiSessionCtx:    r.tiSessionCtx,
@@ -61,6 +61,10 @@ type RowChange struct {
 
 	preValues  []interface{}
 	postValues []interface{}
+	// preSkipped and postSkipped mark the columns not logged in the images,
+	// nil means the image is full.
+	preSkipped  []bool
+	postSkipped []bool
 
 	sourceTableInfo *timodel.TableInfo
 	targetTableInfo *timodel.TableInfo
@@ -232,9 +236,16 @@ func (r *RowChange) whereColumnsAndValues() ([]string, []interface{}) {
 
 	columns, values := r.sourceTableInfo.Columns, r.preValues
 
-	uniqueIndex := r.whereHandle.getWhereIdxByData(r.preValues)
+	var uniqueIndex *timodel.IndexInfo
+	if r.preSkipped != nil {
+		uniqueIndex = r.whereHandle.getWhereIdxByImage(r.preValues, r.preSkipped)
+	} else {
+		uniqueIndex = r.whereHandle.getWhereIdxByData(r.preValues)
+	}
 	if uniqueIndex != nil {
 		columns, values = getColsAndValuesOfIdx(r.sourceTableInfo.Columns, uniqueIndex, values)
+	} else if r.preSkipped != nil {
+		columns, values = getLoggedColsAndValues(columns, values, r.preSkipped)
 	}
 
 	columnNames := make([]string, 0, len(columns))
@@ -315,11 +326,20 @@ func (r *RowChange) genUpdateSQL() (string, []interface{}) {
 		if _, ok := generatedColumns[col.Name.L]; ok {
 			continue
 		}
+		if isSkipped(r.postSkipped, i) {
+			continue
+		}
 
 		if writtenFirstCol {
 			buf.WriteString(", ")
 		}
 		writtenFirstCol = true
+		if diffs, ok := r.postValues[i].(JSONDiffs); ok {
+			expr, diffArgs := genJSONDiffsExpr(col.Name.O, diffs)
+			fmt.Fprintf(&buf, "%s = %s", quotes.QuoteName(col.Name.O), expr)
+			args = append(args, diffArgs...)
+			continue
+		}
 		fmt.Fprintf(&buf, "%s = ?", quotes.QuoteName(col.Name.O))
 		args = append(args, r.postValues[i])
 	}
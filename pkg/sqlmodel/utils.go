@@ -35,6 +35,24 @@ func getColsAndValuesOfIdx(
 	return cols, values
 }
 
+// getLoggedColsAndValues returns the columns and values which are not skipped.
+func getLoggedColsAndValues(
+	columns []*timodel.ColumnInfo,
+	data []interface{},
+	skipped []bool,
+) ([]*timodel.ColumnInfo, []interface{}) {
+	cols := make([]*timodel.ColumnInfo, 0, len(data))
+	values := make([]interface{}, 0, len(data))
+	for i, v := range data {
+		if isSkipped(skipped, i) {
+			continue
+		}
+		cols = append(cols, columns[i])
+		values = append(values, v)
+	}
+	return cols, values
+}
+
 // valuesHolder gens values holder like (?,?,?).
 func valuesHolder(n int) string {
 	var builder strings.Builder
@@ -152,3 +152,33 @@ func (h *WhereHandle) getWhereIdxByData(data []interface{}) *model.IndexInfo {
 	}
 	return nil
 }
+
+// getWhereIdxByImage is like getWhereIdxByData, but the columns of the index
+// must be logged in the image. The order of UniqueIdxs is not changed because
+// the logged columns may differ between row changes.
+func (h *WhereHandle) getWhereIdxByImage(data []interface{}, skipped []bool) *model.IndexInfo {
+	if h == nil {
+		log.L().DPanic("WhereHandle is nil")
+		return nil
+	}
+	allLogged := func(idx *model.IndexInfo, notNull bool) bool {
+		for _, idxCol := range idx.Columns {
+			if isSkipped(skipped, idxCol.Offset) {
+				return false
+			}
+			if notNull && data[idxCol.Offset] == nil {
+				return false
+			}
+		}
+		return true
+	}
+	if h.UniqueNotNullIdx != nil && allLogged(h.UniqueNotNullIdx, false) {
+		return h.UniqueNotNullIdx
+	}
+	for _, idx := range h.UniqueIdxs {
+		if allLogged(idx, true) {
+			return idx
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+#!/bin/bash
+
+set -eu
+
+CUR=$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)
+source $CUR/../_utils/test_prepare
+WORK_DIR=$OUT_DIR/$TEST_NAME
+CDC_BINARY=cdc.test
+SINK_TYPE=$1
+
+MAX_RETRIES=20
+
+function check_capture_count() {
+	pd=$1
+	expected=$2
+	count=$(cdc cli capture list --pd=$pd 2>&1 | jq '.|length')
+	if [[ ! "$count" -eq "$expected" ]]; then
+		echo "count: $count expected: $expected"
+		exit 1
+	fi
+}
+
+export -f check_capture_count
+
+function run() {
+	# test kafka sink only in this case
+	if [ "$SINK_TYPE" != "kafka" ]; then
+		return
+	fi
+
+	rm -rf $WORK_DIR && mkdir -p $WORK_DIR
+	start_tidb_cluster --workdir $WORK_DIR
+	cd $WORK_DIR
+
+	pd_addr="http://$UP_PD_HOST_1:$UP_PD_PORT_1"
+	TOPIC_NAME="ticdc-kafka-exactly-once-test-$RANDOM"
+	SINK_URI="kafka://127.0.0.1:9092/$TOPIC_NAME?protocol=open-protocol&partition-num=4&kafka-version=${KAFKA_VERSION}&max-message-bytes=10485760"
+
+	run_cdc_server --workdir $WORK_DIR --binary $CDC_BINARY --addr "127.0.0.1:8300" --logsuffix 1 --pd $pd_addr
+	run_cdc_server --workdir $WORK_DIR --binary $CDC_BINARY --addr "127.0.0.1:8301" --logsuffix 2 --pd $pd_addr
+	ensure $MAX_RETRIES check_capture_count $pd_addr 2
+
+	cdc cli changefeed create --pd=$pd_addr --sink-uri="$SINK_URI" --config=$CUR/conf/changefeed.toml
+	# The consumer only reads the messages of the committed transactions.
+	run_kafka_consumer $WORK_DIR "kafka://127.0.0.1:9092/$TOPIC_NAME?protocol=open-protocol&partition-num=4&version=${KAFKA_VERSION}&max-message-bytes=10485760"
+
+	run_sql "CREATE DATABASE kafka_exactly_once;" ${UP_TIDB_HOST} ${UP_TIDB_PORT}
+	# The tables have no primary key, the duplicated messages
+	# would insert duplicated rows into the downstream.
+	for i in $(seq 1 4); do
+		run_sql "CREATE table kafka_exactly_once.t$i(id int, val int);" ${UP_TIDB_HOST} ${UP_TIDB_PORT}
+	done
+	for i in $(seq 1 4); do
+		check_table_exists "kafka_exactly_once.t$i" ${DOWN_TIDB_HOST} ${DOWN_TIDB_PORT}
+	done
+
+	for round in $(seq 1 20); do
+		for i in $(seq 1 4); do
+			run_sql "INSERT INTO kafka_exactly_once.t$i VALUES ($round, $round), ($round, $round + 1);" ${UP_TIDB_HOST} ${UP_TIDB_PORT}
+		done
+		# Kill one capture in the middle of the workload, the tables are moved
+		# to the other capture, which fences the producers of the killed one.
+		if [ "$round" -eq 10 ]; then
+			cdc_pid=$(curl -s http://127.0.0.1:8300/status | jq '.pid')
+			kill_cdc_pid $cdc_pid
+			ensure $MAX_RETRIES check_capture_count $pd_addr 1
+		fi
+	done
+
+	run_cdc_server --workdir $WORK_DIR --binary $CDC_BINARY --addr "127.0.0.1:8300" --logsuffix 3 --pd $pd_addr
+	ensure $MAX_RETRIES check_capture_count $pd_addr 2
+	run_sql "CREATE table kafka_exactly_once.finish_mark(id int primary key);" ${UP_TIDB_HOST} ${UP_TIDB_PORT}
+	check_table_exists "kafka_exactly_once.finish_mark" ${DOWN_TIDB_HOST} ${DOWN_TIDB_PORT}
+	check_sync_diff $WORK_DIR $CUR/conf/diff_config.toml
+
+	cleanup_process $CDC_BINARY
+}
+
+trap stop_tidb_cluster EXIT
+run $*
+check_logs $WORK_DIR
+echo "[$(date)] <<<<<< run test case $TEST_NAME success! >>>>>>"